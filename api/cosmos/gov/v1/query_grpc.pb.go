@@ -21,14 +21,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Proposal_FullMethodName    = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName   = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName        = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName       = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName      = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName     = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName    = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Proposal_FullMethodName        = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName       = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName            = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName           = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName          = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName         = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName        = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName     = "/cosmos.gov.v1.Query/TallyResult"
+	Query_CrossChainCalls_FullMethodName = "/cosmos.gov.v1.Query/CrossChainCalls"
 )
 
 // QueryClient is the client API for Query service.
@@ -51,6 +52,8 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// CrossChainCalls queries the cross chain calls executed by a proposal.
+	CrossChainCalls(ctx context.Context, in *QueryCrossChainCallsRequest, opts ...grpc.CallOption) (*QueryCrossChainCallsResponse, error)
 }
 
 type queryClient struct {
@@ -133,6 +136,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) CrossChainCalls(ctx context.Context, in *QueryCrossChainCallsRequest, opts ...grpc.CallOption) (*QueryCrossChainCallsResponse, error) {
+	out := new(QueryCrossChainCallsResponse)
+	err := c.cc.Invoke(ctx, Query_CrossChainCalls_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -153,6 +165,8 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// CrossChainCalls queries the cross chain calls executed by a proposal.
+	CrossChainCalls(context.Context, *QueryCrossChainCallsRequest) (*QueryCrossChainCallsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -184,6 +198,9 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) CrossChainCalls(context.Context, *QueryCrossChainCallsRequest) (*QueryCrossChainCallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrossChainCalls not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -341,6 +358,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CrossChainCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCrossChainCallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CrossChainCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CrossChainCalls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrossChainCalls(ctx, req.(*QueryCrossChainCallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -380,6 +415,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "CrossChainCalls",
+			Handler:    _Query_CrossChainCalls_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
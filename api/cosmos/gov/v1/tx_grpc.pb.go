@@ -28,6 +28,7 @@ const (
 	Msg_Deposit_FullMethodName                = "/cosmos.gov.v1.Msg/Deposit"
 	Msg_UpdateParams_FullMethodName           = "/cosmos.gov.v1.Msg/UpdateParams"
 	Msg_UpdateCrossChainParams_FullMethodName = "/cosmos.gov.v1.Msg/UpdateCrossChainParams"
+	Msg_ExecuteCrossChainCall_FullMethodName  = "/cosmos.gov.v1.Msg/ExecuteCrossChainCall"
 )
 
 // MsgClient is the client API for Msg service.
@@ -52,6 +53,8 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// UpdateCrossChainParams defines a method to send IBC package to update cross-chain params
 	UpdateCrossChainParams(ctx context.Context, in *MsgUpdateCrossChainParams, opts ...grpc.CallOption) (*MsgUpdateCrossChainParamsResponse, error)
+	// ExecuteCrossChainCall defines a method to send IBC package to call a whitelisted contract on the destination chain
+	ExecuteCrossChainCall(ctx context.Context, in *MsgExecuteCrossChainCall, opts ...grpc.CallOption) (*MsgExecuteCrossChainCallResponse, error)
 }
 
 type msgClient struct {
@@ -125,6 +128,15 @@ func (c *msgClient) UpdateCrossChainParams(ctx context.Context, in *MsgUpdateCro
 	return out, nil
 }
 
+func (c *msgClient) ExecuteCrossChainCall(ctx context.Context, in *MsgExecuteCrossChainCall, opts ...grpc.CallOption) (*MsgExecuteCrossChainCallResponse, error) {
+	out := new(MsgExecuteCrossChainCallResponse)
+	err := c.cc.Invoke(ctx, Msg_ExecuteCrossChainCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -147,6 +159,8 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// UpdateCrossChainParams defines a method to send IBC package to update cross-chain params
 	UpdateCrossChainParams(context.Context, *MsgUpdateCrossChainParams) (*MsgUpdateCrossChainParamsResponse, error)
+	// ExecuteCrossChainCall defines a method to send IBC package to call a whitelisted contract on the destination chain
+	ExecuteCrossChainCall(context.Context, *MsgExecuteCrossChainCall) (*MsgExecuteCrossChainCallResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -175,6 +189,9 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) UpdateCrossChainParams(context.Context, *MsgUpdateCrossChainParams) (*MsgUpdateCrossChainParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCrossChainParams not implemented")
 }
+func (UnimplementedMsgServer) ExecuteCrossChainCall(context.Context, *MsgExecuteCrossChainCall) (*MsgExecuteCrossChainCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteCrossChainCall not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -314,6 +331,24 @@ func _Msg_UpdateCrossChainParams_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExecuteCrossChainCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteCrossChainCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteCrossChainCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExecuteCrossChainCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteCrossChainCall(ctx, req.(*MsgExecuteCrossChainCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -349,6 +384,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCrossChainParams",
 			Handler:    _Msg_UpdateCrossChainParams_Handler,
 		},
+		{
+			MethodName: "ExecuteCrossChainCall",
+			Handler:    _Msg_ExecuteCrossChainCall_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
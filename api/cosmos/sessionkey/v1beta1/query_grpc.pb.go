@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/sessionkey/v1beta1/query.proto
+
+package sessionkeyv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_SessionKey_FullMethodName  = "/cosmos.sessionkey.v1beta1.Query/SessionKey"
+	Query_SessionKeys_FullMethodName = "/cosmos.sessionkey.v1beta1.Query/SessionKeys"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// SessionKey queries a session key of an account.
+	SessionKey(ctx context.Context, in *QuerySessionKeyRequest, opts ...grpc.CallOption) (*QuerySessionKeyResponse, error)
+	// SessionKeys queries all the session keys of an account.
+	SessionKeys(ctx context.Context, in *QuerySessionKeysRequest, opts ...grpc.CallOption) (*QuerySessionKeysResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) SessionKey(ctx context.Context, in *QuerySessionKeyRequest, opts ...grpc.CallOption) (*QuerySessionKeyResponse, error) {
+	out := new(QuerySessionKeyResponse)
+	err := c.cc.Invoke(ctx, Query_SessionKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SessionKeys(ctx context.Context, in *QuerySessionKeysRequest, opts ...grpc.CallOption) (*QuerySessionKeysResponse, error) {
+	out := new(QuerySessionKeysResponse)
+	err := c.cc.Invoke(ctx, Query_SessionKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// SessionKey queries a session key of an account.
+	SessionKey(context.Context, *QuerySessionKeyRequest) (*QuerySessionKeyResponse, error)
+	// SessionKeys queries all the session keys of an account.
+	SessionKeys(context.Context, *QuerySessionKeysRequest) (*QuerySessionKeysResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) SessionKey(context.Context, *QuerySessionKeyRequest) (*QuerySessionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionKey not implemented")
+}
+func (UnimplementedQueryServer) SessionKeys(context.Context, *QuerySessionKeysRequest) (*QuerySessionKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionKeys not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_SessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySessionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SessionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SessionKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SessionKey(ctx, req.(*QuerySessionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SessionKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySessionKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SessionKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SessionKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SessionKeys(ctx, req.(*QuerySessionKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.sessionkey.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SessionKey",
+			Handler:    _Query_SessionKey_Handler,
+		},
+		{
+			MethodName: "SessionKeys",
+			Handler:    _Query_SessionKeys_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/sessionkey/v1beta1/query.proto",
+}
@@ -0,0 +1,152 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/sessionkey/v1beta1/tx.proto
+
+package sessionkeyv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_AddSessionKey_FullMethodName    = "/cosmos.sessionkey.v1beta1.Msg/AddSessionKey"
+	Msg_RemoveSessionKey_FullMethodName = "/cosmos.sessionkey.v1beta1.Msg/RemoveSessionKey"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// AddSessionKey registers a session key of the account, or replaces the permissions
+	// of an existing one.
+	AddSessionKey(ctx context.Context, in *MsgAddSessionKey, opts ...grpc.CallOption) (*MsgAddSessionKeyResponse, error)
+	// RemoveSessionKey removes a session key of the account.
+	RemoveSessionKey(ctx context.Context, in *MsgRemoveSessionKey, opts ...grpc.CallOption) (*MsgRemoveSessionKeyResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddSessionKey(ctx context.Context, in *MsgAddSessionKey, opts ...grpc.CallOption) (*MsgAddSessionKeyResponse, error) {
+	out := new(MsgAddSessionKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_AddSessionKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveSessionKey(ctx context.Context, in *MsgRemoveSessionKey, opts ...grpc.CallOption) (*MsgRemoveSessionKeyResponse, error) {
+	out := new(MsgRemoveSessionKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveSessionKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// AddSessionKey registers a session key of the account, or replaces the permissions
+	// of an existing one.
+	AddSessionKey(context.Context, *MsgAddSessionKey) (*MsgAddSessionKeyResponse, error)
+	// RemoveSessionKey removes a session key of the account.
+	RemoveSessionKey(context.Context, *MsgRemoveSessionKey) (*MsgRemoveSessionKeyResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) AddSessionKey(context.Context, *MsgAddSessionKey) (*MsgAddSessionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSessionKey not implemented")
+}
+func (UnimplementedMsgServer) RemoveSessionKey(context.Context, *MsgRemoveSessionKey) (*MsgRemoveSessionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSessionKey not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_AddSessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddSessionKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddSessionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddSessionKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddSessionKey(ctx, req.(*MsgAddSessionKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSessionKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSessionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveSessionKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSessionKey(ctx, req.(*MsgRemoveSessionKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.sessionkey.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddSessionKey",
+			Handler:    _Msg_AddSessionKey_Handler,
+		},
+		{
+			MethodName: "RemoveSessionKey",
+			Handler:    _Msg_RemoveSessionKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/sessionkey/v1beta1/tx.proto",
+}
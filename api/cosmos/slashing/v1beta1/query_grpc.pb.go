@@ -22,6 +22,8 @@ const (
 	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
 	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
 	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Impeachment_FullMethodName  = "/cosmos.slashing.v1beta1.Query/Impeachment"
+	Query_Impeachments_FullMethodName = "/cosmos.slashing.v1beta1.Query/Impeachments"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,10 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// Impeachment queries the impeachment record of given validator address
+	Impeachment(ctx context.Context, in *QueryImpeachmentRequest, opts ...grpc.CallOption) (*QueryImpeachmentResponse, error)
+	// Impeachments queries the impeachment records of all validators
+	Impeachments(ctx context.Context, in *QueryImpeachmentsRequest, opts ...grpc.CallOption) (*QueryImpeachmentsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +77,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) Impeachment(ctx context.Context, in *QueryImpeachmentRequest, opts ...grpc.CallOption) (*QueryImpeachmentResponse, error) {
+	out := new(QueryImpeachmentResponse)
+	err := c.cc.Invoke(ctx, Query_Impeachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Impeachments(ctx context.Context, in *QueryImpeachmentsRequest, opts ...grpc.CallOption) (*QueryImpeachmentsResponse, error) {
+	out := new(QueryImpeachmentsResponse)
+	err := c.cc.Invoke(ctx, Query_Impeachments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +105,10 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// Impeachment queries the impeachment record of given validator address
+	Impeachment(context.Context, *QueryImpeachmentRequest) (*QueryImpeachmentResponse, error)
+	// Impeachments queries the impeachment records of all validators
+	Impeachments(context.Context, *QueryImpeachmentsRequest) (*QueryImpeachmentsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +125,12 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) Impeachment(context.Context, *QueryImpeachmentRequest) (*QueryImpeachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Impeachment not implemented")
+}
+func (UnimplementedQueryServer) Impeachments(context.Context, *QueryImpeachmentsRequest) (*QueryImpeachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Impeachments not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +198,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Impeachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryImpeachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Impeachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Impeachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Impeachment(ctx, req.(*QueryImpeachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Impeachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryImpeachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Impeachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Impeachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Impeachments(ctx, req.(*QueryImpeachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +253,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "Impeachment",
+			Handler:    _Query_Impeachment_Handler,
+		},
+		{
+			MethodName: "Impeachments",
+			Handler:    _Query_Impeachments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -33,6 +33,21 @@ const (
 	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
 	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
 	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_BlsKeyRotation_FullMethodName                = "/cosmos.staking.v1beta1.Query/BlsKeyRotation"
+	Query_BlsKeyRotations_FullMethodName               = "/cosmos.staking.v1beta1.Query/BlsKeyRotations"
+	Query_ValidatorKeyHistory_FullMethodName           = "/cosmos.staking.v1beta1.Query/ValidatorKeyHistory"
+	Query_ValidatorKeysAtHeight_FullMethodName         = "/cosmos.staking.v1beta1.Query/ValidatorKeysAtHeight"
+	Query_ValidatorByRelayerAtHeight_FullMethodName    = "/cosmos.staking.v1beta1.Query/ValidatorByRelayerAtHeight"
+	Query_ValidatorByChallengerAtHeight_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorByChallengerAtHeight"
+	Query_ValidatorByBlsKeyAtHeight_FullMethodName     = "/cosmos.staking.v1beta1.Query/ValidatorByBlsKeyAtHeight"
+	Query_ValidatorApplication_FullMethodName          = "/cosmos.staking.v1beta1.Query/ValidatorApplication"
+	Query_ValidatorApplications_FullMethodName         = "/cosmos.staking.v1beta1.Query/ValidatorApplications"
+	Query_ValidatorRetirement_FullMethodName           = "/cosmos.staking.v1beta1.Query/ValidatorRetirement"
+	Query_ValidatorRetirements_FullMethodName          = "/cosmos.staking.v1beta1.Query/ValidatorRetirements"
+	Query_TokenizeShareRecord_FullMethodName           = "/cosmos.staking.v1beta1.Query/TokenizeShareRecord"
+	Query_TokenizeShareRecords_FullMethodName          = "/cosmos.staking.v1beta1.Query/TokenizeShareRecords"
+	Query_TokenizeShareRecordsOwned_FullMethodName     = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordsOwned"
+	Query_TotalLiquidStaked_FullMethodName             = "/cosmos.staking.v1beta1.Query/TotalLiquidStaked"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +107,38 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BlsKeyRotation queries the active bls key rotation of a validator.
+	BlsKeyRotation(ctx context.Context, in *QueryBlsKeyRotationRequest, opts ...grpc.CallOption) (*QueryBlsKeyRotationResponse, error)
+	// BlsKeyRotations queries all the active bls key rotations.
+	BlsKeyRotations(ctx context.Context, in *QueryBlsKeyRotationsRequest, opts ...grpc.CallOption) (*QueryBlsKeyRotationsResponse, error)
+	// ValidatorKeyHistory queries the history of the relayer address, the challenger address and the bls key
+	// of a validator.
+	ValidatorKeyHistory(ctx context.Context, in *QueryValidatorKeyHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorKeyHistoryResponse, error)
+	// ValidatorKeysAtHeight queries the relayer address, the challenger address and the bls key of a validator
+	// at a height.
+	ValidatorKeysAtHeight(ctx context.Context, in *QueryValidatorKeysAtHeightRequest, opts ...grpc.CallOption) (*QueryValidatorKeysAtHeightResponse, error)
+	// ValidatorByRelayerAtHeight queries the validator whose relayer address was the given one at a height.
+	ValidatorByRelayerAtHeight(ctx context.Context, in *QueryValidatorByRelayerAtHeightRequest, opts ...grpc.CallOption) (*QueryValidatorByRelayerAtHeightResponse, error)
+	// ValidatorByChallengerAtHeight queries the validator whose challenger address was the given one at a height.
+	ValidatorByChallengerAtHeight(ctx context.Context, in *QueryValidatorByChallengerAtHeightRequest, opts ...grpc.CallOption) (*QueryValidatorByChallengerAtHeightResponse, error)
+	// ValidatorByBlsKeyAtHeight queries the validator whose bls key was the given one at a height.
+	ValidatorByBlsKeyAtHeight(ctx context.Context, in *QueryValidatorByBlsKeyAtHeightRequest, opts ...grpc.CallOption) (*QueryValidatorByBlsKeyAtHeightResponse, error)
+	// ValidatorApplication queries the pending application of a validator created without governance.
+	ValidatorApplication(ctx context.Context, in *QueryValidatorApplicationRequest, opts ...grpc.CallOption) (*QueryValidatorApplicationResponse, error)
+	// ValidatorApplications queries all the pending applications of the validators created without governance.
+	ValidatorApplications(ctx context.Context, in *QueryValidatorApplicationsRequest, opts ...grpc.CallOption) (*QueryValidatorApplicationsResponse, error)
+	// ValidatorRetirement queries the retirement of a retiring or retired validator.
+	ValidatorRetirement(ctx context.Context, in *QueryValidatorRetirementRequest, opts ...grpc.CallOption) (*QueryValidatorRetirementResponse, error)
+	// ValidatorRetirements queries the retirements of all the retiring and retired validators.
+	ValidatorRetirements(ctx context.Context, in *QueryValidatorRetirementsRequest, opts ...grpc.CallOption) (*QueryValidatorRetirementsResponse, error)
+	// TokenizeShareRecord queries the tokenize share record by its id.
+	TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error)
+	// TokenizeShareRecords queries all the tokenize share records.
+	TokenizeShareRecords(ctx context.Context, in *QueryTokenizeShareRecordsRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by the address.
+	TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// TotalLiquidStaked queries the total tokens of the tokenized delegations.
+	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
 }
 
 type queryClient struct {
@@ -228,6 +275,141 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BlsKeyRotation(ctx context.Context, in *QueryBlsKeyRotationRequest, opts ...grpc.CallOption) (*QueryBlsKeyRotationResponse, error) {
+	out := new(QueryBlsKeyRotationResponse)
+	err := c.cc.Invoke(ctx, Query_BlsKeyRotation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlsKeyRotations(ctx context.Context, in *QueryBlsKeyRotationsRequest, opts ...grpc.CallOption) (*QueryBlsKeyRotationsResponse, error) {
+	out := new(QueryBlsKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, Query_BlsKeyRotations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorKeyHistory(ctx context.Context, in *QueryValidatorKeyHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorKeyHistoryResponse, error) {
+	out := new(QueryValidatorKeyHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorKeyHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorKeysAtHeight(ctx context.Context, in *QueryValidatorKeysAtHeightRequest, opts ...grpc.CallOption) (*QueryValidatorKeysAtHeightResponse, error) {
+	out := new(QueryValidatorKeysAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorKeysAtHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorByRelayerAtHeight(ctx context.Context, in *QueryValidatorByRelayerAtHeightRequest, opts ...grpc.CallOption) (*QueryValidatorByRelayerAtHeightResponse, error) {
+	out := new(QueryValidatorByRelayerAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorByRelayerAtHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorByChallengerAtHeight(ctx context.Context, in *QueryValidatorByChallengerAtHeightRequest, opts ...grpc.CallOption) (*QueryValidatorByChallengerAtHeightResponse, error) {
+	out := new(QueryValidatorByChallengerAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorByChallengerAtHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorByBlsKeyAtHeight(ctx context.Context, in *QueryValidatorByBlsKeyAtHeightRequest, opts ...grpc.CallOption) (*QueryValidatorByBlsKeyAtHeightResponse, error) {
+	out := new(QueryValidatorByBlsKeyAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorByBlsKeyAtHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorApplication(ctx context.Context, in *QueryValidatorApplicationRequest, opts ...grpc.CallOption) (*QueryValidatorApplicationResponse, error) {
+	out := new(QueryValidatorApplicationResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorApplication_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorApplications(ctx context.Context, in *QueryValidatorApplicationsRequest, opts ...grpc.CallOption) (*QueryValidatorApplicationsResponse, error) {
+	out := new(QueryValidatorApplicationsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorApplications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorRetirement(ctx context.Context, in *QueryValidatorRetirementRequest, opts ...grpc.CallOption) (*QueryValidatorRetirementResponse, error) {
+	out := new(QueryValidatorRetirementResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorRetirement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorRetirements(ctx context.Context, in *QueryValidatorRetirementsRequest, opts ...grpc.CallOption) (*QueryValidatorRetirementsResponse, error) {
+	out := new(QueryValidatorRetirementsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorRetirements_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error) {
+	out := new(QueryTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecords(ctx context.Context, in *QueryTokenizeShareRecordsRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsResponse, error) {
+	out := new(QueryTokenizeShareRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	out := new(QueryTokenizeShareRecordsOwnedResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordsOwned_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error) {
+	out := new(QueryTotalLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, Query_TotalLiquidStaked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -285,6 +467,38 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BlsKeyRotation queries the active bls key rotation of a validator.
+	BlsKeyRotation(context.Context, *QueryBlsKeyRotationRequest) (*QueryBlsKeyRotationResponse, error)
+	// BlsKeyRotations queries all the active bls key rotations.
+	BlsKeyRotations(context.Context, *QueryBlsKeyRotationsRequest) (*QueryBlsKeyRotationsResponse, error)
+	// ValidatorKeyHistory queries the history of the relayer address, the challenger address and the bls key
+	// of a validator.
+	ValidatorKeyHistory(context.Context, *QueryValidatorKeyHistoryRequest) (*QueryValidatorKeyHistoryResponse, error)
+	// ValidatorKeysAtHeight queries the relayer address, the challenger address and the bls key of a validator
+	// at a height.
+	ValidatorKeysAtHeight(context.Context, *QueryValidatorKeysAtHeightRequest) (*QueryValidatorKeysAtHeightResponse, error)
+	// ValidatorByRelayerAtHeight queries the validator whose relayer address was the given one at a height.
+	ValidatorByRelayerAtHeight(context.Context, *QueryValidatorByRelayerAtHeightRequest) (*QueryValidatorByRelayerAtHeightResponse, error)
+	// ValidatorByChallengerAtHeight queries the validator whose challenger address was the given one at a height.
+	ValidatorByChallengerAtHeight(context.Context, *QueryValidatorByChallengerAtHeightRequest) (*QueryValidatorByChallengerAtHeightResponse, error)
+	// ValidatorByBlsKeyAtHeight queries the validator whose bls key was the given one at a height.
+	ValidatorByBlsKeyAtHeight(context.Context, *QueryValidatorByBlsKeyAtHeightRequest) (*QueryValidatorByBlsKeyAtHeightResponse, error)
+	// ValidatorApplication queries the pending application of a validator created without governance.
+	ValidatorApplication(context.Context, *QueryValidatorApplicationRequest) (*QueryValidatorApplicationResponse, error)
+	// ValidatorApplications queries all the pending applications of the validators created without governance.
+	ValidatorApplications(context.Context, *QueryValidatorApplicationsRequest) (*QueryValidatorApplicationsResponse, error)
+	// ValidatorRetirement queries the retirement of a retiring or retired validator.
+	ValidatorRetirement(context.Context, *QueryValidatorRetirementRequest) (*QueryValidatorRetirementResponse, error)
+	// ValidatorRetirements queries the retirements of all the retiring and retired validators.
+	ValidatorRetirements(context.Context, *QueryValidatorRetirementsRequest) (*QueryValidatorRetirementsResponse, error)
+	// TokenizeShareRecord queries the tokenize share record by its id.
+	TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error)
+	// TokenizeShareRecords queries all the tokenize share records.
+	TokenizeShareRecords(context.Context, *QueryTokenizeShareRecordsRequest) (*QueryTokenizeShareRecordsResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by the address.
+	TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// TotalLiquidStaked queries the total tokens of the tokenized delegations.
+	TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -334,6 +548,51 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) BlsKeyRotation(context.Context, *QueryBlsKeyRotationRequest) (*QueryBlsKeyRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlsKeyRotation not implemented")
+}
+func (UnimplementedQueryServer) BlsKeyRotations(context.Context, *QueryBlsKeyRotationsRequest) (*QueryBlsKeyRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlsKeyRotations not implemented")
+}
+func (UnimplementedQueryServer) ValidatorKeyHistory(context.Context, *QueryValidatorKeyHistoryRequest) (*QueryValidatorKeyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorKeyHistory not implemented")
+}
+func (UnimplementedQueryServer) ValidatorKeysAtHeight(context.Context, *QueryValidatorKeysAtHeightRequest) (*QueryValidatorKeysAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorKeysAtHeight not implemented")
+}
+func (UnimplementedQueryServer) ValidatorByRelayerAtHeight(context.Context, *QueryValidatorByRelayerAtHeightRequest) (*QueryValidatorByRelayerAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorByRelayerAtHeight not implemented")
+}
+func (UnimplementedQueryServer) ValidatorByChallengerAtHeight(context.Context, *QueryValidatorByChallengerAtHeightRequest) (*QueryValidatorByChallengerAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorByChallengerAtHeight not implemented")
+}
+func (UnimplementedQueryServer) ValidatorByBlsKeyAtHeight(context.Context, *QueryValidatorByBlsKeyAtHeightRequest) (*QueryValidatorByBlsKeyAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorByBlsKeyAtHeight not implemented")
+}
+func (UnimplementedQueryServer) ValidatorApplication(context.Context, *QueryValidatorApplicationRequest) (*QueryValidatorApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorApplication not implemented")
+}
+func (UnimplementedQueryServer) ValidatorApplications(context.Context, *QueryValidatorApplicationsRequest) (*QueryValidatorApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorApplications not implemented")
+}
+func (UnimplementedQueryServer) ValidatorRetirement(context.Context, *QueryValidatorRetirementRequest) (*QueryValidatorRetirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRetirement not implemented")
+}
+func (UnimplementedQueryServer) ValidatorRetirements(context.Context, *QueryValidatorRetirementsRequest) (*QueryValidatorRetirementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRetirements not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecord not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecords(context.Context, *QueryTokenizeShareRecordsRequest) (*QueryTokenizeShareRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecords not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordsOwned not implemented")
+}
+func (UnimplementedQueryServer) TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidStaked not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -599,6 +858,276 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlsKeyRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlsKeyRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlsKeyRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlsKeyRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlsKeyRotation(ctx, req.(*QueryBlsKeyRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlsKeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlsKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlsKeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlsKeyRotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlsKeyRotations(ctx, req.(*QueryBlsKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorKeyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorKeyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorKeyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorKeyHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorKeyHistory(ctx, req.(*QueryValidatorKeyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorKeysAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorKeysAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorKeysAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorKeysAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorKeysAtHeight(ctx, req.(*QueryValidatorKeysAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorByRelayerAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorByRelayerAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorByRelayerAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorByRelayerAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorByRelayerAtHeight(ctx, req.(*QueryValidatorByRelayerAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorByChallengerAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorByChallengerAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorByChallengerAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorByChallengerAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorByChallengerAtHeight(ctx, req.(*QueryValidatorByChallengerAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorByBlsKeyAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorByBlsKeyAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorByBlsKeyAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorByBlsKeyAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorByBlsKeyAtHeight(ctx, req.(*QueryValidatorByBlsKeyAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorApplication(ctx, req.(*QueryValidatorApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorApplications(ctx, req.(*QueryValidatorApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRetirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRetirementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRetirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorRetirement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRetirement(ctx, req.(*QueryValidatorRetirementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRetirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRetirementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRetirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorRetirements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRetirements(ctx, req.(*QueryValidatorRetirementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, req.(*QueryTokenizeShareRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecords(ctx, req.(*QueryTokenizeShareRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordsOwned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordsOwnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordsOwned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, req.(*QueryTokenizeShareRecordsOwnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalLiquidStaked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, req.(*QueryTotalLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -662,6 +1191,66 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "BlsKeyRotation",
+			Handler:    _Query_BlsKeyRotation_Handler,
+		},
+		{
+			MethodName: "BlsKeyRotations",
+			Handler:    _Query_BlsKeyRotations_Handler,
+		},
+		{
+			MethodName: "ValidatorKeyHistory",
+			Handler:    _Query_ValidatorKeyHistory_Handler,
+		},
+		{
+			MethodName: "ValidatorKeysAtHeight",
+			Handler:    _Query_ValidatorKeysAtHeight_Handler,
+		},
+		{
+			MethodName: "ValidatorByRelayerAtHeight",
+			Handler:    _Query_ValidatorByRelayerAtHeight_Handler,
+		},
+		{
+			MethodName: "ValidatorByChallengerAtHeight",
+			Handler:    _Query_ValidatorByChallengerAtHeight_Handler,
+		},
+		{
+			MethodName: "ValidatorByBlsKeyAtHeight",
+			Handler:    _Query_ValidatorByBlsKeyAtHeight_Handler,
+		},
+		{
+			MethodName: "ValidatorApplication",
+			Handler:    _Query_ValidatorApplication_Handler,
+		},
+		{
+			MethodName: "ValidatorApplications",
+			Handler:    _Query_ValidatorApplications_Handler,
+		},
+		{
+			MethodName: "ValidatorRetirement",
+			Handler:    _Query_ValidatorRetirement_Handler,
+		},
+		{
+			MethodName: "ValidatorRetirements",
+			Handler:    _Query_ValidatorRetirements_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecord",
+			Handler:    _Query_TokenizeShareRecord_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecords",
+			Handler:    _Query_TokenizeShareRecords_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordsOwned",
+			Handler:    _Query_TokenizeShareRecordsOwned_Handler,
+		},
+		{
+			MethodName: "TotalLiquidStaked",
+			Handler:    _Query_TotalLiquidStaked_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -13,10 +13,63 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Config_4_list)(nil)
+
+type _Config_4_list struct {
+	list *[]*Eip712Domain
+}
+
+func (x *_Config_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Config_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Config_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Eip712Domain)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Config_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Eip712Domain)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Config_4_list) AppendMutable() protoreflect.Value {
+	v := new(Eip712Domain)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Config_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Config_4_list) NewElement() protoreflect.Value {
+	v := new(Eip712Domain)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Config_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Config                   protoreflect.MessageDescriptor
-	fd_Config_skip_ante_handler protoreflect.FieldDescriptor
-	fd_Config_skip_post_handler protoreflect.FieldDescriptor
+	md_Config                       protoreflect.MessageDescriptor
+	fd_Config_skip_ante_handler     protoreflect.FieldDescriptor
+	fd_Config_skip_post_handler     protoreflect.FieldDescriptor
+	fd_Config_eip712_domain         protoreflect.FieldDescriptor
+	fd_Config_legacy_eip712_domains protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -24,6 +77,8 @@ func init() {
 	md_Config = File_cosmos_tx_config_v1_config_proto.Messages().ByName("Config")
 	fd_Config_skip_ante_handler = md_Config.Fields().ByName("skip_ante_handler")
 	fd_Config_skip_post_handler = md_Config.Fields().ByName("skip_post_handler")
+	fd_Config_eip712_domain = md_Config.Fields().ByName("eip712_domain")
+	fd_Config_legacy_eip712_domains = md_Config.Fields().ByName("legacy_eip712_domains")
 }
 
 var _ protoreflect.Message = (*fastReflection_Config)(nil)
@@ -103,6 +158,18 @@ func (x *fastReflection_Config) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.Eip712Domain != nil {
+		value := protoreflect.ValueOfMessage(x.Eip712Domain.ProtoReflect())
+		if !f(fd_Config_eip712_domain, value) {
+			return
+		}
+	}
+	if len(x.LegacyEip712Domains) != 0 {
+		value := protoreflect.ValueOfList(&_Config_4_list{list: &x.LegacyEip712Domains})
+		if !f(fd_Config_legacy_eip712_domains, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -122,6 +189,10 @@ func (x *fastReflection_Config) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.SkipAnteHandler != false
 	case "cosmos.tx.config.v1.Config.skip_post_handler":
 		return x.SkipPostHandler != false
+	case "cosmos.tx.config.v1.Config.eip712_domain":
+		return x.Eip712Domain != nil
+	case "cosmos.tx.config.v1.Config.legacy_eip712_domains":
+		return len(x.LegacyEip712Domains) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Config"))
@@ -142,6 +213,10 @@ func (x *fastReflection_Config) Clear(fd protoreflect.FieldDescriptor) {
 		x.SkipAnteHandler = false
 	case "cosmos.tx.config.v1.Config.skip_post_handler":
 		x.SkipPostHandler = false
+	case "cosmos.tx.config.v1.Config.eip712_domain":
+		x.Eip712Domain = nil
+	case "cosmos.tx.config.v1.Config.legacy_eip712_domains":
+		x.LegacyEip712Domains = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Config"))
@@ -164,6 +239,15 @@ func (x *fastReflection_Config) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.tx.config.v1.Config.skip_post_handler":
 		value := x.SkipPostHandler
 		return protoreflect.ValueOfBool(value)
+	case "cosmos.tx.config.v1.Config.eip712_domain":
+		value := x.Eip712Domain
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.config.v1.Config.legacy_eip712_domains":
+		if len(x.LegacyEip712Domains) == 0 {
+			return protoreflect.ValueOfList(&_Config_4_list{})
+		}
+		listValue := &_Config_4_list{list: &x.LegacyEip712Domains}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Config"))
@@ -188,6 +272,12 @@ func (x *fastReflection_Config) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SkipAnteHandler = value.Bool()
 	case "cosmos.tx.config.v1.Config.skip_post_handler":
 		x.SkipPostHandler = value.Bool()
+	case "cosmos.tx.config.v1.Config.eip712_domain":
+		x.Eip712Domain = value.Message().Interface().(*Eip712Domain)
+	case "cosmos.tx.config.v1.Config.legacy_eip712_domains":
+		lv := value.List()
+		clv := lv.(*_Config_4_list)
+		x.LegacyEip712Domains = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Config"))
@@ -208,6 +298,17 @@ func (x *fastReflection_Config) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Config) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.tx.config.v1.Config.eip712_domain":
+		if x.Eip712Domain == nil {
+			x.Eip712Domain = new(Eip712Domain)
+		}
+		return protoreflect.ValueOfMessage(x.Eip712Domain.ProtoReflect())
+	case "cosmos.tx.config.v1.Config.legacy_eip712_domains":
+		if x.LegacyEip712Domains == nil {
+			x.LegacyEip712Domains = []*Eip712Domain{}
+		}
+		value := &_Config_4_list{list: &x.LegacyEip712Domains}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.tx.config.v1.Config.skip_ante_handler":
 		panic(fmt.Errorf("field skip_ante_handler of message cosmos.tx.config.v1.Config is not mutable"))
 	case "cosmos.tx.config.v1.Config.skip_post_handler":
@@ -229,6 +330,12 @@ func (x *fastReflection_Config) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "cosmos.tx.config.v1.Config.skip_post_handler":
 		return protoreflect.ValueOfBool(false)
+	case "cosmos.tx.config.v1.Config.eip712_domain":
+		m := new(Eip712Domain)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.config.v1.Config.legacy_eip712_domains":
+		list := []*Eip712Domain{}
+		return protoreflect.ValueOfList(&_Config_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Config"))
@@ -304,6 +411,16 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 		if x.SkipPostHandler {
 			n += 2
 		}
+		if x.Eip712Domain != nil {
+			l = options.Size(x.Eip712Domain)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.LegacyEip712Domains) > 0 {
+			for _, e := range x.LegacyEip712Domains {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -333,6 +450,36 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.LegacyEip712Domains) > 0 {
+			for iNdEx := len(x.LegacyEip712Domains) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LegacyEip712Domains[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Eip712Domain != nil {
+			encoded, err := options.Marshal(x.Eip712Domain)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.SkipPostHandler {
 			i--
 			if x.SkipPostHandler {
@@ -442,6 +589,76 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.SkipPostHandler = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip712Domain", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Eip712Domain == nil {
+					x.Eip712Domain = &Eip712Domain{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Eip712Domain); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LegacyEip712Domains", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LegacyEip712Domains = append(x.LegacyEip712Domains, &Eip712Domain{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LegacyEip712Domains[len(x.LegacyEip712Domains)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -477,132 +694,862 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/tx/config/v1/config.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_Eip712Domain                    protoreflect.MessageDescriptor
+	fd_Eip712Domain_name               protoreflect.FieldDescriptor
+	fd_Eip712Domain_version            protoreflect.FieldDescriptor
+	fd_Eip712Domain_verifying_contract protoreflect.FieldDescriptor
+	fd_Eip712Domain_salt               protoreflect.FieldDescriptor
 )
 
-// Config is the config object of the x/auth/tx package.
-type Config struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_cosmos_tx_config_v1_config_proto_init()
+	md_Eip712Domain = File_cosmos_tx_config_v1_config_proto.Messages().ByName("Eip712Domain")
+	fd_Eip712Domain_name = md_Eip712Domain.Fields().ByName("name")
+	fd_Eip712Domain_version = md_Eip712Domain.Fields().ByName("version")
+	fd_Eip712Domain_verifying_contract = md_Eip712Domain.Fields().ByName("verifying_contract")
+	fd_Eip712Domain_salt = md_Eip712Domain.Fields().ByName("salt")
+}
 
-	// skip_ante_handler defines whether the ante handler registration should be skipped in case an app wants to override
-	// this functionality.
-	SkipAnteHandler bool `protobuf:"varint,1,opt,name=skip_ante_handler,json=skipAnteHandler,proto3" json:"skip_ante_handler,omitempty"`
-	// skip_post_handler defines whether the post handler registration should be skipped in case an app wants to override
-	// this functionality.
-	SkipPostHandler bool `protobuf:"varint,2,opt,name=skip_post_handler,json=skipPostHandler,proto3" json:"skip_post_handler,omitempty"`
+var _ protoreflect.Message = (*fastReflection_Eip712Domain)(nil)
+
+type fastReflection_Eip712Domain Eip712Domain
+
+func (x *Eip712Domain) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Eip712Domain)(x)
 }
 
-func (x *Config) Reset() {
-	*x = Config{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_config_v1_config_proto_msgTypes[0]
+func (x *Eip712Domain) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_config_v1_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Config) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_Eip712Domain_messageType fastReflection_Eip712Domain_messageType
+var _ protoreflect.MessageType = fastReflection_Eip712Domain_messageType{}
 
-func (*Config) ProtoMessage() {}
+type fastReflection_Eip712Domain_messageType struct{}
 
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_config_v1_config_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_Eip712Domain_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Eip712Domain)(nil)
 }
-
-func (x *Config) GetSkipAnteHandler() bool {
-	if x != nil {
-		return x.SkipAnteHandler
-	}
-	return false
+func (x fastReflection_Eip712Domain_messageType) New() protoreflect.Message {
+	return new(fastReflection_Eip712Domain)
+}
+func (x fastReflection_Eip712Domain_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Eip712Domain
 }
 
-func (x *Config) GetSkipPostHandler() bool {
-	if x != nil {
-		return x.SkipPostHandler
-	}
-	return false
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Eip712Domain) Descriptor() protoreflect.MessageDescriptor {
+	return md_Eip712Domain
 }
 
-var File_cosmos_tx_config_v1_config_proto protoreflect.FileDescriptor
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Eip712Domain) Type() protoreflect.MessageType {
+	return _fastReflection_Eip712Domain_messageType
+}
 
-var file_cosmos_tx_config_v1_config_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x13, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x06, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x61, 0x6e, 0x74,
-	0x65, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0f, 0x73, 0x6b, 0x69, 0x70, 0x41, 0x6e, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
-	0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x68, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x6b, 0x69,
-	0x70, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x3a, 0x2e, 0xba, 0xc0,
-	0x96, 0xda, 0x01, 0x28, 0x0a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x74, 0x78, 0x42, 0xc4, 0x01, 0x0a,
-	0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x74, 0x78, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x54, 0x43, 0xaa, 0x02, 0x13, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x54, 0x78, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x54, 0x78, 0x5c, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x54, 0x78, 0x5c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x54, 0x78, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Eip712Domain) New() protoreflect.Message {
+	return new(fastReflection_Eip712Domain)
 }
 
-var (
-	file_cosmos_tx_config_v1_config_proto_rawDescOnce sync.Once
-	file_cosmos_tx_config_v1_config_proto_rawDescData = file_cosmos_tx_config_v1_config_proto_rawDesc
-)
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Eip712Domain) Interface() protoreflect.ProtoMessage {
+	return (*Eip712Domain)(x)
+}
 
-func file_cosmos_tx_config_v1_config_proto_rawDescGZIP() []byte {
-	file_cosmos_tx_config_v1_config_proto_rawDescOnce.Do(func() {
-		file_cosmos_tx_config_v1_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_cosmos_tx_config_v1_config_proto_rawDescData)
-	})
-	return file_cosmos_tx_config_v1_config_proto_rawDescData
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Eip712Domain) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_Eip712Domain_name, value) {
+			return
+		}
+	}
+	if x.Version != "" {
+		value := protoreflect.ValueOfString(x.Version)
+		if !f(fd_Eip712Domain_version, value) {
+			return
+		}
+	}
+	if x.VerifyingContract != "" {
+		value := protoreflect.ValueOfString(x.VerifyingContract)
+		if !f(fd_Eip712Domain_verifying_contract, value) {
+			return
+		}
+	}
+	if x.Salt != "" {
+		value := protoreflect.ValueOfString(x.Salt)
+		if !f(fd_Eip712Domain_salt, value) {
+			return
+		}
+	}
 }
 
-var file_cosmos_tx_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_cosmos_tx_config_v1_config_proto_goTypes = []interface{}{
-	(*Config)(nil), // 0: cosmos.tx.config.v1.Config
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Eip712Domain) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.config.v1.Eip712Domain.name":
+		return x.Name != ""
+	case "cosmos.tx.config.v1.Eip712Domain.version":
+		return x.Version != ""
+	case "cosmos.tx.config.v1.Eip712Domain.verifying_contract":
+		return x.VerifyingContract != ""
+	case "cosmos.tx.config.v1.Eip712Domain.salt":
+		return x.Salt != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Eip712Domain"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.config.v1.Eip712Domain does not contain field %s", fd.FullName()))
+	}
 }
-var file_cosmos_tx_config_v1_config_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Eip712Domain) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.config.v1.Eip712Domain.name":
+		x.Name = ""
+	case "cosmos.tx.config.v1.Eip712Domain.version":
+		x.Version = ""
+	case "cosmos.tx.config.v1.Eip712Domain.verifying_contract":
+		x.VerifyingContract = ""
+	case "cosmos.tx.config.v1.Eip712Domain.salt":
+		x.Salt = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Eip712Domain"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.config.v1.Eip712Domain does not contain field %s", fd.FullName()))
+	}
 }
 
-func init() { file_cosmos_tx_config_v1_config_proto_init() }
-func file_cosmos_tx_config_v1_config_proto_init() {
-	if File_cosmos_tx_config_v1_config_proto != nil {
-		return
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Eip712Domain) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.config.v1.Eip712Domain.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.config.v1.Eip712Domain.version":
+		value := x.Version
+		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.config.v1.Eip712Domain.verifying_contract":
+		value := x.VerifyingContract
+		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.config.v1.Eip712Domain.salt":
+		value := x.Salt
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Eip712Domain"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.config.v1.Eip712Domain does not contain field %s", descriptor.FullName()))
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_cosmos_tx_config_v1_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config); i {
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Eip712Domain) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.config.v1.Eip712Domain.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.tx.config.v1.Eip712Domain.version":
+		x.Version = value.Interface().(string)
+	case "cosmos.tx.config.v1.Eip712Domain.verifying_contract":
+		x.VerifyingContract = value.Interface().(string)
+	case "cosmos.tx.config.v1.Eip712Domain.salt":
+		x.Salt = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Eip712Domain"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.config.v1.Eip712Domain does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Eip712Domain) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.config.v1.Eip712Domain.name":
+		panic(fmt.Errorf("field name of message cosmos.tx.config.v1.Eip712Domain is not mutable"))
+	case "cosmos.tx.config.v1.Eip712Domain.version":
+		panic(fmt.Errorf("field version of message cosmos.tx.config.v1.Eip712Domain is not mutable"))
+	case "cosmos.tx.config.v1.Eip712Domain.verifying_contract":
+		panic(fmt.Errorf("field verifying_contract of message cosmos.tx.config.v1.Eip712Domain is not mutable"))
+	case "cosmos.tx.config.v1.Eip712Domain.salt":
+		panic(fmt.Errorf("field salt of message cosmos.tx.config.v1.Eip712Domain is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Eip712Domain"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.config.v1.Eip712Domain does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Eip712Domain) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.config.v1.Eip712Domain.name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.tx.config.v1.Eip712Domain.version":
+		return protoreflect.ValueOfString("")
+	case "cosmos.tx.config.v1.Eip712Domain.verifying_contract":
+		return protoreflect.ValueOfString("")
+	case "cosmos.tx.config.v1.Eip712Domain.salt":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.config.v1.Eip712Domain"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.config.v1.Eip712Domain does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Eip712Domain) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.config.v1.Eip712Domain", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Eip712Domain) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Eip712Domain) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Eip712Domain) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Eip712Domain) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Eip712Domain)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Version)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VerifyingContract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Salt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Eip712Domain)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Salt) > 0 {
+			i -= len(x.Salt)
+			copy(dAtA[i:], x.Salt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.VerifyingContract) > 0 {
+			i -= len(x.VerifyingContract)
+			copy(dAtA[i:], x.VerifyingContract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerifyingContract)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Version) > 0 {
+			i -= len(x.Version)
+			copy(dAtA[i:], x.Version)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Version)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Eip712Domain)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Eip712Domain: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Eip712Domain: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Version = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifyingContract", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerifyingContract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Salt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/tx/config/v1/config.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Config is the config object of the x/auth/tx package.
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// skip_ante_handler defines whether the ante handler registration should be skipped in case an app wants to override
+	// this functionality.
+	SkipAnteHandler bool `protobuf:"varint,1,opt,name=skip_ante_handler,json=skipAnteHandler,proto3" json:"skip_ante_handler,omitempty"`
+	// skip_post_handler defines whether the post handler registration should be skipped in case an app wants to override
+	// this functionality.
+	SkipPostHandler bool `protobuf:"varint,2,opt,name=skip_post_handler,json=skipPostHandler,proto3" json:"skip_post_handler,omitempty"`
+	// eip712_domain overrides the default EIP-712 domain used by SIGN_MODE_EIP_712.
+	Eip712Domain *Eip712Domain `protobuf:"bytes,3,opt,name=eip712_domain,json=eip712Domain,proto3" json:"eip712_domain,omitempty"`
+	// legacy_eip712_domains are the previous EIP-712 domains which are still accepted when verifying SIGN_MODE_EIP_712
+	// signatures before the Nagqu upgrade. It allows rolling out a new domain through the upgrade without invalidating
+	// in-flight txs.
+	LegacyEip712Domains []*Eip712Domain `protobuf:"bytes,4,rep,name=legacy_eip712_domains,json=legacyEip712Domains,proto3" json:"legacy_eip712_domains,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_config_v1_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_config_v1_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetSkipAnteHandler() bool {
+	if x != nil {
+		return x.SkipAnteHandler
+	}
+	return false
+}
+
+func (x *Config) GetSkipPostHandler() bool {
+	if x != nil {
+		return x.SkipPostHandler
+	}
+	return false
+}
+
+func (x *Config) GetEip712Domain() *Eip712Domain {
+	if x != nil {
+		return x.Eip712Domain
+	}
+	return nil
+}
+
+func (x *Config) GetLegacyEip712Domains() []*Eip712Domain {
+	if x != nil {
+		return x.LegacyEip712Domains
+	}
+	return nil
+}
+
+// Eip712Domain defines the chain specific fields of the EIP-712 domain separator, the chain id is always derived
+// from the chain.
+type Eip712Domain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the signing domain.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the current version of the signing domain.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// verifying_contract is the verifying contract of the signing domain, it is omitted if empty.
+	VerifyingContract string `protobuf:"bytes,3,opt,name=verifying_contract,json=verifyingContract,proto3" json:"verifying_contract,omitempty"`
+	// salt is the salt of the signing domain, it is omitted if empty.
+	Salt string `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (x *Eip712Domain) Reset() {
+	*x = Eip712Domain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_config_v1_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Eip712Domain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Eip712Domain) ProtoMessage() {}
+
+// Deprecated: Use Eip712Domain.ProtoReflect.Descriptor instead.
+func (*Eip712Domain) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_config_v1_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Eip712Domain) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Eip712Domain) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Eip712Domain) GetVerifyingContract() string {
+	if x != nil {
+		return x.VerifyingContract
+	}
+	return ""
+}
+
+func (x *Eip712Domain) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+var File_cosmos_tx_config_v1_config_proto protoreflect.FileDescriptor
+
+var file_cosmos_tx_config_v1_config_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x13, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x02, 0x0a, 0x06, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x61, 0x6e, 0x74,
+	0x65, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x73, 0x6b, 0x69, 0x70, 0x41, 0x6e, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x68, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x6b, 0x69,
+	0x70, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0d,
+	0x65, 0x69, 0x70, 0x37, 0x31, 0x32, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x69, 0x70, 0x37, 0x31, 0x32,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x0c, 0x65, 0x69, 0x70, 0x37, 0x31, 0x32, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x55, 0x0a, 0x15, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x65,
+	0x69, 0x70, 0x37, 0x31, 0x32, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x69, 0x70, 0x37, 0x31, 0x32,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x13, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x45, 0x69,
+	0x70, 0x37, 0x31, 0x32, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x3a, 0x2e, 0xba, 0xc0, 0x96,
+	0xda, 0x01, 0x28, 0x0a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x74, 0x78, 0x22, 0x7f, 0x0a, 0x0c, 0x45,
+	0x69, 0x70, 0x37, 0x31, 0x32, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x42, 0xc4, 0x01, 0x0a,
+	0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x74, 0x78, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x54, 0x43, 0xaa, 0x02, 0x13, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x54, 0x78, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x54, 0x78, 0x5c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x5c, 0x54, 0x78, 0x5c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x54, 0x78, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cosmos_tx_config_v1_config_proto_rawDescOnce sync.Once
+	file_cosmos_tx_config_v1_config_proto_rawDescData = file_cosmos_tx_config_v1_config_proto_rawDesc
+)
+
+func file_cosmos_tx_config_v1_config_proto_rawDescGZIP() []byte {
+	file_cosmos_tx_config_v1_config_proto_rawDescOnce.Do(func() {
+		file_cosmos_tx_config_v1_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_cosmos_tx_config_v1_config_proto_rawDescData)
+	})
+	return file_cosmos_tx_config_v1_config_proto_rawDescData
+}
+
+var file_cosmos_tx_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cosmos_tx_config_v1_config_proto_goTypes = []interface{}{
+	(*Config)(nil),       // 0: cosmos.tx.config.v1.Config
+	(*Eip712Domain)(nil), // 1: cosmos.tx.config.v1.Eip712Domain
+}
+var file_cosmos_tx_config_v1_config_proto_depIdxs = []int32{
+	1, // 0: cosmos.tx.config.v1.Config.eip712_domain:type_name -> cosmos.tx.config.v1.Eip712Domain
+	1, // 1: cosmos.tx.config.v1.Config.legacy_eip712_domains:type_name -> cosmos.tx.config.v1.Eip712Domain
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cosmos_tx_config_v1_config_proto_init() }
+func file_cosmos_tx_config_v1_config_proto_init() {
+	if File_cosmos_tx_config_v1_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cosmos_tx_config_v1_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_tx_config_v1_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Eip712Domain); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -620,7 +1567,7 @@ func file_cosmos_tx_config_v1_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_tx_config_v1_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
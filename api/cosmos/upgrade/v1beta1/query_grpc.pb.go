@@ -23,6 +23,7 @@ const (
 	Query_AppliedPlan_FullMethodName            = "/cosmos.upgrade.v1beta1.Query/AppliedPlan"
 	Query_UpgradedConsensusState_FullMethodName = "/cosmos.upgrade.v1beta1.Query/UpgradedConsensusState"
 	Query_ModuleVersions_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
+	Query_UpgradeSchedule_FullMethodName        = "/cosmos.upgrade.v1beta1.Query/UpgradeSchedule"
 )
 
 // QueryClient is the client API for Query service.
@@ -45,6 +46,9 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// UpgradeSchedule queries the effective upgrade schedule of the node and
+	// checks it against the canonical schedule published by governance.
+	UpgradeSchedule(ctx context.Context, in *QueryUpgradeScheduleRequest, opts ...grpc.CallOption) (*QueryUpgradeScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -92,6 +96,15 @@ func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersion
 	return out, nil
 }
 
+func (c *queryClient) UpgradeSchedule(ctx context.Context, in *QueryUpgradeScheduleRequest, opts ...grpc.CallOption) (*QueryUpgradeScheduleResponse, error) {
+	out := new(QueryUpgradeScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_UpgradeSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -112,6 +125,9 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// UpgradeSchedule queries the effective upgrade schedule of the node and
+	// checks it against the canonical schedule published by governance.
+	UpgradeSchedule(context.Context, *QueryUpgradeScheduleRequest) (*QueryUpgradeScheduleResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -131,6 +147,9 @@ func (UnimplementedQueryServer) UpgradedConsensusState(context.Context, *QueryUp
 func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
 }
+func (UnimplementedQueryServer) UpgradeSchedule(context.Context, *QueryUpgradeScheduleRequest) (*QueryUpgradeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeSchedule not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +235,24 @@ func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpgradeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UpgradeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeSchedule(ctx, req.(*QueryUpgradeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -239,6 +276,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleVersions",
 			Handler:    _Query_ModuleVersions_Handler,
 		},
+		{
+			MethodName: "UpgradeSchedule",
+			Handler:    _Query_UpgradeSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
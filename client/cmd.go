@@ -284,7 +284,13 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		// If the `from` signer account is a ledger key, we need to use
 		// SIGN_MODE_AMINO_JSON, because ledger doesn't support proto yet.
 		// ref: https://github.com/cosmos/cosmos-sdk/issues/8109
-		if keyType == keyring.TypeLedger && clientCtx.SignModeStr != flags.SignModeLegacyAminoJSON && !clientCtx.LedgerHasProtobuf {
+		// The eth_secp256k1 keys of the Ledger Ethereum app only sign EIP-712 typed data.
+		if keyType == keyring.TypeLedger && isEthLedgerKey(clientCtx.Keyring, fromName) {
+			if clientCtx.SignModeStr != flags.SignModeEIP712 {
+				fmt.Println("Default sign-mode 'direct' not supported by Ledger Ethereum app, using sign-mode 'eip-712'.")
+				clientCtx = clientCtx.WithSignModeStr(flags.SignModeEIP712)
+			}
+		} else if keyType == keyring.TypeLedger && clientCtx.SignModeStr != flags.SignModeLegacyAminoJSON && !clientCtx.LedgerHasProtobuf {
 			fmt.Println("Default sign-mode 'direct' not supported by Ledger, using sign-mode 'amino-json'.")
 			clientCtx = clientCtx.WithSignModeStr(flags.SignModeLegacyAminoJSON)
 		}
@@ -311,6 +317,18 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 	return clientCtx, nil
 }
 
+// isEthLedgerKey returns true if the named key is kept by the Ethereum app of a ledger device.
+func isEthLedgerKey(kr keyring.Keyring, name string) bool {
+	if kr == nil || name == "" {
+		return false
+	}
+	k, err := kr.Key(name)
+	if err != nil {
+		return false
+	}
+	return keyring.IsEthLedgerKey(k)
+}
+
 // GetClientQueryContext returns a Context from a command with fields set based on flags
 // defined in AddQueryFlagsToCmd. An error is returned if any flag query fails.
 //
@@ -32,6 +32,7 @@ const (
 	flagMultisig    = "multisig"
 	flagNoSort      = "nosort"
 	flagHDPath      = "hd-path"
+	flagEIP2334     = "eip2334"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -75,6 +76,7 @@ Example:
 	f.Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
 	f.Bool(flags.FlagDryRun, false, "Perform action, but don't add key to local keystore")
 	f.String(flagHDPath, "", "Manual HD Path derivation (overrides BIP44 config)")
+	f.Bool(flagEIP2334, false, "Derive the eth_bls key on the EIP-2334 signing path m/12381/3600/{account}/0/0 instead of the BIP44 path")
 	f.Uint32(flagCoinType, sdk.GetConfig().GetCoinType(), "coin type number for HD derivation")
 	f.Uint32(flagAccount, 0, "Account number for HD derivation (less than equal 2147483647)")
 	f.Uint32(flagIndex, 0, "Address index number for HD derivation (less than equal 2147483647)")
@@ -208,9 +210,19 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	index, _ := cmd.Flags().GetUint32(flagIndex)
 	hdPath, _ := cmd.Flags().GetString(flagHDPath)
 	useLedger, _ := cmd.Flags().GetBool(flags.FlagUseLedger)
+	useEIP2334, _ := cmd.Flags().GetBool(flagEIP2334)
+
+	if useEIP2334 && (algo.Name() != hd.BLSType || useLedger || len(hdPath) != 0) {
+		return fmt.Errorf("--%s is only supported by the %s keys without a custom path or ledger", flagEIP2334, hd.BLSType)
+	}
 
 	if len(hdPath) == 0 {
-		hdPath = hd.CreateHDPath(coinType, account, index).String()
+		if useEIP2334 {
+			// the BLS keys are derived on the EIP-2334 signing path, as the Ethereum staking tooling does
+			hdPath = hd.CreateBLSSigningPath(account)
+		} else {
+			hdPath = hd.CreateHDPath(coinType, account, index).String()
+		}
 	} else if useLedger {
 		return errors.New("cannot set custom bip32 path with ledger")
 	}
@@ -218,7 +230,7 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	// If we're using ledger, only thing we need is the path and the bech32 prefix.
 	if useLedger {
 		bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
-		k, err := kb.SaveLedgerKey(name, hd.EthSecp256k1, bech32PrefixAccAddr, coinType, account, index)
+		k, err := kb.SaveLedgerKey(name, algo, bech32PrefixAccAddr, coinType, account, index)
 		if err != nil {
 			return err
 		}
@@ -276,3 +276,60 @@ func TestAddRecoverFileBackend(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "keyname1", k.Name)
 }
+
+func TestAddRecoverBLSKey(t *testing.T) {
+	cdc := clienttestutil.MakeTestCodec(t)
+	kbHome := t.TempDir()
+
+	testCases := []struct {
+		name   string
+		args   []string
+		hdPath string
+		expErr bool
+	}{
+		{"bip44 path by default", nil, hd.CreateHDPath(sdk.GetConfig().GetCoinType(), 1, 0).String(), false},
+		{"eip2334 signing path", []string{fmt.Sprintf("--%s", flagEIP2334)}, "m/12381/3600/1/0/0", false},
+		{"eip2334 signing path with custom path", []string{fmt.Sprintf("--%s", flagEIP2334), fmt.Sprintf("--%s=m/0", flagHDPath)}, "", true},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := AddKeyCommand()
+			cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+			mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+			kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+			require.NoError(t, err)
+
+			clientCtx := client.Context{}.WithKeyringDir(kbHome).WithInput(mockIn).WithCodec(cdc)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			name := fmt.Sprintf("blskey%d", i)
+			cmd.SetArgs(append([]string{
+				name,
+				fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+				fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatText),
+				fmt.Sprintf("--%s=%s", flags.FlagKeyType, hd.BLSType),
+				fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+				fmt.Sprintf("--%s=1", flagAccount),
+				fmt.Sprintf("--%s", flagRecover),
+			}, tc.args...))
+			mockIn.Reset(testdata.TestMnemonic + "\n")
+			err = cmd.ExecuteContext(ctx)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			bz, err := hd.EthBLS.Derive()(testdata.TestMnemonic, "", tc.hdPath)
+			require.NoError(t, err)
+
+			k, err := kb.Key(name)
+			require.NoError(t, err)
+			pubKey, err := k.GetPubKey()
+			require.NoError(t, err)
+			require.Equal(t, hd.EthBLS.Generate()(bz).PubKey(), pubKey)
+		})
+	}
+}
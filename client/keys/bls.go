@@ -0,0 +1,253 @@
+package keys
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FlagBlsPubKeys is the flag for the BLS public keys, or the names of the BLS keys, of the voters.
+	FlagBlsPubKeys = "pubkeys"
+	// FlagVoteAddressSet is the flag for the bitset of the voted public keys.
+	FlagVoteAddressSet = "vote-address-set"
+)
+
+// BlsCommands returns the Cobra Command for signing, aggregating and verifying BLS signatures,
+// which can be used to test the cross chain claims offline.
+func BlsCommands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bls",
+		Short: "Sign, aggregate and verify BLS signatures",
+		Long: `Sign messages with the BLS keys of the keyring, aggregate the BLS signatures and verify the
+aggregated signatures the same way the cross chain claims are verified.
+
+BLS keys can also be split into threshold key shares, whose partial signatures are combined into a
+signature of the key, so that no single machine of a validator cluster holds the key.
+
+Messages are hex encoded, the 32 bytes sign bytes of a claim are signed as they are.`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		BlsSignCmd(),
+		BlsAggregateCmd(),
+		BlsVerifyCmd(),
+		BlsSplitCmd(),
+		BlsMergeSharesCmd(),
+		BlsCombineCmd(),
+	)
+
+	return cmd
+}
+
+// BlsSignCmd returns the Cobra Command for signing messages with a BLS key.
+func BlsSignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [message]",
+		Short: "Sign message with a BLS key",
+		Long: `Return the BLS signature of the hex encoded message from the given BLS key.
+!!!NOTE!!!
+This command is allowed to sign any message from your private key.
+Please *DO NOT* use this command unless you know what you are doing.
+
+Example:
+	$ gnfd keys bls sign 0x4a5d... --from bls
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runBlsSignCmd,
+	}
+
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of BLS private key with which to sign")
+	return cmd
+}
+
+func runBlsSignCmd(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	_, name, _, err := client.GetFromFields(clientCtx, clientCtx.Keyring, clientCtx.From)
+	if err != nil {
+		return err
+	}
+	record, err := clientCtx.Keyring.Key(name)
+	if err != nil {
+		return err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if pubKey.Type() != bls.KeyType {
+		return fmt.Errorf("key %s is not a BLS key: %s", name, pubKey.Type())
+	}
+
+	msg, err := decodeHex(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid message: %w", err)
+	}
+	sig, _, err := clientCtx.Keyring.Sign(name, msg)
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(hex.EncodeToString(sig))
+	return nil
+}
+
+// BlsAggregateCmd returns the Cobra Command for aggregating BLS signatures.
+func BlsAggregateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "aggregate [signature] [signature...]",
+		Short: "Aggregate BLS signatures into one signature",
+		Long: `Aggregate the hex encoded BLS signatures into one signature, the signatures should be signed
+over the same message to be verified with FastAggregateVerify.
+
+Example:
+	$ gnfd keys bls aggregate $SIG1 $SIG2 $SIG3
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sigs := make([][]byte, 0, len(args))
+			for _, arg := range args {
+				sig, err := decodeHex(arg)
+				if err != nil {
+					return fmt.Errorf("invalid signature %s: %w", arg, err)
+				}
+				sigs = append(sigs, sig)
+			}
+
+			aggSig, err := bls.AggregateSignatures(sigs)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(hex.EncodeToString(aggSig))
+			return nil
+		},
+	}
+}
+
+// BlsVerifyCmd returns the Cobra Command for verifying aggregated BLS signatures.
+func BlsVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [message] [signature]",
+		Short: "Verify an aggregated BLS signature",
+		Long: `Verify the aggregated BLS signature of the hex encoded message with FastAggregateVerify.
+
+The voters are selected from the public keys by the vote address set, which is a bitset in the same
+format as the vote address set of the cross chain claims, e.g. 5 selects the first and the third
+public keys. All the public keys are voters if the vote address set is not provided.
+
+Example:
+	$ gnfd keys bls verify 0x4a5d... $AGG_SIG --pubkeys bls1,bls2,$BLS3_PUBKEY --vote-address-set 5
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: runBlsVerifyCmd,
+	}
+
+	cmd.Flags().StringSlice(FlagBlsPubKeys, nil, "Comma separated hex encoded BLS public keys, or names of the BLS keys in the keyring")
+	cmd.Flags().StringSlice(FlagVoteAddressSet, nil, "Comma separated uint64 words of the bitset of the voted public keys")
+	_ = cmd.MarkFlagRequired(FlagBlsPubKeys)
+	return cmd
+}
+
+func runBlsVerifyCmd(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	msg, err := decodeHex(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid message: %w", err)
+	}
+	sig, err := decodeHex(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	keys, err := cmd.Flags().GetStringSlice(FlagBlsPubKeys)
+	if err != nil {
+		return err
+	}
+	pubKeys := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		pubKey, err := blsPubKeyFromString(clientCtx, key)
+		if err != nil {
+			return err
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	words, err := cmd.Flags().GetStringSlice(FlagVoteAddressSet)
+	if err != nil {
+		return err
+	}
+	if len(words) != 0 {
+		voteAddressSet := make([]uint64, 0, len(words))
+		for _, word := range words {
+			w, err := strconv.ParseUint(word, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid vote address set: %w", err)
+			}
+			voteAddressSet = append(voteAddressSet, w)
+		}
+
+		pubKeys, err = bls.VotedPubKeys(pubKeys, voteAddressSet)
+		if err != nil {
+			return err
+		}
+	}
+
+	ok, err := bls.FastAggregateVerify(pubKeys, msg, sig)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("signature verify failed")
+	}
+
+	cmd.Printf("Signature verify successfully, voted: %d\n", len(pubKeys))
+	return nil
+}
+
+// blsPubKeyFromString returns the BLS public key from its hex encoding, or from the keyring by the
+// name of the key.
+func blsPubKeyFromString(clientCtx client.Context, key string) ([]byte, error) {
+	if pubKey, err := decodeHex(key); err == nil && len(pubKey) == sdk.BLSPubKeyLength {
+		return pubKey, nil
+	}
+
+	if clientCtx.Keyring == nil {
+		return nil, fmt.Errorf("invalid BLS public key: %s", key)
+	}
+	record, err := clientCtx.Keyring.Key(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BLS public key %s: %w", key, err)
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	if pubKey.Type() != bls.KeyType {
+		return nil, fmt.Errorf("key %s is not a BLS key: %s", key, pubKey.Type())
+	}
+
+	return pubKey.Bytes(), nil
+}
+
+func decodeHex(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+}
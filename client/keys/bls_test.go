@@ -0,0 +1,131 @@
+package keys
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttestutil "github.com/cosmos/cosmos-sdk/client/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_runBlsCmds(t *testing.T) {
+	cdc := clienttestutil.MakeTestCodec(t)
+	kbHome := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+
+	names := []string{"bls1", "bls2", "bls3"}
+	pubKeys := make([]string, 0, len(names))
+	for _, name := range names {
+		record, _, err := kb.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.EthBLS)
+		require.NoError(t, err)
+		pubKey, err := record.GetPubKey()
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, hex.EncodeToString(pubKey.Bytes()))
+	}
+	_, _, err = kb.NewMnemonic("eth", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithKeyring(kb).
+		WithCodec(cdc)
+
+	run := func(cmd *cobra.Command, args ...string) (string, error) {
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		cmd.SetArgs(append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		))
+		_, mockOut := testutil.ApplyMockIO(cmd)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+		err := cmd.ExecuteContext(ctx)
+		return strings.TrimSpace(mockOut.String()), err
+	}
+
+	msg := "0x" + strings.Repeat("ab", 32)
+
+	// sign the message with the first and the third keys
+	sig1, err := run(BlsSignCmd(), msg, "--from", "bls1")
+	require.NoError(t, err)
+	sig3, err := run(BlsSignCmd(), msg, "--from", "bls3")
+	require.NoError(t, err)
+
+	_, err = run(BlsSignCmd(), msg, "--from", "eth")
+	require.ErrorContains(t, err, "not a BLS key")
+	_, err = run(BlsSignCmd(), "not hex", "--from", "bls1")
+	require.Error(t, err)
+
+	aggSig, err := run(BlsAggregateCmd(), sig1, sig3)
+	require.NoError(t, err)
+
+	_, err = run(BlsAggregateCmd(), sig1, "invalid")
+	require.Error(t, err)
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+		mustFail       bool
+	}{
+		{
+			name:           "voters selected by vote address set",
+			args:           []string{msg, aggSig, "--pubkeys", strings.Join(pubKeys, ","), "--vote-address-set", "5"},
+			expectedOutput: "Signature verify successfully, voted: 2",
+		},
+		{
+			name:           "voters from keyring names",
+			args:           []string{msg, aggSig, "--pubkeys", "bls1,bls3"},
+			expectedOutput: "Signature verify successfully, voted: 2",
+		},
+		{
+			name:     "wrong vote address set",
+			args:     []string{msg, aggSig, "--pubkeys", strings.Join(pubKeys, ","), "--vote-address-set", "3"},
+			mustFail: true,
+		},
+		{
+			name:     "all the public keys",
+			args:     []string{msg, aggSig, "--pubkeys", strings.Join(pubKeys, ",")},
+			mustFail: true,
+		},
+		{
+			name:     "votes out of the public keys",
+			args:     []string{msg, aggSig, "--pubkeys", strings.Join(pubKeys, ","), "--vote-address-set", "9"},
+			mustFail: true,
+		},
+		{
+			name:     "not a BLS key",
+			args:     []string{msg, aggSig, "--pubkeys", "bls1,eth"},
+			mustFail: true,
+		},
+		{
+			name:     "another message",
+			args:     []string{"0x" + strings.Repeat("cd", 32), aggSig, "--pubkeys", "bls1,bls3"},
+			mustFail: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := run(BlsVerifyCmd(), tc.args...)
+			if tc.mustFail {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedOutput, out)
+		})
+	}
+}
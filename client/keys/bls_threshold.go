@@ -0,0 +1,378 @@
+package keys
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+)
+
+const (
+	// FlagThreshold is the flag for the number of the shares required to sign for the key.
+	FlagThreshold = "threshold"
+	// FlagShares is the flag for the number of the shares.
+	FlagShares = "shares"
+	// FlagOutputDir is the flag for the directory of the key share keystores.
+	FlagOutputDir = "output-dir"
+	// FlagCommitments is the flag for the commitments files of the key shares.
+	FlagCommitments = "commitments"
+	// FlagMessage is the flag for the message signed by the partial signatures.
+	FlagMessage = "message"
+
+	// commitmentsFile is the name of the commitments file in the output directory.
+	commitmentsFile = "commitments.json"
+)
+
+// thresholdCommitments is the content of the commitments files, which is published to all the
+// participants to verify the key shares and the partial signatures.
+type thresholdCommitments struct {
+	Threshold   int      `json:"threshold"`
+	PubKey      string   `json:"pubkey"`
+	Commitments []string `json:"commitments"`
+}
+
+// BlsSplitCmd returns the Cobra Command for splitting a BLS key into threshold key shares.
+func BlsSplitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split [name]",
+		Short: "Split a BLS key into threshold key shares",
+		Long: `Split the BLS key of the keyring into key shares, any threshold of which can sign for the key.
+The shares are written in EIP-2335 keystores share-<index>.json of the output directory, together
+with the commitments.json of the shares, which should be published to all the participants.
+
+The shares are imported by the participants with the import-keystore command and sign the partial
+signatures with the sign command, the partial signatures are combined with the combine command.
+
+A random key is split if the name is not provided, which is a dealing of the distributed key
+generation, see the merge-shares command.
+
+Example:
+	$ gnfd keys bls split bls --threshold 2 --shares 3 --output-dir ./shares
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runBlsSplitCmd,
+	}
+
+	cmd.Flags().Int(FlagThreshold, 0, "Number of the shares required to sign for the key")
+	cmd.Flags().Int(FlagShares, 0, "Number of the shares")
+	cmd.Flags().String(FlagOutputDir, "", "Directory to write the key share keystores and the commitments")
+	_ = cmd.MarkFlagRequired(FlagThreshold)
+	_ = cmd.MarkFlagRequired(FlagShares)
+	_ = cmd.MarkFlagRequired(FlagOutputDir)
+	return cmd
+}
+
+func runBlsSplitCmd(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	buf := bufio.NewReader(clientCtx.Input)
+
+	threshold, _ := cmd.Flags().GetInt(FlagThreshold)
+	n, _ := cmd.Flags().GetInt(FlagShares)
+	outputDir, _ := cmd.Flags().GetString(FlagOutputDir)
+
+	var privKey *bls.PrivKey
+	if len(args) == 0 {
+		if privKey, err = bls.GenPrivKey(); err != nil {
+			return err
+		}
+	} else {
+		exporter, ok := clientCtx.Keyring.(unsafeExporter)
+		if !ok {
+			return errors.New("the keyring doesn't support exporting private keys")
+		}
+		priv, err := exporter.ExportPrivateKeyObject(args[0])
+		if err != nil {
+			return err
+		}
+		if privKey, ok = priv.(*bls.PrivKey); !ok {
+			return fmt.Errorf("key %s is not a BLS key: %s", args[0], priv.Type())
+		}
+	}
+
+	shares, commitments, err := bls.SplitPrivKey(privKey, threshold, n)
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := input.GetPassword("Enter passphrase to encrypt the key shares:", buf)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(outputDir, 0o700); err != nil {
+		return err
+	}
+	for i, share := range shares {
+		if err := writeKeyShare(outputDir, uint32(i+1), share, passphrase); err != nil {
+			return err
+		}
+	}
+
+	return writeCommitments(cmd, outputDir, commitments)
+}
+
+// BlsMergeSharesCmd returns the Cobra Command for merging the key shares of the distributed key
+// generation.
+func BlsMergeSharesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-shares [index] [share-keystore] [share-keystore...]",
+		Short: "Merge the key shares of the distributed key generation",
+		Long: `Merge the key shares at the index received from all the participants of the distributed key
+generation into the key share of the shared key, which is never known by any participant.
+
+Every participant splits a random key with the split command and sends the share-<index>.json to
+the participant at the index, and publishes the commitments.json. The share keystores are verified
+against the commitments files in the same order, and the merged share is written in the output
+directory together with the commitments of the shared key.
+
+Example:
+	$ gnfd keys bls merge-shares 1 ./p1/share-1.json ./p2/share-1.json ./p3/share-1.json \
+		--commitments ./p1/commitments.json,./p2/commitments.json,./p3/commitments.json --output-dir ./shares
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: runBlsMergeSharesCmd,
+	}
+
+	cmd.Flags().StringSlice(FlagCommitments, nil, "Comma separated commitments files of the key shares")
+	cmd.Flags().String(FlagOutputDir, "", "Directory to write the merged key share keystore and the commitments")
+	_ = cmd.MarkFlagRequired(FlagCommitments)
+	_ = cmd.MarkFlagRequired(FlagOutputDir)
+	return cmd
+}
+
+func runBlsMergeSharesCmd(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	buf := bufio.NewReader(clientCtx.Input)
+
+	index, err := parseShareIndex(args[0])
+	if err != nil {
+		return err
+	}
+
+	files, _ := cmd.Flags().GetStringSlice(FlagCommitments)
+	outputDir, _ := cmd.Flags().GetString(FlagOutputDir)
+	if len(files) != len(args)-1 {
+		return fmt.Errorf("got %d share keystores with %d commitments files", len(args)-1, len(files))
+	}
+
+	shares := make([]*bls.PrivKey, 0, len(files))
+	commitments := make([][][]byte, 0, len(files))
+	for i, file := range files {
+		c, err := readCommitments(file)
+		if err != nil {
+			return err
+		}
+		commitments = append(commitments, c)
+
+		bz, err := os.ReadFile(args[i+1])
+		if err != nil {
+			return err
+		}
+		passphrase, err := input.GetPassword(fmt.Sprintf("Enter passphrase to decrypt %s:", args[i+1]), buf)
+		if err != nil {
+			return err
+		}
+		privKey, err := crypto.DecryptKeystore(bz, passphrase)
+		if err != nil {
+			return err
+		}
+		share, ok := privKey.(*bls.PrivKey)
+		if !ok {
+			return fmt.Errorf("%s is not a BLS key share", args[i+1])
+		}
+		shares = append(shares, share)
+	}
+
+	share, merged, err := bls.CombineKeyShares(index, shares, commitments)
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := input.GetPassword("Enter passphrase to encrypt the merged key share:", buf)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(outputDir, 0o700); err != nil {
+		return err
+	}
+	if err := writeKeyShare(outputDir, index, share, passphrase); err != nil {
+		return err
+	}
+
+	return writeCommitments(cmd, outputDir, merged)
+}
+
+// BlsCombineCmd returns the Cobra Command for combining the partial BLS signatures.
+func BlsCombineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "combine [index:signature] [index:signature...]",
+		Short: "Combine the partial BLS signatures of the key shares into one signature",
+		Long: `Combine the hex encoded partial BLS signatures signed by the key shares at the indices into the
+signature of the shared key, the number of the partial signatures must reach the threshold. The
+combined signature is accepted as a signature of the shared key, e.g. as the BLS proof of the
+validator or a vote of the cross chain claims.
+
+The partial signatures and the combined signature are verified if both the commitments file and
+the message are provided.
+
+Example:
+	$ gnfd keys bls combine 1:$SIG1 3:$SIG3 --commitments ./shares/commitments.json --message 0x4a5d...
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: runBlsCombineCmd,
+	}
+
+	cmd.Flags().String(FlagCommitments, "", "Commitments file of the key shares")
+	cmd.Flags().String(FlagMessage, "", "Hex encoded message signed by the partial signatures")
+	return cmd
+}
+
+func runBlsCombineCmd(cmd *cobra.Command, args []string) error {
+	indices := make([]uint32, 0, len(args))
+	partialSigs := make([][]byte, 0, len(args))
+	for _, arg := range args {
+		parts := strings.SplitN(arg, ":", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid partial signature %s, expected index:signature", arg)
+		}
+		index, err := parseShareIndex(parts[0])
+		if err != nil {
+			return err
+		}
+		sig, err := decodeHex(parts[1])
+		if err != nil {
+			return fmt.Errorf("invalid signature %s: %w", parts[1], err)
+		}
+		indices = append(indices, index)
+		partialSigs = append(partialSigs, sig)
+	}
+
+	file, _ := cmd.Flags().GetString(FlagCommitments)
+	message, _ := cmd.Flags().GetString(FlagMessage)
+	var (
+		commitments [][]byte
+		msg         []byte
+		err         error
+	)
+	if file != "" && message != "" {
+		if commitments, err = readCommitments(file); err != nil {
+			return err
+		}
+		if msg, err = decodeHex(message); err != nil {
+			return fmt.Errorf("invalid message: %w", err)
+		}
+		if len(indices) < len(commitments) {
+			return fmt.Errorf("got %d partial signatures, threshold is %d", len(indices), len(commitments))
+		}
+
+		for i, index := range indices {
+			pubKey, err := bls.KeySharePubKey(index, commitments)
+			if err != nil {
+				return err
+			}
+			if !(&bls.PubKey{Key: pubKey}).VerifySignature(msg, partialSigs[i]) {
+				return fmt.Errorf("invalid partial signature of share %d", index)
+			}
+		}
+	}
+
+	sig, err := bls.CombineSignatures(indices, partialSigs)
+	if err != nil {
+		return err
+	}
+	if commitments != nil && !(&bls.PubKey{Key: commitments[0]}).VerifySignature(msg, sig) {
+		return errors.New("signature verify failed")
+	}
+
+	cmd.Println(hex.EncodeToString(sig))
+	return nil
+}
+
+// writeKeyShare writes the key share at the index in an EIP-2335 keystore of the directory.
+func writeKeyShare(dir string, index uint32, share *bls.PrivKey, passphrase string) error {
+	keystore, err := crypto.EncryptKeystore(share, passphrase)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, fmt.Sprintf("share-%d.json", index)), keystore, 0o600)
+}
+
+// writeCommitments writes the commitments of the key shares in the commitments file of the
+// directory and prints them.
+func writeCommitments(cmd *cobra.Command, dir string, commitments [][]byte) error {
+	c := thresholdCommitments{
+		Threshold:   len(commitments),
+		PubKey:      hex.EncodeToString(commitments[0]),
+		Commitments: make([]string, 0, len(commitments)),
+	}
+	for _, commitment := range commitments {
+		c.Commitments = append(c.Commitments, hex.EncodeToString(commitment))
+	}
+
+	bz, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, commitmentsFile), bz, 0o600); err != nil {
+		return err
+	}
+
+	cmd.Println(string(bz))
+	return nil
+}
+
+// readCommitments reads the commitments of the key shares from the commitments file.
+func readCommitments(file string) ([][]byte, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var c thresholdCommitments
+	if err := json.Unmarshal(bz, &c); err != nil {
+		return nil, fmt.Errorf("invalid commitments file %s: %w", file, err)
+	}
+	if len(c.Commitments) == 0 || len(c.Commitments) != c.Threshold || c.PubKey != c.Commitments[0] {
+		return nil, fmt.Errorf("invalid commitments file %s", file)
+	}
+
+	commitments := make([][]byte, 0, len(c.Commitments))
+	for _, commitment := range c.Commitments {
+		bz, err := decodeHex(commitment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commitments file %s: %w", file, err)
+		}
+		commitments = append(commitments, bz)
+	}
+
+	return commitments, nil
+}
+
+func parseShareIndex(s string) (uint32, error) {
+	index, err := strconv.ParseUint(s, 10, 32)
+	if err != nil || index == 0 {
+		return 0, fmt.Errorf("invalid share index %s", s)
+	}
+
+	return uint32(index), nil
+}
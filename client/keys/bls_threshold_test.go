@@ -0,0 +1,156 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttestutil "github.com/cosmos/cosmos-sdk/client/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_runBlsThresholdCmds(t *testing.T) {
+	defer func(n int) { crypto.KeystoreScryptN = n }(crypto.KeystoreScryptN)
+	crypto.KeystoreScryptN = 1 << 12
+
+	cdc := clienttestutil.MakeTestCodec(t)
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	record, _, err := kb.NewMnemonic("bls", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.EthBLS)
+	require.NoError(t, err)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	_, _, err = kb.NewMnemonic("eth", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+
+	run := func(cmd *cobra.Command, input string, args ...string) (string, error) {
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		cmd.SetArgs(args)
+		mockIn, mockOut := testutil.ApplyMockIO(cmd)
+		mockIn.Reset(input)
+		clientCtx := client.Context{}.WithKeyring(kb).WithCodec(cdc).WithInput(mockIn)
+		err := cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+		return strings.TrimSpace(mockOut.String()), err
+	}
+
+	// split the key into 2 of 3 shares and import them
+	dir := t.TempDir()
+	_, err = run(BlsSplitCmd(), "12345678\n", "bls", "--threshold=2", "--shares=3", "--output-dir="+dir)
+	require.NoError(t, err)
+	for i := 1; i <= 3; i++ {
+		_, err = run(ImportKeystoreCommand(), "12345678\n", fmt.Sprintf("share%d", i), filepath.Join(dir, fmt.Sprintf("share-%d.json", i)))
+		require.NoError(t, err)
+	}
+
+	_, err = run(BlsSplitCmd(), "12345678\n", "eth", "--threshold=2", "--shares=3", "--output-dir="+t.TempDir())
+	require.ErrorContains(t, err, "not a BLS key")
+	_, err = run(BlsSplitCmd(), "12345678\n", "bls", "--threshold=4", "--shares=3", "--output-dir="+t.TempDir())
+	require.Error(t, err)
+
+	// the partial signatures are combined into the signature of the key
+	msg := "0x" + strings.Repeat("ab", 32)
+	sig1, err := run(BlsSignCmd(), "", msg, "--from", "share1")
+	require.NoError(t, err)
+	sig3, err := run(BlsSignCmd(), "", msg, "--from", "share3")
+	require.NoError(t, err)
+	expected, err := run(BlsSignCmd(), "", msg, "--from", "bls")
+	require.NoError(t, err)
+
+	commitments := filepath.Join(dir, commitmentsFile)
+	sig, err := run(BlsCombineCmd(), "", "1:"+sig1, "3:"+sig3, "--commitments="+commitments, "--message="+msg)
+	require.NoError(t, err)
+	require.Equal(t, expected, sig)
+
+	_, err = run(BlsVerifyCmd(), "", msg, sig, fmt.Sprintf("--%s=%x", FlagBlsPubKeys, pubKey.Bytes()))
+	require.NoError(t, err)
+
+	// the indices of the partial signatures are swapped
+	_, err = run(BlsCombineCmd(), "", "3:"+sig1, "1:"+sig3, "--commitments="+commitments, "--message="+msg)
+	require.ErrorContains(t, err, "invalid partial signature")
+	sig, err = run(BlsCombineCmd(), "", "3:"+sig1, "1:"+sig3)
+	require.NoError(t, err)
+	require.NotEqual(t, expected, sig)
+
+	// fewer partial signatures than the threshold
+	_, err = run(BlsCombineCmd(), "", "1:"+sig1, "--commitments="+commitments, "--message="+msg)
+	require.Error(t, err)
+	_, err = run(BlsCombineCmd(), "", sig1)
+	require.Error(t, err)
+	_, err = run(BlsCombineCmd(), "", "0:"+sig1)
+	require.Error(t, err)
+}
+
+func Test_runBlsMergeSharesCmd(t *testing.T) {
+	defer func(n int) { crypto.KeystoreScryptN = n }(crypto.KeystoreScryptN)
+	crypto.KeystoreScryptN = 1 << 12
+
+	cdc := clienttestutil.MakeTestCodec(t)
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	run := func(cmd *cobra.Command, input string, args ...string) (string, error) {
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		cmd.SetArgs(args)
+		mockIn, mockOut := testutil.ApplyMockIO(cmd)
+		mockIn.Reset(input)
+		clientCtx := client.Context{}.WithKeyring(kb).WithCodec(cdc).WithInput(mockIn)
+		err := cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+		return strings.TrimSpace(mockOut.String()), err
+	}
+
+	// every participant splits a random key
+	dealings := make([]string, 2)
+	for i := range dealings {
+		dealings[i] = t.TempDir()
+		_, err := run(BlsSplitCmd(), "12345678\n", "--threshold=2", "--shares=2", "--output-dir="+dealings[i])
+		require.NoError(t, err)
+	}
+
+	var merged []string
+	for index := 1; index <= 2; index++ {
+		dir := t.TempDir()
+		out, err := run(BlsMergeSharesCmd(), "12345678\n12345678\n12345678\n",
+			fmt.Sprint(index),
+			filepath.Join(dealings[0], fmt.Sprintf("share-%d.json", index)),
+			filepath.Join(dealings[1], fmt.Sprintf("share-%d.json", index)),
+			fmt.Sprintf("--commitments=%s,%s", filepath.Join(dealings[0], commitmentsFile), filepath.Join(dealings[1], commitmentsFile)),
+			"--output-dir="+dir,
+		)
+		require.NoError(t, err)
+		merged = append(merged, dir)
+		if index > 1 {
+			// all the participants agree on the commitments of the shared key
+			require.Contains(t, out, "\"threshold\": 2")
+		}
+
+		_, err = run(ImportKeystoreCommand(), "12345678\n", fmt.Sprintf("share%d", index), filepath.Join(dir, fmt.Sprintf("share-%d.json", index)))
+		require.NoError(t, err)
+	}
+
+	// a share of another index is rejected
+	_, err = run(BlsMergeSharesCmd(), "12345678\n12345678\n",
+		"1", filepath.Join(dealings[0], "share-2.json"),
+		"--commitments="+filepath.Join(dealings[0], commitmentsFile), "--output-dir="+t.TempDir(),
+	)
+	require.Error(t, err)
+
+	msg := "0x" + strings.Repeat("ab", 32)
+	sig1, err := run(BlsSignCmd(), "", msg, "--from", "share1")
+	require.NoError(t, err)
+	sig2, err := run(BlsSignCmd(), "", msg, "--from", "share2")
+	require.NoError(t, err)
+	_, err = run(BlsCombineCmd(), "", "1:"+sig1, "2:"+sig2, "--commitments="+filepath.Join(merged[0], commitmentsFile), "--message="+msg)
+	require.NoError(t, err)
+	_, err = run(BlsCombineCmd(), "", "1:"+sig1, "2:"+sig2, "--commitments="+filepath.Join(merged[1], commitmentsFile), "--message="+msg)
+	require.NoError(t, err)
+}
@@ -0,0 +1,77 @@
+package keys
+
+import (
+	"bufio"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+)
+
+// ImportKeystoreCommand imports private keys from an EIP-2335 or an Ethereum V3 keystore.
+func ImportKeystoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-keystore <name> <keystore>",
+		Short: "Import private keys from JSON keystores into the local keybase",
+		Long: `Import a private key from a JSON keystore into the local keybase.
+
+EIP-2335 keystores, which are used by the Ethereum consensus clients, are imported as BLS keys.
+Ethereum V3 keystores, which are used by geth and the BSC tooling, are imported as eth_secp256k1 keys.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt your key:", buf)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.Keyring.ImportPrivKeyKeystore(args[0], string(bz), passphrase)
+		},
+	}
+}
+
+// ExportKeystoreCommand exports private keys in EIP-2335 or Ethereum V3 keystores.
+func ExportKeystoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export-keystore <name>",
+		Short: "Export private keys in JSON keystores",
+		Long: `Export a private key from the local keyring in a JSON keystore.
+
+BLS keys are exported in EIP-2335 keystores and eth_secp256k1 keys are exported in Ethereum V3
+keystores, so that they can be imported by the Ethereum and BSC tooling.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported key:", buf)
+			if err != nil {
+				return err
+			}
+
+			keystore, err := clientCtx.Keyring.ExportPrivKeyKeystore(args[0], encryptPassword)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(keystore)
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package keys
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttestutil "github.com/cosmos/cosmos-sdk/client/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_runExportImportKeystoreCmd(t *testing.T) {
+	defer func(n int) { crypto.KeystoreScryptN = n }(crypto.KeystoreScryptN)
+	crypto.KeystoreScryptN = 1 << 12
+
+	cdc := clienttestutil.MakeTestCodec(t)
+
+	newClientCtx := func(t *testing.T) (client.Context, keyring.Keyring) {
+		kbHome := t.TempDir()
+		kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+		require.NoError(t, err)
+
+		return client.Context{}.WithKeyringDir(kbHome).WithKeyring(kb).WithCodec(cdc), kb
+	}
+
+	for _, algo := range []keyring.SignatureAlgo{hd.EthBLS, hd.EthSecp256k1} {
+		t.Run(string(algo.Name()), func(t *testing.T) {
+			srcCtx, srcKb := newClientCtx(t)
+			record, _, err := srcKb.NewMnemonic("src", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, algo)
+			require.NoError(t, err)
+
+			// export the key in a keystore
+			cmd := ExportKeystoreCommand()
+			cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+			mockIn, mockOut := testutil.ApplyMockIO(cmd)
+			mockIn.Reset("12345678\n")
+			srcCtx = srcCtx.WithInput(mockIn)
+			cmd.SetArgs([]string{"src"})
+			require.NoError(t, cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &srcCtx)))
+
+			keystoreFile := filepath.Join(t.TempDir(), "keystore.json")
+			require.NoError(t, os.WriteFile(keystoreFile, []byte(strings.TrimSpace(mockOut.String())), 0o600))
+
+			// import the keystore into another keyring
+			dstCtx, dstKb := newClientCtx(t)
+			importKeystore := func(passphrase string) error {
+				cmd := ImportKeystoreCommand()
+				cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+				mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+				mockIn.Reset(passphrase + "\n")
+				dstCtx := dstCtx.WithInput(mockIn)
+				cmd.SetArgs([]string{"dst", keystoreFile})
+				return cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &dstCtx))
+			}
+
+			require.Error(t, importKeystore("87654321"))
+			require.NoError(t, importKeystore("12345678"))
+
+			imported, err := dstKb.Key("dst")
+			require.NoError(t, err)
+			expectedPubKey, err := record.GetPubKey()
+			require.NoError(t, err)
+			pubKey, err := imported.GetPubKey()
+			require.NoError(t, err)
+			require.True(t, expectedPubKey.Equals(pubKey))
+
+			// the key can't be overwritten
+			require.Error(t, importKeystore("12345678"))
+		})
+	}
+}
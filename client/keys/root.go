@@ -27,6 +27,9 @@ The keyring supports the following backends:
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+    remote      Forwards the signing requests to a remote signer over gRPC with mutual TLS, which is
+                configured by keyring-remote/config.json within the app's configuration directory.
+                The keys are managed by the remote signer and can't be added, removed or exported.
 
 kwallet and pass backends depend on external tools. Refer to their respective documentation for more
 information:
@@ -42,6 +45,8 @@ The pass backend requires GnuPG: https://gnupg.org/
 		AddKeyCommand(),
 		ExportKeyCommand(),
 		ImportKeyCommand(),
+		ExportKeystoreCommand(),
+		ImportKeystoreCommand(),
 		ListKeysCmd(),
 		ListKeyTypesCmd(),
 		ShowKeysCmd(),
@@ -51,6 +56,7 @@ The pass backend requires GnuPG: https://gnupg.org/
 		MigrateCommand(),
 		SignMsgKeysCmd(),
 		VerifySignatureCmd(),
+		BlsCommands(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
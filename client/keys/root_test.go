@@ -11,5 +11,5 @@ func TestCommands(t *testing.T) {
 	assert.Assert(t, rootCommands != nil)
 
 	// Commands are registered
-	assert.Equal(t, 13, len(rootCommands.Commands()))
+	assert.Equal(t, 16, len(rootCommands.Commands()))
 }
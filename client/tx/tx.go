@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -323,7 +324,7 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 	}
 
 	// Sign those bytes
-	sigBytes, _, err := txf.keybase.Sign(name, bytesToSign)
+	sigBytes, err := signBytes(txf, k, signMode, signerData, txBuilder.GetTx(), bytesToSign)
 	if err != nil {
 		return err
 	}
@@ -355,6 +356,38 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 	return txf.PreprocessTx(name, txBuilder)
 }
 
+// signBytes signs the sign bytes with the key. The EIP-712 typed data of the ledger keys is signed
+// with the hash of the domain and the hash of the message instead, which are signed by the Ledger
+// Ethereum app.
+func signBytes(txf Factory, k *keyring.Record, signMode signing.SignMode, signerData authsigning.SignerData, tx authsigning.Tx, bytesToSign []byte) ([]byte, error) {
+	kb := txf.keybase
+	if binder, ok := kb.(keyring.SignRequestBinder); ok {
+		kb = binder.WithSignRequest(keyring.SignRequest{
+			ChainID:  signerData.ChainID,
+			SignMode: signMode,
+			Msgs:     tx.GetMsgs(),
+		})
+	}
+
+	eip712Signer, ok := kb.(keyring.EIP712Signer)
+	if !ok || signMode != signing.SignMode_SIGN_MODE_EIP_712 || k.GetLedger() == nil {
+		sig, _, err := kb.Sign(k.Name, bytesToSign)
+		return sig, err
+	}
+
+	handler, ok := txf.txConfig.SignModeHandler().(authsigning.SignModeHandlerWithTypedDataHashes)
+	if !ok {
+		return nil, fmt.Errorf("sign mode handler doesn't support the typed data of %s", signMode)
+	}
+	domainSeparator, messageHash, err := handler.GetTypedDataHashes(signMode, signerData, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, _, err := eip712Signer.SignEIP712(k.Name, domainSeparator, messageHash)
+	return sig, err
+}
+
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
 	GasEstimate uint64 `json:"gas_estimate" yaml:"gas_estimate"`
@@ -423,7 +456,16 @@ func makeAuxSignerData(clientCtx client.Context, f Factory, msgs ...sdk.Msg) (tx
 		return tx.AuxSignerData{}, err
 	}
 
-	sig, _, err := clientCtx.Keyring.Sign(name, signBz)
+	kb := clientCtx.Keyring
+	if binder, ok := kb.(keyring.SignRequestBinder); ok {
+		kb = binder.WithSignRequest(keyring.SignRequest{
+			ChainID:  clientCtx.ChainID,
+			SignMode: f.SignMode(),
+			Msgs:     msgs,
+		})
+	}
+
+	sig, _, err := kb.Sign(name, signBz)
 	if err != nil {
 		return tx.AuxSignerData{}, err
 	}
@@ -0,0 +1,60 @@
+//go:build ledger || test_ledger_mock
+// +build ledger test_ledger_mock
+
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSignEIP712WithLedger(t *testing.T) {
+	txConfig, cdc := newTestTxConfig(t)
+	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	k, err := kb.SaveLedgerKey("ledger", hd.EthSecp256k1, "", 60, 0, 0)
+	if err != nil {
+		t.Skip(err.Error())
+	}
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+
+	txf := mockTxFactory(txConfig).
+		WithChainID("greenfield_9000-1").
+		WithKeybase(kb)
+	txb, err := txf.BuildUnsignedTx(banktypes.NewMsgSend(addr, sdk.AccAddress("to"), nil))
+	require.NoError(t, err)
+
+	// the Ethereum app only signs EIP-712 typed data
+	require.Error(t, tx.Sign(txf.WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT), "ledger", txb, true))
+
+	require.NoError(t, tx.Sign(txf.WithSignMode(signingtypes.SignMode_SIGN_MODE_EIP_712), "ledger", txb, true))
+
+	sigs, err := txb.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	sigData := sigs[0].Data.(*signingtypes.SingleSignatureData)
+
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
+		PubKey:        pubKey,
+	}, txb.GetTx())
+	require.NoError(t, err)
+	require.True(t, pubKey.(*ethsecp256k1.PubKey).VerifyDigestSignature(signBytes, sigData.Signature))
+}
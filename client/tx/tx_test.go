@@ -374,6 +374,64 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignWithKeyringPolicy(t *testing.T) {
+	txConfig, cdc := newTestTxConfig(t)
+	requireT := require.New(t)
+	path := hd.CreateHDPath(118, 0, 0).String()
+	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, cdc)
+	requireT.NoError(err)
+
+	from := "test_key"
+	k, _, err := kb.NewMnemonic(from, keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	requireT.NoError(err)
+	addr, err := k.GetAddress()
+	requireT.NoError(err)
+
+	kb, err = keyring.NewPolicyKeyring(kb, keyring.PolicyConfig{
+		Policies: map[string]keyring.KeyPolicy{
+			from: {
+				AllowedMsgTypes: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+				MaxSendAmount:   "100atom",
+				AllowedChainIDs: []string{sdktestutil.DefaultChainId},
+			},
+		},
+	})
+	requireT.NoError(err)
+
+	txf := mockTxFactory(txConfig).
+		WithKeybase(kb).
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT).
+		WithChainID(sdktestutil.DefaultChainId)
+	send := func(amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(addr, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("atom", amount)))
+	}
+
+	testCases := []struct {
+		name   string
+		txf    tx.Factory
+		msgs   []sdk.Msg
+		denied bool
+	}{
+		{"allowed", txf, []sdk.Msg{send(60), send(40)}, false},
+		{"max send amount exceeded", txf, []sdk.Msg{send(60), send(41)}, true},
+		{"msg type not allowed", txf, []sdk.Msg{testdata.NewTestMsg(addr)}, true},
+		{"chain id not allowed", txf.WithChainID("other-chain"), []sdk.Msg{send(1)}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txb, err := tc.txf.BuildUnsignedTx(tc.msgs...)
+			require.NoError(t, err)
+
+			err = tx.Sign(tc.txf, from, txb, true)
+			if tc.denied {
+				require.ErrorIs(t, err, keyring.ErrSignRequestDenied)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestPreprocessHook(t *testing.T) {
 	txConfig, cdc := newTestTxConfig(t)
 	requireT := require.New(t)
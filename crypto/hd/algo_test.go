@@ -16,3 +16,8 @@ func TestDefaults(t *testing.T) {
 	require.Equal(t, hd.PubKeyType("eth_secp256k1"), hd.EthSecp256k1Type)
 	require.Equal(t, hd.PubKeyType("eth_bls"), hd.BLSType)
 }
+
+func TestCreateBLSSigningPath(t *testing.T) {
+	require.Equal(t, "m/12381/3600/0/0/0", hd.CreateBLSSigningPath(0))
+	require.Equal(t, "m/12381/3600/7/0/0", hd.CreateBLSSigningPath(7))
+}
@@ -0,0 +1,20 @@
+package hd
+
+import "fmt"
+
+// The EIP-2334 paths of the BLS keys, which are used by the Ethereum staking tooling to derive the
+// validator keys from a mnemonic:
+//
+//	https://eips.ethereum.org/EIPS/eip-2334
+const (
+	// BLSPurpose is the purpose of the EIP-2334 paths.
+	BLSPurpose = 12381
+	// BLSCoinType is the coin type of the EIP-2334 paths of the Ethereum BLS keys.
+	BLSCoinType = 3600
+)
+
+// CreateBLSSigningPath returns the EIP-2334 path of the signing key of the given account, which is
+// m/12381/3600/{account}/0/0.
+func CreateBLSSigningPath(account uint32) string {
+	return fmt.Sprintf("m/%d/%d/%d/0/0", BLSPurpose, BLSCoinType, account)
+}
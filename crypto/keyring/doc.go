@@ -37,4 +37,8 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//	remote	Same instance as returned by NewRemote. This backend forwards the key lookups and the
+//		signing requests to a remote signer over gRPC with mutual TLS, it is configured by
+//		keyring-remote/config.json within the app's configuration directory. The keys are
+//		managed by the remote signer, so they can't be added, removed or exported.
 package keyring
@@ -10,4 +10,12 @@ var (
 	// ErrUnsupportedLanguage is raised when the caller tries to use a
 	// different language than english for creating a mnemonic sentence.
 	ErrUnsupportedLanguage = errors.New("unsupported language: only english is supported")
+
+	// ErrRemoteSignerReadOnly is raised when the caller tries to add, remove or export
+	// the private keys of the remote keyring, which are managed by the remote signer.
+	ErrRemoteSignerReadOnly = errors.New("keys are managed by the remote signer")
+
+	// ErrSignRequestDenied is raised when the signing request is denied by the usage policy
+	// of the key.
+	ErrSignRequestDenied = errors.New("signing request denied by the key policy")
 )
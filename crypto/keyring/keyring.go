@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/bcrypt"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,6 +35,7 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
@@ -52,7 +54,7 @@ var (
 
 // Keyring exposes operations over a backend supported by github.com/99designs/keyring.
 type Keyring interface {
-	// Get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory".
+	// Get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory", "remote".
 	Backend() string
 	// List all keys.
 	List() ([]*Record, error)
@@ -100,6 +102,13 @@ type Keyring interface {
 	Migrator
 }
 
+// EIP712Signer is implemented by key stores that sign EIP-712 typed data with the hash of the
+// domain and the hash of the message, which is required by the keys of the Ledger Ethereum app.
+type EIP712Signer interface {
+	// SignEIP712 signs the EIP-712 typed data with a user key.
+	SignEIP712(uid string, domainSeparator, messageHash []byte) ([]byte, types.PubKey, error)
+}
+
 // Signer is implemented by key stores that want to provide signing capabilities.
 type Signer interface {
 	// Sign sign byte messages with a user key.
@@ -116,6 +125,9 @@ type Importer interface {
 
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid string, armor string) error
+
+	// ImportPrivKeyKeystore imports private keys from EIP-2335 or Ethereum V3 JSON keystores.
+	ImportPrivKeyKeystore(uid, keystore, passphrase string) error
 }
 
 // Migrator is implemented by key stores and enables migration of keys from amino to proto
@@ -133,6 +145,10 @@ type Exporter interface {
 	// It returns an error if the key does not exist or a wrong encryption passphrase is supplied.
 	ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error)
 	ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (armor string, err error)
+
+	// ExportPrivKeyKeystore returns a private key in a JSON keystore, an EIP-2335 keystore for BLS keys
+	// and an Ethereum V3 keystore for eth_secp256k1 keys.
+	ExportPrivKeyKeystore(uid, encryptPassphrase string) (keystore string, err error)
 }
 
 // Option overrides keyring configuration options.
@@ -146,6 +162,8 @@ type Options struct {
 	SupportedAlgosLedger SigningAlgoList
 	// define Ledger Derivation function
 	LedgerDerivation func() (ledger.SECP256K1, error)
+	// define Ledger Derivation function of the Ethereum app
+	LedgerEthDerivation func() (ledger.ETHEREUM, error)
 	// define Ledger key generation function
 	LedgerCreateKey func([]byte) types.PubKey
 	// define Ledger app name
@@ -170,7 +188,8 @@ func NewInMemoryWithKeyring(kr keyring.Keyring, cdc codec.Codec, opts ...Option)
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// The keyring is wrapped by the policy keyring if keyring-policy.json exists in rootDir.
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -182,6 +201,12 @@ func New(
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(cdc, opts...), err
+	case BackendRemote:
+		kr, err := newRemoteBackend(rootDir, cdc, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return newPolicyKeyringFromDir(kr, rootDir)
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
@@ -200,7 +225,7 @@ func New(
 		return nil, err
 	}
 
-	return newKeystore(db, cdc, backend, opts...), nil
+	return newPolicyKeyringFromDir(newKeystore(db, cdc, backend, opts...), rootDir)
 }
 
 type keystore struct {
@@ -226,6 +251,10 @@ func newKeystore(kr keyring.Keyring, cdc codec.Codec, backend string, opts ...Op
 		ledger.SetDiscoverLedger(options.LedgerDerivation)
 	}
 
+	if options.LedgerEthDerivation != nil {
+		ledger.SetDiscoverLedgerEth(options.LedgerEthDerivation)
+	}
+
 	if options.LedgerCreateKey != nil {
 		ledger.SetCreatePubkey(options.LedgerCreateKey)
 	}
@@ -289,6 +318,20 @@ func (ks keystore) ExportPrivKeyArmor(uid, encryptPassphrase string) (armor stri
 	return crypto.EncryptArmorPrivKey(priv, encryptPassphrase, priv.Type()), nil
 }
 
+func (ks keystore) ExportPrivKeyKeystore(uid, encryptPassphrase string) (string, error) {
+	priv, err := ks.ExportPrivateKeyObject(uid)
+	if err != nil {
+		return "", err
+	}
+
+	keystore, err := crypto.EncryptKeystore(priv, encryptPassphrase)
+	if err != nil {
+		return "", err
+	}
+
+	return string(keystore), nil
+}
+
 // ExportPrivateKeyObject exports an armored private key object.
 func (ks keystore) ExportPrivateKeyObject(uid string) (types.PrivKey, error) {
 	k, err := ks.Key(uid)
@@ -333,6 +376,20 @@ func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 	return nil
 }
 
+func (ks keystore) ImportPrivKeyKeystore(uid, keystore, passphrase string) error {
+	if _, err := ks.Key(uid); err == nil {
+		return fmt.Errorf("cannot overwrite key: %s", uid)
+	}
+
+	privKey, err := crypto.DecryptKeystore([]byte(keystore), passphrase)
+	if err != nil {
+		return errors.Wrap(err, "failed to decrypt private key")
+	}
+
+	_, err = ks.writeLocalKey(uid, privKey)
+	return err
+}
+
 func (ks keystore) ImportPubKey(uid string, armor string) error {
 	if _, err := ks.Key(uid); err == nil {
 		return fmt.Errorf("cannot overwrite key: %s", uid)
@@ -390,6 +447,21 @@ func (ks keystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
 	}
 }
 
+// SignEIP712 signs the EIP-712 typed data with the Ethereum app for the ledger keys, the other keys
+// sign the digest of the typed data.
+func (ks keystore) SignEIP712(uid string, domainSeparator, messageHash []byte) ([]byte, types.PubKey, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if IsEthLedgerKey(k) {
+		return SignEIP712WithLedger(k, domainSeparator, messageHash)
+	}
+
+	return ks.Sign(uid, ledger.EIP712Digest(domainSeparator, messageHash))
+}
+
 func (ks keystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
 	k, err := ks.KeyByAddress(address)
 	if err != nil {
@@ -407,6 +479,17 @@ func (ks keystore) SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coi
 		)
 	}
 
+	// the eth_secp256k1 keys are kept by the Ethereum app, which derives them on the m/44'/60' path
+	if algo.Name() == hd.EthSecp256k1Type {
+		hdPath := hd.NewFundraiserParams(account, ledger.EthCoinType, index)
+		priv, _, err := ledger.NewPrivKeyEthSecp256k1(*hdPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate ledger key: %w", err)
+		}
+
+		return ks.writeLedgerKey(uid, priv.PubKey(), hdPath)
+	}
+
 	hdPath := hd.NewFundraiserParams(account, coinType, index)
 
 	priv, _, err := ledger.NewPrivKeySecp256k1(*hdPath, hrp)
@@ -595,6 +678,42 @@ func (ks keystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
 	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
 }
 
+// IsEthLedgerKey returns true if the key is an eth_secp256k1 key kept by the Ethereum app of a
+// ledger device, which only signs EIP-712 typed data.
+func IsEthLedgerKey(k *Record) bool {
+	if k.GetLedger() == nil {
+		return false
+	}
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return false
+	}
+	_, ok := pubKey.(*ethsecp256k1.PubKey)
+	return ok
+}
+
+// SignEIP712WithLedger signs the EIP-712 typed data with the Ethereum app of the ledger device
+// referenced by an Info object, the typed data is signed with the hash of the domain and the hash
+// of the message. It returns the signed bytes and the public key.
+func SignEIP712WithLedger(k *Record, domainSeparator, messageHash []byte) (sig []byte, pub types.PubKey, err error) {
+	ledgerInfo := k.GetLedger()
+	if ledgerInfo == nil {
+		return nil, nil, errors.New("not a ledger object")
+	}
+
+	priv, err := ledger.NewPrivKeyEthSecp256k1Unsafe(*ledgerInfo.GetPath())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err = priv.(ledger.PrivKeyLedgerEthSecp256k1).SignEIP712(domainSeparator, messageHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sig, priv.PubKey(), nil
+}
+
 // SignWithLedger signs a binary message with the ledger device referenced by an Info object
 // and returns the signed bytes and the public key. It returns an error if the device could
 // not be queried or it returned an error.
@@ -606,6 +725,14 @@ func SignWithLedger(k *Record, msg []byte) (sig []byte, pub types.PubKey, err er
 
 	path := ledgerInfo.GetPath()
 
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := pubKey.(*ethsecp256k1.PubKey); ok {
+		return nil, nil, errors.New("the Ledger Ethereum app only signs EIP-712 typed data, please sign with SIGN_MODE_EIP_712")
+	}
+
 	priv, err := ledger.NewPrivKeySecp256k1Unsafe(*path)
 	if err != nil {
 		return
@@ -8,9 +8,12 @@ import (
 	"strings"
 	"testing"
 
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -133,3 +136,47 @@ func TestAltKeyring_SaveLedgerKey(t *testing.T) {
 	path := ledgerInfo.GetPath()
 	require.Equal(t, "m/44'/118'/3'/0/1", path.String())
 }
+
+func TestSignEIP712KeyRingWithLedger(t *testing.T) {
+	dir := t.TempDir()
+	cdc := getCodec()
+
+	kb, err := New("keybasename", "test", dir, nil, cdc)
+	require.NoError(t, err)
+
+	// the eth_secp256k1 keys are kept by the Ethereum app on the m/44'/60' path
+	k, err := kb.SaveLedgerKey("key", hd.EthSecp256k1, "cosmos", 118, 0, 1)
+	if err != nil {
+		require.Equal(t, "failed to generate ledger key: failed to retrieve device: ledger nano S: support for ledger devices is not available in this executable", err.Error())
+		t.Skip("ledger nano S: support for ledger devices is not available in this executable")
+		return
+	}
+	require.Equal(t, "m/44'/60'/0'/0/1", k.GetLedger().GetPath().String())
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.IsType(t, &ethsecp256k1.PubKey{}, pub)
+
+	domainSeparator := ethcrypto.Keccak256([]byte("domain"))
+	messageHash := ethcrypto.Keccak256([]byte("message"))
+	digest := ledger.EIP712Digest(domainSeparator, messageHash)
+
+	// the Ethereum app only signs EIP-712 typed data
+	_, _, err = kb.Sign("key", digest)
+	require.Error(t, err)
+
+	signer, ok := kb.(EIP712Signer)
+	require.True(t, ok)
+	sig, pub2, err := signer.SignEIP712("key", domainSeparator, messageHash)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(pub2))
+	require.True(t, pub.(*ethsecp256k1.PubKey).VerifyDigestSignature(digest, sig))
+
+	// the local keys sign the digest of the typed data
+	local, _, err := kb.NewMnemonic("local", English, types.FullFundraiserPath, DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+	localPub, err := local.GetPubKey()
+	require.NoError(t, err)
+	sig, _, err = signer.SignEIP712("local", domainSeparator, messageHash)
+	require.NoError(t, err)
+	require.True(t, localPub.(*ethsecp256k1.PubKey).VerifyDigestSignature(digest, sig))
+}
@@ -0,0 +1,364 @@
+package keyring
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/ledger"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// keyringPolicyConfigName is the name of the policy config in the keyring directory, the keyrings
+// are wrapped by the policy keyring if it exists.
+const keyringPolicyConfigName = "keyring-policy.json"
+
+// rawSignMode is the sign mode in the audit log of the signing requests without a transaction,
+// e.g. the BLS votes of the relayers.
+const rawSignMode = "raw"
+
+var _ Keyring = &policyKeyring{}
+
+// SignRequest is the signing request of a transaction, which is checked against the usage policies
+// of the keys and recorded in the audit log.
+type SignRequest struct {
+	ChainID  string
+	SignMode signing.SignMode
+	Msgs     []sdk.Msg
+}
+
+// SignRequestBinder is implemented by the keyrings which check the signing requests of the
+// transactions, e.g. the policy keyring. The signing request of a transaction is bound to the
+// keyring before the sign bytes of the transaction are signed.
+type SignRequestBinder interface {
+	// WithSignRequest returns the keyring which signs the sign bytes of the transaction.
+	WithSignRequest(req SignRequest) Keyring
+}
+
+// SendMsg is implemented by the messages which send coins of the signer, e.g. the bank MsgSend and
+// MsgMultiSend. The sent coins are checked against the max send amounts of the key policies.
+type SendMsg interface {
+	sdk.Msg
+	GetSendAmount() sdk.Coins
+}
+
+// nestedMsgs is implemented by the messages which execute the nested messages on behalf of the
+// signer, e.g. the authz MsgExec, whose nested messages are checked as well.
+type nestedMsgs interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// PolicyConfig defines the config of the policy keyring, it is read from keyring-policy.json in the
+// keyring directory. The relative file paths are resolved against the keyring directory.
+type PolicyConfig struct {
+	// AuditLog is the append-only log file of the signing requests, the requests are not recorded
+	// if it is empty.
+	AuditLog string `json:"audit_log"`
+	// Policies are the usage policies of the keys by their names, the keys without a policy can
+	// sign anything.
+	Policies map[string]KeyPolicy `json:"policies"`
+}
+
+// KeyPolicy defines the usage policy of a key. The empty fields don't restrict the key.
+type KeyPolicy struct {
+	// AllowedMsgTypes are the type URLs of the messages allowed to be signed by the key.
+	AllowedMsgTypes []string `json:"allowed_msg_types"`
+	// MaxSendAmount is the max coins sent by the messages of a transaction, e.g. "1000000000BNB".
+	MaxSendAmount string `json:"max_send_amount"`
+	// AllowedChainIDs are the chain IDs of the transactions allowed to be signed by the key.
+	AllowedChainIDs []string `json:"allowed_chain_ids"`
+	// AllowRawSign allows the key to sign the bytes which are not the sign bytes of a transaction,
+	// e.g. the BLS votes of the relayers, they are not checked against the policy.
+	AllowRawSign bool `json:"allow_raw_sign"`
+}
+
+// keyPolicy is the parsed usage policy of a key.
+type keyPolicy struct {
+	allowedMsgTypes map[string]bool
+	maxSendAmount   sdk.Coins
+	allowedChainIDs map[string]bool
+	allowRawSign    bool
+}
+
+// auditRecord is a signing request recorded in the audit log.
+type auditRecord struct {
+	Time     time.Time `json:"time"`
+	Key      string    `json:"key"`
+	Address  string    `json:"address,omitempty"`
+	SignMode string    `json:"sign_mode"`
+	ChainID  string    `json:"chain_id,omitempty"`
+	MsgTypes []string  `json:"msg_types,omitempty"`
+	// Hash is the sha256 hash of the signed bytes.
+	Hash    string `json:"hash"`
+	Allowed bool   `json:"allowed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// auditLog appends the signing requests to the audit log file.
+type auditLog struct {
+	mtx  sync.Mutex
+	file *os.File
+}
+
+// policyKeyring wraps a keyring to enforce the usage policies of the keys and to record the signing
+// requests in the audit log.
+type policyKeyring struct {
+	Keyring
+
+	policies map[string]keyPolicy
+	log      *auditLog
+	// req is the signing request bound to the keyring, it's nil if the signed bytes are not the
+	// sign bytes of a transaction.
+	req *SignRequest
+}
+
+// NewPolicyKeyring returns a keyring which enforces the usage policies of the keys and records the
+// signing requests in the audit log.
+func NewPolicyKeyring(kr Keyring, config PolicyConfig) (Keyring, error) {
+	policies := make(map[string]keyPolicy, len(config.Policies))
+	for name, policy := range config.Policies {
+		p := keyPolicy{
+			allowedMsgTypes: make(map[string]bool, len(policy.AllowedMsgTypes)),
+			allowedChainIDs: make(map[string]bool, len(policy.AllowedChainIDs)),
+			allowRawSign:    policy.AllowRawSign,
+		}
+		for _, msgType := range policy.AllowedMsgTypes {
+			p.allowedMsgTypes[msgType] = true
+		}
+		for _, chainID := range policy.AllowedChainIDs {
+			p.allowedChainIDs[chainID] = true
+		}
+		if policy.MaxSendAmount != "" {
+			amount, err := sdk.ParseCoinsNormalized(policy.MaxSendAmount)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid max send amount of key %s", name)
+			}
+			p.maxSendAmount = amount
+		}
+		policies[name] = p
+	}
+
+	pk := policyKeyring{
+		Keyring:  kr,
+		policies: policies,
+	}
+	if config.AuditLog != "" {
+		file, err := os.OpenFile(config.AuditLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open the audit log")
+		}
+		pk.log = &auditLog{file: file}
+	}
+
+	return pk, nil
+}
+
+// newPolicyKeyringFromDir wraps the keyring with the policy keyring if the policy config exists in
+// the keyring directory.
+func newPolicyKeyringFromDir(kr Keyring, rootDir string) (Keyring, error) {
+	bz, err := os.ReadFile(filepath.Join(rootDir, keyringPolicyConfigName))
+	if os.IsNotExist(err) {
+		return kr, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the keyring policy config")
+	}
+
+	var config PolicyConfig
+	if err := json.Unmarshal(bz, &config); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the keyring policy config")
+	}
+	if config.AuditLog != "" && !filepath.IsAbs(config.AuditLog) {
+		config.AuditLog = filepath.Join(rootDir, config.AuditLog)
+	}
+
+	return NewPolicyKeyring(kr, config)
+}
+
+// WithSignRequest implements SignRequestBinder.
+func (ks policyKeyring) WithSignRequest(req SignRequest) Keyring {
+	ks.req = &req
+	return ks
+}
+
+func (ks policyKeyring) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.sign(k, msg, func() ([]byte, types.PubKey, error) {
+		return ks.Keyring.Sign(uid, msg)
+	})
+}
+
+func (ks policyKeyring) SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.sign(k, msg, func() ([]byte, types.PubKey, error) {
+		return ks.Keyring.SignByAddress(address, msg)
+	})
+}
+
+// SignEIP712 implements EIP712Signer if the wrapped keyring implements it, the digest of the typed
+// data is checked and recorded.
+func (ks policyKeyring) SignEIP712(uid string, domainSeparator, messageHash []byte) ([]byte, types.PubKey, error) {
+	signer, ok := ks.Keyring.(EIP712Signer)
+	if !ok {
+		return nil, nil, errors.New("the keyring doesn't support signing EIP-712 typed data")
+	}
+
+	k, err := ks.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.sign(k, ledger.EIP712Digest(domainSeparator, messageHash), func() ([]byte, types.PubKey, error) {
+		return signer.SignEIP712(uid, domainSeparator, messageHash)
+	})
+}
+
+// ExportPrivateKeyObject exports the private key of the wrapped keyring, if it supports exporting
+// the private keys.
+func (ks policyKeyring) ExportPrivateKeyObject(uid string) (types.PrivKey, error) {
+	exporter, ok := ks.Keyring.(interface {
+		ExportPrivateKeyObject(uid string) (types.PrivKey, error)
+	})
+	if !ok {
+		return nil, errors.New("the keyring doesn't support exporting private keys")
+	}
+
+	return exporter.ExportPrivateKeyObject(uid)
+}
+
+// sign checks the signing request of the key against its policy and records it in the audit log,
+// the bytes are signed by signFn if the request is allowed.
+func (ks policyKeyring) sign(k *Record, bz []byte, signFn func() ([]byte, types.PubKey, error)) ([]byte, types.PubKey, error) {
+	hash := sha256.Sum256(bz)
+	record := auditRecord{
+		Time:     time.Now().UTC(),
+		Key:      k.Name,
+		SignMode: rawSignMode,
+		Hash:     hex.EncodeToString(hash[:]),
+	}
+	if addr, err := k.GetAddress(); err == nil {
+		record.Address = addr.String()
+	}
+	if ks.req != nil {
+		record.SignMode = ks.req.SignMode.String()
+		record.ChainID = ks.req.ChainID
+		for _, msg := range ks.req.Msgs {
+			record.MsgTypes = append(record.MsgTypes, sdk.MsgTypeURL(msg))
+		}
+	}
+
+	var (
+		sig    []byte
+		pubKey types.PubKey
+	)
+	err := ks.checkPolicy(k.Name)
+	if err == nil {
+		record.Allowed = true
+		sig, pubKey, err = signFn()
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	if logErr := ks.log.append(record); logErr != nil {
+		return nil, nil, errors.Wrap(logErr, "failed to record the signing request in the audit log")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sig, pubKey, nil
+}
+
+// checkPolicy checks the signing request bound to the keyring against the policy of the key.
+func (ks policyKeyring) checkPolicy(uid string) error {
+	policy, ok := ks.policies[uid]
+	if !ok {
+		return nil
+	}
+
+	if ks.req == nil {
+		if !policy.allowRawSign {
+			return errors.Wrapf(ErrSignRequestDenied, "key %s is not allowed to sign the bytes which are not a transaction", uid)
+		}
+		return nil
+	}
+
+	if len(policy.allowedChainIDs) != 0 && !policy.allowedChainIDs[ks.req.ChainID] {
+		return errors.Wrapf(ErrSignRequestDenied, "key %s is not allowed to sign on chain %s", uid, ks.req.ChainID)
+	}
+
+	var sent sdk.Coins
+	if err := policy.checkMsgs(uid, ks.req.Msgs, &sent); err != nil {
+		return err
+	}
+	if !policy.maxSendAmount.Empty() && !sent.IsAllLTE(policy.maxSendAmount) {
+		return errors.Wrapf(ErrSignRequestDenied, "key %s is not allowed to send %s, the max send amount is %s", uid, sent, policy.maxSendAmount)
+	}
+
+	return nil
+}
+
+// checkMsgs checks the types of the messages and their nested messages, and sums the coins sent by
+// them.
+func (p keyPolicy) checkMsgs(uid string, msgs []sdk.Msg, sent *sdk.Coins) error {
+	for _, msg := range msgs {
+		msgType := sdk.MsgTypeURL(msg)
+		if len(p.allowedMsgTypes) != 0 && !p.allowedMsgTypes[msgType] {
+			return errors.Wrapf(ErrSignRequestDenied, "key %s is not allowed to sign %s", uid, msgType)
+		}
+
+		if sendMsg, ok := msg.(SendMsg); ok {
+			*sent = sent.Add(sendMsg.GetSendAmount()...)
+		}
+
+		if nested, ok := msg.(nestedMsgs); ok {
+			nestedMsgs, err := nested.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := p.checkMsgs(uid, nestedMsgs, sent); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// append appends the record to the audit log, and syncs the log to the disk before the signature
+// is returned. It's a no-op if the audit log is not configured.
+func (l *auditLog) append(record auditRecord) error {
+	if l == nil {
+		return nil
+	}
+
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if _, err := l.file.Write(append(bz, '\n')); err != nil {
+		return err
+	}
+	return l.file.Sync()
+}
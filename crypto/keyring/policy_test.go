@@ -0,0 +1,155 @@
+package keyring
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// testSendMsg is a message which sends coins of the signer.
+type testSendMsg struct {
+	*testdata.TestMsg
+	amount sdk.Coins
+}
+
+func (msg testSendMsg) GetSendAmount() sdk.Coins { return msg.amount }
+
+// testExecMsg is a message which executes the nested messages.
+type testExecMsg struct {
+	*testdata.MsgCreateDog
+	msgs []sdk.Msg
+}
+
+func (msg testExecMsg) GetMessages() ([]sdk.Msg, error) { return msg.msgs, nil }
+
+func TestPolicyKeyring(t *testing.T) {
+	dir := t.TempDir()
+	kb, err := New("keybasename", BackendTest, dir, nil, getCodec())
+	require.NoError(t, err)
+	_, isPolicyKeyring := kb.(SignRequestBinder)
+	require.False(t, isPolicyKeyring)
+
+	for _, name := range []string{"relayer", "relayer-bls", "other"} {
+		var algo SignatureAlgo = hd.EthSecp256k1
+		if name == "relayer-bls" {
+			algo = hd.EthBLS
+		}
+		_, _, err := kb.NewMnemonic(name, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, algo)
+		require.NoError(t, err)
+	}
+
+	config := PolicyConfig{
+		AuditLog: "audit.log",
+		Policies: map[string]KeyPolicy{
+			"relayer": {
+				// the test wrappers of the messages aren't registered, whose type URLs are "/"
+				AllowedMsgTypes: []string{sdk.MsgTypeURL(&testdata.TestMsg{}), "/"},
+				MaxSendAmount:   "100BNB",
+				AllowedChainIDs: []string{"greenfield_1017-1"},
+			},
+			"relayer-bls": {
+				AllowRawSign: true,
+			},
+		},
+	}
+	bz, err := json.Marshal(config)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, keyringPolicyConfigName), bz, 0o600))
+
+	kb, err = New("keybasename", BackendTest, dir, nil, getCodec())
+	require.NoError(t, err)
+	binder, ok := kb.(SignRequestBinder)
+	require.True(t, ok)
+	msg := []byte("sign bytes")
+
+	newRequest := func(chainID string, msgs ...sdk.Msg) Keyring {
+		return binder.WithSignRequest(SignRequest{
+			ChainID:  chainID,
+			SignMode: signing.SignMode_SIGN_MODE_EIP_712,
+			Msgs:     msgs,
+		})
+	}
+	send := func(amount string) sdk.Msg {
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		require.NoError(t, err)
+		return testSendMsg{TestMsg: testdata.NewTestMsg(), amount: coins}
+	}
+
+	testCases := []struct {
+		name   string
+		kb     Keyring
+		uid    string
+		denied bool
+	}{
+		{"allowed msgs", newRequest("greenfield_1017-1", testdata.NewTestMsg(), send("60BNB"), send("40BNB")), "relayer", false},
+		{"msg type not allowed", newRequest("greenfield_1017-1", &testdata.MsgCreateDog{}), "relayer", true},
+		{"nested msg type not allowed", newRequest("greenfield_1017-1", testExecMsg{&testdata.MsgCreateDog{}, []sdk.Msg{&testdata.MsgCreateDog{}}}), "relayer", true},
+		{"max send amount exceeded", newRequest("greenfield_1017-1", send("60BNB"), send("41BNB")), "relayer", true},
+		{"chain id not allowed", newRequest("greenfield_9000-1", testdata.NewTestMsg()), "relayer", true},
+		{"raw sign not allowed", kb, "relayer", true},
+		{"raw sign allowed", kb, "relayer-bls", false},
+		{"bls key signs transactions", newRequest("greenfield_9000-1", testdata.NewTestMsg()), "relayer-bls", false},
+		{"no policy", newRequest("greenfield_9000-1", &testdata.MsgCreateDog{}, send("1000BNB")), "other", false},
+		{"no policy raw sign", kb, "other", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sig, _, err := tc.kb.Sign(tc.uid, msg)
+			if tc.denied {
+				require.ErrorIs(t, err, ErrSignRequestDenied)
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, sig)
+		})
+	}
+
+	// the sends of the nested messages are summed as well
+	_, _, err = newRequest("greenfield_1017-1", testExecMsg{&testdata.MsgCreateDog{}, []sdk.Msg{send("101BNB")}}).Sign("other", msg)
+	require.NoError(t, err)
+
+	// the requests are recorded in the audit log
+	file, err := os.Open(filepath.Join(dir, "audit.log"))
+	require.NoError(t, err)
+	defer file.Close()
+
+	hash := sha256.Sum256(msg)
+	var records []auditRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record auditRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.Len(t, records, len(testCases)+1)
+	for i, tc := range testCases {
+		require.Equal(t, tc.uid, records[i].Key)
+		require.Equal(t, !tc.denied, records[i].Allowed, tc.name)
+		require.Equal(t, tc.denied, records[i].Error != "", tc.name)
+		require.Equal(t, hex.EncodeToString(hash[:]), records[i].Hash)
+	}
+	require.Equal(t, "SIGN_MODE_EIP_712", records[0].SignMode)
+	require.Equal(t, "greenfield_1017-1", records[0].ChainID)
+	require.Equal(t, []string{sdk.MsgTypeURL(&testdata.TestMsg{}), "/", "/"}, records[0].MsgTypes)
+	require.Equal(t, rawSignMode, records[5].SignMode)
+	require.Empty(t, records[5].MsgTypes)
+
+	// invalid policies
+	_, err = NewPolicyKeyring(kb, PolicyConfig{Policies: map[string]KeyPolicy{"relayer": {MaxSendAmount: "invalid"}}})
+	require.Error(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, keyringPolicyConfigName), []byte("invalid"), 0o600))
+	_, err = New("keybasename", BackendTest, dir, nil, getCodec())
+	require.Error(t, err)
+}
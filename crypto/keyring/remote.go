@@ -0,0 +1,335 @@
+package keyring
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	keyringRemoteDirName    = "keyring-remote"
+	keyringRemoteConfigName = "config.json"
+
+	// remoteSignerTimeout is the timeout of the requests to the remote signer.
+	remoteSignerTimeout = 10 * time.Second
+)
+
+var _ Keyring = &remoteKeystore{}
+
+// RemoteSignerConfig defines the config of the remote signer used by the remote backend, it is read
+// from keyring-remote/config.json in the keyring directory. The relative file paths are resolved
+// against the keyring-remote directory.
+type RemoteSignerConfig struct {
+	// Address is the gRPC address of the remote signer.
+	Address string `json:"address"`
+	// ServerName is the name of the remote signer in its certificate, the host of the address is
+	// used if it is empty.
+	ServerName string `json:"server_name"`
+	// CAFile is the CA certificate which signs the certificates of the remote signer and the client.
+	CAFile string `json:"ca_file"`
+	// CertFile is the client certificate presented to the remote signer.
+	CertFile string `json:"cert_file"`
+	// KeyFile is the private key of the client certificate.
+	KeyFile string `json:"key_file"`
+}
+
+// remoteKeystore is a keyring backed by a remote signer, the private keys never leave the remote
+// signer, only the public keys are looked up and the messages are signed remotely.
+type remoteKeystore struct {
+	client  remotesigner.RemoteSignerClient
+	cdc     codec.Codec
+	options Options
+}
+
+// NewRemote returns a keyring which forwards the key lookups and the signing requests to the
+// remote signer. The keys are managed by the remote signer, so the keyring is read-only.
+func NewRemote(client remotesigner.RemoteSignerClient, cdc codec.Codec, opts ...Option) Keyring {
+	options := Options{
+		SupportedAlgos:       SigningAlgoList{hd.EthSecp256k1, hd.EthBLS, hd.Secp256k1},
+		SupportedAlgosLedger: SigningAlgoList{hd.EthSecp256k1, hd.EthBLS, hd.Secp256k1},
+	}
+
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	return remoteKeystore{
+		client:  client,
+		cdc:     cdc,
+		options: options,
+	}
+}
+
+// newRemoteBackend connects to the remote signer configured in the keyring directory with mutual TLS.
+func newRemoteBackend(rootDir string, cdc codec.Codec, opts ...Option) (Keyring, error) {
+	dir := filepath.Join(rootDir, keyringRemoteDirName)
+	bz, err := os.ReadFile(filepath.Join(dir, keyringRemoteConfigName))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the remote signer config")
+	}
+
+	var config RemoteSignerConfig
+	if err := json.Unmarshal(bz, &config); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the remote signer config")
+	}
+	if config.Address == "" {
+		return nil, errors.New("remote signer address is empty")
+	}
+
+	readFile := func(name string) ([]byte, error) {
+		if !filepath.IsAbs(name) {
+			name = filepath.Join(dir, name)
+		}
+		return os.ReadFile(name)
+	}
+	caPEM, err := readFile(config.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	certPEM, err := readFile(config.CertFile)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := readFile(config.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := remotesigner.NewClientTLSConfig(caPEM, certPEM, keyPEM, config.ServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(config.Address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRemote(remotesigner.NewRemoteSignerClient(conn), cdc, opts...), nil
+}
+
+// Backend returns the keyring backend option used in the config
+func (ks remoteKeystore) Backend() string {
+	return BackendRemote
+}
+
+func (ks remoteKeystore) List() ([]*Record, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Keys(ctx, &remotesigner.KeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*Record, 0, len(res.Keys))
+	for _, key := range res.Keys {
+		record, err := ks.newRecord(key)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (*Record, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Key(ctx, &remotesigner.KeyRequest{Name: uid})
+	if err != nil {
+		return nil, wrapRemoteSignerErr(err)
+	}
+
+	return ks.newRecord(res.Key)
+}
+
+func (ks remoteKeystore) KeyByAddress(address sdk.Address) (*Record, error) {
+	records, err := ks.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		addr, err := record.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+		if addr.Equals(address) {
+			return record, nil
+		}
+	}
+
+	return nil, sdkerrors.ErrKeyNotFound.Wrapf("key with address %s not found", address.String())
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Sign(ctx, &remotesigner.SignRequest{Name: uid, Msg: msg})
+	if err != nil {
+		return nil, nil, wrapRemoteSignerErr(err)
+	}
+
+	pubKey, err := ks.unpackPubKey(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res.Signature, pubKey, nil
+}
+
+func (ks remoteKeystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.Sign(k.Name, msg)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := k.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := ks.cdc.MarshalInterface(key)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(bz, key.Type()), nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.ExportPubKeyArmor(k.Name)
+}
+
+// MigrateAll returns the keys of the remote signer, which are never stored in the legacy format.
+func (ks remoteKeystore) MigrateAll() ([]*Record, error) {
+	return ks.List()
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return ErrRemoteSignerReadOnly
+}
+
+func (ks remoteKeystore) DeleteByAddress(sdk.Address) error {
+	return ErrRemoteSignerReadOnly
+}
+
+func (ks remoteKeystore) Rename(string, string) error {
+	return ErrRemoteSignerReadOnly
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", ErrRemoteSignerReadOnly
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, ErrRemoteSignerReadOnly
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, ErrRemoteSignerReadOnly
+}
+
+func (ks remoteKeystore) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteSignerReadOnly
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteSignerReadOnly
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return ErrRemoteSignerReadOnly
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return ErrRemoteSignerReadOnly
+}
+
+func (ks remoteKeystore) ImportPrivKeyKeystore(string, string, string) error {
+	return ErrRemoteSignerReadOnly
+}
+
+func (ks remoteKeystore) ExportPrivKeyKeystore(string, string) (string, error) {
+	return "", ErrRemoteSignerReadOnly
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", ErrRemoteSignerReadOnly
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", ErrRemoteSignerReadOnly
+}
+
+// newRecord returns an offline record of the key of the remote signer, so that the private key is
+// never expected to be found locally.
+func (ks remoteKeystore) newRecord(key *remotesigner.KeyInfo) (*Record, error) {
+	if key == nil {
+		return nil, errors.New("remote signer returned an empty key")
+	}
+
+	pubKey, err := ks.unpackPubKey(key.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOfflineRecord(key.Name, pubKey)
+}
+
+func (ks remoteKeystore) unpackPubKey(any *codectypes.Any) (types.PubKey, error) {
+	var pubKey types.PubKey
+	if err := ks.cdc.UnpackAny(any, &pubKey); err != nil {
+		return nil, errors.Wrap(err, "remote signer returned an invalid public key")
+	}
+
+	return pubKey, nil
+}
+
+// wrapRemoteSignerErr converts the not found errors of the remote signer to ErrKeyNotFound.
+func wrapRemoteSignerErr(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return sdkerrors.ErrKeyNotFound.Wrap(status.Convert(err).Message())
+	}
+
+	return err
+}
@@ -0,0 +1,140 @@
+package keyring
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestRemoteKeyring(t *testing.T) {
+	cdc := getCodec()
+
+	ethKey, err := ethsecp256k1.GenPrivKey()
+	require.NoError(t, err)
+	blsKey, err := bls.GenPrivKey()
+	require.NoError(t, err)
+	otherKey, err := ethsecp256k1.GenPrivKey()
+	require.NoError(t, err)
+
+	server := remotesigner.NewServer()
+	server.AddKey("relayer", ethKey, remotesigner.KeyPolicy{AllowedClients: []string{"relayer"}})
+	server.AddKey("relayer-bls", blsKey, remotesigner.KeyPolicy{AllowedClients: []string{"relayer"}, AllowedMsgSizes: []uint64{32}})
+	server.AddKey("other", otherKey, remotesigner.KeyPolicy{AllowedClients: []string{"other"}})
+
+	certs := testutil.NewCertificates(t, "relayer")
+	client, stop, err := server.ServeInProcess(certs.ServerTLSConfig(t), certs.ClientTLSConfig(t, "relayer"))
+	require.NoError(t, err)
+	defer stop()
+
+	kr := NewRemote(client, cdc)
+	require.Equal(t, BackendRemote, kr.Backend())
+
+	// only the keys allowed by the policies are listed
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, "relayer", records[0].Name)
+	require.Equal(t, "relayer-bls", records[1].Name)
+	require.Equal(t, TypeOffline, records[0].GetType())
+
+	record, err := kr.Key("relayer")
+	require.NoError(t, err)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, ethKey.PubKey().Equals(pubKey))
+
+	_, err = kr.Key("other")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	addr := sdk.AccAddress(ethKey.PubKey().Address())
+	record, err = kr.KeyByAddress(addr)
+	require.NoError(t, err)
+	require.Equal(t, "relayer", record.Name)
+	_, err = kr.KeyByAddress(sdk.AccAddress(otherKey.PubKey().Address()))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// sign remotely
+	msg := []byte("message to sign")
+	sig, pub, err := kr.Sign("relayer", msg)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(ethKey.PubKey()))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	sig, pub, err = kr.SignByAddress(addr, msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	digest := make([]byte, 32)
+	sig, pub, err = kr.Sign("relayer-bls", digest)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(blsKey.PubKey()))
+	require.True(t, pub.VerifySignature(digest, sig))
+
+	_, _, err = kr.Sign("relayer-bls", msg)
+	require.Error(t, err)
+	_, _, err = kr.Sign("other", msg)
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	armor, err := kr.ExportPubKeyArmor("relayer")
+	require.NoError(t, err)
+	require.NotEmpty(t, armor)
+
+	// the keys are managed by the remote signer
+	_, _, err = kr.NewMnemonic("new", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.ErrorIs(t, err, ErrRemoteSignerReadOnly)
+	require.ErrorIs(t, kr.Delete("relayer"), ErrRemoteSignerReadOnly)
+	_, err = kr.ExportPrivKeyArmor("relayer", "passphrase")
+	require.ErrorIs(t, err, ErrRemoteSignerReadOnly)
+}
+
+func TestNewRemoteBackend(t *testing.T) {
+	cdc := getCodec()
+	dir := t.TempDir()
+
+	// the config is required
+	_, err := New(t.Name(), BackendRemote, dir, nil, cdc)
+	require.Error(t, err)
+
+	certs := testutil.NewCertificates(t, "relayer")
+	remoteDir := filepath.Join(dir, keyringRemoteDirName)
+	require.NoError(t, os.MkdirAll(remoteDir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(remoteDir, "ca.pem"), certs.CAPEM, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(remoteDir, "client.pem"), certs.Clients["relayer"].CertPEM, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(remoteDir, "client.key"), certs.Clients["relayer"].KeyPEM, 0o600))
+
+	writeConfig := func(config RemoteSignerConfig) {
+		bz, err := json.Marshal(config)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(remoteDir, keyringRemoteConfigName), bz, 0o600))
+	}
+
+	writeConfig(RemoteSignerConfig{CAFile: "ca.pem", CertFile: "client.pem", KeyFile: "client.key"})
+	_, err = New(t.Name(), BackendRemote, dir, nil, cdc)
+	require.ErrorContains(t, err, "address is empty")
+
+	writeConfig(RemoteSignerConfig{Address: "localhost:9999", CAFile: "ca.pem", CertFile: "missing.pem", KeyFile: "client.key"})
+	_, err = New(t.Name(), BackendRemote, dir, nil, cdc)
+	require.Error(t, err)
+
+	writeConfig(RemoteSignerConfig{
+		Address:    "localhost:9999",
+		ServerName: testutil.ServerName,
+		CAFile:     "ca.pem",
+		CertFile:   "client.pem",
+		KeyFile:    filepath.Join(remoteDir, "client.key"),
+	})
+	kr, err := New(t.Name(), BackendRemote, dir, nil, cdc)
+	require.NoError(t, err)
+	require.Equal(t, BackendRemote, kr.Backend())
+}
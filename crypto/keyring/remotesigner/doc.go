@@ -0,0 +1,9 @@
+// Package remotesigner defines the protobuf API of the remote signers used by the remote keyring
+// backend, e.g. a KMS which keeps the ethsecp256k1 and BLS keys of the relayers of a validator.
+//
+// The clients of a remote signer are authenticated with mutual TLS, the common name of the client
+// certificate identifies the client in the KeyPolicy of every key.
+//
+// The Server type is a reference in-memory implementation of the RemoteSigner service, which can
+// be served in-process so that the remote keyring backend can be tested without network access.
+package remotesigner
@@ -0,0 +1,53 @@
+package remotesigner
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// inProcessBufSize is the buffer size of the in-memory listener of the in-process server.
+const inProcessBufSize = 1024 * 1024
+
+// ServeInProcess serves the server over an in-memory listener and returns a client connected to
+// it, so that the remote signer can be used without network access, e.g. in tests. The connection
+// is authenticated with mutual TLS if the TLS configs are provided, otherwise it is insecure. The
+// returned function stops the server and closes the connection.
+func (s *Server) ServeInProcess(serverTLS, clientTLS *tls.Config) (RemoteSignerClient, func(), error) {
+	listener := bufconn.Listen(inProcessBufSize)
+
+	var serverOpts []grpc.ServerOption
+	clientCreds := insecure.NewCredentials()
+	if serverTLS != nil && clientTLS != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(serverTLS)))
+		clientCreds = credentials.NewTLS(clientTLS)
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
+	RegisterRemoteSignerServer(grpcServer, s)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(clientCreds),
+	)
+	if err != nil {
+		grpcServer.Stop()
+		return nil, nil, err
+	}
+
+	return NewRemoteSignerClient(conn), func() {
+		_ = conn.Close()
+		grpcServer.Stop()
+	}, nil
+}
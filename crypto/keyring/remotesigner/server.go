@@ -0,0 +1,181 @@
+package remotesigner
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ RemoteSignerServer = &Server{}
+
+type signerKey struct {
+	priv   cryptotypes.PrivKey
+	policy KeyPolicy
+}
+
+// Server is a reference implementation of the RemoteSigner service which keeps the private keys
+// in memory. It should only be used for testing purposes.
+type Server struct {
+	mtx  sync.RWMutex
+	keys map[string]signerKey
+}
+
+// NewServer creates a new Server without any keys.
+func NewServer() *Server {
+	return &Server{keys: make(map[string]signerKey)}
+}
+
+// AddKey adds a private key with its policy to the server, the key with the same name is replaced.
+func (s *Server) AddKey(name string, priv cryptotypes.PrivKey, policy KeyPolicy) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.keys[name] = signerKey{priv: priv, policy: policy}
+}
+
+// Keys implements the RemoteSignerServer interface.
+func (s *Server) Keys(ctx context.Context, _ *KeysRequest) (*KeysResponse, error) {
+	client := clientName(ctx)
+
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	names := make([]string, 0, len(s.keys))
+	for name, key := range s.keys {
+		if key.policy.AllowsClient(client) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	keys := make([]*KeyInfo, 0, len(names))
+	for _, name := range names {
+		info, err := newKeyInfo(name, s.keys[name].priv.PubKey())
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, info)
+	}
+
+	return &KeysResponse{Keys: keys}, nil
+}
+
+// Key implements the RemoteSignerServer interface.
+func (s *Server) Key(ctx context.Context, req *KeyRequest) (*KeyResponse, error) {
+	key, err := s.key(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := newKeyInfo(req.Name, key.priv.PubKey())
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyResponse{Key: info}, nil
+}
+
+// Sign implements the RemoteSignerServer interface.
+func (s *Server) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	key, err := s.key(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !key.policy.AllowsMsgSize(uint64(len(req.Msg))) {
+		return nil, status.Errorf(codes.PermissionDenied, "key %s is not allowed to sign messages of %d bytes", req.Name, len(req.Msg))
+	}
+
+	sig, err := key.priv.Sign(req.Msg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pubKey, err := codectypes.NewAnyWithValue(key.priv.PubKey())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SignResponse{Signature: sig, PubKey: pubKey}, nil
+}
+
+// key returns the key with the name if the client is allowed to use it. The keys not allowed to
+// use are reported as not found, so that the clients can't probe the keys of the others.
+func (s *Server) key(ctx context.Context, name string) (signerKey, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	key, ok := s.keys[name]
+	if !ok || !key.policy.AllowsClient(clientName(ctx)) {
+		return signerKey{}, status.Errorf(codes.NotFound, "key %s not found", name)
+	}
+
+	return key, nil
+}
+
+// AllowsClient returns true if the client with the common name is allowed to use the key.
+func (p KeyPolicy) AllowsClient(client string) bool {
+	if len(p.AllowedClients) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedClients {
+		if client != "" && allowed == client {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllowsMsgSize returns true if the key is allowed to sign messages of the size.
+func (p KeyPolicy) AllowsMsgSize(size uint64) bool {
+	if p.MaxMsgSize != 0 && size > p.MaxMsgSize {
+		return false
+	}
+
+	if len(p.AllowedMsgSizes) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedMsgSizes {
+		if allowed == size {
+			return true
+		}
+	}
+
+	return false
+}
+
+// clientName returns the common name of the verified client certificate of the request, or an empty
+// string if the client is not authenticated with mutual TLS.
+func clientName(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+}
+
+func newKeyInfo(name string, pubKey cryptotypes.PubKey) (*KeyInfo, error) {
+	any, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &KeyInfo{Name: name, PubKey: any}, nil
+}
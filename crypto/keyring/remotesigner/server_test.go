@@ -0,0 +1,118 @@
+package remotesigner_test
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func newTestServer(t *testing.T) (*remotesigner.Server, cryptotypes.PrivKey, cryptotypes.PrivKey) {
+	ethKey, err := ethsecp256k1.GenPrivKey()
+	require.NoError(t, err)
+	blsKey, err := bls.GenPrivKey()
+	require.NoError(t, err)
+	sharedKey, err := ethsecp256k1.GenPrivKey()
+	require.NoError(t, err)
+
+	server := remotesigner.NewServer()
+	server.AddKey("relayer", ethKey, remotesigner.KeyPolicy{AllowedClients: []string{"relayer"}})
+	server.AddKey("relayer-bls", blsKey, remotesigner.KeyPolicy{
+		AllowedClients:  []string{"relayer"},
+		AllowedMsgSizes: []uint64{32},
+	})
+	server.AddKey("shared", sharedKey, remotesigner.KeyPolicy{MaxMsgSize: 64})
+	return server, ethKey, blsKey
+}
+
+func TestServer(t *testing.T) {
+	server, ethKey, blsKey := newTestServer(t)
+	certs := testutil.NewCertificates(t, "relayer", "other")
+
+	serve := func(client string) remotesigner.RemoteSignerClient {
+		c, stop, err := server.ServeInProcess(certs.ServerTLSConfig(t), certs.ClientTLSConfig(t, client))
+		require.NoError(t, err)
+		t.Cleanup(stop)
+		return c
+	}
+	ctx := context.Background()
+
+	// the relayer can use all the keys
+	relayer := serve("relayer")
+	keys, err := relayer.Keys(ctx, &remotesigner.KeysRequest{})
+	require.NoError(t, err)
+	require.Len(t, keys.Keys, 3)
+	require.Equal(t, "relayer", keys.Keys[0].Name)
+	require.Equal(t, "relayer-bls", keys.Keys[1].Name)
+	require.Equal(t, "shared", keys.Keys[2].Name)
+
+	msg := []byte("message to sign")
+	res, err := relayer.Sign(ctx, &remotesigner.SignRequest{Name: "relayer", Msg: msg})
+	require.NoError(t, err)
+	require.True(t, ethKey.PubKey().VerifySignature(msg, res.Signature))
+
+	digest := make([]byte, 32)
+	res, err = relayer.Sign(ctx, &remotesigner.SignRequest{Name: "relayer-bls", Msg: digest})
+	require.NoError(t, err)
+	require.True(t, blsKey.PubKey().VerifySignature(digest, res.Signature))
+
+	// the bls key only signs digests
+	_, err = relayer.Sign(ctx, &remotesigner.SignRequest{Name: "relayer-bls", Msg: msg})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the shared key only signs short messages
+	_, err = relayer.Sign(ctx, &remotesigner.SignRequest{Name: "shared", Msg: make([]byte, 65)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = relayer.Key(ctx, &remotesigner.KeyRequest{Name: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// the other client can only use the shared key
+	other := serve("other")
+	keys, err = other.Keys(ctx, &remotesigner.KeysRequest{})
+	require.NoError(t, err)
+	require.Len(t, keys.Keys, 1)
+	require.Equal(t, "shared", keys.Keys[0].Name)
+
+	_, err = other.Key(ctx, &remotesigner.KeyRequest{Name: "relayer"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = other.Sign(ctx, &remotesigner.SignRequest{Name: "relayer", Msg: msg})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServerRequiresClientCertificate(t *testing.T) {
+	server, _, _ := newTestServer(t)
+	certs := testutil.NewCertificates(t, "relayer")
+
+	clientTLS := certs.ClientTLSConfig(t, "relayer")
+	clientTLS.Certificates = []tls.Certificate{}
+	client, stop, err := server.ServeInProcess(certs.ServerTLSConfig(t), clientTLS)
+	require.NoError(t, err)
+	defer stop()
+
+	_, err = client.Keys(context.Background(), &remotesigner.KeysRequest{})
+	require.Error(t, err)
+}
+
+func TestServerInsecure(t *testing.T) {
+	server, _, _ := newTestServer(t)
+
+	client, stop, err := server.ServeInProcess(nil, nil)
+	require.NoError(t, err)
+	defer stop()
+
+	// the unauthenticated clients can only use the keys without allowed clients
+	keys, err := client.Keys(context.Background(), &remotesigner.KeysRequest{})
+	require.NoError(t, err)
+	require.Len(t, keys.Keys, 1)
+	require.Equal(t, "shared", keys.Keys[0].Name)
+}
@@ -0,0 +1,107 @@
+package testutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner"
+)
+
+// ServerName is the name of the remote signer in the test certificates.
+const ServerName = "remote-signer"
+
+// Certificate is a PEM encoded certificate with its private key.
+type Certificate struct {
+	CertPEM []byte
+	KeyPEM  []byte
+}
+
+// Certificates are the test certificates of a remote signer and its clients signed by a test CA.
+type Certificates struct {
+	CAPEM   []byte
+	Server  Certificate
+	Clients map[string]Certificate
+}
+
+// NewCertificates generates a test CA, the certificate of the remote signer and the certificates of
+// the clients with the given common names.
+func NewCertificates(t testing.TB, clients ...string) *Certificates {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	serial := int64(1)
+	issue := func(commonName string, usage x509.ExtKeyUsage, dnsNames []string) Certificate {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		serial++
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: commonName},
+			DNSNames:     dnsNames,
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+
+		return Certificate{
+			CertPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+			KeyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		}
+	}
+
+	certs := &Certificates{
+		CAPEM:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		Server:  issue(ServerName, x509.ExtKeyUsageServerAuth, []string{ServerName}),
+		Clients: make(map[string]Certificate, len(clients)),
+	}
+	for _, client := range clients {
+		certs.Clients[client] = issue(client, x509.ExtKeyUsageClientAuth, nil)
+	}
+
+	return certs
+}
+
+// ServerTLSConfig returns the TLS config of the remote signer.
+func (c *Certificates) ServerTLSConfig(t testing.TB) *tls.Config {
+	config, err := remotesigner.NewServerTLSConfig(c.CAPEM, c.Server.CertPEM, c.Server.KeyPEM)
+	require.NoError(t, err)
+	return config
+}
+
+// ClientTLSConfig returns the TLS config of the client with the common name.
+func (c *Certificates) ClientTLSConfig(t testing.TB, client string) *tls.Config {
+	cert, ok := c.Clients[client]
+	require.True(t, ok, "unknown client %s", client)
+	config, err := remotesigner.NewClientTLSConfig(c.CAPEM, cert.CertPEM, cert.KeyPEM, ServerName)
+	require.NoError(t, err)
+	return config
+}
@@ -0,0 +1,82 @@
+package bls
+
+import (
+	"errors"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/willf/bitset"
+)
+
+// DigestLength is the length of the digests signed by the validators, e.g. the bls sign bytes
+// of the cross chain claims.
+const DigestLength = 32
+
+// AggregateSignatures aggregates the given BLS signatures into one signature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+
+	signatures, err := bls.MultipleSignaturesFromBytes(sigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return bls.AggregateSignatures(signatures).Marshal(), nil
+}
+
+// FastAggregateVerify verifies the aggregated signature of the message signed by all the given public
+// keys. The message is verified with FastAggregateVerify as the cross chain claims are if it is a 32
+// bytes digest, otherwise it is verified against the aggregated public key, which is equivalent.
+func FastAggregateVerify(pubKeys [][]byte, msg, sig []byte) (bool, error) {
+	if len(pubKeys) == 0 {
+		return false, errors.New("no public keys to verify")
+	}
+
+	signature, err := bls.SignatureFromBytes(sig)
+	if err != nil {
+		return false, err
+	}
+
+	keys := make([]bls.PublicKey, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		key, err := bls.PublicKeyFromBytes(pubKey)
+		if err != nil {
+			return false, err
+		}
+		keys = append(keys, key)
+	}
+
+	if len(msg) == DigestLength {
+		var digest [DigestLength]byte
+		copy(digest[:], msg)
+		return signature.FastAggregateVerify(keys, digest), nil
+	}
+
+	aggPubKey, err := bls.AggregatePublicKeys(pubKeys)
+	if err != nil {
+		return false, err
+	}
+	return signature.Verify(aggPubKey, msg), nil
+}
+
+// VotedPubKeys returns the public keys of the voters in the vote address set, which is a bitset over
+// the given public keys in the same format as the vote address set of the cross chain claims.
+func VotedPubKeys(pubKeys [][]byte, voteAddressSet []uint64) ([][]byte, error) {
+	votes := bitset.From(voteAddressSet)
+	if votes.Count() > uint(len(pubKeys)) {
+		return nil, errors.New("number of votes is larger than the number of public keys")
+	}
+
+	voted := make([][]byte, 0, votes.Count())
+	for index, pubKey := range pubKeys {
+		if votes.Test(uint(index)) {
+			voted = append(voted, pubKey)
+		}
+	}
+	if uint(len(voted)) != votes.Count() {
+		return nil, errors.New("vote address set contains votes out of the public keys")
+	}
+
+	return voted, nil
+}
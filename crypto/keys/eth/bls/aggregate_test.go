@@ -0,0 +1,80 @@
+package bls_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+)
+
+func TestFastAggregateVerify(t *testing.T) {
+	digest := make([]byte, bls.DigestLength)
+	digest[0] = 1
+	msg := []byte("arbitrary message")
+
+	var pubKeys, digestSigs, msgSigs [][]byte
+	for i := 0; i < 3; i++ {
+		privKey, err := bls.GenPrivKey()
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, privKey.PubKey().Bytes())
+
+		sig, err := privKey.Sign(digest)
+		require.NoError(t, err)
+		digestSigs = append(digestSigs, sig)
+
+		sig, err = privKey.Sign(msg)
+		require.NoError(t, err)
+		msgSigs = append(msgSigs, sig)
+	}
+
+	aggSig, err := bls.AggregateSignatures(digestSigs)
+	require.NoError(t, err)
+	ok, err := bls.FastAggregateVerify(pubKeys, digest, aggSig)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// missing a signer
+	ok, err = bls.FastAggregateVerify(pubKeys[:2], digest, aggSig)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// another message
+	ok, err = bls.FastAggregateVerify(pubKeys, msg, aggSig)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// messages which are not digests
+	aggSig, err = bls.AggregateSignatures(msgSigs)
+	require.NoError(t, err)
+	ok, err = bls.FastAggregateVerify(pubKeys, msg, aggSig)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, err = bls.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = bls.FastAggregateVerify(nil, msg, aggSig)
+	require.Error(t, err)
+	_, err = bls.FastAggregateVerify(pubKeys, msg, []byte("invalid"))
+	require.Error(t, err)
+}
+
+func TestVotedPubKeys(t *testing.T) {
+	pubKeys := [][]byte{{1}, {2}, {3}}
+
+	voted, err := bls.VotedPubKeys(pubKeys, []uint64{5})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{1}, {3}}, voted)
+
+	voted, err = bls.VotedPubKeys(pubKeys, []uint64{7})
+	require.NoError(t, err)
+	require.Equal(t, pubKeys, voted)
+
+	// votes out of the public keys
+	_, err = bls.VotedPubKeys(pubKeys, []uint64{9})
+	require.Error(t, err)
+
+	// more votes than public keys
+	_, err = bls.VotedPubKeys(pubKeys, []uint64{15})
+	require.Error(t, err)
+}
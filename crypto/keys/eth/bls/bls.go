@@ -8,6 +8,8 @@ import (
 const (
 	// KeyType is the string constant for the BLS algorithm
 	KeyType = "eth_bls"
+	// SecretKeyLength is the length of the BLS private keys
+	SecretKeyLength = 32
 )
 
 // Amino encoding names
@@ -0,0 +1,250 @@
+package bls
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// The threshold BLS keys are split with the Shamir secret sharing, the key shares are the points
+// of a random polynomial of degree threshold - 1 whose constant term is the secret key, and the
+// Feldman commitments of the coefficients of the polynomial are published to verify the shares.
+// The first commitment is the public key of the shared key.
+//
+// The shares are BLS keys by themselves, a partial signature is a regular BLS signature signed by
+// a share, and any threshold partial signatures are combined into the signature of the shared key
+// with the Lagrange interpolation. The combined signature can't be told apart from a signature
+// signed by the shared key directly.
+//
+// Without a trusted dealer, the participants run a distributed key generation, each of them splits
+// a random key to all the participants, and every participant combines the shares it received
+// with CombineKeyShares. The shared key is the sum of the random keys, which is never known by any
+// participant.
+
+// curveOrder is the order r of the BLS12-381 curve.
+var curveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// SplitPrivKey splits the BLS private key into n shares, any threshold of which can sign for the
+// key. It returns the shares, whose indices are 1 to n, and the commitments of the shares.
+func SplitPrivKey(privKey *PrivKey, threshold, n int) ([]*PrivKey, [][]byte, error) {
+	if threshold < 1 || threshold > n {
+		return nil, nil, fmt.Errorf("invalid threshold %d of %d shares", threshold, n)
+	}
+	if uint64(n) >= uint64(^uint32(0)) {
+		return nil, nil, fmt.Errorf("too many shares: %d", n)
+	}
+
+	secret := new(big.Int).SetBytes(privKey.Bytes())
+	if len(privKey.Key) != SecretKeyLength || secret.Sign() == 0 || secret.Cmp(curveOrder) >= 0 {
+		return nil, nil, errors.New("invalid BLS private key")
+	}
+
+	coefficients := []*big.Int{secret}
+	for i := 1; i < threshold; i++ {
+		coefficient, err := randomScalar()
+		if err != nil {
+			return nil, nil, err
+		}
+		coefficients = append(coefficients, coefficient)
+	}
+
+	commitments := make([][]byte, 0, threshold)
+	for _, coefficient := range coefficients {
+		commitments = append(commitments, new(blst.P1Affine).From(toScalar(coefficient)).Compress())
+	}
+
+	shares := make([]*PrivKey, 0, n)
+	for index := 1; index <= n; index++ {
+		// evaluate the polynomial at the index with the Horner's method
+		x := big.NewInt(int64(index))
+		share := new(big.Int)
+		for i := len(coefficients) - 1; i >= 0; i-- {
+			share.Mul(share, x)
+			share.Add(share, coefficients[i])
+			share.Mod(share, curveOrder)
+		}
+		if share.Sign() == 0 {
+			// the share can't be a BLS key, which happens with a negligible probability
+			return SplitPrivKey(privKey, threshold, n)
+		}
+
+		shares = append(shares, &PrivKey{Key: share.FillBytes(make([]byte, SecretKeyLength))})
+	}
+
+	return shares, commitments, nil
+}
+
+// KeySharePubKey returns the public key of the share at the index from the commitments of the
+// shares, which is used to verify the partial signatures.
+func KeySharePubKey(index uint32, commitments [][]byte) ([]byte, error) {
+	if index == 0 {
+		return nil, errors.New("share index must be positive")
+	}
+	if len(commitments) == 0 {
+		return nil, errors.New("no commitments of the shares")
+	}
+
+	// evaluate the committed polynomial at the index with the Horner's method
+	x := new(big.Int).SetUint64(uint64(index))
+	var pubKey *blst.P1
+	for i := len(commitments) - 1; i >= 0; i-- {
+		commitment, err := decompressPubKey(commitments[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid commitment %d: %w", i, err)
+		}
+
+		if pubKey == nil {
+			pubKey = new(blst.P1)
+			pubKey.FromAffine(commitment)
+			continue
+		}
+		pubKey = pubKey.Mult(toScalar(x)).Add(commitment)
+	}
+
+	return pubKey.Compress(), nil
+}
+
+// VerifyKeyShare verifies the share at the index against the commitments of the shares.
+func VerifyKeyShare(index uint32, share *PrivKey, commitments [][]byte) error {
+	pubKey, err := KeySharePubKey(index, commitments)
+	if err != nil {
+		return err
+	}
+
+	sharePubKey := share.PubKey()
+	if sharePubKey == nil || !sharePubKey.Equals(&PubKey{Key: pubKey}) {
+		return fmt.Errorf("share %d doesn't match the commitments", index)
+	}
+
+	return nil
+}
+
+// CombineKeyShares combines the shares at the index of the distributed key generation, which are
+// split from the random keys of all the participants, into the share of the shared key. It returns
+// the share and the commitments of the shares of the shared key.
+func CombineKeyShares(index uint32, shares []*PrivKey, commitments [][][]byte) (*PrivKey, [][]byte, error) {
+	if len(shares) == 0 || len(shares) != len(commitments) {
+		return nil, nil, fmt.Errorf("got %d shares with %d commitments", len(shares), len(commitments))
+	}
+
+	threshold := len(commitments[0])
+	share := new(big.Int)
+	combined := make([]*blst.P1, threshold)
+	for i := range shares {
+		if len(commitments[i]) != threshold {
+			return nil, nil, fmt.Errorf("threshold %d of the commitments %d doesn't match %d", len(commitments[i]), i, threshold)
+		}
+		if err := VerifyKeyShare(index, shares[i], commitments[i]); err != nil {
+			return nil, nil, err
+		}
+
+		share.Add(share, new(big.Int).SetBytes(shares[i].Key))
+		for j, commitment := range commitments[i] {
+			point, err := decompressPubKey(commitment)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid commitment %d: %w", j, err)
+			}
+
+			if combined[j] == nil {
+				combined[j] = new(blst.P1)
+				combined[j].FromAffine(point)
+				continue
+			}
+			combined[j].AddAssign(point)
+		}
+	}
+	share.Mod(share, curveOrder)
+	if share.Sign() == 0 {
+		return nil, nil, errors.New("the combined share is zero")
+	}
+
+	combinedCommitments := make([][]byte, 0, threshold)
+	for _, commitment := range combined {
+		combinedCommitments = append(combinedCommitments, commitment.Compress())
+	}
+
+	return &PrivKey{Key: share.FillBytes(make([]byte, SecretKeyLength))}, combinedCommitments, nil
+}
+
+// CombineSignatures combines the partial signatures signed by the shares at the indices into the
+// signature of the shared key, the number of the partial signatures must reach the threshold.
+func CombineSignatures(indices []uint32, partialSigs [][]byte) ([]byte, error) {
+	if len(indices) == 0 || len(indices) != len(partialSigs) {
+		return nil, fmt.Errorf("got %d indices of %d partial signatures", len(indices), len(partialSigs))
+	}
+
+	seen := make(map[uint32]bool, len(indices))
+	for _, index := range indices {
+		if index == 0 {
+			return nil, errors.New("share index must be positive")
+		}
+		if seen[index] {
+			return nil, fmt.Errorf("duplicated share index %d", index)
+		}
+		seen[index] = true
+	}
+
+	var sig *blst.P2
+	for i, index := range indices {
+		partialSig := new(blst.P2Affine).Uncompress(partialSigs[i])
+		if partialSig == nil || !partialSig.SigValidate(false) {
+			return nil, fmt.Errorf("invalid partial signature of share %d", index)
+		}
+
+		// the Lagrange coefficient of the index at zero
+		numerator, denominator := big.NewInt(1), big.NewInt(1)
+		for _, other := range indices {
+			if other == index {
+				continue
+			}
+			numerator.Mul(numerator, new(big.Int).SetUint64(uint64(other)))
+			numerator.Mod(numerator, curveOrder)
+			denominator.Mul(denominator, new(big.Int).Sub(new(big.Int).SetUint64(uint64(other)), new(big.Int).SetUint64(uint64(index))))
+			denominator.Mod(denominator, curveOrder)
+		}
+		coefficient := numerator.Mul(numerator, denominator.ModInverse(denominator, curveOrder))
+		coefficient.Mod(coefficient, curveOrder)
+
+		point := new(blst.P2)
+		point.FromAffine(partialSig)
+		point.MultAssign(toScalar(coefficient))
+		if sig == nil {
+			sig = point
+			continue
+		}
+		sig.AddAssign(point)
+	}
+
+	return sig.Compress(), nil
+}
+
+// randomScalar returns a random non-zero scalar of the BLS12-381 curve.
+func randomScalar() (*big.Int, error) {
+	for {
+		scalar, err := rand.Int(rand.Reader, curveOrder)
+		if err != nil {
+			return nil, err
+		}
+		if scalar.Sign() != 0 {
+			return scalar, nil
+		}
+	}
+}
+
+// toScalar converts the non-zero integer less than the curve order to a blst scalar.
+func toScalar(x *big.Int) *blst.Scalar {
+	return new(blst.Scalar).Deserialize(x.FillBytes(make([]byte, SecretKeyLength)))
+}
+
+// decompressPubKey decompresses the BLS public key and checks that it's a valid public key.
+func decompressPubKey(pubKey []byte) (*blst.P1Affine, error) {
+	point := new(blst.P1Affine).Uncompress(pubKey)
+	if point == nil || !point.KeyValidate() {
+		return nil, errors.New("invalid BLS public key")
+	}
+
+	return point, nil
+}
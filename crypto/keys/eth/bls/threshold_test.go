@@ -0,0 +1,127 @@
+package bls_test
+
+import (
+	"testing"
+
+	prysmbls "github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+)
+
+func TestThresholdSignatures(t *testing.T) {
+	privKey, err := bls.GenPrivKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey().Bytes()
+
+	shares, commitments, err := bls.SplitPrivKey(privKey, 3, 5)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+	require.Len(t, commitments, 3)
+	require.Equal(t, pubKey, commitments[0])
+
+	digest := make([]byte, bls.DigestLength)
+	digest[0] = 1
+	partialSigs := make([][]byte, 0, len(shares))
+	for i, share := range shares {
+		index := uint32(i + 1)
+		require.NoError(t, bls.VerifyKeyShare(index, share, commitments))
+		require.Error(t, bls.VerifyKeyShare(index+1, share, commitments))
+
+		sig, err := share.Sign(digest)
+		require.NoError(t, err)
+		sharePubKey, err := bls.KeySharePubKey(index, commitments)
+		require.NoError(t, err)
+		require.True(t, (&bls.PubKey{Key: sharePubKey}).VerifySignature(digest, sig))
+		partialSigs = append(partialSigs, sig)
+	}
+
+	expected, err := privKey.Sign(digest)
+	require.NoError(t, err)
+
+	// any threshold of the partial signatures is combined into the signature of the key
+	for _, indices := range [][]uint32{{1, 2, 3}, {5, 1, 3}, {2, 3, 4, 5}} {
+		sigs := make([][]byte, 0, len(indices))
+		for _, index := range indices {
+			sigs = append(sigs, partialSigs[index-1])
+		}
+		sig, err := bls.CombineSignatures(indices, sigs)
+		require.NoError(t, err)
+		require.Equal(t, expected, sig)
+
+		ok, err := bls.FastAggregateVerify([][]byte{pubKey}, digest, sig)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	// fewer partial signatures than the threshold
+	sig, err := bls.CombineSignatures([]uint32{1, 2}, partialSigs[:2])
+	require.NoError(t, err)
+	require.NotEqual(t, expected, sig)
+
+	_, err = bls.CombineSignatures([]uint32{1, 1}, partialSigs[:2])
+	require.Error(t, err)
+	_, err = bls.CombineSignatures([]uint32{0, 1}, partialSigs[:2])
+	require.Error(t, err)
+	_, err = bls.CombineSignatures([]uint32{1}, partialSigs[:2])
+	require.Error(t, err)
+	_, err = bls.CombineSignatures([]uint32{1, 2}, [][]byte{partialSigs[0], []byte("invalid")})
+	require.Error(t, err)
+
+	_, _, err = bls.SplitPrivKey(privKey, 0, 5)
+	require.Error(t, err)
+	_, _, err = bls.SplitPrivKey(privKey, 6, 5)
+	require.Error(t, err)
+	_, _, err = bls.SplitPrivKey(&bls.PrivKey{Key: make([]byte, bls.SecretKeyLength)}, 1, 1)
+	require.Error(t, err)
+}
+
+func TestDistributedKeyGeneration(t *testing.T) {
+	const threshold, n = 2, 3
+
+	// every participant splits a random key to all the participants
+	dealings := make([][]*bls.PrivKey, n)
+	dealingCommitments := make([][][]byte, n)
+	for i := 0; i < n; i++ {
+		privKey, err := bls.GenPrivKey()
+		require.NoError(t, err)
+		dealings[i], dealingCommitments[i], err = bls.SplitPrivKey(privKey, threshold, n)
+		require.NoError(t, err)
+	}
+
+	shares := make([]*bls.PrivKey, n)
+	var commitments [][]byte
+	for j := 0; j < n; j++ {
+		received := make([]*bls.PrivKey, n)
+		for i := 0; i < n; i++ {
+			received[i] = dealings[i][j]
+		}
+
+		share, shareCommitments, err := bls.CombineKeyShares(uint32(j+1), received, dealingCommitments)
+		require.NoError(t, err)
+		require.NoError(t, bls.VerifyKeyShare(uint32(j+1), share, shareCommitments))
+		if commitments != nil {
+			require.Equal(t, commitments, shareCommitments)
+		}
+		shares[j], commitments = share, shareCommitments
+
+		// a share from another participant is rejected
+		received[0] = dealings[0][(j+1)%n]
+		_, _, err = bls.CombineKeyShares(uint32(j+1), received, dealingCommitments)
+		require.Error(t, err)
+	}
+
+	msg := []byte("arbitrary message")
+	sig1, err := shares[0].Sign(msg)
+	require.NoError(t, err)
+	sig3, err := shares[2].Sign(msg)
+	require.NoError(t, err)
+	sig, err := bls.CombineSignatures([]uint32{1, 3}, [][]byte{sig1, sig3})
+	require.NoError(t, err)
+
+	pubKey, err := prysmbls.PublicKeyFromBytes(commitments[0])
+	require.NoError(t, err)
+	signature, err := prysmbls.SignatureFromBytes(sig)
+	require.NoError(t, err)
+	require.True(t, signature.Verify(pubKey, msg))
+}
@@ -96,3 +96,20 @@ func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
 	// the signature needs to be in [R || S] format when provided to VerifySignature
 	return crypto.VerifySignature(pubKey.Key, crypto.Keccak256Hash(msg).Bytes(), sig)
 }
+
+// VerifyDigestSignature verifies that the ECDSA public key created a given signature over
+// the provided digest, e.g. an EIP-712 typed data hash. Unlike VerifySignature, the digest
+// is not hashed prior to verification.
+//
+// CONTRACT: The signature should be in [R || S] or [R || S || V] format.
+func (pubKey *PubKey) VerifyDigestSignature(digest, sig []byte) bool {
+	if len(digest) != crypto.DigestLength {
+		return false
+	}
+	if len(sig) == crypto.SignatureLength {
+		// remove recovery ID (V) if contained in the signature
+		sig = sig[:len(sig)-1]
+	}
+
+	return crypto.VerifySignature(pubKey.Key, digest, sig)
+}
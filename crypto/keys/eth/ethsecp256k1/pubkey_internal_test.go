@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	proto "github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -63,6 +64,21 @@ func (suite *PKSuite) TestEquals() {
 	require.True(pkOther.Equals(pkOther), "Equals must be reflexive") //nolint:gocritic // false positive
 }
 
+func (suite *PKSuite) TestVerifyDigestSignature() {
+	require := suite.Require()
+
+	digest := crypto.Keccak256([]byte("typed data"))
+	sig, err := suite.sk.Sign(digest)
+	require.NoError(err)
+
+	require.True(suite.pk.VerifyDigestSignature(digest, sig))
+	require.True(suite.pk.VerifyDigestSignature(digest, sig[:crypto.RecoveryIDOffset]))
+	// the digest is signed as is, so it must not be hashed again
+	require.False(suite.pk.VerifySignature(digest, sig))
+	require.False(suite.pk.VerifyDigestSignature(digest[1:], sig))
+	require.False(suite.pk.VerifyDigestSignature(crypto.Keccak256(digest), sig))
+}
+
 func (suite *PKSuite) TestMarshalProto() {
 	require := suite.Require()
 
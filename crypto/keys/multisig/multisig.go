@@ -77,7 +77,7 @@ func (m *LegacyAminoPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetS
 				if err != nil {
 					return err
 				}
-				if !pubKeys[i].VerifySignature(msg, si.Signature) {
+				if !verifySingleSignature(pubKeys[i], si, msg) {
 					return fmt.Errorf("unable to verify signature at index %d", i)
 				}
 			case *signing.MultiSignatureData:
@@ -97,6 +97,27 @@ func (m *LegacyAminoPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetS
 	return nil
 }
 
+// digestVerifier is implemented by the pubkeys which can verify a signature over an already
+// hashed digest.
+type digestVerifier interface {
+	VerifyDigestSignature(digest, sig []byte) bool
+}
+
+// verifySingleSignature verifies the signature of a single key of the multisig. The sign bytes
+// of SIGN_MODE_EIP_712 are the typed data hash which is signed as is, so they must not be
+// hashed again by the pubkey.
+func verifySingleSignature(pubKey cryptotypes.PubKey, sig *signing.SingleSignatureData, msg []byte) bool {
+	if sig.SignMode == signing.SignMode_SIGN_MODE_EIP_712 {
+		verifier, ok := pubKey.(digestVerifier)
+		if !ok {
+			return false
+		}
+		return verifier.VerifyDigestSignature(msg, sig.Signature)
+	}
+
+	return pubKey.VerifySignature(msg, sig.Signature)
+}
+
 // VerifySignature implements cryptotypes.PubKey VerifySignature method,
 // it panics because it can't handle MultiSignatureData
 // cf. https://github.com/cosmos/cosmos-sdk/issues/7109#issuecomment-686329936
@@ -0,0 +1,414 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	keystoreVersionV3      = 3
+	keystoreVersionEIP2335 = 4
+
+	kdfScrypt     = "scrypt"
+	kdfPBKDF2     = "pbkdf2"
+	prfHmacSha256 = "hmac-sha256"
+	cipherAES128  = "aes-128-ctr"
+	checksumSha   = "sha256"
+
+	keystoreDKLen = 32
+)
+
+// KeystoreScryptN and KeystoreScryptP are the scrypt parameters of the JSON keystores, they can be
+// lowered within the tests like BcryptSecurityParameter. The defaults are the standard parameters of
+// EIP-2335 and of the Ethereum clients.
+var (
+	KeystoreScryptN = 1 << 18
+	KeystoreScryptP = 1
+)
+
+const keystoreScryptR = 8
+
+// keystoreKDFParams are the parameters of the KDF of both the EIP-2335 and the V3 keystores.
+type keystoreKDFParams struct {
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	N     int    `json:"n,omitempty"`
+	R     int    `json:"r,omitempty"`
+	P     int    `json:"p,omitempty"`
+	C     int    `json:"c,omitempty"`
+	PRF   string `json:"prf,omitempty"`
+}
+
+type keystoreCipherParams struct {
+	IV string `json:"iv"`
+}
+
+// eip2335Keystore is the EIP-2335 keystore of BLS keys.
+type eip2335Keystore struct {
+	Crypto      eip2335Crypto `json:"crypto"`
+	Description string        `json:"description"`
+	PubKey      string        `json:"pubkey"`
+	Path        string        `json:"path"`
+	UUID        string        `json:"uuid"`
+	Version     int           `json:"version"`
+}
+
+type eip2335Crypto struct {
+	KDF      eip2335Module `json:"kdf"`
+	Checksum eip2335Module `json:"checksum"`
+	Cipher   eip2335Module `json:"cipher"`
+}
+
+type eip2335Module struct {
+	Function string          `json:"function"`
+	Params   json.RawMessage `json:"params"`
+	Message  string          `json:"message"`
+}
+
+// v3Keystore is the Web3 Secret Storage (version 3) keystore of Ethereum keys.
+type v3Keystore struct {
+	Address string   `json:"address"`
+	Crypto  v3Crypto `json:"crypto"`
+	ID      string   `json:"id"`
+	Version int      `json:"version"`
+}
+
+type v3Crypto struct {
+	Cipher       string               `json:"cipher"`
+	CipherText   string               `json:"ciphertext"`
+	CipherParams keystoreCipherParams `json:"cipherparams"`
+	KDF          string               `json:"kdf"`
+	KDFParams    json.RawMessage      `json:"kdfparams"`
+	MAC          string               `json:"mac"`
+}
+
+// EncryptKeystore encrypts the private key into a JSON keystore with the passphrase. BLS keys are
+// encrypted into EIP-2335 keystores and eth_secp256k1 keys into Web3 Secret Storage (version 3)
+// keystores, so that they can be imported by the Ethereum and BSC tooling.
+func EncryptKeystore(privKey cryptotypes.PrivKey, passphrase string) ([]byte, error) {
+	switch privKey.(type) {
+	case *bls.PrivKey:
+		return encryptEIP2335Keystore(privKey, passphrase)
+	case *ethsecp256k1.PrivKey:
+		return encryptV3Keystore(privKey, passphrase)
+	default:
+		return nil, fmt.Errorf("keystores are not supported for %s keys", privKey.Type())
+	}
+}
+
+// DecryptKeystore decrypts the private key from an EIP-2335 or a Web3 Secret Storage (version 3)
+// keystore. The keys of EIP-2335 keystores are BLS keys and the keys of version 3 keystores are
+// eth_secp256k1 keys.
+func DecryptKeystore(keystore []byte, passphrase string) (cryptotypes.PrivKey, error) {
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(keystore, &header); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid keystore")
+	}
+
+	switch header.Version {
+	case keystoreVersionEIP2335:
+		return decryptEIP2335Keystore(keystore, passphrase)
+	case keystoreVersionV3:
+		return decryptV3Keystore(keystore, passphrase)
+	default:
+		return nil, fmt.Errorf("unsupported keystore version: %d", header.Version)
+	}
+}
+
+func encryptEIP2335Keystore(privKey cryptotypes.PrivKey, passphrase string) ([]byte, error) {
+	kdfParams, derivedKey, err := newScryptKey(eip2335Password(passphrase))
+	if err != nil {
+		return nil, err
+	}
+	iv, cipherText, err := aes128CTREncrypt(derivedKey[:16], privKey.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	kdfParamsBz, err := json.Marshal(kdfParams)
+	if err != nil {
+		return nil, err
+	}
+	cipherParamsBz, err := json.Marshal(keystoreCipherParams{IV: hex.EncodeToString(iv)})
+	if err != nil {
+		return nil, err
+	}
+	checksum := sha256.Sum256(append(derivedKey[16:32:32], cipherText...))
+
+	uuid, err := newKeystoreUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(eip2335Keystore{
+		Crypto: eip2335Crypto{
+			KDF:      eip2335Module{Function: kdfScrypt, Params: kdfParamsBz},
+			Checksum: eip2335Module{Function: checksumSha, Params: json.RawMessage("{}"), Message: hex.EncodeToString(checksum[:])},
+			Cipher:   eip2335Module{Function: cipherAES128, Params: cipherParamsBz, Message: hex.EncodeToString(cipherText)},
+		},
+		PubKey:  hex.EncodeToString(privKey.PubKey().Bytes()),
+		UUID:    uuid,
+		Version: keystoreVersionEIP2335,
+	}, "", "  ")
+}
+
+func decryptEIP2335Keystore(bz []byte, passphrase string) (cryptotypes.PrivKey, error) {
+	var keystore eip2335Keystore
+	if err := json.Unmarshal(bz, &keystore); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid EIP-2335 keystore")
+	}
+
+	var kdfParams keystoreKDFParams
+	if err := json.Unmarshal(keystore.Crypto.KDF.Params, &kdfParams); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid kdf params")
+	}
+	derivedKey, err := deriveKeystoreKey(keystore.Crypto.KDF.Function, kdfParams, eip2335Password(passphrase))
+	if err != nil {
+		return nil, err
+	}
+
+	if keystore.Crypto.Checksum.Function != checksumSha {
+		return nil, fmt.Errorf("unsupported checksum function: %s", keystore.Crypto.Checksum.Function)
+	}
+	checksum, err := hex.DecodeString(keystore.Crypto.Checksum.Message)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid checksum")
+	}
+	cipherText, err := hex.DecodeString(keystore.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid cipher message")
+	}
+	expected := sha256.Sum256(append(derivedKey[16:32:32], cipherText...))
+	if subtle.ConstantTimeCompare(checksum, expected[:]) != 1 {
+		return nil, sdkerrors.ErrWrongPassword
+	}
+
+	if keystore.Crypto.Cipher.Function != cipherAES128 {
+		return nil, fmt.Errorf("unsupported cipher function: %s", keystore.Crypto.Cipher.Function)
+	}
+	var cipherParams keystoreCipherParams
+	if err := json.Unmarshal(keystore.Crypto.Cipher.Params, &cipherParams); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid cipher params")
+	}
+	secret, err := aes128CTRDecrypt(derivedKey[:16], cipherParams.IV, cipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey := &bls.PrivKey{Key: secret}
+	pubKey := privKey.PubKey()
+	if pubKey == nil {
+		return nil, fmt.Errorf("invalid BLS private key")
+	}
+	if keystore.PubKey != "" && !strings.EqualFold(strings.TrimPrefix(keystore.PubKey, "0x"), hex.EncodeToString(pubKey.Bytes())) {
+		return nil, fmt.Errorf("public key mismatch: %s", keystore.PubKey)
+	}
+
+	return privKey, nil
+}
+
+func encryptV3Keystore(privKey cryptotypes.PrivKey, passphrase string) ([]byte, error) {
+	kdfParams, derivedKey, err := newScryptKey([]byte(passphrase))
+	if err != nil {
+		return nil, err
+	}
+	iv, cipherText, err := aes128CTREncrypt(derivedKey[:16], privKey.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	kdfParamsBz, err := json.Marshal(kdfParams)
+	if err != nil {
+		return nil, err
+	}
+
+	uuid, err := newKeystoreUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(v3Keystore{
+		Address: hex.EncodeToString(privKey.PubKey().Address()),
+		Crypto: v3Crypto{
+			Cipher:       cipherAES128,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: keystoreCipherParams{IV: hex.EncodeToString(iv)},
+			KDF:          kdfScrypt,
+			KDFParams:    kdfParamsBz,
+			MAC:          hex.EncodeToString(ethcrypto.Keccak256(derivedKey[16:32], cipherText)),
+		},
+		ID:      uuid,
+		Version: keystoreVersionV3,
+	}, "", "  ")
+}
+
+func decryptV3Keystore(bz []byte, passphrase string) (cryptotypes.PrivKey, error) {
+	var keystore v3Keystore
+	if err := json.Unmarshal(bz, &keystore); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid V3 keystore")
+	}
+
+	var kdfParams keystoreKDFParams
+	if err := json.Unmarshal(keystore.Crypto.KDFParams, &kdfParams); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid kdf params")
+	}
+	derivedKey, err := deriveKeystoreKey(keystore.Crypto.KDF, kdfParams, []byte(passphrase))
+	if err != nil {
+		return nil, err
+	}
+
+	mac, err := hex.DecodeString(keystore.Crypto.MAC)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid mac")
+	}
+	cipherText, err := hex.DecodeString(keystore.Crypto.CipherText)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid cipher text")
+	}
+	if subtle.ConstantTimeCompare(mac, ethcrypto.Keccak256(derivedKey[16:32], cipherText)) != 1 {
+		return nil, sdkerrors.ErrWrongPassword
+	}
+
+	if keystore.Crypto.Cipher != cipherAES128 {
+		return nil, fmt.Errorf("unsupported cipher: %s", keystore.Crypto.Cipher)
+	}
+	secret, err := aes128CTRDecrypt(derivedKey[:16], keystore.Crypto.CipherParams.IV, cipherText)
+	if err != nil {
+		return nil, err
+	}
+	if len(secret) != ethsecp256k1.PrivKeySize {
+		return nil, fmt.Errorf("invalid private key length: %d", len(secret))
+	}
+
+	privKey := &ethsecp256k1.PrivKey{Key: secret}
+	if _, err := privKey.ToECDSA(); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid private key")
+	}
+	if keystore.Address != "" {
+		address, err := hex.DecodeString(strings.TrimPrefix(keystore.Address, "0x"))
+		if err != nil || !bytes.Equal(address, privKey.PubKey().Address()) {
+			return nil, fmt.Errorf("address mismatch: %s", keystore.Address)
+		}
+	}
+
+	return privKey, nil
+}
+
+// newScryptKey derives a key from the password with scrypt and a random salt.
+func newScryptKey(password []byte) (keystoreKDFParams, []byte, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return keystoreKDFParams{}, nil, err
+	}
+
+	params := keystoreKDFParams{
+		DKLen: keystoreDKLen,
+		Salt:  hex.EncodeToString(salt),
+		N:     KeystoreScryptN,
+		R:     keystoreScryptR,
+		P:     KeystoreScryptP,
+	}
+	derivedKey, err := deriveKeystoreKey(kdfScrypt, params, password)
+	return params, derivedKey, err
+}
+
+// deriveKeystoreKey derives the decryption key of the keystore from the password.
+func deriveKeystoreKey(function string, params keystoreKDFParams, password []byte) ([]byte, error) {
+	if params.DKLen != keystoreDKLen {
+		return nil, fmt.Errorf("unsupported dklen: %d", params.DKLen)
+	}
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid salt")
+	}
+
+	switch function {
+	case kdfScrypt:
+		return scrypt.Key(password, salt, params.N, params.R, params.P, params.DKLen)
+	case kdfPBKDF2:
+		if params.PRF != prfHmacSha256 {
+			return nil, fmt.Errorf("unsupported prf: %s", params.PRF)
+		}
+		return pbkdf2.Key(password, salt, params.C, params.DKLen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("unsupported kdf: %s", function)
+	}
+}
+
+// eip2335Password returns the password of EIP-2335 keystores, which is NFKD normalized with the
+// C0, C1 and Delete control codes stripped.
+func eip2335Password(passphrase string) []byte {
+	var password strings.Builder
+	for _, r := range norm.NFKD.String(passphrase) {
+		if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+			continue
+		}
+		password.WriteRune(r)
+	}
+
+	return []byte(password.String())
+}
+
+func aes128CTREncrypt(key, plainText []byte) (iv, cipherText []byte, err error) {
+	iv = make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	cipherText = make([]byte, len(plainText))
+	cipher.NewCTR(block, iv).XORKeyStream(cipherText, plainText)
+
+	return iv, cipherText, nil
+}
+
+func aes128CTRDecrypt(key []byte, ivHex string, cipherText []byte) ([]byte, error) {
+	iv, err := hex.DecodeString(ivHex)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, fmt.Errorf("invalid iv: %s", ivHex)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	plainText := make([]byte, len(cipherText))
+	cipher.NewCTR(block, iv).XORKeyStream(plainText, cipherText)
+
+	return plainText, nil
+}
+
+// newKeystoreUUID returns a random (version 4) UUID.
+func newKeystoreUUID() (string, error) {
+	var uuid [16]byte
+	if _, err := rand.Read(uuid[:]); err != nil {
+		return "", err
+	}
+	uuid[6] = (uuid[6] & 0x0f) | 0x40
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:]), nil
+}
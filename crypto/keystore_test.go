@@ -0,0 +1,186 @@
+package crypto_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// The test vectors of EIP-2335, see https://eips.ethereum.org/EIPS/eip-2335#test-cases
+const (
+	eip2335Password = "\U0001d531\U0001d522\U0001d530\U0001d531\U0001d52d\U0001d51e\U0001d530\U0001d530\U0001d534\U0001d52c\U0001d52f\U0001d521\U0001f511"
+	eip2335Secret   = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+
+	eip2335ScryptKeystore = `{
+    "crypto": {
+        "kdf": {
+            "function": "scrypt",
+            "params": {
+                "dklen": 32,
+                "n": 262144,
+                "p": 1,
+                "r": 8,
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "d2217fe5f3e9a1e34581ef8a78f7c9928e436d36dacc5e846690a5581e8ea484"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "06ae90d55fe0a6e9c5c3bc5b170827b2e5cce3929ed3f116c2811e6366dfe20f"
+        }
+    },
+    "description": "This is a test keystore that uses scrypt to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/3141592653/589793238",
+    "uuid": "1d85ae20-35c5-4611-98e8-aa14a633906f",
+    "version": 4
+}`
+
+	eip2335PBKDF2Keystore = `{
+    "crypto": {
+        "kdf": {
+            "function": "pbkdf2",
+            "params": {
+                "dklen": 32,
+                "c": 262144,
+                "prf": "hmac-sha256",
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+        }
+    },
+    "description": "This is a test keystore that uses PBKDF2 to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/0/0",
+    "uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+    "version": 4
+}`
+)
+
+// The test vector of the Web3 Secret Storage Definition.
+const (
+	v3Password = "testpassword"
+	v3Secret   = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+
+	v3PBKDF2Keystore = `{
+    "crypto" : {
+        "cipher" : "aes-128-ctr",
+        "cipherparams" : {
+            "iv" : "6087dab2f9fdbbfaddc31a909735c1e6"
+        },
+        "ciphertext" : "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+        "kdf" : "pbkdf2",
+        "kdfparams" : {
+            "c" : 262144,
+            "dklen" : 32,
+            "prf" : "hmac-sha256",
+            "salt" : "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"
+        },
+        "mac" : "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+    },
+    "id" : "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+    "version" : 3
+}`
+)
+
+func TestDecryptKeystoreVectors(t *testing.T) {
+	for name, keystore := range map[string]string{
+		"scrypt": eip2335ScryptKeystore,
+		"pbkdf2": eip2335PBKDF2Keystore,
+	} {
+		t.Run("eip2335 "+name, func(t *testing.T) {
+			privKey, err := crypto.DecryptKeystore([]byte(keystore), eip2335Password)
+			require.NoError(t, err)
+			require.IsType(t, &bls.PrivKey{}, privKey)
+			require.Equal(t, eip2335Secret, hex.EncodeToString(privKey.Bytes()))
+
+			_, err = crypto.DecryptKeystore([]byte(keystore), "wrong")
+			require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+		})
+	}
+
+	t.Run("v3 pbkdf2", func(t *testing.T) {
+		privKey, err := crypto.DecryptKeystore([]byte(v3PBKDF2Keystore), v3Password)
+		require.NoError(t, err)
+		require.IsType(t, &ethsecp256k1.PrivKey{}, privKey)
+		require.Equal(t, v3Secret, hex.EncodeToString(privKey.Bytes()))
+
+		_, err = crypto.DecryptKeystore([]byte(v3PBKDF2Keystore), "wrong")
+		require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+	})
+}
+
+func TestEncryptKeystore(t *testing.T) {
+	defer func(n int) { crypto.KeystoreScryptN = n }(crypto.KeystoreScryptN)
+	crypto.KeystoreScryptN = 1 << 12
+
+	blsKey, err := bls.GenPrivKey()
+	require.NoError(t, err)
+	ethKey, err := ethsecp256k1.GenPrivKey()
+	require.NoError(t, err)
+
+	// the control codes are stripped from the EIP-2335 passwords
+	keystore, err := crypto.EncryptKeystore(blsKey, "pass\u0007word")
+	require.NoError(t, err)
+	var eip2335 struct {
+		PubKey  string `json:"pubkey"`
+		Version int    `json:"version"`
+	}
+	require.NoError(t, json.Unmarshal(keystore, &eip2335))
+	require.Equal(t, 4, eip2335.Version)
+	require.Equal(t, hex.EncodeToString(blsKey.PubKey().Bytes()), eip2335.PubKey)
+
+	privKey, err := crypto.DecryptKeystore(keystore, "password")
+	require.NoError(t, err)
+	require.True(t, blsKey.Equals(privKey))
+
+	keystore, err = crypto.EncryptKeystore(ethKey, "password")
+	require.NoError(t, err)
+	var v3 struct {
+		Address string `json:"address"`
+		Version int    `json:"version"`
+	}
+	require.NoError(t, json.Unmarshal(keystore, &v3))
+	require.Equal(t, 3, v3.Version)
+	require.Equal(t, hex.EncodeToString(ethKey.PubKey().Address()), v3.Address)
+
+	privKey, err = crypto.DecryptKeystore(keystore, "password")
+	require.NoError(t, err)
+	require.True(t, ethKey.Equals(privKey))
+	_, err = crypto.DecryptKeystore(keystore, "wrong")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	// the keystores of other keys are not supported
+	_, err = crypto.EncryptKeystore(secp256k1.GenPrivKey(), "password")
+	require.Error(t, err)
+	_, err = crypto.DecryptKeystore([]byte(`{"version": 1}`), "password")
+	require.Error(t, err)
+}
@@ -16,4 +16,6 @@ func init() {
 func RegisterAmino(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(PrivKeyLedgerSecp256k1{},
 		"tendermint/PrivKeyLedgerSecp256k1", nil)
+	cdc.RegisterConcrete(PrivKeyLedgerEthSecp256k1{},
+		"ethereum/PrivKeyLedgerEthSecp256k1", nil)
 }
@@ -0,0 +1,260 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// EthCoinType is the coin type of the Ethereum app, the eth_secp256k1 keys of the Ledger devices
+// are always derived on the m/44'/60' path.
+const EthCoinType = 60
+
+// ethAppName is the name of the Ethereum app on the Ledger device.
+const ethAppName = "Ethereum"
+
+type (
+	// discoverLedgerEthFn defines a Ledger discovery function that returns a connected device
+	// running the Ethereum app or an error upon failure.
+	discoverLedgerEthFn func() (ETHEREUM, error)
+
+	// ETHEREUM reflects an interface a Ledger API must implement for the Ethereum app
+	ETHEREUM interface {
+		Close() error
+		// Returns an uncompressed pubkey and the hex address, the address is shown on the device
+		// and requires user confirmation if display is set
+		GetPublicKeyETH(derivationPath []uint32, display bool) ([]byte, string, error)
+		// Signs the EIP-712 typed data with the hash of the domain and the hash of the message
+		// (requires user confirmation), it returns a 65 bytes [R || S || V] signature
+		SignEIP712HashedMessage(derivationPath []uint32, domainSeparator, messageHash []byte) ([]byte, error)
+	}
+
+	// PrivKeyLedgerEthSecp256k1 implements PrivKey for the eth_secp256k1 keys of the Ethereum app,
+	// which only signs EIP-712 typed data. It caches the PubKey from the first call to use it later.
+	PrivKeyLedgerEthSecp256k1 struct {
+		// CachedPubKey should be private, but we want to encode it via
+		// go-amino so we can view the address later, even without having the
+		// ledger attached.
+		CachedPubKey types.PubKey
+		Path         hd.BIP44Params
+	}
+)
+
+// SetDiscoverLedgerEth sets the discovery function of the devices running the Ethereum app
+func SetDiscoverLedgerEth(fn discoverLedgerEthFn) {
+	options.discoverLedgerEth = fn
+}
+
+// NewPrivKeyEthSecp256k1Unsafe will generate a new key of the Ethereum app and store the public
+// key for later use.
+//
+// This function is marked as unsafe as it will retrieve a pubkey without user verification.
+// It can only be used to verify a pubkey but never to create new accounts/keys. In that case,
+// please refer to NewPrivKeyEthSecp256k1
+func NewPrivKeyEthSecp256k1Unsafe(path hd.BIP44Params) (types.LedgerPrivKey, error) {
+	device, err := getEthDevice()
+	if err != nil {
+		return nil, err
+	}
+	defer warnIfErrors(device.Close)
+
+	pubKey, _, err := getEthPubKey(device, path, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return PrivKeyLedgerEthSecp256k1{pubKey, path}, nil
+}
+
+// NewPrivKeyEthSecp256k1 will generate a new key of the Ethereum app and store the public key for
+// later use. The request will require user confirmation and will show the address in the device.
+func NewPrivKeyEthSecp256k1(path hd.BIP44Params) (types.LedgerPrivKey, string, error) {
+	device, err := getEthDevice()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to retrieve device: %w", err)
+	}
+	defer warnIfErrors(device.Close)
+
+	pubKey, addr, err := getEthPubKey(device, path, true)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to recover pubkey: %w", err)
+	}
+
+	return PrivKeyLedgerEthSecp256k1{pubKey, path}, addr, nil
+}
+
+// PubKey returns the cached public key.
+func (pkl PrivKeyLedgerEthSecp256k1) PubKey() types.PubKey {
+	return pkl.CachedPubKey
+}
+
+// Sign always fails, the Ethereum app only signs EIP-712 typed data, which is signed by SignEIP712.
+func (pkl PrivKeyLedgerEthSecp256k1) Sign([]byte) ([]byte, error) {
+	return nil, errors.New("the Ledger Ethereum app only signs EIP-712 typed data, please sign with SIGN_MODE_EIP_712")
+}
+
+// SignEIP712 returns the eth_secp256k1 signature of the EIP-712 typed data with the hash of the
+// domain and the hash of the message, the signature is checked against the cached public key.
+func (pkl PrivKeyLedgerEthSecp256k1) SignEIP712(domainSeparator, messageHash []byte) ([]byte, error) {
+	device, err := getEthDevice()
+	if err != nil {
+		return nil, err
+	}
+	defer warnIfErrors(device.Close)
+
+	return signEIP712(device, pkl, domainSeparator, messageHash)
+}
+
+// ValidateKey allows us to verify the sanity of a public key after loading it
+// from disk.
+func (pkl PrivKeyLedgerEthSecp256k1) ValidateKey() error {
+	device, err := getEthDevice()
+	if err != nil {
+		return err
+	}
+	defer warnIfErrors(device.Close)
+
+	return validateEthKey(device, pkl)
+}
+
+// AssertIsPrivKeyInner implements the PrivKey interface. It performs a no-op.
+func (pkl *PrivKeyLedgerEthSecp256k1) AssertIsPrivKeyInner() {}
+
+// Bytes implements the PrivKey interface. It stores the cached public key so
+// we can verify the same key when we reconnect to a ledger.
+func (pkl PrivKeyLedgerEthSecp256k1) Bytes() []byte {
+	return cdc.MustMarshal(pkl)
+}
+
+// Equals implements the PrivKey interface. It makes sure two private keys
+// refer to the same public key.
+func (pkl PrivKeyLedgerEthSecp256k1) Equals(other types.LedgerPrivKey) bool {
+	if otherKey, ok := other.(PrivKeyLedgerEthSecp256k1); ok {
+		return pkl.CachedPubKey.Equals(otherKey.CachedPubKey)
+	}
+	return false
+}
+
+func (pkl PrivKeyLedgerEthSecp256k1) Type() string { return "PrivKeyLedgerEthSecp256k1" }
+
+// EIP712Digest returns the digest of the EIP-712 typed data with the hash of the domain and the
+// hash of the message, which is the digest signed by the Ethereum app.
+func EIP712Digest(domainSeparator, messageHash []byte) []byte {
+	return crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, messageHash)
+}
+
+// showEthAddress triggers the Ethereum app to show the address of the eth_secp256k1 key.
+func showEthAddress(path hd.BIP44Params, expectedPubKey types.PubKey) error {
+	device, err := getEthDevice()
+	if err != nil {
+		return err
+	}
+	defer warnIfErrors(device.Close)
+
+	pubKey, _, err := getEthPubKey(device, path, true)
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.Equals(expectedPubKey) {
+		return fmt.Errorf("the key's pubkey does not match with the one retrieved from Ledger. Check that the HD path and device are the correct ones")
+	}
+
+	return nil
+}
+
+func getEthDevice() (ETHEREUM, error) {
+	if options.discoverLedgerEth == nil {
+		return nil, errors.New("no Ledger Ethereum app discovery function defined")
+	}
+
+	device, err := options.discoverLedgerEth()
+	if err != nil {
+		return nil, fmt.Errorf("ledger nano S: %w", err)
+	}
+
+	return device, nil
+}
+
+func validateEthKey(device ETHEREUM, pkl PrivKeyLedgerEthSecp256k1) error {
+	pub, _, err := getEthPubKey(device, pkl.Path, false)
+	if err != nil {
+		return err
+	}
+
+	// verify this matches cached address
+	if !pub.Equals(pkl.CachedPubKey) {
+		return fmt.Errorf("cached key does not match retrieved key")
+	}
+
+	return nil
+}
+
+// signEIP712 calls the Ethereum app to sign the EIP-712 typed data and checks the signature.
+func signEIP712(device ETHEREUM, pkl PrivKeyLedgerEthSecp256k1, domainSeparator, messageHash []byte) ([]byte, error) {
+	if len(domainSeparator) != crypto.DigestLength || len(messageHash) != crypto.DigestLength {
+		return nil, fmt.Errorf("the EIP-712 hashes must be %d bytes", crypto.DigestLength)
+	}
+
+	if err := validateEthKey(device, pkl); err != nil {
+		return nil, err
+	}
+
+	sig, err := device.SignEIP712HashedMessage(pkl.Path.DerivationPath(), domainSeparator, messageHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length: %d", len(sig))
+	}
+
+	// the Ethereum app returns the recovery id as 27 or 28
+	sig = append([]byte{}, sig...)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	// the recovered public key is checked, so that the recovery id is checked as well
+	pub, err := crypto.SigToPub(EIP712Digest(domainSeparator, messageHash), sig)
+	if err != nil || !pkl.CachedPubKey.Equals(&ethsecp256k1.PubKey{Key: crypto.CompressPubkey(pub)}) {
+		return nil, errors.New("Ledger generated an invalid signature. Perhaps you have multiple ledgers and need to try another one")
+	}
+
+	return sig, nil
+}
+
+// getEthPubKey reads the pubkey and the address of the Ethereum app, the address is shown on the
+// device and requires user confirmation if display is set.
+func getEthPubKey(device ETHEREUM, path hd.BIP44Params, display bool) (types.PubKey, string, error) {
+	if path.CoinType != EthCoinType {
+		return nil, "", fmt.Errorf("invalid coin type %d of the Ethereum app, expected %d", path.CoinType, EthCoinType)
+	}
+
+	publicKey, addr, err := device.GetPublicKeyETH(path.DerivationPath(), display)
+	if err != nil {
+		if display {
+			return nil, "", fmt.Errorf("%w: address rejected for path %s", err, path.String())
+		}
+		return nil, "", fmt.Errorf("please open the %v app on the Ledger device - error: %v", ethAppName, err)
+	}
+
+	pub, err := crypto.UnmarshalPubkey(publicKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("error parsing public key: %v", err)
+	}
+
+	// check the address computed by the device
+	expectedAddr := crypto.PubkeyToAddress(*pub)
+	if !strings.EqualFold(strings.TrimPrefix(addr, "0x"), strings.TrimPrefix(expectedAddr.Hex(), "0x")) {
+		return nil, "", fmt.Errorf("the address %s does not match the public key", addr)
+	}
+
+	return &ethsecp256k1.PubKey{Key: crypto.CompressPubkey(pub)}, common.HexToAddress(addr).Hex(), nil
+}
@@ -0,0 +1,116 @@
+//go:build cgo && ledger && !test_ledger_mock
+// +build cgo,ledger,!test_ledger_mock
+
+package ledger
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ledger_go "github.com/zondax/ledger-go"
+)
+
+// The APDUs of the Ethereum app, see
+// https://github.com/LedgerHQ/app-ethereum/blob/develop/doc/ethapp.adoc
+const (
+	ethCLA                        = 0xe0
+	ethInsGetPublicKey            = 0x02
+	ethInsSignEIP712HashedMessage = 0x0c
+
+	ethP1NoDisplay = 0x00
+	ethP1Display   = 0x01
+	ethP2NoChain   = 0x00
+
+	hardenedOffset = 0x80000000
+)
+
+// ledgerEthereumApp drives the Ethereum app of a Ledger device.
+type ledgerEthereumApp struct {
+	device ledger_go.LedgerDevice
+}
+
+var _ ETHEREUM = ledgerEthereumApp{}
+
+// findLedgerEthereumApp connects to the first Ledger device, which should run the Ethereum app.
+func findLedgerEthereumApp() (ETHEREUM, error) {
+	device, err := ledger_go.NewLedgerAdmin().Connect(0)
+	if err != nil {
+		return nil, err
+	}
+
+	return ledgerEthereumApp{device: device}, nil
+}
+
+func (app ledgerEthereumApp) Close() error {
+	return app.device.Close()
+}
+
+// GetPublicKeyETH returns the uncompressed pubkey and the hex address of the derivation path.
+func (app ledgerEthereumApp) GetPublicKeyETH(derivationPath []uint32, display bool) ([]byte, string, error) {
+	path, err := serializeEthPath(derivationPath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	p1 := byte(ethP1NoDisplay)
+	if display {
+		p1 = ethP1Display
+	}
+	response, err := app.device.Exchange(append([]byte{ethCLA, ethInsGetPublicKey, p1, ethP2NoChain, byte(len(path))}, path...))
+	if err != nil {
+		return nil, "", err
+	}
+
+	// pubkey length || pubkey || address length || address
+	if len(response) < 1 || len(response) < 1+int(response[0])+1 {
+		return nil, "", errors.New("invalid response of the public key")
+	}
+	pubKey := response[1 : 1+response[0]]
+	response = response[1+response[0]:]
+	if len(response) < 1+int(response[0]) {
+		return nil, "", errors.New("invalid response of the address")
+	}
+
+	return pubKey, "0x" + string(response[1:1+response[0]]), nil
+}
+
+// SignEIP712HashedMessage signs the EIP-712 typed data, it returns a [R || S || V] signature.
+func (app ledgerEthereumApp) SignEIP712HashedMessage(derivationPath []uint32, domainSeparator, messageHash []byte) ([]byte, error) {
+	path, err := serializeEthPath(derivationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	data := append(append(path, domainSeparator...), messageHash...)
+	response, err := app.device.Exchange(append([]byte{ethCLA, ethInsSignEIP712HashedMessage, 0x00, 0x00, byte(len(data))}, data...))
+	if err != nil {
+		return nil, err
+	}
+
+	// V || R || S
+	if len(response) != 65 {
+		return nil, fmt.Errorf("invalid signature length: %d", len(response))
+	}
+
+	return append(response[1:], response[0]), nil
+}
+
+// serializeEthPath serializes the BIP44 derivation path, the purpose, the coin type and the account
+// are hardened.
+func serializeEthPath(derivationPath []uint32) ([]byte, error) {
+	if len(derivationPath) != 5 {
+		return nil, errors.New("invalid derivation path")
+	}
+
+	path := make([]byte, 1+4*len(derivationPath))
+	path[0] = byte(len(derivationPath))
+	for i, n := range derivationPath {
+		if i < 3 {
+			n |= hardenedOffset
+		}
+		binary.BigEndian.PutUint32(path[1+4*i:], n)
+	}
+
+	return path, nil
+}
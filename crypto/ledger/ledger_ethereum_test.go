@@ -0,0 +1,64 @@
+//go:build ledger || test_ledger_mock
+// +build ledger test_ledger_mock
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestEthPublicKey(t *testing.T) {
+	path := *hd.NewFundraiserParams(0, EthCoinType, 1)
+	priv, err := NewPrivKeyEthSecp256k1Unsafe(path)
+	require.NoError(t, err)
+
+	derivedPriv, err := hd.EthSecp256k1.Derive()(testdata.TestMnemonic, "", path.String())
+	require.NoError(t, err)
+	expectedPubKey := (&ethsecp256k1.PrivKey{Key: derivedPriv}).PubKey()
+	require.True(t, expectedPubKey.Equals(priv.PubKey()), "Is your device using test mnemonic: %s ?", testdata.TestMnemonic)
+
+	safePriv, addr, err := NewPrivKeyEthSecp256k1(path)
+	require.NoError(t, err)
+	require.True(t, priv.Equals(safePriv))
+	require.Equal(t, common.BytesToAddress(expectedPubKey.Address()).Hex(), addr)
+
+	require.NoError(t, ShowAddress(path, priv.PubKey(), ""))
+
+	// the keys of the Ethereum app are derived on the m/44'/60' path
+	_, err = NewPrivKeyEthSecp256k1Unsafe(*hd.NewFundraiserParams(0, 118, 0))
+	require.Error(t, err)
+}
+
+func TestEthSignEIP712(t *testing.T) {
+	path := *hd.NewFundraiserParams(0, EthCoinType, 0)
+	priv, err := NewPrivKeyEthSecp256k1Unsafe(path)
+	require.NoError(t, err)
+	require.NoError(t, priv.(PrivKeyLedgerEthSecp256k1).ValidateKey())
+
+	domainSeparator := crypto.Keccak256([]byte("domain"))
+	messageHash := crypto.Keccak256([]byte("message"))
+	sig, err := priv.(PrivKeyLedgerEthSecp256k1).SignEIP712(domainSeparator, messageHash)
+	require.NoError(t, err)
+	require.Len(t, sig, crypto.SignatureLength)
+
+	pubKey := priv.PubKey().(*ethsecp256k1.PubKey)
+	digest := EIP712Digest(domainSeparator, messageHash)
+	require.True(t, pubKey.VerifyDigestSignature(digest, sig))
+	recovered, err := crypto.SigToPub(digest, sig)
+	require.NoError(t, err)
+	require.Equal(t, pubKey.Bytes(), crypto.CompressPubkey(recovered))
+
+	// the Ethereum app only signs typed data
+	_, err = priv.Sign(digest)
+	require.Error(t, err)
+	_, err = priv.(PrivKeyLedgerEthSecp256k1).SignEIP712(domainSeparator[:16], messageHash)
+	require.Error(t, err)
+}
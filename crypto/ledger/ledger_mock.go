@@ -11,8 +11,10 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cosmos/go-bip39"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
 	csecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,6 +27,9 @@ func init() {
 	options.discoverLedger = func() (SECP256K1, error) {
 		return LedgerSECP256K1Mock{}, nil
 	}
+	options.discoverLedgerEth = func() (ETHEREUM, error) {
+		return LedgerEthereumMock{}, nil
+	}
 
 	initOptionsDefault()
 }
@@ -110,3 +115,62 @@ func (mock LedgerSECP256K1Mock) ShowAddressSECP256K1(bip32Path []uint32, hrp str
 	fmt.Printf("Request to show address for %v at %v", hrp, bip32Path)
 	return nil
 }
+
+// LedgerEthereumMock mocks the Ethereum app of a ledger device, the keys are derived from the
+// test mnemonic.
+type LedgerEthereumMock struct{}
+
+func (mock LedgerEthereumMock) Close() error {
+	return nil
+}
+
+// GetPublicKeyETH mocks a ledger device
+// as per the original API, it returns an uncompressed key and a hex address
+func (mock LedgerEthereumMock) GetPublicKeyETH(derivationPath []uint32, display bool) ([]byte, string, error) {
+	priv, err := mock.derivePrivKey(derivationPath)
+	if err != nil {
+		return nil, "", err
+	}
+	key, err := priv.ToECDSA()
+	if err != nil {
+		return nil, "", err
+	}
+
+	addr := ethcrypto.PubkeyToAddress(key.PublicKey).Hex()
+	if display {
+		fmt.Printf("Request to show address %v at %v", addr, derivationPath)
+	}
+
+	return ethcrypto.FromECDSAPub(&key.PublicKey), addr, nil
+}
+
+// SignEIP712HashedMessage mocks a ledger device
+// as per the original API, it returns a [R || S || V] signature with V as 27 or 28
+func (mock LedgerEthereumMock) SignEIP712HashedMessage(derivationPath []uint32, domainSeparator, messageHash []byte) ([]byte, error) {
+	priv, err := mock.derivePrivKey(derivationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := priv.Sign(ethcrypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, messageHash))
+	if err != nil {
+		return nil, err
+	}
+	sig[ethcrypto.RecoveryIDOffset] += 27
+
+	return sig, nil
+}
+
+func (mock LedgerEthereumMock) derivePrivKey(derivationPath []uint32) (*ethsecp256k1.PrivKey, error) {
+	if derivationPath[0] != 44 || derivationPath[1] != EthCoinType {
+		return nil, errors.New("invalid derivation path")
+	}
+
+	path := hd.NewParams(derivationPath[0], derivationPath[1], derivationPath[2], derivationPath[3] != 0, derivationPath[4])
+	derivedPriv, err := hd.EthSecp256k1.Derive()(testdata.TestMnemonic, "", path.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethsecp256k1.PrivKey{Key: derivedPriv}, nil
+}
@@ -19,6 +19,9 @@ func init() {
 
 		return device, nil
 	}
+	options.discoverLedgerEth = func() (ETHEREUM, error) {
+		return findLedgerEthereumApp()
+	}
 
 	initOptionsDefault()
 }
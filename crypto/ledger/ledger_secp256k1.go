@@ -10,6 +10,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -42,6 +43,7 @@ type (
 	// signing and usage across chains.
 	Options struct {
 		discoverLedger    discoverLedgerFn
+		discoverLedgerEth discoverLedgerEthFn
 		createPubkey      createPubkeyFn
 		appName           string
 		skipDERConversion bool
@@ -140,8 +142,13 @@ func (pkl PrivKeyLedgerSecp256k1) Sign(message []byte) ([]byte, error) {
 	return sign(device, pkl, message)
 }
 
-// ShowAddress triggers a ledger device to show the corresponding address.
+// ShowAddress triggers a ledger device to show the corresponding address, the addresses of the
+// eth_secp256k1 keys are shown by the Ethereum app.
 func ShowAddress(path hd.BIP44Params, expectedPubKey types.PubKey, accountAddressPrefix string) error {
+	if _, ok := expectedPubKey.(*ethsecp256k1.PubKey); ok {
+		return showEthAddress(path, expectedPubKey)
+	}
+
 	device, err := getDevice()
 	if err != nil {
 		return err
@@ -0,0 +1,357 @@
+//go:build gofuzz || go1.18
+
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// FuzzXAuthTxEIP712 builds txs with arbitrarily nested Any, one_of and repeated msg fields and
+// checks that the EIP-712 sign bytes are deterministic. The sign bytes of the txs built of the
+// msgs supported by referenceEIP712Hash are checked against its independent encoding.
+func FuzzXAuthTxEIP712(f *testing.F) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	authz.RegisterInterfaces(interfaceRegistry)
+	govtypes.RegisterInterfaces(interfaceRegistry)
+	group.RegisterInterfaces(interfaceRegistry)
+	gashubtypes.RegisterInterfaces(interfaceRegistry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712})
+
+	pubKey := ethsecp256k1.GenPrivKeyFromSecret([]byte("eip712")).PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	signerData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "greenfield_9000-1",
+		AccountNumber: 1,
+		Sequence:      1,
+		PubKey:        pubKey,
+	}
+
+	f.Add("memo", []byte{0})
+	f.Add("", []byte{1, 2, 3, 4, 5})
+	f.Add("nested", []byte{2, 12, 33, 7, 255, 128})
+
+	getSignBytes := func(t *testing.T, memo string, msgs []sdk.Msg, gasLimit uint64) []byte {
+		txBuilder := txConfig.NewTxBuilder()
+		if err := txBuilder.SetMsgs(msgs...); err != nil {
+			t.Fatal(err)
+		}
+		txBuilder.SetMemo(memo)
+		txBuilder.SetGasLimit(gasLimit)
+
+		signBytes, err := txConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signerData, txBuilder.GetTx())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signBytes
+	}
+
+	f.Fuzz(func(t *testing.T, memo string, layout []byte) {
+		if !utf8.ValidString(memo) || len(layout) == 0 || len(layout) > 16 {
+			return
+		}
+
+		var msgs, refMsgs []sdk.Msg
+		for _, b := range layout {
+			msgs = append(msgs, fuzzEIP712Msg(addr, memo, b))
+			refMsgs = append(refMsgs, fuzzReferenceEIP712Msg(addr, b))
+		}
+
+		signBytes := getSignBytes(t, memo, msgs, uint64(len(layout)))
+		sameSignBytes := getSignBytes(t, memo, msgs, uint64(len(layout)))
+		if !bytes.Equal(signBytes, sameSignBytes) {
+			t.Fatalf("non deterministic sign bytes %X != %X", signBytes, sameSignBytes)
+		}
+
+		refSignBytes := getSignBytes(t, memo, refMsgs, uint64(len(layout)))
+		expSignBytes, err := referenceEIP712Hash(signerData, memo, uint64(len(layout)), refMsgs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(refSignBytes, expSignBytes) {
+			t.Fatalf("sign bytes %X don't match the reference encoding %X", refSignBytes, expSignBytes)
+		}
+	})
+}
+
+// fuzzReferenceEIP712Msg returns a msg supported by referenceEIP712Hash whose shape is defined by b.
+func fuzzReferenceEIP712Msg(addr sdk.AccAddress, b byte) sdk.Msg {
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(int64(b)+1)))
+	msgSend := banktypes.NewMsgSend(addr, addr, coins)
+	count := int(b>>3)%4 + 1
+
+	switch b % 3 {
+	case 0:
+		return msgSend
+	case 1:
+		inner := make([]sdk.Msg, count)
+		for i := range inner {
+			inner[i] = msgSend
+		}
+		msgExec := authz.NewMsgExec(addr, inner)
+		return &msgExec
+	default:
+		outputs := make([]banktypes.Output, count)
+		for i := range outputs {
+			outputs[i] = banktypes.NewOutput(addr, coins)
+		}
+		return banktypes.NewMsgMultiSend([]banktypes.Input{banktypes.NewInput(addr, coins)}, outputs)
+	}
+}
+
+// referenceEIP712Hash encodes the tx with hand written EIP-712 types, independently of the type
+// derivation of x/auth/tx, and hashes it with go-ethereum. Only MsgSend, MsgMultiSend and MsgExec
+// of MsgSends are supported.
+func referenceEIP712Hash(signerData signing.SignerData, memo string, gasLimit uint64, msgs []sdk.Msg) ([]byte, error) {
+	chainID, err := sdk.ParseChainID(signerData.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	coinType := []apitypes.Type{{Name: "amount", Type: "string"}, {Name: "denom", Type: "string"}}
+	coinValues := func(coins sdk.Coins) []interface{} {
+		values := make([]interface{}, len(coins))
+		for i, coin := range coins {
+			values[i] = map[string]interface{}{"amount": coin.Amount.String(), "denom": coin.Denom}
+		}
+		return values
+	}
+	msgSendJSON := func(msg *banktypes.MsgSend) []byte {
+		amount := make([]interface{}, len(msg.Amount))
+		for i, coin := range msg.Amount {
+			amount[i] = map[string]interface{}{"amount": coin.Amount.String(), "denom": coin.Denom}
+		}
+		bz, err := json.Marshal(map[string]interface{}{
+			"@type":        sdk.MsgTypeURL(msg),
+			"amount":       amount,
+			"from_address": msg.FromAddress,
+			"to_address":   msg.ToAddress,
+		})
+		if err != nil {
+			panic(err)
+		}
+		return bz
+	}
+
+	types := apitypes.Types{
+		"EIP712Domain": {
+			{Name: "chainId", Type: "uint256"},
+			{Name: "name", Type: "string"},
+			{Name: "salt", Type: "string"},
+			{Name: "verifyingContract", Type: "string"},
+			{Name: "version", Type: "string"},
+		},
+		"Tx": {
+			{Name: "account_number", Type: "uint256"},
+			{Name: "chain_id", Type: "uint256"},
+			{Name: "fee", Type: "Fee"},
+			{Name: "memo", Type: "string"},
+			{Name: "sequence", Type: "uint256"},
+			{Name: "timeout_height", Type: "uint256"},
+		},
+		"Fee": {
+			{Name: "amount", Type: "Coin[]"},
+			{Name: "gas_limit", Type: "uint256"},
+			{Name: "granter", Type: "string"},
+			{Name: "payer", Type: "string"},
+		},
+		"Coin": {{Name: "amount", Type: "uint256"}, {Name: "denom", Type: "string"}},
+	}
+	message := apitypes.TypedDataMessage{
+		"account_number": fmt.Sprintf("%d", signerData.AccountNumber),
+		"chain_id":       chainID.String(),
+		"fee": map[string]interface{}{
+			"amount":    []interface{}{},
+			"gas_limit": fmt.Sprintf("%d", gasLimit),
+			"granter":   "",
+			"payer":     signerData.Address,
+		},
+		"memo":           memo,
+		"sequence":       fmt.Sprintf("%d", signerData.Sequence),
+		"timeout_height": "0",
+	}
+
+	for i, msg := range msgs {
+		msgType := fmt.Sprintf("Msg%d", i+1)
+		types["Tx"] = append(types["Tx"], apitypes.Type{Name: fmt.Sprintf("msg%d", i+1), Type: msgType})
+
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			amountType := fmt.Sprintf("TypeMsg%dAmount", i+1)
+			types[msgType] = []apitypes.Type{
+				{Name: "amount", Type: amountType + "[]"},
+				{Name: "from_address", Type: "string"},
+				{Name: "to_address", Type: "string"},
+				{Name: "type", Type: "string"},
+			}
+			types[amountType] = coinType
+			message[fmt.Sprintf("msg%d", i+1)] = map[string]interface{}{
+				"amount":       coinValues(msg.Amount),
+				"from_address": msg.FromAddress,
+				"to_address":   msg.ToAddress,
+				"type":         sdk.MsgTypeURL(msg),
+			}
+		case *banktypes.MsgMultiSend:
+			inputsType, outputsType := fmt.Sprintf("TypeMsg%dInputs", i+1), fmt.Sprintf("TypeMsg%dOutputs", i+1)
+			types[msgType] = []apitypes.Type{
+				{Name: "inputs", Type: inputsType + "[]"},
+				{Name: "outputs", Type: outputsType + "[]"},
+				{Name: "type", Type: "string"},
+			}
+			types[inputsType] = []apitypes.Type{{Name: "address", Type: "string"}, {Name: "coins", Type: inputsType + "Coins[]"}}
+			types[inputsType+"Coins"] = coinType
+			types[outputsType] = []apitypes.Type{{Name: "address", Type: "string"}, {Name: "coins", Type: outputsType + "Coins[]"}}
+			types[outputsType+"Coins"] = coinType
+
+			inputs := make([]interface{}, len(msg.Inputs))
+			for j, input := range msg.Inputs {
+				inputs[j] = map[string]interface{}{"address": input.Address, "coins": coinValues(input.Coins)}
+			}
+			outputs := make([]interface{}, len(msg.Outputs))
+			for j, output := range msg.Outputs {
+				outputs[j] = map[string]interface{}{"address": output.Address, "coins": coinValues(output.Coins)}
+			}
+			message[fmt.Sprintf("msg%d", i+1)] = map[string]interface{}{
+				"inputs":  inputs,
+				"outputs": outputs,
+				"type":    sdk.MsgTypeURL(msg),
+			}
+		case *authz.MsgExec:
+			types[msgType] = []apitypes.Type{
+				{Name: "grantee", Type: "string"},
+				{Name: "msgs", Type: "TypeAny[]"},
+				{Name: "type", Type: "string"},
+			}
+			types["TypeAny"] = []apitypes.Type{{Name: "type", Type: "string"}, {Name: "value", Type: "bytes"}}
+
+			inner, err := msg.GetMessages()
+			if err != nil {
+				return nil, err
+			}
+			anys := make([]interface{}, len(inner))
+			for j, innerMsg := range inner {
+				msgSend, ok := innerMsg.(*banktypes.MsgSend)
+				if !ok {
+					return nil, fmt.Errorf("unsupported inner msg %T", innerMsg)
+				}
+				anys[j] = map[string]interface{}{"type": sdk.MsgTypeURL(msgSend), "value": msgSendJSON(msgSend)}
+			}
+			message[fmt.Sprintf("msg%d", i+1)] = map[string]interface{}{
+				"grantee": msg.Grantee,
+				"msgs":    anys,
+				"type":    sdk.MsgTypeURL(msg),
+			}
+		default:
+			return nil, fmt.Errorf("unsupported msg %T", msg)
+		}
+	}
+
+	// the fields of the structs are ordered by name
+	txTypes := types["Tx"]
+	sort.Slice(txTypes, func(i, j int) bool { return txTypes[i].Name < txTypes[j].Name })
+
+	typedData := apitypes.TypedData{
+		Types:       types,
+		PrimaryType: "Tx",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Greenfield Tx",
+			Version:           "1.0.0",
+			ChainId:           math.NewHexOrDecimal256(chainID.Int64()),
+			VerifyingContract: "greenfield",
+			Salt:              "0",
+		},
+		Message: message,
+	}
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	return hash, err
+}
+
+// fuzzEIP712Msg returns a msg whose shape is defined by the bits of b.
+func fuzzEIP712Msg(addr sdk.AccAddress, memo string, b byte) sdk.Msg {
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(int64(b)+1)))
+	msgSend := banktypes.NewMsgSend(addr, addr, coins)
+	count := int(b>>3)%4 + 1
+
+	switch b % 6 {
+	case 0:
+		return msgSend
+	case 1:
+		inner := make([]sdk.Msg, count)
+		for i := range inner {
+			inner[i] = msgSend
+		}
+		msgExec := authz.NewMsgExec(addr, inner)
+		return &msgExec
+	case 2:
+		inner := []sdk.Msg{msgSend}
+		for i := 1; i < count; i++ {
+			inner = append(inner, fuzzEIP712Msg(addr, memo, b>>1))
+		}
+		msgProposal, err := govtypes.NewMsgSubmitProposal(inner, coins, addr.String(), memo, memo, memo)
+		if err != nil {
+			panic(err)
+		}
+		return msgProposal
+	case 3:
+		msgProposal := &group.MsgSubmitProposal{
+			GroupPolicyAddress: addr.String(),
+			Proposers:          []string{addr.String()},
+			Metadata:           memo,
+		}
+		inner := make([]sdk.Msg, count)
+		for i := range inner {
+			inner[i] = fuzzEIP712Msg(addr, memo, b>>2+byte(i))
+		}
+		if err := msgProposal.SetMsgs(inner); err != nil {
+			panic(err)
+		}
+		return msgProposal
+	case 4:
+		msgSetGasParams := &gashubtypes.MsgSetMsgGasParams{Authority: addr.String()}
+		for i := 0; i < count; i++ {
+			dynamicGasParams := &gashubtypes.MsgGasParams_DynamicGasParams{FixedGas: uint64(b), GasPerItem: uint64(i)}
+			var gasParams *gashubtypes.MsgGasParams
+			switch (int(b) + i) % 4 {
+			case 0:
+				gasParams = gashubtypes.NewMsgGasParamsWithFixedGas(memo, uint64(i))
+			case 1:
+				gasParams = gashubtypes.NewMsgGasParamsWithDynamicGas(memo, &gashubtypes.MsgGasParams_GrantType{GrantType: dynamicGasParams})
+			case 2:
+				gasParams = gashubtypes.NewMsgGasParamsWithDynamicGas(memo, &gashubtypes.MsgGasParams_MultiSendType{MultiSendType: dynamicGasParams})
+			default:
+				gasParams = &gashubtypes.MsgGasParams{MsgTypeUrl: memo}
+			}
+			msgSetGasParams.UpdateSet = append(msgSetGasParams.UpdateSet, gasParams)
+		}
+		return msgSetGasParams
+	default:
+		outputs := make([]banktypes.Output, count)
+		for i := range outputs {
+			outputs[i] = banktypes.NewOutput(addr, coins)
+		}
+		return banktypes.NewMsgMultiSend([]banktypes.Input{banktypes.NewInput(addr, coins)}, outputs)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	mintmodulev1 "cosmossdk.io/api/cosmos/mint/module/v1"
 	nftmodulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
 	paramsmodulev1 "cosmossdk.io/api/cosmos/params/module/v1"
+	sessionkeymodulev1 "cosmossdk.io/api/cosmos/sessionkey/module/v1"
 	slashingmodulev1 "cosmossdk.io/api/cosmos/slashing/module/v1"
 	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
 	txconfigv1 "cosmossdk.io/api/cosmos/tx/config/v1"
@@ -31,6 +32,8 @@ import (
 
 	crosschainmodulev1 "cosmossdk.io/api/cosmos/crosschain/module/v1"
 	oraclemodulev1 "cosmossdk.io/api/cosmos/oracle/module/v1"
+	sessionkeytypes "github.com/cosmos/cosmos-sdk/x/auth/sessionkey/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -69,6 +72,7 @@ var (
 		minttypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, nft.ModuleName, group.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
 		vestingtypes.ModuleName, consensustypes.ModuleName, crosschaintypes.ModuleName, oracletypes.ModuleName,
+		sessionkeytypes.ModuleName,
 	}
 
 	// module account permissions
@@ -78,6 +82,7 @@ var (
 		{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+		{Account: stakingtypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: nft.ModuleName},
 		{Account: crosschaintypes.ModuleName, Permissions: []string{authtypes.Minter}},
@@ -90,11 +95,16 @@ var (
 		minttypes.ModuleName,
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
+		stakingtypes.ModuleName,
 		nft.ModuleName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
 
+	// the EIP-712 domain of the txs signed with SIGN_MODE_EIP_712, it is shared by the
+	// tx config of the app and the tx config of the client context
+	eip712Domain = authtx.DefaultEIP712Domain
+
 	// application configuration (used by depinject)
 	AppConfig = appconfig.Compose(&appv1alpha1.Config{
 		Modules: []*appv1alpha1.ModuleConfig{
@@ -130,6 +140,7 @@ var (
 						crosschaintypes.ModuleName,
 						oracletypes.ModuleName,
 						gashubtypes.ModuleName,
+						sessionkeytypes.ModuleName,
 					},
 					EndBlockers: []string{
 						crisistypes.ModuleName,
@@ -154,6 +165,7 @@ var (
 						crosschaintypes.ModuleName,
 						oracletypes.ModuleName,
 						gashubtypes.ModuleName,
+						sessionkeytypes.ModuleName,
 					},
 					OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{
 						{
@@ -202,8 +214,15 @@ var (
 				Config: appconfig.WrapAny(&paramsmodulev1.Module{}),
 			},
 			{
-				Name:   "tx",
-				Config: appconfig.WrapAny(&txconfigv1.Config{}),
+				Name: "tx",
+				Config: appconfig.WrapAny(&txconfigv1.Config{
+					Eip712Domain: &txconfigv1.Eip712Domain{
+						Name:              eip712Domain.Name,
+						Version:           eip712Domain.Version,
+						VerifyingContract: eip712Domain.VerifyingContract,
+						Salt:              eip712Domain.Salt,
+					},
+				}),
 			},
 			{
 				Name:   genutiltypes.ModuleName,
@@ -274,6 +293,10 @@ var (
 				Name:   gashubtypes.ModuleName,
 				Config: appconfig.WrapAny(&gashubmodulev1.Module{}),
 			},
+			{
+				Name:   sessionkeytypes.ModuleName,
+				Config: appconfig.WrapAny(&sessionkeymodulev1.Module{}),
+			},
 		},
 	})
 )
@@ -22,6 +22,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/sessionkey"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -40,6 +41,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestSimAppExportAndBlockedAddrs(t *testing.T) {
@@ -200,6 +202,7 @@ func TestRunMigrations(t *testing.T) {
 					"crosschain":   crosschain.AppModule{}.ConsensusVersion(),
 					"oracle":       oracle.AppModule{}.ConsensusVersion(),
 					"gashub":       gashub.AppModule{}.ConsensusVersion(),
+					"sessionkey":   sessionkey.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -254,6 +257,7 @@ func TestInitGenesisOnMigration(t *testing.T) {
 			"crosschain":   crosschain.AppModule{}.ConsensusVersion(),
 			"oracle":       oracle.AppModule{}.ConsensusVersion(),
 			"gashub":       gashub.AppModule{}.ConsensusVersion(),
+			"sessionkey":   sessionkey.AppModule{}.ConsensusVersion(),
 		},
 	)
 	require.NoError(t, err)
@@ -279,3 +283,21 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 
 	require.NotNil(t, app.UpgradeKeeper.GetVersionSetter())
 }
+
+func TestVerifyUpgradeSchedule(t *testing.T) {
+	db := dbm.NewMemDB()
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	app := NewSimappWithCustomOptions(t, false, SetupOptions{
+		Logger:  logger,
+		DB:      db,
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+	require.NoError(t, app.VerifyUpgradeSchedule())
+
+	// publish a canonical schedule which the upgrade config of the node doesn't contain
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	require.NoError(t, app.UpgradeKeeper.SetUpgradeSchedule(ctx, []upgradetypes.Plan{{Name: "test", Height: 100}}))
+	app.Commit()
+
+	require.ErrorIs(t, app.VerifyUpgradeSchedule(), upgradetypes.ErrUpgradeScheduleMismatch)
+}
@@ -31,6 +31,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/sessionkey"
+	sessionkeykeeper "github.com/cosmos/cosmos-sdk/x/auth/sessionkey/keeper"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config" // import for side-effects
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -113,6 +115,7 @@ var (
 		crosschain.AppModuleBasic{},
 		oracle.AppModuleBasic{},
 		gashub.AppModuleBasic{},
+		sessionkey.AppModuleBasic{},
 	)
 )
 
@@ -152,6 +155,7 @@ type SimApp struct {
 	CrossChainKeeper      crosschainkeeper.Keeper
 	OracleKeeper          oraclekeeper.Keeper
 	GashubKeeper          gashubkeeper.Keeper
+	SessionKeyKeeper      sessionkeykeeper.Keeper
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -240,6 +244,7 @@ func NewSimApp(
 		&app.CrossChainKeeper,
 		&app.OracleKeeper,
 		&app.GashubKeeper,
+		&app.SessionKeyKeeper,
 	); err != nil {
 		panic(err)
 	}
@@ -324,6 +329,11 @@ func NewSimApp(
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
 	}
+	if loadLatest {
+		if err := app.VerifyUpgradeSchedule(); err != nil {
+			panic(err)
+		}
+	}
 
 	return app
 }
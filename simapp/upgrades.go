@@ -1,11 +1,15 @@
 package simapp
 
 import (
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	sessionkeytypes "github.com/cosmos/cosmos-sdk/x/auth/sessionkey/types"
 )
 
 func (app *SimApp) RegisterUpgradeHandlers(chainID string, serverCfg *serverconfig.Config) error {
@@ -16,6 +20,13 @@ func (app *SimApp) RegisterUpgradeHandlers(chainID string, serverCfg *serverconf
 		return err
 	}
 
+	// Add the stores of the new modules at the upgrade heights
+	if height, found := app.UpgradeKeeper.GetUpgradeHeight(upgradetypes.Nagqu); found {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(height, &storetypes.StoreUpgrades{
+			Added: []string{sessionkeytypes.StoreKey},
+		}))
+	}
+
 	// Register the upgrade handler
 	app.UpgradeKeeper.SetUpgradeHandler(upgradetypes.EnablePublicDelegationUpgrade,
 		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
@@ -23,6 +34,13 @@ func (app *SimApp) RegisterUpgradeHandlers(chainID string, serverCfg *serverconf
 			return fromVM, nil
 		})
 
+	app.UpgradeKeeper.SetUpgradeHandler(upgradetypes.Nagqu,
+		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			app.Logger().Info("upgrade to ", plan.Name)
+			app.StakingKeeper.InitValidatorKeyHistory(ctx)
+			return fromVM, nil
+		})
+
 	// Register the upgrade initializer
 	app.UpgradeKeeper.SetUpgradeInitializer(upgradetypes.EnablePublicDelegationUpgrade,
 		func() error {
@@ -31,5 +49,23 @@ func (app *SimApp) RegisterUpgradeHandlers(chainID string, serverCfg *serverconf
 		},
 	)
 
+	app.UpgradeKeeper.SetUpgradeInitializer(upgradetypes.Nagqu,
+		func() error {
+			app.Logger().Info("Init Nagqu upgrade")
+			return app.GovKeeper.RegisterCrossChainGovCallApp()
+		},
+	)
+
 	return nil
 }
+
+// VerifyUpgradeSchedule checks the upgrade plans of the node against the canonical upgrade schedule of the
+// latest committed state, so that a node with a stale upgrade config fails at startup instead of halting
+// at the upgrade height.
+func (app *SimApp) VerifyUpgradeSchedule() error {
+	ctx := app.NewUncachedContext(false, tmproto.Header{ChainID: app.ChainID(), Height: app.LastBlockHeight() + 1})
+	if app.UpgradeKeeper.IsDuePlanMismatch(ctx) {
+		return upgradetypes.ErrUpgradeScheduleMismatch.Wrapf("the plans due at height %d differ", ctx.BlockHeight())
+	}
+	return app.UpgradeKeeper.VerifyUpgradeSchedule(ctx)
+}
@@ -23,7 +23,7 @@ func (s *E2ETestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"voting_params":{"voting_period":"172800s"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s","voting_period":"172800s","quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","min_initial_deposit_ratio":"0.000000000000000000","burn_vote_quorum":false,"burn_proposal_deposit_prevote":false,"burn_vote_veto":true}}`,
+			`{"voting_params":{"voting_period":"172800s"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s","voting_period":"172800s","quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","min_initial_deposit_ratio":"0.000000000000000000","burn_vote_quorum":false,"burn_proposal_deposit_prevote":false,"burn_vote_veto":true,"cross_chain_call_targets":[],"expedited_voting_period":"86400s","expedited_quorum":"0.500000000000000000","expedited_threshold":"0.667000000000000000","expedited_msg_type_urls":["/cosmos.crosschain.v1.MsgUpdateChannelPermissions","/cosmos.slashing.v1beta1.MsgImpeach"],"allowed_msg_type_urls":[],"denied_msg_type_urls":[],"msg_type_rules":[{"msg_type_url":"/cosmos.crosschain.v1.MsgMintModuleTokens","min_deposit":[],"threshold":"0.667000000000000000"}]}}`,
 		},
 		{
 			"text output",
@@ -35,14 +35,27 @@ deposit_params:
   - amount: "10000000"
     denom: stake
 params:
+  allowed_msg_type_urls: []
   burn_proposal_deposit_prevote: false
   burn_vote_quorum: false
   burn_vote_veto: true
+  cross_chain_call_targets: []
+  denied_msg_type_urls: []
+  expedited_msg_type_urls:
+  - /cosmos.crosschain.v1.MsgUpdateChannelPermissions
+  - /cosmos.slashing.v1beta1.MsgImpeach
+  expedited_quorum: "0.500000000000000000"
+  expedited_threshold: "0.667000000000000000"
+  expedited_voting_period: 86400s
   max_deposit_period: 172800s
   min_deposit:
   - amount: "10000000"
     denom: stake
   min_initial_deposit_ratio: "0.000000000000000000"
+  msg_type_rules:
+  - min_deposit: []
+    msg_type_url: /cosmos.crosschain.v1.MsgMintModuleTokens
+    threshold: "0.667000000000000000"
   quorum: "0.334000000000000000"
   threshold: "0.500000000000000000"
   veto_threshold: "0.334000000000000000"
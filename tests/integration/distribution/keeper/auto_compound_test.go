@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func TestAutoCompoundRewards(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	upgradedCtx := sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu || name == upgradetypes.EnablePublicDelegationUpgrade
+	}, ctx.Logger()).WithChainID(ctx.ChainID())
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	params := distrKeeper.GetParams(ctx)
+	params.AutoCompoundThreshold = sdk.NewInt(20)
+	params.MaxAutoCompoundDelegators = 1
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 4, sdk.NewInt(1000))
+	valAddr, delAddrs, other := addrs[0], addrs[1:3], addrs[3]
+	if bytes.Compare(delAddrs[0], delAddrs[1]) > 0 {
+		delAddrs[0], delAddrs[1] = delAddrs[1], delAddrs[0]
+	}
+
+	tstaking := stakingtestutil.NewHelper(t, ctx, stakingKeeper)
+	tstaking.CreateValidator(valAddr, valConsPk0, sdk.NewInt(100), true)
+	tstaking.Ctx = upgradedCtx
+	tstaking.Delegate(delAddrs[0], valAddr, sdk.NewInt(100))
+	tstaking.Delegate(delAddrs[1], valAddr, sdk.NewInt(100))
+	tstaking.Delegate(other, valAddr, sdk.NewInt(100))
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	// the auto compounding can't be enabled before the upgrade
+	_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound(delAddrs[0], true))
+	require.Error(t, err)
+
+	for _, delAddr := range delAddrs {
+		_, err = msgServer.SetAutoCompound(upgradedCtx, types.NewMsgSetAutoCompound(delAddr, true))
+		require.NoError(t, err)
+	}
+	res, err := keeper.NewQuerier(distrKeeper).DelegatorAutoCompound(sdk.WrapSDKContext(ctx), &types.QueryDelegatorAutoCompoundRequest{DelegatorAddress: delAddrs[0].String()})
+	require.NoError(t, err)
+	require.True(t, res.Enabled)
+
+	// the rewards withdrawn to another address can't be compounded
+	require.NoError(t, distrKeeper.SetWithdrawAddr(ctx, other, valAddr))
+	_, err = msgServer.SetAutoCompound(upgradedCtx, types.NewMsgSetAutoCompound(other, true))
+	require.ErrorIs(t, err, types.ErrAutoCompoundWithdrawAddr)
+
+	delegatedTokens := func(delAddr sdk.AccAddress) math.Int {
+		validator := stakingKeeper.Validator(ctx, valAddr)
+		return validator.TokensFromShares(stakingKeeper.Delegation(ctx, delAddr, valAddr).GetShares()).TruncateInt()
+	}
+	allocate := func(amount int64) {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		upgradedCtx = upgradedCtx.WithBlockHeight(ctx.BlockHeight())
+		rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+		require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, types.ModuleName, rewards))
+		distrKeeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddr), sdk.NewDecCoinsFromCoins(rewards...))
+	}
+
+	// the rewards below the threshold are not compounded
+	allocate(40)
+	distribution.EndBlocker(upgradedCtx, distrKeeper)
+	distribution.EndBlocker(upgradedCtx, distrKeeper)
+	require.Equal(t, sdk.NewInt(100), delegatedTokens(delAddrs[0]))
+	require.Equal(t, sdk.NewInt(100), delegatedTokens(delAddrs[1]))
+
+	// the compounding isn't processed before the upgrade
+	allocate(160)
+	distribution.EndBlocker(ctx, distrKeeper)
+	require.Equal(t, sdk.NewInt(100), delegatedTokens(delAddrs[0]))
+
+	// a single delegator is processed per block, the rewards of each delegator are 200 / 4
+	distribution.EndBlocker(upgradedCtx, distrKeeper)
+	require.Equal(t, sdk.NewInt(150), delegatedTokens(delAddrs[0]))
+	require.Equal(t, sdk.NewInt(100), delegatedTokens(delAddrs[1]))
+	require.Equal(t, sdk.NewInt(100), delegatedTokens(other))
+
+	distribution.EndBlocker(upgradedCtx, distrKeeper)
+	require.Equal(t, sdk.NewInt(150), delegatedTokens(delAddrs[0]))
+	require.Equal(t, sdk.NewInt(150), delegatedTokens(delAddrs[1]))
+	require.Equal(t, sdk.NewInt(1000-100), bankKeeper.GetBalance(ctx, delAddrs[1], sdk.DefaultBondDenom).Amount)
+
+	// the disabled delegators are not compounded, the rewards of the enabled delegator are 250 * 150 / 500
+	_, err = msgServer.SetAutoCompound(upgradedCtx, types.NewMsgSetAutoCompound(delAddrs[0], false))
+	require.NoError(t, err)
+	allocate(250)
+	distribution.EndBlocker(upgradedCtx, distrKeeper)
+	distribution.EndBlocker(upgradedCtx, distrKeeper)
+	require.Equal(t, sdk.NewInt(150), delegatedTokens(delAddrs[0]))
+	require.Equal(t, sdk.NewInt(225), delegatedTokens(delAddrs[1]))
+
+	// the compounding is disabled by a zero max auto compound delegators
+	params.MaxAutoCompoundDelegators = 0
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	allocate(250)
+	distribution.EndBlocker(upgradedCtx, distrKeeper)
+	require.Equal(t, sdk.NewInt(225), delegatedTokens(delAddrs[1]))
+
+	genState := distrKeeper.ExportGenesis(ctx)
+	require.Equal(t, []string{delAddrs[1].String()}, genState.AutoCompoundDelegators)
+}
+
+func TestAutoCompoundRewardsOperations(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	upgradedCtx := sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu || name == upgradetypes.EnablePublicDelegationUpgrade
+	}, ctx.Logger()).WithChainID(ctx.ChainID())
+
+	// the withdrawal and the delegation of a compounding are two operations
+	params := distrKeeper.GetParams(ctx)
+	params.AutoCompoundThreshold = sdk.NewInt(20)
+	params.MaxAutoCompoundDelegators = 2
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 3, sdk.NewInt(1000))
+	valAddrs, delAddr := addrs[0:2], addrs[2]
+	if bytes.Compare(valAddrs[0], valAddrs[1]) > 0 {
+		valAddrs[0], valAddrs[1] = valAddrs[1], valAddrs[0]
+	}
+
+	tstaking := stakingtestutil.NewHelper(t, ctx, stakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], valConsPk0, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[1], valConsPk1, sdk.NewInt(100), true)
+	tstaking.Ctx = upgradedCtx
+	tstaking.Delegate(delAddr, valAddrs[0], sdk.NewInt(100))
+	tstaking.Delegate(delAddr, valAddrs[1], sdk.NewInt(100))
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	_, err = keeper.NewMsgServerImpl(distrKeeper).SetAutoCompound(upgradedCtx, types.NewMsgSetAutoCompound(delAddr, true))
+	require.NoError(t, err)
+
+	delegatedTokens := func(valAddr sdk.AccAddress) math.Int {
+		validator := stakingKeeper.Validator(ctx, valAddr)
+		return validator.TokensFromShares(stakingKeeper.Delegation(ctx, delAddr, valAddr).GetShares()).TruncateInt()
+	}
+	allocate := func(amount int64) {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		upgradedCtx = upgradedCtx.WithBlockHeight(ctx.BlockHeight())
+		for _, valAddr := range valAddrs {
+			rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+			require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, types.ModuleName, rewards))
+			distrKeeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddr), sdk.NewDecCoinsFromCoins(rewards...))
+		}
+	}
+
+	// a single delegation of the delegator is compounded per block, the rewards of each delegation are 200 / 2
+	allocate(200)
+	distribution.EndBlocker(upgradedCtx, distrKeeper)
+	require.Equal(t, sdk.NewInt(200), delegatedTokens(valAddrs[0]))
+	require.Equal(t, sdk.NewInt(100), delegatedTokens(valAddrs[1]))
+
+	distribution.EndBlocker(upgradedCtx, distrKeeper)
+	require.Equal(t, sdk.NewInt(200), delegatedTokens(valAddrs[0]))
+	require.Equal(t, sdk.NewInt(200), delegatedTokens(valAddrs[1]))
+
+	// the compounding started below the limit is completed, the rewards of each delegation are 300 * 200 / 300
+	params.MaxAutoCompoundDelegators = 3
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	allocate(300)
+	distribution.EndBlocker(upgradedCtx, distrKeeper)
+	require.Equal(t, sdk.NewInt(400), delegatedTokens(valAddrs[0]))
+	require.Equal(t, sdk.NewInt(400), delegatedTokens(valAddrs[1]))
+}
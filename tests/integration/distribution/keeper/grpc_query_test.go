@@ -89,10 +89,12 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 			"valid request",
 			func() {
 				params = types.Params{
-					CommunityTax:        sdk.NewDecWithPrec(3, 1),
-					BaseProposerReward:  sdk.ZeroDec(),
-					BonusProposerReward: sdk.ZeroDec(),
-					WithdrawAddrEnabled: true,
+					CommunityTax:              sdk.NewDecWithPrec(3, 1),
+					BaseProposerReward:        sdk.ZeroDec(),
+					BonusProposerReward:       sdk.ZeroDec(),
+					WithdrawAddrEnabled:       true,
+					AutoCompoundThreshold:     sdk.NewInt(100),
+					MaxAutoCompoundDelegators: 10,
 				}
 
 				suite.NoError(suite.distrKeeper.SetParams(ctx, params))
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"gotest.tools/v3/assert"
 
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
@@ -29,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 var (
@@ -78,7 +80,8 @@ func initFixture(t assert.TestingT) *fixture {
 	assert.NilError(t, err)
 
 	router := types.NewRouter()
-	router = router.AddRoute(types.RouteEquivocation, testEquivocationHandler(evidenceKeeper))
+	router = router.AddRoute(types.RouteEquivocation, testEquivocationHandler(evidenceKeeper)).
+		AddRoute(types.RouteRelayerDoubleSign, keeper.NewRelayerDoubleSignHandler(evidenceKeeper))
 	evidenceKeeper.SetRouter(router)
 
 	f.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
@@ -197,6 +200,128 @@ func TestHandleDoubleSign_TooOld(t *testing.T) {
 	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())) == false)
 }
 
+func TestHandleRelayerDoubleSign(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	upgradedCtx := sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, ctx.Logger()).WithChainID(ctx.ChainID())
+	populateValidators(t, f)
+
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, val, 100, true)
+	staking.EndBlocker(ctx, f.stakingKeeper)
+
+	// replace the bls key of the validator with a known one
+	blsSecretKey, err := bls.RandKey()
+	assert.NilError(t, err)
+	validator, found := f.stakingKeeper.GetValidator(ctx, operatorAddr)
+	assert.Assert(t, found)
+	validator.BlsKey = blsSecretKey.PublicKey().Marshal()
+	f.stakingKeeper.SetValidator(ctx, validator)
+	f.stakingKeeper.RecordValidatorKeys(ctx, validator)
+
+	// handle a signature to set signing info
+	f.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), selfDelegation.Int64(), true)
+
+	// rotate the bls key of the validator at height 10
+	rotatedSecretKey, err := bls.RandKey()
+	assert.NilError(t, err)
+	ctx = ctx.WithBlockHeight(10)
+	upgradedCtx = upgradedCtx.WithBlockHeight(10)
+	validator.BlsKey = rotatedSecretKey.PublicKey().Marshal()
+	f.stakingKeeper.SetValidator(ctx, validator)
+	f.stakingKeeper.RecordValidatorKeys(ctx, validator)
+
+	newVote := func(payload []byte, secretKey bls.SecretKey) types.ClaimVote {
+		vote := types.ClaimVote{
+			SrcChainId:  56,
+			DestChainId: 1017,
+			Sequence:    10,
+			Timestamp:   uint64(time.Now().Unix()),
+			Payload:     payload,
+		}
+		signBytes := vote.GetBlsSignBytes()
+		vote.Signature = secretKey.Sign(signBytes[:]).Marshal()
+		return vote
+	}
+	evidence := &types.RelayerDoubleSign{
+		ValidatorAddress: operatorAddr.String(),
+		VoteA:            newVote([]byte("payload a"), blsSecretKey),
+		VoteB:            newVote([]byte("payload b"), blsSecretKey),
+		Height:           5,
+	}
+
+	// the evidence is not handled before the upgrade
+	cacheCtx, _ := ctx.CacheContext()
+	assert.ErrorContains(t, f.evidenceKeeper.SubmitEvidence(cacheCtx, evidence), "not enabled")
+
+	// the votes must conflict
+	cacheCtx, _ = upgradedCtx.CacheContext()
+	assert.ErrorContains(t, f.evidenceKeeper.SubmitEvidence(cacheCtx, &types.RelayerDoubleSign{
+		ValidatorAddress: operatorAddr.String(),
+		VoteA:            evidence.VoteA,
+		VoteB:            evidence.VoteA,
+		Height:           evidence.Height,
+	}), "same payload")
+
+	// the height must not be in the future
+	cacheCtx, _ = upgradedCtx.CacheContext()
+	assert.ErrorContains(t, f.evidenceKeeper.SubmitEvidence(cacheCtx, &types.RelayerDoubleSign{
+		ValidatorAddress: operatorAddr.String(),
+		VoteA:            evidence.VoteA,
+		VoteB:            evidence.VoteB,
+		Height:           11,
+	}), "in the future")
+
+	// the votes must be signed by the bls key of the validator
+	otherSecretKey, err := bls.RandKey()
+	assert.NilError(t, err)
+	cacheCtx, _ = upgradedCtx.CacheContext()
+	assert.ErrorContains(t, f.evidenceKeeper.SubmitEvidence(cacheCtx, &types.RelayerDoubleSign{
+		ValidatorAddress: operatorAddr.String(),
+		VoteA:            evidence.VoteA,
+		VoteB:            newVote([]byte("payload b"), otherSecretKey),
+		Height:           evidence.Height,
+	}), types.ErrInvalidBlsSignature.Error())
+
+	// the votes signed by the previous bls key are not verified after the rotation
+	cacheCtx, _ = upgradedCtx.CacheContext()
+	assert.ErrorContains(t, f.evidenceKeeper.SubmitEvidence(cacheCtx, &types.RelayerDoubleSign{
+		ValidatorAddress: operatorAddr.String(),
+		VoteA:            evidence.VoteA,
+		VoteB:            evidence.VoteB,
+		Height:           10,
+	}), types.ErrInvalidBlsSignature.Error())
+
+	oldTokens := f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	assert.NilError(t, f.evidenceKeeper.SubmitEvidence(upgradedCtx, evidence))
+
+	// should be jailed, tombstoned and slashed
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
+	slashed := sdk.NewDecFromInt(oldTokens).Mul(f.slashingKeeper.SlashFractionDoubleSign(ctx)).TruncateInt()
+	assert.DeepEqual(t, oldTokens.Sub(slashed), f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens())
+
+	_, found = f.evidenceKeeper.GetEvidence(ctx, evidence.Hash())
+	assert.Assert(t, found)
+
+	// submit duplicate evidence
+	assert.ErrorContains(t, f.evidenceKeeper.SubmitEvidence(upgradedCtx, evidence), types.ErrEvidenceExists.Error())
+
+	// the tombstoned validator can't be slashed again
+	cacheCtx, _ = upgradedCtx.CacheContext()
+	assert.ErrorContains(t, f.evidenceKeeper.SubmitEvidence(cacheCtx, &types.RelayerDoubleSign{
+		ValidatorAddress: operatorAddr.String(),
+		VoteA:            evidence.VoteB,
+		VoteB:            evidence.VoteA,
+		Height:           evidence.Height,
+	}), "tombstoned")
+}
+
 func populateValidators(t assert.TestingT, f *fixture) {
 	// add accounts and set total supply
 	totalSupplyAmt := initAmt.MulRaw(int64(len(valAddresses)))
@@ -691,13 +691,16 @@ func (suite *DeterministicTestSuite) TestGRPCRedelegations() {
 func (suite *DeterministicTestSuite) TestGRPCParams() {
 	rapid.Check(suite.T(), func(t *rapid.T) {
 		params := stakingtypes.Params{
-			BondDenom:         rapid.StringMatching(sdk.DefaultCoinDenomRegex()).Draw(t, "bond-denom"),
-			UnbondingTime:     durationGenerator().Draw(t, "duration"),
-			MaxValidators:     rapid.Uint32Min(1).Draw(t, "max-validators"),
-			MaxEntries:        rapid.Uint32Min(1).Draw(t, "max-entries"),
-			HistoricalEntries: rapid.Uint32Min(1).Draw(t, "historical-entries"),
-			MinCommissionRate: sdk.NewDecWithPrec(rapid.Int64Range(0, 100).Draw(t, "commission"), 2),
-			MinSelfDelegation: sdk.NewInt(rapid.Int64Range(1, 100).Draw(t, "self-delegation")),
+			BondDenom:                   rapid.StringMatching(sdk.DefaultCoinDenomRegex()).Draw(t, "bond-denom"),
+			UnbondingTime:               durationGenerator().Draw(t, "duration"),
+			MaxValidators:               rapid.Uint32Min(1).Draw(t, "max-validators"),
+			MaxEntries:                  rapid.Uint32Min(1).Draw(t, "max-entries"),
+			HistoricalEntries:           rapid.Uint32Min(1).Draw(t, "historical-entries"),
+			MinCommissionRate:           sdk.NewDecWithPrec(rapid.Int64Range(0, 100).Draw(t, "commission"), 2),
+			MinSelfDelegation:           sdk.NewInt(rapid.Int64Range(1, 100).Draw(t, "self-delegation")),
+			BlsKeyOverlapBlocks:         rapid.Uint64Min(1).Draw(t, "bls-key-overlap-blocks"),
+			ValidatorApplicationPeriod:  durationGenerator().Draw(t, "validator-application-period"),
+			ValidatorRetirementCooldown: durationGenerator().Draw(t, "validator-retirement-cooldown"),
 		}
 
 		err := suite.stakingKeeper.SetParams(suite.ctx, params)
@@ -707,13 +710,16 @@ func (suite *DeterministicTestSuite) TestGRPCParams() {
 	})
 
 	params := stakingtypes.Params{
-		BondDenom:         "denom",
-		UnbondingTime:     time.Hour,
-		MaxValidators:     85,
-		MaxEntries:        5,
-		HistoricalEntries: 5,
-		MinCommissionRate: sdk.NewDecWithPrec(5, 2),
-		MinSelfDelegation: sdk.NewInt(1),
+		BondDenom:                   "denom",
+		UnbondingTime:               time.Hour,
+		MaxValidators:               85,
+		MaxEntries:                  5,
+		HistoricalEntries:           5,
+		MinCommissionRate:           sdk.NewDecWithPrec(5, 2),
+		MinSelfDelegation:           sdk.NewInt(1),
+		BlsKeyOverlapBlocks:         stakingtypes.DefaultBlsKeyOverlapBlocks,
+		ValidatorApplicationPeriod:  stakingtypes.DefaultValidatorApplicationPeriod,
+		ValidatorRetirementCooldown: stakingtypes.DefaultValidatorRetirementCooldown,
 	}
 
 	err := suite.stakingKeeper.SetParams(suite.ctx, params)
@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/simapp"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func TestTokenizeShares(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	distrMsgServer := distrkeeper.NewMsgServerImpl(app.DistrKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	upgradedCtx := sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu || name == upgradetypes.EnablePublicDelegationUpgrade
+	}, ctx.Logger()).WithChainID(ctx.ChainID())
+
+	tokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 100)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 4, tokens)
+	delAddr, owner, holder := addrs[1], addrs[2], addrs[3]
+	validator := createTokenizeShareValidator(t, app, ctx, addrs[0], tokens)
+	valAddr := validator.GetOperator()
+	_, err := msgServer.Delegate(upgradedCtx, types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, tokens)))
+	require.NoError(t, err)
+
+	// the delegation can't be tokenized before the upgrade
+	quarter := tokens.QuoRaw(4)
+	msg := types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, quarter), owner)
+	_, err = msgServer.TokenizeShares(ctx, msg)
+	require.Error(t, err)
+
+	// the self delegation can't be tokenized
+	_, err = msgServer.TokenizeShares(upgradedCtx, types.NewMsgTokenizeShares(validator.GetSelfDelegator(), valAddr, sdk.NewCoin(bondDenom, quarter), owner))
+	require.ErrorIs(t, err, types.ErrInvalidTokenizedShares)
+
+	res, err := msgServer.TokenizeShares(upgradedCtx, msg)
+	require.NoError(t, err)
+	shareDenom := types.GetTokenizeShareDenom(valAddr, 1)
+	require.Equal(t, sdk.NewCoin(shareDenom, quarter), res.Amount)
+	require.Equal(t, res.Amount, app.BankKeeper.GetBalance(ctx, delAddr, shareDenom))
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, owner.String(), record.Owner)
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(quarter), delegation.Shares)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(tokens.Sub(quarter)), delegation.Shares)
+	require.Equal(t, quarter, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the tokenized delegations can't exceed 25% of the total bonded tokens, the failed messages run on a
+	// cache context as their writes are discarded with the transaction
+	cacheCtx, _ := upgradedCtx.CacheContext()
+	_, err = msgServer.TokenizeShares(cacheCtx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, tokens.QuoRaw(2)), owner))
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	// the rewards of the tokenized delegation are withdrawn by the owner
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	upgradedCtx = upgradedCtx.WithBlockHeight(ctx.BlockHeight())
+	rewards := sdk.NewCoins(sdk.NewCoin(bondDenom, tokens.QuoRaw(100)))
+	require.NoError(t, banktestutil.FundModuleAccount(app.BankKeeper, ctx, distrtypes.ModuleName, rewards))
+	app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddr), sdk.NewDecCoinsFromCoins(rewards...))
+
+	_, err = distrMsgServer.WithdrawTokenizeShareRecordReward(upgradedCtx, distrtypes.NewMsgWithdrawTokenizeShareRecordReward(delAddr, 1))
+	require.ErrorIs(t, err, distrtypes.ErrNotTokenizeShareRecordOwner)
+	withdrawRes, err := distrMsgServer.WithdrawTokenizeShareRecordReward(upgradedCtx, distrtypes.NewMsgWithdrawTokenizeShareRecordReward(owner, 1))
+	require.NoError(t, err)
+	require.True(t, withdrawRes.Amount.IsAllPositive())
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// the share tokens are transferable and redeemable by any holder
+	half := quarter.QuoRaw(2)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delAddr, holder, sdk.NewCoins(sdk.NewCoin(shareDenom, half))))
+	redeemRes, err := msgServer.RedeemTokensForShares(upgradedCtx, types.NewMsgRedeemTokensForShares(holder, sdk.NewCoin(shareDenom, half)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(bondDenom, half), redeemRes.Amount)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, holder, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(half), delegation.Shares)
+	require.Equal(t, quarter.Sub(half), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the tokenized delegation is slashed like any other delegation
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), validator.ConsensusPower(app.StakingKeeper.PowerReduction(ctx)), sdk.NewDecWithPrec(1, 1))
+	slashed := sdk.NewDecFromInt(quarter.Sub(half)).Mul(sdk.NewDecWithPrec(9, 1)).TruncateInt()
+	require.True(t, slashed.Sub(app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)).Abs().LTE(sdk.OneInt()))
+
+	// the record is deleted once all the share tokens are redeemed
+	remaining := app.BankKeeper.GetBalance(ctx, delAddr, shareDenom)
+	redeemRes, err = msgServer.RedeemTokensForShares(upgradedCtx, types.NewMsgRedeemTokensForShares(delAddr, remaining))
+	require.NoError(t, err)
+	require.True(t, slashed.Sub(redeemRes.Amount.Amount).Abs().LTE(sdk.OneInt()))
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(t, found)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx, shareDenom).IsZero())
+
+	_, err = msgServer.RedeemTokensForShares(upgradedCtx, types.NewMsgRedeemTokensForShares(delAddr, remaining))
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotFound)
+}
+
+func TestTokenizeSharesValidatorCap(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu || name == upgradetypes.EnablePublicDelegationUpgrade
+	}, ctx.Logger()).WithChainID(ctx.ChainID())
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(1, 1)
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+	tokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 100)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, tokens)
+	delAddr := addrs[1]
+	validator := createTokenizeShareValidator(t, app, ctx, addrs[0], tokens)
+	valAddr := validator.GetOperator()
+	_, err := msgServer.Delegate(ctx, types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, tokens)))
+	require.NoError(t, err)
+
+	// the tokenized shares can't exceed 10% of the delegator shares of the validator
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.TokenizeShares(cacheCtx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, tokens.QuoRaw(4)), delAddr))
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, tokens.QuoRaw(5)), delAddr))
+	require.NoError(t, err)
+
+	// the tokenization is disabled by a zero cap
+	params.ValidatorLiquidStakingCap = sdk.ZeroDec()
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.TokenizeShares(cacheCtx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, sdk.OneInt()), delAddr))
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// the records are queryable
+	recordsRes, err := keeper.Querier{Keeper: app.StakingKeeper}.TokenizeShareRecordsOwned(sdk.WrapSDKContext(ctx), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: delAddr.String()})
+	require.NoError(t, err)
+	require.Len(t, recordsRes.Records, 1)
+	totalRes, err := keeper.Querier{Keeper: app.StakingKeeper}.TotalLiquidStaked(sdk.WrapSDKContext(ctx), &types.QueryTotalLiquidStakedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, tokens.QuoRaw(5), totalRes.Tokens)
+
+	// the tokenized delegations of the validators which are not bonded aren't counted in the global cap
+	require.Equal(t, tokens.QuoRaw(5), app.StakingKeeper.GetBondedLiquidStakedTokens(ctx))
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	app.StakingKeeper.Jail(ctx, consAddr)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+	require.True(t, app.StakingKeeper.GetBondedLiquidStakedTokens(ctx).IsZero())
+	require.Equal(t, tokens.QuoRaw(5), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
+
+// createTokenizeShareValidator creates a bonded validator with the given self delegation.
+func createTokenizeShareValidator(t *testing.T, app *simapp.SimApp, ctx sdk.Context, valAddr sdk.AccAddress, selfBond math.Int) types.Validator {
+	validator := testutil.NewValidator(t, valAddr, simtestutil.CreateTestPubKeys(1)[0])
+	validator.MinSelfDelegation = math.OneInt()
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+	app.StakingKeeper.SetNewValidatorByPowerIndex(ctx, validator)
+	require.NoError(t, app.StakingKeeper.Hooks().AfterValidatorCreated(ctx, valAddr))
+
+	_, err := app.StakingKeeper.Delegate(ctx, valAddr, selfBond, types.Unbonded, validator, true)
+	require.NoError(t, err)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsBonded())
+	return validator
+}
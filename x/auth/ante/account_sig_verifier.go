@@ -0,0 +1,45 @@
+package ante
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountSignatureVerifier lets an account type delegate the validation of its signatures to
+// module logic instead of a cryptographic pubkey, in the spirit of EIP-1271, e.g. a group policy
+// account or a session key account. The SigVerificationDecorator calls it for the signers which
+// have no pubkey set, or whose pubkey in the tx is not the one of the account.
+type AccountSignatureVerifier interface {
+	// CanVerifyAccount returns true if the signatures of the account made with the pubkey of
+	// the tx are verified by the verifier. The pubkey is nil if the tx carries none.
+	CanVerifyAccount(ctx sdk.Context, acc types.AccountI, pubKey cryptotypes.PubKey) bool
+
+	// VerifyAccountSignature verifies the signature of the account over the tx and returns an
+	// error if it is invalid. The pubkey of the tx, if any, is set in the signer data. The gas
+	// consumed by the module logic is charged to the tx.
+	VerifyAccountSignature(
+		ctx sdk.Context,
+		acc types.AccountI,
+		signerData authsigning.SignerData,
+		sigData signing.SignatureData,
+		handler authsigning.SignModeHandler,
+		tx sdk.Tx,
+	) error
+}
+
+// AccountSignatureVerifiers is an ordered list of AccountSignatureVerifier, an account is verified
+// by the first verifier which can verify it.
+type AccountSignatureVerifiers []AccountSignatureVerifier
+
+// Get returns the verifier of the account, or nil if none of the verifiers can verify it.
+func (verifiers AccountSignatureVerifiers) Get(ctx sdk.Context, acc types.AccountI, pubKey cryptotypes.PubKey) AccountSignatureVerifier {
+	for _, verifier := range verifiers {
+		if verifier.CanVerifyAccount(ctx, acc, pubKey) {
+			return verifier
+		}
+	}
+	return nil
+}
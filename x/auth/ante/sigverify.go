@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 var (
@@ -82,6 +83,13 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		}
 		// Only make check if simulate=false
 		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
+			// after the Nagqu upgrade, the pubkey might be a delegated key of the account, e.g. a
+			// session key, which is checked by the SigVerificationDecorator
+			if ctx.IsUpgraded(upgradetypes.Nagqu) {
+				if acc, err := GetSignerAcc(ctx, spkd.ak, signers[i]); err == nil && acc.GetPubKey() != nil {
+					continue
+				}
+			}
 			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
 				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
 		}
@@ -188,6 +196,12 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			pubKey = simSecp256k1Pubkey
 		}
 
+		// After the Nagqu upgrade, the signatures of the accounts without pubkey are verified by an
+		// AccountSignatureVerifier, which is charged by the SigVerificationDecorator.
+		if pubKey == nil && ctx.IsUpgraded(upgradetypes.Nagqu) {
+			continue
+		}
+
 		// make a SignatureV2 with PubKey filled in from above
 		sig = signing.SignatureV2{
 			PubKey:   pubKey,
@@ -209,15 +223,20 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
+//
+// The signatures of the accounts which have no pubkey set are verified by the first
+// AccountSignatureVerifier which can verify the account.
 type SigVerificationDecorator struct {
-	ak              AccountKeeper
-	signModeHandler authsigning.SignModeHandler
+	ak               AccountKeeper
+	signModeHandler  authsigning.SignModeHandler
+	accountVerifiers AccountSignatureVerifiers
 }
 
-func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.SignModeHandler) SigVerificationDecorator {
+func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.SignModeHandler, accountVerifiers ...AccountSignatureVerifier) SigVerificationDecorator {
 	return SigVerificationDecorator{
-		ak:              ak,
-		signModeHandler: signModeHandler,
+		ak:               ak,
+		signModeHandler:  signModeHandler,
+		accountVerifiers: accountVerifiers,
 	}
 }
 
@@ -259,6 +278,12 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, err
 	}
 
+	// both the legacy and the new EIP-712 encodings are accepted before the upgrade, only the new one after it
+	signModeHandler := svd.signModeHandler
+	if legacyHandler, ok := signModeHandler.(authsigning.SignModeHandlerWithLegacyEncoding); ok && !ctx.IsUpgraded(upgradetypes.Nagqu) {
+		signModeHandler = legacyHandler.WithLegacyEncoding()
+	}
+
 	signerAddrs := sigTx.GetSigners()
 
 	// check that signer length and signature length are the same
@@ -266,6 +291,15 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	upgraded := ctx.IsUpgraded(upgradetypes.Nagqu)
+	var txPubKeys []cryptotypes.PubKey
+	if upgraded {
+		txPubKeys, err = sigTx.GetPubKeys()
+		if err != nil {
+			return ctx, err
+		}
+	}
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
@@ -274,7 +308,21 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 		// retrieve pubkey
 		pubKey := acc.GetPubKey()
-		if !simulate && pubKey == nil {
+		var accountVerifier AccountSignatureVerifier
+		if !simulate && upgraded {
+			var txPubKey cryptotypes.PubKey
+			if i < len(txPubKeys) {
+				txPubKey = txPubKeys[i]
+			}
+			accountVerifier, err = svd.getAccountVerifier(ctx, acc, txPubKey, i)
+			if err != nil {
+				return ctx, err
+			}
+			if accountVerifier != nil {
+				pubKey = txPubKey
+			}
+		}
+		if !simulate && pubKey == nil && accountVerifier == nil {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
@@ -302,8 +350,14 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 
 		// no need to verify signatures on recheck tx
-		if !simulate && !ctx.IsReCheckTx() {
-			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx, ctx.SigCache(), ctx.TxBytes())
+		if accountVerifier != nil && !ctx.IsReCheckTx() {
+			err := accountVerifier.VerifyAccountSignature(ctx, acc, signerData, sig.Data, signModeHandler, tx)
+			if err != nil {
+				errMsg := fmt.Sprintf("signature verification failed; please verify account (%s); err: %s", acc.GetAddress(), err)
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+			}
+		} else if !simulate && !ctx.IsReCheckTx() {
+			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, signModeHandler, tx, ctx.SigCache(), ctx.TxBytes(), upgraded)
 			if err != nil {
 				errMsg := fmt.Sprintf("signature verification failed; please verify account (%s); err: %s", pubKey.Address(), err)
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, errMsg)
@@ -314,6 +368,32 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
+// getAccountVerifier returns the AccountSignatureVerifier of the signer if the account has no pubkey
+// or the tx is signed with another key, it returns nil if the signature is verified with the pubkey
+// of the account. It's only used after the Nagqu upgrade.
+func (svd SigVerificationDecorator) getAccountVerifier(ctx sdk.Context, acc types.AccountI, txPubKey cryptotypes.PubKey, i int) (AccountSignatureVerifier, error) {
+	pubKey := acc.GetPubKey()
+	switch {
+	case pubKey == nil:
+		accountVerifier := svd.accountVerifiers.Get(ctx, acc, txPubKey)
+		if accountVerifier == nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+		}
+		// accounts without pubkey aren't charged by the SigGasConsumeDecorator
+		ctx.GasMeter().ConsumeGas(svd.ak.GetParams(ctx).SigVerifyCostSecp256k1, "ante verify: account")
+		return accountVerifier, nil
+	case txPubKey != nil && !txPubKey.Equals(pubKey):
+		accountVerifier := svd.accountVerifiers.Get(ctx, acc, txPubKey)
+		if accountVerifier == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", acc.GetAddress(), i)
+		}
+		return accountVerifier, nil
+	default:
+		return nil, nil
+	}
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is need to execute IncrementSequenceDecorator on RecheckTx since
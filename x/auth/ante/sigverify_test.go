@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
@@ -14,10 +15,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -201,6 +205,191 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+// ownerVerifier verifies the signatures of a smart account with the key of its owner.
+type ownerVerifier struct {
+	account sdk.AccAddress
+	owner   *ethsecp256k1.PubKey
+}
+
+func (v ownerVerifier) CanVerifyAccount(_ sdk.Context, acc types.AccountI, _ cryptotypes.PubKey) bool {
+	return acc.GetAddress().Equals(v.account)
+}
+
+func (v ownerVerifier) VerifyAccountSignature(
+	_ sdk.Context,
+	_ types.AccountI,
+	signerData authsigning.SignerData,
+	sigData signing.SignatureData,
+	handler authsigning.SignModeHandler,
+	tx sdk.Tx,
+) error {
+	data, ok := sigData.(*signing.SingleSignatureData)
+	if !ok {
+		return fmt.Errorf("unexpected signature data %T", sigData)
+	}
+	signBytes, err := handler.GetSignBytes(data.SignMode, signerData, tx)
+	if err != nil {
+		return err
+	}
+	if !v.owner.VerifyDigestSignature(signBytes, data.Signature) {
+		return fmt.Errorf("invalid owner signature")
+	}
+	return nil
+}
+
+func TestSigVerification_AccountSignatureVerifier(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	legacyCtx := suite.ctx
+	suite.ctx = sdk.NewContext(suite.ctx.MultiStore(), suite.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, suite.ctx.Logger()).WithChainID(suite.ctx.ChainID())
+
+	ownerPriv, ownerPub, _ := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	otherPriv, _, _ := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	smartAddr := types.NewModuleAddress("smart-account")
+	plainAddr := types.NewModuleAddress("plain-account")
+	for i, addr := range []sdk.AccAddress{smartAddr, plainAddr} {
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		require.NoError(t, acc.SetAccountNumber(uint64(i)))
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+	}
+
+	verifier := ownerVerifier{account: smartAddr, owner: ownerPub.(*ethsecp256k1.PubKey)}
+	svgc := ante.NewSigGasConsumeDecorator(suite.accountKeeper, ante.DefaultSigVerificationGasConsumer)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), verifier)
+	antehandler := sdk.ChainAnteDecorators(ante.NewSetPubKeyDecorator(suite.accountKeeper), svgc, svd)
+
+	buildTx := func(addr sdk.AccAddress, accNum uint64, priv cryptotypes.PrivKey) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_EIP_712}
+		require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{Data: sigData}))
+		signBytes, err := suite.clientCtx.TxConfig.SignModeHandler().GetSignBytes(
+			signing.SignMode_SIGN_MODE_EIP_712,
+			authsigning.SignerData{
+				Address:       addr.String(),
+				ChainID:       suite.ctx.ChainID(),
+				AccountNumber: accNum,
+			},
+			suite.txBuilder.GetTx(),
+		)
+		require.NoError(t, err)
+		sigData.Signature, err = priv.Sign(signBytes)
+		require.NoError(t, err)
+		require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{Data: sigData}))
+		return suite.txBuilder.GetTx()
+	}
+
+	t.Log("verify the accounts without pubkey are rejected before the Nagqu upgrade")
+	_, err := antehandler(legacyCtx, buildTx(smartAddr, 0, ownerPriv), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+
+	t.Log("verify the smart account signature is delegated to the verifier")
+	ctx, err := antehandler(suite.ctx, buildTx(smartAddr, 0, ownerPriv), false)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), types.DefaultSigVerifyCostSecp256k1)
+
+	t.Log("verify the verifier rejects the signature of another key")
+	_, err = antehandler(suite.ctx, buildTx(smartAddr, 0, otherPriv), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	t.Log("verify the accounts without pubkey and verifier are rejected")
+	_, err = antehandler(suite.ctx, buildTx(plainAddr, 1, ownerPriv), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+}
+
+// delegateVerifier verifies the signatures of the accounts made with a delegated key, e.g. a
+// session key.
+type delegateVerifier struct {
+	delegate *ethsecp256k1.PubKey
+}
+
+func (v delegateVerifier) CanVerifyAccount(_ sdk.Context, _ types.AccountI, pubKey cryptotypes.PubKey) bool {
+	return pubKey != nil && pubKey.Equals(v.delegate)
+}
+
+func (v delegateVerifier) VerifyAccountSignature(
+	_ sdk.Context,
+	_ types.AccountI,
+	signerData authsigning.SignerData,
+	sigData signing.SignatureData,
+	handler authsigning.SignModeHandler,
+	tx sdk.Tx,
+) error {
+	if !signerData.PubKey.Equals(v.delegate) {
+		return fmt.Errorf("unexpected pubkey %s", signerData.PubKey)
+	}
+	return authsigning.VerifySignature(signerData.PubKey, signerData, sigData, handler, tx, nil, nil, true)
+}
+
+func TestSigVerification_DelegatedKey(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	legacyCtx := suite.ctx
+	suite.ctx = sdk.NewContext(suite.ctx.MultiStore(), suite.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, suite.ctx.Logger()).WithChainID(suite.ctx.ChainID())
+
+	accPriv, accPub, addr := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	delegatePriv, delegatePub, _ := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	otherPriv, _, _ := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetPubKey(accPub))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	verifier := delegateVerifier{delegate: delegatePub.(*ethsecp256k1.PubKey)}
+	svgc := ante.NewSigGasConsumeDecorator(suite.accountKeeper, ante.DefaultSigVerificationGasConsumer)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), verifier)
+	antehandler := sdk.ChainAnteDecorators(ante.NewSetPubKeyDecorator(suite.accountKeeper), svgc, svd)
+
+	buildTx := func(priv cryptotypes.PrivKey) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_EIP_712}
+		sig := signing.SignatureV2{PubKey: priv.PubKey(), Data: sigData}
+		require.NoError(t, suite.txBuilder.SetSignatures(sig))
+		signBytes, err := suite.clientCtx.TxConfig.SignModeHandler().GetSignBytes(
+			signing.SignMode_SIGN_MODE_EIP_712,
+			authsigning.SignerData{
+				Address:       addr.String(),
+				ChainID:       suite.ctx.ChainID(),
+				AccountNumber: acc.GetAccountNumber(),
+				PubKey:        priv.PubKey(),
+			},
+			suite.txBuilder.GetTx(),
+		)
+		require.NoError(t, err)
+		sigData.Signature, err = priv.Sign(signBytes)
+		require.NoError(t, err)
+		require.NoError(t, suite.txBuilder.SetSignatures(sig))
+		return suite.txBuilder.GetTx()
+	}
+
+	t.Log("verify the signature of the account key")
+	_, err := antehandler(suite.ctx, buildTx(accPriv), false)
+	require.NoError(t, err)
+
+	t.Log("verify the signature of the delegated key is rejected before the Nagqu upgrade")
+	_, err = antehandler(legacyCtx, buildTx(delegatePriv), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+
+	t.Log("verify the signature of the delegated key is verified by the verifier")
+	_, err = antehandler(suite.ctx, buildTx(delegatePriv), false)
+	require.NoError(t, err)
+	require.True(t, suite.accountKeeper.GetAccount(suite.ctx, addr).GetPubKey().Equals(accPub))
+
+	t.Log("verify the signature of an unknown key is rejected")
+	_, err = antehandler(suite.ctx, buildTx(otherPriv), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+}
+
 // This test is exactly like the one above, but we set the codec explicitly to
 // Amino.
 // Once https://github.com/cosmos/cosmos-sdk/issues/6190 is in, we can remove
@@ -51,7 +51,8 @@ If the --offline flag is on, the client will not reach out to an external node.
 Account number or sequence number lookups are not performed so you must
 set these parameters manually.
 
-The current multisig implementation defaults to amino-json sign mode.
+The current multisig implementation defaults to eip-712 sign mode, all the signatures
+of the eth_secp256k1 keys are combined over the same EIP-712 typed data hash.
 The SIGN_MODE_DIRECT sign mode is not supported.'
 `,
 				version.AppName,
@@ -85,7 +86,7 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 			return err
 		}
 		if txFactory.SignMode() == signingtypes.SignMode_SIGN_MODE_UNSPECIFIED {
-			txFactory = txFactory.WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			txFactory = txFactory.WithSignMode(signingtypes.SignMode_SIGN_MODE_EIP_712)
 		}
 
 		txCfg := clientCtx.TxConfig
@@ -139,7 +140,7 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 					PubKey:        sig.PubKey,
 				}
 
-				err = signing.VerifySignature(sig.PubKey, signingData, sig.Data, txCfg.SignModeHandler(), txBuilder.GetTx(), nil, nil)
+				err = signing.VerifySignature(sig.PubKey, signingData, sig.Data, txCfg.SignModeHandler(), txBuilder.GetTx(), nil, nil, true)
 				if err != nil {
 					addr, _ := sdk.AccAddressFromHexUnsafe(sig.PubKey.Address().String())
 					return fmt.Errorf("couldn't verify signature for address %s", addr)
@@ -226,7 +227,8 @@ multisig key [name], and attach the key name to the transaction read from [file]
 Example:
 $ %s tx multisign-batch transactions.json multisigk1k2k3 k1sigs.json k2sigs.json k3sig.json
 
-The current multisig implementation defaults to amino-json sign mode.
+The current multisig implementation defaults to eip-712 sign mode, all the signatures
+of the eth_secp256k1 keys are combined over the same EIP-712 typed data hash.
 The SIGN_MODE_DIRECT sign mode is not supported.'
 `, version.AppName,
 			),
@@ -262,7 +264,7 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 			return err
 		}
 		if txFactory.SignMode() == signingtypes.SignMode_SIGN_MODE_UNSPECIFIED {
-			txFactory = txFactory.WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			txFactory = txFactory.WithSignMode(signingtypes.SignMode_SIGN_MODE_EIP_712)
 		}
 
 		// reads tx from args[0]
@@ -329,7 +331,7 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 			}
 
 			for _, sig := range signatureBatch {
-				err = signing.VerifySignature(sig[i].PubKey, signingData, sig[i].Data, txCfg.SignModeHandler(), txBldr.GetTx(), nil, nil)
+				err = signing.VerifySignature(sig[i].PubKey, signingData, sig[i].Data, txCfg.SignModeHandler(), txBldr.GetTx(), nil, nil, true)
 				if err != nil {
 					return fmt.Errorf("couldn't verify signature: %w %v", err, sig)
 				}
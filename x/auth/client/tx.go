@@ -39,10 +39,19 @@ func SignTx(txFactory tx.Factory, clientCtx client.Context, name string, txBuild
 		return err
 	}
 
-	// Ledger and Multisigs only support LEGACY_AMINO_JSON signing.
-	if txFactory.SignMode() == signing.SignMode_SIGN_MODE_UNSPECIFIED &&
-		(k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeMulti) {
-		txFactory = txFactory.WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	// Ledger only supports LEGACY_AMINO_JSON signing except the Ethereum app which signs EIP712,
+	// multisigs combine EIP712 signatures.
+	if txFactory.SignMode() == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		switch k.GetType() {
+		case keyring.TypeLedger:
+			if keyring.IsEthLedgerKey(k) {
+				txFactory = txFactory.WithSignMode(signing.SignMode_SIGN_MODE_EIP_712)
+			} else {
+				txFactory = txFactory.WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			}
+		case keyring.TypeMulti:
+			txFactory = txFactory.WithSignMode(signing.SignMode_SIGN_MODE_EIP_712)
+		}
 	}
 
 	pubKey, err := k.GetPubKey()
@@ -71,9 +80,9 @@ func SignTx(txFactory tx.Factory, clientCtx client.Context, name string, txBuild
 func SignTxWithSignerAddress(txFactory tx.Factory, clientCtx client.Context, addr sdk.AccAddress,
 	name string, txBuilder client.TxBuilder, offline, overwrite bool,
 ) (err error) {
-	// Multisigs only support LEGACY_AMINO_JSON signing.
+	// Multisigs combine EIP712 signatures by default.
 	if txFactory.SignMode() == signing.SignMode_SIGN_MODE_UNSPECIFIED {
-		txFactory = txFactory.WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+		txFactory = txFactory.WithSignMode(signing.SignMode_SIGN_MODE_EIP_712)
 	}
 
 	// check whether the address is a signer
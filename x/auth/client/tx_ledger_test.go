@@ -0,0 +1,88 @@
+//go:build ledger || test_ledger_mock
+// +build ledger test_ledger_mock
+
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttestutil "github.com/cosmos/cosmos-sdk/client/testutil"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSignTxWithEthLedgerKey(t *testing.T) {
+	var (
+		pcdc codec.ProtoCodecMarshaler
+		cdc  codec.Codec
+	)
+	require.NoError(t, depinject.Inject(clienttestutil.TestConfig, &pcdc, &cdc))
+	txConfig := authtx.NewTxConfig(pcdc, authtx.DefaultSignModes)
+
+	kb, err := keyring.New(t.Name(), keyring.BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	k, err := kb.SaveLedgerKey("ledger", hd.EthSecp256k1, "", 60, 0, 0)
+	if err != nil {
+		t.Skip(err.Error())
+	}
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+
+	// the tx commands default to the EIP-712 sign mode for the keys of the Ledger Ethereum app
+	cmd := &cobra.Command{Use: "test"}
+	flags.AddTxFlagsToCmd(cmd)
+	require.NoError(t, cmd.ParseFlags([]string{"--from", "ledger", "--offline", "--account-number", "1", "--sequence", "2"}))
+	clientCtx := client.Context{}.
+		WithKeyring(kb).
+		WithTxConfig(txConfig).
+		WithCodec(cdc).
+		WithChainID("greenfield_9000-1")
+	cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+
+	clientCtx, err = client.GetClientTxContext(cmd)
+	require.NoError(t, err)
+	require.Equal(t, flags.SignModeEIP712, clientCtx.SignModeStr)
+	txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+	require.NoError(t, err)
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_EIP_712, txf.SignMode())
+
+	// the keys of the Ledger Ethereum app sign EIP-712 typed data if the sign mode is unspecified
+	txf = txf.WithSignMode(signingtypes.SignMode_SIGN_MODE_UNSPECIFIED)
+	txb, err := txf.BuildUnsignedTx(banktypes.NewMsgSend(addr, sdk.AccAddress("to"), nil))
+	require.NoError(t, err)
+	require.NoError(t, authclient.SignTx(txf, clientCtx, "ledger", txb, true, true))
+
+	sigs, err := txb.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	sigData := sigs[0].Data.(*signingtypes.SingleSignatureData)
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_EIP_712, sigData.SignMode)
+
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
+		PubKey:        pubKey,
+	}, txb.GetTx())
+	require.NoError(t, err)
+	require.True(t, pubKey.(*ethsecp256k1.PubKey).VerifyDigestSignature(signBytes, sigData.Signature))
+}
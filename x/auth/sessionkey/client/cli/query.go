@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/sessionkey/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the sessionkey module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQuerySessionKey(),
+		GetCmdQuerySessionKeys(),
+	)
+
+	return cmd
+}
+
+// GetCmdQuerySessionKey returns the command handler for querying a session key of an account.
+func GetCmdQuerySessionKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "session-key [account] [address]",
+		Short: "Query a session key of an account",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a session key of an account by the address of the session key:
+
+$ %s query %s session-key [account] [address]
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SessionKey(cmd.Context(), &types.QuerySessionKeyRequest{
+				Account: args[0],
+				Address: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.SessionKey)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySessionKeys returns the command handler for querying the session keys of an account.
+func GetCmdQuerySessionKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "session-keys [account]",
+		Short: "Query all the session keys of an account",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the session keys of an account:
+
+$ %s query %s session-keys [account]
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SessionKeys(cmd.Context(), &types.QuerySessionKeysRequest{
+				Account:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "session-keys")
+
+	return cmd
+}
@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/sessionkey/types"
+)
+
+// flag for sessionkey module
+const (
+	FlagExpiration  = "expiration"
+	FlagSpendLimit  = "spend-limit"
+	FlagAllowedMsgs = "allowed-messages"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Session key transactions subcommands",
+		Long:                       "Add and remove the session keys of an account",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewCmdAddSessionKey(),
+		NewCmdRemoveSessionKey(),
+	)
+
+	return cmd
+}
+
+// NewCmdAddSessionKey returns a CLI command handler for creating a MsgAddSessionKey transaction.
+func NewCmdAddSessionKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add [pubkey]",
+		Short: "Add a session key to the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add a session key which signs txs in place of the key of the account, until
+it expires and within the allowed messages and spend limit. The spend limit covers the fees
+and the bank transfers of the account, a session key with a spend limit can only be allowed
+to sign bank transfers.
+
+Example:
+$ %s tx %s add '{"@type":"/cosmos.crypto.eth.ethsecp256k1.PubKey","key":"A..."}' --from mykey \
+	--expiration 2023-01-30T15:04:05Z \
+	--allowed-messages "/greenfield.storage.MsgCreateObject,/greenfield.storage.MsgSealObject"
+$ %s tx %s add '{"@type":"/cosmos.crypto.eth.ethsecp256k1.PubKey","key":"A..."}' --from mykey \
+	--expiration 2023-01-30T15:04:05Z --spend-limit 1000000000BNB \
+	--allowed-messages "/cosmos.bank.v1beta1.MsgSend"
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pubKey cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pubKey); err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			expiration, err := time.Parse(time.RFC3339, exp)
+			if err != nil {
+				return err
+			}
+
+			sl, err := cmd.Flags().GetString(FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+			spendLimit, err := sdk.ParseCoinsNormalized(sl)
+			if err != nil {
+				return err
+			}
+
+			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgAddSessionKey(clientCtx.GetFromAddress(), pubKey, expiration, allowedMsgs, spendLimit)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the session key expires")
+	cmd.Flags().String(FlagSpendLimit, "", "The coins the session key can spend, e.g. 1000BNB, the spend isn't limited if it's not set")
+	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "The msg type urls the session key can sign")
+	_ = cmd.MarkFlagRequired(FlagExpiration)
+	_ = cmd.MarkFlagRequired(FlagAllowedMsgs)
+
+	return cmd
+}
+
+// NewCmdRemoveSessionKey returns a CLI command handler for creating a MsgRemoveSessionKey transaction.
+func NewCmdRemoveSessionKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove [address]",
+		Short: "Remove a session key of the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove a session key of the account by the address of the session key.
+
+Example:
+$ %s tx %s remove 0x6D967dc83b625603c963713eABd5B43A281E595e --from mykey
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			keyAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveSessionKey(clientCtx.GetFromAddress(), keyAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
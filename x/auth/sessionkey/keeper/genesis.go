@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/sessionkey/types"
+)
+
+// InitGenesis - Init store state from genesis data
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, sessionKey := range genState.SessionKeys {
+		if err := k.SetSessionKey(ctx, sessionKey); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	sessionKeys := []types.SessionKey{}
+	k.IterateAllSessionKeys(ctx, func(sessionKey types.SessionKey) bool {
+		sessionKeys = append(sessionKeys, sessionKey)
+		return false
+	})
+	return types.NewGenesisState(sessionKeys)
+}
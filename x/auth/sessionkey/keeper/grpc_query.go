@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth/sessionkey/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// SessionKey returns a session key of an account
+func (k Keeper) SessionKey(c context.Context, req *types.QuerySessionKeyRequest) (*types.QuerySessionKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	account, err := sdk.AccAddressFromHexUnsafe(req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	keyAddr, err := sdk.AccAddressFromHexUnsafe(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sessionKey, found := k.GetSessionKey(ctx, account, keyAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "session key %s of account %s not found", req.Address, req.Account)
+	}
+
+	return &types.QuerySessionKeyResponse{SessionKey: &sessionKey}, nil
+}
+
+// SessionKeys returns all the session keys of an account
+func (k Keeper) SessionKeys(c context.Context, req *types.QuerySessionKeysRequest) (*types.QuerySessionKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	account, err := sdk.AccAddressFromHexUnsafe(req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SessionKeysPrefix(account))
+	sessionKeys, pageRes, err := query.GenericFilteredPaginate(k.cdc, store, req.Pagination, func(key []byte, result *types.SessionKey) (*types.SessionKey, error) {
+		return result, nil
+	}, func() *types.SessionKey {
+		return &types.SessionKey{}
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySessionKeysResponse{SessionKeys: sessionKeys, Pagination: pageRes}, nil
+}
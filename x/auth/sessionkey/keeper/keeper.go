@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/sessionkey/types"
+)
+
+// Keeper manages the session keys of the accounts.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	ak       types.AccountKeeper
+}
+
+// NewKeeper returns a new sessionkey keeper
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		ak:       ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetSessionKey returns a session key of the account.
+func (k Keeper) GetSessionKey(ctx sdk.Context, account, keyAddr sdk.AccAddress) (types.SessionKey, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SessionKeyKey(account, keyAddr))
+	if bz == nil {
+		return types.SessionKey{}, false
+	}
+
+	var sessionKey types.SessionKey
+	k.cdc.MustUnmarshal(bz, &sessionKey)
+	return sessionKey, true
+}
+
+// HasSessionKey returns true if the account has registered the session key.
+func (k Keeper) HasSessionKey(ctx sdk.Context, account, keyAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.SessionKeyKey(account, keyAddr))
+}
+
+// SetSessionKey sets a session key of the account.
+func (k Keeper) SetSessionKey(ctx sdk.Context, sessionKey types.SessionKey) error {
+	account, err := sdk.AccAddressFromHexUnsafe(sessionKey.Account)
+	if err != nil {
+		return err
+	}
+	pubKey, err := sessionKey.GetSessionPubKey()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SessionKeyKey(account, sdk.AccAddress(pubKey.Address())), k.cdc.MustMarshal(&sessionKey))
+	return nil
+}
+
+// RemoveSessionKey removes a session key of the account.
+func (k Keeper) RemoveSessionKey(ctx sdk.Context, account, keyAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SessionKeyKey(account, keyAddr))
+}
+
+// IterateSessionKeys iterates over the session keys of the account, the iteration stops if
+// the callback returns true.
+func (k Keeper) IterateSessionKeys(ctx sdk.Context, account sdk.AccAddress, cb func(sessionKey types.SessionKey) (stop bool)) {
+	k.iterateSessionKeys(ctx, types.SessionKeysPrefix(account), cb)
+}
+
+// IterateAllSessionKeys iterates over the session keys of all the accounts, the iteration
+// stops if the callback returns true.
+func (k Keeper) IterateAllSessionKeys(ctx sdk.Context, cb func(sessionKey types.SessionKey) (stop bool)) {
+	k.iterateSessionKeys(ctx, types.SessionKeyPrefix, cb)
+}
+
+func (k Keeper) iterateSessionKeys(ctx sdk.Context, prefix []byte, cb func(sessionKey types.SessionKey) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sessionKey types.SessionKey
+		k.cdc.MustUnmarshal(iterator.Value(), &sessionKey)
+		if cb(sessionKey) {
+			break
+		}
+	}
+}
+
+// PruneExpiredSessionKeys removes the expired session keys of the account, and returns the
+// number of the remaining ones.
+func (k Keeper) PruneExpiredSessionKeys(ctx sdk.Context, account sdk.AccAddress) int {
+	var expired []sdk.AccAddress
+	remaining := 0
+	k.IterateSessionKeys(ctx, account, func(sessionKey types.SessionKey) bool {
+		if sessionKey.IsExpired(ctx.BlockTime()) {
+			pubKey, err := sessionKey.GetSessionPubKey()
+			if err != nil {
+				panic(err)
+			}
+			expired = append(expired, sdk.AccAddress(pubKey.Address()))
+		} else {
+			remaining++
+		}
+		return false
+	})
+
+	for _, keyAddr := range expired {
+		k.RemoveSessionKey(ctx, account, keyAddr)
+	}
+	return remaining
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth/sessionkey/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/sessionkey/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+type mockAccountKeeper map[string]authtypes.AccountI
+
+func (ak mockAccountKeeper) GetAccount(_ sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	return ak[addr.String()]
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx              sdk.Context
+	legacyCtx        sdk.Context
+	sessionKeyKeeper keeper.Keeper
+	accountKeeper    mockAccountKeeper
+
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+
+	encCfg moduletestutil.TestEncodingConfig
+
+	account     sdk.AccAddress
+	accountPriv cryptotypes.PrivKey
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: cmttime.Now()})
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	priv, pub, addr := testdata.KeyTestPubAddrEthSecp256k1(suite.Require())
+	suite.account = addr
+	suite.accountPriv = priv
+	suite.accountKeeper = mockAccountKeeper{
+		addr.String(): authtypes.NewBaseAccount(addr, pub, 1, 0),
+	}
+
+	suite.legacyCtx = ctx
+	suite.ctx = sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, ctx.Logger())
+	suite.sessionKeyKeeper = keeper.NewKeeper(encCfg.Codec, key, suite.accountKeeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, suite.sessionKeyKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.msgServer = keeper.NewMsgServerImpl(suite.sessionKeyKeeper)
+	suite.encCfg = encCfg
+}
+
+// newSessionKey generates a session key of the test account and registers it in the store.
+func (suite *KeeperTestSuite) newSessionKey(expiration time.Time, allowedMsgs []string, spendLimit sdk.Coins) (cryptotypes.PrivKey, types.SessionKey) {
+	priv, pub, _ := testdata.KeyTestPubAddrEthSecp256k1(suite.Require())
+	sessionKey, err := types.NewSessionKey(suite.account, pub, expiration, allowedMsgs, spendLimit)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.sessionKeyKeeper.SetSessionKey(suite.ctx, *sessionKey))
+	return priv, *sessionKey
+}
+
+func (suite *KeeperTestSuite) TestSetGetRemoveSessionKey() {
+	expiration := suite.ctx.BlockTime().Add(time.Hour)
+	priv, sessionKey := suite.newSessionKey(expiration, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil)
+	keyAddr := sdk.AccAddress(priv.PubKey().Address())
+
+	suite.Require().True(suite.sessionKeyKeeper.HasSessionKey(suite.ctx, suite.account, keyAddr))
+	got, found := suite.sessionKeyKeeper.GetSessionKey(suite.ctx, suite.account, keyAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sessionKey.Account, got.Account)
+	suite.Require().True(sessionKey.Expiration.Equal(got.Expiration))
+	pubKey, err := got.GetSessionPubKey()
+	suite.Require().NoError(err)
+	suite.Require().True(pubKey.Equals(priv.PubKey()))
+
+	// the session key is scoped to the account
+	_, _, other := testdata.KeyTestPubAddrEthSecp256k1(suite.Require())
+	suite.Require().False(suite.sessionKeyKeeper.HasSessionKey(suite.ctx, other, keyAddr))
+
+	suite.sessionKeyKeeper.RemoveSessionKey(suite.ctx, suite.account, keyAddr)
+	suite.Require().False(suite.sessionKeyKeeper.HasSessionKey(suite.ctx, suite.account, keyAddr))
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredSessionKeys() {
+	now := suite.ctx.BlockTime()
+	msgs := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	expiredPriv, _ := suite.newSessionKey(now.Add(-time.Minute), msgs, nil)
+	activePriv, _ := suite.newSessionKey(now.Add(time.Hour), msgs, nil)
+
+	remaining := suite.sessionKeyKeeper.PruneExpiredSessionKeys(suite.ctx, suite.account)
+	suite.Require().Equal(1, remaining)
+	suite.Require().False(suite.sessionKeyKeeper.HasSessionKey(suite.ctx, suite.account, sdk.AccAddress(expiredPriv.PubKey().Address())))
+	suite.Require().True(suite.sessionKeyKeeper.HasSessionKey(suite.ctx, suite.account, sdk.AccAddress(activePriv.PubKey().Address())))
+}
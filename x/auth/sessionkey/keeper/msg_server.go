@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/sessionkey/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the sessionkey MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) AddSessionKey(goCtx context.Context, msg *types.MsgAddSessionKey) (*types.MsgAddSessionKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ctx.IsUpgraded(upgradetypes.Nagqu) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("session key is not enabled")
+	}
+
+	account, err := sdk.AccAddressFromHexUnsafe(msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if acc := k.ak.GetAccount(ctx, account); acc == nil {
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", msg.Account)
+	}
+
+	sessionKey := msg.SessionKey()
+	if sessionKey.IsExpired(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrSessionKeyExpired, "expiration %s is before block time", sessionKey.Expiration)
+	}
+	pubKey, err := sessionKey.GetSessionPubKey()
+	if err != nil {
+		return nil, err
+	}
+	keyAddr := sdk.AccAddress(pubKey.Address())
+
+	remaining := k.PruneExpiredSessionKeys(ctx, account)
+	if !k.HasSessionKey(ctx, account, keyAddr) && remaining >= types.MaxSessionKeysPerAccount {
+		return nil, errorsmod.Wrapf(types.ErrTooManySessionKeys, "account can't register more than %d session keys", types.MaxSessionKeysPerAccount)
+	}
+	if err := k.SetSessionKey(ctx, sessionKey); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAddSessionKey{
+		Account:    msg.Account,
+		Address:    keyAddr.String(),
+		Expiration: sessionKey.Expiration.Unix(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddSessionKeyResponse{}, nil
+}
+
+func (k msgServer) RemoveSessionKey(goCtx context.Context, msg *types.MsgRemoveSessionKey) (*types.MsgRemoveSessionKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ctx.IsUpgraded(upgradetypes.Nagqu) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("session key is not enabled")
+	}
+
+	account, err := sdk.AccAddressFromHexUnsafe(msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	keyAddr, err := sdk.AccAddressFromHexUnsafe(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !k.HasSessionKey(ctx, account, keyAddr) {
+		return nil, errorsmod.Wrapf(types.ErrSessionKeyNotFound, "session key %s of account %s", msg.Address, msg.Account)
+	}
+	k.Keeper.RemoveSessionKey(ctx, account, keyAddr)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRemoveSessionKey{
+		Account: msg.Account,
+		Address: msg.Address,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveSessionKeyResponse{}, nil
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/sessionkey/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgAddSessionKey() {
+	now := suite.ctx.BlockTime()
+	msgs := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	_, pub, _ := testdata.KeyTestPubAddrEthSecp256k1(suite.Require())
+	_, _, unknown := testdata.KeyTestPubAddrEthSecp256k1(suite.Require())
+
+	testCases := []struct {
+		name      string
+		malleate  func() *types.MsgAddSessionKey
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "unknown account",
+			malleate: func() *types.MsgAddSessionKey {
+				msg, err := types.NewMsgAddSessionKey(unknown, pub, now.Add(time.Hour), msgs, nil)
+				suite.Require().NoError(err)
+				return msg
+			},
+			expErr:    true,
+			expErrMsg: "does not exist",
+		},
+		{
+			name: "expired",
+			malleate: func() *types.MsgAddSessionKey {
+				msg, err := types.NewMsgAddSessionKey(suite.account, pub, now, msgs, nil)
+				suite.Require().NoError(err)
+				return msg
+			},
+			expErr:    true,
+			expErrMsg: "session key expired",
+		},
+		{
+			name: "too many session keys",
+			malleate: func() *types.MsgAddSessionKey {
+				for i := 0; i < types.MaxSessionKeysPerAccount; i++ {
+					suite.newSessionKey(now.Add(time.Hour), msgs, nil)
+				}
+				msg, err := types.NewMsgAddSessionKey(suite.account, pub, now.Add(time.Hour), msgs, nil)
+				suite.Require().NoError(err)
+				return msg
+			},
+			expErr:    true,
+			expErrMsg: "too many session keys",
+		},
+		{
+			name: "expired session keys are pruned",
+			malleate: func() *types.MsgAddSessionKey {
+				for i := 0; i < types.MaxSessionKeysPerAccount; i++ {
+					suite.newSessionKey(now.Add(-time.Hour), msgs, nil)
+				}
+				msg, err := types.NewMsgAddSessionKey(suite.account, pub, now.Add(time.Hour), msgs, nil)
+				suite.Require().NoError(err)
+				return msg
+			},
+			expErr: false,
+		},
+		{
+			name: "all good",
+			malleate: func() *types.MsgAddSessionKey {
+				msg, err := types.NewMsgAddSessionKey(suite.account, pub, now.Add(time.Hour), msgs, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+				suite.Require().NoError(err)
+				return msg
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			msg := tc.malleate()
+			_, err := suite.msgServer.AddSessionKey(suite.ctx, msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			sessionKey, found := suite.sessionKeyKeeper.GetSessionKey(suite.ctx, suite.account, sdk.AccAddress(pub.Address()))
+			suite.Require().True(found)
+			suite.Require().Equal(msg.SpendLimit, sessionKey.SpendLimit)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRemoveSessionKey() {
+	priv, _ := suite.newSessionKey(suite.ctx.BlockTime().Add(time.Hour), []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil)
+	keyAddr := sdk.AccAddress(priv.PubKey().Address())
+
+	_, err := suite.msgServer.RemoveSessionKey(suite.ctx, types.NewMsgRemoveSessionKey(suite.account, keyAddr))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.sessionKeyKeeper.HasSessionKey(suite.ctx, suite.account, keyAddr))
+
+	_, err = suite.msgServer.RemoveSessionKey(suite.ctx, types.NewMsgRemoveSessionKey(suite.account, keyAddr))
+	suite.Require().ErrorIs(err, types.ErrSessionKeyNotFound)
+}
+
+func (suite *KeeperTestSuite) TestMsgSessionKeyBeforeUpgrade() {
+	_, pub, _ := testdata.KeyTestPubAddrEthSecp256k1(suite.Require())
+	msg, err := types.NewMsgAddSessionKey(suite.account, pub, suite.ctx.BlockTime().Add(time.Hour), nil, nil)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.AddSessionKey(suite.legacyCtx, msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = suite.msgServer.RemoveSessionKey(suite.legacyCtx, types.NewMsgRemoveSessionKey(suite.account, sdk.AccAddress(pub.Address())))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
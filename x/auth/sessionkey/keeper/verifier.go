@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/sessionkey/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+var _ ante.AccountSignatureVerifier = Keeper{}
+
+// CanVerifyAccount implements ante.AccountSignatureVerifier, the signatures made with a
+// session key registered by the account are verified by the keeper after the Nagqu upgrade.
+func (k Keeper) CanVerifyAccount(ctx sdk.Context, acc authtypes.AccountI, pubKey cryptotypes.PubKey) bool {
+	if pubKey == nil || !ctx.IsUpgraded(upgradetypes.Nagqu) {
+		return false
+	}
+	return k.HasSessionKey(ctx, acc.GetAddress(), sdk.AccAddress(pubKey.Address()))
+}
+
+// VerifyAccountSignature implements ante.AccountSignatureVerifier, it verifies the signature
+// of the session key and that the tx is within the permissions of the session key, then
+// deducts the coins spent by the tx from the spend limit if the session key has one.
+func (k Keeper) VerifyAccountSignature(
+	ctx sdk.Context,
+	acc authtypes.AccountI,
+	signerData authsigning.SignerData,
+	sigData signing.SignatureData,
+	handler authsigning.SignModeHandler,
+	tx sdk.Tx,
+) error {
+	if signerData.PubKey == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "session key pubkey is not set")
+	}
+	sessionKey, found := k.GetSessionKey(ctx, acc.GetAddress(), sdk.AccAddress(signerData.PubKey.Address()))
+	if !found {
+		return types.ErrSessionKeyNotFound
+	}
+	pubKey, err := sessionKey.GetSessionPubKey()
+	if err != nil {
+		return err
+	}
+	if !pubKey.Equals(signerData.PubKey) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey does not match the session key")
+	}
+
+	if err := authsigning.VerifySignature(pubKey, signerData, sigData, handler, tx, nil, nil, ctx.IsUpgraded(upgradetypes.Nagqu)); err != nil {
+		return err
+	}
+
+	if sessionKey.IsExpired(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrSessionKeyExpired, "expired at %s", sessionKey.Expiration)
+	}
+	if err := sessionKey.Accept(acc.GetAddress(), tx.GetMsgs()); err != nil {
+		return err
+	}
+
+	if !sessionKey.HasSpendLimit() {
+		return nil
+	}
+	spent := types.SpentCoins(acc.GetAddress(), tx)
+	if spent.IsZero() {
+		return nil
+	}
+	remaining, hasNeg := sessionKey.SpendLimit.SafeSub(spent...)
+	if hasNeg {
+		return errorsmod.Wrapf(types.ErrSpendLimitExceeded, "spent %s, limit %s", spent, sessionKey.SpendLimit)
+	}
+	// an empty spend limit means no limit, so the session key is removed once it's used up
+	if remaining.Empty() {
+		k.RemoveSessionKey(ctx, acc.GetAddress(), sdk.AccAddress(pubKey.Address()))
+		return nil
+	}
+	sessionKey.SpendLimit = remaining
+	return k.SetSessionKey(ctx, sessionKey)
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/sessionkey/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// signTx builds a tx of the test account signed by the given key with EIP-712.
+func (suite *KeeperTestSuite) signTx(priv cryptotypes.PrivKey, fee sdk.Coins, msgs ...sdk.Msg) (authsigning.Tx, authsigning.SignerData, signingtypes.SignatureData, authsigning.SignModeHandler) {
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(suite.encCfg.InterfaceRegistry), []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712})
+	handler := txConfig.SignModeHandler()
+
+	txBuilder := txConfig.NewTxBuilder()
+	suite.Require().NoError(txBuilder.SetMsgs(msgs...))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(200000)
+
+	sigData := &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_EIP_712}
+	sig := signingtypes.SignatureV2{PubKey: priv.PubKey(), Data: sigData}
+	suite.Require().NoError(txBuilder.SetSignatures(sig))
+
+	signerData := authsigning.SignerData{
+		Address:       suite.account.String(),
+		ChainID:       "greenfield_9000-1",
+		AccountNumber: 1,
+		Sequence:      0,
+		PubKey:        priv.PubKey(),
+	}
+	signBytes, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signerData, txBuilder.GetTx())
+	suite.Require().NoError(err)
+	sigData.Signature, err = priv.Sign(signBytes)
+	suite.Require().NoError(err)
+	suite.Require().NoError(txBuilder.SetSignatures(sig))
+
+	return txBuilder.GetTx(), signerData, sigData, handler
+}
+
+func (suite *KeeperTestSuite) TestCanVerifyAccount() {
+	priv, _ := suite.newSessionKey(suite.ctx.BlockTime().Add(time.Hour), []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil)
+	_, otherPub, _ := testdata.KeyTestPubAddrEthSecp256k1(suite.Require())
+	acc := suite.accountKeeper.GetAccount(suite.ctx, suite.account)
+
+	suite.Require().True(suite.sessionKeyKeeper.CanVerifyAccount(suite.ctx, acc, priv.PubKey()))
+	suite.Require().False(suite.sessionKeyKeeper.CanVerifyAccount(suite.ctx, acc, otherPub))
+	suite.Require().False(suite.sessionKeyKeeper.CanVerifyAccount(suite.ctx, acc, nil))
+	suite.Require().False(suite.sessionKeyKeeper.CanVerifyAccount(suite.legacyCtx, acc, priv.PubKey()))
+}
+
+func (suite *KeeperTestSuite) TestVerifyAccountSignature() {
+	now := suite.ctx.BlockTime()
+	allowed := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	_, _, to := testdata.KeyTestPubAddrEthSecp256k1(suite.Require())
+	// the msgs are built after the suite is set up, as the test account is regenerated
+	send := func(amount int64) func() sdk.Msg {
+		return func() sdk.Msg {
+			return banktypes.NewMsgSend(suite.account, to, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+		}
+	}
+	vote := func() sdk.Msg {
+		return govtypes.NewMsgVote(suite.account, 1, govtypes.OptionYes, "")
+	}
+
+	testCases := []struct {
+		name         string
+		expiration   time.Time
+		wrongSigner  bool
+		fee          sdk.Coins
+		msgs         []func() sdk.Msg
+		noSpendLimit bool
+		expErr       error
+		expRemaining sdk.Coins
+		expRemoved   bool
+	}{
+		{
+			name:       "expired",
+			expiration: now,
+			msgs:       []func() sdk.Msg{send(10)},
+			expErr:     types.ErrSessionKeyExpired,
+		},
+		{
+			name:       "msg not allowed",
+			expiration: now.Add(time.Hour),
+			msgs:       []func() sdk.Msg{vote},
+			expErr:     types.ErrMsgNotAllowed,
+		},
+		{
+			name:       "spend limit exceeded",
+			expiration: now.Add(time.Hour),
+			fee:        sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+			msgs:       []func() sdk.Msg{send(100)},
+			expErr:     types.ErrSpendLimitExceeded,
+		},
+		{
+			name:        "invalid signature",
+			expiration:  now.Add(time.Hour),
+			wrongSigner: true,
+			msgs:        []func() sdk.Msg{send(10)},
+			expErr:      sdkerrors.ErrorInvalidSigner,
+		},
+		{
+			name:         "spend limit deducted",
+			expiration:   now.Add(time.Hour),
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+			msgs:         []func() sdk.Msg{send(10)},
+			expRemaining: sdk.NewCoins(sdk.NewInt64Coin("stake", 85)),
+		},
+		{
+			name:       "spend limit used up",
+			expiration: now.Add(time.Hour),
+			msgs:       []func() sdk.Msg{send(100)},
+			expRemoved: true,
+		},
+		{
+			name:         "no spend limit",
+			expiration:   now.Add(time.Hour),
+			noSpendLimit: true,
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+			msgs:         []func() sdk.Msg{send(1000), vote},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.encCfg.InterfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &govtypes.MsgVote{})
+			var priv cryptotypes.PrivKey
+			if tc.noSpendLimit {
+				priv, _ = suite.newSessionKey(tc.expiration, append(allowed, sdk.MsgTypeURL(&govtypes.MsgVote{})), nil)
+			} else {
+				priv, _ = suite.newSessionKey(tc.expiration, allowed, limit)
+			}
+			signer := priv
+			if tc.wrongSigner {
+				signer, _, _ = testdata.KeyTestPubAddrEthSecp256k1(suite.Require())
+			}
+
+			msgs := make([]sdk.Msg, len(tc.msgs))
+			for i, msg := range tc.msgs {
+				msgs[i] = msg()
+			}
+
+			tx, signerData, sigData, handler := suite.signTx(signer, tc.fee, msgs...)
+			// the signature is checked against the registered session key
+			signerData.PubKey = priv.PubKey()
+			acc := suite.accountKeeper.GetAccount(suite.ctx, suite.account)
+
+			err := suite.sessionKeyKeeper.VerifyAccountSignature(suite.ctx, acc, signerData, sigData, handler, tx)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			sessionKey, found := suite.sessionKeyKeeper.GetSessionKey(suite.ctx, suite.account, sdk.AccAddress(priv.PubKey().Address()))
+			suite.Require().Equal(!tc.expRemoved, found)
+			suite.Require().Equal(tc.expRemaining, sessionKey.SpendLimit)
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrInvalidSessionKey  = errors.Register(ModuleName, 2, "invalid session key")
+	ErrSessionKeyNotFound = errors.Register(ModuleName, 3, "session key not found")
+	ErrSessionKeyExpired  = errors.Register(ModuleName, 4, "session key expired")
+	ErrMsgNotAllowed      = errors.Register(ModuleName, 5, "msg not allowed by session key")
+	ErrSpendLimitExceeded = errors.Register(ModuleName, 6, "spend limit of session key exceeded")
+	ErrTooManySessionKeys = errors.Register(ModuleName, 7, "too many session keys")
+)
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState instance.
+func NewGenesisState(sessionKeys []SessionKey) *GenesisState {
+	return &GenesisState{
+		SessionKeys: sessionKeys,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]SessionKey{})
+}
+
+// Validate performs basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	counts := make(map[string]int)
+	for _, key := range gs.SessionKeys {
+		if err := key.Validate(); err != nil {
+			return err
+		}
+
+		pubKey, _ := key.GetSessionPubKey()
+		id := key.Account + "/" + sdk.AccAddress(pubKey.Address()).String()
+		if seen[id] {
+			return fmt.Errorf("duplicate session key: %s", id)
+		}
+		seen[id] = true
+
+		counts[key.Account]++
+		if counts[key.Account] > MaxSessionKeysPerAccount {
+			return fmt.Errorf("account %s has more than %d session keys", key.Account, MaxSessionKeysPerAccount)
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, key := range gs.SessionKeys {
+		if err := key.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
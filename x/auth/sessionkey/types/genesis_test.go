@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	_, _, account := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	allowedMsgs := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	expiration := time.Now().Add(time.Hour)
+	newSessionKey := func() SessionKey {
+		_, pub, _ := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+		sessionKey, err := NewSessionKey(account, pub, expiration, allowedMsgs, nil)
+		require.NoError(t, err)
+		return *sessionKey
+	}
+
+	sessionKey := newSessionKey()
+	tooMany := make([]SessionKey, MaxSessionKeysPerAccount+1)
+	for i := range tooMany {
+		tooMany[i] = newSessionKey()
+	}
+
+	testCases := []struct {
+		name         string
+		genesisState *GenesisState
+		expErr       bool
+	}{
+		{"default genesisState", DefaultGenesisState(), false},
+		{"valid genesisState", NewGenesisState([]SessionKey{sessionKey, newSessionKey()}), false},
+		{"duplicate session key", NewGenesisState([]SessionKey{sessionKey, sessionKey}), true},
+		{"too many session keys", NewGenesisState(tooMany), true},
+		{"invalid session key", NewGenesisState([]SessionKey{{Account: account.String()}}), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesisState.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
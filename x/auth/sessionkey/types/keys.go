@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name
+	ModuleName = "sessionkey"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+// SessionKeyPrefix is the prefix of the session keys
+// - 0x01<account_address_len (1 Byte)><account_address_Bytes><key_address_len (1 Byte)><key_address_Bytes>: SessionKey
+var SessionKeyPrefix = []byte{0x01}
+
+// SessionKeysPrefix returns the prefix of all the session keys of an account.
+func SessionKeysPrefix(account sdk.AccAddress) []byte {
+	return append(SessionKeyPrefix, address.MustLengthPrefix(account)...)
+}
+
+// SessionKeyKey returns the key of a session key of an account.
+func SessionKeyKey(account, keyAddr sdk.AccAddress) []byte {
+	return append(SessionKeysPrefix(account), address.MustLengthPrefix(keyAddr)...)
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg                            = &MsgAddSessionKey{}
+	_ sdk.Msg                            = &MsgRemoveSessionKey{}
+	_ codectypes.UnpackInterfacesMessage = &MsgAddSessionKey{}
+)
+
+// NewMsgAddSessionKey creates a new MsgAddSessionKey instance.
+func NewMsgAddSessionKey(
+	account sdk.AccAddress, pubKey cryptotypes.PubKey, expiration time.Time, allowedMsgs []string, spendLimit sdk.Coins,
+) (*MsgAddSessionKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgAddSessionKey{
+		Account:     account.String(),
+		PubKey:      pkAny,
+		Expiration:  expiration,
+		AllowedMsgs: allowedMsgs,
+		SpendLimit:  spendLimit,
+	}, nil
+}
+
+// GetSigners returns the expected signers for MsgAddSessionKey.
+func (msg MsgAddSessionKey) GetSigners() []sdk.AccAddress {
+	account, _ := sdk.AccAddressFromHexUnsafe(msg.Account)
+	return []sdk.AccAddress{account}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgAddSessionKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs basic MsgAddSessionKey message validation.
+func (msg MsgAddSessionKey) ValidateBasic() error {
+	return msg.SessionKey().Validate()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgAddSessionKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.PubKey, &pubKey)
+}
+
+// SessionKey returns the session key registered by the msg.
+func (msg MsgAddSessionKey) SessionKey() SessionKey {
+	return SessionKey{
+		Account:     msg.Account,
+		PubKey:      msg.PubKey,
+		Expiration:  msg.Expiration,
+		AllowedMsgs: msg.AllowedMsgs,
+		SpendLimit:  msg.SpendLimit,
+	}
+}
+
+// NewMsgRemoveSessionKey creates a new MsgRemoveSessionKey instance.
+func NewMsgRemoveSessionKey(account, keyAddr sdk.AccAddress) *MsgRemoveSessionKey {
+	return &MsgRemoveSessionKey{
+		Account: account.String(),
+		Address: keyAddr.String(),
+	}
+}
+
+// GetSigners returns the expected signers for MsgRemoveSessionKey.
+func (msg MsgRemoveSessionKey) GetSigners() []sdk.AccAddress {
+	account, _ := sdk.AccAddressFromHexUnsafe(msg.Account)
+	return []sdk.AccAddress{account}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRemoveSessionKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs basic MsgRemoveSessionKey message validation.
+func (msg MsgRemoveSessionKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.Account); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid session key address: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &QuerySessionKeyResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QuerySessionKeysResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r *QuerySessionKeyResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if r.SessionKey == nil {
+		return nil
+	}
+	return r.SessionKey.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r *QuerySessionKeysResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, key := range r.SessionKeys {
+		if err := key.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
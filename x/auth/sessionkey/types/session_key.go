@@ -0,0 +1,195 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// MaxSessionKeysPerAccount is the maximum number of session keys an account can register
+	MaxSessionKeysPerAccount = 16
+
+	// MaxAllowedMsgs is the maximum number of msg types a session key can be allowed to sign
+	MaxAllowedMsgs = 32
+)
+
+var _ codectypes.UnpackInterfacesMessage = &SessionKey{}
+
+// spendCountedMsgs are the msgs whose spend is counted by SpentCoins, a session key with a
+// spend limit can only be allowed to sign them.
+var spendCountedMsgs = map[string]bool{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}):      true,
+	sdk.MsgTypeURL(&banktypes.MsgMultiSend{}): true,
+}
+
+// NewSessionKey creates a new SessionKey instance.
+func NewSessionKey(
+	account sdk.AccAddress, pubKey cryptotypes.PubKey, expiration time.Time, allowedMsgs []string, spendLimit sdk.Coins,
+) (*SessionKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SessionKey{
+		Account:     account.String(),
+		PubKey:      pkAny,
+		Expiration:  expiration,
+		AllowedMsgs: allowedMsgs,
+		SpendLimit:  spendLimit,
+	}, nil
+}
+
+// GetSessionPubKey returns the pubkey of the session key.
+func (k SessionKey) GetSessionPubKey() (cryptotypes.PubKey, error) {
+	return unpackSessionPubKey(k.PubKey)
+}
+
+// HasSpendLimit returns true if the coins the session key can spend are limited.
+func (k SessionKey) HasSpendLimit() bool {
+	return !k.SpendLimit.Empty()
+}
+
+// IsExpired returns true if the session key can't sign txs anymore at the given time.
+func (k SessionKey) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(k.Expiration)
+}
+
+// Accept returns an error if the msgs of the account aren't all allowed to be signed by
+// the session key.
+func (k SessionKey) Accept(account sdk.AccAddress, msgs []sdk.Msg) error {
+	allowed := make(map[string]bool, len(k.AllowedMsgs))
+	for _, msg := range k.AllowedMsgs {
+		allowed[msg] = true
+	}
+
+	for _, msg := range msgs {
+		if !isMsgSigner(account, msg) {
+			continue
+		}
+		if !allowed[sdk.MsgTypeURL(msg)] {
+			return errorsmod.Wrapf(ErrMsgNotAllowed, "%s", sdk.MsgTypeURL(msg))
+		}
+	}
+	return nil
+}
+
+// Validate performs basic validation of the session key.
+func (k SessionKey) Validate() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(k.Account); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+	pubKey, err := k.GetSessionPubKey()
+	if err != nil {
+		return err
+	}
+	if sdk.AccAddress(pubKey.Address()).String() == k.Account {
+		return errorsmod.Wrap(ErrInvalidSessionKey, "session key can't be the account key")
+	}
+	if k.Expiration.IsZero() {
+		return errorsmod.Wrap(ErrInvalidSessionKey, "expiration is required")
+	}
+
+	return validatePermissions(k.AllowedMsgs, k.SpendLimit)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (k SessionKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(k.PubKey, &pubKey)
+}
+
+// SpentCoins returns the coins of the account the msgs and the fee of the tx spend, they are
+// the fee if paid by the account and the bank transfers from the account.
+func SpentCoins(account sdk.AccAddress, tx sdk.Tx) sdk.Coins {
+	spent := sdk.NewCoins()
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		if feeTx.FeeGranter().Empty() && account.Equals(feeTx.FeePayer()) {
+			spent = spent.Add(feeTx.GetFee()...)
+		}
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			if msg.FromAddress == account.String() {
+				spent = spent.Add(msg.Amount...)
+			}
+		case *banktypes.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				if input.Address == account.String() {
+					spent = spent.Add(input.Coins...)
+				}
+			}
+		}
+	}
+	return spent
+}
+
+func unpackSessionPubKey(pkAny *codectypes.Any) (cryptotypes.PubKey, error) {
+	if pkAny == nil {
+		return nil, errorsmod.Wrap(ErrInvalidSessionKey, "pubkey is required")
+	}
+	pubKey, ok := pkAny.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "expecting cryptotypes.PubKey, got %T", pkAny.GetCachedValue())
+	}
+	if _, ok := pubKey.(*ethsecp256k1.PubKey); !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "session key must be %s, got %s", ethsecp256k1.KeyType, pubKey.Type())
+	}
+	return pubKey, nil
+}
+
+func validatePermissions(allowedMsgs []string, spendLimit sdk.Coins) error {
+	if len(allowedMsgs) == 0 {
+		return errorsmod.Wrap(ErrInvalidSessionKey, "allowed msgs are empty")
+	}
+	if len(allowedMsgs) > MaxAllowedMsgs {
+		return errorsmod.Wrapf(ErrInvalidSessionKey, "allowed msgs can't exceed %d", MaxAllowedMsgs)
+	}
+
+	seen := make(map[string]bool, len(allowedMsgs))
+	for _, msg := range allowedMsgs {
+		if msg == "" {
+			return errorsmod.Wrap(ErrInvalidSessionKey, "empty allowed msg")
+		}
+		if seen[msg] {
+			return errorsmod.Wrapf(ErrInvalidSessionKey, "duplicate allowed msg %s", msg)
+		}
+		// a session key must not be able to manage the session keys of the account
+		if msg == sdk.MsgTypeURL(&MsgAddSessionKey{}) || msg == sdk.MsgTypeURL(&MsgRemoveSessionKey{}) {
+			return errorsmod.Wrapf(ErrInvalidSessionKey, "%s can't be allowed", msg)
+		}
+		seen[msg] = true
+	}
+
+	if !spendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid spend limit: %s", spendLimit)
+	}
+	// the spend of the other msgs can't be computed, so it can't be limited
+	if !spendLimit.Empty() {
+		for _, msg := range allowedMsgs {
+			if !spendCountedMsgs[msg] {
+				return errorsmod.Wrapf(ErrInvalidSessionKey, "spend of %s can't be limited", msg)
+			}
+		}
+	}
+	return nil
+}
+
+func isMsgSigner(account sdk.AccAddress, msg sdk.Msg) bool {
+	for _, signer := range msg.GetSigners() {
+		if signer.Equals(account) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSessionKeyValidate(t *testing.T) {
+	_, accountPub, account := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	_, pub, _ := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	expiration := time.Now().Add(time.Hour)
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	voteURL := "/cosmos.gov.v1.MsgVote"
+
+	invalidCoins := sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}
+
+	testCases := []struct {
+		name        string
+		pubKey      cryptotypes.PubKey
+		expiration  time.Time
+		allowedMsgs []string
+		spendLimit  sdk.Coins
+		expErr      bool
+	}{
+		{"valid", pub, expiration, []string{sendURL}, nil, false},
+		{"account key", accountPub, expiration, []string{sendURL}, nil, true},
+		{"not eth key", secp256k1.GenPrivKey().PubKey(), expiration, []string{sendURL}, nil, true},
+		{"no expiration", pub, time.Time{}, []string{sendURL}, nil, true},
+		{"no allowed msgs", pub, expiration, nil, nil, true},
+		{"duplicate allowed msgs", pub, expiration, []string{sendURL, sendURL}, nil, true},
+		{"session key msg allowed", pub, expiration, []string{sdk.MsgTypeURL(&MsgAddSessionKey{})}, nil, true},
+		{"invalid spend limit", pub, expiration, []string{sendURL}, invalidCoins, true},
+		{"spend limit", pub, expiration, []string{sendURL}, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), false},
+		{"spend limit of uncounted msg", pub, expiration, []string{sendURL, voteURL}, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), true},
+		{"no spend limit of uncounted msg", pub, expiration, []string{sendURL, voteURL}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sessionKey, err := NewSessionKey(account, tc.pubKey, tc.expiration, tc.allowedMsgs, tc.spendLimit)
+			require.NoError(t, err)
+			err = sessionKey.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSessionKeyAccept(t *testing.T) {
+	_, _, account := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	_, pub, other := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	sessionKey, err := NewSessionKey(account, pub, time.Now(), []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil)
+	require.NoError(t, err)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	require.NoError(t, sessionKey.Accept(account, []sdk.Msg{banktypes.NewMsgSend(account, other, coins)}))
+
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(account, coins)},
+		[]banktypes.Output{banktypes.NewOutput(other, coins)},
+	)
+	require.ErrorIs(t, sessionKey.Accept(account, []sdk.Msg{multiSend}), ErrMsgNotAllowed)
+
+	// msgs signed by other accounts of the tx are not restricted
+	otherMultiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(other, coins)},
+		[]banktypes.Output{banktypes.NewOutput(account, coins)},
+	)
+	require.NoError(t, sessionKey.Accept(account, []sdk.Msg{otherMultiSend}))
+}
+
+func TestSpentCoins(t *testing.T) {
+	_, _, account := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	_, _, other := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+
+	tx := mockFeeTx{
+		msgs: []sdk.Msg{
+			banktypes.NewMsgSend(account, other, coins),
+			banktypes.NewMsgSend(other, account, coins),
+			banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(account, coins)},
+				[]banktypes.Output{banktypes.NewOutput(other, coins)},
+			),
+		},
+		fee:   fee,
+		payer: account,
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 21)), SpentCoins(account, tx))
+
+	// the fee isn't spent by the account if it is granted
+	tx.granter = other
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), SpentCoins(account, tx))
+}
+
+type mockFeeTx struct {
+	msgs    []sdk.Msg
+	fee     sdk.Coins
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+func (tx mockFeeTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx mockFeeTx) ValidateBasic() error       { return nil }
+func (tx mockFeeTx) GetGas() uint64             { return 0 }
+func (tx mockFeeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx mockFeeTx) FeePayer() sdk.AccAddress   { return tx.payer }
+func (tx mockFeeTx) FeeGranter() sdk.AccAddress { return tx.granter }
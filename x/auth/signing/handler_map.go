@@ -16,7 +16,12 @@ type SignModeHandlerMap struct {
 	signModeHandlers map[signing.SignMode]SignModeHandler
 }
 
-var _ SignModeHandler = SignModeHandlerMap{}
+var (
+	_ SignModeHandler                    = SignModeHandlerMap{}
+	_ SignModeHandlerWithAltSignBytes    = SignModeHandlerMap{}
+	_ SignModeHandlerWithTypedDataHashes = SignModeHandlerMap{}
+	_ SignModeHandlerWithLegacyEncoding  = SignModeHandlerMap{}
+)
 
 // NewSignModeHandlerMap returns a new SignModeHandlerMap with the provided defaultMode and handlers
 func NewSignModeHandlerMap(defaultMode signing.SignMode, handlers []SignModeHandler) SignModeHandlerMap {
@@ -58,3 +63,47 @@ func (h SignModeHandlerMap) GetSignBytes(mode signing.SignMode, data SignerData,
 	}
 	return handler.GetSignBytes(mode, data, tx)
 }
+
+// GetAltSignBytes implements SignModeHandlerWithAltSignBytes.GetAltSignBytes
+func (h SignModeHandlerMap) GetAltSignBytes(mode signing.SignMode, data SignerData, tx sdk.Tx) ([][]byte, error) {
+	handler, found := h.signModeHandlers[mode]
+	if !found {
+		return nil, fmt.Errorf("can't verify sign mode %s", mode.String())
+	}
+	altHandler, ok := handler.(SignModeHandlerWithAltSignBytes)
+	if !ok {
+		return nil, nil
+	}
+	return altHandler.GetAltSignBytes(mode, data, tx)
+}
+
+// GetTypedDataHashes implements SignModeHandlerWithTypedDataHashes.GetTypedDataHashes
+func (h SignModeHandlerMap) GetTypedDataHashes(mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, []byte, error) {
+	handler, found := h.signModeHandlers[mode]
+	if !found {
+		return nil, nil, fmt.Errorf("can't verify sign mode %s", mode.String())
+	}
+	typedDataHandler, ok := handler.(SignModeHandlerWithTypedDataHashes)
+	if !ok {
+		return nil, nil, fmt.Errorf("sign mode %s has no typed data", mode.String())
+	}
+	return typedDataHandler.GetTypedDataHashes(mode, data, tx)
+}
+
+// WithLegacyEncoding implements SignModeHandlerWithLegacyEncoding.WithLegacyEncoding, it returns
+// a handler map whose handlers use the legacy encoding if they support it.
+func (h SignModeHandlerMap) WithLegacyEncoding() SignModeHandler {
+	handlers := make(map[signing.SignMode]SignModeHandler, len(h.signModeHandlers))
+	for mode, handler := range h.signModeHandlers {
+		if legacyHandler, ok := handler.(SignModeHandlerWithLegacyEncoding); ok {
+			handler = legacyHandler.WithLegacyEncoding()
+		}
+		handlers[mode] = handler
+	}
+
+	return SignModeHandlerMap{
+		defaultMode:      h.defaultMode,
+		modes:            h.modes,
+		signModeHandlers: handlers,
+	}
+}
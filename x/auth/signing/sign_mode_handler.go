@@ -21,6 +21,28 @@ type SignModeHandler interface {
 	GetSignBytes(mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
 }
 
+// SignModeHandlerWithAltSignBytes is an optional extension of SignModeHandler for handlers
+// which accept more than one sign bytes for the same tx, e.g. during a migration of the
+// EIP-712 domain. The sign bytes returned by GetSignBytes are always checked first.
+type SignModeHandlerWithAltSignBytes interface {
+	SignModeHandler
+
+	// GetAltSignBytes returns the alternative sign bytes for the provided SignMode,
+	// SignerData and Tx, or an error
+	GetAltSignBytes(mode signing.SignMode, data SignerData, tx sdk.Tx) ([][]byte, error)
+}
+
+// SignModeHandlerWithTypedDataHashes is an optional extension of SignModeHandler for the EIP-712
+// handlers, which returns the hash of the domain and the hash of the message the sign bytes are
+// computed from. They are signed by the hardware wallets instead of the sign bytes.
+type SignModeHandlerWithTypedDataHashes interface {
+	SignModeHandler
+
+	// GetTypedDataHashes returns the hash of the EIP-712 domain and the hash of the EIP-712 message
+	// for the provided SignMode, SignerData and Tx, or an error
+	GetTypedDataHashes(mode signing.SignMode, data SignerData, tx sdk.Tx) (domainSeparator, messageHash []byte, err error)
+}
+
 // SignerData is the specific information needed to sign a transaction that generally
 // isn't included in the transaction body itself
 type SignerData struct {
@@ -51,3 +73,13 @@ type SignerData struct {
 	// multisig that is signing the current sign doc.
 	PubKey cryptotypes.PubKey
 }
+
+// SignModeHandlerWithLegacyEncoding is an optional extension of SignModeHandler for the EIP-712
+// handlers, which returns a handler computing the sign bytes with the encoding used before the
+// Nagqu upgrade, and accepting the new encoding as alternative sign bytes until the upgrade.
+type SignModeHandlerWithLegacyEncoding interface {
+	SignModeHandler
+
+	// WithLegacyEncoding returns a handler computing the sign bytes with the legacy encoding
+	WithLegacyEncoding() SignModeHandler
+}
@@ -10,14 +10,15 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 // VerifySignature verifies a transaction signature contained in SignatureData abstracting over different signing modes
-// and single vs multi-signatures.
-func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData signing.SignatureData, handler SignModeHandler, tx sdk.Tx, sigCache *lru.ARCCache, txBytes []byte) error {
+// and single vs multi-signatures. The multi-signatures are only accepted if multisigEnabled, i.e. after the Nagqu upgrade.
+func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData signing.SignatureData, handler SignModeHandler, tx sdk.Tx, sigCache *lru.ARCCache, txBytes []byte, multisigEnabled bool) error {
 	switch data := sigData.(type) {
 	case *signing.SingleSignatureData:
 		// EIP712 signatures are verified in a different way
@@ -47,23 +48,33 @@ func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData s
 				sig[ethcrypto.RecoveryIDOffset] -= 27
 			}
 
-			// recover the pubkey from the signature
-			feePayerPubkey, err := secp256k1.RecoverPubkey(sigHash, sig)
+			pk, err := recoverEip712PubKey(sigHash, sig)
 			if err != nil {
-				return errorsmod.Wrap(err, "failed to recover fee payer from sig")
-			}
-
-			ecPubKey, err := ethcrypto.UnmarshalPubkey(feePayerPubkey)
-			if err != nil {
-				return errorsmod.Wrap(err, "failed to unmarshal recovered fee payer pubkey")
+				return err
 			}
 
 			// check that the recovered pubkey matches the one in the signerData data
-			pk := &ethsecp256k1.PubKey{
-				Key: ethcrypto.CompressPubkey(ecPubKey),
-			}
 			if !pubKey.Equals(pk) {
-				return errorsmod.Wrapf(sdkerrors.ErrorInvalidSigner, "feePayer's pubkey %s is different from signature's pubkey %s", pubKey, pk)
+				// the signature might be produced with a legacy EIP-712 domain
+				altHandler, ok := handler.(SignModeHandlerWithAltSignBytes)
+				if !ok {
+					return errorsmod.Wrapf(sdkerrors.ErrorInvalidSigner, "feePayer's pubkey %s is different from signature's pubkey %s", pubKey, pk)
+				}
+				altSigHashes, err := altHandler.GetAltSignBytes(data.SignMode, signerData, tx)
+				if err != nil {
+					return err
+				}
+				matched := false
+				for _, altSigHash := range altSigHashes {
+					altPk, err := recoverEip712PubKey(altSigHash, sig)
+					if err == nil && pubKey.Equals(altPk) {
+						matched = true
+						break
+					}
+				}
+				if !matched {
+					return errorsmod.Wrapf(sdkerrors.ErrorInvalidSigner, "feePayer's pubkey %s is different from signature's pubkey %s", pubKey, pk)
+				}
 			}
 
 			// add the tx to the cache if needed
@@ -84,8 +95,108 @@ func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData s
 		}
 
 	case *signing.MultiSignatureData:
-		return fmt.Errorf("multi signature is not allowed")
+		if !multisigEnabled {
+			return fmt.Errorf("multi signature is not allowed")
+		}
+		multiPK, ok := pubKey.(multisig.PubKey)
+		if !ok {
+			return fmt.Errorf("expected %T, got %T", (multisig.PubKey)(nil), pubKey)
+		}
+		if err := validateMultisigSignModes(data, false); err != nil {
+			return err
+		}
+
+		// skip signature verification if we have a cache and the tx is already in it
+		if sigCache != nil && txBytes != nil {
+			if _, known := sigCache.Get(string(txBytes)); known {
+				return nil
+			}
+		}
+
+		err := multiPK.VerifyMultisignature(func(mode signing.SignMode) ([]byte, error) {
+			return handler.GetSignBytes(mode, signerData, tx)
+		}, data)
+		if err != nil {
+			// the EIP-712 signatures might be produced with a legacy EIP-712 domain
+			if !verifyMultisignatureWithAltSignBytes(multiPK, signerData, data, handler, tx) {
+				return err
+			}
+		}
+
+		// add the tx to the cache if needed
+		if sigCache != nil && txBytes != nil {
+			sigCache.Add(string(txBytes), tx)
+		}
+		return nil
 	default:
 		return fmt.Errorf("unexpected SignatureData %T", sigData)
 	}
 }
+
+// validateMultisigSignModes checks that the signatures of the multisig are produced with the sign
+// modes which have a defined multisig encoding. The signatures of the members are either amino-json
+// or EIP-712 signatures, the nested multisigs are only defined for amino-json.
+func validateMultisigSignModes(data *signing.MultiSignatureData, nested bool) error {
+	for _, sig := range data.Signatures {
+		switch sig := sig.(type) {
+		case *signing.SingleSignatureData:
+			switch {
+			case sig.SignMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+			case sig.SignMode == signing.SignMode_SIGN_MODE_EIP_712 && !nested:
+			default:
+				return errorsmod.Wrapf(sdkerrors.ErrNotSupported, "sign mode %s in multisig", sig.SignMode)
+			}
+		case *signing.MultiSignatureData:
+			if err := validateMultisigSignModes(sig, true); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unexpected SignatureData %T", sig)
+		}
+	}
+	return nil
+}
+
+// verifyMultisignatureWithAltSignBytes verifies a multisignature against the alternative EIP-712
+// sign bytes of the handler, all the EIP-712 signatures of the members must be produced with the
+// same domain.
+func verifyMultisignatureWithAltSignBytes(multiPK multisig.PubKey, signerData SignerData, data *signing.MultiSignatureData, handler SignModeHandler, tx sdk.Tx) bool {
+	altHandler, ok := handler.(SignModeHandlerWithAltSignBytes)
+	if !ok {
+		return false
+	}
+	altSigHashes, err := altHandler.GetAltSignBytes(signing.SignMode_SIGN_MODE_EIP_712, signerData, tx)
+	if err != nil {
+		return false
+	}
+	for _, altSigHash := range altSigHashes {
+		err = multiPK.VerifyMultisignature(func(mode signing.SignMode) ([]byte, error) {
+			if mode == signing.SignMode_SIGN_MODE_EIP_712 {
+				return altSigHash, nil
+			}
+			return handler.GetSignBytes(mode, signerData, tx)
+		}, data)
+		if err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// recoverEip712PubKey recovers the eth secp256k1 pubkey from an EIP-712 signature.
+func recoverEip712PubKey(sigHash, sig []byte) (*ethsecp256k1.PubKey, error) {
+	// recover the pubkey from the signature
+	feePayerPubkey, err := secp256k1.RecoverPubkey(sigHash, sig)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to recover fee payer from sig")
+	}
+
+	ecPubKey, err := ethcrypto.UnmarshalPubkey(feePayerPubkey)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unmarshal recovered fee payer pubkey")
+	}
+
+	return &ethsecp256k1.PubKey{
+		Key: ethcrypto.CompressPubkey(ecPubKey),
+	}, nil
+}
@@ -19,15 +19,32 @@ type config struct {
 	protoCodec  codec.ProtoCodecMarshaler
 }
 
+// ConfigOptions define the configuration of a protobuf TxConfig.
+type ConfigOptions struct {
+	// EnabledSignModes are the sign modes supported by the TxConfig, the first enabled
+	// sign mode will become the default sign mode.
+	EnabledSignModes []signingtypes.SignMode
+	// EIP712Domain is the domain used by SIGN_MODE_EIP_712, DefaultEIP712Domain is used if it is nil.
+	EIP712Domain *EIP712Domain
+	// LegacyEIP712Domains are previous EIP-712 domains which are still accepted when
+	// verifying SIGN_MODE_EIP_712 signatures before the Nagqu upgrade.
+	LegacyEIP712Domains []EIP712Domain
+}
+
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
 // first enabled sign mode will become the default sign mode.
 // NOTE: Use NewTxConfigWithHandler to provide a custom signing handler in case the sign mode
 // is not supported by default (eg: SignMode_SIGN_MODE_EIP_191).
 func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
-	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes))
+	return NewTxConfigWithOptions(protoCodec, ConfigOptions{EnabledSignModes: enabledSignModes})
+}
+
+// NewTxConfigWithOptions returns a new protobuf TxConfig using the provided ProtoCodec and options.
+func NewTxConfigWithOptions(protoCodec codec.ProtoCodecMarshaler, options ConfigOptions) client.TxConfig {
+	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(options))
 }
 
-// NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and signing handler.
+// NewTxConfigWithHandler returns a new protobuf TxConfig using the provided ProtoCodec and signing handler.
 func NewTxConfigWithHandler(protoCodec codec.ProtoCodecMarshaler, handler signing.SignModeHandler) client.TxConfig {
 	return &config{
 		handler:     handler,
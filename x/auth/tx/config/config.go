@@ -33,8 +33,19 @@ type TxInputs struct {
 	AccountKeeper  ante.AccountKeeper    `optional:"true"`
 	BankKeeper     authtypes.BankKeeper  `optional:"true"`
 	FeeGrantKeeper feegrantkeeper.Keeper `optional:"true"`
+
+	AccountVerifiers []AccountVerifierOption
+}
+
+// AccountVerifierOption lets a module provide an ante.AccountSignatureVerifier to the
+// default ante handler.
+type AccountVerifierOption struct {
+	ante.AccountSignatureVerifier
 }
 
+// IsManyPerContainerType indicates that this is a depinject.ManyPerContainerType.
+func (AccountVerifierOption) IsManyPerContainerType() {}
+
 type TxOutputs struct {
 	depinject.Out
 
@@ -43,7 +54,11 @@ type TxOutputs struct {
 }
 
 func ProvideModule(in TxInputs) TxOutputs {
-	txConfig := tx.NewTxConfig(in.ProtoCodecMarshaler, tx.DefaultSignModes)
+	txConfig := tx.NewTxConfigWithOptions(in.ProtoCodecMarshaler, tx.ConfigOptions{
+		EnabledSignModes:    tx.DefaultSignModes,
+		EIP712Domain:        eip712DomainFromConfig(in.Config.Eip712Domain),
+		LegacyEIP712Domains: legacyEIP712DomainsFromConfig(in.Config.LegacyEip712Domains),
+	})
 
 	baseAppOption := func(app *baseapp.BaseApp) {
 		// AnteHandlers
@@ -92,13 +107,19 @@ func newAnteHandler(txConfig client.TxConfig, in TxInputs) (sdk.AnteHandler, err
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
 	}
 
+	accountVerifiers := make([]ante.AccountSignatureVerifier, 0, len(in.AccountVerifiers))
+	for _, verifier := range in.AccountVerifiers {
+		accountVerifiers = append(accountVerifiers, verifier.AccountSignatureVerifier)
+	}
+
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   in.AccountKeeper,
-			BankKeeper:      in.BankKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			FeegrantKeeper:  in.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:    in.AccountKeeper,
+			BankKeeper:       in.BankKeeper,
+			SignModeHandler:  txConfig.SignModeHandler(),
+			FeegrantKeeper:   in.FeeGrantKeeper,
+			SigGasConsumer:   ante.DefaultSigVerificationGasConsumer,
+			AccountVerifiers: accountVerifiers,
 		},
 	)
 	if err != nil {
@@ -107,3 +128,28 @@ func newAnteHandler(txConfig client.TxConfig, in TxInputs) (sdk.AnteHandler, err
 
 	return anteHandler, nil
 }
+
+func eip712DomainFromConfig(domain *txconfigv1.Eip712Domain) *tx.EIP712Domain {
+	if domain == nil {
+		return nil
+	}
+
+	return &tx.EIP712Domain{
+		Name:              domain.Name,
+		Version:           domain.Version,
+		VerifyingContract: domain.VerifyingContract,
+		Salt:              domain.Salt,
+	}
+}
+
+func legacyEIP712DomainsFromConfig(domains []*txconfigv1.Eip712Domain) []tx.EIP712Domain {
+	legacyDomains := make([]tx.EIP712Domain, 0, len(domains))
+	for _, domain := range domains {
+		if domain == nil {
+			continue
+		}
+		legacyDomains = append(legacyDomains, *eip712DomainFromConfig(domain))
+	}
+
+	return legacyDomains
+}
@@ -32,17 +32,97 @@ import (
 	"github.com/cosmos/gogoproto/jsonpb"
 )
 
-var domain = &apitypes.TypedDataDomain{
+// EIP712Domain defines the chain specific fields of the EIP-712 domain separator.
+// The chainId of the domain is always derived from the signer data.
+type EIP712Domain struct {
+	Name              string
+	Version           string
+	VerifyingContract string
+	Salt              string
+}
+
+// DefaultEIP712Domain is the EIP-712 domain used by greenfield.
+var DefaultEIP712Domain = EIP712Domain{
 	Name:              "Greenfield Tx",
 	Version:           "1.0.0",
 	VerifyingContract: "greenfield",
 	Salt:              "0",
 }
 
-// signModeEip712Handler defines the SIGN_MODE_DIRECT SignModeHandler
-type signModeEip712Handler struct{}
+// ValidateBasic performs a stateless validation of the domain.
+func (d EIP712Domain) ValidateBasic() error {
+	if d.Name == "" {
+		return fmt.Errorf("eip712 domain name cannot be empty")
+	}
+	if d.Version == "" {
+		return fmt.Errorf("eip712 domain version cannot be empty")
+	}
+	return nil
+}
+
+// Types returns the EIP712Domain type definition, optional fields are only
+// included when they are set.
+func (d EIP712Domain) Types() []apitypes.Type {
+	domainTypes := []apitypes.Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+	}
+	if d.VerifyingContract != "" {
+		domainTypes = append(domainTypes, apitypes.Type{Name: "verifyingContract", Type: "string"})
+	}
+	if d.Salt != "" {
+		domainTypes = append(domainTypes, apitypes.Type{Name: "salt", Type: "string"})
+	}
+	return domainTypes
+}
+
+// TypedDataDomain returns the go-ethereum representation of the domain for the given chain id.
+func (d EIP712Domain) TypedDataDomain(chainID uint64) apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:              d.Name,
+		Version:           d.Version,
+		ChainId:           math.NewHexOrDecimal256(int64(chainID)),
+		VerifyingContract: d.VerifyingContract,
+		Salt:              d.Salt,
+	}
+}
+
+// signModeEip712Handler defines the SIGN_MODE_EIP_712 SignModeHandler
+type signModeEip712Handler struct {
+	// domain is the domain used to generate sign bytes, DefaultEIP712Domain is used if it is not set.
+	domain *EIP712Domain
+	// legacyDomains are the previous versions of the domain which are still accepted
+	// when verifying signatures before the Nagqu upgrade, so that the domain change is
+	// rolled out through the upgrade without invalidating txs which are signed but not
+	// yet included.
+	legacyDomains []EIP712Domain
+	// legacyEncoding is true if the types and the values of the msgs are derived with the encoding
+	// used before the nested Any, one_of and repeated msg fields were supported.
+	legacyEncoding bool
+}
+
+var (
+	_ signing.SignModeHandler                    = signModeEip712Handler{}
+	_ signing.SignModeHandlerWithAltSignBytes    = signModeEip712Handler{}
+	_ signing.SignModeHandlerWithTypedDataHashes = signModeEip712Handler{}
+	_ signing.SignModeHandlerWithLegacyEncoding  = signModeEip712Handler{}
+)
 
-var _ signing.SignModeHandler = signModeEip712Handler{}
+// newSignModeEip712Handler returns a SIGN_MODE_EIP_712 handler signing with the provided domain.
+func newSignModeEip712Handler(domain EIP712Domain, legacyDomains []EIP712Domain) signModeEip712Handler {
+	return signModeEip712Handler{
+		domain:        &domain,
+		legacyDomains: legacyDomains,
+	}
+}
+
+func (h signModeEip712Handler) activeDomain() EIP712Domain {
+	if h.domain == nil {
+		return DefaultEIP712Domain
+	}
+	return *h.domain
+}
 
 // DefaultMode implements SignModeHandler.DefaultMode
 func (signModeEip712Handler) DefaultMode() signingtypes.SignMode {
@@ -55,27 +135,62 @@ func (signModeEip712Handler) Modes() []signingtypes.SignMode {
 }
 
 // GetSignBytes implements SignModeHandler.GetSignBytes
-func (signModeEip712Handler) GetSignBytes(mode signingtypes.SignMode, signerData signing.SignerData, tx sdk.Tx) ([]byte, error) {
-	if mode != signingtypes.SignMode_SIGN_MODE_EIP_712 {
-		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_EIP_712, mode)
+func (h signModeEip712Handler) GetSignBytes(mode signingtypes.SignMode, signerData signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	return h.getSignBytes(h.activeDomain(), mode, signerData, tx)
+}
+
+// GetAltSignBytes implements SignModeHandlerWithAltSignBytes.GetAltSignBytes. Only the handler
+// with the legacy encoding, which is used before the Nagqu upgrade, returns alternative sign bytes:
+// the sign bytes computed with each of the legacy domains, and the sign bytes computed with the
+// new encoding, so that the signatures of the clients which are already upgraded are accepted.
+func (h signModeEip712Handler) GetAltSignBytes(mode signingtypes.SignMode, signerData signing.SignerData, tx sdk.Tx) ([][]byte, error) {
+	if !h.legacyEncoding {
+		return nil, nil
 	}
 
-	// get the EIP155 chainID from the signerData
-	chainID, err := sdk.ParseChainID(signerData.ChainID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse chainID: %s", signerData.ChainID)
+	altSignBytes := make([][]byte, 0, 2*len(h.legacyDomains)+1)
+	for _, legacyDomain := range h.legacyDomains {
+		sigHash, err := h.getSignBytes(legacyDomain, mode, signerData, tx)
+		if err != nil {
+			return nil, err
+		}
+		altSignBytes = append(altSignBytes, sigHash)
 	}
 
-	// get the EIP712 types and signDoc from the tx
-	msgTypes, signDoc, err := GetMsgTypes(signerData, tx, chainID)
+	h.legacyEncoding = false
+	for _, domain := range append([]EIP712Domain{h.activeDomain()}, h.legacyDomains...) {
+		sigHash, err := h.getSignBytes(domain, mode, signerData, tx)
+		if err != nil {
+			return nil, err
+		}
+		altSignBytes = append(altSignBytes, sigHash)
+	}
+	return altSignBytes, nil
+}
+
+// WithLegacyEncoding implements SignModeHandlerWithLegacyEncoding.WithLegacyEncoding, it returns
+// a handler which derives the types and the values of the msgs with the legacy encoding.
+func (h signModeEip712Handler) WithLegacyEncoding() signing.SignModeHandler {
+	h.legacyEncoding = true
+	return h
+}
+
+// GetTypedDataHashes implements SignModeHandlerWithTypedDataHashes.GetTypedDataHashes, it returns
+// the hashes of the domain and of the message of the sign bytes, which are signed by the Ledger
+// Ethereum app.
+func (h signModeEip712Handler) GetTypedDataHashes(mode signingtypes.SignMode, signerData signing.SignerData, tx sdk.Tx) ([]byte, []byte, error) {
+	typedData, err := h.getTypedData(h.activeDomain(), mode, signerData, tx)
 	if err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to get msg types")
+		return nil, nil, err
 	}
 
-	// pack the tx data in EIP712 object
-	typedData, err := WrapTxToTypedData(chainID.Uint64(), signDoc, msgTypes)
+	return ComputeTypedDataHashes(typedData)
+}
+
+func (h signModeEip712Handler) getSignBytes(domain EIP712Domain, mode signingtypes.SignMode, signerData signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	typedData, err := h.getTypedData(domain, mode, signerData, tx)
 	if err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to pack tx data in EIP712 object")
+		return nil, err
 	}
 
 	// compute the hash
@@ -87,7 +202,38 @@ func (signModeEip712Handler) GetSignBytes(mode signingtypes.SignMode, signerData
 	return sigHash, nil
 }
 
+func (h signModeEip712Handler) getTypedData(domain EIP712Domain, mode signingtypes.SignMode, signerData signing.SignerData, tx sdk.Tx) (apitypes.TypedData, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_EIP_712 {
+		return apitypes.TypedData{}, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_EIP_712, mode)
+	}
+
+	// get the EIP155 chainID from the signerData
+	chainID, err := sdk.ParseChainID(signerData.ChainID)
+	if err != nil {
+		return apitypes.TypedData{}, fmt.Errorf("failed to parse chainID: %s", signerData.ChainID)
+	}
+
+	// get the EIP712 types and signDoc from the tx
+	msgTypes, signDoc, err := getMsgTypes(signerData, tx, chainID, h.legacyEncoding)
+	if err != nil {
+		return apitypes.TypedData{}, errorsmod.Wrapf(err, "failed to get msg types")
+	}
+
+	// pack the tx data in EIP712 object
+	typedData, err := wrapTxToTypedData(domain, chainID.Uint64(), signDoc, msgTypes, h.legacyEncoding)
+	if err != nil {
+		return apitypes.TypedData{}, errorsmod.Wrapf(err, "failed to pack tx data in EIP712 object")
+	}
+
+	return typedData, nil
+}
+
+// GetMsgTypes returns the EIP712 types and the sign doc of the tx.
 func GetMsgTypes(signerData signing.SignerData, tx sdk.Tx, typedChainID *big.Int) (apitypes.Types, *types.SignDocEip712, error) {
+	return getMsgTypes(signerData, tx, typedChainID, false)
+}
+
+func getMsgTypes(signerData signing.SignerData, tx sdk.Tx, typedChainID *big.Int, legacyEncoding bool) (apitypes.Types, *types.SignDocEip712, error) {
 	protoTx, ok := tx.(*wrapper)
 	if !ok {
 		return nil, nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
@@ -117,28 +263,7 @@ func GetMsgTypes(signerData signing.SignerData, tx sdk.Tx, typedChainID *big.Int
 
 	// extract the msg types
 	msgTypes := apitypes.Types{
-		"EIP712Domain": {
-			{
-				Name: "name",
-				Type: "string",
-			},
-			{
-				Name: "version",
-				Type: "string",
-			},
-			{
-				Name: "chainId",
-				Type: "uint256",
-			},
-			{
-				Name: "verifyingContract",
-				Type: "string",
-			},
-			{
-				Name: "salt",
-				Type: "string",
-			},
-		},
+		"EIP712Domain": DefaultEIP712Domain.Types(),
 		"Tx": {
 			{Name: "account_number", Type: "uint256"},
 			{Name: "chain_id", Type: "uint256"},
@@ -159,7 +284,7 @@ func GetMsgTypes(signerData signing.SignerData, tx sdk.Tx, typedChainID *big.Int
 		},
 	}
 	for i, msg := range protoTx.GetMsgs() {
-		tmpMsgTypes, err := extractMsgTypes(msg, i+1)
+		tmpMsgTypes, err := extractMsgTypes(msg, i+1, legacyEncoding)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -188,26 +313,55 @@ func GetMsgTypes(signerData signing.SignerData, tx sdk.Tx, typedChainID *big.Int
 
 // ComputeTypedDataHash computes keccak hash of typed data for signing.
 func ComputeTypedDataHash(typedData apitypes.TypedData) ([]byte, error) {
-	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	domainSeparator, typedDataHash, err := ComputeTypedDataHashes(typedData)
 	if err != nil {
-		err = errorsmod.Wrap(err, "failed to pack and hash typedData EIP712Domain")
 		return nil, err
 	}
 
-	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
+	return crypto.Keccak256(rawData), nil
+}
+
+// ComputeTypedDataHashes computes the hash of the domain and the hash of the message of typed data.
+func ComputeTypedDataHashes(typedData apitypes.TypedData) (domainSeparator, typedDataHash []byte, err error) {
+	domainSeparator, err = typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to pack and hash typedData EIP712Domain")
+	}
+
+	typedDataHash, err = typedData.HashStruct(typedData.PrimaryType, typedData.Message)
 	if err != nil {
-		err = errorsmod.Wrap(err, "failed to pack and hash typedData primary type")
-		return nil, err
+		return nil, nil, errorsmod.Wrap(err, "failed to pack and hash typedData primary type")
 	}
 
-	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
-	return crypto.Keccak256(rawData), nil
+	return domainSeparator, typedDataHash, nil
 }
 
+// WrapTxToTypedData packs the sign doc in an EIP712 object using DefaultEIP712Domain.
 func WrapTxToTypedData(
 	chainID uint64,
 	signDoc *types.SignDocEip712,
 	msgTypes apitypes.Types,
+) (apitypes.TypedData, error) {
+	return WrapTxToTypedDataWithDomain(DefaultEIP712Domain, chainID, signDoc, msgTypes)
+}
+
+// WrapTxToTypedDataWithDomain packs the sign doc in an EIP712 object using the provided domain.
+func WrapTxToTypedDataWithDomain(
+	domain EIP712Domain,
+	chainID uint64,
+	signDoc *types.SignDocEip712,
+	msgTypes apitypes.Types,
+) (apitypes.TypedData, error) {
+	return wrapTxToTypedData(domain, chainID, signDoc, msgTypes, false)
+}
+
+func wrapTxToTypedData(
+	domain EIP712Domain,
+	chainID uint64,
+	signDoc *types.SignDocEip712,
+	msgTypes apitypes.Types,
+	legacyEncoding bool,
 ) (apitypes.TypedData, error) {
 	msgCodec := jsonpb.Marshaler{
 		EmitDefaults: true,
@@ -231,10 +385,16 @@ func WrapTxToTypedData(
 	msgData := txData["msgs"].([]interface{})
 	for i := range signDoc.GetMsgs() {
 		txData[fmt.Sprintf("msg%d", i+1)] = msgData[i]
-		cleanTypesAndMsgValue(msgTypes, fmt.Sprintf("Msg%d", i+1), msgData[i].(map[string]interface{}))
+		if legacyEncoding {
+			legacyCleanTypesAndMsgValue(msgTypes, fmt.Sprintf("Msg%d", i+1), msgData[i].(map[string]interface{}))
+		} else {
+			cleanTypesAndMsgValue(msgTypes, fmt.Sprintf("Msg%d", i+1), msgData[i].(map[string]interface{}), false)
+		}
 	}
 	delete(txData, "msgs")
 
+	msgTypes["EIP712Domain"] = domain.Types()
+
 	// sort the msg types
 	for _, val := range msgTypes {
 		sort.Slice(val, func(i, j int) bool {
@@ -242,26 +402,28 @@ func WrapTxToTypedData(
 		})
 	}
 
-	tempDomain := *domain
-	tempDomain.ChainId = math.NewHexOrDecimal256(int64(chainID))
 	typedData := apitypes.TypedData{
 		Types:       msgTypes,
 		PrimaryType: "Tx",
-		Domain:      tempDomain,
+		Domain:      domain.TypedDataDomain(chainID),
 		Message:     txData,
 	}
 
 	return typedData, nil
 }
 
-func extractMsgTypes(msg sdk.Msg, index int) (apitypes.Types, error) {
+func extractMsgTypes(msg sdk.Msg, index int, legacyEncoding bool) (apitypes.Types, error) {
 	rootTypes := apitypes.Types{
 		fmt.Sprintf("Msg%d", index): {
 			{Name: "type", Type: "string"},
 		},
 	}
 
-	if err := walkFields(rootTypes, msg, index); err != nil {
+	walk := walkFields
+	if legacyEncoding {
+		walk = legacyWalkFields
+	}
+	if err := walk(rootTypes, msg, index); err != nil {
 		return nil, err
 	}
 
@@ -288,7 +450,7 @@ func walkFields(typeMap apitypes.Types, in interface{}, index int) (err error) {
 		break
 	}
 
-	return traverseFields(typeMap, typeDefPrefix, index, t, v)
+	return traverseFields(typeMap, newTypeDefNames(index), typeDefPrefix, index, t, v)
 }
 
 type anyWrapper struct {
@@ -296,8 +458,58 @@ type anyWrapper struct {
 	Value []byte `json:"value"`
 }
 
+// typeDefNames assigns a unique typedef name to every field path of a msg.
+type typeDefNames struct {
+	index int
+	names map[string]string // field path -> typedef
+	paths map[string]string // typedef -> field path
+}
+
+func newTypeDefNames(index int) *typeDefNames {
+	return &typeDefNames{
+		index: index,
+		names: make(map[string]string),
+		paths: make(map[string]string),
+	}
+}
+
+// get returns the typedef of the field path. Different paths can be sanitized to the
+// same typedef, e.g. `_.a_b.c` and `_.a.b_c`, in which case the path visited later gets
+// a numeric suffix. The fields are always visited in the same order so the names are
+// deterministic.
+func (n *typeDefNames) get(path string) string {
+	if name, ok := n.names[path]; ok {
+		return name
+	}
+
+	base := sanitizeTypedef(path, n.index)
+	name := base
+	for i := 2; ; i++ {
+		if _, taken := n.paths[name]; !taken {
+			break
+		}
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+
+	n.names[path] = name
+	n.paths[name] = path
+	return name
+}
+
+// appendTypeField appends the field to the typedef. Fields which are already defined are
+// skipped so that the types of all the elements of a collection are merged.
+func appendTypeField(typeMap apitypes.Types, typeDef string, field apitypes.Type) {
+	for _, f := range typeMap[typeDef] {
+		if f.Name == field.Name {
+			return
+		}
+	}
+	typeMap[typeDef] = append(typeMap[typeDef], field)
+}
+
 func traverseFields(
 	typeMap apitypes.Types,
+	names *typeDefNames,
 	prefix string,
 	index int,
 	t reflect.Type,
@@ -319,6 +531,10 @@ func traverseFields(
 			// For protobuf one_of interface, there's no json tag.
 			// So we need to unwrap it first.
 			if isProtobufOneOf(t.Field(i).Tag) {
+				// skip the one_of if none of its fields is set
+				if !field.IsValid() || field.IsNil() {
+					continue
+				}
 				fieldType = reflect.TypeOf(field.Interface())
 				field = reflect.ValueOf(field.Interface())
 				if fieldType.Kind() == reflect.Ptr {
@@ -338,6 +554,8 @@ func traverseFields(
 		fieldType, field, fieldName = unwrapField(fieldType, field, fieldName)
 
 		var isCollection bool
+		// the remaining elements of a collection, their types are merged with the first one's
+		var otherElems []reflect.Value
 		if fieldType.Kind() == reflect.Array || fieldType.Kind() == reflect.Slice {
 			isCollection = true
 			if field.Len() == 0 {
@@ -348,10 +566,13 @@ func traverseFields(
 					continue
 				}
 			} else {
-				fieldType = fieldType.Elem()
-				field = field.Index(0)
+				collection, elemType, collectionName := field, fieldType.Elem(), fieldName
+				fieldType, field, fieldName = unwrapField(elemType, collection.Index(0), collectionName)
 
-				fieldType, field, fieldName = unwrapField(fieldType, field, fieldName)
+				for j := 1; j < collection.Len(); j++ {
+					_, elem, _ := unwrapField(elemType, collection.Index(j), collectionName)
+					otherElems = append(otherElems, elem)
+				}
 			}
 		}
 
@@ -371,14 +592,12 @@ func traverseFields(
 			}
 
 			if prefix == typeDefPrefix {
-				tag := fmt.Sprintf("Msg%d", index)
-				typeMap[tag] = append(typeMap[tag], apitypes.Type{
+				appendTypeField(typeMap, fmt.Sprintf("Msg%d", index), apitypes.Type{
 					Name: fieldName,
 					Type: ethTyp,
 				})
 			} else {
-				typeDef := sanitizeTypedef(prefix, index)
-				typeMap[typeDef] = append(typeMap[typeDef], apitypes.Type{
+				appendTypeField(typeMap, names.get(prefix), apitypes.Type{
 					Name: fieldName,
 					Type: ethTyp,
 				})
@@ -391,28 +610,31 @@ func traverseFields(
 			var fieldTypedef string
 
 			if isCollection {
-				fieldTypedef = sanitizeTypedef(fieldPrefix, index) + "[]"
+				fieldTypedef = names.get(fieldPrefix) + "[]"
 			} else {
-				fieldTypedef = sanitizeTypedef(fieldPrefix, index)
+				fieldTypedef = names.get(fieldPrefix)
 			}
 
 			if prefix == typeDefPrefix {
-				tag := fmt.Sprintf("Msg%d", index)
-				typeMap[tag] = append(typeMap[tag], apitypes.Type{
+				appendTypeField(typeMap, fmt.Sprintf("Msg%d", index), apitypes.Type{
 					Name: fieldName,
 					Type: fieldTypedef,
 				})
 			} else {
-				typeDef := sanitizeTypedef(prefix, index)
-				typeMap[typeDef] = append(typeMap[typeDef], apitypes.Type{
+				appendTypeField(typeMap, names.get(prefix), apitypes.Type{
 					Name: fieldName,
 					Type: fieldTypedef,
 				})
 			}
 
-			if err := traverseFields(typeMap, fieldPrefix, index, fieldType, field); err != nil {
+			if err := traverseFields(typeMap, names, fieldPrefix, index, fieldType, field); err != nil {
 				return err
 			}
+			for _, elem := range otherElems {
+				if err := traverseFields(typeMap, names, fieldPrefix, index, fieldType, elem); err != nil {
+					return err
+				}
+			}
 			continue
 		}
 	}
@@ -444,7 +666,10 @@ func isProtobufOneOf(tag reflect.StructTag) bool {
 	return tag.Get("protobuf_oneof") != ""
 }
 
-func cleanTypesAndMsgValue(typedData apitypes.Types, primaryType string, msgValue map[string]interface{}) {
+// cleanTypesAndMsgValue aligns the msg value with the types extracted from the msg. The types of
+// the elements in a collection are shared, so missing values of these elements are filled with
+// default values instead of removing the field from the types.
+func cleanTypesAndMsgValue(typedData apitypes.Types, primaryType string, msgValue map[string]interface{}, inCollection bool) {
 	// 1. the proto codec will set *types.Any's type struct name to be "@type". Need remove prefix "@"
 	if msgValue["@type"] != nil {
 		msgValue["type"] = msgValue["@type"]
@@ -452,47 +677,61 @@ func cleanTypesAndMsgValue(typedData apitypes.Types, primaryType string, msgValu
 	}
 
 	// 2. clean msg value.
+	var nilStructFields []string
 	for i, field := range typedData[primaryType] {
 		encName := field.Name
 		encType := field.Type
 		if strings.HasSuffix(encName, "Any") {
+			encName = encName[:len(encName)-3]
+			typedData[primaryType][i].Name = encName
 			if encType[len(encType)-1:] == "]" {
-				anySet := msgValue[encName[:len(encName)-3]].([]interface{})
-				newAnySet := make([]interface{}, len(anySet))
-				for i, item := range anySet {
-					anyValue := item.(map[string]interface{})
-					newValue := make(map[string]interface{})
-					bz, _ := json.Marshal(anyValue)
-					newValue["type"] = anyValue["@type"]
-					newValue["value"] = bz
-					newAnySet[i] = newValue
-				}
-				msgValue[encName[:len(encName)-3]] = newAnySet
-				typedData[primaryType][i].Name = encName[:len(encName)-3]
-				typedData[primaryType][i].Type = "TypeAny[]"
 				delete(typedData, encType[:len(encType)-2])
+				encType = "TypeAny[]"
 			} else {
-				anyValue := msgValue[encName[:len(encName)-3]].(map[string]interface{})
-				newValue := make(map[string]interface{})
-				bz, _ := json.Marshal(anyValue)
-				newValue["type"] = anyValue["@type"]
-				base64Str := base64.StdEncoding.EncodeToString(bz) // base64 encode to keep consistency with js-sdk
-				newValue["value"] = []byte(base64Str)
-				msgValue[encName[:len(encName)-3]] = newValue
-				typedData[primaryType][i].Name = encName[:len(encName)-3]
-				typedData[primaryType][i].Type = "TypeAny"
 				delete(typedData, encType)
+				encType = "TypeAny"
 			}
+			typedData[primaryType][i].Type = encType
 			typedData["TypeAny"] = anyApiTypes
-			continue
 		}
 		encValue := msgValue[encName]
 		switch {
+		case encType == "TypeAny[]":
+			anySet, _ := encValue.([]interface{})
+			newAnySet := make([]interface{}, len(anySet))
+			for j, item := range anySet {
+				anyValue := item.(map[string]interface{})
+				newValue := make(map[string]interface{})
+				bz, _ := json.Marshal(anyValue)
+				newValue["type"] = anyValue["@type"]
+				newValue["value"] = bz
+				newAnySet[j] = newValue
+			}
+			msgValue[encName] = newAnySet
+		case encType == "TypeAny":
+			anyValue, ok := encValue.(map[string]interface{})
+			if !ok {
+				msgValue[encName] = map[string]interface{}{"type": "", "value": []byte{}}
+				continue
+			}
+			newValue := make(map[string]interface{})
+			bz, _ := json.Marshal(anyValue)
+			newValue["type"] = anyValue["@type"]
+			base64Str := base64.StdEncoding.EncodeToString(bz) // base64 encode to keep consistency with js-sdk
+			newValue["value"] = []byte(base64Str)
+			msgValue[encName] = newValue
 		case encType[len(encType)-1:] == "]":
 			if typedData[encType[:len(encType)-2]] != nil {
-				for i := 0; i < len(msgValue[encName].([]interface{})); i++ {
-					cleanTypesAndMsgValue(typedData, encType[:len(encType)-2], msgValue[encName].([]interface{})[i].(map[string]interface{}))
+				items, ok := encValue.([]interface{})
+				if !ok {
+					items = []interface{}{}
+					msgValue[encName] = items
+				}
+				for j := 0; j < len(items); j++ {
+					cleanTypesAndMsgValue(typedData, encType[:len(encType)-2], items[j].(map[string]interface{}), true)
 				}
+			} else if encValue == nil {
+				msgValue[encName] = []interface{}{}
 			} else if encType == "bytes[]" {
 				// convert string to type
 				byteList, ok := encValue.([]interface{})
@@ -506,14 +745,17 @@ func cleanTypesAndMsgValue(typedData apitypes.Types, primaryType string, msgValu
 				msgValue[encName] = newBytesList
 			}
 		case typedData[encType] != nil:
-			subType, ok := msgValue[encName].(map[string]interface{})
-			if !ok {
+			subType, ok := encValue.(map[string]interface{})
+			switch {
+			case ok:
+				cleanTypesAndMsgValue(typedData, encType, subType, inCollection)
+			case inCollection:
+				subType = make(map[string]interface{})
+				msgValue[encName] = subType
+				cleanTypesAndMsgValue(typedData, encType, subType, inCollection)
+			default:
 				// Delete nil struct
-				typedData[primaryType] = append(typedData[primaryType][:i], typedData[primaryType][i+1:]...)
-				delete(typedData, encType)
-				delete(msgValue, encName)
-			} else {
-				cleanTypesAndMsgValue(typedData, encType, subType)
+				nilStructFields = append(nilStructFields, encName)
 			}
 		case encValue == nil:
 			// For nil primitive value, fill in default value
@@ -522,6 +764,8 @@ func cleanTypesAndMsgValue(typedData apitypes.Types, primaryType string, msgValu
 				msgValue[encName] = false
 			case "string":
 				msgValue[encName] = ""
+			case "bytes":
+				msgValue[encName] = []byte{}
 			default:
 				if strings.HasPrefix(encType, "uint") || strings.HasPrefix(encType, "int") {
 					msgValue[encName] = "0"
@@ -534,7 +778,19 @@ func cleanTypesAndMsgValue(typedData apitypes.Types, primaryType string, msgValu
 		}
 	}
 
-	// Delete nil struct
+	// Delete nil struct, it's done after the iteration to not skip any field
+	for _, encName := range nilStructFields {
+		for i, field := range typedData[primaryType] {
+			if field.Name == encName {
+				typedData[primaryType] = append(typedData[primaryType][:i], typedData[primaryType][i+1:]...)
+				delete(typedData, field.Type)
+				break
+			}
+		}
+		delete(msgValue, encName)
+	}
+
+	// Delete the values which are not defined in the types
 	for key := range msgValue {
 		var isExist bool
 		for _, field := range typedData[primaryType] {
@@ -0,0 +1,265 @@
+package tx
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// The functions in this file derive the EIP-712 types and values the way they were derived
+// before the nested Any, one_of and repeated msg fields were supported. The sign bytes of
+// some msgs differ between the two encodings, so both encodings are accepted before the Nagqu
+// upgrade, and only the new encoding is accepted after it.
+
+func legacyWalkFields(typeMap apitypes.Types, in interface{}, index int) (err error) {
+	defer doRecover(&err)
+
+	t := reflect.TypeOf(in)
+	v := reflect.ValueOf(in)
+
+	for {
+		if t.Kind() == reflect.Ptr ||
+			t.Kind() == reflect.Interface {
+			t = t.Elem()
+			v = v.Elem()
+
+			continue
+		}
+
+		break
+	}
+
+	return legacyTraverseFields(typeMap, typeDefPrefix, index, t, v)
+}
+
+func legacyTraverseFields(
+	typeMap apitypes.Types,
+	prefix string,
+	index int,
+	t reflect.Type,
+	v reflect.Value,
+) error {
+	n := t.NumField()
+
+	for i := 0; i < n; i++ {
+		var field reflect.Value
+		if v.IsValid() {
+			field = v.Field(i)
+		}
+
+		fieldType := t.Field(i).Type
+		fieldName := jsonNameFromTag(t.Field(i).Tag)
+		isOmitEmpty := isOmitEmpty(t.Field(i).Tag)
+
+		if fieldName == "" {
+			// For protobuf one_of interface, there's no json tag.
+			// So we need to unwrap it first.
+			if isProtobufOneOf(t.Field(i).Tag) {
+				fieldType = reflect.TypeOf(field.Interface())
+				field = reflect.ValueOf(field.Interface())
+				if fieldType.Kind() == reflect.Ptr {
+					fieldType = fieldType.Elem()
+					if field.IsValid() {
+						field = field.Elem()
+					}
+				}
+				field = field.Field(0)
+				fieldName = jsonNameFromTag(fieldType.Field(0).Tag)
+				fieldType = fieldType.Field(0).Type
+			} else {
+				panic("empty json tag")
+			}
+		}
+
+		fieldType, field, fieldName = unwrapField(fieldType, field, fieldName)
+
+		var isCollection bool
+		if fieldType.Kind() == reflect.Array || fieldType.Kind() == reflect.Slice {
+			isCollection = true
+			if field.Len() == 0 {
+				if !isOmitEmpty {
+					fieldType = reflect.TypeOf("str")
+				} else {
+					// skip empty collections from type mapping if is omitEmpty
+					continue
+				}
+			} else {
+				fieldType = fieldType.Elem()
+				field = field.Index(0)
+
+				fieldType, field, fieldName = unwrapField(fieldType, field, fieldName)
+			}
+		}
+
+		fieldPrefix := fmt.Sprintf("%s.%s", prefix, fieldName)
+
+		ethTyp := typToEth(fieldType)
+		if len(ethTyp) > 0 {
+			if isCollection {
+				if fieldType.Kind() != reflect.Slice && fieldType.Kind() != reflect.Array {
+					ethTyp += "[]"
+					if ethTyp == "uint8[]" {
+						ethTyp = "bytes"
+					}
+				} else if ethTyp == "uint8[]" {
+					ethTyp = "bytes[]"
+				}
+			}
+
+			if prefix == typeDefPrefix {
+				tag := fmt.Sprintf("Msg%d", index)
+				typeMap[tag] = append(typeMap[tag], apitypes.Type{
+					Name: fieldName,
+					Type: ethTyp,
+				})
+			} else {
+				typeDef := sanitizeTypedef(prefix, index)
+				typeMap[typeDef] = append(typeMap[typeDef], apitypes.Type{
+					Name: fieldName,
+					Type: ethTyp,
+				})
+			}
+
+			continue
+		}
+
+		if fieldType.Kind() == reflect.Struct {
+			var fieldTypedef string
+
+			if isCollection {
+				fieldTypedef = sanitizeTypedef(fieldPrefix, index) + "[]"
+			} else {
+				fieldTypedef = sanitizeTypedef(fieldPrefix, index)
+			}
+
+			if prefix == typeDefPrefix {
+				tag := fmt.Sprintf("Msg%d", index)
+				typeMap[tag] = append(typeMap[tag], apitypes.Type{
+					Name: fieldName,
+					Type: fieldTypedef,
+				})
+			} else {
+				typeDef := sanitizeTypedef(prefix, index)
+				typeMap[typeDef] = append(typeMap[typeDef], apitypes.Type{
+					Name: fieldName,
+					Type: fieldTypedef,
+				})
+			}
+
+			if err := legacyTraverseFields(typeMap, fieldPrefix, index, fieldType, field); err != nil {
+				return err
+			}
+			continue
+		}
+	}
+
+	return nil
+}
+
+func legacyCleanTypesAndMsgValue(typedData apitypes.Types, primaryType string, msgValue map[string]interface{}) {
+	// 1. the proto codec will set *types.Any's type struct name to be "@type". Need remove prefix "@"
+	if msgValue["@type"] != nil {
+		msgValue["type"] = msgValue["@type"]
+		delete(msgValue, "@type")
+	}
+
+	// 2. clean msg value.
+	for i, field := range typedData[primaryType] {
+		encName := field.Name
+		encType := field.Type
+		if strings.HasSuffix(encName, "Any") {
+			if encType[len(encType)-1:] == "]" {
+				anySet := msgValue[encName[:len(encName)-3]].([]interface{})
+				newAnySet := make([]interface{}, len(anySet))
+				for i, item := range anySet {
+					anyValue := item.(map[string]interface{})
+					newValue := make(map[string]interface{})
+					bz, _ := json.Marshal(anyValue)
+					newValue["type"] = anyValue["@type"]
+					newValue["value"] = bz
+					newAnySet[i] = newValue
+				}
+				msgValue[encName[:len(encName)-3]] = newAnySet
+				typedData[primaryType][i].Name = encName[:len(encName)-3]
+				typedData[primaryType][i].Type = "TypeAny[]"
+				delete(typedData, encType[:len(encType)-2])
+			} else {
+				anyValue := msgValue[encName[:len(encName)-3]].(map[string]interface{})
+				newValue := make(map[string]interface{})
+				bz, _ := json.Marshal(anyValue)
+				newValue["type"] = anyValue["@type"]
+				base64Str := base64.StdEncoding.EncodeToString(bz) // base64 encode to keep consistency with js-sdk
+				newValue["value"] = []byte(base64Str)
+				msgValue[encName[:len(encName)-3]] = newValue
+				typedData[primaryType][i].Name = encName[:len(encName)-3]
+				typedData[primaryType][i].Type = "TypeAny"
+				delete(typedData, encType)
+			}
+			typedData["TypeAny"] = anyApiTypes
+			continue
+		}
+		encValue := msgValue[encName]
+		switch {
+		case encType[len(encType)-1:] == "]":
+			if typedData[encType[:len(encType)-2]] != nil {
+				for i := 0; i < len(msgValue[encName].([]interface{})); i++ {
+					legacyCleanTypesAndMsgValue(typedData, encType[:len(encType)-2], msgValue[encName].([]interface{})[i].(map[string]interface{}))
+				}
+			} else if encType == "bytes[]" {
+				// convert string to type
+				byteList, ok := encValue.([]interface{})
+				if !ok {
+					continue
+				}
+				newBytesList := make([]interface{}, len(byteList))
+				for j, item := range byteList {
+					newBytesList[j] = []byte(item.(string))
+				}
+				msgValue[encName] = newBytesList
+			}
+		case typedData[encType] != nil:
+			subType, ok := msgValue[encName].(map[string]interface{})
+			if !ok {
+				// Delete nil struct
+				typedData[primaryType] = append(typedData[primaryType][:i], typedData[primaryType][i+1:]...)
+				delete(typedData, encType)
+				delete(msgValue, encName)
+			} else {
+				legacyCleanTypesAndMsgValue(typedData, encType, subType)
+			}
+		case encValue == nil:
+			// For nil primitive value, fill in default value
+			switch encType {
+			case "bool":
+				msgValue[encName] = false
+			case "string":
+				msgValue[encName] = ""
+			default:
+				if strings.HasPrefix(encType, "uint") || strings.HasPrefix(encType, "int") {
+					msgValue[encName] = "0"
+				}
+			}
+		case encType == "bytes":
+			if reflect.TypeOf(encValue).Kind() == reflect.String {
+				msgValue[encName] = []byte(encValue.(string))
+			}
+		}
+	}
+
+	// Delete nil struct
+	for key := range msgValue {
+		var isExist bool
+		for _, field := range typedData[primaryType] {
+			if field.Name == key {
+				isExist = true
+			}
+		}
+		if !isExist {
+			delete(msgValue, key)
+		}
+	}
+}
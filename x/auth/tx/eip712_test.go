@@ -5,19 +5,27 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/stretchr/testify/require"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/group"
 )
@@ -95,6 +103,13 @@ func TestEIP712Handler(t *testing.T) {
 	signBytes, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
 	require.NoError(t, err)
 	require.Equal(t, expectedSignBytes, signBytes)
+
+	t.Log("verify that the sign bytes are the digest of the typed data hashes")
+	typedDataHandler, ok := txConfig.SignModeHandler().(signing.SignModeHandlerWithTypedDataHashes)
+	require.True(t, ok)
+	domainSeparator, messageHash, err := typedDataHandler.GetTypedDataHashes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBytes, crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, messageHash))
 }
 
 func TestEIP712Handler_DefaultMode(t *testing.T) {
@@ -327,3 +342,323 @@ func TestMultiMsgs(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, signBytes)
 }
+
+func TestEIP712Handler_Domain(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &banktypes.MsgSend{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	newDomain := EIP712Domain{
+		Name:    "Greenfield Tx",
+		Version: "2.0.0",
+	}
+	legacyTxConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712})
+	newTxConfig := NewTxConfigWithOptions(marshaler, ConfigOptions{
+		EnabledSignModes: []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712},
+		EIP712Domain:     &newDomain,
+	})
+	upgradeTxConfig := NewTxConfigWithOptions(marshaler, ConfigOptions{
+		EnabledSignModes:    []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712},
+		EIP712Domain:        &newDomain,
+		LegacyEIP712Domains: []EIP712Domain{DefaultEIP712Domain},
+	})
+
+	txBuilder := legacyTxConfig.NewTxBuilder()
+	testMsg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))))
+	require.NoError(t, txBuilder.SetMsgs(testMsg))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "greenfield_9000-1",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        pubkey,
+	}
+
+	legacySignBytes, err := legacyTxConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	newSignBytes, err := newTxConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, legacySignBytes, newSignBytes)
+
+	upgradeSignBytes, err := upgradeTxConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, newSignBytes, upgradeSignBytes)
+
+	t.Log("verify signatures with the legacy domain are only accepted while it is configured and before the upgrade")
+	preUpgradeHandler := upgradeTxConfig.SignModeHandler().(signing.SignModeHandlerWithLegacyEncoding).WithLegacyEncoding()
+	legacySig, err := privKey.Sign(legacySignBytes)
+	require.NoError(t, err)
+	sigData := &signingtypes.SingleSignatureData{
+		SignMode:  signingtypes.SignMode_SIGN_MODE_EIP_712,
+		Signature: legacySig,
+	}
+	err = signing.VerifySignature(pubkey, signingData, sigData, preUpgradeHandler, txBuilder.GetTx(), nil, nil, true)
+	require.NoError(t, err)
+	err = signing.VerifySignature(pubkey, signingData, sigData, upgradeTxConfig.SignModeHandler(), txBuilder.GetTx(), nil, nil, true)
+	require.Error(t, err)
+	err = signing.VerifySignature(pubkey, signingData, sigData, newTxConfig.SignModeHandler().(signing.SignModeHandlerWithLegacyEncoding).WithLegacyEncoding(), txBuilder.GetTx(), nil, nil, true)
+	require.Error(t, err)
+
+	t.Log("verify signatures with the new domain are accepted")
+	newSig, err := privKey.Sign(newSignBytes)
+	require.NoError(t, err)
+	sigData.Signature = newSig
+	err = signing.VerifySignature(pubkey, signingData, sigData, preUpgradeHandler, txBuilder.GetTx(), nil, nil, true)
+	require.NoError(t, err)
+	err = signing.VerifySignature(pubkey, signingData, sigData, upgradeTxConfig.SignModeHandler(), txBuilder.GetTx(), nil, nil, true)
+	require.NoError(t, err)
+
+	t.Log("verify invalid domain")
+	require.Panics(t, func() {
+		NewTxConfigWithOptions(marshaler, ConfigOptions{
+			EnabledSignModes: []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712},
+			EIP712Domain:     &EIP712Domain{Version: "1.0.0"},
+		})
+	})
+}
+
+func TestEIP712NestedMsgs(t *testing.T) {
+	_, pubkey, addr := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	authz.RegisterInterfaces(interfaceRegistry)
+	govtypes.RegisterInterfaces(interfaceRegistry)
+	group.RegisterInterfaces(interfaceRegistry)
+	gashubtypes.RegisterInterfaces(interfaceRegistry)
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712})
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "greenfield_9000-1",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        pubkey,
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1)))
+	msgSend := banktypes.NewMsgSend(addr, addr, coins)
+	msgExec := authz.NewMsgExec(addr, []sdk.Msg{msgSend, msgSend})
+	msgProposal, err := govtypes.NewMsgSubmitProposal([]sdk.Msg{msgSend, &msgExec}, coins, addr.String(), "metadata", "title", "summary")
+	require.NoError(t, err)
+	msgGroupProposal := &group.MsgSubmitProposal{
+		GroupPolicyAddress: addr.String(),
+		Proposers:          []string{addr.String()},
+	}
+	require.NoError(t, msgGroupProposal.SetMsgs([]sdk.Msg{msgSend, &msgExec}))
+	msgSetGasParams := &gashubtypes.MsgSetMsgGasParams{
+		Authority: addr.String(),
+		UpdateSet: []*gashubtypes.MsgGasParams{
+			gashubtypes.NewMsgGasParamsWithFixedGas("/cosmos.bank.v1beta1.MsgSend", 1e5),
+			gashubtypes.NewMsgGasParamsWithDynamicGas(
+				"/cosmos.authz.v1beta1.MsgGrant",
+				&gashubtypes.MsgGasParams_GrantType{GrantType: &gashubtypes.MsgGasParams_DynamicGasParams{FixedGas: 1e5, GasPerItem: 1e4}},
+			),
+			{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgMultiSend"},
+		},
+	}
+
+	testCases := []struct {
+		name string
+		msgs []sdk.Msg
+	}{
+		{"authz exec with several msgs", []sdk.Msg{&msgExec}},
+		{"gov proposal with nested exec", []sdk.Msg{msgProposal}},
+		{"group proposal with nested exec", []sdk.Msg{msgGroupProposal}},
+		{"repeated msgs with different one_of fields", []sdk.Msg{msgSetGasParams}},
+		{"all msgs", []sdk.Msg{msgSend, &msgExec, msgProposal, msgGroupProposal, msgSetGasParams}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetFeeAmount(coins)
+			txBuilder.SetGasLimit(20000)
+
+			signBytes, err := txConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+			require.NoError(t, err)
+
+			// the sign bytes must be deterministic and match go-ethereum's typed data hashing
+			chainID, err := sdk.ParseChainID(signingData.ChainID)
+			require.NoError(t, err)
+			msgTypes, signDoc, err := GetMsgTypes(signingData, txBuilder.GetTx(), chainID)
+			require.NoError(t, err)
+			typedData, err := WrapTxToTypedData(chainID.Uint64(), signDoc, msgTypes)
+			require.NoError(t, err)
+			ethSignBytes, _, err := apitypes.TypedDataAndHash(typedData)
+			require.NoError(t, err)
+			require.Equal(t, signBytes, ethSignBytes)
+		})
+	}
+}
+
+func TestEIP712LegacyEncoding(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	gashubtypes.RegisterInterfaces(interfaceRegistry)
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712})
+	handler := txConfig.SignModeHandler()
+	legacyHandler := handler.(signing.SignModeHandlerWithLegacyEncoding).WithLegacyEncoding()
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "greenfield_9000-1",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        pubkey,
+	}
+	sign := func(signBytes []byte) *signingtypes.SingleSignatureData {
+		sig, err := privKey.Sign(signBytes)
+		require.NoError(t, err)
+		return &signingtypes.SingleSignatureData{
+			SignMode:  signingtypes.SignMode_SIGN_MODE_EIP_712,
+			Signature: sig,
+		}
+	}
+
+	t.Log("verify both encodings are accepted before the upgrade, and only the new encoding after the upgrade")
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))))))
+	txBuilder.SetGasLimit(20000)
+	legacySignBytes, err := legacyHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	newSignBytes, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	err = signing.VerifySignature(pubkey, signingData, sign(legacySignBytes), legacyHandler, txBuilder.GetTx(), nil, nil, true)
+	require.NoError(t, err)
+	err = signing.VerifySignature(pubkey, signingData, sign(newSignBytes), legacyHandler, txBuilder.GetTx(), nil, nil, true)
+	require.NoError(t, err)
+	err = signing.VerifySignature(pubkey, signingData, sign(newSignBytes), handler, txBuilder.GetTx(), nil, nil, true)
+	require.NoError(t, err)
+	altSignBytes, err := legacyHandler.(signing.SignModeHandlerWithAltSignBytes).GetAltSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Contains(t, altSignBytes, newSignBytes)
+	altSignBytes, err = handler.(signing.SignModeHandlerWithAltSignBytes).GetAltSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Empty(t, altSignBytes)
+
+	t.Log("verify the repeated msg fields with different one_of fields are only supported by the new encoding")
+	msgSetGasParams := &gashubtypes.MsgSetMsgGasParams{
+		Authority: addr.String(),
+		UpdateSet: []*gashubtypes.MsgGasParams{
+			gashubtypes.NewMsgGasParamsWithFixedGas("/cosmos.bank.v1beta1.MsgSend", 1e5),
+			gashubtypes.NewMsgGasParamsWithDynamicGas(
+				"/cosmos.authz.v1beta1.MsgGrant",
+				&gashubtypes.MsgGasParams_GrantType{GrantType: &gashubtypes.MsgGasParams_DynamicGasParams{FixedGas: 1e5, GasPerItem: 1e4}},
+			),
+		},
+	}
+	require.NoError(t, txBuilder.SetMsgs(msgSetGasParams))
+	_, err = legacyHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+	signBytes, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	err = signing.VerifySignature(pubkey, signingData, sign(signBytes), handler, txBuilder.GetTx(), nil, nil, true)
+	require.NoError(t, err)
+	err = signing.VerifySignature(pubkey, signingData, sign(signBytes), legacyHandler, txBuilder.GetTx(), nil, nil, true)
+	require.Error(t, err)
+}
+
+func TestTypeDefNames(t *testing.T) {
+	names := newTypeDefNames(1)
+	require.Equal(t, "TypeMsg1ABC", names.get("_.a_b.c"))
+	require.Equal(t, "TypeMsg1ABC2", names.get("_.a.b_c"))
+	require.Equal(t, "TypeMsg1ABC", names.get("_.a_b.c"))
+	require.Equal(t, "TypeMsg1AB", names.get("_.a.b"))
+}
+
+func TestEIP712Multisig(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &banktypes.MsgSend{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+	txConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712})
+
+	privKeys := make([]cryptotypes.PrivKey, 3)
+	pubKeys := make([]cryptotypes.PubKey, 3)
+	for i := range privKeys {
+		privKeys[i], pubKeys[i], _ = testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	}
+	multiPK := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	multiAddr := sdk.AccAddress(multiPK.Address())
+
+	txBuilder := txConfig.NewTxBuilder()
+	testMsg := banktypes.NewMsgSend(multiAddr, multiAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))))
+	require.NoError(t, txBuilder.SetMsgs(testMsg))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	signingData := signing.SignerData{
+		Address:       multiAddr.String(),
+		ChainID:       "greenfield_9000-1",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        multiPK,
+	}
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	signAs := func(i int) signingtypes.SignatureV2 {
+		sig, err := privKeys[i].Sign(signBytes)
+		require.NoError(t, err)
+		return signingtypes.SignatureV2{
+			PubKey: pubKeys[i],
+			Data: &signingtypes.SingleSignatureData{
+				SignMode:  signingtypes.SignMode_SIGN_MODE_EIP_712,
+				Signature: sig,
+			},
+			Sequence: signingData.Sequence,
+		}
+	}
+
+	t.Log("verify the multisig is rejected below the threshold")
+	multisigSig := multisig.NewMultisig(len(pubKeys))
+	require.NoError(t, multisig.AddSignatureV2(multisigSig, signAs(0), pubKeys))
+	err = signing.VerifySignature(multiPK, signingData, multisigSig, txConfig.SignModeHandler(), txBuilder.GetTx(), nil, nil, true)
+	require.Error(t, err)
+
+	t.Log("verify the multisig is accepted once the threshold is reached")
+	require.NoError(t, multisig.AddSignatureV2(multisigSig, signAs(2), pubKeys))
+	err = signing.VerifySignature(multiPK, signingData, multisigSig, txConfig.SignModeHandler(), txBuilder.GetTx(), nil, nil, true)
+	require.NoError(t, err)
+
+	t.Log("verify the multisig is rejected before the Nagqu upgrade")
+	err = signing.VerifySignature(multiPK, signingData, multisigSig, txConfig.SignModeHandler(), txBuilder.GetTx(), nil, nil, false)
+	require.EqualError(t, err, "multi signature is not allowed")
+
+	t.Log("verify the multisig is rejected for a different sign doc")
+	signingData.Sequence++
+	err = signing.VerifySignature(multiPK, signingData, multisigSig, txConfig.SignModeHandler(), txBuilder.GetTx(), nil, nil, true)
+	require.Error(t, err)
+
+	t.Log("verify the multisig is rejected for a single key")
+	signingData.Sequence--
+	err = signing.VerifySignature(pubKeys[0], signingData, multisigSig, txConfig.SignModeHandler(), txBuilder.GetTx(), nil, nil, true)
+	require.Error(t, err)
+
+	t.Log("verify the multisig is rejected for a sign mode without multisig encoding")
+	directSig := signAs(1)
+	directSig.Data.(*signingtypes.SingleSignatureData).SignMode = signingtypes.SignMode_SIGN_MODE_DIRECT
+	directMultisigSig := multisig.NewMultisig(len(pubKeys))
+	require.NoError(t, multisig.AddSignatureV2(directMultisigSig, signAs(0), pubKeys))
+	require.NoError(t, multisig.AddSignatureV2(directMultisigSig, directSig, pubKeys))
+	err = signing.VerifySignature(multiPK, signingData, directMultisigSig, txConfig.SignModeHandler(), txBuilder.GetTx(), nil, nil, true)
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+
+	t.Log("verify the nested multisig of EIP-712 signatures is rejected")
+	nestedPK := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{multiPK, pubKeys[0]})
+	nestedMultisigSig := multisig.NewMultisig(2)
+	require.NoError(t, multisig.AddSignatureV2(nestedMultisigSig, signingtypes.SignatureV2{
+		PubKey:   multiPK,
+		Data:     multisigSig,
+		Sequence: signingData.Sequence,
+	}, nestedPK.GetPubKeys()))
+	signingData.PubKey = nestedPK
+	err = signing.VerifySignature(nestedPK, signingData, nestedMultisigSig, txConfig.SignModeHandler(), txBuilder.GetTx(), nil, nil, true)
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+}
@@ -18,12 +18,23 @@ var DefaultSignModes = []signingtypes.SignMode{
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX and SIGN_MODE_LEGACY_AMINO_JSON.
-func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
+// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX, SIGN_MODE_LEGACY_AMINO_JSON and SIGN_MODE_EIP_712.
+func makeSignModeHandler(options ConfigOptions) signing.SignModeHandler {
+	modes := options.EnabledSignModes
 	if len(modes) < 1 {
 		panic(fmt.Errorf("no sign modes enabled"))
 	}
 
+	eip712Domain := DefaultEIP712Domain
+	if options.EIP712Domain != nil {
+		eip712Domain = *options.EIP712Domain
+	}
+	for _, domain := range append([]EIP712Domain{eip712Domain}, options.LegacyEIP712Domains...) {
+		if err := domain.ValidateBasic(); err != nil {
+			panic(err)
+		}
+	}
+
 	handlers := make([]signing.SignModeHandler, len(modes))
 
 	for i, mode := range modes {
@@ -35,7 +46,7 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 		case signingtypes.SignMode_SIGN_MODE_DIRECT_AUX:
 			handlers[i] = signModeDirectAuxHandler{}
 		case signingtypes.SignMode_SIGN_MODE_EIP_712:
-			handlers[i] = signModeEip712Handler{}
+			handlers[i] = newSignModeEip712Handler(eip712Domain, options.LegacyEIP712Domains)
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
@@ -64,6 +64,11 @@ func (msg MsgSend) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{fromAddress}
 }
 
+// GetSendAmount returns the coins sent by the msg, which are checked by the keyring policies.
+func (msg MsgSend) GetSendAmount() sdk.Coins {
+	return msg.Amount
+}
+
 // NewMsgMultiSend - construct arbitrary multi-in, multi-out send msg.
 func NewMsgMultiSend(in []Input, out []Output) *MsgMultiSend {
 	return &MsgMultiSend{Inputs: in, Outputs: out}
@@ -111,6 +116,16 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+// GetSendAmount returns the coins sent by the msg, which are checked by the keyring policies.
+func (msg MsgMultiSend) GetSendAmount() sdk.Coins {
+	var amount sdk.Coins
+	for _, in := range msg.Inputs {
+		amount = amount.Add(in.Coins...)
+	}
+
+	return amount
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromHexUnsafe(in.Address); err != nil {
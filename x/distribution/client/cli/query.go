@@ -32,6 +32,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryDelegatorAutoCompound(),
 	)
 
 	return distQueryCmd
@@ -330,6 +331,49 @@ $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1ggh
 	return cmd
 }
 
+// GetCmdQueryDelegatorAutoCompound implements the query delegator auto compound command.
+func GetCmdQueryDelegatorAutoCompound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether the auto compounding of the rewards of a delegator is enabled",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the auto compounding of the rewards of a delegator is enabled.
+
+Example:
+$ %s query distribution auto-compound 0x91D7deA99716Cbb247E81F1cfB692009164a967E
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorAutoCompound(
+				cmd.Context(),
+				&types.QueryDelegatorAutoCompoundRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryCommunityPool returns the command for fetching community pool info.
 func GetCmdQueryCommunityPool() *cobra.Command {
 	cmd := &cobra.Command{
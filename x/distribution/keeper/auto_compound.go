@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// HasAutoCompoundDelegator returns whether the auto compounding of the rewards of the delegator is enabled.
+func (k Keeper) HasAutoCompoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// SetAutoCompoundDelegator enables the auto compounding of the rewards of the delegator.
+func (k Keeper) SetAutoCompoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundDelegatorKey(delAddr), []byte{})
+}
+
+// DeleteAutoCompoundDelegator disables the auto compounding of the rewards of the delegator.
+func (k Keeper) DeleteAutoCompoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// IterateAutoCompoundDelegators iterates over the delegators with the auto compounding enabled.
+func (k Keeper) IterateAutoCompoundDelegators(ctx sdk.Context, handler func(delAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundDelegatorPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetAutoCompoundDelegatorAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// AutoCompoundRewards delegates the rewards of the delegators with the auto compounding enabled back to their
+// validators. The delegations are processed in the order of the delegator and the validator addresses from the
+// last processed delegation, until the reward withdrawals and the delegations reach the max auto compound
+// delegators per block. The compounding of a delegation which is started below the limit is always completed.
+func (k Keeper) AutoCompoundRewards(ctx sdk.Context) {
+	maxOperations := k.GetMaxAutoCompoundDelegators(ctx)
+	if maxOperations == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	start := types.AutoCompoundDelegatorPrefix
+	var cursorDelAddr, cursorValAddr sdk.AccAddress
+	cursor := store.Get(types.AutoCompoundCursorKey)
+	if cursor != nil {
+		cursorDelAddr, cursorValAddr = types.GetAutoCompoundCursorAddresses(cursor)
+		start = types.GetAutoCompoundDelegatorKey(cursorDelAddr)
+		// the delegations of the delegator are all compounded if the cursor has no validator
+		if cursorValAddr.Empty() {
+			start = append(start, 0x00)
+		}
+	}
+
+	// the delegators are collected before compounding, as the compounding writes to the store being iterated,
+	// each delegator takes at least one operation unless it has nothing to compound
+	delegators := make([]sdk.AccAddress, 0, maxOperations)
+	exhausted := true
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoCompoundDelegatorPrefix))
+	for ; iter.Valid(); iter.Next() {
+		if len(delegators) == int(maxOperations) {
+			exhausted = false
+			break
+		}
+		delegators = append(delegators, types.GetAutoCompoundDelegatorAddress(iter.Key()))
+	}
+	iter.Close()
+
+	threshold := k.GetAutoCompoundThreshold(ctx)
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	operations := uint32(0)
+	for _, delAddr := range delegators {
+		for _, valAddr := range k.autoCompoundValidators(ctx, delAddr) {
+			// the delegations up to the cursor are compounded in the previous blocks
+			if delAddr.Equals(cursorDelAddr) && !cursorValAddr.Empty() &&
+				bytes.Compare(address.MustLengthPrefix(valAddr), address.MustLengthPrefix(cursorValAddr)) <= 0 {
+				continue
+			}
+			if operations >= maxOperations {
+				store.Set(types.AutoCompoundCursorKey, cursor)
+				return
+			}
+
+			operations += k.autoCompoundDelegationRewards(ctx, delAddr, valAddr, bondDenom, threshold)
+			cursor = types.GetAutoCompoundCursor(delAddr, valAddr)
+		}
+		cursor = types.GetAutoCompoundCursor(delAddr, nil)
+	}
+
+	// the next block starts over from the first delegator once all the delegators are processed
+	if exhausted {
+		store.Delete(types.AutoCompoundCursorKey)
+	} else {
+		store.Set(types.AutoCompoundCursorKey, cursor)
+	}
+}
+
+// autoCompoundValidators returns the validators of the delegations of the delegator in the order of the delegation
+// keys, none is returned if the rewards of the delegator are withdrawn to another address, as they are not owned by
+// the delegator.
+func (k Keeper) autoCompoundValidators(ctx sdk.Context, delAddr sdk.AccAddress) []sdk.AccAddress {
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return nil
+	}
+
+	var valAddrs []sdk.AccAddress
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		valAddrs = append(valAddrs, del.GetValidatorAddr())
+		return false
+	})
+	return valAddrs
+}
+
+// autoCompoundDelegationRewards delegates the bond denom rewards of the delegation back to the validator if they
+// reach the threshold, and returns the number of the reward withdrawals and the delegations it took. The failed
+// compounding of a delegation is discarded without affecting the others.
+func (k Keeper) autoCompoundDelegationRewards(ctx sdk.Context, delAddr, valAddr sdk.AccAddress, bondDenom string, threshold math.Int) uint32 {
+	cacheCtx, write := ctx.CacheContext()
+	rewards, err := k.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+	if err != nil {
+		k.Logger(ctx).Error("failed to withdraw the auto compounded rewards", "delegator", delAddr, "validator", valAddr, "err", err)
+		return 1
+	}
+
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() || amount.LT(threshold) {
+		return 1
+	}
+
+	if _, err := k.stakingKeeper.DelegateCoins(cacheCtx, delAddr, valAddr, amount); err != nil {
+		k.Logger(ctx).Error("failed to delegate the auto compounded rewards", "delegator", delAddr, "validator", valAddr, "err", err)
+		return 2
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+	return 2
+}
@@ -286,6 +286,24 @@ func (k Querier) DelegatorWithdrawAddress(c context.Context, req *types.QueryDel
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// DelegatorAutoCompound queries whether the auto compounding of the rewards of a delegator is enabled
+func (k Querier) DelegatorAutoCompound(c context.Context, req *types.QueryDelegatorAutoCompoundRequest) (*types.QueryDelegatorAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromHexUnsafe(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryDelegatorAutoCompoundResponse{Enabled: k.HasAutoCompoundDelegator(ctx, delAdr)}, nil
+}
+
 // CommunityPool queries the community pool coins
 func (k Querier) CommunityPool(c context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
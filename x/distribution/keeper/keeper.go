@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cometbft/cometbft/libs/log"
 
@@ -10,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Keeper of the distribution store
@@ -102,6 +104,53 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr, valAddr sdk.
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the tokenized delegation of the record, and sends
+// all the balances of the record account to the owner of the record.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress, recordID uint64) (sdk.Coins, error) {
+	record, found := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if !found {
+		return nil, stakingtypes.ErrTokenizeShareRecordNotFound
+	}
+
+	owner, err := sdk.AccAddressFromHexUnsafe(record.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if !owner.Equals(ownerAddr) {
+		return nil, types.ErrNotTokenizeShareRecordOwner
+	}
+
+	recordAddr := record.GetModuleAddress()
+	valAddr, err := sdk.AccAddressFromHexUnsafe(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	// the rewards are withdrawn to the record account, which also holds the rewards withdrawn when the
+	// delegator shares of the record were modified
+	if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+		return nil, err
+	}
+
+	rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyOwner, record.Owner),
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(record.Id, 10)),
+		),
+	)
+
+	return rewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.AccAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
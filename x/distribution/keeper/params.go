@@ -45,3 +45,18 @@ func (k Keeper) GetCommunityTax(ctx sdk.Context) math.LegacyDec {
 func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	return k.GetParams(ctx).WithdrawAddrEnabled
 }
+
+// GetAutoCompoundThreshold returns the minimum amount of bond denom rewards of a delegation to be compounded.
+func (k Keeper) GetAutoCompoundThreshold(ctx sdk.Context) math.Int {
+	threshold := k.GetParams(ctx).AutoCompoundThreshold
+	if threshold.IsNil() {
+		return math.ZeroInt()
+	}
+	return threshold
+}
+
+// GetMaxAutoCompoundDelegators returns the maximum number of the reward withdrawals and the delegations of the
+// auto compounding in a block.
+func (k Keeper) GetMaxAutoCompoundDelegators(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxAutoCompoundDelegators
+}
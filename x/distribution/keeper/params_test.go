@@ -42,6 +42,8 @@ func TestParams(t *testing.T) {
 	// default params
 	communityTax := sdk.NewDecWithPrec(2, 2) // 2%
 	withdrawAddrEnabled := true
+	autoCompoundThreshold := types.DefaultAutoCompoundThreshold
+	maxAutoCompoundDelegators := types.DefaultMaxAutoCompoundDelegators
 
 	testCases := []struct {
 		name      string
@@ -52,10 +54,12 @@ func TestParams(t *testing.T) {
 		{
 			name: "community tax > 1",
 			input: types.Params{
-				CommunityTax:        sdk.NewDecWithPrec(2, 0),
-				BaseProposerReward:  sdk.ZeroDec(),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:              sdk.NewDecWithPrec(2, 0),
+				BaseProposerReward:        sdk.ZeroDec(),
+				BonusProposerReward:       sdk.ZeroDec(),
+				WithdrawAddrEnabled:       withdrawAddrEnabled,
+				AutoCompoundThreshold:     autoCompoundThreshold,
+				MaxAutoCompoundDelegators: maxAutoCompoundDelegators,
 			},
 			expErr:    true,
 			expErrMsg: "community tax should be non-negative and less than one",
@@ -63,10 +67,12 @@ func TestParams(t *testing.T) {
 		{
 			name: "negative community tax",
 			input: types.Params{
-				CommunityTax:        sdk.NewDecWithPrec(-2, 1),
-				BaseProposerReward:  sdk.ZeroDec(),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:              sdk.NewDecWithPrec(-2, 1),
+				BaseProposerReward:        sdk.ZeroDec(),
+				BonusProposerReward:       sdk.ZeroDec(),
+				WithdrawAddrEnabled:       withdrawAddrEnabled,
+				AutoCompoundThreshold:     autoCompoundThreshold,
+				MaxAutoCompoundDelegators: maxAutoCompoundDelegators,
 			},
 			expErr:    true,
 			expErrMsg: "community tax should be non-negative and less than one",
@@ -74,10 +80,12 @@ func TestParams(t *testing.T) {
 		{
 			name: "base proposer reward > 1",
 			input: types.Params{
-				CommunityTax:        communityTax,
-				BaseProposerReward:  sdk.NewDecWithPrec(1, 2),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:              communityTax,
+				BaseProposerReward:        sdk.NewDecWithPrec(1, 2),
+				BonusProposerReward:       sdk.ZeroDec(),
+				WithdrawAddrEnabled:       withdrawAddrEnabled,
+				AutoCompoundThreshold:     autoCompoundThreshold,
+				MaxAutoCompoundDelegators: maxAutoCompoundDelegators,
 			},
 			expErr:    false,
 			expErrMsg: "base proposer rewards should not be taken into account",
@@ -85,10 +93,12 @@ func TestParams(t *testing.T) {
 		{
 			name: "bonus proposer reward > 1",
 			input: types.Params{
-				CommunityTax:        communityTax,
-				BaseProposerReward:  sdk.NewDecWithPrec(1, 2),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:              communityTax,
+				BaseProposerReward:        sdk.NewDecWithPrec(1, 2),
+				BonusProposerReward:       sdk.ZeroDec(),
+				WithdrawAddrEnabled:       withdrawAddrEnabled,
+				AutoCompoundThreshold:     autoCompoundThreshold,
+				MaxAutoCompoundDelegators: maxAutoCompoundDelegators,
 			},
 			expErr:    false,
 			expErrMsg: "bonus proposer rewards should not be taken into account",
@@ -96,10 +106,12 @@ func TestParams(t *testing.T) {
 		{
 			name: "all good",
 			input: types.Params{
-				CommunityTax:        communityTax,
-				BaseProposerReward:  sdk.ZeroDec(),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:              communityTax,
+				BaseProposerReward:        sdk.ZeroDec(),
+				BonusProposerReward:       sdk.ZeroDec(),
+				WithdrawAddrEnabled:       withdrawAddrEnabled,
+				AutoCompoundThreshold:     autoCompoundThreshold,
+				MaxAutoCompoundDelegators: maxAutoCompoundDelegators,
 			},
 			expErr: false,
 		},
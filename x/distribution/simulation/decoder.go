@@ -63,6 +63,14 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundDelegatorPrefix):
+			return fmt.Sprintf("%v\n%v", types.GetAutoCompoundDelegatorAddress(kvA.Key), types.GetAutoCompoundDelegatorAddress(kvB.Key))
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundCursorKey):
+			delAddrA, valAddrA := types.GetAutoCompoundCursorAddresses(kvA.Value)
+			delAddrB, valAddrB := types.GetAutoCompoundCursorAddresses(kvB.Value)
+			return fmt.Sprintf("%v %v\n%v %v", delAddrA, valAddrA, delAddrB, valAddrB)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
@@ -46,8 +46,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:              communityTax,
+			WithdrawAddrEnabled:       withdrawEnabled,
+			AutoCompoundThreshold:     types.DefaultAutoCompoundThreshold,
+			MaxAutoCompoundDelegators: types.DefaultMaxAutoCompoundDelegators,
 		},
 	}
 
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -37,6 +38,11 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_threshold is the minimum amount of bond denom rewards of a delegation to be compounded.
+	AutoCompoundThreshold cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=auto_compound_threshold,json=autoCompoundThreshold,proto3,customtype=cosmossdk.io/math.Int" json:"auto_compound_threshold"`
+	// max_auto_compound_delegators is the maximum number of the reward withdrawals and the delegations of the auto
+	// compounding in a block, the compounding is disabled if it's zero.
+	MaxAutoCompoundDelegators uint32 `protobuf:"varint,6,opt,name=max_auto_compound_delegators,json=maxAutoCompoundDelegators,proto3" json:"max_auto_compound_delegators,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -78,6 +84,13 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxAutoCompoundDelegators() uint32 {
+	if m != nil {
+		return m.MaxAutoCompoundDelegators
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -638,69 +651,75 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x34, 0x8e, 0x93, 0x4c, 0x69, 0x42, 0x27, 0x4e, 0xea, 0xb8, 0x95, 0x6d, 0xad, 0x44,
-	0x49, 0x43, 0x63, 0x93, 0x22, 0x24, 0x64, 0x21, 0xa4, 0xd8, 0x0e, 0x82, 0x53, 0xa3, 0x0d, 0x02,
-	0xc4, 0x65, 0x35, 0xde, 0x9d, 0xd8, 0xa3, 0xee, 0xce, 0x2c, 0x33, 0x63, 0x27, 0x3d, 0x70, 0xaf,
-	0x7a, 0x00, 0x8e, 0x15, 0xa7, 0x08, 0x2e, 0x15, 0xa7, 0x1c, 0xf2, 0x21, 0x2a, 0x4e, 0x55, 0x0f,
-	0x80, 0x2a, 0x14, 0x50, 0x72, 0x08, 0xe2, 0x53, 0xa0, 0xd9, 0x19, 0xaf, 0x9d, 0x10, 0xa2, 0x4a,
-	0xad, 0xd5, 0x4b, 0xe2, 0x79, 0x6f, 0xf7, 0xfd, 0xfe, 0xcc, 0x9b, 0x37, 0x0b, 0xab, 0x3e, 0x97,
-	0x11, 0x97, 0xb5, 0x80, 0x4a, 0x25, 0x68, 0xbb, 0xa7, 0x28, 0x67, 0xb5, 0xfe, 0x5a, 0x9b, 0x28,
-	0xbc, 0x76, 0x2a, 0x58, 0x8d, 0x05, 0x57, 0x1c, 0x5d, 0x37, 0xcf, 0x57, 0x4f, 0xa5, 0xec, 0xf3,
-	0xc5, 0x7c, 0x87, 0x77, 0x78, 0xf2, 0x5c, 0x4d, 0xff, 0x32, 0xaf, 0x14, 0x4b, 0x16, 0xa2, 0x8d,
-	0x25, 0x49, 0x4b, 0xfb, 0x9c, 0xda, 0x92, 0xc5, 0x25, 0x93, 0xf7, 0xcc, 0x8b, 0xb6, 0xbe, 0x49,
-	0x5d, 0xc5, 0x11, 0x65, 0xbc, 0x96, 0xfc, 0x35, 0x21, 0x67, 0x7f, 0x02, 0xe6, 0x36, 0xb1, 0xc0,
-	0x91, 0x44, 0x18, 0x5e, 0xf1, 0x79, 0x14, 0xf5, 0x18, 0x55, 0xf7, 0x3d, 0x85, 0x77, 0x0b, 0xa0,
-	0x02, 0x96, 0x67, 0x1a, 0x1f, 0x3e, 0x39, 0x2c, 0x67, 0x9e, 0x1f, 0x96, 0x6f, 0x76, 0xa8, 0xea,
-	0xf6, 0xda, 0x55, 0x9f, 0x47, 0xb6, 0xaa, 0xfd, 0xb7, 0x2a, 0x83, 0x7b, 0x35, 0x75, 0x3f, 0x26,
-	0xb2, 0xda, 0x22, 0xfe, 0xb3, 0x83, 0x55, 0x68, 0x41, 0x5b, 0xc4, 0x77, 0xdf, 0x48, 0x4b, 0x7e,
-	0x86, 0x77, 0x51, 0x0c, 0xf3, 0x9a, 0xb6, 0xe6, 0x16, 0x73, 0x49, 0x84, 0x27, 0xc8, 0x0e, 0x16,
-	0x41, 0xe1, 0x52, 0x82, 0xf4, 0xd1, 0xcb, 0x20, 0x15, 0x80, 0x8b, 0x74, 0xed, 0x4d, 0x5b, 0xda,
-	0x4d, 0x2a, 0x23, 0x01, 0x17, 0xda, 0x9c, 0xf5, 0xe4, 0x7f, 0x20, 0x27, 0x5e, 0x09, 0xe4, 0x7c,
-	0x52, 0xfc, 0x0c, 0xe6, 0x1d, 0xb8, 0xb0, 0x43, 0x55, 0x37, 0x10, 0x78, 0xc7, 0xc3, 0x41, 0x20,
-	0x3c, 0xc2, 0x70, 0x3b, 0x24, 0x41, 0x21, 0x5b, 0x01, 0xcb, 0xd3, 0xee, 0xfc, 0x20, 0xb9, 0x1e,
-	0x04, 0x62, 0xc3, 0xa4, 0xea, 0xb7, 0x1e, 0xed, 0x95, 0x33, 0x0f, 0x4f, 0xf6, 0x57, 0x2a, 0x23,
-	0xb8, 0xbb, 0xa7, 0xfb, 0xc8, 0xec, 0x93, 0xf3, 0x2b, 0x80, 0xc5, 0xcf, 0x71, 0x48, 0x03, 0xac,
-	0xb8, 0xf8, 0x84, 0x4a, 0xc5, 0x05, 0xf5, 0x71, 0x68, 0xc0, 0x25, 0xfa, 0x16, 0xc0, 0x6b, 0x7e,
-	0x2f, 0xea, 0x85, 0x58, 0xd1, 0x3e, 0xb1, 0x72, 0x3d, 0x81, 0x15, 0xe5, 0x05, 0x50, 0x99, 0x58,
-	0xbe, 0x7c, 0xe7, 0x86, 0xed, 0xd2, 0xaa, 0xf6, 0x6b, 0xd0, 0x6d, 0x5a, 0x50, 0x93, 0x53, 0xd6,
-	0xf8, 0x40, 0x5b, 0xf2, 0xf3, 0x9f, 0xe5, 0x77, 0x5e, 0xcc, 0x12, 0xfd, 0x8e, 0x7c, 0x7c, 0xb2,
-	0xbf, 0x02, 0xdc, 0x85, 0x21, 0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x36, 0x9c, 0x13, 0x64, 0x9b,
-	0x08, 0xc2, 0x7c, 0xe2, 0xf9, 0xbc, 0xc7, 0x54, 0xb2, 0xdf, 0x57, 0xdc, 0xd9, 0x34, 0xdc, 0xd4,
-	0x51, 0xe7, 0x27, 0x00, 0xaf, 0xa5, 0xc2, 0x9a, 0x3d, 0x21, 0x08, 0x53, 0x03, 0x55, 0x31, 0x9c,
-	0x32, 0x4a, 0xe4, 0x98, 0x45, 0x0c, 0x60, 0xd0, 0x22, 0xcc, 0xc5, 0x44, 0x50, 0x6e, 0xba, 0x33,
-	0xeb, 0xda, 0x95, 0xf3, 0x08, 0xc0, 0x52, 0xca, 0x72, 0xdd, 0xb7, 0x9a, 0x49, 0xd0, 0xe4, 0x51,
-	0x44, 0xa5, 0xa4, 0x9c, 0xa1, 0x3e, 0x84, 0x7e, 0xba, 0x1a, 0x33, 0xdf, 0x11, 0x24, 0xe7, 0x3b,
-	0x00, 0xaf, 0xa7, 0xd4, 0xee, 0xf6, 0x94, 0x54, 0x98, 0x05, 0x94, 0x75, 0x5e, 0x9b, 0x89, 0xce,
-	0x0f, 0x00, 0xce, 0xa7, 0x8c, 0xb6, 0x42, 0x2c, 0xbb, 0x1b, 0x7d, 0xc2, 0x14, 0xba, 0x05, 0xdf,
-	0xec, 0x0f, 0xc2, 0x9e, 0xb5, 0x19, 0x24, 0x36, 0xcf, 0xa5, 0xf1, 0xcd, 0x24, 0x8c, 0xbe, 0x84,
-	0xd3, 0xdb, 0x02, 0xfb, 0xfa, 0x04, 0xd8, 0x39, 0xf1, 0x72, 0x13, 0x29, 0xad, 0xa6, 0xed, 0xca,
-	0x9f, 0x43, 0x4e, 0xa2, 0xaf, 0xe1, 0xe2, 0x90, 0x9d, 0xd4, 0x09, 0x8f, 0x24, 0x19, 0x6b, 0xdb,
-	0xbb, 0xd5, 0x0b, 0xc6, 0x76, 0xf5, 0x9c, 0x92, 0x8d, 0x19, 0x4d, 0xd9, 0x78, 0x93, 0xef, 0x9f,
-	0x03, 0x59, 0xcf, 0xea, 0xf3, 0xef, 0x3c, 0x00, 0x70, 0xea, 0x63, 0x42, 0x36, 0x39, 0x0f, 0xd1,
-	0x37, 0x70, 0x76, 0x38, 0x8e, 0x63, 0xce, 0xc3, 0x31, 0xef, 0xd9, 0x70, 0xf8, 0x6b, 0x78, 0xe7,
-	0xe1, 0x25, 0x58, 0x6c, 0x8e, 0x46, 0xb6, 0x62, 0xc2, 0x02, 0x33, 0xe9, 0x70, 0x88, 0xf2, 0x70,
-	0x52, 0x51, 0x15, 0x12, 0x73, 0x49, 0xb8, 0x66, 0x81, 0x2a, 0xf0, 0x72, 0x40, 0xa4, 0x2f, 0x68,
-	0x3c, 0xdc, 0x2e, 0x77, 0x34, 0x84, 0x6e, 0xc0, 0x19, 0x41, 0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x99,
-	0xc1, 0xee, 0x30, 0x80, 0xba, 0x30, 0x87, 0xa3, 0x64, 0x42, 0x64, 0x13, 0xad, 0x4b, 0xe7, 0x6a,
-	0x4d, 0x84, 0xbe, 0x6f, 0x85, 0x2e, 0xbf, 0x80, 0xd0, 0x11, 0x95, 0xb6, 0x7e, 0xfd, 0xf6, 0x83,
-	0xbd, 0x72, 0x46, 0x7b, 0xfe, 0xf7, 0x5e, 0x39, 0xf3, 0xcb, 0xc1, 0x6a, 0xd1, 0x02, 0x75, 0x78,
-	0x7f, 0x04, 0x87, 0x29, 0x4d, 0x13, 0x38, 0xcf, 0x01, 0x5c, 0x68, 0x91, 0x90, 0x74, 0x92, 0x6d,
-	0x53, 0x58, 0x28, 0xca, 0x3a, 0x9f, 0xb2, 0xed, 0x64, 0xb8, 0xc5, 0x82, 0xf4, 0x29, 0xd7, 0x57,
-	0xcc, 0x68, 0x1f, 0xcf, 0x0e, 0xc2, 0xb6, 0x8d, 0x5d, 0x38, 0x29, 0x15, 0xbe, 0x47, 0x5e, 0x49,
-	0x0f, 0x9b, 0x52, 0xa8, 0x05, 0x73, 0x5d, 0x42, 0x3b, 0x5d, 0xe3, 0x64, 0xb6, 0x71, 0xfb, 0x9f,
-	0xc3, 0xf2, 0x9c, 0x2f, 0x88, 0x1e, 0xbb, 0xcc, 0x33, 0xa9, 0x1f, 0x4f, 0xf6, 0x57, 0xce, 0xc6,
-	0xac, 0x15, 0x66, 0xe1, 0xfc, 0x01, 0xe0, 0x92, 0x15, 0x47, 0x39, 0x4b, 0x65, 0xda, 0xcb, 0x6c,
-	0x03, 0x5e, 0x1d, 0x9e, 0x05, 0x7d, 0x9b, 0x11, 0x29, 0xed, 0x97, 0x41, 0xe1, 0xd9, 0xc1, 0x6a,
-	0xde, 0xb2, 0x5a, 0x37, 0x99, 0x2d, 0x25, 0xf4, 0xbc, 0x19, 0x1e, 0x6e, 0x1b, 0x47, 0x0c, 0xe6,
-	0xd2, 0xbb, 0x7e, 0x9c, 0x5d, 0x6c, 0x51, 0xea, 0xd3, 0x76, 0x7f, 0x81, 0xf3, 0x1b, 0x80, 0x6f,
-	0xfd, 0x7f, 0x23, 0x7f, 0x41, 0x55, 0xb7, 0x45, 0x62, 0x2e, 0xa9, 0x1a, 0x53, 0x4f, 0x2f, 0x8e,
-	0xf4, 0xb4, 0x4e, 0xd9, 0x15, 0x2a, 0xc0, 0xa9, 0xc0, 0x00, 0x17, 0x26, 0x93, 0xc4, 0x60, 0x59,
-	0xbf, 0x39, 0xe0, 0x7e, 0x71, 0x5f, 0x36, 0xee, 0x3e, 0x3e, 0x2a, 0x81, 0x27, 0x47, 0x25, 0xf0,
-	0xf4, 0xa8, 0x04, 0xfe, 0x3a, 0x2a, 0x81, 0xef, 0x8f, 0x4b, 0x99, 0xa7, 0xc7, 0xa5, 0xcc, 0xef,
-	0xc7, 0xa5, 0xcc, 0x57, 0x6b, 0x17, 0x7a, 0x77, 0xe6, 0xd3, 0x22, 0xb1, 0xb2, 0x9d, 0x4b, 0xbe,
-	0x09, 0xdf, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x9e, 0xb6, 0x03, 0xc6, 0x0a, 0x00, 0x00,
+	// 1077 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x24, 0xc6, 0xc0, 0xa4, 0x40, 0x33, 0xd8, 0x60, 0x1c, 0x64, 0x5b, 0x96, 0x9a, 0x12,
+	0x12, 0xec, 0x92, 0xaa, 0x52, 0x85, 0xaa, 0x56, 0x60, 0xa8, 0xca, 0x29, 0x68, 0x89, 0xda, 0xaa,
+	0x97, 0xd5, 0x78, 0x77, 0xb0, 0x47, 0xec, 0xce, 0x6c, 0x67, 0x66, 0x0d, 0x39, 0xf4, 0x1e, 0xe5,
+	0xd0, 0xf6, 0x18, 0xf5, 0x84, 0xda, 0x4b, 0xd4, 0x13, 0x07, 0x7e, 0x44, 0xd4, 0x53, 0x94, 0x43,
+	0x5a, 0x45, 0x15, 0xad, 0xe0, 0x40, 0xd5, 0x5f, 0x51, 0xcd, 0xce, 0x78, 0x6d, 0x28, 0x45, 0x91,
+	0x12, 0xd4, 0x0b, 0x78, 0xde, 0xdb, 0xf9, 0xbe, 0xef, 0xbd, 0x79, 0xf3, 0xde, 0xc0, 0xba, 0xc7,
+	0x65, 0xc8, 0x65, 0xc3, 0xa7, 0x52, 0x09, 0xda, 0x8a, 0x15, 0xe5, 0xac, 0xd1, 0x5d, 0x6c, 0x11,
+	0x85, 0x17, 0x4f, 0x19, 0xeb, 0x91, 0xe0, 0x8a, 0xa3, 0x1b, 0xe6, 0xfb, 0xfa, 0x29, 0x97, 0xfd,
+	0xbe, 0x94, 0x6f, 0xf3, 0x36, 0x4f, 0xbe, 0x6b, 0xe8, 0x5f, 0x66, 0x4b, 0xa9, 0x6c, 0x29, 0x5a,
+	0x58, 0x92, 0x14, 0xda, 0xe3, 0xd4, 0x42, 0x96, 0x66, 0x8c, 0xdf, 0x35, 0x1b, 0x2d, 0xbe, 0x71,
+	0x5d, 0xc7, 0x21, 0x65, 0xbc, 0x91, 0xfc, 0x35, 0xa6, 0xda, 0x8b, 0x2c, 0xcc, 0x6d, 0x60, 0x81,
+	0x43, 0x89, 0x30, 0x1c, 0xf3, 0x78, 0x18, 0xc6, 0x8c, 0xaa, 0x07, 0xae, 0xc2, 0xbb, 0x45, 0x50,
+	0x05, 0x73, 0xa3, 0x2b, 0x1f, 0x3d, 0x3d, 0xac, 0x64, 0x5e, 0x1e, 0x56, 0x6e, 0xb6, 0xa9, 0xea,
+	0xc4, 0xad, 0xba, 0xc7, 0x43, 0x8b, 0x6a, 0xff, 0x2d, 0x48, 0x7f, 0xbb, 0xa1, 0x1e, 0x44, 0x44,
+	0xd6, 0x57, 0x89, 0xf7, 0xfc, 0x60, 0x01, 0x5a, 0xd2, 0x55, 0xe2, 0x39, 0x6f, 0xa5, 0x90, 0xf7,
+	0xf1, 0x2e, 0x8a, 0x60, 0x5e, 0xcb, 0xd6, 0xda, 0x22, 0x2e, 0x89, 0x70, 0x05, 0xd9, 0xc1, 0xc2,
+	0x2f, 0x5e, 0x49, 0x98, 0x3e, 0x7e, 0x1d, 0xa6, 0x22, 0x70, 0x90, 0xc6, 0xde, 0xb0, 0xd0, 0x4e,
+	0x82, 0x8c, 0x04, 0x2c, 0xb4, 0x38, 0x8b, 0xe5, 0xbf, 0x28, 0xaf, 0xbe, 0x11, 0xca, 0xc9, 0x04,
+	0xfc, 0x0c, 0xe7, 0x5d, 0x58, 0xd8, 0xa1, 0xaa, 0xe3, 0x0b, 0xbc, 0xe3, 0x62, 0xdf, 0x17, 0x2e,
+	0x61, 0xb8, 0x15, 0x10, 0xbf, 0x98, 0xad, 0x82, 0xb9, 0x11, 0x67, 0xb2, 0xe7, 0x5c, 0xf6, 0x7d,
+	0xb1, 0x66, 0x5c, 0xc8, 0x83, 0xd3, 0x38, 0x56, 0xdc, 0xf5, 0x78, 0x18, 0xf1, 0x98, 0xf9, 0xae,
+	0xea, 0x08, 0x22, 0x3b, 0x3c, 0xf0, 0x8b, 0x43, 0x89, 0xd2, 0xdb, 0x56, 0x69, 0xc1, 0xf0, 0x4b,
+	0x7f, 0xbb, 0x4e, 0x79, 0x23, 0xc4, 0xaa, 0x53, 0x5f, 0x67, 0x6a, 0x40, 0xd8, 0x3a, 0x53, 0x4e,
+	0x41, 0x63, 0x35, 0x2d, 0xd4, 0xfd, 0x1e, 0x12, 0xfa, 0x04, 0xce, 0x86, 0x78, 0xd7, 0x3d, 0x4d,
+	0xe4, 0x93, 0x80, 0xb4, 0xb1, 0xe2, 0x42, 0x16, 0x73, 0x55, 0x30, 0x37, 0xe6, 0xcc, 0x84, 0x78,
+	0x77, 0x79, 0x60, 0xff, 0x6a, 0xfa, 0xc1, 0xd2, 0xad, 0xc7, 0x7b, 0x95, 0xcc, 0xa3, 0x93, 0xfd,
+	0xf9, 0xea, 0x40, 0x76, 0x76, 0x4f, 0x57, 0xbb, 0xa9, 0xa6, 0xda, 0x0b, 0x00, 0x4b, 0x9f, 0xe3,
+	0x80, 0xfa, 0x7a, 0xe7, 0x67, 0x54, 0x2a, 0x2e, 0xa8, 0x87, 0x03, 0x93, 0x22, 0x89, 0xbe, 0x05,
+	0x70, 0xda, 0x8b, 0xc3, 0x38, 0xc0, 0x8a, 0x76, 0x89, 0x3d, 0x14, 0x57, 0x60, 0x45, 0x79, 0x11,
+	0x54, 0xaf, 0xce, 0x5d, 0xbb, 0x3b, 0x6b, 0xef, 0x52, 0x5d, 0x9f, 0x6a, 0xef, 0x4e, 0xe8, 0xb4,
+	0x37, 0x39, 0x65, 0x2b, 0x1f, 0xea, 0x74, 0xfc, 0xfc, 0x47, 0xe5, 0xf6, 0xab, 0x1d, 0x9c, 0xde,
+	0x23, 0x9f, 0x9c, 0xec, 0xcf, 0x03, 0xa7, 0xd0, 0xa7, 0x35, 0x62, 0x1c, 0x4d, 0x8a, 0xde, 0x85,
+	0x13, 0x82, 0x6c, 0x11, 0x41, 0x98, 0x47, 0x5c, 0x8f, 0xc7, 0x4c, 0x25, 0x55, 0x39, 0xe6, 0x8c,
+	0xa7, 0xe6, 0xa6, 0xb6, 0xd6, 0x7e, 0x02, 0x70, 0x3a, 0x0d, 0xac, 0x19, 0x0b, 0x41, 0x98, 0xea,
+	0x45, 0x15, 0xc1, 0x61, 0x13, 0x89, 0xbc, 0xe4, 0x20, 0x7a, 0x34, 0x68, 0x0a, 0xe6, 0x22, 0x22,
+	0x28, 0x37, 0x77, 0x28, 0xeb, 0xd8, 0x55, 0xed, 0x31, 0x80, 0xe5, 0x54, 0xe5, 0xb2, 0x67, 0x63,
+	0x26, 0x7e, 0x93, 0x87, 0x21, 0x95, 0x92, 0x72, 0x86, 0xba, 0x10, 0x7a, 0xe9, 0xea, 0x92, 0xf5,
+	0x0e, 0x30, 0xd5, 0xbe, 0x03, 0xf0, 0x46, 0x2a, 0xed, 0x5e, 0xac, 0xa4, 0xc2, 0xcc, 0xa7, 0xac,
+	0xfd, 0xbf, 0x25, 0xb1, 0xf6, 0x03, 0x80, 0x93, 0xa9, 0xa2, 0xcd, 0x00, 0xcb, 0xce, 0x5a, 0x97,
+	0x30, 0x85, 0x6e, 0xc1, 0xb7, 0xbb, 0x3d, 0xb3, 0x6b, 0xd3, 0x0c, 0x92, 0x34, 0x4f, 0xa4, 0xf6,
+	0x8d, 0xc4, 0x8c, 0xbe, 0x84, 0x23, 0x5b, 0x02, 0x7b, 0xfa, 0x06, 0xd8, 0x6e, 0xf6, 0x7a, 0x7d,
+	0x33, 0x45, 0xd3, 0xe9, 0xca, 0x9f, 0x23, 0x4e, 0xa2, 0xaf, 0xe1, 0x54, 0x5f, 0x9d, 0xd4, 0x0e,
+	0x97, 0x24, 0x1e, 0x9b, 0xb6, 0xf7, 0xea, 0x17, 0x0c, 0x97, 0xfa, 0x39, 0x90, 0x2b, 0xa3, 0x5a,
+	0xb2, 0xc9, 0x4d, 0xbe, 0x7b, 0x0e, 0xe5, 0x52, 0x56, 0xdf, 0xff, 0xda, 0x43, 0x00, 0x87, 0x3f,
+	0x25, 0x64, 0x83, 0xf3, 0x00, 0x7d, 0x03, 0xc7, 0xfb, 0x43, 0x23, 0xe2, 0x3c, 0xb8, 0xe4, 0x33,
+	0xeb, 0x8f, 0x28, 0x4d, 0x5f, 0x7b, 0x74, 0x05, 0x96, 0x9a, 0x83, 0x96, 0xcd, 0x88, 0x30, 0xdf,
+	0xf4, 0x63, 0x1c, 0xa0, 0x3c, 0x1c, 0x52, 0x54, 0x05, 0xc4, 0x8c, 0x32, 0xc7, 0x2c, 0x50, 0x15,
+	0x5e, 0xf3, 0x89, 0xf4, 0x04, 0x8d, 0xfa, 0xc7, 0xe5, 0x0c, 0x9a, 0xd0, 0x2c, 0x1c, 0x15, 0xc4,
+	0xa3, 0x11, 0x25, 0x4c, 0x99, 0x49, 0xe1, 0xf4, 0x0d, 0xa8, 0x03, 0x73, 0x38, 0x4c, 0x3a, 0x44,
+	0x36, 0x89, 0x75, 0xe6, 0xdc, 0x58, 0x93, 0x40, 0x3f, 0xb0, 0x81, 0xce, 0xbd, 0x42, 0xa0, 0x03,
+	0x51, 0x5a, 0xfc, 0xa5, 0x3b, 0x0f, 0xf7, 0x2a, 0x19, 0x9d, 0xf3, 0xbf, 0xf6, 0x2a, 0x99, 0x5f,
+	0x0e, 0x16, 0x4a, 0x96, 0xa8, 0xcd, 0xbb, 0x03, 0x3c, 0x4c, 0x69, 0x99, 0xa0, 0xf6, 0x12, 0xc0,
+	0x42, 0xda, 0xac, 0x37, 0x15, 0x16, 0x8a, 0xb2, 0xf6, 0x3a, 0xdb, 0x4a, 0x9a, 0x5b, 0x24, 0x48,
+	0x97, 0x72, 0x3d, 0x08, 0x07, 0xeb, 0x78, 0xbc, 0x67, 0xb6, 0x65, 0xec, 0xc0, 0x21, 0xa9, 0xf0,
+	0x36, 0x79, 0x23, 0x35, 0x6c, 0xa0, 0xd0, 0x2a, 0xcc, 0x75, 0x08, 0x6d, 0x77, 0x4c, 0x26, 0xb3,
+	0x2b, 0x77, 0xfe, 0x3e, 0xac, 0x4c, 0x78, 0x82, 0xe8, 0xb6, 0xcb, 0x5c, 0xe3, 0xfa, 0xf1, 0x64,
+	0x7f, 0xfe, 0xac, 0xcd, 0xa6, 0xc2, 0x2c, 0x6a, 0xbf, 0x03, 0x38, 0x63, 0x83, 0xa3, 0x9c, 0xa5,
+	0x61, 0xda, 0x91, 0xbb, 0x06, 0xaf, 0xf7, 0xef, 0x82, 0x9e, 0xb9, 0x44, 0x4a, 0xfb, 0x7e, 0x29,
+	0x3e, 0x3f, 0x58, 0xc8, 0x5b, 0x55, 0xcb, 0xc6, 0xb3, 0xa9, 0x84, 0xee, 0x37, 0xfd, 0xcb, 0x6d,
+	0xed, 0x88, 0xc1, 0x5c, 0xfa, 0x22, 0xb9, 0xcc, 0x2a, 0xb6, 0x2c, 0x4b, 0x23, 0xf6, 0x7c, 0x41,
+	0xed, 0x57, 0x00, 0xdf, 0xf9, 0xef, 0x42, 0xfe, 0x82, 0xaa, 0xce, 0x2a, 0x89, 0xb8, 0xa4, 0xea,
+	0x92, 0x6a, 0x7a, 0x6a, 0xa0, 0xa6, 0xb5, 0xcb, 0xae, 0x50, 0x11, 0x0e, 0xfb, 0x86, 0xd8, 0xbc,
+	0x43, 0x9c, 0xde, 0x72, 0xe9, 0x66, 0x4f, 0xfb, 0xc5, 0x75, 0xb9, 0x72, 0xef, 0xc9, 0x51, 0x19,
+	0x3c, 0x3d, 0x2a, 0x83, 0x67, 0x47, 0x65, 0xf0, 0xe7, 0x51, 0x19, 0x7c, 0x7f, 0x5c, 0xce, 0x3c,
+	0x3b, 0x2e, 0x67, 0x7e, 0x3b, 0x2e, 0x67, 0xbe, 0x5a, 0xbc, 0x30, 0x77, 0x67, 0x9e, 0x16, 0x49,
+	0x2a, 0x5b, 0xb9, 0xe4, 0xe5, 0xfa, 0xfe, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x00, 0x11,
+	0x8d, 0x6c, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -734,6 +753,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if !this.AutoCompoundThreshold.Equal(that1.AutoCompoundThreshold) {
+		return false
+	}
+	if this.MaxAutoCompoundDelegators != that1.MaxAutoCompoundDelegators {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1061,6 +1086,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAutoCompoundDelegators != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxAutoCompoundDelegators))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.AutoCompoundThreshold.Size()
+		i -= size
+		if _, err := m.AutoCompoundThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1603,6 +1643,11 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = m.AutoCompoundThreshold.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.MaxAutoCompoundDelegators != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxAutoCompoundDelegators))
+	}
 	return n
 }
 
@@ -1965,6 +2010,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoCompoundThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundDelegators", wireType)
+			}
+			m.MaxAutoCompoundDelegators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundDelegators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -18,4 +18,7 @@ var (
 	ErrEmptyProposalRecipient  = sdkerrors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
+
+	ErrNotTokenizeShareRecordOwner = sdkerrors.Register(ModuleName, 14, "not the owner of the tokenize share record")
+	ErrAutoCompoundWithdrawAddr    = sdkerrors.Register(ModuleName, 15, "rewards withdrawn to another address cannot be auto compounded")
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -37,6 +39,7 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundDelegators:          []string{},
 	}
 }
 
@@ -45,5 +48,18 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	seenDelegators := make(map[string]bool, len(gs.AutoCompoundDelegators))
+	for _, delegator := range gs.AutoCompoundDelegators {
+		delAddr, err := sdk.AccAddressFromHexUnsafe(delegator)
+		if err != nil {
+			return err
+		}
+		if seenDelegators[delAddr.String()] {
+			return fmt.Errorf("duplicate auto compound delegator %s", delegator)
+		}
+		seenDelegators[delAddr.String()] = true
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
@@ -348,6 +348,8 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_delegators defines the delegators with the auto compounding of rewards enabled at genesis.
+	AutoCompoundDelegators []string `protobuf:"bytes,11,rep,name=auto_compound_delegators,json=autoCompoundDelegators,proto3" json:"auto_compound_delegators,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -399,65 +401,67 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 921 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x38, 0x25, 0x4d, 0xc6, 0x45, 0xb4, 0xdb, 0x34, 0x6c, 0xd2, 0xb2, 0x4e, 0x4b, 0x0f,
-	0x05, 0xd4, 0x35, 0x31, 0x08, 0xaa, 0x22, 0x90, 0x62, 0xb7, 0xe5, 0xe3, 0xd2, 0xc8, 0x96, 0x40,
-	0x20, 0x24, 0x6b, 0xbc, 0x3b, 0x5e, 0x8f, 0xb0, 0x77, 0xac, 0x99, 0xf1, 0x1a, 0x90, 0x38, 0x70,
-	0x02, 0x21, 0x90, 0x38, 0xc2, 0xad, 0xc7, 0x0a, 0x09, 0x89, 0x03, 0x7f, 0x44, 0x25, 0x2e, 0x15,
-	0x27, 0x4e, 0x7c, 0x38, 0x07, 0xe0, 0x9f, 0x40, 0x68, 0x67, 0x66, 0x77, 0xc7, 0xda, 0xed, 0xd6,
-	0x69, 0x93, 0x4b, 0xe2, 0x9d, 0x79, 0x1f, 0xbf, 0xdf, 0x7b, 0x3f, 0xbd, 0x37, 0xf0, 0x39, 0x8f,
-	0xf2, 0x31, 0xe5, 0x0d, 0x9f, 0x70, 0xc1, 0x48, 0x7f, 0x2a, 0x08, 0x0d, 0x1b, 0xd1, 0x6e, 0x1f,
-	0x0b, 0xb4, 0xdb, 0x08, 0x70, 0x88, 0x39, 0xe1, 0xee, 0x84, 0x51, 0x41, 0xad, 0xf3, 0xca, 0xd4,
-	0x35, 0x4d, 0x5d, 0x6d, 0xba, 0xbd, 0x11, 0xd0, 0x80, 0x4a, 0xbb, 0x46, 0xfc, 0x4b, 0xb9, 0x6c,
-	0x3b, 0x3a, 0x7a, 0x1f, 0x71, 0x9c, 0x46, 0xf5, 0x28, 0x09, 0xf5, 0xbd, 0x5b, 0x96, 0x7d, 0x21,
-	0x8f, 0xb2, 0xdf, 0x52, 0xf6, 0x3d, 0x95, 0x48, 0xe3, 0x51, 0x57, 0x67, 0xd0, 0x98, 0x84, 0xb4,
-	0x21, 0xff, 0xaa, 0xa3, 0x4b, 0x3f, 0x02, 0x78, 0xee, 0x06, 0x1e, 0xe1, 0x00, 0x09, 0xca, 0xde,
-	0x23, 0x62, 0xe8, 0x33, 0x34, 0x7b, 0x3b, 0x1c, 0x50, 0xeb, 0x26, 0x3c, 0xe3, 0x27, 0x17, 0x3d,
-	0xe4, 0xfb, 0x0c, 0x73, 0x6e, 0x83, 0x1d, 0x70, 0x65, 0xbd, 0x65, 0xff, 0xfa, 0xf3, 0xd5, 0x0d,
-	0x1d, 0x79, 0x4f, 0xdd, 0x74, 0x05, 0x23, 0x61, 0xd0, 0x39, 0x9d, 0xba, 0xe8, 0x73, 0xab, 0x0d,
-	0x4f, 0xcf, 0x74, 0xd8, 0x34, 0x4a, 0xf5, 0x21, 0x51, 0x9e, 0x4a, 0x3c, 0xf4, 0xf1, 0xf5, 0xb5,
-	0x2f, 0xef, 0xd4, 0x2b, 0xff, 0xdc, 0xa9, 0x57, 0x2e, 0xfd, 0x07, 0xe0, 0xc5, 0x77, 0xd1, 0x88,
-	0xf8, 0x71, 0x8e, 0xdb, 0x53, 0xc1, 0x05, 0x0a, 0xfd, 0xd8, 0x07, 0xcf, 0x10, 0xf3, 0x79, 0x07,
-	0x7b, 0x94, 0xf9, 0x31, 0xf6, 0x28, 0x31, 0x5a, 0x1e, 0x7b, 0xea, 0x92, 0x60, 0xff, 0x02, 0xc0,
-	0xb3, 0x34, 0xcb, 0xd1, 0x63, 0x2a, 0x89, 0x5d, 0xdd, 0x59, 0xb9, 0x52, 0x6b, 0x5e, 0xd0, 0x9d,
-	0x71, 0xe3, 0xce, 0x25, 0x4d, 0x76, 0x6f, 0x60, 0xaf, 0x4d, 0x49, 0xd8, 0xba, 0x76, 0xef, 0xf7,
-	0x7a, 0xe5, 0x87, 0x3f, 0xea, 0x2f, 0x04, 0x44, 0x0c, 0xa7, 0x7d, 0xd7, 0xa3, 0x63, 0xdd, 0x0c,
-	0xfd, 0xef, 0x2a, 0xf7, 0x3f, 0x6a, 0x88, 0x4f, 0x26, 0x98, 0x27, 0x3e, 0xfc, 0xee, 0xdf, 0x3f,
-	0x3d, 0x0f, 0x3a, 0x16, 0xcd, 0xd1, 0x32, 0x0a, 0xf0, 0x17, 0x80, 0x97, 0xd3, 0x02, 0xec, 0x79,
-	0xde, 0x74, 0x3c, 0x1d, 0x21, 0x81, 0xfd, 0x36, 0x1d, 0x8f, 0x09, 0xe7, 0x84, 0x86, 0x47, 0x5b,
-	0x83, 0x21, 0xac, 0xa1, 0x2c, 0x8b, 0x6c, 0x5d, 0xad, 0xf9, 0x9a, 0x5b, 0xa2, 0x73, 0xb7, 0x1c,
-	0x5e, 0x6b, 0x3d, 0xae, 0x8c, 0xa2, 0x6a, 0x86, 0x36, 0x38, 0xfe, 0x0b, 0xe0, 0x4e, 0x1a, 0xe4,
-	0x2d, 0xc2, 0x05, 0x65, 0xc4, 0x43, 0xa3, 0x63, 0xe9, 0xf1, 0x26, 0x5c, 0x9d, 0x60, 0x46, 0xa8,
-	0xa2, 0x76, 0xa2, 0xa3, 0xbf, 0xac, 0x0f, 0xe1, 0xc9, 0xa4, 0xdd, 0x2b, 0x92, 0xf3, 0xab, 0xcb,
-	0x71, 0xce, 0xc1, 0x35, 0xf9, 0x26, 0x21, 0x0d, 0xae, 0xbf, 0x00, 0xf8, 0x4c, 0xea, 0xdc, 0x9e,
-	0x32, 0x86, 0x43, 0x71, 0x2c, 0x44, 0xdf, 0xcf, 0x08, 0xa9, 0x26, 0xbe, 0xbc, 0x1c, 0xa1, 0x45,
-	0x4c, 0x0f, 0x61, 0xf3, 0x7d, 0x15, 0x9e, 0x4f, 0xc7, 0x49, 0x57, 0x20, 0x26, 0x48, 0x18, 0xc4,
-	0xe3, 0x24, 0xe3, 0x72, 0x14, 0x43, 0xa5, 0xb0, 0x24, 0xd5, 0x43, 0x97, 0xa4, 0x0f, 0x9f, 0xe4,
-	0x1a, 0x63, 0x8f, 0x84, 0x03, 0xaa, 0x3b, 0xdd, 0x2c, 0x2d, 0x4c, 0x21, 0x3d, 0xb3, 0x2c, 0xa7,
-	0xb8, 0x71, 0x61, 0xd4, 0xe6, 0x9b, 0x2a, 0xdc, 0x4a, 0xab, 0xda, 0x1d, 0x21, 0x3e, 0xbc, 0x19,
-	0xc9, 0xc2, 0x1e, 0xb1, 0x9c, 0x87, 0x98, 0x04, 0x43, 0x91, 0xc8, 0x59, 0x7d, 0x19, 0x32, 0x5f,
-	0x59, 0x90, 0x39, 0x85, 0xe7, 0xb2, 0xb4, 0x3c, 0x06, 0xd5, 0xc3, 0x31, 0x2a, 0xfb, 0x84, 0x2c,
-	0xc5, 0x8b, 0xcb, 0x69, 0x24, 0x63, 0x63, 0x16, 0xe2, 0x6c, 0x94, 0xbf, 0x37, 0xea, 0xf1, 0xf5,
-	0x3a, 0x3c, 0xf5, 0xa6, 0xda, 0x9e, 0x5d, 0x81, 0x04, 0xb6, 0x6e, 0xc1, 0xd5, 0x09, 0x62, 0x68,
-	0xac, 0x78, 0xd7, 0x9a, 0xcf, 0x96, 0x26, 0xdf, 0x97, 0xa6, 0x66, 0x3e, 0xed, 0x6d, 0xbd, 0x03,
-	0xd7, 0x06, 0x18, 0xf7, 0x26, 0x94, 0x8e, 0xb4, 0xd4, 0x2f, 0x97, 0x46, 0xba, 0x85, 0xf1, 0x3e,
-	0xa5, 0xa3, 0x05, 0x69, 0x0f, 0xd4, 0x99, 0x35, 0x83, 0x76, 0x26, 0xd8, 0x74, 0x91, 0xc5, 0x62,
-	0x89, 0xe7, 0xc2, 0xca, 0xf2, 0x6a, 0x31, 0x77, 0xab, 0x99, 0x69, 0xd3, 0x2f, 0xb2, 0x90, 0x12,
-	0x9f, 0x30, 0x1c, 0x11, 0x3a, 0x95, 0xab, 0x7c, 0x42, 0x39, 0x66, 0xb2, 0x29, 0xa5, 0x7a, 0x48,
-	0x5c, 0xf6, 0xb5, 0x87, 0xf5, 0x69, 0xf1, 0x06, 0x7b, 0x42, 0x42, 0x7f, 0x63, 0xb9, 0xee, 0x3e,
-	0x68, 0xcd, 0x9a, 0x34, 0x0a, 0x96, 0x96, 0xf5, 0x1d, 0x80, 0x17, 0x0d, 0x4d, 0x67, 0xa3, 0xbe,
-	0xe7, 0xa5, 0xdb, 0x80, 0xdb, 0xab, 0x12, 0xca, 0xde, 0x63, 0x6c, 0x94, 0x3c, 0x9a, 0x7a, 0x54,
-	0xea, 0xc0, 0xad, 0xaf, 0x00, 0xbc, 0x90, 0x41, 0x1b, 0xa6, 0x33, 0x3b, 0x2d, 0xd0, 0x49, 0x89,
-	0xea, 0xf5, 0x47, 0x9c, 0xf9, 0x79, 0x44, 0xdb, 0xd1, 0x03, 0x8d, 0xad, 0xcf, 0x01, 0xdc, 0xca,
-	0xc0, 0x78, 0x6a, 0xde, 0xa6, 0x48, 0xd6, 0x24, 0x92, 0xeb, 0x8f, 0x32, 0xac, 0xf3, 0x30, 0x9e,
-	0x8e, 0x8a, 0x2d, 0xad, 0xcf, 0x4c, 0x9d, 0x2f, 0x0c, 0x45, 0x6e, 0xaf, 0x4b, 0x04, 0xd7, 0x0e,
-	0x3f, 0x15, 0xf3, 0xf9, 0x33, 0xb5, 0x9b, 0x76, 0xdc, 0x9a, 0xc1, 0xcd, 0xc2, 0x31, 0xc4, 0x6d,
-	0x28, 0x93, 0xbf, 0x72, 0xd8, 0x39, 0x94, 0x4f, 0xbd, 0x51, 0x30, 0x8d, 0x8c, 0xd5, 0xd5, 0xba,
-	0x7d, 0x77, 0xee, 0x80, 0x7b, 0x73, 0x07, 0xdc, 0x9f, 0x3b, 0xe0, 0xcf, 0xb9, 0x03, 0xbe, 0x3d,
-	0x70, 0x2a, 0xf7, 0x0f, 0x9c, 0xca, 0x6f, 0x07, 0x4e, 0xe5, 0x83, 0xdd, 0xd2, 0x67, 0xdc, 0xc7,
-	0x8b, 0xaf, 0x73, 0xf9, 0xaa, 0xeb, 0xaf, 0xca, 0x17, 0xf6, 0x4b, 0xff, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x71, 0x88, 0x91, 0xf2, 0x3f, 0x0c, 0x00, 0x00,
+	// 950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xd8, 0x25, 0x4d, 0xc6, 0x45, 0xb4, 0xdb, 0x34, 0x6c, 0xd2, 0x62, 0xa7, 0xa5, 0x87,
+	0x02, 0xea, 0x9a, 0x04, 0x04, 0x55, 0x11, 0x48, 0x89, 0xdb, 0xf2, 0xe3, 0xd2, 0xc8, 0x91, 0x40,
+	0x20, 0x24, 0x6b, 0xbc, 0x3b, 0xb1, 0x47, 0xd8, 0x3b, 0xab, 0x99, 0xd9, 0x35, 0x20, 0x71, 0xe0,
+	0x04, 0x42, 0x42, 0xe2, 0x08, 0xb7, 0x1e, 0x2b, 0x24, 0x24, 0x0e, 0xfc, 0x11, 0x95, 0xb8, 0x54,
+	0x9c, 0x38, 0x41, 0x49, 0x0e, 0xc0, 0x3f, 0x81, 0xd0, 0xce, 0xcc, 0xce, 0x8e, 0xb5, 0xdb, 0xad,
+	0xd3, 0x26, 0x97, 0x24, 0x3b, 0xf3, 0x7e, 0x7c, 0xdf, 0x7b, 0x5f, 0xde, 0x1b, 0xf8, 0x82, 0x4f,
+	0xf9, 0x84, 0xf2, 0x4e, 0x40, 0xb8, 0x60, 0x64, 0x10, 0x0b, 0x42, 0xc3, 0x4e, 0xb2, 0x31, 0xc0,
+	0x02, 0x6d, 0x74, 0x86, 0x38, 0xc4, 0x9c, 0x70, 0x2f, 0x62, 0x54, 0x50, 0xe7, 0xbc, 0x32, 0xf5,
+	0x6c, 0x53, 0x4f, 0x9b, 0xae, 0x2d, 0x0f, 0xe9, 0x90, 0x4a, 0xbb, 0x4e, 0xfa, 0x97, 0x72, 0x59,
+	0x6b, 0xe9, 0xe8, 0x03, 0xc4, 0xb1, 0x89, 0xea, 0x53, 0x12, 0xea, 0x7b, 0xaf, 0x2a, 0xfb, 0x4c,
+	0x1e, 0x65, 0xbf, 0xaa, 0xec, 0xfb, 0x2a, 0x91, 0xc6, 0xa3, 0xae, 0xce, 0xa0, 0x09, 0x09, 0x69,
+	0x47, 0xfe, 0x54, 0x47, 0x97, 0x7e, 0x02, 0xf0, 0xdc, 0x0d, 0x3c, 0xc6, 0x43, 0x24, 0x28, 0xfb,
+	0x80, 0x88, 0x51, 0xc0, 0xd0, 0xf4, 0xdd, 0x70, 0x8f, 0x3a, 0x37, 0xe1, 0x99, 0x20, 0xbb, 0xe8,
+	0xa3, 0x20, 0x60, 0x98, 0x73, 0x17, 0xac, 0x83, 0x2b, 0x4b, 0xdb, 0xee, 0x6f, 0xbf, 0x5c, 0x5d,
+	0xd6, 0x91, 0xb7, 0xd4, 0xcd, 0xae, 0x60, 0x24, 0x1c, 0xf6, 0x4e, 0x1b, 0x17, 0x7d, 0xee, 0x74,
+	0xe1, 0xe9, 0xa9, 0x0e, 0x6b, 0xa2, 0xd4, 0x1f, 0x11, 0xe5, 0x99, 0xcc, 0x43, 0x1f, 0x5f, 0x5f,
+	0xfc, 0xfa, 0x4e, 0xbb, 0xf6, 0xcf, 0x9d, 0x76, 0xed, 0xd2, 0x7f, 0x00, 0x5e, 0x7c, 0x1f, 0x8d,
+	0x49, 0x90, 0xe6, 0xb8, 0x1d, 0x0b, 0x2e, 0x50, 0x18, 0xa4, 0x3e, 0x78, 0x8a, 0x58, 0xc0, 0x7b,
+	0xd8, 0xa7, 0x2c, 0x48, 0xb1, 0x27, 0x99, 0xd1, 0xfc, 0xd8, 0x8d, 0x4b, 0x86, 0xfd, 0x2b, 0x00,
+	0xcf, 0xd2, 0x3c, 0x47, 0x9f, 0xa9, 0x24, 0x6e, 0x7d, 0xbd, 0x71, 0xa5, 0xb9, 0x79, 0x41, 0x77,
+	0xc6, 0x4b, 0x3b, 0x97, 0x35, 0xd9, 0xbb, 0x81, 0xfd, 0x2e, 0x25, 0xe1, 0xf6, 0xb5, 0x7b, 0x7f,
+	0xb4, 0x6b, 0x3f, 0xfe, 0xd9, 0x7e, 0x69, 0x48, 0xc4, 0x28, 0x1e, 0x78, 0x3e, 0x9d, 0xe8, 0x66,
+	0xe8, 0x5f, 0x57, 0x79, 0xf0, 0x49, 0x47, 0x7c, 0x16, 0x61, 0x9e, 0xf9, 0xf0, 0xbb, 0x7f, 0xff,
+	0xfc, 0x22, 0xe8, 0x39, 0xb4, 0x40, 0xcb, 0x2a, 0xc0, 0x5f, 0x00, 0x5e, 0x36, 0x05, 0xd8, 0xf2,
+	0xfd, 0x78, 0x12, 0x8f, 0x91, 0xc0, 0x41, 0x97, 0x4e, 0x26, 0x84, 0x73, 0x42, 0xc3, 0xa3, 0xad,
+	0xc1, 0x08, 0x36, 0x51, 0x9e, 0x45, 0xb6, 0xae, 0xb9, 0xf9, 0x86, 0x57, 0xa1, 0x73, 0xaf, 0x1a,
+	0xde, 0xf6, 0x52, 0x5a, 0x19, 0x45, 0xd5, 0x0e, 0x6d, 0x71, 0xfc, 0x17, 0xc0, 0x75, 0x13, 0xe4,
+	0x1d, 0xc2, 0x05, 0x65, 0xc4, 0x47, 0xe3, 0x63, 0xe9, 0xf1, 0x0a, 0x5c, 0x88, 0x30, 0x23, 0x54,
+	0x51, 0x3b, 0xd1, 0xd3, 0x5f, 0xce, 0xc7, 0xf0, 0x64, 0xd6, 0xee, 0x86, 0xe4, 0xfc, 0xfa, 0x7c,
+	0x9c, 0x0b, 0x70, 0x6d, 0xbe, 0x59, 0x48, 0x8b, 0xeb, 0xaf, 0x00, 0x3e, 0x67, 0x9c, 0xbb, 0x31,
+	0x63, 0x38, 0x14, 0xc7, 0x42, 0xf4, 0xc3, 0x9c, 0x90, 0x6a, 0xe2, 0xab, 0xf3, 0x11, 0x9a, 0xc5,
+	0xf4, 0x08, 0x36, 0x3f, 0xd4, 0xe1, 0x79, 0x33, 0x4e, 0x76, 0x05, 0x62, 0x82, 0x84, 0xc3, 0x74,
+	0x9c, 0xe4, 0x5c, 0x8e, 0x62, 0xa8, 0x94, 0x96, 0xa4, 0x7e, 0xe8, 0x92, 0x0c, 0xe0, 0xd3, 0x5c,
+	0x63, 0xec, 0x93, 0x70, 0x8f, 0xea, 0x4e, 0x6f, 0x56, 0x16, 0xa6, 0x94, 0x9e, 0x5d, 0x96, 0x53,
+	0xdc, 0xba, 0xb0, 0x6a, 0xf3, 0x6d, 0x1d, 0xae, 0x9a, 0xaa, 0xee, 0x8e, 0x11, 0x1f, 0xdd, 0x4c,
+	0x64, 0x61, 0x8f, 0x58, 0xce, 0x23, 0x4c, 0x86, 0x23, 0x91, 0xc9, 0x59, 0x7d, 0x59, 0x32, 0x6f,
+	0xcc, 0xc8, 0x9c, 0xc2, 0x73, 0x79, 0x5a, 0x9e, 0x82, 0xea, 0xe3, 0x14, 0x95, 0x7b, 0x42, 0x96,
+	0xe2, 0xe5, 0xf9, 0x34, 0x92, 0xb3, 0xb1, 0x0b, 0x71, 0x36, 0x29, 0xde, 0x5b, 0xf5, 0x78, 0xb0,
+	0x04, 0x4f, 0xbd, 0xad, 0xb6, 0xe7, 0xae, 0x40, 0x02, 0x3b, 0xb7, 0xe0, 0x42, 0x84, 0x18, 0x9a,
+	0x28, 0xde, 0xcd, 0xcd, 0xe7, 0x2b, 0x93, 0xef, 0x48, 0x53, 0x3b, 0x9f, 0xf6, 0x76, 0xde, 0x83,
+	0x8b, 0x7b, 0x18, 0xf7, 0x23, 0x4a, 0xc7, 0x5a, 0xea, 0x97, 0x2b, 0x23, 0xdd, 0xc2, 0x78, 0x87,
+	0xd2, 0xf1, 0x8c, 0xb4, 0xf7, 0xd4, 0x99, 0x33, 0x85, 0x6e, 0x2e, 0x58, 0xb3, 0xc8, 0x52, 0xb1,
+	0xa4, 0x73, 0xa1, 0x31, 0xbf, 0x5a, 0xec, 0xdd, 0x6a, 0x67, 0x5a, 0x09, 0xca, 0x2c, 0xa4, 0xc4,
+	0x23, 0x86, 0x13, 0x42, 0x63, 0xb9, 0xca, 0x23, 0xca, 0x31, 0x93, 0x4d, 0xa9, 0xd4, 0x43, 0xe6,
+	0xb2, 0xa3, 0x3d, 0x9c, 0xcf, 0xcb, 0x37, 0xd8, 0x53, 0x12, 0xfa, 0x5b, 0xf3, 0x75, 0xf7, 0x61,
+	0x6b, 0xd6, 0xa6, 0x51, 0xb2, 0xb4, 0x9c, 0xef, 0x01, 0xbc, 0x68, 0x69, 0x3a, 0x1f, 0xf5, 0x7d,
+	0xdf, 0x6c, 0x03, 0xee, 0x2e, 0x48, 0x28, 0x5b, 0x4f, 0xb0, 0x51, 0x8a, 0x68, 0xda, 0x49, 0xa5,
+	0x03, 0x77, 0xbe, 0x01, 0xf0, 0x42, 0x0e, 0x6d, 0x64, 0x66, 0xb6, 0x29, 0xd0, 0x49, 0x89, 0xea,
+	0xcd, 0xc7, 0x9c, 0xf9, 0x45, 0x44, 0x6b, 0xc9, 0x43, 0x8d, 0x9d, 0x2f, 0x01, 0x5c, 0xcd, 0xc1,
+	0xf8, 0x6a, 0xde, 0x1a, 0x24, 0x8b, 0x12, 0xc9, 0xf5, 0xc7, 0x19, 0xd6, 0x45, 0x18, 0xcf, 0x26,
+	0xe5, 0x96, 0xce, 0x17, 0xb6, 0xce, 0x67, 0x86, 0x22, 0x77, 0x97, 0x24, 0x82, 0x6b, 0x87, 0x9f,
+	0x8a, 0xc5, 0xfc, 0xb9, 0xda, 0x6d, 0x3b, 0xee, 0x4c, 0xe1, 0x4a, 0xe9, 0x18, 0xe2, 0x2e, 0x94,
+	0xc9, 0x5f, 0x3b, 0xec, 0x1c, 0x2a, 0xa6, 0x5e, 0x2e, 0x99, 0x46, 0xdc, 0xe9, 0x41, 0x17, 0xc5,
+	0x82, 0xa6, 0x8a, 0x8c, 0x68, 0x1c, 0x06, 0x7d, 0x03, 0x90, 0xbb, 0xcd, 0xf5, 0x46, 0xe5, 0x7f,
+	0xdb, 0x4a, 0xea, 0xd9, 0xd5, 0x8e, 0xa6, 0x00, 0xd6, 0x3a, 0xdc, 0xbe, 0x7d, 0x77, 0xbf, 0x05,
+	0xee, 0xed, 0xb7, 0xc0, 0xfd, 0xfd, 0x16, 0x78, 0xb0, 0xdf, 0x02, 0xdf, 0x1d, 0xb4, 0x6a, 0xf7,
+	0x0f, 0x5a, 0xb5, 0xdf, 0x0f, 0x5a, 0xb5, 0x8f, 0x36, 0x2a, 0x9f, 0x86, 0x9f, 0xce, 0xbe, 0xf8,
+	0xe5, 0x4b, 0x71, 0xb0, 0x20, 0x5f, 0xed, 0xaf, 0xfc, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xd0,
+	0xeb, 0x58, 0x93, 0x0c, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -783,6 +787,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundDelegators) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCompoundDelegators[iNdEx])
+			copy(dAtA[i:], m.AutoCompoundDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1102,6 +1115,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for _, s := range m.AutoCompoundDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2370,6 +2389,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegators = append(m.AutoCompoundDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
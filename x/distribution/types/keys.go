@@ -41,6 +41,10 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0A<accAddrLen (1 Byte)><accAddr_Bytes>: []byte{} (auto compound delegator)
+//
+// - 0x0B: <accAddrLen (1 Byte)><accAddr_Bytes>[<valAddrLen (1 Byte)><valAddr_Bytes>] (last auto compounded delegation)
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -54,6 +58,9 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = []byte{0x09} // key for distribution module params
+
+	AutoCompoundDelegatorPrefix = []byte{0x0A} // key for the delegators with the auto compounding enabled
+	AutoCompoundCursorKey       = []byte{0x0B} // key for the last auto compounded delegation
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -82,6 +89,19 @@ func GetDelegatorWithdrawInfoAddress(key []byte) (delAddr sdk.AccAddress) {
 	return sdk.AccAddress(addr)
 }
 
+// GetAutoCompoundDelegatorAddress creates an address from an auto compound delegator key.
+func GetAutoCompoundDelegatorAddress(key []byte) (delAddr sdk.AccAddress) {
+	// key is in the format:
+	// 0x0A<accAddrLen (1 Byte)><accAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.AccAddress(addr)
+}
+
 // GetDelegatorStartingInfoAddresses creates the addresses from a delegator starting info key.
 func GetDelegatorStartingInfoAddresses(key []byte) (valAddr, delAddr sdk.AccAddress) {
 	// key is in the format:
@@ -98,6 +118,24 @@ func GetDelegatorStartingInfoAddresses(key []byte) (valAddr, delAddr sdk.AccAddr
 	return
 }
 
+// GetAutoCompoundCursorAddresses creates the addresses from an auto compound cursor.
+func GetAutoCompoundCursorAddresses(cursor []byte) (delAddr, valAddr sdk.AccAddress) {
+	// cursor is in the format:
+	// <accAddrLen (1 Byte)><accAddr_Bytes>[<valAddrLen (1 Byte)><valAddr_Bytes>]
+	kv.AssertKeyAtLeastLength(cursor, 2)
+	delAddrLen := int(cursor[0])
+	kv.AssertKeyAtLeastLength(cursor, 1+delAddrLen)
+	delAddr = sdk.AccAddress(cursor[1 : 1+delAddrLen])
+	if len(cursor) == 1+delAddrLen {
+		return
+	}
+	valAddrLen := int(cursor[1+delAddrLen])
+	valAddr = sdk.AccAddress(cursor[2+delAddrLen:])
+	kv.AssertKeyLength(valAddr.Bytes(), valAddrLen)
+
+	return
+}
+
 // GetValidatorHistoricalRewardsAddressPeriod creates the address & period from a validator's historical rewards key.
 func GetValidatorHistoricalRewardsAddressPeriod(key []byte) (valAddr sdk.AccAddress, period uint64) {
 	// key is in the format:
@@ -163,6 +201,17 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
 }
 
+// GetAutoCompoundDelegatorKey creates the key for a delegator with the auto compounding enabled.
+func GetAutoCompoundDelegatorKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundDelegatorPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// GetAutoCompoundCursor creates the auto compound cursor of a delegation, the validator address is empty
+// once all the delegations of the delegator are compounded.
+func GetAutoCompoundCursor(delAddr, valAddr sdk.AccAddress) []byte {
+	return append(address.MustLengthPrefix(delAddr.Bytes()), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
 // GetDelegatorStartingInfoKey creates the key for a delegator's starting info.
 func GetDelegatorStartingInfoKey(v, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, address.MustLengthPrefix(v.Bytes())...), address.MustLengthPrefix(d.Bytes())...)
@@ -9,13 +9,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Default parameter values
+var (
+	DefaultAutoCompoundThreshold            = sdk.NewInt(1e16) // 0.01 of the 18 decimals bond denom
+	DefaultMaxAutoCompoundDelegators uint32 = 100
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.ZeroDec(),            // deprecated
-		BonusProposerReward: sdk.ZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:              sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:        sdk.ZeroDec(),            // deprecated
+		BonusProposerReward:       sdk.ZeroDec(),            // deprecated
+		WithdrawAddrEnabled:       true,
+		AutoCompoundThreshold:     DefaultAutoCompoundThreshold,
+		MaxAutoCompoundDelegators: DefaultMaxAutoCompoundDelegators,
 	}
 }
 
@@ -32,6 +40,11 @@ func (p Params) ValidateBasic() error {
 		)
 	}
 
+	// the threshold is nil in the params stored before the auto compounding
+	if !p.AutoCompoundThreshold.IsNil() && p.AutoCompoundThreshold.IsNegative() {
+		return fmt.Errorf("auto compound threshold should be non-negative: %s", p.AutoCompoundThreshold)
+	}
+
 	return nil
 }
 
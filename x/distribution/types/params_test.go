@@ -43,6 +43,15 @@ func TestParams_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestParams_ValidateAutoCompoundThreshold(t *testing.T) {
+	p := types.DefaultParams()
+	p.AutoCompoundThreshold = sdk.ZeroInt()
+	require.NoError(t, p.ValidateBasic())
+
+	p.AutoCompoundThreshold = sdk.NewInt(-1)
+	require.Error(t, p.ValidateBasic())
+}
+
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
@@ -952,6 +952,93 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryDelegatorAutoCompoundRequest is the request type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) Reset()         { *m = QueryDelegatorAutoCompoundRequest{} }
+func (m *QueryDelegatorAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundResponse is the response type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundResponse struct {
+	// enabled defines whether the auto compounding of the rewards is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Reset()         { *m = QueryDelegatorAutoCompoundResponse{} }
+func (m *QueryDelegatorAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -973,6 +1060,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundResponse")
 }
 
 func init() {
@@ -980,86 +1069,91 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
+	// 1333 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xf6, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x99, 0x46, 0xc8, 0xd9, 0x04, 0x3b, 0xda,
-	0xd0, 0x26, 0x6a, 0x14, 0x6f, 0x93, 0x48, 0xa5, 0x34, 0x54, 0x10, 0x3b, 0x09, 0x45, 0xad, 0xfa,
-	0xc7, 0x2d, 0x44, 0x80, 0x2a, 0x6b, 0xed, 0xdd, 0xac, 0x17, 0xec, 0x1d, 0x67, 0x67, 0x9d, 0x10,
-	0x55, 0xbd, 0x14, 0x21, 0x15, 0x4e, 0x08, 0x2e, 0x3d, 0xe6, 0x88, 0x38, 0x71, 0x00, 0x71, 0xe4,
-	0xc2, 0xa1, 0xc7, 0x0a, 0x24, 0xc4, 0x09, 0x50, 0x02, 0xa2, 0x1c, 0x90, 0xb8, 0x71, 0x45, 0x9e,
-	0x99, 0xb5, 0x77, 0xe3, 0xf5, 0xfa, 0x5f, 0x7c, 0x49, 0x56, 0x6f, 0xe6, 0x7d, 0xef, 0x7d, 0xef,
-	0xcd, 0x9b, 0xf9, 0x12, 0x98, 0xcd, 0x13, 0x5a, 0x22, 0x54, 0xd1, 0x4c, 0xea, 0xd8, 0x66, 0xae,
-	0xe2, 0x98, 0xc4, 0x52, 0x76, 0x16, 0x73, 0xba, 0xa3, 0x2e, 0x2a, 0xdb, 0x15, 0xdd, 0xde, 0x4b,
-	0x96, 0x6d, 0xe2, 0x10, 0x3c, 0xc9, 0x37, 0x26, 0xbd, 0x1b, 0x93, 0x62, 0xa3, 0x74, 0x5e, 0xa0,
-	0xe4, 0x54, 0xaa, 0x73, 0xaf, 0x1a, 0x46, 0x59, 0x35, 0x4c, 0x4b, 0x65, 0xbb, 0x19, 0x90, 0x34,
-	0x6e, 0x10, 0x83, 0xb0, 0x4f, 0xa5, 0xfa, 0x25, 0xac, 0x53, 0x06, 0x21, 0x46, 0x51, 0x57, 0xd4,
-	0xb2, 0xa9, 0xa8, 0x96, 0x45, 0x1c, 0xe6, 0x42, 0xc5, 0x6a, 0xdc, 0x8b, 0xef, 0x22, 0xe7, 0x89,
-	0xe9, 0x62, 0x26, 0xc3, 0x58, 0xf8, 0x32, 0xe6, 0xfb, 0x27, 0xf8, 0xfe, 0x2c, 0x4f, 0x43, 0x30,
-	0xe3, 0x4b, 0x63, 0x6a, 0xc9, 0xb4, 0x88, 0xc2, 0x7e, 0x72, 0x93, 0x3c, 0x0e, 0xf8, 0x76, 0x95,
-	0xd3, 0x2d, 0xd5, 0x56, 0x4b, 0x34, 0xa3, 0x6f, 0x57, 0x74, 0xea, 0xc8, 0xf7, 0xe0, 0x8c, 0xcf,
-	0x4a, 0xcb, 0xc4, 0xa2, 0x3a, 0xde, 0x80, 0xa1, 0x32, 0xb3, 0xc4, 0xd0, 0x34, 0x9a, 0x3b, 0xb5,
-	0x34, 0x93, 0x0c, 0x29, 0x5c, 0x92, 0x3b, 0xa7, 0x86, 0x9f, 0xfc, 0x92, 0x88, 0x7c, 0xf1, 0xe7,
-	0x57, 0xe7, 0x51, 0x46, 0x78, 0xcb, 0x16, 0x9c, 0x65, 0xf0, 0x6f, 0xa9, 0x45, 0x53, 0x53, 0x1d,
-	0x62, 0xaf, 0x79, 0xfc, 0xdf, 0xb0, 0xb6, 0x88, 0xc8, 0x03, 0xaf, 0xc3, 0xd8, 0x8e, 0xbb, 0x27,
-	0xab, 0x6a, 0x9a, 0xad, 0x53, 0x1e, 0x7b, 0x38, 0x15, 0xfb, 0xe1, 0xeb, 0x85, 0x71, 0x11, 0x7e,
-	0x95, 0xaf, 0xdc, 0x71, 0x6c, 0xd3, 0x32, 0x32, 0xa3, 0x35, 0x17, 0x61, 0x97, 0xff, 0x88, 0xc2,
-	0xb9, 0x56, 0x01, 0x05, 0xc5, 0x34, 0x8c, 0x92, 0xb2, 0x6e, 0x77, 0x14, 0x70, 0xc4, 0xf5, 0x10,
-	0x66, 0xfc, 0x10, 0xc1, 0x18, 0xd5, 0x8b, 0x5b, 0xd9, 0x1c, 0xb1, 0xb4, 0xac, 0xad, 0xef, 0xaa,
-	0xb6, 0x46, 0x63, 0xd1, 0xe9, 0x81, 0xb9, 0x53, 0x4b, 0x53, 0x6e, 0xcd, 0xaa, 0xfd, 0xae, 0xd5,
-	0x6a, 0x4d, 0xcf, 0xa7, 0x89, 0x69, 0xa5, 0x2e, 0x55, 0x8b, 0xf5, 0xe5, 0xaf, 0x89, 0x79, 0xc3,
-	0x74, 0x0a, 0x95, 0x5c, 0x32, 0x4f, 0x4a, 0xa2, 0x85, 0xe2, 0xd7, 0x02, 0xd5, 0xde, 0x57, 0x9c,
-	0xbd, 0xb2, 0x4e, 0x5d, 0x1f, 0xca, 0x6b, 0x3b, 0x52, 0x0d, 0x98, 0x22, 0x96, 0x96, 0xe1, 0xe1,
-	0xf0, 0x36, 0x40, 0x9e, 0x94, 0x4a, 0x26, 0xa5, 0x26, 0xb1, 0x62, 0x03, 0x6d, 0x04, 0x5f, 0xee,
-	0x22, 0x78, 0xc6, 0x13, 0x44, 0x2e, 0xc3, 0xac, 0xbf, 0xcc, 0x37, 0x2b, 0x0e, 0x75, 0x54, 0x4b,
-	0xab, 0x56, 0x89, 0xa7, 0x75, 0xcc, 0x9d, 0xfd, 0x18, 0xc1, 0x5c, 0xeb, 0x90, 0xa2, 0xb7, 0xf7,
-	0xe0, 0xa4, 0xdb, 0x0b, 0x7e, 0x7e, 0x2f, 0x85, 0x9e, 0xdf, 0x10, 0x48, 0xef, 0xa1, 0x76, 0x31,
-	0xe5, 0x02, 0x24, 0xfc, 0xa9, 0xa4, 0x6b, 0x95, 0x39, 0x66, 0xd6, 0x9f, 0x20, 0x98, 0x6e, 0x1e,
-	0x4a, 0xb0, 0xdd, 0xf2, 0xf5, 0x9f, 0x13, 0x5e, 0x69, 0x8f, 0xf0, 0x6a, 0x3e, 0x5f, 0x29, 0x55,
-	0x8a, 0xaa, 0xa3, 0x6b, 0x75, 0x60, 0x2f, 0x67, 0x6f, 0xd3, 0x3f, 0x8a, 0xc2, 0x94, 0x3f, 0x99,
-	0x3b, 0x45, 0x95, 0x16, 0xf4, 0x63, 0x6e, 0x35, 0x9e, 0x85, 0x11, 0xea, 0xa8, 0xb6, 0x63, 0x5a,
-	0x46, 0xb6, 0xa0, 0x9b, 0x46, 0xc1, 0x89, 0x45, 0xa7, 0xd1, 0xdc, 0x60, 0xe6, 0x39, 0xd7, 0x7c,
-	0x95, 0x59, 0xf1, 0x0c, 0x9c, 0xd6, 0x59, 0xb3, 0xdc, 0x6d, 0x03, 0x6c, 0xdb, 0xff, 0xb9, 0x51,
-	0x6c, 0xda, 0x00, 0xa8, 0xdf, 0xde, 0xb1, 0x41, 0x56, 0x9d, 0x73, 0xbe, 0xe9, 0xe0, 0x0f, 0x44,
-	0xfd, 0x32, 0x33, 0x74, 0x41, 0x28, 0xe3, 0xf1, 0xbc, 0xfc, 0xbf, 0x47, 0xfb, 0x89, 0xc8, 0xe3,
-	0xfd, 0x04, 0x92, 0xbf, 0x43, 0xf0, 0x42, 0x93, 0x3a, 0x88, 0x8e, 0xbc, 0x09, 0x27, 0x29, 0x37,
-	0xc5, 0x10, 0x1b, 0xc7, 0x0b, 0xed, 0xb5, 0x83, 0xe1, 0xac, 0xef, 0xe8, 0x96, 0xe3, 0x3b, 0x77,
-	0x02, 0x0b, 0xbf, 0xee, 0xa3, 0x12, 0x65, 0x54, 0x66, 0x5b, 0x52, 0xe1, 0x39, 0x79, 0xb9, 0xc8,
-	0xdf, 0xba, 0x0c, 0xd6, 0xf4, 0xa2, 0x6e, 0x30, 0x5b, 0xe3, 0xd4, 0x6a, 0x7c, 0xad, 0x93, 0x56,
-	0xd6, 0x5c, 0xdc, 0x56, 0x06, 0x9e, 0x88, 0x68, 0xa7, 0x27, 0x82, 0xd7, 0xfe, 0xd9, 0x7e, 0x22,
-	0x22, 0x7f, 0x86, 0x20, 0xde, 0x2c, 0x73, 0x51, 0xfc, 0xb2, 0x77, 0xf8, 0xfb, 0x79, 0x11, 0xd7,
-	0xee, 0x83, 0x0a, 0xc8, 0x47, 0x72, 0xba, 0x4b, 0x1c, 0xb5, 0xd8, 0x97, 0x92, 0x7a, 0x6a, 0xf1,
-	0x0f, 0x82, 0x99, 0xd0, 0xb8, 0xa2, 0x20, 0xef, 0x1e, 0x2d, 0xc8, 0xc5, 0xd0, 0xd3, 0x58, 0x47,
-	0x5b, 0x73, 0x63, 0x73, 0xc4, 0xa0, 0xbb, 0x10, 0x17, 0xe1, 0x84, 0x53, 0x0d, 0xda, 0xe7, 0x47,
-	0x8f, 0x07, 0x91, 0x6d, 0x71, 0xf3, 0xd6, 0x32, 0xab, 0x8d, 0x4e, 0xff, 0xca, 0x7c, 0x5d, 0x5c,
-	0xc1, 0x81, 0x31, 0x45, 0x89, 0xe3, 0x00, 0xb5, 0x43, 0xcb, 0xab, 0x3c, 0x9c, 0xf1, 0x58, 0x3c,
-	0x68, 0xbb, 0xf0, 0xa2, 0x1f, 0x6d, 0xd3, 0x74, 0x0a, 0x9a, 0xad, 0xee, 0x8a, 0xc0, 0x7d, 0xa3,
-	0xb1, 0x23, 0xa4, 0x58, 0xf3, 0xc0, 0x75, 0x61, 0xb4, 0x2b, 0x96, 0xda, 0x17, 0x46, 0xbb, 0x7e,
-	0x30, 0x4f, 0xdc, 0x49, 0x98, 0x60, 0x71, 0xab, 0xef, 0x4b, 0xc5, 0x32, 0x9d, 0xbd, 0x5b, 0x84,
-	0x14, 0x5d, 0xf9, 0xf9, 0x08, 0x81, 0x14, 0xb4, 0x2a, 0x52, 0x79, 0x0f, 0x06, 0xcb, 0x84, 0x14,
-	0xfb, 0x3c, 0xc7, 0x2c, 0xc6, 0xd2, 0xf7, 0x63, 0x70, 0x82, 0xa5, 0x82, 0x1f, 0x23, 0x18, 0xe2,
-	0x92, 0x16, 0x2b, 0xa1, 0x93, 0xd2, 0xa8, 0xa7, 0xa5, 0x0b, 0xed, 0x3b, 0x70, 0x8e, 0xf2, 0xfc,
-	0xc3, 0x1f, 0x7f, 0xff, 0x3c, 0x7a, 0x16, 0xcf, 0x28, 0x61, 0xf2, 0x9f, 0xeb, 0x69, 0xfc, 0x17,
-	0x82, 0x89, 0xa6, 0xd2, 0x16, 0xa7, 0x5a, 0x07, 0x6f, 0x25, 0xc4, 0xa5, 0x74, 0x4f, 0x18, 0x82,
-	0x53, 0x9a, 0x71, 0xba, 0x82, 0x57, 0x42, 0x39, 0xd5, 0xe7, 0x43, 0xb9, 0xdf, 0xf0, 0x4a, 0x3c,
-	0xc0, 0x1f, 0x46, 0x61, 0x32, 0x44, 0x99, 0xe1, 0xb5, 0x0e, 0x32, 0x6d, 0x2a, 0x4f, 0xa5, 0xf5,
-	0x1e, 0x51, 0x04, 0xe3, 0x4d, 0xc6, 0xf8, 0x36, 0xbe, 0xd9, 0x03, 0x63, 0x85, 0xd4, 0xf1, 0xdd,
-	0xbf, 0x25, 0xf0, 0x01, 0x82, 0x33, 0x01, 0xe2, 0x0f, 0xbf, 0xd2, 0x41, 0xde, 0x0d, 0xf2, 0x54,
-	0xba, 0xd2, 0xa5, 0xb7, 0x60, 0x7b, 0x83, 0xb1, 0xbd, 0x8a, 0x37, 0x7a, 0x61, 0x5b, 0x57, 0x96,
-	0xf8, 0x27, 0x04, 0xa3, 0x47, 0xc5, 0x14, 0x7e, 0xb9, 0x83, 0x1c, 0xfd, 0x42, 0x54, 0xba, 0xdc,
-	0x8d, 0xab, 0xe0, 0x76, 0x8d, 0x71, 0x5b, 0xc7, 0xe9, 0x5e, 0xb8, 0xb9, 0x8a, 0xed, 0x6f, 0x04,
-	0x63, 0x0d, 0x4a, 0x05, 0xb7, 0x91, 0x5e, 0x33, 0x61, 0x26, 0xad, 0x74, 0xe5, 0x2b, 0xb8, 0x65,
-	0x19, 0xb7, 0xb7, 0xf1, 0x66, 0x28, 0xb7, 0xda, 0x23, 0x42, 0x95, 0xfb, 0x0d, 0x6f, 0xd0, 0x03,
-	0x45, 0x9c, 0xcc, 0xc0, 0x99, 0x7d, 0x86, 0xe0, 0xf9, 0x60, 0x35, 0x82, 0x5f, 0xed, 0x24, 0xf1,
-	0x00, 0xfd, 0x24, 0xbd, 0xd6, 0x3d, 0x40, 0x47, 0xad, 0x6d, 0x8f, 0x3e, 0x1b, 0xcc, 0x00, 0x49,
-	0xd0, 0xce, 0x60, 0x36, 0x57, 0x2f, 0xed, 0x0c, 0x66, 0x88, 0x0e, 0x69, 0x73, 0x30, 0x5b, 0x30,
-	0xac, 0x9f, 0x6d, 0xfc, 0x2f, 0x82, 0x58, 0x33, 0xc1, 0x80, 0x57, 0x3b, 0xc8, 0x35, 0x58, 0xe5,
-	0x48, 0xa9, 0x5e, 0x20, 0x04, 0xe7, 0xbb, 0x8c, 0xf3, 0x0d, 0x7c, 0xbd, 0x17, 0xce, 0x47, 0x15,
-	0x0f, 0xfe, 0x06, 0xc1, 0x69, 0x9f, 0x28, 0xc1, 0x17, 0x5b, 0xe7, 0x1a, 0xa4, 0x71, 0xa4, 0x97,
-	0x3a, 0xf6, 0x13, 0xc4, 0x96, 0x19, 0xb1, 0x05, 0x3c, 0x1f, 0x4a, 0x2c, 0xef, 0xfa, 0x66, 0xab,
-	0x32, 0x26, 0x75, 0xed, 0xc9, 0x41, 0x1c, 0x3d, 0x3d, 0x88, 0xa3, 0xdf, 0x0e, 0xe2, 0xe8, 0xd3,
-	0xc3, 0x78, 0xe4, 0xe9, 0x61, 0x3c, 0xf2, 0xf3, 0x61, 0x3c, 0xf2, 0xce, 0x62, 0xa8, 0x30, 0xfa,
-	0xc0, 0x8f, 0xce, 0x74, 0x52, 0x6e, 0x88, 0xfd, 0xeb, 0x70, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x58, 0xdf, 0x9a, 0x20, 0x60, 0x15, 0x00, 0x00,
+	0x17, 0xf6, 0x38, 0x69, 0xd2, 0x4c, 0x7f, 0xfd, 0x25, 0x99, 0x04, 0xe4, 0x6c, 0x82, 0x1d, 0x36,
+	0xb4, 0x89, 0x1a, 0xc5, 0xdb, 0x24, 0x52, 0x29, 0x0d, 0x6d, 0x89, 0x9d, 0x84, 0x56, 0xa9, 0xda,
+	0xc6, 0x2d, 0x44, 0x80, 0x2a, 0x6b, 0xed, 0x9d, 0xd8, 0x0b, 0xf6, 0x8e, 0xb3, 0x3b, 0x9b, 0x10,
+	0x55, 0xbd, 0x14, 0x21, 0x15, 0x4e, 0x08, 0x2e, 0x3d, 0xe6, 0x88, 0x38, 0x21, 0x04, 0xe2, 0xc8,
+	0xb5, 0xc7, 0x0a, 0x24, 0xc4, 0x09, 0x50, 0x02, 0xa2, 0x08, 0x21, 0x71, 0xe3, 0x8a, 0x3c, 0x33,
+	0x6b, 0xef, 0xc6, 0xeb, 0xf5, 0xbf, 0xf8, 0xd2, 0xba, 0xb3, 0xf3, 0xbe, 0xef, 0x7d, 0xef, 0xcd,
+	0x9b, 0xfd, 0xb6, 0x70, 0x3a, 0x4b, 0xac, 0x22, 0xb1, 0x14, 0x4d, 0xb7, 0xa8, 0xa9, 0x67, 0x6c,
+	0xaa, 0x13, 0x43, 0xd9, 0x99, 0xcf, 0x60, 0xaa, 0xce, 0x2b, 0xdb, 0x36, 0x36, 0xf7, 0xe2, 0x25,
+	0x93, 0x50, 0x82, 0xc6, 0xf9, 0xc6, 0xb8, 0x7b, 0x63, 0x5c, 0x6c, 0x94, 0xce, 0x09, 0x94, 0x8c,
+	0x6a, 0x61, 0x1e, 0x55, 0xc1, 0x28, 0xa9, 0x39, 0xdd, 0x50, 0xd9, 0x6e, 0x06, 0x24, 0x8d, 0xe6,
+	0x48, 0x8e, 0xb0, 0x9f, 0x4a, 0xf9, 0x97, 0x58, 0x9d, 0xc8, 0x11, 0x92, 0x2b, 0x60, 0x45, 0x2d,
+	0xe9, 0x8a, 0x6a, 0x18, 0x84, 0xb2, 0x10, 0x4b, 0x3c, 0x8d, 0xba, 0xf1, 0x1d, 0xe4, 0x2c, 0xd1,
+	0x1d, 0xcc, 0x78, 0x90, 0x0a, 0x4f, 0xc6, 0x7c, 0xff, 0x18, 0xdf, 0x9f, 0xe6, 0x69, 0x08, 0x65,
+	0xfc, 0xd1, 0xb0, 0x5a, 0xd4, 0x0d, 0xa2, 0xb0, 0x3f, 0xf9, 0x92, 0x3c, 0x0a, 0xd1, 0x46, 0x59,
+	0xd3, 0x6d, 0xd5, 0x54, 0x8b, 0x56, 0x0a, 0x6f, 0xdb, 0xd8, 0xa2, 0xf2, 0x3d, 0x38, 0xe2, 0x59,
+	0xb5, 0x4a, 0xc4, 0xb0, 0x30, 0x5a, 0x83, 0x7d, 0x25, 0xb6, 0x12, 0x01, 0x93, 0x60, 0xe6, 0xd4,
+	0xc2, 0x54, 0x3c, 0xa0, 0x70, 0x71, 0x1e, 0x9c, 0x18, 0x78, 0xf2, 0x73, 0x2c, 0xf4, 0xf9, 0x1f,
+	0x5f, 0x9e, 0x03, 0x29, 0x11, 0x2d, 0x1b, 0xf0, 0x0c, 0x83, 0x7f, 0x53, 0x2d, 0xe8, 0x9a, 0x4a,
+	0x89, 0xb9, 0xe2, 0x8a, 0xbf, 0x6e, 0x6c, 0x11, 0x91, 0x07, 0x5a, 0x85, 0xc3, 0x3b, 0xce, 0x9e,
+	0xb4, 0xaa, 0x69, 0x26, 0xb6, 0x38, 0xf7, 0x40, 0x22, 0xf2, 0xfd, 0xd7, 0x73, 0xa3, 0x82, 0x7e,
+	0x99, 0x3f, 0xb9, 0x43, 0x4d, 0xdd, 0xc8, 0xa5, 0x86, 0x2a, 0x21, 0x62, 0x5d, 0xfe, 0x3d, 0x0c,
+	0xcf, 0x36, 0x22, 0x14, 0x12, 0x93, 0x70, 0x88, 0x94, 0xb0, 0xd9, 0x12, 0xe1, 0xa0, 0x13, 0x21,
+	0x96, 0xd1, 0x43, 0x00, 0x87, 0x2d, 0x5c, 0xd8, 0x4a, 0x67, 0x88, 0xa1, 0xa5, 0x4d, 0xbc, 0xab,
+	0x9a, 0x9a, 0x15, 0x09, 0x4f, 0xf6, 0xcc, 0x9c, 0x5a, 0x98, 0x70, 0x6a, 0x56, 0xee, 0x77, 0xa5,
+	0x56, 0x2b, 0x38, 0x9b, 0x24, 0xba, 0x91, 0xb8, 0x58, 0x2e, 0xd6, 0x17, 0xbf, 0xc4, 0x66, 0x73,
+	0x3a, 0xcd, 0xdb, 0x99, 0x78, 0x96, 0x14, 0x45, 0x0b, 0xc5, 0x5f, 0x73, 0x96, 0xf6, 0x9e, 0x42,
+	0xf7, 0x4a, 0xd8, 0x72, 0x62, 0x2c, 0x5e, 0xdb, 0xc1, 0x32, 0x61, 0x82, 0x18, 0x5a, 0x8a, 0xd3,
+	0xa1, 0x6d, 0x08, 0xb3, 0xa4, 0x58, 0xd4, 0x2d, 0x4b, 0x27, 0x46, 0xa4, 0xa7, 0x09, 0xf2, 0xc5,
+	0x36, 0xc8, 0x53, 0x2e, 0x12, 0xb9, 0x04, 0xa7, 0xbd, 0x65, 0xbe, 0x65, 0x53, 0x8b, 0xaa, 0x86,
+	0x56, 0xae, 0x12, 0x4f, 0xeb, 0x98, 0x3b, 0xfb, 0x11, 0x80, 0x33, 0x8d, 0x29, 0x45, 0x6f, 0xef,
+	0xc1, 0x7e, 0xa7, 0x17, 0xfc, 0xfc, 0x5e, 0x0c, 0x3c, 0xbf, 0x01, 0x90, 0xee, 0x43, 0xed, 0x60,
+	0xca, 0x79, 0x18, 0xf3, 0xa6, 0x92, 0xac, 0x54, 0xe6, 0x98, 0x55, 0x7f, 0x0c, 0xe0, 0x64, 0x7d,
+	0x2a, 0xa1, 0x76, 0xcb, 0xd3, 0x7f, 0x2e, 0x78, 0xa9, 0x39, 0xc1, 0xcb, 0xd9, 0xac, 0x5d, 0xb4,
+	0x0b, 0x2a, 0xc5, 0x5a, 0x15, 0xd8, 0xad, 0xd9, 0xdd, 0xf4, 0x0f, 0xc3, 0x70, 0xc2, 0x9b, 0xcc,
+	0x9d, 0x82, 0x6a, 0xe5, 0xf1, 0x31, 0xb7, 0x1a, 0x4d, 0xc3, 0x41, 0x8b, 0xaa, 0x26, 0xd5, 0x8d,
+	0x5c, 0x3a, 0x8f, 0xf5, 0x5c, 0x9e, 0x46, 0xc2, 0x93, 0x60, 0xa6, 0x37, 0xf5, 0x7f, 0x67, 0xf9,
+	0x1a, 0x5b, 0x45, 0x53, 0xf0, 0x34, 0x66, 0xcd, 0x72, 0xb6, 0xf5, 0xb0, 0x6d, 0xff, 0xe3, 0x8b,
+	0x62, 0xd3, 0x1a, 0x84, 0xd5, 0xdb, 0x3b, 0xd2, 0xcb, 0xaa, 0x73, 0xd6, 0x33, 0x1d, 0xfc, 0x05,
+	0x51, 0xbd, 0xcc, 0x72, 0x58, 0x08, 0x4a, 0xb9, 0x22, 0x2f, 0x9d, 0x7c, 0xb4, 0x1f, 0x0b, 0x3d,
+	0xde, 0x8f, 0x01, 0xf9, 0x3b, 0x00, 0x5f, 0xa8, 0x53, 0x07, 0xd1, 0x91, 0x37, 0x60, 0xbf, 0xc5,
+	0x97, 0x22, 0x80, 0x8d, 0xe3, 0xf9, 0xe6, 0xda, 0xc1, 0x70, 0x56, 0x77, 0xb0, 0x41, 0x3d, 0xe7,
+	0x4e, 0x60, 0xa1, 0xd7, 0x3d, 0x52, 0xc2, 0x4c, 0xca, 0x74, 0x43, 0x29, 0x3c, 0x27, 0xb7, 0x16,
+	0xf9, 0x5b, 0x47, 0xc1, 0x0a, 0x2e, 0xe0, 0x1c, 0x5b, 0xab, 0x9d, 0x5a, 0x8d, 0x3f, 0x6b, 0xa5,
+	0x95, 0x95, 0x10, 0xa7, 0x95, 0xbe, 0x27, 0x22, 0xdc, 0xea, 0x89, 0xe0, 0xb5, 0x7f, 0xb6, 0x1f,
+	0x0b, 0xc9, 0x9f, 0x02, 0x18, 0xad, 0x97, 0xb9, 0x28, 0x7e, 0xc9, 0x3d, 0xfc, 0xdd, 0xbc, 0x88,
+	0x2b, 0xf7, 0x81, 0x0d, 0xe5, 0x23, 0x39, 0xdd, 0x25, 0x54, 0x2d, 0x74, 0xa5, 0xa4, 0xae, 0x5a,
+	0xfc, 0x03, 0xe0, 0x54, 0x20, 0xaf, 0x28, 0xc8, 0x3b, 0x47, 0x0b, 0x72, 0x21, 0xf0, 0x34, 0x56,
+	0xd1, 0x56, 0x1c, 0x6e, 0x8e, 0xe8, 0x77, 0x17, 0xa2, 0x02, 0x3c, 0x41, 0xcb, 0xa4, 0x5d, 0x7e,
+	0xe9, 0x71, 0x12, 0xd9, 0x14, 0x37, 0x6f, 0x25, 0xb3, 0xca, 0xe8, 0x74, 0xaf, 0xcc, 0x37, 0xc4,
+	0x15, 0xec, 0xcb, 0x29, 0x4a, 0x1c, 0x85, 0xb0, 0x72, 0x68, 0x79, 0x95, 0x07, 0x52, 0xae, 0x15,
+	0x17, 0xda, 0x2e, 0x7c, 0xc9, 0x8b, 0xb6, 0xa9, 0xd3, 0xbc, 0x66, 0xaa, 0xbb, 0x82, 0xb8, 0x6b,
+	0x32, 0x76, 0x84, 0x15, 0xab, 0x4f, 0x5c, 0x35, 0x46, 0xbb, 0xe2, 0x51, 0xf3, 0xc6, 0x68, 0xd7,
+	0x0b, 0xe6, 0xe2, 0x1d, 0x87, 0x63, 0x8c, 0xb7, 0xfc, 0x7e, 0xb1, 0x0d, 0x9d, 0xee, 0xdd, 0x26,
+	0xa4, 0xe0, 0xd8, 0xcf, 0x47, 0x00, 0x4a, 0x7e, 0x4f, 0x45, 0x2a, 0xef, 0xc2, 0xde, 0x12, 0x21,
+	0x85, 0x2e, 0xcf, 0x31, 0xe3, 0x90, 0x29, 0x7c, 0xd1, 0x5b, 0x9f, 0x65, 0x9b, 0x92, 0x24, 0x29,
+	0x96, 0x88, 0x5d, 0xb6, 0x5a, 0x5d, 0xea, 0xca, 0x15, 0xef, 0xd5, 0x71, 0x94, 0x55, 0xd4, 0x21,
+	0x02, 0xfb, 0xb1, 0xa1, 0x66, 0x0a, 0x58, 0x63, 0x64, 0x27, 0x53, 0xce, 0x3f, 0x17, 0xbe, 0x1a,
+	0x81, 0x27, 0x18, 0x00, 0x7a, 0x0c, 0x60, 0x1f, 0x37, 0xe2, 0x48, 0x09, 0x9c, 0xef, 0xda, 0xaf,
+	0x00, 0xe9, 0x7c, 0xf3, 0x01, 0x3c, 0x23, 0x79, 0xf6, 0xe1, 0x0f, 0xbf, 0x7d, 0x16, 0x3e, 0x83,
+	0xa6, 0x94, 0xa0, 0x8f, 0x16, 0xfe, 0x15, 0x80, 0xfe, 0x04, 0x70, 0xac, 0xae, 0x21, 0x47, 0x89,
+	0xc6, 0xe4, 0x8d, 0x3e, 0x1f, 0xa4, 0x64, 0x47, 0x18, 0x42, 0x53, 0x92, 0x69, 0xba, 0x8c, 0x96,
+	0x02, 0x35, 0x55, 0xa7, 0x5a, 0xb9, 0x5f, 0xf3, 0x6e, 0x7b, 0x80, 0x3e, 0x08, 0xc3, 0xf1, 0x00,
+	0x3f, 0x89, 0x56, 0x5a, 0xc8, 0xb4, 0xae, 0xa9, 0x96, 0x56, 0x3b, 0x44, 0x11, 0x8a, 0x37, 0x99,
+	0xe2, 0x0d, 0x74, 0xab, 0x03, 0xc5, 0x0a, 0xa9, 0xe2, 0x3b, 0x5f, 0x40, 0xe8, 0x00, 0xc0, 0x11,
+	0x1f, 0xcb, 0x8a, 0x5e, 0x6d, 0x21, 0xef, 0x1a, 0x53, 0x2d, 0x5d, 0x6e, 0x33, 0x5a, 0xa8, 0xbd,
+	0xc9, 0xd4, 0x5e, 0x43, 0x6b, 0x9d, 0xa8, 0xad, 0xfa, 0x61, 0xf4, 0x23, 0x80, 0x43, 0x47, 0x2d,
+	0x20, 0x7a, 0xa5, 0x85, 0x1c, 0xbd, 0xf6, 0x59, 0xba, 0xd4, 0x4e, 0xa8, 0xd0, 0xb6, 0xce, 0xb4,
+	0xad, 0xa2, 0x64, 0x27, 0xda, 0x1c, 0x9f, 0xf9, 0x37, 0x80, 0xc3, 0x35, 0xfe, 0x0a, 0x35, 0x91,
+	0x5e, 0x3d, 0x3b, 0x29, 0x2d, 0xb5, 0x15, 0x2b, 0xb4, 0xa5, 0x99, 0xb6, 0xb7, 0xd0, 0x66, 0xa0,
+	0xb6, 0xca, 0x25, 0x6b, 0x29, 0xf7, 0x6b, 0xee, 0xe8, 0x07, 0x8a, 0x38, 0x99, 0xbe, 0x33, 0xfb,
+	0x0c, 0xc0, 0xe7, 0xfd, 0x3d, 0x14, 0xba, 0xda, 0x4a, 0xe2, 0x3e, 0xae, 0x4f, 0x7a, 0xad, 0x7d,
+	0x80, 0x96, 0x5a, 0xdb, 0x9c, 0x7c, 0x36, 0x98, 0x3e, 0x46, 0xa6, 0x99, 0xc1, 0xac, 0xef, 0xb9,
+	0x9a, 0x19, 0xcc, 0x00, 0xf7, 0xd4, 0xe4, 0x60, 0x36, 0x50, 0x58, 0x3d, 0xdb, 0xe8, 0x5f, 0x00,
+	0x23, 0xf5, 0x6c, 0x0e, 0x5a, 0x6e, 0x21, 0x57, 0x7f, 0x6f, 0x26, 0x25, 0x3a, 0x81, 0x10, 0x9a,
+	0xef, 0x32, 0xcd, 0x37, 0xd1, 0x8d, 0x4e, 0x34, 0x1f, 0xf5, 0x69, 0xe8, 0x1b, 0x00, 0x4f, 0x7b,
+	0xac, 0x14, 0xba, 0xd0, 0x38, 0x57, 0x3f, 0x67, 0x26, 0xbd, 0xdc, 0x72, 0x9c, 0x10, 0xb6, 0xc8,
+	0x84, 0xcd, 0xa1, 0xd9, 0x40, 0x61, 0x59, 0x27, 0x36, 0x5d, 0x36, 0x5f, 0xe8, 0x2f, 0x00, 0x9f,
+	0xf3, 0xb5, 0x40, 0xe8, 0x4a, 0x0b, 0xb5, 0xf6, 0x71, 0x6c, 0xd2, 0xd5, 0xb6, 0xe3, 0x85, 0x9e,
+	0x0d, 0xa6, 0x67, 0x1d, 0x5d, 0xef, 0xa4, 0x51, 0xaa, 0x4d, 0x49, 0x3a, 0x2b, 0xa0, 0x13, 0xeb,
+	0x4f, 0x0e, 0xa2, 0xe0, 0xe9, 0x41, 0x14, 0xfc, 0x7a, 0x10, 0x05, 0x9f, 0x1c, 0x46, 0x43, 0x4f,
+	0x0f, 0xa3, 0xa1, 0x9f, 0x0e, 0xa3, 0xa1, 0xb7, 0xe7, 0x03, 0xcd, 0xeb, 0xfb, 0x5e, 0x6e, 0xe6,
+	0x65, 0x33, 0x7d, 0xec, 0xbf, 0x77, 0x17, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x55, 0x54, 0x92,
+	0xa4, 0x04, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1095,6 +1189,8 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// DelegatorAutoCompound queries whether the auto compounding of the rewards of a delegator is enabled.
+	DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error)
 }
 
 type queryClient struct {
@@ -1195,6 +1291,15 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error) {
+	out := new(QueryDelegatorAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1218,6 +1323,8 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// DelegatorAutoCompound queries whether the auto compounding of the rewards of a delegator is enabled.
+	DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1254,6 +1361,9 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorAutoCompound(ctx context.Context, req *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompound not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1439,6 +1549,24 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, req.(*QueryDelegatorAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1483,6 +1611,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompound",
+			Handler:    _Query_DelegatorAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2196,6 +2328,69 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2499,6 +2694,31 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4336,6 +4556,158 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
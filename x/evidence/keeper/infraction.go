@@ -3,10 +3,14 @@ package keeper
 import (
 	"fmt"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // HandleEquivocationEvidence implements an equivocation evidence handler. Assuming the
@@ -126,3 +130,119 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	k.slashingKeeper.Tombstone(ctx, consAddr)
 	k.SetEvidence(ctx, evidence)
 }
+
+// NewRelayerDoubleSignHandler returns the Evidence Handler of the RelayerDoubleSign
+// evidence, which is registered in the evidence router by the app.
+func NewRelayerDoubleSignHandler(k Keeper) types.Handler {
+	return func(ctx sdk.Context, e exported.Evidence) error {
+		evidence, ok := e.(*types.RelayerDoubleSign)
+		if !ok {
+			return fmt.Errorf("unexpected evidence type: %T", e)
+		}
+
+		return k.HandleRelayerDoubleSignEvidence(ctx, evidence)
+	}
+}
+
+// HandleRelayerDoubleSignEvidence implements a relayer double sign evidence handler.
+// Assuming the two claims are both signed by the BLS key the validator had at the
+// height of the evidence, the validator will be slashed at the height, jailed and
+// tombstoned in the same way as for an equivocation.
+//
+// The evidence is considered invalid if:
+// - the height is in the future or older than the max age of the evidence
+// - the validator is unbonded or does not exist
+// - any of the BLS signatures can't be verified by the BLS key of the validator at the height
+// - the signing info does not exist
+// - the validator is already tombstoned
+func (k Keeper) HandleRelayerDoubleSignEvidence(ctx sdk.Context, evidence *types.RelayerDoubleSign) error {
+	if !ctx.IsUpgraded(upgradetypes.Nagqu) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relayer double sign evidence is not enabled")
+	}
+
+	if err := evidence.ValidateBasic(); err != nil {
+		return err
+	}
+
+	infractionHeight := evidence.GetHeight()
+	if infractionHeight > ctx.BlockHeight() {
+		return fmt.Errorf("relayer double sign height %d is in the future", infractionHeight)
+	}
+
+	// reject the evidence if it's older than the max age of the evidence, which corresponds to the unbonding
+	// period, as the stake which has been unbonded since can't be slashed anymore.
+	cp := ctx.ConsensusParams()
+	if cp != nil && cp.Evidence != nil && ctx.BlockHeight()-infractionHeight > cp.Evidence.MaxAgeNumBlocks {
+		return fmt.Errorf(
+			"relayer double sign height %d is older than the max age of %d blocks", infractionHeight, cp.Evidence.MaxAgeNumBlocks,
+		)
+	}
+
+	validator := k.stakingKeeper.Validator(ctx, evidence.GetValidatorAddress())
+	if validator == nil || validator.IsUnbonded() {
+		return fmt.Errorf("validator %s is unbonded or does not exist", evidence.ValidatorAddress)
+	}
+
+	// the claims are verified with the bls key the validator had at the height, as it may have been rotated since
+	keys, found := k.stakingKeeper.GetValidatorKeysAtHeight(ctx, evidence.GetValidatorAddress(), infractionHeight)
+	if !found {
+		return fmt.Errorf("bls key of validator %s at height %d is unknown", evidence.ValidatorAddress, infractionHeight)
+	}
+
+	pubKey, err := bls.PublicKeyFromBytes(keys.BlsKey)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidBlsSignature, "invalid bls key of validator %s: %v", evidence.ValidatorAddress, err)
+	}
+	for _, vote := range []types.ClaimVote{evidence.VoteA, evidence.VoteB} {
+		sig, err := bls.SignatureFromBytes(vote.Signature)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidBlsSignature, "BLS signature converts failed: %v", err)
+		}
+		signBytes := vote.GetBlsSignBytes()
+		if !sig.Verify(pubKey, signBytes[:]) {
+			return sdkerrors.Wrapf(types.ErrInvalidBlsSignature, "signature is not signed by validator %s", evidence.ValidatorAddress)
+		}
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
+		return fmt.Errorf("expected signing info for validator %s but not found", consAddr)
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return fmt.Errorf("validator %s is already tombstoned", consAddr)
+	}
+
+	k.Logger(ctx).Info(
+		"confirmed relayer double sign",
+		"validator", evidence.ValidatorAddress,
+		"infraction_height", infractionHeight,
+		"src_chain_id", evidence.VoteA.SrcChainId,
+		"sequence", evidence.VoteA.Sequence,
+	)
+
+	// The power is derived from the tokens since the validator may be jailed and
+	// have no consensus power.
+	power := sdk.TokensToConsensusPower(validator.GetTokens(), k.stakingKeeper.PowerReduction(ctx))
+	k.slashingKeeper.SlashWithInfractionReason(
+		ctx,
+		consAddr,
+		k.slashingKeeper.SlashFractionDoubleSign(ctx),
+		power, infractionHeight,
+		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+	)
+
+	// Jail the validator if not already jailed. This will begin unbonding the
+	// validator if not already unbonding (tombstoned).
+	if !validator.IsJailed() {
+		k.slashingKeeper.Jail(ctx, consAddr)
+	}
+
+	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
+	k.slashingKeeper.Tombstone(ctx, consAddr)
+	return nil
+}
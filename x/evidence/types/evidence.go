@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
@@ -11,15 +12,21 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	oracletypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
 )
 
 // Evidence type constants
 const (
-	RouteEquivocation = "equivocation"
-	TypeEquivocation  = "equivocation"
+	RouteEquivocation      = "equivocation"
+	TypeEquivocation       = "equivocation"
+	RouteRelayerDoubleSign = "relayerdoublesign"
+	TypeRelayerDoubleSign  = "relayerdoublesign"
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence = &Equivocation{}
+	_ exported.Evidence = &RelayerDoubleSign{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -97,3 +104,90 @@ func FromABCIEvidence(e abci.Misbehavior) exported.Evidence {
 		Time:             e.Time,
 	}
 }
+
+// Route returns the Evidence Handler route for a RelayerDoubleSign type.
+func (e *RelayerDoubleSign) Route() string { return RouteRelayerDoubleSign }
+
+// Type returns the Evidence Handler type for a RelayerDoubleSign type.
+func (e *RelayerDoubleSign) Type() string { return TypeRelayerDoubleSign }
+
+func (e *RelayerDoubleSign) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a RelayerDoubleSign object.
+func (e *RelayerDoubleSign) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a RelayerDoubleSign
+// object. The two claims must be signed for the same source chain, destination
+// chain and sequence, but carry different payloads.
+func (e *RelayerDoubleSign) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(e.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid relayer double sign validator address: %w", err)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid relayer double sign height: %d", e.Height)
+	}
+	if err := e.VoteA.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid relayer double sign vote a: %w", err)
+	}
+	if err := e.VoteB.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid relayer double sign vote b: %w", err)
+	}
+	if e.VoteA.SrcChainId != e.VoteB.SrcChainId || e.VoteA.DestChainId != e.VoteB.DestChainId ||
+		e.VoteA.Sequence != e.VoteB.Sequence {
+		return fmt.Errorf("relayer double sign votes are not for the same chains and sequence")
+	}
+	if bytes.Equal(e.VoteA.Payload, e.VoteB.Payload) {
+		return fmt.Errorf("relayer double sign votes have the same payload")
+	}
+
+	return nil
+}
+
+// GetValidatorAddress returns the operator address of the validator whose BLS key
+// signed the conflicting claims.
+func (e RelayerDoubleSign) GetValidatorAddress() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromHexUnsafe(e.ValidatorAddress)
+	return addr
+}
+
+// GetHeight returns the height at which the claims were signed.
+func (e RelayerDoubleSign) GetHeight() int64 {
+	return e.Height
+}
+
+// ValidateBasic performs basic stateless validation checks on a ClaimVote object.
+func (v ClaimVote) ValidateBasic() error {
+	if len(v.Payload) == 0 {
+		return fmt.Errorf("payload should not be empty")
+	}
+	if v.Timestamp == 0 {
+		return fmt.Errorf("timestamp should not be 0")
+	}
+	if len(v.Signature) != oracletypes.BLSSignatureLength {
+		return fmt.Errorf("length of signature should be %d", oracletypes.BLSSignatureLength)
+	}
+
+	return nil
+}
+
+// GetBlsSignBytes returns the digest of the claim signed by the BLS key, which is
+// the same as the one of the corresponding MsgClaim.
+func (v ClaimVote) GetBlsSignBytes() [32]byte {
+	claim := &oracletypes.BlsClaim{
+		SrcChainId:  v.SrcChainId,
+		DestChainId: v.DestChainId,
+		Timestamp:   v.Timestamp,
+		Sequence:    v.Sequence,
+		Payload:     v.Payload,
+	}
+	return claim.GetSignBytes()
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -74,8 +75,148 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// RelayerDoubleSign implements the Evidence interface and defines evidence of a
+// relayer BLS key signing two conflicting cross chain claims for the same source
+// chain, destination chain and sequence.
+type RelayerDoubleSign struct {
+	// validator_address is the operator address of the validator whose BLS key signed the claims.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// vote_a is the first BLS-signed claim.
+	VoteA ClaimVote `protobuf:"bytes,2,opt,name=vote_a,json=voteA,proto3" json:"vote_a"`
+	// vote_b is the second BLS-signed claim conflicting with vote_a.
+	VoteB ClaimVote `protobuf:"bytes,3,opt,name=vote_b,json=voteB,proto3" json:"vote_b"`
+	// height is the height at which the claims were signed, the claims are verified with the BLS key of the
+	// validator at the height and the validator is slashed at the height.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RelayerDoubleSign) Reset()      { *m = RelayerDoubleSign{} }
+func (*RelayerDoubleSign) ProtoMessage() {}
+func (*RelayerDoubleSign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *RelayerDoubleSign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerDoubleSign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerDoubleSign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerDoubleSign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerDoubleSign.Merge(m, src)
+}
+func (m *RelayerDoubleSign) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerDoubleSign) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerDoubleSign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerDoubleSign proto.InternalMessageInfo
+
+// ClaimVote defines a cross chain claim signed by the BLS key of a relayer.
+type ClaimVote struct {
+	// src_chain_id is the source chain id of the claim.
+	SrcChainId uint32 `protobuf:"varint,1,opt,name=src_chain_id,json=srcChainId,proto3" json:"src_chain_id,omitempty"`
+	// dest_chain_id is the destination chain id of the claim.
+	DestChainId uint32 `protobuf:"varint,2,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// sequence is the sequence of the claim.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// timestamp is the timestamp of the claim.
+	Timestamp uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// payload is the payload of the claim.
+	Payload []byte `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	// signature is the BLS signature of the claim.
+	Signature []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ClaimVote) Reset()         { *m = ClaimVote{} }
+func (m *ClaimVote) String() string { return proto.CompactTextString(m) }
+func (*ClaimVote) ProtoMessage()    {}
+func (*ClaimVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{2}
+}
+func (m *ClaimVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimVote.Merge(m, src)
+}
+func (m *ClaimVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimVote proto.InternalMessageInfo
+
+func (m *ClaimVote) GetSrcChainId() uint32 {
+	if m != nil {
+		return m.SrcChainId
+	}
+	return 0
+}
+
+func (m *ClaimVote) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *ClaimVote) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ClaimVote) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ClaimVote) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *ClaimVote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*RelayerDoubleSign)(nil), "cosmos.evidence.v1beta1.RelayerDoubleSign")
+	proto.RegisterType((*ClaimVote)(nil), "cosmos.evidence.v1beta1.ClaimVote")
 }
 
 func init() {
@@ -83,32 +224,83 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0x84, 0x0b, 0xe8, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x43, 0xd4, 0xe9,
-	0xc1, 0x85, 0xa1, 0xea, 0xa4, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0xad,
-	0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xe5, 0xd3, 0xf3, 0xf3,
-	0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2, 0x34, 0xfd, 0x92, 0xcc, 0xdc, 0xd4, 0xe2, 0x92,
-	0xc4, 0xdc, 0x02, 0xa8, 0x02, 0x49, 0x88, 0x15, 0xf1, 0x10, 0x9d, 0x50, 0xfb, 0xc0, 0x1c, 0xa5,
-	0x37, 0x8c, 0x5c, 0x3c, 0xae, 0x85, 0xa5, 0x99, 0x65, 0xf9, 0xc9, 0x89, 0x25, 0x99, 0xf9, 0x79,
-	0x42, 0x62, 0x5c, 0x6c, 0x19, 0xa9, 0x99, 0xe9, 0x19, 0x25, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc,
-	0x41, 0x50, 0x9e, 0x90, 0x2d, 0x17, 0x0b, 0xc8, 0x58, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x29, 0x3d, 0x88, 0x9d, 0x7a, 0x30, 0x3b, 0xf5, 0x42, 0x60, 0x76, 0x3a, 0xf1, 0x9e, 0xb8, 0x27,
-	0xcf, 0x30, 0xe1, 0xbe, 0x3c, 0xe3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xc0, 0xda, 0x84, 0x44,
-	0xb8, 0x58, 0x0b, 0xf2, 0xcb, 0x53, 0x8b, 0x24, 0x98, 0xc1, 0xa6, 0x42, 0x38, 0x42, 0xae, 0x5c,
-	0x82, 0xc9, 0xf9, 0x79, 0xc5, 0xa9, 0x79, 0xc5, 0xa5, 0xc5, 0xf1, 0x89, 0x29, 0x29, 0x45, 0xa9,
-	0xc5, 0xc5, 0x12, 0x2c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0x9d,
-	0xea, 0x08, 0x91, 0x09, 0x2e, 0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x12, 0x80, 0x6b, 0x81, 0x8a, 0x5b,
-	0x69, 0x74, 0x2c, 0x90, 0x67, 0x98, 0xb1, 0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x86, 0xae, 0xe7,
-	0x1b, 0xb4, 0xa0, 0x61, 0xaa, 0x5b, 0x9c, 0x92, 0xad, 0x8f, 0xec, 0x3b, 0x27, 0xef, 0x15, 0x8f,
-	0xe4, 0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
-	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x37, 0x3d, 0xb3,
-	0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17, 0x1a, 0x4a, 0xfa, 0x48, 0x06, 0x55, 0x20, 0xa2,
-	0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x79, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x8d, 0x61, 0xa8, 0x30, 0xea, 0x01, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xf5, 0xb5, 0x49, 0x20, 0xd7, 0x44, 0x22, 0xa7, 0x08, 0x4c, 0x54, 0xd9, 0x51, 0x06, 0x14,
+	0x55, 0x8a, 0xad, 0xc2, 0x56, 0x89, 0xa1, 0x69, 0x3b, 0x20, 0x36, 0x17, 0x31, 0xb0, 0x44, 0x67,
+	0xfb, 0x70, 0x4e, 0xd8, 0xbe, 0xd4, 0x77, 0x36, 0xe4, 0x1f, 0x20, 0xa6, 0x8e, 0x8c, 0x19, 0xbb,
+	0x20, 0x75, 0xe0, 0x1f, 0xb0, 0x74, 0xac, 0x98, 0x98, 0x00, 0x25, 0x43, 0x19, 0xf8, 0x11, 0xc8,
+	0xe7, 0x8b, 0x63, 0x81, 0x10, 0x12, 0x8b, 0xe5, 0xef, 0xdd, 0x7b, 0xdf, 0xfb, 0xee, 0xdd, 0x1d,
+	0x7c, 0xe0, 0x31, 0x1e, 0x31, 0x6e, 0x93, 0x8c, 0xfa, 0x24, 0xf6, 0x88, 0x9d, 0xed, 0xbb, 0x44,
+	0xe0, 0xfd, 0x12, 0xb0, 0x66, 0x09, 0x13, 0x0c, 0xdd, 0x2b, 0x78, 0x56, 0x09, 0x2b, 0x5e, 0xaf,
+	0x83, 0x23, 0x1a, 0x33, 0x5b, 0x7e, 0x0b, 0x6e, 0xaf, 0x1b, 0xb0, 0x80, 0xc9, 0x5f, 0x3b, 0xff,
+	0x53, 0xa8, 0x19, 0x30, 0x16, 0x84, 0xc4, 0x96, 0x95, 0x9b, 0xbe, 0xb4, 0x05, 0x8d, 0x08, 0x17,
+	0x38, 0x9a, 0x29, 0xc2, 0xfd, 0xc2, 0x62, 0x52, 0x28, 0x95, 0x9f, 0x2c, 0x06, 0x3f, 0x01, 0x6c,
+	0x9d, 0x9c, 0xa5, 0x34, 0x63, 0x1e, 0x16, 0x94, 0xc5, 0xe8, 0x2e, 0x6c, 0x4c, 0x09, 0x0d, 0xa6,
+	0x42, 0x07, 0x7d, 0x30, 0xdc, 0x76, 0x54, 0x85, 0x1e, 0xc3, 0x5a, 0xde, 0x56, 0xdf, 0xea, 0x83,
+	0xe1, 0xce, 0xc3, 0x9e, 0x55, 0x78, 0x5a, 0x6b, 0x4f, 0xeb, 0xd9, 0xda, 0x73, 0xdc, 0xbe, 0xfa,
+	0x6a, 0x6a, 0xe7, 0xdf, 0x4c, 0x70, 0x71, 0x73, 0xb9, 0x07, 0x1c, 0x29, 0x43, 0x5d, 0x58, 0x9f,
+	0xb1, 0xd7, 0x24, 0xd1, 0xb7, 0x65, 0xd7, 0xa2, 0x40, 0x27, 0xb0, 0xe3, 0xb1, 0x98, 0x93, 0x98,
+	0xa7, 0x7c, 0x82, 0x7d, 0x3f, 0x21, 0x9c, 0xeb, 0xb5, 0x3e, 0x18, 0x36, 0xc7, 0xfa, 0xe7, 0x8f,
+	0xa3, 0xae, 0x1a, 0xf5, 0xb0, 0x58, 0x39, 0x15, 0x09, 0x8d, 0x03, 0xe7, 0x4e, 0x29, 0x51, 0xf8,
+	0xc1, 0xf0, 0xed, 0xc2, 0xd4, 0xde, 0x2f, 0x4c, 0xed, 0xc7, 0xc2, 0xd4, 0xde, 0xdd, 0x5c, 0xee,
+	0xa9, 0x4c, 0x47, 0xdc, 0x7f, 0x65, 0x57, 0x77, 0x37, 0xf8, 0xb0, 0x05, 0x3b, 0x0e, 0x09, 0xf1,
+	0x9c, 0x24, 0xc7, 0x2c, 0x75, 0x43, 0x72, 0x4a, 0x83, 0x38, 0x1f, 0x23, 0xc3, 0x21, 0xf5, 0xb1,
+	0x60, 0x49, 0x39, 0x06, 0xf8, 0xd7, 0x18, 0xa5, 0x44, 0xe1, 0xe8, 0x18, 0x36, 0x32, 0x26, 0xc8,
+	0x04, 0xab, 0x90, 0x06, 0xd6, 0x5f, 0x8e, 0xd6, 0x3a, 0x0a, 0x31, 0x8d, 0x9e, 0x33, 0x41, 0xc6,
+	0xcd, 0x3c, 0xac, 0x22, 0xa8, 0x7a, 0x2e, 0x3e, 0x2c, 0xbb, 0xb8, 0x32, 0xaa, 0xff, 0xeb, 0x32,
+	0xae, 0x1c, 0x63, 0xad, 0x7a, 0x8c, 0x07, 0xa3, 0xdf, 0xa3, 0xda, 0xad, 0x44, 0xf5, 0x47, 0x32,
+	0x83, 0x4f, 0x00, 0x36, 0x4b, 0x1b, 0xd4, 0x87, 0x2d, 0x9e, 0x78, 0x13, 0x6f, 0x8a, 0x69, 0x3c,
+	0xa1, 0xbe, 0x8c, 0xa8, 0xed, 0x40, 0x9e, 0x78, 0x47, 0x39, 0xf4, 0xc4, 0x47, 0x03, 0xd8, 0xf6,
+	0x09, 0x17, 0x1b, 0xca, 0x96, 0xa4, 0xec, 0xe4, 0xe0, 0x9a, 0xd3, 0x83, 0xb7, 0x39, 0x39, 0x4b,
+	0xf3, 0xad, 0xc8, 0x2d, 0xd6, 0x9c, 0xb2, 0x46, 0xbb, 0xb0, 0x59, 0x5e, 0x5e, 0x39, 0x79, 0xcd,
+	0xd9, 0x00, 0x48, 0x87, 0xb7, 0x66, 0x78, 0x1e, 0x32, 0xec, 0xeb, 0xf5, 0x3e, 0x18, 0xb6, 0x9c,
+	0x75, 0x99, 0xeb, 0x38, 0x0d, 0x62, 0x2c, 0xd2, 0x84, 0xe8, 0x0d, 0xb9, 0xb6, 0x01, 0xc6, 0x4f,
+	0x2f, 0x96, 0x06, 0xb8, 0x5a, 0x1a, 0xe0, 0x7a, 0x69, 0x80, 0xef, 0x4b, 0x03, 0x9c, 0xaf, 0x0c,
+	0xed, 0x7a, 0x65, 0x68, 0x5f, 0x56, 0x86, 0xf6, 0x62, 0x14, 0x50, 0x31, 0x4d, 0x5d, 0xcb, 0x63,
+	0x91, 0x7a, 0x1b, 0x76, 0x25, 0x93, 0x37, 0x9b, 0x07, 0x2c, 0xe6, 0x33, 0xc2, 0xdd, 0x86, 0xbc,
+	0xf2, 0x8f, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x15, 0xb6, 0x24, 0xaa, 0xe0, 0x03, 0x00, 0x00,
 }
 
+func (this *ClaimVote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClaimVote)
+	if !ok {
+		that2, ok := that.(ClaimVote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SrcChainId != that1.SrcChainId {
+		return false
+	}
+	if this.DestChainId != that1.DestChainId {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -157,6 +349,118 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RelayerDoubleSign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerDoubleSign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerDoubleSign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.VoteB.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.VoteA.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SrcChainId != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.SrcChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +493,55 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *RelayerDoubleSign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = m.VoteA.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	l = m.VoteB.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ClaimVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SrcChainId != 0 {
+		n += 1 + sovEvidence(uint64(m.SrcChainId))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovEvidence(uint64(m.DestChainId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvidence(uint64(m.Sequence))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovEvidence(uint64(m.Timestamp))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +701,367 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RelayerDoubleSign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerDoubleSign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerDoubleSign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChainId", wireType)
+			}
+			m.SrcChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
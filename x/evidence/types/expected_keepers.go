@@ -3,6 +3,8 @@ package types
 import (
 	"time"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -14,8 +16,11 @@ type (
 	// StakingKeeper defines the staking module interface contract needed by the
 	// evidence module.
 	StakingKeeper interface {
+		Validator(sdk.Context, sdk.AccAddress) stakingtypes.ValidatorI
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
 		GetParams(ctx sdk.Context) (params stakingtypes.Params)
+		PowerReduction(ctx sdk.Context) math.Int
+		GetValidatorKeysAtHeight(ctx sdk.Context, operator sdk.AccAddress, height int64) (stakingtypes.ValidatorKeyRecord, bool)
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -71,6 +71,8 @@ func DefaultGenesisState() *GenesisState {
 		*NewMsgGasParamsWithFixedGas("/cosmos.gov.v1.MsgVote", 2e6),
 		*NewMsgGasParamsWithFixedGas("/cosmos.gov.v1.MsgVoteWeighted", 2e6),
 		*NewMsgGasParamsWithFixedGas("/cosmos.oracle.v1.MsgClaim", 1e3),
+		*NewMsgGasParamsWithFixedGas("/cosmos.sessionkey.v1beta1.MsgAddSessionKey", 1.2e3),
+		*NewMsgGasParamsWithFixedGas("/cosmos.sessionkey.v1beta1.MsgRemoveSessionKey", 1.2e3),
 		*NewMsgGasParamsWithFixedGas("/cosmos.slashing.v1beta1.MsgUnjail", 1.2e3),
 		*NewMsgGasParamsWithFixedGas("/cosmos.staking.v1beta1.MsgBeginRedelegate", 1.2e3),
 		*NewMsgGasParamsWithFixedGas("/cosmos.staking.v1beta1.MsgCancelUnbondingDelegation", 1.2e3),
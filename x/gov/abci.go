@@ -56,6 +56,27 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
+		// an expedited proposal failing the expedited vote falls back to a normal proposal,
+		// its deposits and votes are kept for the normal voting period.
+		if proposal.Expedited && !passes {
+			proposal = keeper.ConvertExpeditedProposal(ctx, proposal)
+
+			logger.Info(
+				"expedited proposal converted to normal proposal",
+				"proposal", proposal.Id,
+				"voting_end_time", proposal.VotingEndTime,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
 		} else {
@@ -74,6 +95,9 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 			// the handlers fails, no state mutation is written and the error
 			// message is logged.
 			cacheCtx, writeCache := ctx.CacheContext()
+			// The proposal id is carried by the context so that the messages can
+			// be attributed to the proposal executing them.
+			cacheCtx = types.WithProposalID(cacheCtx, proposal.Id)
 			messages, err := proposal.GetMsgs()
 			if err == nil {
 				for idx, msg = range messages {
@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestTickExpiredDepositPeriod(t *testing.T) {
@@ -407,3 +408,127 @@ func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sd
 		require.NotNil(t, res)
 	}
 }
+
+func TestExpeditedProposalFallback(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 3, valTokens)
+
+	SortAddresses(addrs)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+	header := tmproto.Header{ChainID: sdktestutil.DefaultChainId, Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, addrs, []int64{10, 10, 10})
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	params := suite.GovKeeper.GetParams(ctx)
+	params.ExpeditedMsgTypeUrls = append(params.ExpeditedMsgTypeUrls, sdk.MsgTypeURL(&v1.MsgExecLegacyContent{}))
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	// the messages of an expedited proposal must be allowlisted
+	msg := banktypes.NewMsgSend(authtypes.NewModuleAddress(types.ModuleName), addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1))))
+	_, err := suite.GovKeeper.SubmitExpeditedProposal(ctx, []sdk.Msg{msg}, "", "Bank Msg Send", "send message", addrs[0])
+	require.ErrorIs(t, err, types.ErrInvalidExpeditedProposal)
+
+	proposal, err := suite.GovKeeper.SubmitExpeditedProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0])
+	require.NoError(t, err)
+	require.True(t, proposal.Expedited)
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	_, err = govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), v1.NewMsgDeposit(addrs[0], proposal.Id, proposalCoins))
+	require.NoError(t, err)
+
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(*params.ExpeditedVotingPeriod), *proposal.VotingEndTime)
+
+	// 2/3 of the votes are yes, it does not reach the expedited threshold but the normal threshold
+	require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[2], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	// the proposal falls back to a normal proposal, keeping its votes
+	proposal, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.False(t, proposal.Expedited)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(*params.VotingPeriod), *proposal.VotingEndTime)
+	_, found := suite.GovKeeper.GetVote(ctx, proposal.Id, addrs[0])
+	require.True(t, found)
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+	_, found = suite.GovKeeper.GetVote(ctx, proposal.Id, addrs[0])
+	require.False(t, found)
+}
+
+func TestProposalMsgTypeRuleThreshold(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 3, valTokens)
+
+	SortAddresses(addrs)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+	header := tmproto.Header{ChainID: sdktestutil.DefaultChainId, Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, addrs, []int64{10, 10, 10})
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	upgradedCtx := sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, ctx.Logger()).WithChainID(ctx.ChainID())
+
+	params := suite.GovKeeper.GetParams(ctx)
+	params.MsgTypeRules = append(params.MsgTypeRules, v1.MsgTypeRule{
+		MsgTypeUrl: sdk.MsgTypeURL(&v1.MsgExecLegacyContent{}),
+		Threshold:  sdk.NewDecWithPrec(75, 2).String(),
+	})
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+
+	var proposals []v1.Proposal
+	for i := 0; i < 2; i++ {
+		proposal, err := suite.GovKeeper.SubmitProposal(upgradedCtx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0])
+		require.NoError(t, err)
+		_, err = govMsgSvr.Deposit(sdk.WrapSDKContext(upgradedCtx), v1.NewMsgDeposit(addrs[0], proposal.Id, proposalCoins))
+		require.NoError(t, err)
+
+		// 2/3 of the votes are yes, it reaches the threshold but not the threshold of the rule
+		require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+		require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+		require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[2], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+		proposals = append(proposals, proposal)
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*params.VotingPeriod).Add(time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+	upgradedCtx = upgradedCtx.WithBlockHeader(newHeader)
+
+	// the rule is only applied after the upgrade
+	passes, _, _ := suite.GovKeeper.Tally(ctx, proposals[0])
+	require.True(t, passes)
+	passes, _, _ = suite.GovKeeper.Tally(upgradedCtx, proposals[1])
+	require.False(t, passes)
+}
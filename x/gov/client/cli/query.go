@@ -38,6 +38,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryCrossChainCalls(),
 	)
 
 	return govQueryCmd
@@ -508,6 +509,52 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryCrossChainCalls implements the query cross chain calls command.
+func GetCmdQueryCrossChainCalls() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-chain-calls [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the cross chain calls executed by a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cross chain calls executed by a passed proposal and their
+execution status on the destination chain.
+
+Example:
+$ %s query gov cross-chain-calls 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.CrossChainCalls(
+				cmd.Context(),
+				&v1.QueryCrossChainCallsRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 //
 //nolint:staticcheck // this function contains deprecated commands that we need.
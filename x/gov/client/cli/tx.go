@@ -32,6 +32,7 @@ const (
 	flagStatus       = "status"
 	FlagMetadata     = "metadata"
 	FlagSummary      = "summary"
+	FlagExpedited    = "expedited"
 	// Deprecated: only used for v1beta1 legacy proposals.
 	FlagProposal = "proposal"
 )
@@ -93,6 +94,10 @@ They should be defined in a JSON file.
 Example:
 $ %s tx gov submit-proposal path/to/proposal.json
 
+An expedited proposal with a shorter voting period can be submitted with the --expedited flag,
+if all of its messages are allowed in expedited proposals. It falls back to a normal proposal
+if it fails the expedited vote.
+
 Where proposal.json contains:
 
 {
@@ -142,10 +147,16 @@ metadata example:
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			msg.Expedited, err = cmd.Flags().GetBool(FlagExpedited)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagExpedited, false, "Submit an expedited proposal, whose messages must be allowed in expedited proposals")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
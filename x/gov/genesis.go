@@ -40,6 +40,10 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetProposal(ctx, *proposal)
 	}
 
+	for _, call := range data.CrossChainCalls {
+		k.SetCrossChainCall(ctx, *call)
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -74,5 +78,6 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 		Votes:              proposalsVotes,
 		Proposals:          proposals,
 		Params:             &params,
+		CrossChainCalls:    k.GetAllCrossChainCalls(ctx),
 	}
 }
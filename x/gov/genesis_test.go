@@ -35,3 +35,21 @@ func TestImportExportQueues_ErrorUnconsistentState(t *testing.T) {
 	genState := gov.ExportGenesis(ctx, suite.GovKeeper)
 	require.Equal(t, genState, v1.DefaultGenesisState())
 }
+
+func TestImportExportCrossChainCalls(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	genState := v1.DefaultGenesisState()
+	genState.CrossChainCalls = []*v1.CrossChainCall{
+		{ProposalId: 1, DestChainId: 1, Sequence: 0, Target: sdk.AccAddress("target").String(), Status: v1.CrossChainCallExecuted, Result: []byte{0x01}},
+		{ProposalId: 2, DestChainId: 1, Sequence: 1, Target: sdk.AccAddress("target").String(), Status: v1.CrossChainCallPending},
+	}
+	gov.InitGenesis(ctx, suite.AccountKeeper, suite.BankKeeper, suite.GovKeeper, genState)
+
+	call, found := suite.GovKeeper.GetCrossChainCallBySequence(ctx, 1, 1)
+	require.True(t, found)
+	require.Equal(t, *genState.CrossChainCalls[1], call)
+	require.Equal(t, genState, gov.ExportGenesis(ctx, suite.GovKeeper))
+}
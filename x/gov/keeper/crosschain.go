@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/hex"
 	"math/big"
+	"strconv"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,11 +12,13 @@ import (
 )
 
 func (k Keeper) RegisterCrossChainSyncParamsApp() error {
-	if err := k.crossChainKeeper.RegisterChannel(types.SyncParamsChannel, types.SyncParamsChannelID, SyncParamsApp{keeper: k}); err != nil {
-		return err
-	}
+	return k.crossChainKeeper.RegisterChannel(types.SyncParamsChannel, types.SyncParamsChannelID, SyncParamsApp{keeper: k})
+}
 
-	return nil
+// RegisterCrossChainGovCallApp registers the gov call channel, it should be called by the initializer of the
+// Nagqu upgrade so that the channel is only open once the upgrade is applied.
+func (k Keeper) RegisterCrossChainGovCallApp() error {
+	return k.crossChainKeeper.RegisterChannel(types.GovCallChannel, types.GovCallChannelID, GovCallApp{keeper: k})
 }
 
 type SyncParamsApp struct {
@@ -23,7 +26,7 @@ type SyncParamsApp struct {
 }
 
 func (app SyncParamsApp) ExecuteSynPackage(ctx sdk.Context, _ *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
-	app.keeper.Logger(ctx).Error("received sync params sync package", "payload", hex.EncodeToString(payload))
+	app.keeper.Logger(ctx).Info("received sync params sync package", "payload", hex.EncodeToString(payload))
 	return sdk.ExecuteResult{}
 }
 
@@ -72,14 +75,181 @@ func (k Keeper) SyncParams(ctx sdk.Context, destChainId sdk.ChainID, cpc govv1.C
 		return sdkerrors.Wrapf(types.ErrChainNotSupported, "destination chain (%d) is not supported", destChainId)
 	}
 
-	_, err := k.crossChainKeeper.CreateRawIBCPackageWithFee(
+	_, err := k.createSynPackage(ctx, destChainId, types.SyncParamsChannelID, encodedPackage)
+	return err
+}
+
+// createSynPackage creates a cross chain syn package of the gov module, the packages of all the gov channels
+// are charged with the same relayer fees.
+func (k Keeper) createSynPackage(ctx sdk.Context, destChainId sdk.ChainID, channelID sdk.ChannelID, payload []byte) (uint64, error) {
+	return k.crossChainKeeper.CreateRawIBCPackageWithFee(
 		ctx,
 		destChainId,
-		types.SyncParamsChannelID,
+		channelID,
 		sdk.SynCrossChainPackageType,
-		encodedPackage,
+		payload,
 		big.NewInt(0),
 		big.NewInt(0),
 	)
-	return err
+}
+
+type GovCallApp struct {
+	keeper Keeper
+}
+
+func (app GovCallApp) ExecuteSynPackage(ctx sdk.Context, _ *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	app.keeper.Logger(ctx).Info("received gov call sync package", "payload", hex.EncodeToString(payload))
+	return sdk.ExecuteResult{}
+}
+
+func (app GovCallApp) ExecuteAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	app.keeper.setCrossChainCallResult(ctx, appCtx, govv1.CrossChainCallExecuted, payload)
+	return sdk.ExecuteResult{}
+}
+
+func (app GovCallApp) ExecuteFailAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	app.keeper.setCrossChainCallResult(ctx, appCtx, govv1.CrossChainCallFailed, payload)
+	return sdk.ExecuteResult{}
+}
+
+// setCrossChainCallResult records the result of the acknowledged cross chain call on the proposal executing it
+func (k Keeper) setCrossChainCallResult(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, status govv1.CrossChainCallStatus, payload []byte) {
+	call, found := k.GetCrossChainCallBySequence(ctx, appCtx.SrcChainId, appCtx.Sequence)
+	if !found {
+		k.Logger(ctx).Error("received ack of unknown gov call package", "chain_id", appCtx.SrcChainId,
+			"sequence", appCtx.Sequence, "payload", hex.EncodeToString(payload))
+		return
+	}
+
+	call.Status = status
+	call.Result = payload
+	k.SetCrossChainCall(ctx, call)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCrossChainCall,
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(call.ProposalId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDestChainID, strconv.FormatUint(uint64(call.DestChainId), 10)),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(call.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyCallStatus, call.Status.String()),
+		),
+	)
+}
+
+// ExecuteCrossChainCall sends the calldata to the whitelisted target contract on the destination chain
+// on behalf of the proposal, and records the pending call on it.
+func (k Keeper) ExecuteCrossChainCall(ctx sdk.Context, proposalID uint64, destChainId sdk.ChainID, target string, calldata []byte) (uint64, error) {
+	targetAddr, err := sdk.AccAddressFromHexUnsafe(target)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrAddressNotValid, "invalid target %s", target)
+	}
+	if !k.isCrossChainCallTarget(ctx, targetAddr) {
+		return 0, sdkerrors.Wrapf(types.ErrTargetNotWhitelisted, "target %s", target)
+	}
+	if !k.crossChainKeeper.IsDestChainSupported(destChainId) {
+		return 0, sdkerrors.Wrapf(types.ErrChainNotSupported, "destination chain (%d) is not supported", destChainId)
+	}
+
+	pack := types.GovCallPackage{
+		Target:   targetAddr.Bytes(),
+		Calldata: calldata,
+	}
+
+	sequence, err := k.createSynPackage(ctx, destChainId, types.GovCallChannelID, pack.MustSerialize())
+	if err != nil {
+		return 0, err
+	}
+
+	k.SetCrossChainCall(ctx, govv1.CrossChainCall{
+		ProposalId:  proposalID,
+		DestChainId: uint32(destChainId),
+		Sequence:    sequence,
+		Target:      targetAddr.String(),
+		Calldata:    calldata,
+		Status:      govv1.CrossChainCallPending,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCrossChainCall,
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposalID, 10)),
+			sdk.NewAttribute(types.AttributeKeyDestChainID, strconv.FormatUint(uint64(destChainId), 10)),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyTarget, targetAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyCallStatus, govv1.CrossChainCallPending.String()),
+		),
+	)
+
+	return sequence, nil
+}
+
+func (k Keeper) isCrossChainCallTarget(ctx sdk.Context, target sdk.AccAddress) bool {
+	for _, t := range k.GetParams(ctx).CrossChainCallTargets {
+		if addr, err := sdk.AccAddressFromHexUnsafe(t); err == nil && addr.Equals(target) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetCrossChainCall sets a cross chain call to the store
+func (k Keeper) SetCrossChainCall(ctx sdk.Context, call govv1.CrossChainCall) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&call)
+	destChainId := sdk.ChainID(call.DestChainId)
+	store.Set(types.CrossChainCallKey(call.ProposalId, destChainId, call.Sequence), bz)
+	store.Set(types.CrossChainCallProposalKey(destChainId, call.Sequence), types.GetProposalIDBytes(call.ProposalId))
+}
+
+// GetCrossChainCall gets a cross chain call of a proposal from the store
+func (k Keeper) GetCrossChainCall(ctx sdk.Context, proposalID uint64, destChainId sdk.ChainID, sequence uint64) (call govv1.CrossChainCall, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CrossChainCallKey(proposalID, destChainId, sequence))
+	if bz == nil {
+		return call, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &call)
+	return call, true
+}
+
+// GetCrossChainCallBySequence gets a cross chain call by the sequence of its package from the store
+func (k Keeper) GetCrossChainCallBySequence(ctx sdk.Context, destChainId sdk.ChainID, sequence uint64) (call govv1.CrossChainCall, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CrossChainCallProposalKey(destChainId, sequence))
+	if bz == nil {
+		return call, false
+	}
+
+	return k.GetCrossChainCall(ctx, types.GetProposalIDFromBytes(bz), destChainId, sequence)
+}
+
+// GetCrossChainCalls returns all the cross chain calls executed by a proposal
+func (k Keeper) GetCrossChainCalls(ctx sdk.Context, proposalID uint64) (calls []*govv1.CrossChainCall) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CrossChainCallsKey(proposalID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var call govv1.CrossChainCall
+		k.cdc.MustUnmarshal(iterator.Value(), &call)
+		calls = append(calls, &call)
+	}
+
+	return calls
+}
+
+// GetAllCrossChainCalls returns all the cross chain calls executed by the proposals
+func (k Keeper) GetAllCrossChainCalls(ctx sdk.Context) (calls []*govv1.CrossChainCall) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CrossChainCallsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var call govv1.CrossChainCall
+		k.cdc.MustUnmarshal(iterator.Value(), &call)
+		calls = append(calls, &call)
+	}
+
+	return calls
 }
@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // GetDeposit gets the deposit of a specific depositor on a specific proposal
@@ -131,7 +132,7 @@ func (k Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(k.GetParams(ctx).MinDeposit) {
+	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(k.proposalMinDeposit(ctx, proposal)) {
 		k.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -179,6 +180,16 @@ func (k Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	})
 }
 
+// proposalMinDeposit returns the minimum deposit for the proposal to enter the voting period, which is
+// raised by the rules of its message types.
+func (k Keeper) proposalMinDeposit(ctx sdk.Context, proposal v1.Proposal) sdk.Coins {
+	params := k.GetParams(ctx)
+	if !ctx.IsUpgraded(upgradetypes.Nagqu) {
+		return params.MinDeposit
+	}
+	return params.ProposalMinDeposit(proposal.MsgTypeURLs())
+}
+
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
 // the deposit parameters. Returns nil on success, error otherwise.
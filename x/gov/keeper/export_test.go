@@ -7,3 +7,9 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
 	return k.validateInitialDeposit(ctx, initialDeposit)
 }
+
+// NewGovCallApp is a helper function used only in cross chain call tests which returns the
+// cross chain app handling the acks of the gov call packages.
+func NewGovCallApp(k Keeper) GovCallApp {
+	return GovCallApp{keeper: k}
+}
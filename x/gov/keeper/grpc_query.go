@@ -247,6 +247,21 @@ func (k Keeper) Deposits(c context.Context, req *v1.QueryDepositsRequest) (*v1.Q
 	return &v1.QueryDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
 }
 
+// CrossChainCalls queries the cross chain calls executed by a proposal
+func (k Keeper) CrossChainCalls(c context.Context, req *v1.QueryCrossChainCallsRequest) (*v1.QueryCrossChainCallsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &v1.QueryCrossChainCallsResponse{Calls: k.GetCrossChainCalls(ctx, req.ProposalId)}, nil
+}
+
 // TallyResult queries the tally of a proposal vote
 func (k Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest) (*v1.QueryTallyResultResponse, error) {
 	if req == nil {
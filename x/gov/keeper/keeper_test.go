@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -121,6 +122,17 @@ func TestProposalQueues(t *testing.T) {
 	activeIterator.Close()
 }
 
+func TestRegisterCrossChainApps(t *testing.T) {
+	govKeeper, _, _, _, crossChainKeeper, _, _ := setupGovKeeper(t)
+
+	// the gov call channel is not registered with the sync params channel, but by the initializer of the upgrade
+	crossChainKeeper.EXPECT().RegisterChannel(types.SyncParamsChannel, types.SyncParamsChannelID, gomock.Any()).Return(nil)
+	require.NoError(t, govKeeper.RegisterCrossChainSyncParamsApp())
+
+	crossChainKeeper.EXPECT().RegisterChannel(types.GovCallChannel, types.GovCallChannelID, gomock.Any()).Return(nil)
+	require.NoError(t, govKeeper.RegisterCrossChainGovCallApp())
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
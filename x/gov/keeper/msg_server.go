@@ -10,9 +10,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 type msgServer struct {
@@ -47,7 +49,15 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer)
+	var proposal v1.Proposal
+	if msg.Expedited {
+		if !ctx.IsUpgraded(upgradetypes.Nagqu) {
+			return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "expedited proposal is not enabled")
+		}
+		proposal, err = k.Keeper.SubmitExpeditedProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer)
+	} else {
+		proposal, err = k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -203,6 +213,26 @@ func (k msgServer) UpdateCrossChainParams(goCtx context.Context, msg *v1.MsgUpda
 	return &v1.MsgUpdateCrossChainParamsResponse{}, nil
 }
 
+// ExecuteCrossChainCall implements the MsgServer.ExecuteCrossChainCall method.
+func (k msgServer) ExecuteCrossChainCall(goCtx context.Context, msg *v1.MsgExecuteCrossChainCall) (*v1.MsgExecuteCrossChainCallResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !ctx.IsUpgraded(upgradetypes.Nagqu) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "cross chain call is not enabled")
+	}
+	proposalID, ok := govtypes.ProposalIDFromContext(ctx)
+	if !ok {
+		return nil, govtypes.ErrNotExecutedByProposal
+	}
+	sequence, err := k.Keeper.ExecuteCrossChainCall(ctx, proposalID, sdk.ChainID(msg.DestChainId), msg.Target, msg.Calldata)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgExecuteCrossChainCallResponse{Sequence: sequence}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -7,9 +7,13 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func (suite *KeeperTestSuite) TestSubmitProposalReq() {
@@ -132,6 +136,165 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitExpeditedProposalReq() {
+	suite.reset()
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	proposer := suite.addrs[0]
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+
+	upgradedCtx := sdk.NewContext(suite.ctx.MultiStore(), suite.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, suite.ctx.Logger()).WithChainID(suite.ctx.ChainID())
+
+	msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{bankMsg}, coins, proposer.String(), "", "Proposal", "description of proposal")
+	suite.Require().NoError(err)
+	msg.Expedited = true
+
+	_, err = suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().ErrorContains(err, "expedited proposal is not enabled")
+
+	_, err = suite.msgSrvr.SubmitProposal(upgradedCtx, msg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidExpeditedProposal)
+
+	params := suite.govKeeper.GetParams(suite.ctx)
+	params.ExpeditedMsgTypeUrls = append(params.ExpeditedMsgTypeUrls, sdk.MsgTypeURL(bankMsg))
+	suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+
+	res, err := suite.msgSrvr.SubmitProposal(upgradedCtx, msg)
+	suite.Require().NoError(err)
+	proposal, found := suite.govKeeper.GetProposal(suite.ctx, res.ProposalId)
+	suite.Require().True(found)
+	suite.Require().True(proposal.Expedited)
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposalMsgTypeRules() {
+	suite.reset()
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	proposer := suite.addrs[0]
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+	bankMsgTypeURL := sdk.MsgTypeURL(bankMsg)
+
+	upgradedCtx := sdk.NewContext(suite.ctx.MultiStore(), suite.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, suite.ctx.Logger()).WithChainID(suite.ctx.ChainID())
+
+	defaultParams := suite.govKeeper.GetParams(suite.ctx)
+	minDeposit := sdk.NewCoins(defaultParams.MinDeposit...)
+	ruleMinDeposit := minDeposit.Add(minDeposit...)
+
+	execMsg := authz.NewMsgExec(govAcct, []sdk.Msg{bankMsg})
+	legacyMsg, err := v1.NewLegacyContent(v1beta1.NewTextProposal("title", "description"), govAcct.String())
+	suite.Require().NoError(err)
+
+	cases := map[string]struct {
+		params    func(params v1.Params) v1.Params
+		msgs      []sdk.Msg
+		ctx       sdk.Context
+		expErrMsg string
+	}{
+		"denied msg type": {
+			params: func(params v1.Params) v1.Params {
+				params.DeniedMsgTypeUrls = []string{bankMsgTypeURL}
+				return params
+			},
+			ctx:       upgradedCtx,
+			expErrMsg: "message type is not allowed in proposals",
+		},
+		"msg type not in allowlist": {
+			params: func(params v1.Params) v1.Params {
+				params.AllowedMsgTypeUrls = []string{sdk.MsgTypeURL(&v1.MsgUpdateParams{})}
+				return params
+			},
+			ctx:       upgradedCtx,
+			expErrMsg: "message type is not allowed in proposals",
+		},
+		"denied msg type nested in authz exec": {
+			params: func(params v1.Params) v1.Params {
+				params.DeniedMsgTypeUrls = []string{bankMsgTypeURL}
+				return params
+			},
+			msgs:      []sdk.Msg{&execMsg},
+			ctx:       upgradedCtx,
+			expErrMsg: bankMsgTypeURL,
+		},
+		"denied legacy content": {
+			params: func(params v1.Params) v1.Params {
+				params.DeniedMsgTypeUrls = []string{"/cosmos.gov.v1beta1.TextProposal"}
+				return params
+			},
+			msgs:      []sdk.Msg{legacyMsg},
+			ctx:       upgradedCtx,
+			expErrMsg: "/cosmos.gov.v1beta1.TextProposal",
+		},
+		"denied msg type before upgrade": {
+			params: func(params v1.Params) v1.Params {
+				params.DeniedMsgTypeUrls = []string{bankMsgTypeURL}
+				return params
+			},
+			ctx: suite.ctx,
+		},
+		"msg type in allowlist": {
+			params: func(params v1.Params) v1.Params {
+				params.AllowedMsgTypeUrls = []string{bankMsgTypeURL}
+				return params
+			},
+			ctx: upgradedCtx,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, tc.params(defaultParams)))
+
+			msgs := tc.msgs
+			if msgs == nil {
+				msgs = []sdk.Msg{bankMsg}
+			}
+			msg, err := v1.NewMsgSubmitProposal(msgs, coins, proposer.String(), "", "Proposal", "description of proposal")
+			suite.Require().NoError(err)
+
+			_, err = suite.msgSrvr.SubmitProposal(tc.ctx, msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	// the proposal enters the voting period with the higher minimum deposit of the rule
+	params := defaultParams
+	params.MsgTypeRules = append(params.MsgTypeRules, v1.MsgTypeRule{MsgTypeUrl: bankMsgTypeURL, MinDeposit: ruleMinDeposit})
+	suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+
+	msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{bankMsg}, minDeposit, proposer.String(), "", "Proposal", "description of proposal")
+	suite.Require().NoError(err)
+	res, err := suite.msgSrvr.SubmitProposal(upgradedCtx, msg)
+	suite.Require().NoError(err)
+
+	proposal, found := suite.govKeeper.GetProposal(suite.ctx, res.ProposalId)
+	suite.Require().True(found)
+	suite.Require().Equal(v1.StatusDepositPeriod, proposal.Status)
+
+	_, err = suite.msgSrvr.Deposit(upgradedCtx, v1.NewMsgDeposit(proposer, res.ProposalId, minDeposit))
+	suite.Require().NoError(err)
+
+	proposal, found = suite.govKeeper.GetProposal(suite.ctx, res.ProposalId)
+	suite.Require().True(found)
+	suite.Require().Equal(v1.StatusVotingPeriod, proposal.Status)
+}
+
 func (suite *KeeperTestSuite) TestVoteReq() {
 	suite.reset()
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
@@ -982,6 +1145,79 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			expErr:    true,
 			expErrMsg: "voting period must be positive",
 		},
+		{
+			name: "expedited voting period not shorter than voting period",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				duration := *params.VotingPeriod
+				params1.ExpeditedVotingPeriod = &duration
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "must be shorter than the voting period",
+		},
+		{
+			name: "expedited threshold not greater than threshold",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.ExpeditedThreshold = params.Threshold
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "must be greater than the threshold",
+		},
+		{
+			name: "msg type both allowed and denied",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.AllowedMsgTypeUrls = []string{"/cosmos.bank.v1beta1.MsgSend"}
+				params1.DeniedMsgTypeUrls = []string{"/cosmos.bank.v1beta1.MsgSend"}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "cannot be both allowed and denied",
+		},
+		{
+			name: "msg type rule threshold not greater than threshold",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MsgTypeRules = []v1.MsgTypeRule{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Threshold: params.Threshold}}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "must be greater than the threshold",
+		},
+		{
+			name: "expedited proposals disabled",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.ExpeditedVotingPeriod = nil
+				params1.ExpeditedQuorum = ""
+				params1.ExpeditedThreshold = ""
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1080,3 +1316,93 @@ func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgExecuteCrossChainCall() {
+	suite.reset()
+	authority := suite.govKeeper.GetAuthority()
+	target := sdk.AccAddress(strings.Repeat("t", 20)).String()
+	calldata := []byte{0x1, 0x2, 0x3, 0x4}
+	destChainID := sdk.ChainID(714)
+	proposalID := uint64(1)
+
+	params := suite.govKeeper.GetParams(suite.ctx)
+	params.CrossChainCallTargets = []string{target}
+	suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+
+	upgradedCtx := sdk.NewContext(suite.ctx.MultiStore(), suite.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, suite.ctx.Logger()).WithChainID(suite.ctx.ChainID())
+
+	testCases := []struct {
+		name      string
+		ctx       sdk.Context
+		input     *v1.MsgExecuteCrossChainCall
+		expErrMsg string
+	}{
+		{
+			name:      "not upgraded",
+			ctx:       govtypes.WithProposalID(suite.ctx, proposalID),
+			input:     v1.NewMsgExecuteCrossChainCall(authority, destChainID, target, calldata),
+			expErrMsg: "cross chain call is not enabled",
+		},
+		{
+			name:      "invalid authority",
+			ctx:       govtypes.WithProposalID(upgradedCtx, proposalID),
+			input:     v1.NewMsgExecuteCrossChainCall(suite.addrs[0].String(), destChainID, target, calldata),
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "not executed by a proposal",
+			ctx:       upgradedCtx,
+			input:     v1.NewMsgExecuteCrossChainCall(authority, destChainID, target, calldata),
+			expErrMsg: "call is not executed by a proposal",
+		},
+		{
+			name:      "target not whitelisted",
+			ctx:       govtypes.WithProposalID(upgradedCtx, proposalID),
+			input:     v1.NewMsgExecuteCrossChainCall(authority, destChainID, suite.addrs[0].String(), calldata),
+			expErrMsg: "target is not whitelisted",
+		},
+		{
+			name:  "valid",
+			ctx:   govtypes.WithProposalID(upgradedCtx, proposalID),
+			input: v1.NewMsgExecuteCrossChainCall(authority, destChainID, target, calldata),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.Require().NoError(tc.input.ValidateBasic())
+
+			_, err := suite.msgSrvr.ExecuteCrossChainCall(tc.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	res, err := suite.queryClient.CrossChainCalls(suite.ctx, &v1.QueryCrossChainCallsRequest{ProposalId: proposalID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Calls, 1)
+	suite.Require().Equal(target, res.Calls[0].Target)
+	suite.Require().Equal(calldata, res.Calls[0].Calldata)
+	suite.Require().Equal(v1.CrossChainCallPending, res.Calls[0].Status)
+
+	// the ack of the destination chain is recorded on the call
+	app := keeper.NewGovCallApp(*suite.govKeeper)
+	result := []byte("result")
+	app.ExecuteAckPackage(suite.ctx, &sdk.CrossChainAppContext{SrcChainId: destChainID, Sequence: res.Calls[0].Sequence}, result)
+
+	call, found := suite.govKeeper.GetCrossChainCall(suite.ctx, proposalID, destChainID, res.Calls[0].Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(v1.CrossChainCallExecuted, call.Status)
+	suite.Require().Equal(result, call.Result)
+
+	app.ExecuteFailAckPackage(suite.ctx, &sdk.CrossChainAppContext{SrcChainId: destChainID, Sequence: res.Calls[0].Sequence}, result)
+	call, _ = suite.govKeeper.GetCrossChainCall(suite.ctx, proposalID, destChainID, res.Calls[0].Sequence)
+	suite.Require().Equal(v1.CrossChainCallFailed, call.Status)
+}
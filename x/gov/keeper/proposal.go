@@ -3,16 +3,39 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // SubmitProposal creates a new proposal given an array of messages
 func (k Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	return k.submitProposal(ctx, messages, metadata, title, summary, proposer, false)
+}
+
+// SubmitExpeditedProposal creates a new expedited proposal given an array of messages, which
+// must all be allowed in the expedited proposals.
+func (k Keeper) SubmitExpeditedProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	params := k.GetParams(ctx)
+	if !params.ExpeditedEnabled() {
+		return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidExpeditedProposal, "expedited proposals are not enabled")
+	}
+
+	for _, msg := range messages {
+		if typeURL := sdk.MsgTypeURL(msg); !params.IsExpeditedMsgTypeURL(typeURL) {
+			return v1.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidExpeditedProposal, "%s is not allowed in expedited proposals", typeURL)
+		}
+	}
+
+	return k.submitProposal(ctx, messages, metadata, title, summary, proposer, true)
+}
+
+func (k Keeper) submitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
 	err := k.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -33,11 +56,21 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, ti
 	// Will hold a comma-separated string of all Msg type URLs.
 	msgsStr := ""
 
+	params := k.GetParams(ctx)
+	checkMsgTypeURL := ctx.IsUpgraded(upgradetypes.Nagqu)
+
 	// Loop through all messages and confirm that each has a handler and the gov module account
 	// as the only signer
 	for _, msg := range messages {
 		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
 
+		// assert that the message type and the types of the messages nested in it can be proposed
+		if checkMsgTypeURL {
+			if typeURL, found := params.DisallowedMsgTypeURL(v1.MsgTypeURLs([]sdk.Msg{msg})); found {
+				return v1.Proposal{}, sdkerrors.Wrap(types.ErrMsgTypeNotAllowed, typeURL)
+			}
+		}
+
 		// perform a basic validation of the message
 		if err := msg.ValidateBasic(); err != nil {
 			return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
@@ -82,12 +115,13 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, ti
 	}
 
 	submitTime := ctx.BlockHeader().Time
-	depositPeriod := k.GetParams(ctx).MaxDepositPeriod
+	depositPeriod := params.MaxDepositPeriod
 
 	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.Expedited = expedited
 
 	k.SetProposal(ctx, proposal)
 	k.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
@@ -101,6 +135,7 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, ti
 			types.EventTypeSubmitProposal,
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
+			sdk.NewAttribute(types.AttributeKeyExpedited, strconv.FormatBool(expedited)),
 		),
 	)
 
@@ -262,7 +297,11 @@ func (k Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (k Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal) {
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	votingPeriod := k.GetParams(ctx).VotingPeriod
+	params := k.GetParams(ctx)
+	votingPeriod := params.VotingPeriod
+	if proposal.Expedited {
+		votingPeriod = params.ExpeditedVotingPeriod
+	}
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
@@ -289,3 +328,17 @@ func (k Keeper) UnmarshalProposal(bz []byte, proposal *v1.Proposal) error {
 	}
 	return nil
 }
+
+// ConvertExpeditedProposal converts an expedited proposal failing the expedited vote to a normal
+// proposal, whose voting period is extended to the normal voting period from its voting start time.
+func (k Keeper) ConvertExpeditedProposal(ctx sdk.Context, proposal v1.Proposal) v1.Proposal {
+	k.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+	endTime := proposal.VotingStartTime.Add(*k.GetParams(ctx).VotingPeriod)
+	proposal.VotingEndTime = &endTime
+	proposal.Expedited = false
+	k.SetProposal(ctx, proposal)
+
+	k.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+	return proposal
+}
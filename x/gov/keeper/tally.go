@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // TODO: Break into several smaller functions for clarity
@@ -34,9 +35,22 @@ func (k Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnD
 		return false
 	})
 
+	var voters []sdk.AccAddress
+	defer func() {
+		// the votes of an expedited proposal failing the expedited vote are kept, as it falls
+		// back to a normal proposal
+		if proposal.Expedited && !passes {
+			return
+		}
+		for _, voter := range voters {
+			k.deleteVote(ctx, proposal.Id, voter)
+		}
+	}()
+
 	k.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) bool {
 		// if validator, just record it in the map
 		voter := sdk.MustAccAddressFromHex(vote.Voter)
+		voters = append(voters, voter)
 
 		valAddrStr := sdk.AccAddress(voter.Bytes()).String()
 		if val, ok := currValidators[valAddrStr]; ok {
@@ -68,7 +82,6 @@ func (k Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnD
 			return false
 		})
 
-		k.deleteVote(ctx, vote.ProposalId, voter)
 		return false
 	})
 
@@ -100,7 +113,12 @@ func (k Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnD
 
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(k.sk.TotalBondedTokens(ctx)))
-	quorum, _ := sdk.NewDecFromStr(params.Quorum)
+	quorumStr, thresholdStr := params.Quorum, params.Threshold
+	if proposal.Expedited {
+		quorumStr, thresholdStr = params.ExpeditedQuorum, params.ExpeditedThreshold
+	}
+
+	quorum, _ := sdk.NewDecFromStr(quorumStr)
 	if percentVoting.LT(quorum) {
 		return false, params.BurnVoteQuorum, tallyResults
 	}
@@ -117,7 +135,10 @@ func (k Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnD
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	threshold, _ := sdk.NewDecFromStr(params.Threshold)
+	threshold, _ := sdk.NewDecFromStr(thresholdStr)
+	if ctx.IsUpgraded(upgradetypes.Nagqu) {
+		threshold = params.ProposalThreshold(proposal.MsgTypeURLs(), threshold)
+	}
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
@@ -73,6 +73,7 @@ func TestMigrateJSON(t *testing.T) {
 	// Make sure about:
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
+	"cross_chain_calls": [],
 	"deposit_params": {
 		"max_deposit_period": "172800s",
 		"min_deposit": [
@@ -87,6 +88,7 @@ func TestMigrateJSON(t *testing.T) {
 	"proposals": [
 		{
 			"deposit_end_time": "2001-09-09T01:46:40Z",
+			"expedited": false,
 			"failed_reason": "",
 			"final_tally_result": {
 				"abstain_count": "0",
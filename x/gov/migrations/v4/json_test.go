@@ -41,6 +41,14 @@ func TestMigrateJSON(t *testing.T) {
 
 	migrated, err := v4.MigrateJSON(oldGovState)
 	require.NoError(t, err)
+
+	// the expedited proposals are not enabled by the migration
+	govGenState.Params.ExpeditedVotingPeriod = nil
+	govGenState.Params.ExpeditedQuorum = ""
+	govGenState.Params.ExpeditedThreshold = ""
+	govGenState.Params.ExpeditedMsgTypeUrls = nil
+	// neither are the rules of the message types
+	govGenState.Params.MsgTypeRules = nil
 	require.Equal(t, migrated, govGenState)
 
 	bz, err := clientCtx.Codec.MarshalJSON(migrated)
@@ -56,12 +64,20 @@ func TestMigrateJSON(t *testing.T) {
 	// Make sure about:
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
+	"cross_chain_calls": [],
 	"deposit_params": null,
 	"deposits": [],
 	"params": {
+		"allowed_msg_type_urls": [],
 		"burn_proposal_deposit_prevote": false,
 		"burn_vote_quorum": false,
 		"burn_vote_veto": true,
+		"cross_chain_call_targets": [],
+		"denied_msg_type_urls": [],
+		"expedited_msg_type_urls": [],
+		"expedited_quorum": "",
+		"expedited_threshold": "",
+		"expedited_voting_period": null,
 		"max_deposit_period": "172800s",
 		"min_deposit": [
 			{
@@ -70,6 +86,7 @@ func TestMigrateJSON(t *testing.T) {
 			}
 		],
 		"min_initial_deposit_ratio": "0.000000000000000000",
+		"msg_type_rules": [],
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000",
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// proposalIDContextKey is the context key of the id of the proposal whose messages
+// are being executed.
+type proposalIDContextKey struct{}
+
+// WithProposalID returns a context carrying the id of the proposal whose messages
+// are executed with it.
+func WithProposalID(ctx sdk.Context, proposalID uint64) sdk.Context {
+	return ctx.WithValue(proposalIDContextKey{}, proposalID)
+}
+
+// ProposalIDFromContext returns the id of the proposal whose messages are executed
+// with the context, it returns false if the messages are not executed by a proposal.
+func ProposalIDFromContext(ctx sdk.Context) (uint64, bool) {
+	proposalID, ok := ctx.Value(proposalIDContextKey{}).(uint64)
+	return proposalID, ok
+}
@@ -7,8 +7,10 @@ import (
 
 const (
 	SyncParamsChannel = "syncParametersChange"
+	GovCallChannel    = "govCall"
 
 	SyncParamsChannelID sdk.ChannelID = 3
+	GovCallChannelID    sdk.ChannelID = 10
 
 	KeyUpgrade = "upgrade"
 )
@@ -42,3 +44,30 @@ func (p SyncParamsPackage) MustSerialize() []byte {
 	}
 	return encodedBytes
 }
+
+// GovCallPackage is the payload of the cross-chain package calling a contract on the destination chain
+type GovCallPackage struct {
+	// Target is the address of the contract to be called
+	Target []byte
+	// Calldata is the ABI-encoded calldata of the call
+	Calldata []byte
+}
+
+var (
+	govCallPackageType, _ = abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "Target", Type: "bytes"},
+		{Name: "Calldata", Type: "bytes"},
+	})
+
+	govCallPackageArgs = abi.Arguments{
+		{Type: govCallPackageType},
+	}
+)
+
+func (p GovCallPackage) MustSerialize() []byte {
+	encodedBytes, err := govCallPackageArgs.Pack(&p)
+	if err != nil {
+		panic("encode gov call package error")
+	}
+	return encodedBytes
+}
@@ -37,4 +37,10 @@ var (
 	ErrInvalidValue            = errors.Register(ModuleName, 29, "decode hex value failed")
 
 	ErrChainNotSupported = errors.Register(ModuleName, 30, "crosschain: chain is not supported")
+
+	ErrTargetNotWhitelisted  = errors.Register(ModuleName, 31, "crosschain: target is not whitelisted")
+	ErrNotExecutedByProposal = errors.Register(ModuleName, 32, "crosschain: call is not executed by a proposal")
+
+	ErrInvalidExpeditedProposal = errors.Register(ModuleName, 33, "invalid expedited proposal")
+	ErrMsgTypeNotAllowed        = errors.Register(ModuleName, 34, "message type is not allowed in proposals")
 )
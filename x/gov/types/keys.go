@@ -38,6 +38,10 @@ const (
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
 //
 // - 0x30: Params
+//
+// - 0x40<proposalID_Bytes><destChainID_Bytes><sequence_Bytes>: CrossChainCall
+//
+// - 0x41<destChainID_Bytes><sequence_Bytes>: proposalID
 var (
 	ProposalsKeyPrefix            = []byte{0x00}
 	ActiveProposalQueuePrefix     = []byte{0x01}
@@ -51,6 +55,9 @@ var (
 
 	// ParamsKey is the key to query all gov params
 	ParamsKey = []byte{0x30}
+
+	CrossChainCallsKeyPrefix        = []byte{0x40}
+	CrossChainCallProposalKeyPrefix = []byte{0x41}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -169,3 +176,25 @@ func splitKeyWithAddress(key []byte) (proposalID uint64, addr sdk.AccAddress) {
 	addr = sdk.AccAddress(key[10:])
 	return
 }
+
+// CrossChainCallsKey gets the first part of the cross chain calls key based on the proposalID
+func CrossChainCallsKey(proposalID uint64) []byte {
+	return append(CrossChainCallsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// CrossChainCallKey key of a specific cross chain call of a proposal
+func CrossChainCallKey(proposalID uint64, destChainID sdk.ChainID, sequence uint64) []byte {
+	return append(CrossChainCallsKey(proposalID), crossChainPackageBytes(destChainID, sequence)...)
+}
+
+// CrossChainCallProposalKey gets the key of the proposalID executing the cross chain call
+func CrossChainCallProposalKey(destChainID sdk.ChainID, sequence uint64) []byte {
+	return append(CrossChainCallProposalKeyPrefix, crossChainPackageBytes(destChainID, sequence)...)
+}
+
+func crossChainPackageBytes(destChainID sdk.ChainID, sequence uint64) []byte {
+	bz := make([]byte, 10)
+	binary.BigEndian.PutUint16(bz, uint16(destChainID))
+	binary.BigEndian.PutUint64(bz[2:], sequence)
+	return bz
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"fmt"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -44,3 +45,42 @@ func (m *CrossChainParamsChange) ValidateBasic() error {
 	}
 	return nil
 }
+
+const (
+	// CrossChainCallPending defines a call waiting for the ack of the destination chain
+	CrossChainCallPending = CrossChainCallStatus_CROSS_CHAIN_CALL_STATUS_PENDING
+	// CrossChainCallExecuted defines a call acknowledged by the destination chain
+	CrossChainCallExecuted = CrossChainCallStatus_CROSS_CHAIN_CALL_STATUS_EXECUTED
+	// CrossChainCallFailed defines a call failed on the destination chain
+	CrossChainCallFailed = CrossChainCallStatus_CROSS_CHAIN_CALL_STATUS_FAILED
+)
+
+// ValidateCrossChainCallTargets checks that the whitelisted targets are valid hex addresses without duplicates
+func ValidateCrossChainCallTargets(targets []string) error {
+	seen := make(map[string]bool, len(targets))
+	for _, target := range targets {
+		addr, err := sdk.AccAddressFromHexUnsafe(target)
+		if err != nil {
+			return types.ErrAddressNotValid.Wrapf("invalid cross chain call target %s", target)
+		}
+		if seen[addr.String()] {
+			return fmt.Errorf("duplicate cross chain call target: %s", target)
+		}
+		seen[addr.String()] = true
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of a cross chain call
+func (c CrossChainCall) ValidateBasic() error {
+	if c.ProposalId == 0 {
+		return fmt.Errorf("invalid proposal id of cross chain call: %d", c.ProposalId)
+	}
+	if _, err := sdk.AccAddressFromHexUnsafe(c.Target); err != nil {
+		return types.ErrAddressNotValid.Wrapf("invalid cross chain call target %s", c.Target)
+	}
+	if _, ok := CrossChainCallStatus_name[int32(c.Status)]; !ok || c.Status == CrossChainCallStatus_CROSS_CHAIN_CALL_STATUS_UNSPECIFIED {
+		return fmt.Errorf("invalid cross chain call status: %s", c.Status)
+	}
+	return nil
+}
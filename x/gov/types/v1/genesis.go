@@ -2,6 +2,7 @@ package v1
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 )
@@ -33,6 +34,18 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
+	seen := make(map[string]bool, len(data.CrossChainCalls))
+	for _, call := range data.CrossChainCalls {
+		if err := call.ValidateBasic(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d/%d", call.DestChainId, call.Sequence)
+		if seen[key] {
+			return fmt.Errorf("duplicate cross chain call of chain %d and sequence %d", call.DestChainId, call.Sequence)
+		}
+		seen[key] = true
+	}
+
 	return data.Params.ValidateBasic()
 }
 
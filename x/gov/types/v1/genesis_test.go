@@ -18,6 +18,7 @@ func TestEmptyGenesis(t *testing.T) {
 
 func TestValidateGenesis(t *testing.T) {
 	params := v1.DefaultParams()
+	target := sdk.AccAddress("target").String()
 
 	testCases := []struct {
 		name         string
@@ -80,6 +81,47 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "valid cross chain calls",
+			genesisState: func() *v1.GenesisState {
+				genState := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				genState.CrossChainCalls = []*v1.CrossChainCall{
+					{ProposalId: 1, DestChainId: 1, Sequence: 0, Target: target, Status: v1.CrossChainCallPending},
+					{ProposalId: 1, DestChainId: 1, Sequence: 1, Target: target, Status: v1.CrossChainCallExecuted, Result: []byte{0x01}},
+				}
+				return genState
+			},
+		},
+		{
+			name: "invalid cross chain call status",
+			genesisState: func() *v1.GenesisState {
+				genState := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				genState.CrossChainCalls = []*v1.CrossChainCall{{ProposalId: 1, DestChainId: 1, Target: target}}
+				return genState
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid cross chain call target",
+			genesisState: func() *v1.GenesisState {
+				genState := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				genState.CrossChainCalls = []*v1.CrossChainCall{{ProposalId: 1, DestChainId: 1, Target: "target", Status: v1.CrossChainCallPending}}
+				return genState
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate cross chain call",
+			genesisState: func() *v1.GenesisState {
+				genState := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				genState.CrossChainCalls = []*v1.CrossChainCall{
+					{ProposalId: 1, DestChainId: 1, Sequence: 0, Target: target, Status: v1.CrossChainCallPending},
+					{ProposalId: 2, DestChainId: 1, Sequence: 0, Target: target, Status: v1.CrossChainCallFailed},
+				}
+				return genState
+			},
+			expErr: true,
+		},
 		{
 			name: "invalid veto threshold",
 			genesisState: func() *v1.GenesisState {
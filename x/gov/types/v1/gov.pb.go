@@ -121,6 +121,42 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{1}
 }
 
+// CrossChainCallStatus enumerates the execution status of a cross chain call.
+type CrossChainCallStatus int32
+
+const (
+	// CROSS_CHAIN_CALL_STATUS_UNSPECIFIED defines the default status.
+	CrossChainCallStatus_CROSS_CHAIN_CALL_STATUS_UNSPECIFIED CrossChainCallStatus = 0
+	// CROSS_CHAIN_CALL_STATUS_PENDING defines a call sent to the destination chain and waiting for the ack.
+	CrossChainCallStatus_CROSS_CHAIN_CALL_STATUS_PENDING CrossChainCallStatus = 1
+	// CROSS_CHAIN_CALL_STATUS_EXECUTED defines a call acknowledged by the destination chain.
+	CrossChainCallStatus_CROSS_CHAIN_CALL_STATUS_EXECUTED CrossChainCallStatus = 2
+	// CROSS_CHAIN_CALL_STATUS_FAILED defines a call failed on the destination chain.
+	CrossChainCallStatus_CROSS_CHAIN_CALL_STATUS_FAILED CrossChainCallStatus = 3
+)
+
+var CrossChainCallStatus_name = map[int32]string{
+	0: "CROSS_CHAIN_CALL_STATUS_UNSPECIFIED",
+	1: "CROSS_CHAIN_CALL_STATUS_PENDING",
+	2: "CROSS_CHAIN_CALL_STATUS_EXECUTED",
+	3: "CROSS_CHAIN_CALL_STATUS_FAILED",
+}
+
+var CrossChainCallStatus_value = map[string]int32{
+	"CROSS_CHAIN_CALL_STATUS_UNSPECIFIED": 0,
+	"CROSS_CHAIN_CALL_STATUS_PENDING":     1,
+	"CROSS_CHAIN_CALL_STATUS_EXECUTED":    2,
+	"CROSS_CHAIN_CALL_STATUS_FAILED":      3,
+}
+
+func (x CrossChainCallStatus) String() string {
+	return proto.EnumName(CrossChainCallStatus_name, int32(x))
+}
+
+func (CrossChainCallStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
+}
+
 // WeightedVoteOption defines a unit of vote for vote split.
 type WeightedVoteOption struct {
 	// option defines the valid vote options, it must not contain duplicate vote options.
@@ -279,6 +315,9 @@ type Proposal struct {
 	Proposer string `protobuf:"bytes,13,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	// The reason of the failure proposal
 	FailedReason string `protobuf:"bytes,14,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+	// expedited defines if the proposal is expedited, it is voted with the expedited voting
+	// period, quorum and threshold, and falls back to a normal proposal if the expedited vote fails.
+	Expedited bool `protobuf:"varint,15,opt,name=expedited,proto3" json:"expedited,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -412,6 +451,13 @@ func (m *Proposal) GetFailedReason() string {
 	return ""
 }
 
+func (m *Proposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -738,15 +784,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	//  Minimum percentage of total stake needed to vote for a result to be
-	//  considered valid.
+	// Minimum percentage of total stake needed to vote for a result to be
+	// considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	//  Minimum value of Veto votes to Total votes ratio for proposal to be
-	//  vetoed. Default value: 1/3.
+	// Minimum value of Veto votes to Total votes ratio for proposal to be
+	// vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -754,6 +800,28 @@ type Params struct {
 	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
 	// burn deposits if quorum with vote type no_veto is met
 	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// cross_chain_call_targets defines the whitelist of the contract addresses in hex format
+	// on the destination chains which can be called by MsgExecuteCrossChainCall.
+	CrossChainCallTargets []string `protobuf:"bytes,16,rep,name=cross_chain_call_targets,json=crossChainCallTargets,proto3" json:"cross_chain_call_targets,omitempty"`
+	// Duration of the voting period of the expedited proposals, expedited proposals
+	// are disabled if it is not set.
+	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,17,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
+	// Minimum percentage of total stake needed to vote for an expedited proposal to be
+	// considered valid.
+	ExpeditedQuorum string `protobuf:"bytes,18,opt,name=expedited_quorum,json=expeditedQuorum,proto3" json:"expedited_quorum,omitempty"`
+	// Minimum proportion of Yes votes for an expedited proposal to pass.
+	ExpeditedThreshold string `protobuf:"bytes,19,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
+	// expedited_msg_type_urls defines the type urls of the messages allowed in the
+	// expedited proposals.
+	ExpeditedMsgTypeUrls []string `protobuf:"bytes,20,rep,name=expedited_msg_type_urls,json=expeditedMsgTypeUrls,proto3" json:"expedited_msg_type_urls,omitempty"`
+	// allowed_msg_type_urls defines the type urls of the messages allowed in the proposals,
+	// all the messages are allowed if it is empty.
+	AllowedMsgTypeUrls []string `protobuf:"bytes,21,rep,name=allowed_msg_type_urls,json=allowedMsgTypeUrls,proto3" json:"allowed_msg_type_urls,omitempty"`
+	// denied_msg_type_urls defines the type urls of the messages denied in the proposals.
+	DeniedMsgTypeUrls []string `protobuf:"bytes,22,rep,name=denied_msg_type_urls,json=deniedMsgTypeUrls,proto3" json:"denied_msg_type_urls,omitempty"`
+	// msg_type_rules defines the higher minimum deposit and threshold of the proposals
+	// containing the messages of the type urls.
+	MsgTypeRules []MsgTypeRule `protobuf:"bytes,23,rep,name=msg_type_rules,json=msgTypeRules,proto3" json:"msg_type_rules"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -859,6 +927,129 @@ func (m *Params) GetBurnVoteVeto() bool {
 	return false
 }
 
+func (m *Params) GetCrossChainCallTargets() []string {
+	if m != nil {
+		return m.CrossChainCallTargets
+	}
+	return nil
+}
+
+func (m *Params) GetExpeditedVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetExpeditedQuorum() string {
+	if m != nil {
+		return m.ExpeditedQuorum
+	}
+	return ""
+}
+
+func (m *Params) GetExpeditedThreshold() string {
+	if m != nil {
+		return m.ExpeditedThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetExpeditedMsgTypeUrls() []string {
+	if m != nil {
+		return m.ExpeditedMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedMsgTypeUrls() []string {
+	if m != nil {
+		return m.AllowedMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetDeniedMsgTypeUrls() []string {
+	if m != nil {
+		return m.DeniedMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetMsgTypeRules() []MsgTypeRule {
+	if m != nil {
+		return m.MsgTypeRules
+	}
+	return nil
+}
+
+// MsgTypeRule defines the higher minimum deposit and threshold required by the proposals
+// containing the messages of a type url.
+type MsgTypeRule struct {
+	// msg_type_url is the type url of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// min_deposit is the minimum deposit of the proposals containing the message, it is
+	// only applied if it is higher than the minimum deposit.
+	MinDeposit []types.Coin `protobuf:"bytes,2,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	// threshold is the minimum proportion of Yes votes for the proposals containing the
+	// message to pass, it is only applied if it is set and higher than the threshold.
+	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *MsgTypeRule) Reset()         { *m = MsgTypeRule{} }
+func (m *MsgTypeRule) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeRule) ProtoMessage()    {}
+func (*MsgTypeRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
+}
+func (m *MsgTypeRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeRule.Merge(m, src)
+}
+func (m *MsgTypeRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeRule proto.InternalMessageInfo
+
+func (m *MsgTypeRule) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTypeRule) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
+func (m *MsgTypeRule) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
 // CrossChainParamsChange defines the parameter change or contract upgrade
 type CrossChainParamsChange struct {
 	// parameter to be updated or 'upgrade' for contract upgrade
@@ -873,7 +1064,7 @@ func (m *CrossChainParamsChange) Reset()         { *m = CrossChainParamsChange{}
 func (m *CrossChainParamsChange) String() string { return proto.CompactTextString(m) }
 func (*CrossChainParamsChange) ProtoMessage()    {}
 func (*CrossChainParamsChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{9}
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
 }
 func (m *CrossChainParamsChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -923,9 +1114,110 @@ func (m *CrossChainParamsChange) GetTargets() []string {
 	return nil
 }
 
+// CrossChainCall defines a contract call sent to the destination chain by a proposal.
+type CrossChainCall struct {
+	// proposal_id is the id of the proposal executing the call.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// dest_chain_id is the id of the destination chain.
+	DestChainId uint32 `protobuf:"varint,2,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// sequence is the sequence of the cross chain package.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// target is the contract address in hex format on the destination chain.
+	Target string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	// calldata is the ABI-encoded calldata of the call.
+	Calldata []byte `protobuf:"bytes,5,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// status is the execution status of the call.
+	Status CrossChainCallStatus `protobuf:"varint,6,opt,name=status,proto3,enum=cosmos.gov.v1.CrossChainCallStatus" json:"status,omitempty"`
+	// result is the payload of the ack or fail ack package.
+	Result []byte `protobuf:"bytes,7,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *CrossChainCall) Reset()         { *m = CrossChainCall{} }
+func (m *CrossChainCall) String() string { return proto.CompactTextString(m) }
+func (*CrossChainCall) ProtoMessage()    {}
+func (*CrossChainCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *CrossChainCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossChainCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossChainCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossChainCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossChainCall.Merge(m, src)
+}
+func (m *CrossChainCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossChainCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossChainCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossChainCall proto.InternalMessageInfo
+
+func (m *CrossChainCall) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *CrossChainCall) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *CrossChainCall) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *CrossChainCall) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *CrossChainCall) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
+}
+
+func (m *CrossChainCall) GetStatus() CrossChainCallStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CrossChainCallStatus_CROSS_CHAIN_CALL_STATUS_UNSPECIFIED
+}
+
+func (m *CrossChainCall) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterEnum("cosmos.gov.v1.CrossChainCallStatus", CrossChainCallStatus_name, CrossChainCallStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
@@ -935,98 +1227,123 @@ func init() {
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*MsgTypeRule)(nil), "cosmos.gov.v1.MsgTypeRule")
 	proto.RegisterType((*CrossChainParamsChange)(nil), "cosmos.gov.v1.CrossChainParamsChange")
+	proto.RegisterType((*CrossChainCall)(nil), "cosmos.gov.v1.CrossChainCall")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x72, 0x13, 0xc7,
-	0x16, 0xf6, 0x58, 0xb2, 0x2c, 0x1d, 0x5b, 0xb2, 0x68, 0x7c, 0xcd, 0xd8, 0x60, 0xd9, 0xe8, 0x52,
-	0x94, 0x2f, 0x3f, 0xd2, 0x35, 0x5c, 0xee, 0x86, 0x6c, 0x64, 0x6b, 0x08, 0xa2, 0x88, 0xa5, 0x8c,
-	0x06, 0x53, 0xa4, 0x52, 0x35, 0xd5, 0xf6, 0x34, 0xd2, 0x14, 0x9a, 0x69, 0x65, 0xba, 0x25, 0xd0,
-	0x23, 0x64, 0xc7, 0x32, 0x95, 0x55, 0x96, 0x59, 0x66, 0x41, 0xe5, 0x19, 0x58, 0x25, 0x14, 0x9b,
-	0x24, 0x1b, 0x92, 0x82, 0xaa, 0xa4, 0x8a, 0xa7, 0x48, 0xf5, 0xcf, 0x48, 0xb2, 0xac, 0x94, 0x0d,
-	0x1b, 0x7b, 0xfa, 0x9c, 0xef, 0x3b, 0x7d, 0xfa, 0xfc, 0x75, 0x0b, 0xce, 0x1d, 0x52, 0x16, 0x50,
-	0x56, 0x6e, 0xd1, 0x7e, 0xb9, 0xbf, 0x2d, 0xfe, 0x95, 0xba, 0x11, 0xe5, 0x14, 0x65, 0x95, 0xa2,
-	0x24, 0x24, 0xfd, 0xed, 0xb5, 0x82, 0xc6, 0x1d, 0x60, 0x46, 0xca, 0xfd, 0xed, 0x03, 0xc2, 0xf1,
-	0x76, 0xf9, 0x90, 0xfa, 0xa1, 0x82, 0xaf, 0x2d, 0xb7, 0x68, 0x8b, 0xca, 0xcf, 0xb2, 0xf8, 0xd2,
-	0xd2, 0x8d, 0x16, 0xa5, 0xad, 0x0e, 0x29, 0xcb, 0xd5, 0x41, 0xef, 0x71, 0x99, 0xfb, 0x01, 0x61,
-	0x1c, 0x07, 0x5d, 0x0d, 0x58, 0x9d, 0x04, 0xe0, 0x70, 0xa0, 0x55, 0x85, 0x49, 0x95, 0xd7, 0x8b,
-	0x30, 0xf7, 0x69, 0xbc, 0xe3, 0xaa, 0xf2, 0xc8, 0x55, 0x9b, 0x6a, 0x6f, 0x95, 0xea, 0x0c, 0x0e,
-	0xfc, 0x90, 0x96, 0xe5, 0x5f, 0x25, 0x2a, 0x52, 0x40, 0x0f, 0x89, 0xdf, 0x6a, 0x73, 0xe2, 0xed,
-	0x53, 0x4e, 0xea, 0x5d, 0x61, 0x09, 0x6d, 0x43, 0x8a, 0xca, 0x2f, 0xd3, 0xd8, 0x34, 0xb6, 0x72,
-	0x37, 0x56, 0x4b, 0x47, 0x4e, 0x5d, 0x1a, 0x41, 0x6d, 0x0d, 0x44, 0x97, 0x21, 0xf5, 0x54, 0x1a,
-	0x32, 0x67, 0x37, 0x8d, 0xad, 0xcc, 0x4e, 0xee, 0xf5, 0x8b, 0xeb, 0xa0, 0x59, 0x55, 0x72, 0x68,
-	0x6b, 0x6d, 0xf1, 0x3b, 0x03, 0xe6, 0xab, 0xa4, 0x4b, 0x99, 0xcf, 0xd1, 0x06, 0x2c, 0x74, 0x23,
-	0xda, 0xa5, 0x0c, 0x77, 0x5c, 0xdf, 0x93, 0x7b, 0x25, 0x6d, 0x88, 0x45, 0x35, 0x0f, 0xfd, 0x1f,
-	0x32, 0x9e, 0xc2, 0xd2, 0x48, 0xdb, 0x35, 0x5f, 0xbf, 0xb8, 0xbe, 0xac, 0xed, 0x56, 0x3c, 0x2f,
-	0x22, 0x8c, 0x35, 0x79, 0xe4, 0x87, 0x2d, 0x7b, 0x04, 0x45, 0x9f, 0x40, 0x0a, 0x07, 0xb4, 0x17,
-	0x72, 0x33, 0xb1, 0x99, 0xd8, 0x5a, 0x18, 0xf9, 0x2f, 0xd2, 0x54, 0xd2, 0x69, 0x2a, 0xed, 0x52,
-	0x3f, 0xdc, 0xc9, 0xbc, 0x7c, 0xb3, 0x31, 0xf3, 0xfd, 0x5f, 0x3f, 0x5c, 0x31, 0x6c, 0xcd, 0x29,
-	0xfe, 0x39, 0x07, 0xe9, 0x86, 0x76, 0x02, 0xe5, 0x60, 0x76, 0xe8, 0xda, 0xac, 0xef, 0xa1, 0xff,
-	0x42, 0x3a, 0x20, 0x8c, 0xe1, 0x16, 0x61, 0xe6, 0xac, 0x34, 0xbe, 0x5c, 0x52, 0x19, 0x29, 0xc5,
-	0x19, 0x29, 0x55, 0xc2, 0x81, 0x3d, 0x44, 0xa1, 0x5b, 0x90, 0x62, 0x1c, 0xf3, 0x1e, 0x33, 0x13,
-	0x32, 0x98, 0xeb, 0x13, 0xc1, 0x8c, 0xb7, 0x6a, 0x4a, 0x90, 0xad, 0xc1, 0xe8, 0x2e, 0xa0, 0xc7,
-	0x7e, 0x88, 0x3b, 0x2e, 0xc7, 0x9d, 0xce, 0xc0, 0x8d, 0x08, 0xeb, 0x75, 0xb8, 0x99, 0xdc, 0x34,
-	0xb6, 0x16, 0x6e, 0xac, 0x4d, 0x98, 0x70, 0x04, 0xc4, 0x96, 0x08, 0x3b, 0x2f, 0x59, 0x63, 0x12,
-	0x54, 0x81, 0x05, 0xd6, 0x3b, 0x08, 0x7c, 0xee, 0x8a, 0x32, 0x33, 0xe7, 0xb4, 0x89, 0x49, 0xaf,
-	0x9d, 0xb8, 0x06, 0x77, 0x92, 0xcf, 0x7f, 0xdf, 0x30, 0x6c, 0x50, 0x24, 0x21, 0x46, 0xf7, 0x20,
-	0xaf, 0xa3, 0xeb, 0x92, 0xd0, 0x53, 0x76, 0x52, 0xa7, 0xb4, 0x93, 0xd3, 0x4c, 0x2b, 0xf4, 0xa4,
-	0xad, 0x1a, 0x64, 0x39, 0xe5, 0xb8, 0xe3, 0x6a, 0xb9, 0x39, 0xff, 0x01, 0x39, 0x5a, 0x94, 0xd4,
-	0xb8, 0x80, 0xee, 0xc3, 0x99, 0x3e, 0xe5, 0x7e, 0xd8, 0x72, 0x19, 0xc7, 0x91, 0x3e, 0x5f, 0xfa,
-	0x94, 0x7e, 0x2d, 0x29, 0x6a, 0x53, 0x30, 0xa5, 0x63, 0x77, 0x41, 0x8b, 0x46, 0x67, 0xcc, 0x9c,
-	0xd2, 0x56, 0x56, 0x11, 0xe3, 0x23, 0xae, 0x89, 0x22, 0xe1, 0xd8, 0xc3, 0x1c, 0x9b, 0x20, 0xca,
-	0xd6, 0x1e, 0xae, 0xd1, 0x32, 0xcc, 0x71, 0x9f, 0x77, 0x88, 0xb9, 0x20, 0x15, 0x6a, 0x81, 0x4c,
-	0x98, 0x67, 0xbd, 0x20, 0xc0, 0xd1, 0xc0, 0x5c, 0x94, 0xf2, 0x78, 0x89, 0xfe, 0x07, 0x69, 0xd5,
-	0x11, 0x24, 0x32, 0xb3, 0x27, 0xb4, 0xc0, 0x10, 0x89, 0xfe, 0x0d, 0xd9, 0xc7, 0xd8, 0xef, 0x10,
-	0xcf, 0x8d, 0x08, 0x66, 0x34, 0x34, 0x73, 0xd2, 0xea, 0xa2, 0x12, 0xda, 0x52, 0x56, 0xfc, 0xc5,
-	0x80, 0x85, 0xf1, 0x42, 0xb9, 0x0a, 0x99, 0x01, 0x61, 0xee, 0xa1, 0xec, 0x1c, 0xe3, 0x58, 0x1b,
-	0xd7, 0x42, 0x6e, 0xa7, 0x07, 0x84, 0xed, 0x0a, 0x3d, 0xba, 0x09, 0x59, 0x7c, 0xc0, 0x38, 0xf6,
-	0x43, 0x4d, 0x98, 0x9d, 0x4a, 0x58, 0xd4, 0x20, 0x45, 0xfa, 0x0f, 0xa4, 0x43, 0xaa, 0xf1, 0x89,
-	0xa9, 0xf8, 0xf9, 0x90, 0x2a, 0xe8, 0x6d, 0x40, 0x21, 0x75, 0x9f, 0xfa, 0xbc, 0xed, 0xf6, 0x09,
-	0x8f, 0x49, 0xc9, 0xa9, 0xa4, 0xa5, 0x90, 0x3e, 0xf4, 0x79, 0x7b, 0x9f, 0x70, 0x45, 0x2e, 0xfe,
-	0x68, 0x40, 0x52, 0x0c, 0xa9, 0x93, 0x47, 0x4c, 0x09, 0xe6, 0xfa, 0x94, 0x93, 0x93, 0xc7, 0x8b,
-	0x82, 0xa1, 0xdb, 0x30, 0xaf, 0x26, 0x1e, 0x33, 0x93, 0xb2, 0x6e, 0x2f, 0x4e, 0xf4, 0xe2, 0xf1,
-	0x71, 0x6a, 0xc7, 0x8c, 0x23, 0x75, 0x31, 0x77, 0xb4, 0x2e, 0xee, 0x25, 0xd3, 0x89, 0x7c, 0xb2,
-	0xf8, 0x9b, 0x01, 0x59, 0x5d, 0xdd, 0x0d, 0x1c, 0xe1, 0x80, 0xa1, 0x47, 0xb0, 0x10, 0xf8, 0xe1,
-	0xb0, 0x59, 0x8c, 0x93, 0x9a, 0x65, 0x5d, 0x34, 0xcb, 0xfb, 0x37, 0x1b, 0xff, 0x1a, 0x63, 0x5d,
-	0xa3, 0x81, 0xcf, 0x49, 0xd0, 0xe5, 0x03, 0x1b, 0x02, 0x3f, 0x8c, 0xdb, 0x27, 0x00, 0x14, 0xe0,
-	0x67, 0x31, 0xc8, 0xed, 0x92, 0xc8, 0xa7, 0x9e, 0x0c, 0x84, 0xd8, 0x61, 0xb2, 0xe6, 0xab, 0xfa,
-	0x9e, 0xd9, 0xb9, 0xf4, 0xfe, 0xcd, 0xc6, 0x85, 0xe3, 0xc4, 0xd1, 0x26, 0xdf, 0x88, 0x96, 0xc8,
-	0x07, 0xf8, 0x59, 0x7c, 0x12, 0xa9, 0x2f, 0x3a, 0xb0, 0xb8, 0x2f, 0xdb, 0x44, 0x9f, 0xac, 0x0a,
-	0xba, 0x6d, 0xe2, 0x9d, 0x8d, 0x93, 0x76, 0x4e, 0x4a, 0xcb, 0x8b, 0x8a, 0xa5, 0xad, 0x7e, 0x1b,
-	0x17, 0xb1, 0xb6, 0x7a, 0x19, 0x52, 0x5f, 0xf5, 0x68, 0xd4, 0x0b, 0xa6, 0x54, 0xb0, 0xbc, 0x88,
-	0x94, 0x16, 0x5d, 0x83, 0x0c, 0x6f, 0x47, 0x84, 0xb5, 0x69, 0xc7, 0xfb, 0x87, 0x3b, 0x6b, 0x04,
-	0x40, 0xb7, 0x20, 0x27, 0xab, 0x70, 0x44, 0x49, 0x4c, 0xa5, 0x64, 0x05, 0xca, 0x89, 0x41, 0xc5,
-	0x9f, 0x93, 0x90, 0xd2, 0x7e, 0x59, 0x1f, 0x98, 0xc7, 0xb1, 0xa1, 0x37, 0x9e, 0xb3, 0xcf, 0x3e,
-	0x2e, 0x67, 0xc9, 0xe9, 0x39, 0x39, 0x9e, 0x83, 0xc4, 0x47, 0xe4, 0x60, 0x2c, 0xe6, 0xc9, 0xd3,
-	0xc7, 0x7c, 0xee, 0xc3, 0x63, 0x9e, 0x3a, 0x45, 0xcc, 0x51, 0x0d, 0x56, 0x45, 0xa0, 0xfd, 0xd0,
-	0xe7, 0xfe, 0xe8, 0x96, 0x71, 0xa5, 0xfb, 0xe6, 0xfc, 0x54, 0x0b, 0x2b, 0x81, 0x1f, 0xd6, 0x14,
-	0x5e, 0x87, 0xc7, 0x16, 0x68, 0xb4, 0x05, 0xf9, 0x83, 0x5e, 0x14, 0xba, 0xa2, 0xf5, 0x5d, 0x7d,
-	0x42, 0x31, 0x83, 0xd3, 0x76, 0x4e, 0xc8, 0x45, 0x8b, 0x7f, 0xae, 0x4e, 0x56, 0x81, 0x75, 0x89,
-	0x1c, 0x0e, 0x9b, 0x61, 0x82, 0x22, 0x22, 0xd8, 0x72, 0xfe, 0xa6, 0xed, 0x35, 0x01, 0x8a, 0x2f,
-	0xfc, 0x38, 0x13, 0x0a, 0x81, 0x2e, 0x41, 0x6e, 0xb4, 0x99, 0x38, 0x92, 0xb9, 0x24, 0x39, 0x8b,
-	0xf1, 0x56, 0x62, 0xbc, 0x15, 0xbf, 0x84, 0x95, 0xdd, 0x88, 0x32, 0xb6, 0xdb, 0xc6, 0x7e, 0xa8,
-	0x4a, 0x6b, 0xb7, 0x8d, 0xc3, 0x16, 0x41, 0x79, 0x48, 0x3c, 0x21, 0x03, 0x55, 0xf5, 0xb6, 0xf8,
-	0x44, 0x2b, 0x90, 0xea, 0xe3, 0x4e, 0x4f, 0xbf, 0x54, 0x32, 0xb6, 0x5e, 0x89, 0xcb, 0x86, 0xe3,
-	0xa8, 0x45, 0x38, 0x93, 0xef, 0xa3, 0x8c, 0x1d, 0x2f, 0xaf, 0x7c, 0x6d, 0x00, 0x8c, 0xbd, 0x03,
-	0xcf, 0xc3, 0xb9, 0xfd, 0xba, 0x63, 0xb9, 0xf5, 0x86, 0x53, 0xab, 0xef, 0xb9, 0x0f, 0xf6, 0x9a,
-	0x0d, 0x6b, 0xb7, 0x76, 0xa7, 0x66, 0x55, 0xf3, 0x33, 0xe8, 0x2c, 0x2c, 0x8d, 0x2b, 0x1f, 0x59,
-	0xcd, 0xbc, 0x81, 0xce, 0xc1, 0xd9, 0x71, 0x61, 0x65, 0xa7, 0xe9, 0x54, 0x6a, 0x7b, 0xf9, 0x59,
-	0x84, 0x20, 0x37, 0xae, 0xd8, 0xab, 0xe7, 0x13, 0xe8, 0x02, 0x98, 0x47, 0x65, 0xee, 0xc3, 0x9a,
-	0x73, 0xd7, 0xdd, 0xb7, 0x9c, 0x7a, 0x3e, 0x79, 0xe5, 0x27, 0x03, 0x72, 0x47, 0xdf, 0x46, 0x68,
-	0x03, 0xce, 0x37, 0xec, 0x7a, 0xa3, 0xde, 0xac, 0xdc, 0x77, 0x9b, 0x4e, 0xc5, 0x79, 0xd0, 0x9c,
-	0xf0, 0xa9, 0x08, 0x85, 0x49, 0x40, 0xd5, 0x6a, 0xd4, 0x9b, 0x35, 0xc7, 0x6d, 0x58, 0x76, 0xad,
-	0x5e, 0xcd, 0x1b, 0xe8, 0x22, 0xac, 0x4f, 0x62, 0xf6, 0xeb, 0x4e, 0x6d, 0xef, 0xd3, 0x18, 0x32,
-	0x8b, 0xd6, 0x60, 0x65, 0x12, 0xd2, 0xa8, 0x34, 0x9b, 0x56, 0x55, 0x39, 0x3d, 0xa9, 0xb3, 0xad,
-	0x7b, 0xd6, 0xae, 0x63, 0x55, 0xf3, 0xc9, 0x69, 0xcc, 0x3b, 0x95, 0xda, 0x7d, 0xab, 0x9a, 0x9f,
-	0xdb, 0xb1, 0x5e, 0xbe, 0x2d, 0x18, 0xaf, 0xde, 0x16, 0x8c, 0x3f, 0xde, 0x16, 0x8c, 0xe7, 0xef,
-	0x0a, 0x33, 0xaf, 0xde, 0x15, 0x66, 0x7e, 0x7d, 0x57, 0x98, 0xf9, 0xe2, 0x6a, 0xcb, 0xe7, 0xed,
-	0xde, 0x41, 0xe9, 0x90, 0x06, 0xfa, 0xc5, 0xae, 0xff, 0x5d, 0x67, 0xde, 0x93, 0xf2, 0x33, 0xf9,
-	0x2b, 0x84, 0x0f, 0xba, 0x84, 0x89, 0x9f, 0x18, 0x29, 0xd9, 0x93, 0x37, 0xff, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0xce, 0x40, 0x9f, 0xc9, 0xa3, 0x0c, 0x00, 0x00,
+	// 1720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x25, 0x59, 0x96, 0x9e, 0x3e, 0xac, 0x4c, 0xfc, 0xc1, 0x78, 0x13, 0xd9, 0xab, 0x04,
+	0x5b, 0x37, 0xbb, 0x91, 0xea, 0xdd, 0x6e, 0x8b, 0x22, 0x05, 0x0a, 0x59, 0x62, 0x36, 0x0c, 0xb2,
+	0x96, 0x4a, 0x31, 0x4e, 0xb7, 0x28, 0x40, 0xd0, 0xe6, 0x44, 0x26, 0x96, 0xe4, 0x68, 0x39, 0x23,
+	0x25, 0xba, 0xf7, 0xd2, 0xdb, 0x1e, 0x8b, 0x9e, 0x7a, 0xe8, 0xa1, 0xa7, 0xa2, 0x87, 0x45, 0xcf,
+	0x3d, 0xee, 0xa9, 0x58, 0xec, 0xa5, 0xed, 0x25, 0x2d, 0x92, 0x43, 0x81, 0xfd, 0x13, 0x7a, 0x5a,
+	0xcc, 0x70, 0x48, 0x51, 0xb2, 0x0c, 0x3b, 0xb9, 0xd8, 0x9c, 0xf7, 0x7e, 0xbf, 0x37, 0x6f, 0xde,
+	0xd7, 0x90, 0x82, 0xed, 0x53, 0x42, 0x7d, 0x42, 0x5b, 0x43, 0x32, 0x69, 0x4d, 0x0e, 0xf8, 0xbf,
+	0xe6, 0x28, 0x24, 0x8c, 0xa0, 0x4a, 0xa4, 0x68, 0x72, 0xc9, 0xe4, 0x60, 0xa7, 0x2e, 0x71, 0x27,
+	0x36, 0xc5, 0xad, 0xc9, 0xc1, 0x09, 0x66, 0xf6, 0x41, 0xeb, 0x94, 0xb8, 0x41, 0x04, 0xdf, 0xd9,
+	0x18, 0x92, 0x21, 0x11, 0x8f, 0x2d, 0xfe, 0x24, 0xa5, 0xbb, 0x43, 0x42, 0x86, 0x1e, 0x6e, 0x89,
+	0xd5, 0xc9, 0xf8, 0x59, 0x8b, 0xb9, 0x3e, 0xa6, 0xcc, 0xf6, 0x47, 0x12, 0x70, 0x63, 0x11, 0x60,
+	0x07, 0x53, 0xa9, 0xaa, 0x2f, 0xaa, 0x9c, 0x71, 0x68, 0x33, 0x97, 0xc4, 0x3b, 0xde, 0x88, 0x3c,
+	0xb2, 0xa2, 0x4d, 0xa5, 0xb7, 0x91, 0xea, 0x9a, 0xed, 0xbb, 0x01, 0x69, 0x89, 0xbf, 0x91, 0xa8,
+	0x41, 0x00, 0x3d, 0xc5, 0xee, 0xf0, 0x8c, 0x61, 0xe7, 0x98, 0x30, 0xdc, 0x1b, 0x71, 0x4b, 0xe8,
+	0x00, 0xf2, 0x44, 0x3c, 0xa9, 0xca, 0x9e, 0xb2, 0x5f, 0xfd, 0xf0, 0x46, 0x73, 0xee, 0xd4, 0xcd,
+	0x19, 0xd4, 0x90, 0x40, 0xf4, 0x1e, 0xe4, 0x9f, 0x0b, 0x43, 0x6a, 0x66, 0x4f, 0xd9, 0x2f, 0x1e,
+	0x56, 0xbf, 0xfd, 0xea, 0x1e, 0x48, 0x56, 0x17, 0x9f, 0x1a, 0x52, 0xdb, 0xf8, 0xa3, 0x02, 0x6b,
+	0x5d, 0x3c, 0x22, 0xd4, 0x65, 0x68, 0x17, 0x4a, 0xa3, 0x90, 0x8c, 0x08, 0xb5, 0x3d, 0xcb, 0x75,
+	0xc4, 0x5e, 0x39, 0x03, 0x62, 0x91, 0xee, 0xa0, 0x9f, 0x40, 0xd1, 0x89, 0xb0, 0x24, 0x94, 0x76,
+	0xd5, 0x6f, 0xbf, 0xba, 0xb7, 0x21, 0xed, 0xb6, 0x1d, 0x27, 0xc4, 0x94, 0x0e, 0x58, 0xe8, 0x06,
+	0x43, 0x63, 0x06, 0x45, 0x3f, 0x87, 0xbc, 0xed, 0x93, 0x71, 0xc0, 0xd4, 0xec, 0x5e, 0x76, 0xbf,
+	0x34, 0xf3, 0x9f, 0xa7, 0xa9, 0x29, 0xd3, 0xd4, 0xec, 0x10, 0x37, 0x38, 0x2c, 0x7e, 0xfd, 0x72,
+	0x77, 0xe5, 0xcf, 0xff, 0xfb, 0xeb, 0x5d, 0xc5, 0x90, 0x9c, 0xc6, 0x6f, 0xf3, 0x50, 0xe8, 0x4b,
+	0x27, 0x50, 0x15, 0x32, 0x89, 0x6b, 0x19, 0xd7, 0x41, 0x3f, 0x82, 0x82, 0x8f, 0x29, 0xb5, 0x87,
+	0x98, 0xaa, 0x19, 0x61, 0x7c, 0xa3, 0x19, 0x65, 0xa4, 0x19, 0x67, 0xa4, 0xd9, 0x0e, 0xa6, 0x46,
+	0x82, 0x42, 0x1f, 0x43, 0x9e, 0x32, 0x9b, 0x8d, 0xa9, 0x9a, 0x15, 0xc1, 0xbc, 0xb5, 0x10, 0xcc,
+	0x78, 0xab, 0x81, 0x00, 0x19, 0x12, 0x8c, 0x1e, 0x02, 0x7a, 0xe6, 0x06, 0xb6, 0x67, 0x31, 0xdb,
+	0xf3, 0xa6, 0x56, 0x88, 0xe9, 0xd8, 0x63, 0x6a, 0x6e, 0x4f, 0xd9, 0x2f, 0x7d, 0xb8, 0xb3, 0x60,
+	0xc2, 0xe4, 0x10, 0x43, 0x20, 0x8c, 0x9a, 0x60, 0xa5, 0x24, 0xa8, 0x0d, 0x25, 0x3a, 0x3e, 0xf1,
+	0x5d, 0x66, 0xf1, 0x32, 0x53, 0x57, 0xa5, 0x89, 0x45, 0xaf, 0xcd, 0xb8, 0x06, 0x0f, 0x73, 0x5f,
+	0xfe, 0x67, 0x57, 0x31, 0x20, 0x22, 0x71, 0x31, 0x7a, 0x04, 0x35, 0x19, 0x5d, 0x0b, 0x07, 0x4e,
+	0x64, 0x27, 0x7f, 0x45, 0x3b, 0x55, 0xc9, 0xd4, 0x02, 0x47, 0xd8, 0xd2, 0xa1, 0xc2, 0x08, 0xb3,
+	0x3d, 0x4b, 0xca, 0xd5, 0xb5, 0x37, 0xc8, 0x51, 0x59, 0x50, 0xe3, 0x02, 0x7a, 0x0c, 0xd7, 0x26,
+	0x84, 0xb9, 0xc1, 0xd0, 0xa2, 0xcc, 0x0e, 0xe5, 0xf9, 0x0a, 0x57, 0xf4, 0x6b, 0x3d, 0xa2, 0x0e,
+	0x38, 0x53, 0x38, 0xf6, 0x10, 0xa4, 0x68, 0x76, 0xc6, 0xe2, 0x15, 0x6d, 0x55, 0x22, 0x62, 0x7c,
+	0xc4, 0x1d, 0x5e, 0x24, 0xcc, 0x76, 0x6c, 0x66, 0xab, 0xc0, 0xcb, 0xd6, 0x48, 0xd6, 0x68, 0x03,
+	0x56, 0x99, 0xcb, 0x3c, 0xac, 0x96, 0x84, 0x22, 0x5a, 0x20, 0x15, 0xd6, 0xe8, 0xd8, 0xf7, 0xed,
+	0x70, 0xaa, 0x96, 0x85, 0x3c, 0x5e, 0xa2, 0x1f, 0x43, 0x21, 0xea, 0x08, 0x1c, 0xaa, 0x95, 0x4b,
+	0x5a, 0x20, 0x41, 0xa2, 0xdb, 0x50, 0x79, 0x66, 0xbb, 0x1e, 0x76, 0xac, 0x10, 0xdb, 0x94, 0x04,
+	0x6a, 0x55, 0x58, 0x2d, 0x47, 0x42, 0x43, 0xc8, 0xd0, 0x4d, 0x28, 0xe2, 0x17, 0x23, 0xec, 0xb8,
+	0x0c, 0x3b, 0xea, 0xfa, 0x9e, 0xb2, 0x5f, 0x30, 0x66, 0x82, 0xc6, 0x3f, 0x15, 0x28, 0xa5, 0xcb,
+	0xe8, 0x7d, 0x28, 0x4e, 0x31, 0xb5, 0x4e, 0x45, 0x5f, 0x29, 0xe7, 0x9a, 0x5c, 0x0f, 0x98, 0x51,
+	0x98, 0x62, 0xda, 0xe1, 0x7a, 0xf4, 0x11, 0x54, 0xec, 0x13, 0xca, 0x6c, 0x37, 0x90, 0x84, 0xcc,
+	0x52, 0x42, 0x59, 0x82, 0x22, 0xd2, 0x0f, 0xa1, 0x10, 0x10, 0x89, 0xcf, 0x2e, 0xc5, 0xaf, 0x05,
+	0x24, 0x82, 0xde, 0x07, 0x14, 0x10, 0xeb, 0xb9, 0xcb, 0xce, 0xac, 0x09, 0x66, 0x31, 0x29, 0xb7,
+	0x94, 0xb4, 0x1e, 0x90, 0xa7, 0x2e, 0x3b, 0x3b, 0xc6, 0x2c, 0x22, 0x37, 0xfe, 0xa6, 0x40, 0x8e,
+	0x8f, 0xb0, 0xcb, 0x07, 0x50, 0x13, 0x56, 0x27, 0x84, 0xe1, 0xcb, 0x87, 0x4f, 0x04, 0x43, 0xf7,
+	0x61, 0x2d, 0x9a, 0x87, 0x54, 0xcd, 0x89, 0xaa, 0x7e, 0x77, 0xa1, 0x53, 0xcf, 0x0f, 0x5b, 0x23,
+	0x66, 0xcc, 0x55, 0xcd, 0xea, 0x7c, 0xd5, 0x3c, 0xca, 0x15, 0xb2, 0xb5, 0x5c, 0xe3, 0xdf, 0x0a,
+	0x54, 0x64, 0xed, 0xf7, 0xed, 0xd0, 0xf6, 0x29, 0xfa, 0x0c, 0x4a, 0xbe, 0x1b, 0x24, 0xad, 0xa4,
+	0x5c, 0xd6, 0x4a, 0xb7, 0x78, 0x2b, 0x7d, 0xf7, 0x72, 0x77, 0x33, 0xc5, 0xfa, 0x80, 0xf8, 0x2e,
+	0xc3, 0xfe, 0x88, 0x4d, 0x0d, 0xf0, 0xdd, 0x20, 0x6e, 0x2e, 0x1f, 0x90, 0x6f, 0xbf, 0x88, 0x41,
+	0xd6, 0x08, 0x87, 0x2e, 0x71, 0x44, 0x20, 0xf8, 0x0e, 0x8b, 0x1d, 0xd1, 0x95, 0xb7, 0xd0, 0xe1,
+	0x9d, 0xef, 0x5e, 0xee, 0xde, 0x3c, 0x4f, 0x9c, 0x6d, 0xf2, 0x7b, 0xde, 0x30, 0x35, 0xdf, 0x7e,
+	0x11, 0x9f, 0x44, 0xe8, 0x1b, 0x26, 0x94, 0x8f, 0x45, 0x13, 0xc9, 0x93, 0x75, 0x41, 0x36, 0x55,
+	0xbc, 0xb3, 0x72, 0xd9, 0xce, 0x39, 0x61, 0xb9, 0x1c, 0xb1, 0xa4, 0xd5, 0x3f, 0xc4, 0x45, 0x2c,
+	0xad, 0xbe, 0x07, 0xf9, 0x2f, 0xc6, 0x24, 0x1c, 0xfb, 0x4b, 0x2a, 0x58, 0x5c, 0x53, 0x91, 0x16,
+	0x7d, 0x00, 0x45, 0x76, 0x16, 0x62, 0x7a, 0x46, 0x3c, 0xe7, 0x82, 0x1b, 0x6d, 0x06, 0x40, 0x1f,
+	0x43, 0x55, 0x54, 0xe1, 0x8c, 0x92, 0x5d, 0x4a, 0xa9, 0x70, 0x94, 0x19, 0x83, 0x1a, 0x7f, 0x2f,
+	0x40, 0x5e, 0xfa, 0xa5, 0xbd, 0x61, 0x1e, 0x53, 0x23, 0x31, 0x9d, 0xb3, 0x4f, 0xdf, 0x2e, 0x67,
+	0xb9, 0xe5, 0x39, 0x39, 0x9f, 0x83, 0xec, 0x5b, 0xe4, 0x20, 0x15, 0xf3, 0xdc, 0xd5, 0x63, 0xbe,
+	0xfa, 0xe6, 0x31, 0xcf, 0x5f, 0x21, 0xe6, 0x48, 0x87, 0x1b, 0x3c, 0xd0, 0x6e, 0xe0, 0x32, 0x77,
+	0x76, 0x07, 0x59, 0xc2, 0x7d, 0x75, 0x6d, 0xa9, 0x85, 0x2d, 0xdf, 0x0d, 0xf4, 0x08, 0x2f, 0xc3,
+	0x63, 0x70, 0x34, 0xda, 0x87, 0xda, 0xc9, 0x38, 0x0c, 0x2c, 0xde, 0xfa, 0x96, 0x3c, 0x61, 0x45,
+	0x4c, 0xd1, 0x2a, 0x97, 0xf3, 0x16, 0xff, 0x65, 0x74, 0xb2, 0x36, 0xdc, 0x12, 0xc8, 0x64, 0xd8,
+	0x24, 0x09, 0x0a, 0x31, 0x67, 0x8b, 0xe9, 0x5c, 0x30, 0x76, 0x38, 0x28, 0x7e, 0x1d, 0x88, 0x33,
+	0x11, 0x21, 0xd0, 0x1d, 0xa8, 0xce, 0x36, 0xe3, 0x47, 0x92, 0x03, 0xbb, 0x1c, 0x6f, 0xc5, 0xc7,
+	0x1b, 0xfa, 0x29, 0xa8, 0xa7, 0x21, 0xa1, 0xd4, 0x3a, 0x3d, 0x13, 0xa3, 0xd7, 0xf6, 0xf8, 0xfb,
+	0x43, 0x38, 0xc4, 0x8c, 0xaa, 0xb5, 0xbd, 0xec, 0x7e, 0xd1, 0xd8, 0x14, 0xfa, 0x0e, 0x57, 0x77,
+	0x6c, 0xcf, 0x33, 0x23, 0x25, 0x7a, 0x0a, 0xdb, 0xc9, 0xe4, 0xb7, 0xe6, 0x73, 0x7e, 0xed, 0x6a,
+	0x39, 0xdf, 0x4c, 0xf8, 0xc7, 0xe9, 0xe4, 0xff, 0x0c, 0x6a, 0x33, 0xc3, 0x32, 0x48, 0x68, 0x69,
+	0x98, 0xd7, 0x13, 0x9c, 0x8c, 0xda, 0x2f, 0xe0, 0xfa, 0x8c, 0x3a, 0x4b, 0xf3, 0xf5, 0xa5, 0x6c,
+	0x94, 0x40, 0xcd, 0x54, 0x89, 0xa4, 0x0e, 0xe5, 0xd3, 0xa1, 0xc5, 0xa6, 0x23, 0x6c, 0x8d, 0x43,
+	0x8f, 0xaa, 0x1b, 0x22, 0x18, 0x1b, 0x89, 0xfa, 0x53, 0x3a, 0x34, 0xa7, 0x23, 0xfc, 0x24, 0xf4,
+	0x28, 0x3a, 0x80, 0x4d, 0xdb, 0xf3, 0xc8, 0xf3, 0x73, 0xa4, 0x4d, 0x41, 0x42, 0x52, 0x99, 0xa6,
+	0xb4, 0x60, 0xc3, 0xc1, 0x81, 0x7b, 0x8e, 0xb1, 0x25, 0x18, 0xd7, 0x22, 0x5d, 0x9a, 0xf0, 0x00,
+	0xaa, 0x09, 0x32, 0x1c, 0x7b, 0x98, 0xaa, 0xdb, 0xa2, 0xe5, 0x17, 0xdf, 0xec, 0x24, 0xc7, 0x18,
+	0x7b, 0xf8, 0x30, 0xc7, 0x7b, 0xde, 0x28, 0xfb, 0x33, 0x11, 0x6d, 0xfc, 0x49, 0x81, 0x52, 0x0a,
+	0x83, 0xf6, 0xa0, 0x9c, 0xf6, 0x20, 0x9a, 0x72, 0x06, 0xf8, 0xc9, 0xd6, 0x8b, 0x93, 0x26, 0xf3,
+	0x96, 0x93, 0x66, 0xae, 0x59, 0xb3, 0x97, 0x34, 0x6b, 0xe3, 0x37, 0xb0, 0xd5, 0x49, 0xea, 0x2e,
+	0x1a, 0x79, 0x9d, 0x33, 0x3b, 0x18, 0x62, 0x54, 0x83, 0xec, 0xe7, 0x78, 0x2a, 0xfd, 0xe4, 0x8f,
+	0x68, 0x0b, 0xf2, 0x13, 0xdb, 0x1b, 0xcb, 0xf7, 0xeb, 0xa2, 0x21, 0x57, 0xfc, 0x15, 0x29, 0x2e,
+	0xe5, 0xac, 0x50, 0xc4, 0xcb, 0xc6, 0xff, 0x15, 0xa8, 0x76, 0xe6, 0xca, 0xfa, 0xf2, 0x9b, 0xbd,
+	0x01, 0x15, 0x07, 0x53, 0x26, 0x1b, 0xc5, 0x8d, 0x86, 0x64, 0xc5, 0x28, 0x71, 0xa1, 0x30, 0xa3,
+	0x3b, 0xfc, 0x42, 0xa6, 0xf8, 0x8b, 0x31, 0x0e, 0x4e, 0xb1, 0x38, 0x62, 0xce, 0x48, 0xd6, 0xdc,
+	0xcb, 0x68, 0xfb, 0x68, 0xa8, 0x19, 0x72, 0xc5, 0x39, 0xbc, 0xeb, 0x92, 0x4b, 0xbc, 0x6c, 0x24,
+	0x6b, 0x74, 0x3f, 0xf9, 0x12, 0xc8, 0x8b, 0x2f, 0x81, 0xdb, 0x0b, 0xc9, 0x9e, 0x3f, 0xc3, 0xc2,
+	0xf7, 0xc0, 0x16, 0xe4, 0xe5, 0x37, 0xc0, 0x9a, 0x30, 0x2b, 0x57, 0x77, 0x7f, 0xa7, 0x00, 0xa4,
+	0x3e, 0xdd, 0xde, 0x81, 0xed, 0xe3, 0x9e, 0xa9, 0x59, 0xbd, 0xbe, 0xa9, 0xf7, 0x8e, 0xac, 0x27,
+	0x47, 0x83, 0xbe, 0xd6, 0xd1, 0x1f, 0xe8, 0x5a, 0xb7, 0xb6, 0x82, 0xae, 0xc3, 0x7a, 0x5a, 0xf9,
+	0x99, 0x36, 0xa8, 0x29, 0x68, 0x1b, 0xae, 0xa7, 0x85, 0xed, 0xc3, 0x81, 0xd9, 0xd6, 0x8f, 0x6a,
+	0x19, 0x84, 0xa0, 0x9a, 0x56, 0x1c, 0xf5, 0x6a, 0x59, 0x74, 0x13, 0xd4, 0x79, 0x99, 0xf5, 0x54,
+	0x37, 0x1f, 0x5a, 0xc7, 0x9a, 0xd9, 0xab, 0xe5, 0xee, 0xfe, 0x43, 0x81, 0xea, 0xfc, 0xe7, 0x0c,
+	0xda, 0x85, 0x77, 0xfa, 0x46, 0xaf, 0xdf, 0x1b, 0xb4, 0x1f, 0x5b, 0x03, 0xb3, 0x6d, 0x3e, 0x19,
+	0x2c, 0xf8, 0xd4, 0x80, 0xfa, 0x22, 0xa0, 0xab, 0xf5, 0x7b, 0x03, 0xdd, 0xb4, 0xfa, 0x9a, 0xa1,
+	0xf7, 0xba, 0x35, 0x05, 0xbd, 0x0b, 0xb7, 0x16, 0x31, 0xc7, 0x3d, 0x53, 0x3f, 0xfa, 0x24, 0x86,
+	0x64, 0xd0, 0x0e, 0x6c, 0x2d, 0x42, 0xfa, 0xed, 0xc1, 0x40, 0xeb, 0x46, 0x4e, 0x2f, 0xea, 0x0c,
+	0xed, 0x91, 0xd6, 0x31, 0xb5, 0x6e, 0x2d, 0xb7, 0x8c, 0xf9, 0xa0, 0xad, 0x3f, 0xd6, 0xba, 0xb5,
+	0xd5, 0xbb, 0x7f, 0x51, 0x60, 0x63, 0x59, 0x56, 0xd0, 0x0f, 0xe0, 0x76, 0xc7, 0xe8, 0x0d, 0x06,
+	0x56, 0xe7, 0x61, 0x5b, 0x3f, 0xb2, 0x3a, 0xed, 0xc7, 0x17, 0x1c, 0xef, 0x36, 0xec, 0x5e, 0x04,
+	0xec, 0x6b, 0x47, 0x5d, 0xfd, 0xe8, 0x93, 0x9a, 0x82, 0xee, 0xc0, 0xde, 0x45, 0x20, 0xed, 0x57,
+	0x5a, 0xe7, 0x09, 0x77, 0x34, 0xc3, 0x23, 0x75, 0x11, 0x4a, 0x3a, 0x9c, 0x3d, 0xd4, 0xbe, 0x7e,
+	0x55, 0x57, 0xbe, 0x79, 0x55, 0x57, 0xfe, 0xfb, 0xaa, 0xae, 0x7c, 0xf9, 0xba, 0xbe, 0xf2, 0xcd,
+	0xeb, 0xfa, 0xca, 0xbf, 0x5e, 0xd7, 0x57, 0x7e, 0xfd, 0xfe, 0xd0, 0x65, 0x67, 0xe3, 0x93, 0xe6,
+	0x29, 0xf1, 0xe5, 0xaf, 0x02, 0xf2, 0xdf, 0x3d, 0xea, 0x7c, 0xde, 0x7a, 0x21, 0x7e, 0xe9, 0xe0,
+	0x63, 0x83, 0xb6, 0x26, 0x07, 0x27, 0x79, 0x31, 0xe5, 0x3f, 0xfa, 0x3e, 0x00, 0x00, 0xff, 0xff,
+	0x3e, 0xa2, 0x7e, 0x75, 0x07, 0x11, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1133,6 +1450,16 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
 	if len(m.FailedReason) > 0 {
 		i -= len(m.FailedReason)
 		copy(dAtA[i:], m.FailedReason)
@@ -1512,6 +1839,96 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgTypeRules) > 0 {
+		for iNdEx := len(m.MsgTypeRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.DeniedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.DeniedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.DeniedMsgTypeUrls[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.DeniedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.AllowedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypeUrls[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.AllowedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.ExpeditedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.ExpeditedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpeditedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.ExpeditedMsgTypeUrls[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ExpeditedQuorum) > 0 {
+		i -= len(m.ExpeditedQuorum)
+		copy(dAtA[i:], m.ExpeditedQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedQuorum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.CrossChainCallTargets) > 0 {
+		for iNdEx := len(m.CrossChainCallTargets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CrossChainCallTargets[iNdEx])
+			copy(dAtA[i:], m.CrossChainCallTargets[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.CrossChainCallTargets[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if m.BurnVoteVeto {
 		i--
 		if m.BurnVoteVeto {
@@ -1571,22 +1988,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1607,7 +2024,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CrossChainParamsChange) Marshal() (dAtA []byte, err error) {
+func (m *MsgTypeRule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1617,44 +2034,159 @@ func (m *CrossChainParamsChange) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CrossChainParamsChange) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTypeRule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CrossChainParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTypeRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Targets) > 0 {
-		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Targets[iNdEx])
-			copy(dAtA[i:], m.Targets[iNdEx])
-			i = encodeVarintGov(dAtA, i, uint64(len(m.Targets[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Values) > 0 {
-		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Values[iNdEx])
-			copy(dAtA[i:], m.Values[iNdEx])
-			i = encodeVarintGov(dAtA, i, uint64(len(m.Values[iNdEx])))
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Key)))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
+func (m *CrossChainParamsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossChainParamsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossChainParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Targets[iNdEx])
+			copy(dAtA[i:], m.Targets[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Targets[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossChainCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossChainCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossChainCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1768,6 +2300,9 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1920,6 +2455,71 @@ func (m *Params) Size() (n int) {
 	if m.BurnVoteVeto {
 		n += 2
 	}
+	if len(m.CrossChainCallTargets) > 0 {
+		for _, s := range m.CrossChainCallTargets {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ExpeditedQuorum)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.ExpeditedMsgTypeUrls) > 0 {
+		for _, s := range m.ExpeditedMsgTypeUrls {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for _, s := range m.AllowedMsgTypeUrls {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.DeniedMsgTypeUrls) > 0 {
+		for _, s := range m.DeniedMsgTypeUrls {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.MsgTypeRules) > 0 {
+		for _, e := range m.MsgTypeRules {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTypeRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1948,6 +2548,39 @@ func (m *CrossChainParamsChange) Size() (n int) {
 	return n
 }
 
+func (m *CrossChainCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovGov(uint64(m.DestChainId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGov(uint64(m.Sequence))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2665,6 +3298,26 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.FailedReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3706,59 +4359,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.BurnVoteVeto = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CrossChainParamsChange) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CrossChainParamsChange: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CrossChainParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainCallTargets", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3786,13 +4389,13 @@ func (m *CrossChainParamsChange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.CrossChainCallTargets = append(m.CrossChainCallTargets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3802,27 +4405,31 @@ func (m *CrossChainParamsChange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			if m.ExpeditedVotingPeriod == nil {
+				m.ExpeditedVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedQuorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3850,7 +4457,689 @@ func (m *CrossChainParamsChange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Targets = append(m.Targets, string(dAtA[iNdEx:postIndex]))
+			m.ExpeditedQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedMsgTypeUrls = append(m.ExpeditedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypeUrls = append(m.AllowedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedMsgTypeUrls = append(m.DeniedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeRules = append(m.MsgTypeRules, MsgTypeRule{})
+			if err := m.MsgTypeRules[len(m.MsgTypeRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTypeRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossChainParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossChainParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossChainParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossChainCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossChainCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossChainCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CrossChainCallStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
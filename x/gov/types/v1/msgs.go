@@ -15,8 +15,8 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgUpdateCrossChainParams{}
-	_, _                codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgUpdateCrossChainParams{}, &MsgExecuteCrossChainCall{}
+	_, _                   codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -329,3 +329,42 @@ func (msg MsgUpdateCrossChainParams) GetSigners() []sdk.AccAddress {
 	authority, _ := sdk.AccAddressFromHexUnsafe(msg.Authority)
 	return []sdk.AccAddress{authority}
 }
+
+// NewMsgExecuteCrossChainCall creates a new MsgExecuteCrossChainCall instance
+func NewMsgExecuteCrossChainCall(authority string, destChainID sdk.ChainID, target string, calldata []byte) *MsgExecuteCrossChainCall {
+	return &MsgExecuteCrossChainCall{
+		Authority:   authority,
+		DestChainId: uint32(destChainID),
+		Target:      target,
+		Calldata:    calldata,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgExecuteCrossChainCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if uint32(sdk.ChainID(msg.DestChainId)) != msg.DestChainId {
+		return types.ErrChainNotSupported.Wrapf("invalid destination chain id %d", msg.DestChainId)
+	}
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.Target); err != nil {
+		return types.ErrAddressNotValid.Wrapf("invalid target address: %s", err)
+	}
+	if len(msg.Calldata) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("calldata cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgExecuteCrossChainCall) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgExecuteCrossChainCall.
+func (msg MsgExecuteCrossChainCall) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromHexUnsafe(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"cosmossdk.io/math"
@@ -12,6 +13,8 @@ import (
 // Default period for deposits & voting
 const (
 	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	// DefaultExpeditedPeriod is the default voting period of the expedited proposals
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24 // 1 day
 )
 
 // Default governance params
@@ -24,6 +27,27 @@ var (
 	DefaultBurnProposalPrevote    = false // set to false to replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteQuorom         = false // set to false to  replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteVeto           = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultExpeditedQuorum        = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold     = sdk.NewDecWithPrec(667, 3)
+	// DefaultExpeditedMsgTypeURLs defines the messages for the bridge emergencies, e.g. closing a channel
+	// or impeaching a validator, which are allowed in the expedited proposals.
+	DefaultExpeditedMsgTypeURLs = []string{
+		"/cosmos.crosschain.v1.MsgUpdateChannelPermissions",
+		"/cosmos.slashing.v1beta1.MsgImpeach",
+	}
+	// DefaultMsgTypeRuleThreshold is the default threshold of the messages which should not pass with an
+	// ordinary majority, e.g. minting the tokens of the crosschain module.
+	DefaultMsgTypeRuleThreshold = sdk.NewDecWithPrec(667, 3)
+	DefaultMsgTypeRuleURLs      = []string{
+		"/cosmos.crosschain.v1.MsgMintModuleTokens",
+	}
+
+	// MinMsgTypeThresholds are the minimum thresholds of the proposals containing the messages of the type
+	// urls, they are enforced regardless of the rules in the params, e.g. of the chains whose params were
+	// migrated without the rules.
+	MinMsgTypeThresholds = map[string]sdk.Dec{
+		"/cosmos.crosschain.v1.MsgMintModuleTokens": DefaultMsgTypeRuleThreshold,
+	}
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -71,7 +95,7 @@ func NewParams(
 
 // DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
 		DefaultPeriod,
@@ -83,6 +107,18 @@ func DefaultParams() Params {
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
 	)
+	expeditedPeriod := DefaultExpeditedPeriod
+	params.ExpeditedVotingPeriod = &expeditedPeriod
+	params.ExpeditedQuorum = DefaultExpeditedQuorum.String()
+	params.ExpeditedThreshold = DefaultExpeditedThreshold.String()
+	params.ExpeditedMsgTypeUrls = append([]string{}, DefaultExpeditedMsgTypeURLs...)
+	for _, typeURL := range DefaultMsgTypeRuleURLs {
+		params.MsgTypeRules = append(params.MsgTypeRules, MsgTypeRule{
+			MsgTypeUrl: typeURL,
+			Threshold:  DefaultMsgTypeRuleThreshold.String(),
+		})
+	}
+	return params
 }
 
 // ValidateBasic performs basic validation on governance parameters.
@@ -140,5 +176,186 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("voting period must be positive: %s", p.VotingPeriod)
 	}
 
+	if err := ValidateCrossChainCallTargets(p.CrossChainCallTargets); err != nil {
+		return err
+	}
+
+	if err := p.validateExpedited(quorum, threshold); err != nil {
+		return err
+	}
+
+	return p.validateMsgTypeRules(threshold)
+}
+
+// validateExpedited performs basic validation on the expedited proposal parameters,
+// they are only validated if the expedited proposals are enabled.
+func (p Params) validateExpedited(quorum, threshold sdk.Dec) error {
+	if !p.ExpeditedEnabled() {
+		return nil
+	}
+
+	if p.ExpeditedVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", p.ExpeditedVotingPeriod)
+	}
+	if *p.ExpeditedVotingPeriod >= *p.VotingPeriod {
+		return fmt.Errorf("expedited voting period %s must be shorter than the voting period %s", p.ExpeditedVotingPeriod, p.VotingPeriod)
+	}
+
+	expeditedQuorum, err := sdk.NewDecFromStr(p.ExpeditedQuorum)
+	if err != nil {
+		return fmt.Errorf("invalid expedited quorum string: %w", err)
+	}
+	if expeditedQuorum.LT(quorum) {
+		return fmt.Errorf("expedited quorum %s cannot be lower than the quorum %s", expeditedQuorum, quorum)
+	}
+	if expeditedQuorum.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("expedited quorum too large: %s", expeditedQuorum)
+	}
+
+	expeditedThreshold, err := sdk.NewDecFromStr(p.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if expeditedThreshold.LTE(threshold) {
+		return fmt.Errorf("expedited threshold %s must be greater than the threshold %s", expeditedThreshold, threshold)
+	}
+	if expeditedThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("expedited threshold too large: %s", expeditedThreshold)
+	}
+
+	return validateMsgTypeURLs("expedited", p.ExpeditedMsgTypeUrls)
+}
+
+// validateMsgTypeRules performs basic validation on the allowlist, the denylist and
+// the rules of the message type urls.
+func (p Params) validateMsgTypeRules(threshold sdk.Dec) error {
+	if err := validateMsgTypeURLs("allowed", p.AllowedMsgTypeUrls); err != nil {
+		return err
+	}
+	if err := validateMsgTypeURLs("denied", p.DeniedMsgTypeUrls); err != nil {
+		return err
+	}
+	for _, typeURL := range p.DeniedMsgTypeUrls {
+		if containsString(p.AllowedMsgTypeUrls, typeURL) {
+			return fmt.Errorf("msg type url cannot be both allowed and denied: %s", typeURL)
+		}
+	}
+
+	seen := make(map[string]bool, len(p.MsgTypeRules))
+	for _, rule := range p.MsgTypeRules {
+		if len(strings.TrimSpace(rule.MsgTypeUrl)) == 0 {
+			return fmt.Errorf("msg type rule url cannot be empty")
+		}
+		if seen[rule.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg type rule: %s", rule.MsgTypeUrl)
+		}
+		seen[rule.MsgTypeUrl] = true
+
+		if len(rule.MinDeposit) == 0 && rule.Threshold == "" {
+			return fmt.Errorf("msg type rule of %s must set the minimum deposit or the threshold", rule.MsgTypeUrl)
+		}
+		if minDeposit := sdk.Coins(rule.MinDeposit); !minDeposit.IsValid() {
+			return fmt.Errorf("invalid minimum deposit of msg type rule %s: %s", rule.MsgTypeUrl, minDeposit)
+		}
+		if rule.Threshold == "" {
+			continue
+		}
+		ruleThreshold, err := sdk.NewDecFromStr(rule.Threshold)
+		if err != nil {
+			return fmt.Errorf("invalid threshold string of msg type rule %s: %w", rule.MsgTypeUrl, err)
+		}
+		if ruleThreshold.LTE(threshold) {
+			return fmt.Errorf("threshold of msg type rule %s must be greater than the threshold %s", rule.MsgTypeUrl, threshold)
+		}
+		if ruleThreshold.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("threshold of msg type rule %s too large: %s", rule.MsgTypeUrl, ruleThreshold)
+		}
+	}
+
 	return nil
 }
+
+func validateMsgTypeURLs(name string, typeURLs []string) error {
+	seen := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if len(strings.TrimSpace(typeURL)) == 0 {
+			return fmt.Errorf("%s msg type url cannot be empty", name)
+		}
+		if seen[typeURL] {
+			return fmt.Errorf("duplicate %s msg type url: %s", name, typeURL)
+		}
+		seen[typeURL] = true
+	}
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpeditedEnabled returns true if the expedited proposals are enabled.
+func (p Params) ExpeditedEnabled() bool {
+	return p.ExpeditedVotingPeriod != nil
+}
+
+// IsExpeditedMsgTypeURL returns true if the messages of the type url are allowed in the expedited proposals.
+func (p Params) IsExpeditedMsgTypeURL(typeURL string) bool {
+	return containsString(p.ExpeditedMsgTypeUrls, typeURL)
+}
+
+// IsMsgTypeURLAllowed returns true if the messages of the type url can be proposed, they must not
+// be denied, and must be allowed if the allowlist is not empty.
+func (p Params) IsMsgTypeURLAllowed(typeURL string) bool {
+	if containsString(p.DeniedMsgTypeUrls, typeURL) {
+		return false
+	}
+	return len(p.AllowedMsgTypeUrls) == 0 || containsString(p.AllowedMsgTypeUrls, typeURL)
+}
+
+// DisallowedMsgTypeURL returns the first type url which can't be proposed, it returns false if all
+// the type urls can be proposed.
+func (p Params) DisallowedMsgTypeURL(typeURLs []string) (string, bool) {
+	for _, typeURL := range typeURLs {
+		if !p.IsMsgTypeURLAllowed(typeURL) {
+			return typeURL, true
+		}
+	}
+	return "", false
+}
+
+// ProposalMinDeposit returns the minimum deposit of a proposal containing the messages of the type urls,
+// it is raised by the minimum deposits of the rules of the message types.
+func (p Params) ProposalMinDeposit(typeURLs []string) sdk.Coins {
+	minDeposit := sdk.NewCoins(p.MinDeposit...)
+	for _, rule := range p.MsgTypeRules {
+		if containsString(typeURLs, rule.MsgTypeUrl) {
+			minDeposit = minDeposit.Max(sdk.NewCoins(rule.MinDeposit...))
+		}
+	}
+	return minDeposit
+}
+
+// ProposalThreshold returns the threshold of a proposal containing the messages of the type urls,
+// it is raised from the given threshold by the minimum thresholds of the message types and the
+// thresholds of the rules of the message types.
+func (p Params) ProposalThreshold(typeURLs []string, threshold sdk.Dec) sdk.Dec {
+	for _, typeURL := range typeURLs {
+		if minThreshold, ok := MinMsgTypeThresholds[typeURL]; ok {
+			threshold = sdk.MaxDec(threshold, minThreshold)
+		}
+	}
+	for _, rule := range p.MsgTypeRules {
+		if rule.Threshold == "" || !containsString(typeURLs, rule.MsgTypeUrl) {
+			continue
+		}
+		if ruleThreshold, err := sdk.NewDecFromStr(rule.Threshold); err == nil {
+			threshold = sdk.MaxDec(threshold, ruleThreshold)
+		}
+	}
+	return threshold
+}
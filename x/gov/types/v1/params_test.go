@@ -0,0 +1,51 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+func TestMsgTypeURLs(t *testing.T) {
+	govAddr := sdk.AccAddress("gov_________________")
+	bankMsg := &banktypes.MsgSend{FromAddress: govAddr.String(), ToAddress: govAddr.String()}
+	execMsg := authz.NewMsgExec(govAddr, []sdk.Msg{bankMsg})
+	legacyMsg, err := v1.NewLegacyContent(v1beta1.NewTextProposal("title", "description"), govAddr.String())
+	require.NoError(t, err)
+
+	// the messages nested in the messages are included
+	typeURLs := v1.MsgTypeURLs([]sdk.Msg{&execMsg, legacyMsg})
+	require.Equal(t, []string{
+		sdk.MsgTypeURL(&execMsg),
+		sdk.MsgTypeURL(bankMsg),
+		sdk.MsgTypeURL(legacyMsg),
+		"/cosmos.gov.v1beta1.TextProposal",
+	}, typeURLs)
+
+	params := v1.DefaultParams()
+	params.DeniedMsgTypeUrls = []string{sdk.MsgTypeURL(bankMsg)}
+	typeURL, found := params.DisallowedMsgTypeURL(typeURLs)
+	require.True(t, found)
+	require.Equal(t, sdk.MsgTypeURL(bankMsg), typeURL)
+}
+
+func TestProposalThreshold(t *testing.T) {
+	mintTypeURL := "/cosmos.crosschain.v1.MsgMintModuleTokens"
+	threshold := sdk.NewDecWithPrec(5, 1)
+
+	// the minimum threshold is enforced without the rule in the params
+	params := v1.DefaultParams()
+	params.MsgTypeRules = nil
+	require.Equal(t, v1.DefaultMsgTypeRuleThreshold, params.ProposalThreshold([]string{mintTypeURL}, threshold))
+	require.Equal(t, threshold, params.ProposalThreshold([]string{"/cosmos.bank.v1beta1.MsgSend"}, threshold))
+
+	// the rules can raise the threshold above the minimum
+	params.MsgTypeRules = []v1.MsgTypeRule{{MsgTypeUrl: mintTypeURL, Threshold: "0.9"}}
+	require.Equal(t, sdk.NewDecWithPrec(9, 1), params.ProposalThreshold([]string{mintTypeURL}, threshold))
+}
@@ -52,6 +52,45 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
 }
 
+// MsgTypeURLs returns the type urls of the messages of the proposal, including the messages nested in them.
+func (p Proposal) MsgTypeURLs() []string {
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		typeURLs := make([]string, len(p.Messages))
+		for i, msg := range p.Messages {
+			typeURLs[i] = msg.TypeUrl
+		}
+		return typeURLs
+	}
+	return MsgTypeURLs(msgs)
+}
+
+// nestedMsgs is implemented by the messages executing other messages, e.g. the authz MsgExec.
+type nestedMsgs interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// MsgTypeURLs returns the type urls of the messages and of the messages nested in them, i.e. the messages
+// executed by a MsgExec of authz and the content of a MsgExecLegacyContent, so the rules of the message
+// types can't be bypassed by wrapping the messages.
+func MsgTypeURLs(msgs []sdk.Msg) []string {
+	typeURLs := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		typeURLs = append(typeURLs, sdk.MsgTypeURL(msg))
+		switch msg := msg.(type) {
+		case *MsgExecLegacyContent:
+			if msg.Content != nil {
+				typeURLs = append(typeURLs, msg.Content.TypeUrl)
+			}
+		case nestedMsgs:
+			if nested, err := msg.GetMessages(); err == nil {
+				typeURLs = append(typeURLs, MsgTypeURLs(nested)...)
+			}
+		}
+	}
+	return typeURLs
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, p.Messages)
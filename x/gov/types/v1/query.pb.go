@@ -893,6 +893,98 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryCrossChainCallsRequest is the request type for the Query/CrossChainCalls RPC method.
+type QueryCrossChainCallsRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryCrossChainCallsRequest) Reset()         { *m = QueryCrossChainCallsRequest{} }
+func (m *QueryCrossChainCallsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossChainCallsRequest) ProtoMessage()    {}
+func (*QueryCrossChainCallsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{16}
+}
+func (m *QueryCrossChainCallsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCrossChainCallsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossChainCallsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCrossChainCallsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossChainCallsRequest.Merge(m, src)
+}
+func (m *QueryCrossChainCallsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrossChainCallsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossChainCallsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCrossChainCallsRequest proto.InternalMessageInfo
+
+func (m *QueryCrossChainCallsRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryCrossChainCallsResponse is the response type for the Query/CrossChainCalls RPC method.
+type QueryCrossChainCallsResponse struct {
+	// calls defines the cross chain calls executed by the proposal.
+	Calls []*CrossChainCall `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (m *QueryCrossChainCallsResponse) Reset()         { *m = QueryCrossChainCallsResponse{} }
+func (m *QueryCrossChainCallsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossChainCallsResponse) ProtoMessage()    {}
+func (*QueryCrossChainCallsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{17}
+}
+func (m *QueryCrossChainCallsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCrossChainCallsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossChainCallsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCrossChainCallsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossChainCallsResponse.Merge(m, src)
+}
+func (m *QueryCrossChainCallsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrossChainCallsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossChainCallsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCrossChainCallsResponse proto.InternalMessageInfo
+
+func (m *QueryCrossChainCallsResponse) GetCalls() []*CrossChainCall {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1.QueryProposalResponse")
@@ -910,73 +1002,80 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryCrossChainCallsRequest)(nil), "cosmos.gov.v1.QueryCrossChainCallsRequest")
+	proto.RegisterType((*QueryCrossChainCallsResponse)(nil), "cosmos.gov.v1.QueryCrossChainCallsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 964 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x6f, 0xdc, 0x54,
-	0x14, 0x8e, 0x27, 0x8f, 0xce, 0x9c, 0x34, 0x01, 0x4e, 0x1f, 0x19, 0x4c, 0x99, 0x06, 0x87, 0x26,
-	0x81, 0x12, 0x5f, 0x26, 0x7d, 0x49, 0x50, 0x16, 0x0d, 0x25, 0x05, 0x89, 0x45, 0x98, 0x56, 0x2c,
-	0xd8, 0x44, 0x4e, 0xc6, 0x32, 0x16, 0x13, 0x5f, 0x77, 0xee, 0x9d, 0x11, 0x21, 0x8d, 0x90, 0x2a,
-	0x21, 0x58, 0x01, 0x12, 0x15, 0xf0, 0x43, 0xf8, 0x11, 0x2c, 0x2b, 0xd8, 0x20, 0x56, 0x28, 0xe1,
-	0x87, 0x20, 0xdf, 0x7b, 0xec, 0xb1, 0x1d, 0x8f, 0x33, 0x53, 0x55, 0xac, 0x22, 0xdf, 0xfb, 0x9d,
-	0xef, 0x7c, 0xe7, 0x79, 0x33, 0xf0, 0xf2, 0x2e, 0x17, 0x7b, 0x5c, 0x30, 0x8f, 0xf7, 0x59, 0xbf,
-	0xc9, 0x1e, 0xf6, 0xdc, 0xee, 0xbe, 0x1d, 0x76, 0xb9, 0xe4, 0x38, 0xa7, 0xaf, 0x6c, 0x8f, 0xf7,
-	0xed, 0x7e, 0xd3, 0x7c, 0x93, 0x90, 0x3b, 0x8e, 0x70, 0x35, 0x8e, 0xf5, 0x9b, 0x3b, 0xae, 0x74,
-	0x9a, 0x2c, 0x74, 0x3c, 0x3f, 0x70, 0xa4, 0xcf, 0x03, 0x6d, 0x6a, 0x5e, 0xf2, 0x38, 0xf7, 0x3a,
-	0x2e, 0x73, 0x42, 0x9f, 0x39, 0x41, 0xc0, 0xa5, 0xba, 0x14, 0x74, 0xbb, 0x90, 0xf5, 0x19, 0xf1,
-	0xeb, 0x0b, 0x12, 0xb3, 0xad, 0xbe, 0x18, 0xb9, 0x57, 0x1f, 0xd6, 0x2d, 0x38, 0xff, 0x49, 0xe4,
-	0x73, 0xab, 0xcb, 0x43, 0x2e, 0x9c, 0x4e, 0xcb, 0x7d, 0xd8, 0x73, 0x85, 0xc4, 0xcb, 0x30, 0x1b,
-	0xd2, 0xd1, 0xb6, 0xdf, 0xae, 0x1b, 0x8b, 0xc6, 0xea, 0x54, 0x0b, 0xe2, 0xa3, 0x8f, 0xda, 0xd6,
-	0xc7, 0x70, 0x21, 0x67, 0x28, 0x42, 0x1e, 0x08, 0x17, 0xaf, 0x41, 0x35, 0x86, 0x29, 0xb3, 0xd9,
-	0xf5, 0x05, 0x3b, 0x13, 0xb1, 0x9d, 0x98, 0x24, 0x40, 0xeb, 0x87, 0x4a, 0x8e, 0x4e, 0xc4, 0x42,
-	0x36, 0xe1, 0x85, 0x44, 0x88, 0x90, 0x8e, 0xec, 0x09, 0xc5, 0x3a, 0xbf, 0xfe, 0xea, 0x10, 0xd6,
-	0xfb, 0x0a, 0xd4, 0x9a, 0x0f, 0x33, 0xdf, 0x68, 0xc3, 0x74, 0x9f, 0x4b, 0xb7, 0x5b, 0xaf, 0x2c,
-	0x1a, 0xab, 0xb5, 0x8d, 0xfa, 0x1f, 0xbf, 0xad, 0x9d, 0x27, 0x82, 0x3b, 0xed, 0x76, 0xd7, 0x15,
-	0xe2, 0xbe, 0xec, 0xfa, 0x81, 0xd7, 0xd2, 0x30, 0xbc, 0x09, 0xb5, 0xb6, 0x1b, 0x72, 0xe1, 0x4b,
-	0xde, 0xad, 0x4f, 0x9e, 0x62, 0x33, 0x80, 0xe2, 0x26, 0xc0, 0xa0, 0x6c, 0xf5, 0x29, 0x95, 0x80,
-	0xe5, 0x58, 0x6a, 0x54, 0x63, 0x5b, 0xf7, 0x02, 0xd5, 0xd8, 0xde, 0x72, 0x3c, 0x97, 0x62, 0x6d,
-	0xa5, 0x2c, 0xad, 0x5f, 0x0d, 0xb8, 0x98, 0xcf, 0x08, 0x65, 0xf8, 0x06, 0xd4, 0xe2, 0xe0, 0xa2,
-	0x64, 0x4c, 0x96, 0xa5, 0x78, 0x80, 0xc4, 0x7b, 0x19, 0x65, 0x15, 0xa5, 0x6c, 0xe5, 0x54, 0x65,
-	0xda, 0x67, 0x46, 0xda, 0x2e, 0xbc, 0xa8, 0x94, 0x7d, 0xca, 0xa5, 0x3b, 0x6a, 0xbf, 0x8c, 0x9b,
-	0x7f, 0xeb, 0x36, 0xbc, 0x94, 0x72, 0x42, 0x91, 0xaf, 0xc0, 0x54, 0x74, 0x4b, 0x7d, 0x75, 0x2e,
-	0x17, 0xb4, 0x82, 0x2a, 0x80, 0xf5, 0x28, 0x65, 0x2d, 0x46, 0xd6, 0xb8, 0x59, 0x90, 0xa1, 0x67,
-	0xa9, 0xdd, 0x77, 0x06, 0x60, 0xda, 0x3d, 0xa9, 0x7f, 0x43, 0xa7, 0x20, 0xae, 0x59, 0xa1, 0x7c,
-	0x8d, 0x78, 0x7e, 0xb5, 0xba, 0x41, 0x4a, 0xb6, 0x9c, 0xae, 0xb3, 0x97, 0xc9, 0x84, 0x3a, 0xd8,
-	0x96, 0xfb, 0xa1, 0x4e, 0x67, 0x2d, 0x32, 0x8b, 0x8e, 0x1e, 0xec, 0x87, 0xae, 0xf5, 0x73, 0x05,
-	0xce, 0x65, 0xec, 0x28, 0x84, 0xbb, 0x30, 0xd7, 0xe7, 0xd2, 0x0f, 0xbc, 0x6d, 0x0d, 0xa6, 0x4a,
-	0xbc, 0x72, 0x32, 0x14, 0x3f, 0xf0, 0xb4, 0xed, 0x46, 0xa5, 0x6e, 0xb4, 0xce, 0xf6, 0x53, 0x27,
-	0x78, 0x0f, 0xe6, 0x69, 0x60, 0x62, 0x1a, 0x1d, 0xe1, 0xa5, 0x1c, 0xcd, 0x5d, 0x0d, 0x4a, 0xf1,
-	0xcc, 0xb5, 0xd3, 0x47, 0x78, 0x07, 0xce, 0x4a, 0xa7, 0xd3, 0xd9, 0x8f, 0x69, 0x26, 0x15, 0x8d,
-	0x99, 0xa3, 0x79, 0x10, 0x41, 0x52, 0x24, 0xb3, 0x72, 0x70, 0x80, 0x6b, 0x30, 0x43, 0xc6, 0x7a,
-	0x56, 0x2f, 0xe4, 0x27, 0x49, 0x27, 0x80, 0x40, 0x56, 0x40, 0x79, 0x21, 0x69, 0x23, 0xb7, 0x56,
-	0x66, 0x9d, 0x54, 0x46, 0x5e, 0x27, 0xd6, 0x87, 0xb4, 0x9f, 0x13, 0x7f, 0x54, 0x88, 0xb7, 0xe1,
-	0x0c, 0x81, 0xa8, 0x04, 0x17, 0x8b, 0x73, 0xd7, 0x8a, 0x61, 0xd6, 0xd7, 0x59, 0xa6, 0xff, 0x7f,
-	0x2a, 0x9e, 0x18, 0xb4, 0xe3, 0x07, 0x0a, 0x28, 0x98, 0x75, 0xa8, 0x92, 0xca, 0x78, 0x36, 0x86,
-	0x45, 0x93, 0xe0, 0x9e, 0xdf, 0x84, 0xbc, 0x03, 0x0b, 0x4a, 0x95, 0xea, 0x92, 0x96, 0x2b, 0x7a,
-	0x1d, 0x39, 0xc6, 0x23, 0x58, 0x3f, 0x69, 0x9b, 0x54, 0x68, 0x5a, 0xf5, 0x19, 0xd5, 0xa7, 0xb0,
-	0x29, 0xc9, 0x44, 0x03, 0xd7, 0xff, 0xae, 0xc2, 0xb4, 0xa2, 0xc3, 0x6f, 0x0c, 0xa8, 0xc6, 0x2b,
-	0x1c, 0x97, 0x72, 0x96, 0x45, 0xef, 0xb5, 0xf9, 0x7a, 0x39, 0x48, 0x6b, 0xb2, 0xec, 0xc7, 0x7f,
-	0xfe, 0xfb, 0x53, 0x65, 0x15, 0x97, 0x59, 0xf6, 0x5f, 0x85, 0xe4, 0x91, 0x60, 0x07, 0xa9, 0x80,
-	0x0f, 0xf1, 0x2b, 0xa8, 0x25, 0xcf, 0x0f, 0x96, 0xba, 0x88, 0xdb, 0xc9, 0xbc, 0x72, 0x0a, 0x8a,
-	0x94, 0x2c, 0x2a, 0x25, 0x26, 0xd6, 0x87, 0x29, 0xc1, 0x6f, 0x0d, 0x98, 0x8a, 0x56, 0x22, 0x5e,
-	0x2e, 0x62, 0x4c, 0xbd, 0x3d, 0xe6, 0xe2, 0x70, 0x00, 0x79, 0xbb, 0xad, 0xbc, 0xdd, 0xc4, 0xeb,
-	0xa3, 0xc5, 0xcd, 0xd4, 0x12, 0x66, 0x07, 0xea, 0x25, 0x3a, 0xc4, 0xc7, 0x06, 0x4c, 0xab, 0x4d,
-	0x8e, 0x43, 0x3d, 0x25, 0xe1, 0xbf, 0x56, 0x82, 0x20, 0x31, 0xd7, 0x95, 0x18, 0x1b, 0xdf, 0x1a,
-	0x47, 0x0c, 0x3e, 0x82, 0x19, 0xda, 0x58, 0x85, 0x2e, 0x32, 0xfb, 0xdd, 0xb4, 0xca, 0x20, 0x24,
-	0xe3, 0xaa, 0x92, 0x71, 0x05, 0x97, 0xf2, 0x32, 0x14, 0x8c, 0x1d, 0xa4, 0x1e, 0x88, 0x43, 0xfc,
-	0xc5, 0x80, 0x33, 0x34, 0x83, 0x58, 0x48, 0x9e, 0xdd, 0x87, 0xe6, 0x52, 0x29, 0x86, 0x14, 0xbc,
-	0xaf, 0x14, 0xbc, 0x87, 0xef, 0x8e, 0x98, 0x88, 0x78, 0xf6, 0xd9, 0x41, 0xb2, 0x1f, 0x0f, 0xf1,
-	0x7b, 0x03, 0xaa, 0xf1, 0x42, 0xc1, 0x32, 0xb7, 0xa2, 0x74, 0x54, 0xf2, 0x3b, 0xc9, 0xba, 0xa5,
-	0xc4, 0x35, 0x91, 0x8d, 0x29, 0x0e, 0x9f, 0x18, 0x30, 0x9b, 0x1a, 0x6e, 0x5c, 0x2e, 0x72, 0x77,
-	0x72, 0xd9, 0x98, 0x2b, 0xa7, 0xe2, 0x9e, 0xb1, 0x7f, 0xd4, 0x72, 0xd9, 0xf8, 0xe0, 0xf7, 0xa3,
-	0x86, 0xf1, 0xf4, 0xa8, 0x61, 0xfc, 0x73, 0xd4, 0x30, 0x7e, 0x3c, 0x6e, 0x4c, 0x3c, 0x3d, 0x6e,
-	0x4c, 0xfc, 0x75, 0xdc, 0x98, 0xf8, 0xec, 0xaa, 0xe7, 0xcb, 0xcf, 0x7b, 0x3b, 0xf6, 0x2e, 0xdf,
-	0x8b, 0x19, 0xf5, 0x9f, 0x35, 0xd1, 0xfe, 0x82, 0x7d, 0xa9, 0xe8, 0xa3, 0x2e, 0x10, 0xd1, 0xef,
-	0x92, 0x19, 0xf5, 0xb3, 0xe1, 0xda, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9f, 0xe4, 0xf6, 0xe9,
-	0xe0, 0x0c, 0x00, 0x00,
+	// 1043 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6e, 0xdc, 0x54,
+	0x14, 0x8e, 0x27, 0x99, 0x34, 0x73, 0xd2, 0xa4, 0x70, 0xfa, 0x93, 0xc1, 0x0d, 0xd3, 0xe0, 0xd0,
+	0x24, 0x34, 0xc4, 0x66, 0xd2, 0x3f, 0x54, 0x0a, 0xa2, 0x49, 0x49, 0x41, 0x62, 0x11, 0x26, 0x15,
+	0x0b, 0x36, 0x23, 0x67, 0xc6, 0x72, 0x2d, 0x26, 0xbe, 0xae, 0xaf, 0x67, 0x44, 0x48, 0x23, 0xa4,
+	0x4a, 0x08, 0xd8, 0x00, 0x12, 0x15, 0xf0, 0x10, 0x2c, 0x79, 0x08, 0x96, 0x15, 0x6c, 0x58, 0xa2,
+	0x84, 0x07, 0x41, 0xbe, 0xf7, 0xd8, 0x63, 0x3b, 0x9e, 0x89, 0x53, 0x45, 0xac, 0x22, 0xdf, 0xfb,
+	0x9d, 0xef, 0x7c, 0xe7, 0xe7, 0x9e, 0x93, 0x81, 0x57, 0x5a, 0x8c, 0xef, 0x30, 0x6e, 0xd8, 0xac,
+	0x67, 0xf4, 0xea, 0xc6, 0xe3, 0xae, 0xe5, 0xef, 0xea, 0x9e, 0xcf, 0x02, 0x86, 0x53, 0xf2, 0x4a,
+	0xb7, 0x59, 0x4f, 0xef, 0xd5, 0xd5, 0x6b, 0x84, 0xdc, 0x36, 0xb9, 0x25, 0x71, 0x46, 0xaf, 0xbe,
+	0x6d, 0x05, 0x66, 0xdd, 0xf0, 0x4c, 0xdb, 0x71, 0xcd, 0xc0, 0x61, 0xae, 0x34, 0x55, 0x67, 0x6d,
+	0xc6, 0xec, 0x8e, 0x65, 0x98, 0x9e, 0x63, 0x98, 0xae, 0xcb, 0x02, 0x71, 0xc9, 0xe9, 0x76, 0x26,
+	0xed, 0x33, 0xe4, 0x97, 0x17, 0x24, 0xa6, 0x29, 0xbe, 0x0c, 0x72, 0x2f, 0x3e, 0xb4, 0xdb, 0x70,
+	0xe1, 0x93, 0xd0, 0xe7, 0xa6, 0xcf, 0x3c, 0xc6, 0xcd, 0x4e, 0xc3, 0x7a, 0xdc, 0xb5, 0x78, 0x80,
+	0x57, 0x60, 0xd2, 0xa3, 0xa3, 0xa6, 0xd3, 0xae, 0x2a, 0x73, 0xca, 0xd2, 0x58, 0x03, 0xa2, 0xa3,
+	0x8f, 0xda, 0xda, 0xc7, 0x70, 0x31, 0x63, 0xc8, 0x3d, 0xe6, 0x72, 0x0b, 0xaf, 0xc3, 0x44, 0x04,
+	0x13, 0x66, 0x93, 0xab, 0x33, 0x7a, 0x2a, 0x62, 0x3d, 0x36, 0x89, 0x81, 0xda, 0x0f, 0xa5, 0x0c,
+	0x1d, 0x8f, 0x84, 0x6c, 0xc0, 0xb9, 0x58, 0x08, 0x0f, 0xcc, 0xa0, 0xcb, 0x05, 0xeb, 0xf4, 0xea,
+	0xab, 0x03, 0x58, 0xb7, 0x04, 0xa8, 0x31, 0xed, 0xa5, 0xbe, 0x51, 0x87, 0x72, 0x8f, 0x05, 0x96,
+	0x5f, 0x2d, 0xcd, 0x29, 0x4b, 0x95, 0xb5, 0xea, 0x9f, 0xbf, 0xaf, 0x5c, 0x20, 0x82, 0x7b, 0xed,
+	0xb6, 0x6f, 0x71, 0xbe, 0x15, 0xf8, 0x8e, 0x6b, 0x37, 0x24, 0x0c, 0x6f, 0x41, 0xa5, 0x6d, 0x79,
+	0x8c, 0x3b, 0x01, 0xf3, 0xab, 0xa3, 0xc7, 0xd8, 0xf4, 0xa1, 0xb8, 0x01, 0xd0, 0x2f, 0x5b, 0x75,
+	0x4c, 0x24, 0x60, 0x21, 0x92, 0x1a, 0xd6, 0x58, 0x97, 0xbd, 0x40, 0x35, 0xd6, 0x37, 0x4d, 0xdb,
+	0xa2, 0x58, 0x1b, 0x09, 0x4b, 0xed, 0x57, 0x05, 0x2e, 0x65, 0x33, 0x42, 0x19, 0xbe, 0x09, 0x95,
+	0x28, 0xb8, 0x30, 0x19, 0xa3, 0xc3, 0x52, 0xdc, 0x47, 0xe2, 0x83, 0x94, 0xb2, 0x92, 0x50, 0xb6,
+	0x78, 0xac, 0x32, 0xe9, 0x33, 0x25, 0xad, 0x05, 0x2f, 0x09, 0x65, 0x9f, 0xb2, 0xc0, 0x2a, 0xda,
+	0x2f, 0x27, 0xcd, 0xbf, 0x76, 0x17, 0x5e, 0x4e, 0x38, 0xa1, 0xc8, 0x17, 0x61, 0x2c, 0xbc, 0xa5,
+	0xbe, 0x3a, 0x9f, 0x09, 0x5a, 0x40, 0x05, 0x40, 0x7b, 0x92, 0xb0, 0xe6, 0x85, 0x35, 0x6e, 0xe4,
+	0x64, 0xe8, 0x45, 0x6a, 0xf7, 0xad, 0x02, 0x98, 0x74, 0x4f, 0xea, 0xdf, 0x90, 0x29, 0x88, 0x6a,
+	0x96, 0x2b, 0x5f, 0x22, 0x4e, 0xaf, 0x56, 0x37, 0x49, 0xc9, 0xa6, 0xe9, 0x9b, 0x3b, 0xa9, 0x4c,
+	0x88, 0x83, 0x66, 0xb0, 0xeb, 0xc9, 0x74, 0x56, 0x42, 0xb3, 0xf0, 0xe8, 0xe1, 0xae, 0x67, 0x69,
+	0x3f, 0x97, 0xe0, 0x7c, 0xca, 0x8e, 0x42, 0xb8, 0x0f, 0x53, 0x3d, 0x16, 0x38, 0xae, 0xdd, 0x94,
+	0x60, 0xaa, 0xc4, 0xe5, 0xa3, 0xa1, 0x38, 0xae, 0x2d, 0x6d, 0xd7, 0x4a, 0x55, 0xa5, 0x71, 0xb6,
+	0x97, 0x38, 0xc1, 0x07, 0x30, 0x4d, 0x0f, 0x26, 0xa2, 0x91, 0x11, 0xce, 0x66, 0x68, 0xee, 0x4b,
+	0x50, 0x82, 0x67, 0xaa, 0x9d, 0x3c, 0xc2, 0x7b, 0x70, 0x36, 0x30, 0x3b, 0x9d, 0xdd, 0x88, 0x66,
+	0x54, 0xd0, 0xa8, 0x19, 0x9a, 0x87, 0x21, 0x24, 0x41, 0x32, 0x19, 0xf4, 0x0f, 0x70, 0x05, 0xc6,
+	0xc9, 0x58, 0xbe, 0xd5, 0x8b, 0xd9, 0x97, 0x24, 0x13, 0x40, 0x20, 0xcd, 0xa5, 0xbc, 0x90, 0xb4,
+	0xc2, 0xad, 0x95, 0x1a, 0x27, 0xa5, 0xc2, 0xe3, 0x44, 0xfb, 0x90, 0xe6, 0x73, 0xec, 0x8f, 0x0a,
+	0xf1, 0x16, 0x9c, 0x21, 0x10, 0x95, 0xe0, 0x52, 0x7e, 0xee, 0x1a, 0x11, 0x4c, 0xfb, 0x2a, 0xcd,
+	0xf4, 0xff, 0xbf, 0x8a, 0x67, 0x0a, 0xcd, 0xf8, 0xbe, 0x02, 0x0a, 0x66, 0x15, 0x26, 0x48, 0x65,
+	0xf4, 0x36, 0x06, 0x45, 0x13, 0xe3, 0x4e, 0xef, 0x85, 0xdc, 0x81, 0x19, 0xa1, 0x4a, 0x74, 0x49,
+	0xc3, 0xe2, 0xdd, 0x4e, 0x70, 0x82, 0x25, 0x58, 0x3d, 0x6a, 0x1b, 0x57, 0xa8, 0x2c, 0xfa, 0x8c,
+	0xea, 0x93, 0xdb, 0x94, 0x64, 0x22, 0x81, 0xda, 0x7b, 0x70, 0x59, 0xb0, 0xad, 0xfb, 0x8c, 0xf3,
+	0xf5, 0x47, 0xa6, 0xe3, 0xae, 0x9b, 0x9d, 0x4e, 0xe1, 0x42, 0x69, 0x5b, 0x30, 0x9b, 0x6f, 0x1f,
+	0x6f, 0xe6, 0x72, 0x2b, 0x3c, 0xa0, 0x1c, 0x67, 0x17, 0x68, 0xda, 0xac, 0x21, 0xb1, 0xab, 0xdf,
+	0x01, 0x94, 0x05, 0x2b, 0x7e, 0xad, 0xc0, 0x44, 0xb4, 0x57, 0x70, 0x3e, 0x63, 0x9c, 0xf7, 0x4f,
+	0x84, 0xfa, 0xfa, 0x70, 0x90, 0x94, 0xa5, 0xe9, 0x4f, 0xff, 0xfa, 0xf7, 0xa7, 0xd2, 0x12, 0x2e,
+	0x18, 0xe9, 0xff, 0x5f, 0xe2, 0xcd, 0x65, 0xec, 0x25, 0xe2, 0xde, 0xc7, 0x2f, 0xa1, 0x12, 0xef,
+	0x44, 0x1c, 0xea, 0x22, 0x4a, 0x9d, 0x7a, 0xf5, 0x18, 0x14, 0x29, 0x99, 0x13, 0x4a, 0x54, 0xac,
+	0x0e, 0x52, 0x82, 0xdf, 0x28, 0x30, 0x16, 0xce, 0x69, 0xbc, 0x92, 0xc7, 0x98, 0x58, 0x88, 0xea,
+	0xdc, 0x60, 0x00, 0x79, 0xbb, 0x2b, 0xbc, 0xdd, 0xc2, 0x1b, 0xc5, 0xe2, 0x36, 0xc4, 0x66, 0x30,
+	0xf6, 0xc4, 0x7a, 0xdc, 0xc7, 0xa7, 0x0a, 0x94, 0xc5, 0x7a, 0xc1, 0x81, 0x9e, 0xe2, 0xf0, 0x5f,
+	0x1b, 0x82, 0x20, 0x31, 0x37, 0x84, 0x18, 0x1d, 0xdf, 0x3c, 0x89, 0x18, 0x7c, 0x02, 0xe3, 0x34,
+	0x46, 0x73, 0x5d, 0xa4, 0x96, 0x8e, 0xaa, 0x0d, 0x83, 0x90, 0x8c, 0x65, 0x21, 0xe3, 0x2a, 0xce,
+	0x67, 0x65, 0x08, 0x98, 0xb1, 0x97, 0xd8, 0x5a, 0xfb, 0xf8, 0x8b, 0x02, 0x67, 0x68, 0x30, 0x60,
+	0x2e, 0x79, 0x7a, 0x48, 0xab, 0xf3, 0x43, 0x31, 0xa4, 0x60, 0x5d, 0x28, 0x78, 0x17, 0xdf, 0x29,
+	0x98, 0x88, 0x68, 0x20, 0x19, 0x7b, 0xf1, 0xd0, 0xde, 0xc7, 0xef, 0x15, 0x98, 0x88, 0xa6, 0x1c,
+	0x0e, 0x73, 0xcb, 0x87, 0x3e, 0x95, 0xec, 0xa0, 0xd4, 0x6e, 0x0b, 0x71, 0x75, 0x34, 0x4e, 0x28,
+	0x0e, 0x9f, 0x29, 0x30, 0x99, 0x98, 0x38, 0xb8, 0x90, 0xe7, 0xee, 0xe8, 0x04, 0x54, 0x17, 0x8f,
+	0xc5, 0xbd, 0x60, 0xff, 0x88, 0x89, 0x87, 0xbf, 0x29, 0x70, 0x2e, 0x33, 0xad, 0xf0, 0x5a, 0x9e,
+	0xcb, 0xfc, 0x91, 0xa8, 0x2e, 0x17, 0xc2, 0x92, 0xc4, 0xf7, 0x85, 0xc4, 0x3b, 0xf8, 0x76, 0x41,
+	0x89, 0xad, 0x90, 0xa7, 0xd9, 0x0a, 0x89, 0x9a, 0x62, 0x16, 0xae, 0x7d, 0xf0, 0xc7, 0x41, 0x4d,
+	0x79, 0x7e, 0x50, 0x53, 0xfe, 0x39, 0xa8, 0x29, 0x3f, 0x1e, 0xd6, 0x46, 0x9e, 0x1f, 0xd6, 0x46,
+	0xfe, 0x3e, 0xac, 0x8d, 0x7c, 0xb6, 0x6c, 0x3b, 0xc1, 0xa3, 0xee, 0xb6, 0xde, 0x62, 0x3b, 0x11,
+	0xbb, 0xfc, 0xb3, 0xc2, 0xdb, 0x9f, 0x1b, 0x5f, 0x08, 0x57, 0x61, 0xd3, 0xf2, 0xf0, 0xb7, 0xdd,
+	0xb8, 0xf8, 0xe9, 0x75, 0xfd, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x8d, 0xc2, 0x17, 0x24,
+	0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1007,6 +1106,8 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// CrossChainCalls queries the cross chain calls executed by a proposal.
+	CrossChainCalls(ctx context.Context, in *QueryCrossChainCallsRequest, opts ...grpc.CallOption) (*QueryCrossChainCallsResponse, error)
 }
 
 type queryClient struct {
@@ -1089,6 +1190,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) CrossChainCalls(ctx context.Context, in *QueryCrossChainCallsRequest, opts ...grpc.CallOption) (*QueryCrossChainCallsResponse, error) {
+	out := new(QueryCrossChainCallsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/CrossChainCalls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1107,6 +1217,8 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// CrossChainCalls queries the cross chain calls executed by a proposal.
+	CrossChainCalls(context.Context, *QueryCrossChainCallsRequest) (*QueryCrossChainCallsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1137,6 +1249,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) CrossChainCalls(ctx context.Context, req *QueryCrossChainCallsRequest) (*QueryCrossChainCallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrossChainCalls not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1286,6 +1401,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CrossChainCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCrossChainCallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CrossChainCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/CrossChainCalls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrossChainCalls(ctx, req.(*QueryCrossChainCallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1322,6 +1455,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "CrossChainCalls",
+			Handler:    _Query_CrossChainCalls_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -1975,6 +2112,71 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCrossChainCallsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossChainCallsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossChainCallsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossChainCallsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossChainCallsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossChainCallsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2245,6 +2447,33 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCrossChainCallsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryCrossChainCallsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3950,6 +4179,159 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCrossChainCallsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossChainCallsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossChainCallsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCrossChainCallsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossChainCallsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossChainCallsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, &CrossChainCall{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
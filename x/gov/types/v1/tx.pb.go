@@ -52,6 +52,8 @@ type MsgSubmitProposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Summary string `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	// expedited defines if the proposal is expedited.
+	Expedited bool `protobuf:"varint,7,opt,name=expedited,proto3" json:"expedited,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -129,6 +131,13 @@ func (m *MsgSubmitProposal) GetSummary() string {
 	return ""
 }
 
+func (m *MsgSubmitProposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -788,6 +797,125 @@ func (m *MsgUpdateCrossChainParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateCrossChainParamsResponse proto.InternalMessageInfo
 
+// MsgExecuteCrossChainCall defines a contract call on the destination chain executed by a proposal.
+type MsgExecuteCrossChainCall struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// dest_chain_id is the id of the destination chain.
+	DestChainId uint32 `protobuf:"varint,2,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// target is the whitelisted contract address in hex format on the destination chain.
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// calldata is the ABI-encoded calldata of the call.
+	Calldata []byte `protobuf:"bytes,4,opt,name=calldata,proto3" json:"calldata,omitempty"`
+}
+
+func (m *MsgExecuteCrossChainCall) Reset()         { *m = MsgExecuteCrossChainCall{} }
+func (m *MsgExecuteCrossChainCall) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteCrossChainCall) ProtoMessage()    {}
+func (*MsgExecuteCrossChainCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{14}
+}
+func (m *MsgExecuteCrossChainCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteCrossChainCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteCrossChainCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteCrossChainCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteCrossChainCall.Merge(m, src)
+}
+func (m *MsgExecuteCrossChainCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteCrossChainCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteCrossChainCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteCrossChainCall proto.InternalMessageInfo
+
+func (m *MsgExecuteCrossChainCall) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgExecuteCrossChainCall) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *MsgExecuteCrossChainCall) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *MsgExecuteCrossChainCall) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
+}
+
+// MsgExecuteCrossChainCallResponse defines the response structure for executing a MsgExecuteCrossChainCall message.
+type MsgExecuteCrossChainCallResponse struct {
+	// sequence is the sequence of the cross chain package.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgExecuteCrossChainCallResponse) Reset()         { *m = MsgExecuteCrossChainCallResponse{} }
+func (m *MsgExecuteCrossChainCallResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteCrossChainCallResponse) ProtoMessage()    {}
+func (*MsgExecuteCrossChainCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{15}
+}
+func (m *MsgExecuteCrossChainCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteCrossChainCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteCrossChainCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteCrossChainCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteCrossChainCallResponse.Merge(m, src)
+}
+func (m *MsgExecuteCrossChainCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteCrossChainCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteCrossChainCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteCrossChainCallResponse proto.InternalMessageInfo
+
+func (m *MsgExecuteCrossChainCallResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -803,75 +931,84 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgUpdateCrossChainParams)(nil), "cosmos.gov.v1.MsgUpdateCrossChainParams")
 	proto.RegisterType((*MsgUpdateCrossChainParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateCrossChainParamsResponse")
+	proto.RegisterType((*MsgExecuteCrossChainCall)(nil), "cosmos.gov.v1.MsgExecuteCrossChainCall")
+	proto.RegisterType((*MsgExecuteCrossChainCallResponse)(nil), "cosmos.gov.v1.MsgExecuteCrossChainCallResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 996 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0x26, 0xb1, 0xdd, 0x4c, 0x48, 0xaa, 0xac, 0xdc, 0x74, 0xbd, 0x2a, 0x9b, 0x64, 0x03,
-	0xc8, 0x4a, 0xc8, 0x6e, 0x1d, 0x68, 0x85, 0x4c, 0x85, 0xa8, 0x4d, 0x05, 0x95, 0x30, 0x54, 0xae,
-	0x28, 0x12, 0x42, 0x8a, 0xc6, 0xde, 0x61, 0xbc, 0x22, 0xbb, 0xb3, 0xda, 0x19, 0x5b, 0xf1, 0xad,
-	0x82, 0x1b, 0x27, 0x7e, 0x06, 0xc7, 0x1c, 0x7a, 0xeb, 0x1f, 0x28, 0x9c, 0x2a, 0x4e, 0x9c, 0x2a,
-	0x94, 0x08, 0x22, 0xf1, 0x27, 0x40, 0xf3, 0xb1, 0x6b, 0x7b, 0xd7, 0x76, 0x22, 0x90, 0xb8, 0x58,
-	0x33, 0xef, 0xfb, 0xbc, 0x1f, 0xcf, 0xe3, 0x79, 0x67, 0x16, 0x6c, 0x76, 0x09, 0x0d, 0x08, 0x75,
-	0x31, 0x19, 0xb8, 0x83, 0x9a, 0xcb, 0x4e, 0x9c, 0x28, 0x26, 0x8c, 0xe8, 0x6b, 0xd2, 0xee, 0x60,
-	0x32, 0x70, 0x06, 0x35, 0xd3, 0x52, 0xb0, 0x0e, 0xa4, 0xc8, 0x1d, 0xd4, 0x3a, 0x88, 0xc1, 0x9a,
-	0xdb, 0x25, 0x7e, 0x28, 0xe1, 0xe6, 0xcd, 0xc9, 0x34, 0x3c, 0x4a, 0x3a, 0xca, 0x98, 0x60, 0x22,
-	0x96, 0x2e, 0x5f, 0x29, 0x6b, 0x45, 0xc2, 0x8f, 0xa4, 0x43, 0x95, 0x52, 0x2e, 0x4c, 0x08, 0x3e,
-	0x46, 0xae, 0xd8, 0x75, 0xfa, 0xdf, 0xb8, 0x30, 0x1c, 0x66, 0x8a, 0x04, 0x14, 0xf3, 0x22, 0x01,
-	0xc5, 0xca, 0xb1, 0x01, 0x03, 0x3f, 0x24, 0xae, 0xf8, 0x95, 0x26, 0xfb, 0xe7, 0x45, 0xb0, 0xd1,
-	0xa2, 0xf8, 0x71, 0xbf, 0x13, 0xf8, 0xec, 0x51, 0x4c, 0x22, 0x42, 0xe1, 0xb1, 0x7e, 0x1b, 0x5c,
-	0x0b, 0x10, 0xa5, 0x10, 0x23, 0x6a, 0x68, 0xdb, 0x4b, 0xd5, 0xd5, 0xc3, 0xb2, 0x23, 0xeb, 0x39,
-	0x49, 0x3d, 0xe7, 0x7e, 0x38, 0x6c, 0xa7, 0x28, 0xbd, 0x05, 0xae, 0xfb, 0xa1, 0xcf, 0x7c, 0x78,
-	0x7c, 0xe4, 0xa1, 0x88, 0x50, 0x9f, 0x19, 0x8b, 0x22, 0xb0, 0xe2, 0xa8, 0xb6, 0xb9, 0x24, 0x8e,
-	0x92, 0xc4, 0x69, 0x12, 0x3f, 0x6c, 0xac, 0xbc, 0x78, 0xb5, 0xb5, 0xf0, 0xd3, 0xc5, 0xe9, 0x9e,
-	0xd6, 0x5e, 0x57, 0xc1, 0x1f, 0xc9, 0x58, 0xfd, 0x5d, 0x70, 0x2d, 0x12, 0xcd, 0xa0, 0xd8, 0x58,
-	0xda, 0xd6, 0xaa, 0x2b, 0x0d, 0xe3, 0xd7, 0x67, 0x07, 0x65, 0x95, 0xea, 0xbe, 0xe7, 0xc5, 0x88,
-	0xd2, 0xc7, 0x2c, 0xf6, 0x43, 0xdc, 0x4e, 0x91, 0xba, 0xc9, 0xdb, 0x66, 0xd0, 0x83, 0x0c, 0x1a,
-	0xcb, 0x3c, 0xaa, 0x9d, 0xee, 0xf5, 0x32, 0x28, 0x30, 0x9f, 0x1d, 0x23, 0xa3, 0x20, 0x1c, 0x72,
-	0xa3, 0x1b, 0xa0, 0x44, 0xfb, 0x41, 0x00, 0xe3, 0xa1, 0x51, 0x14, 0xf6, 0x64, 0x5b, 0xaf, 0x7d,
-	0x77, 0x71, 0xba, 0x97, 0xa6, 0xfe, 0xe1, 0xe2, 0x74, 0x6f, 0x4b, 0x56, 0x3f, 0xa0, 0xde, 0xb7,
-	0x5c, 0xd6, 0x9c, 0x6a, 0xf6, 0x3d, 0x50, 0xc9, 0x19, 0xdb, 0x88, 0x46, 0x24, 0xa4, 0x48, 0xdf,
-	0x02, 0xab, 0x91, 0xb2, 0x1d, 0xf9, 0x9e, 0xa1, 0x6d, 0x6b, 0xd5, 0xe5, 0x36, 0x48, 0x4c, 0x0f,
-	0x3d, 0xfb, 0xb9, 0x06, 0xca, 0x2d, 0x8a, 0x1f, 0x9c, 0xa0, 0xee, 0xa7, 0x08, 0xc3, 0xee, 0xb0,
-	0x49, 0x42, 0x86, 0x42, 0xa6, 0x7f, 0x06, 0x4a, 0x5d, 0xb9, 0x14, 0x51, 0x33, 0xfe, 0x8b, 0x86,
-	0xf5, 0xcb, 0xb3, 0x03, 0x73, 0xe2, 0x34, 0x26, 0x52, 0x8b, 0xd8, 0x76, 0x92, 0x44, 0xbf, 0x05,
-	0x56, 0x60, 0x9f, 0xf5, 0x48, 0xec, 0xb3, 0xa1, 0xb1, 0x28, 0x58, 0x8f, 0x0c, 0xf5, 0x3b, 0x9c,
-	0xf7, 0x68, 0xcf, 0x89, 0xdb, 0x39, 0xe2, 0xb9, 0x26, 0x6d, 0x0b, 0xdc, 0x9a, 0x66, 0x4f, 0xe8,
-	0xdb, 0x7f, 0x68, 0xa0, 0xd4, 0xa2, 0xf8, 0x09, 0x61, 0x48, 0xbf, 0x33, 0x45, 0x8a, 0x46, 0xf9,
-	0xaf, 0x57, 0x5b, 0xe3, 0x66, 0x79, 0x2e, 0xc6, 0x04, 0xd2, 0x1d, 0x50, 0x18, 0x10, 0x86, 0x62,
-	0xd9, 0xf3, 0x9c, 0x03, 0x21, 0x61, 0x7a, 0x0d, 0x14, 0x49, 0xc4, 0x7c, 0x12, 0x8a, 0x13, 0xb4,
-	0x3e, 0x3a, 0x89, 0x52, 0x1d, 0x87, 0xf7, 0xf2, 0xb9, 0x00, 0xb4, 0x15, 0x70, 0xde, 0x01, 0xaa,
-	0xbf, 0xc1, 0x85, 0x91, 0xa9, 0xb9, 0x28, 0x37, 0x72, 0xa2, 0xf0, 0x7c, 0xf6, 0x06, 0xb8, 0xae,
-	0x96, 0x29, 0xf5, 0xbf, 0xb5, 0xd4, 0xf6, 0x25, 0xf2, 0x71, 0x8f, 0x21, 0xef, 0xff, 0x92, 0xe0,
-	0x7d, 0x50, 0x92, 0xcc, 0xa8, 0xb1, 0x24, 0xa6, 0x71, 0x27, 0xa3, 0x41, 0xd2, 0xd0, 0x98, 0x16,
-	0x49, 0xc4, 0x5c, 0x31, 0xde, 0x9e, 0x14, 0xe3, 0xf5, 0xa9, 0x62, 0x24, 0xc9, 0xed, 0x0a, 0xb8,
-	0x99, 0x31, 0xa5, 0xe2, 0xfc, 0xa9, 0x01, 0xd0, 0xa2, 0x38, 0x99, 0xfb, 0x7f, 0xa9, 0xcb, 0x5d,
-	0xb0, 0xa2, 0x6e, 0x1d, 0x72, 0xb9, 0x36, 0x23, 0xa8, 0x7e, 0x0f, 0x14, 0x61, 0x40, 0xfa, 0x21,
-	0x53, 0xf2, 0x5c, 0xed, 0xb2, 0x52, 0x31, 0xf5, 0x7d, 0x31, 0x2a, 0x69, 0x36, 0x2e, 0x84, 0x91,
-	0x13, 0x42, 0x31, 0xb3, 0xcb, 0x40, 0x1f, 0xed, 0x52, 0xfa, 0xcf, 0xe5, 0xd9, 0xf8, 0x22, 0xf2,
-	0x20, 0x43, 0x8f, 0x60, 0x0c, 0x03, 0xca, 0xc9, 0x8c, 0xe6, 0x53, 0xbb, 0x8c, 0x4c, 0x0a, 0xd5,
-	0xdf, 0x03, 0xc5, 0x48, 0x64, 0x10, 0x0a, 0xac, 0x1e, 0xde, 0xc8, 0xfc, 0xd7, 0x32, 0xfd, 0x04,
-	0x11, 0x89, 0xaf, 0xdf, 0xcd, 0xcf, 0xfc, 0xee, 0x18, 0x91, 0x93, 0xe4, 0xb9, 0xca, 0x74, 0xaa,
-	0xfe, 0xd7, 0x71, 0x53, 0x4a, 0xec, 0xfb, 0x45, 0x71, 0x19, 0x4a, 0x5f, 0x33, 0x26, 0x94, 0x36,
-	0x7b, 0xd0, 0x0f, 0xff, 0x23, 0xc5, 0x4f, 0x32, 0x14, 0xdf, 0xcc, 0x50, 0xcc, 0x16, 0x6a, 0xf6,
-	0x60, 0x88, 0xd1, 0x14, 0xca, 0xba, 0x0d, 0xd6, 0x3c, 0x44, 0xd9, 0x51, 0x97, 0x83, 0xf9, 0x51,
-	0xe3, 0x77, 0xc4, 0x5a, 0x7b, 0x95, 0x1b, 0x45, 0x82, 0x87, 0x5e, 0xfd, 0xc3, 0xbc, 0x2c, 0x07,
-	0x73, 0x65, 0xc9, 0x96, 0xb7, 0x77, 0xc1, 0xce, 0x4c, 0x67, 0x22, 0xd5, 0xe1, 0xd3, 0x02, 0x58,
-	0x6a, 0x51, 0xac, 0x7f, 0x0d, 0xd6, 0x33, 0xcf, 0xf0, 0x76, 0x86, 0x5e, 0xee, 0x75, 0x31, 0xab,
-	0x97, 0x21, 0xd2, 0xf7, 0x07, 0x81, 0x8d, 0xfc, 0xd3, 0xb2, 0x9b, 0x0f, 0xcf, 0x81, 0xcc, 0xfd,
-	0x2b, 0x80, 0xd2, 0x32, 0x1f, 0x80, 0x65, 0x71, 0xc7, 0x6f, 0xe6, 0x83, 0xb8, 0xdd, 0xb4, 0xa6,
-	0xdb, 0xd3, 0xf8, 0x27, 0xe0, 0xb5, 0x89, 0x8b, 0x72, 0x06, 0x3e, 0xf1, 0x9b, 0x6f, 0xcd, 0xf7,
-	0xa7, 0x79, 0x3f, 0x06, 0xa5, 0xe4, 0x8e, 0xa9, 0xe4, 0x43, 0x94, 0xcb, 0xdc, 0x99, 0xe9, 0x1a,
-	0x6f, 0x70, 0x62, 0x5a, 0xa7, 0x34, 0x38, 0xee, 0x9f, 0xd6, 0xe0, 0xb4, 0x81, 0xd1, 0x19, 0xd8,
-	0x9c, 0x31, 0x2c, 0xd5, 0x59, 0x19, 0xb2, 0x48, 0xf3, 0xf6, 0x55, 0x91, 0x49, 0x55, 0xb3, 0xf0,
-	0x94, 0x4f, 0x45, 0xe3, 0xc1, 0x8b, 0x33, 0x4b, 0x7b, 0x79, 0x66, 0x69, 0xbf, 0x9f, 0x59, 0xda,
-	0x8f, 0xe7, 0xd6, 0xc2, 0xcb, 0x73, 0x6b, 0xe1, 0xb7, 0x73, 0x6b, 0xe1, 0xab, 0x7d, 0xec, 0xb3,
-	0x5e, 0xbf, 0xe3, 0x74, 0x49, 0xa0, 0xbe, 0x3f, 0xdd, 0xdc, 0x08, 0xb0, 0x61, 0x84, 0x28, 0xff,
-	0xda, 0x2d, 0x8a, 0x0f, 0x92, 0x77, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x75, 0x7f, 0xe1, 0x20,
-	0x2d, 0x0b, 0x00, 0x00,
+	// 1106 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0xe6, 0xc5, 0x4e, 0x26, 0x4d, 0xaa, 0xac, 0x9c, 0x74, 0xb3, 0xea, 0xdf, 0x71, 0x36,
+	0x7f, 0xc0, 0x4a, 0xc8, 0x6e, 0x1d, 0x68, 0x85, 0x4c, 0x55, 0xd1, 0x98, 0x0a, 0x2a, 0x61, 0xa8,
+	0xb6, 0xa2, 0x48, 0x08, 0x29, 0x9a, 0x78, 0x87, 0xcd, 0x0a, 0xef, 0xce, 0x76, 0x67, 0x6c, 0xc5,
+	0x37, 0x04, 0x37, 0x4e, 0x7c, 0x0c, 0x8e, 0x39, 0xf4, 0xd6, 0x2f, 0x50, 0x71, 0xaa, 0x38, 0x71,
+	0xaa, 0x50, 0x22, 0x88, 0xc4, 0x95, 0x0f, 0x00, 0x9a, 0x97, 0x1d, 0xdb, 0xbb, 0xeb, 0x24, 0x2a,
+	0x12, 0x97, 0x68, 0xe7, 0x79, 0x7e, 0xcf, 0xcb, 0xef, 0x37, 0x33, 0xcf, 0xc4, 0x60, 0xad, 0x83,
+	0x49, 0x88, 0x89, 0xe3, 0xe3, 0xbe, 0xd3, 0x6f, 0x38, 0xf4, 0xd8, 0x8e, 0x13, 0x4c, 0xb1, 0xbe,
+	0x24, 0xec, 0xb6, 0x8f, 0xfb, 0x76, 0xbf, 0x61, 0x56, 0x25, 0xec, 0x10, 0x12, 0xe4, 0xf4, 0x1b,
+	0x87, 0x88, 0xc2, 0x86, 0xd3, 0xc1, 0x41, 0x24, 0xe0, 0xe6, 0x8d, 0xf1, 0x34, 0x2c, 0x4a, 0x38,
+	0x2a, 0x3e, 0xf6, 0x31, 0xff, 0x74, 0xd8, 0x97, 0xb4, 0xae, 0x0b, 0xf8, 0x81, 0x70, 0xc8, 0x52,
+	0xd2, 0xe5, 0x63, 0xec, 0x77, 0x91, 0xc3, 0x57, 0x87, 0xbd, 0xaf, 0x1d, 0x18, 0x0d, 0x32, 0x45,
+	0x42, 0xe2, 0xb3, 0x22, 0x21, 0xf1, 0xa5, 0x63, 0x05, 0x86, 0x41, 0x84, 0x1d, 0xfe, 0x57, 0x98,
+	0xac, 0xf3, 0x69, 0xb0, 0xd2, 0x26, 0xfe, 0xe3, 0xde, 0x61, 0x18, 0xd0, 0x47, 0x09, 0x8e, 0x31,
+	0x81, 0x5d, 0xfd, 0x16, 0x98, 0x0f, 0x11, 0x21, 0xd0, 0x47, 0xc4, 0xd0, 0x6a, 0x33, 0xf5, 0xc5,
+	0xbd, 0x8a, 0x2d, 0xea, 0xd9, 0x69, 0x3d, 0xfb, 0x7e, 0x34, 0x70, 0x15, 0x4a, 0x6f, 0x83, 0xeb,
+	0x41, 0x14, 0xd0, 0x00, 0x76, 0x0f, 0x3c, 0x14, 0x63, 0x12, 0x50, 0x63, 0x9a, 0x07, 0xae, 0xdb,
+	0xb2, 0x6d, 0x26, 0x89, 0x2d, 0x25, 0xb1, 0x5b, 0x38, 0x88, 0xf6, 0x17, 0x5e, 0xbc, 0xda, 0x98,
+	0xfa, 0xe9, 0xfc, 0x64, 0x5b, 0x73, 0x97, 0x65, 0xf0, 0x87, 0x22, 0x56, 0x7f, 0x17, 0xcc, 0xc7,
+	0xbc, 0x19, 0x94, 0x18, 0x33, 0x35, 0xad, 0xbe, 0xb0, 0x6f, 0xfc, 0xf2, 0x6c, 0xb7, 0x22, 0x53,
+	0xdd, 0xf7, 0xbc, 0x04, 0x11, 0xf2, 0x98, 0x26, 0x41, 0xe4, 0xbb, 0x0a, 0xa9, 0x9b, 0xac, 0x6d,
+	0x0a, 0x3d, 0x48, 0xa1, 0x31, 0xcb, 0xa2, 0x5c, 0xb5, 0xd6, 0x2b, 0x60, 0x8e, 0x06, 0xb4, 0x8b,
+	0x8c, 0x39, 0xee, 0x10, 0x0b, 0xdd, 0x00, 0x65, 0xd2, 0x0b, 0x43, 0x98, 0x0c, 0x8c, 0x12, 0xb7,
+	0xa7, 0x4b, 0xfd, 0x26, 0x58, 0x40, 0xc7, 0x31, 0xf2, 0x02, 0x8a, 0x3c, 0xa3, 0x5c, 0xd3, 0xea,
+	0xf3, 0xee, 0xd0, 0xd0, 0x6c, 0x7c, 0x77, 0x7e, 0xb2, 0xad, 0x0a, 0xff, 0x70, 0x7e, 0xb2, 0xbd,
+	0x21, 0x7a, 0xdb, 0x25, 0xde, 0x37, 0x4c, 0xf4, 0x9c, 0xa6, 0xd6, 0x5d, 0xb0, 0x9e, 0x33, 0xba,
+	0x88, 0xc4, 0x38, 0x22, 0x48, 0xdf, 0x00, 0x8b, 0xb1, 0xb4, 0x1d, 0x04, 0x9e, 0xa1, 0xd5, 0xb4,
+	0xfa, 0xac, 0x0b, 0x52, 0xd3, 0x43, 0xcf, 0x7a, 0xae, 0x81, 0x4a, 0x9b, 0xf8, 0x0f, 0x8e, 0x51,
+	0xe7, 0x13, 0xe4, 0xc3, 0xce, 0xa0, 0x85, 0x23, 0x8a, 0x22, 0xaa, 0x7f, 0x0a, 0xca, 0x1d, 0xf1,
+	0xc9, 0xa3, 0x26, 0xec, 0xd4, 0x7e, 0xf5, 0xe7, 0x67, 0xbb, 0xe6, 0xd8, 0x59, 0x4d, 0x37, 0x82,
+	0xc7, 0xba, 0x69, 0x12, 0xc6, 0x1b, 0xf6, 0xe8, 0x11, 0x4e, 0x02, 0x3a, 0x30, 0xa6, 0xb9, 0x26,
+	0x43, 0x43, 0xf3, 0x36, 0xe3, 0x3d, 0x5c, 0x33, 0xe2, 0x56, 0x8e, 0x78, 0xae, 0x49, 0xab, 0x0a,
+	0x6e, 0x16, 0xd9, 0x53, 0xfa, 0xd6, 0xef, 0x1a, 0x28, 0xb7, 0x89, 0xff, 0x04, 0x53, 0xa4, 0xdf,
+	0x2e, 0x90, 0x62, 0xbf, 0xf2, 0xe7, 0xab, 0x8d, 0x51, 0xb3, 0x38, 0x35, 0x23, 0x02, 0xe9, 0x36,
+	0x98, 0xeb, 0x63, 0x8a, 0x12, 0xd1, 0xf3, 0x05, 0xc7, 0x45, 0xc0, 0xf4, 0x06, 0x28, 0xe1, 0x98,
+	0x06, 0x38, 0xe2, 0xe7, 0x6b, 0x79, 0x78, 0x4e, 0x85, 0x3a, 0x36, 0xeb, 0xe5, 0x33, 0x0e, 0x70,
+	0x25, 0xf0, 0xa2, 0xe3, 0xd5, 0xfc, 0x3f, 0x13, 0x46, 0xa4, 0x66, 0xa2, 0xac, 0xe6, 0x44, 0x61,
+	0xf9, 0xac, 0x15, 0x70, 0x5d, 0x7e, 0x2a, 0xea, 0x7f, 0x6b, 0xca, 0xf6, 0x05, 0x0a, 0xfc, 0x23,
+	0x8a, 0xbc, 0xff, 0x4a, 0x82, 0xf7, 0x41, 0x59, 0x30, 0x23, 0xc6, 0x0c, 0xbf, 0xab, 0x9b, 0x19,
+	0x0d, 0xd2, 0x86, 0x46, 0xb4, 0x48, 0x23, 0x2e, 0x14, 0xe3, 0xed, 0x71, 0x31, 0xfe, 0x57, 0x28,
+	0x46, 0x9a, 0xdc, 0x5a, 0x07, 0x37, 0x32, 0x26, 0x25, 0xce, 0x1f, 0x1a, 0x00, 0x6d, 0xe2, 0xa7,
+	0x53, 0xe1, 0x35, 0x75, 0xb9, 0x03, 0x16, 0xe4, 0x4c, 0xc2, 0x97, 0x6b, 0x33, 0x84, 0xea, 0x77,
+	0x41, 0x09, 0x86, 0xb8, 0x17, 0x51, 0x29, 0xcf, 0xd5, 0x46, 0x99, 0x8c, 0x69, 0xee, 0xf0, 0xab,
+	0xa2, 0xb2, 0x31, 0x21, 0x8c, 0x9c, 0x10, 0x92, 0x99, 0x55, 0x01, 0xfa, 0x70, 0xa5, 0xe8, 0x3f,
+	0x17, 0x67, 0xe3, 0xf3, 0xd8, 0x83, 0x14, 0x3d, 0x82, 0x09, 0x0c, 0x09, 0x23, 0x33, 0xbc, 0x9f,
+	0xda, 0x65, 0x64, 0x14, 0x54, 0x7f, 0x0f, 0x94, 0x62, 0x9e, 0x81, 0x2b, 0xb0, 0xb8, 0xb7, 0x9a,
+	0xd9, 0x6b, 0x91, 0x7e, 0x8c, 0x88, 0xc0, 0x37, 0xef, 0xe4, 0xef, 0xfc, 0xd6, 0x08, 0x91, 0xe3,
+	0xf4, 0x31, 0xcb, 0x74, 0x2a, 0xf7, 0x75, 0xd4, 0xa4, 0x88, 0x7d, 0x3f, 0xcd, 0x87, 0xa1, 0xf0,
+	0xb5, 0x12, 0x4c, 0x48, 0xeb, 0x08, 0x06, 0xd1, 0xbf, 0xa4, 0xf8, 0x71, 0x86, 0xe2, 0x1b, 0x19,
+	0x8a, 0xd9, 0x42, 0xad, 0x23, 0x18, 0xf9, 0xa8, 0x80, 0xb2, 0x6e, 0x81, 0x25, 0x0f, 0x11, 0x7a,
+	0xd0, 0x61, 0x60, 0x76, 0xd4, 0xd8, 0x8c, 0x58, 0x72, 0x17, 0x99, 0x91, 0x27, 0x78, 0xe8, 0x35,
+	0x3f, 0xc8, 0xcb, 0xb2, 0x7b, 0xa1, 0x2c, 0xd9, 0xf2, 0xd6, 0x16, 0xd8, 0x9c, 0xe8, 0x54, 0x52,
+	0x9d, 0x6a, 0xc0, 0x90, 0xb3, 0xb3, 0x37, 0x0a, 0x6b, 0xc1, 0x6e, 0xf7, 0xb5, 0x95, 0xca, 0xf1,
+	0x9b, 0xce, 0xf1, 0xd3, 0xd7, 0x40, 0x89, 0xc2, 0xc4, 0x47, 0x54, 0x3c, 0xc0, 0xae, 0x5c, 0xb1,
+	0x8b, 0xdf, 0x81, 0xdd, 0xae, 0xba, 0xf8, 0xd7, 0x5c, 0xb5, 0xbe, 0xec, 0xa8, 0x0c, 0x9f, 0x87,
+	0x71, 0x1e, 0xd6, 0x3d, 0x50, 0x9b, 0xc4, 0x51, 0x3d, 0x91, 0x26, 0x98, 0x27, 0xe8, 0x69, 0x0f,
+	0x45, 0x1d, 0x24, 0xdf, 0x47, 0xb5, 0xde, 0xfb, 0x6b, 0x0e, 0xcc, 0xb4, 0x89, 0xaf, 0x7f, 0x05,
+	0x96, 0x33, 0xff, 0xc9, 0xd4, 0x32, 0x67, 0x20, 0xf7, 0x04, 0x9b, 0xf5, 0xcb, 0x10, 0xaa, 0x03,
+	0x04, 0x56, 0xf2, 0xef, 0xef, 0x56, 0x3e, 0x3c, 0x07, 0x32, 0x77, 0xae, 0x00, 0x52, 0x65, 0xee,
+	0x81, 0x59, 0xfe, 0x10, 0xae, 0xe5, 0x83, 0x98, 0xdd, 0xac, 0x16, 0xdb, 0x55, 0xfc, 0x13, 0x70,
+	0x6d, 0xec, 0x35, 0x99, 0x80, 0x4f, 0xfd, 0xe6, 0x9b, 0x17, 0xfb, 0x55, 0xde, 0x8f, 0x40, 0x39,
+	0x1d, 0xc4, 0xeb, 0xf9, 0x10, 0xe9, 0x32, 0x37, 0x27, 0xba, 0x46, 0x1b, 0x1c, 0x1b, 0x69, 0x05,
+	0x0d, 0x8e, 0xfa, 0x8b, 0x1a, 0x2c, 0x9a, 0x2a, 0x3a, 0x05, 0x6b, 0x13, 0x26, 0x4a, 0x7d, 0x52,
+	0x86, 0x2c, 0xd2, 0xbc, 0x75, 0x55, 0xa4, 0xaa, 0xfa, 0x14, 0xac, 0x16, 0x5f, 0xce, 0xb7, 0x8a,
+	0x37, 0x3d, 0x07, 0x34, 0x9d, 0x2b, 0x02, 0xd3, 0x92, 0xe6, 0xdc, 0xb7, 0x6c, 0x5a, 0xed, 0x3f,
+	0x78, 0x71, 0x5a, 0xd5, 0x5e, 0x9e, 0x56, 0xb5, 0xdf, 0x4e, 0xab, 0xda, 0x8f, 0x67, 0xd5, 0xa9,
+	0x97, 0x67, 0xd5, 0xa9, 0x5f, 0xcf, 0xaa, 0x53, 0x5f, 0xee, 0xf8, 0x01, 0x3d, 0xea, 0x1d, 0xda,
+	0x1d, 0x1c, 0xca, 0x5f, 0x0d, 0x4e, 0x6e, 0x34, 0xd1, 0x41, 0x8c, 0x08, 0xfb, 0x8d, 0x52, 0xe2,
+	0xff, 0x28, 0xbe, 0xf3, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x33, 0x62, 0x77, 0xe3, 0x0c,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -904,6 +1041,8 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// UpdateCrossChainParams defines a method to send IBC package to update cross-chain params
 	UpdateCrossChainParams(ctx context.Context, in *MsgUpdateCrossChainParams, opts ...grpc.CallOption) (*MsgUpdateCrossChainParamsResponse, error)
+	// ExecuteCrossChainCall defines a method to send IBC package to call a whitelisted contract on the destination chain
+	ExecuteCrossChainCall(ctx context.Context, in *MsgExecuteCrossChainCall, opts ...grpc.CallOption) (*MsgExecuteCrossChainCallResponse, error)
 }
 
 type msgClient struct {
@@ -977,6 +1116,15 @@ func (c *msgClient) UpdateCrossChainParams(ctx context.Context, in *MsgUpdateCro
 	return out, nil
 }
 
+func (c *msgClient) ExecuteCrossChainCall(ctx context.Context, in *MsgExecuteCrossChainCall, opts ...grpc.CallOption) (*MsgExecuteCrossChainCallResponse, error) {
+	out := new(MsgExecuteCrossChainCallResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/ExecuteCrossChainCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -997,6 +1145,8 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// UpdateCrossChainParams defines a method to send IBC package to update cross-chain params
 	UpdateCrossChainParams(context.Context, *MsgUpdateCrossChainParams) (*MsgUpdateCrossChainParamsResponse, error)
+	// ExecuteCrossChainCall defines a method to send IBC package to call a whitelisted contract on the destination chain
+	ExecuteCrossChainCall(context.Context, *MsgExecuteCrossChainCall) (*MsgExecuteCrossChainCallResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1024,6 +1174,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) UpdateCrossChainParams(ctx context.Context, req *MsgUpdateCrossChainParams) (*MsgUpdateCrossChainParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCrossChainParams not implemented")
 }
+func (*UnimplementedMsgServer) ExecuteCrossChainCall(ctx context.Context, req *MsgExecuteCrossChainCall) (*MsgExecuteCrossChainCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteCrossChainCall not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1155,6 +1308,24 @@ func _Msg_UpdateCrossChainParams_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExecuteCrossChainCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteCrossChainCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteCrossChainCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/ExecuteCrossChainCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteCrossChainCall(ctx, req.(*MsgExecuteCrossChainCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1187,6 +1358,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCrossChainParams",
 			Handler:    _Msg_UpdateCrossChainParams_Handler,
 		},
+		{
+			MethodName: "ExecuteCrossChainCall",
+			Handler:    _Msg_ExecuteCrossChainCall_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -1212,6 +1387,16 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -1716,6 +1901,83 @@ func (m *MsgUpdateCrossChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgExecuteCrossChainCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteCrossChainCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteCrossChainCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteCrossChainCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteCrossChainCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteCrossChainCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1761,6 +2023,9 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1951,6 +2216,42 @@ func (m *MsgUpdateCrossChainParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgExecuteCrossChainCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovTx(uint64(m.DestChainId))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteCrossChainCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2182,6 +2483,26 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3393,6 +3714,242 @@ func (m *MsgUpdateCrossChainParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgExecuteCrossChainCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteCrossChainCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteCrossChainCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteCrossChainCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteCrossChainCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteCrossChainCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,6 +14,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/oracle/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	// maxRotatingVoters is the max number of voted validators rotating their bls keys for which all the
+	// combinations of the previous and the new bls pubkeys are tried when verifying a claim.
+	maxRotatingVoters = 4
+	// rotatedBlsKeysVerifyGas is the gas consumed by each verification of a claim with the rotated bls keys.
+	rotatedBlsKeysVerifyGas sdk.Gas = 10000
 )
 
 type Keeper struct {
@@ -145,6 +154,7 @@ func (k Keeper) CheckClaim(ctx sdk.Context, claim *types.MsgClaim) (sdk.AccAddre
 	}
 
 	signedRelayers := make([]sdk.AccAddress, 0, validatorsBitSet.Count())
+	votedValidators := make([]stakingtypes.Validator, 0, validatorsBitSet.Count())
 	votedPubKeys := make([]bls.PublicKey, 0, validatorsBitSet.Count())
 	for index, val := range validators {
 		if !validatorsBitSet.Test(uint(index)) {
@@ -152,6 +162,7 @@ func (k Keeper) CheckClaim(ctx sdk.Context, claim *types.MsgClaim) (sdk.AccAddre
 		}
 
 		signedRelayers = append(signedRelayers, sdk.MustAccAddressFromHex(val.RelayerAddress))
+		votedValidators = append(votedValidators, val)
 
 		votePubKey, err := bls.PublicKeyFromBytes(val.BlsKey)
 		if err != nil {
@@ -171,13 +182,80 @@ func (k Keeper) CheckClaim(ctx sdk.Context, claim *types.MsgClaim) (sdk.AccAddre
 		return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrInvalidBlsSignature, "BLS signature converts failed: %v", err)
 	}
 
-	if !aggSig.FastAggregateVerify(votedPubKeys, claim.GetBlsSignBytes()) {
+	blsSignBytes := claim.GetBlsSignBytes()
+	if !aggSig.FastAggregateVerify(votedPubKeys, blsSignBytes) &&
+		!k.verifyWithRotatedBlsKeys(ctx, votedValidators, votedPubKeys, aggSig, blsSignBytes) {
 		return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrInvalidBlsSignature, "signature verify failed")
 	}
 
 	return relayer, signedRelayers, nil
 }
 
+// verifyWithRotatedBlsKeys verifies the aggregated signature of the claim with the other bls pubkeys of the
+// voted validators whose bls keys are being rotated, both the previous and the new bls pubkeys of them are
+// accepted until the rotations expire. Every combination of the pubkeys is tried if there are no more than
+// maxRotatingVoters such validators, so at most 2^maxRotatingVoters-1 verifications are made. Otherwise only
+// the one with all the other pubkeys is tried, and a claim signed with a mix of the previous and the new bls
+// keys of these validators is rejected. Each verification consumes rotatedBlsKeysVerifyGas.
+func (k Keeper) verifyWithRotatedBlsKeys(ctx sdk.Context, voters []stakingtypes.Validator, pubKeys []bls.PublicKey, aggSig bls.Signature, signBytes [32]byte) bool {
+	if !ctx.IsUpgraded(upgradetypes.Nagqu) {
+		return false
+	}
+
+	rotating := make([]int, 0)
+	otherKeys := make([]bls.PublicKey, 0)
+	for index, voter := range voters {
+		rotation, found := k.StakingKeeper.GetBlsKeyRotation(ctx, voter.GetOperator())
+		if !found || rotation.ExpiryHeight <= ctx.BlockHeight() {
+			continue
+		}
+
+		var otherKey []byte
+		switch {
+		case bytes.Equal(voter.BlsKey, rotation.BlsKey):
+			otherKey = rotation.PrevBlsKey
+		case bytes.Equal(voter.BlsKey, rotation.PrevBlsKey):
+			otherKey = rotation.BlsKey
+		default:
+			continue
+		}
+
+		pubKey, err := bls.PublicKeyFromBytes(otherKey)
+		if err != nil {
+			continue
+		}
+		rotating = append(rotating, index)
+		otherKeys = append(otherKeys, pubKey)
+	}
+	if len(rotating) == 0 {
+		return false
+	}
+
+	masks := []uint{1<<len(rotating) - 1}
+	if len(rotating) <= maxRotatingVoters {
+		masks = masks[:0]
+		for mask := uint(1); mask < 1<<len(rotating); mask++ {
+			masks = append(masks, mask)
+		}
+	}
+
+	candidates := make([]bls.PublicKey, len(pubKeys))
+	for _, mask := range masks {
+		copy(candidates, pubKeys)
+		for i, index := range rotating {
+			if mask&(1<<i) != 0 {
+				candidates[index] = otherKeys[i]
+			}
+		}
+		ctx.GasMeter().ConsumeGas(rotatedBlsKeysVerifyGas, "verify claim with rotated bls keys")
+		if aggSig.FastAggregateVerify(candidates, signBytes) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // GetParams returns the current params
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	store := ctx.KVStore(k.storeKey)
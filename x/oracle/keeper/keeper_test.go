@@ -31,6 +31,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/oracle/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 type TestSuite struct {
@@ -156,6 +157,165 @@ func (s *TestSuite) TestProcessClaim() {
 	s.Require().Contains(err.Error(), "BLS signature converts failed")
 }
 
+func (s *TestSuite) TestCheckClaimWithRotatedBlsKey() {
+	s.oracleKeeper.SetParams(s.ctx, types.Params{
+		RelayerTimeout:     5,
+		RelayerRewardShare: 50,
+		RelayerInterval:    600,
+	})
+
+	newValidators, blsKeys := createValidators(s.T())
+
+	// the first validator has rotated its bls key, but its relayer still signs with the previous one
+	prevBlsKey := blsKeys[0]
+	newBlsKey, _ := blst.RandKey()
+	newValidators[0].BlsKey = newBlsKey.PublicKey().Marshal()
+	rotation := stakingtypes.BlsKeyRotation{
+		ValidatorAddress: newValidators[0].OperatorAddress,
+		PrevBlsKey:       prevBlsKey.PublicKey().Marshal(),
+		BlsKey:           newValidators[0].BlsKey,
+		Height:           10,
+		ExpiryHeight:     16,
+	}
+
+	s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), gomock.Any()).Return(stakingtypes.HistoricalInfo{
+		Header: s.ctx.BlockHeader(),
+		Valset: newValidators,
+	}, true).AnyTimes()
+	s.stakingKeeper.EXPECT().GetBlsKeyRotation(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, operator sdk.AccAddress) (stakingtypes.BlsKeyRotation, bool) {
+			if operator.String() == rotation.ValidatorAddress {
+				return rotation, true
+			}
+			return stakingtypes.BlsKeyRotation{}, false
+		}).AnyTimes()
+
+	msgClaim := types.MsgClaim{
+		FromAddress: newValidators[0].RelayerAddress,
+		SrcChainId:  1,
+		DestChainId: 2,
+		Sequence:    1,
+		Timestamp:   1992,
+		Payload:     []byte("test payload"),
+	}
+
+	valBitSet := bitset.New(256)
+	for idx := range newValidators {
+		valBitSet.Set(uint(idx))
+	}
+	blsSignBytes := msgClaim.GetBlsSignBytes()
+	msgClaim.VoteAddressSet = valBitSet.Bytes()
+	msgClaim.AggSignature = otestutil.GenerateBlsSig(blsKeys, blsSignBytes[:])
+
+	upgraded := true
+	ctx := sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return upgraded && name == upgradetypes.Nagqu
+	}, s.ctx.Logger()).WithBlockTime(time.Unix(int64(msgClaim.Timestamp), 0))
+
+	// the previous bls key is accepted during the overlap window
+	_, _, err := s.oracleKeeper.CheckClaim(ctx.WithBlockHeight(15), &msgClaim)
+	s.Require().NoError(err)
+
+	// the previous bls key is retired once the rotation expires
+	_, _, err = s.oracleKeeper.CheckClaim(ctx.WithBlockHeight(16), &msgClaim)
+	s.Require().ErrorIs(err, types.ErrInvalidBlsSignature)
+
+	// the previous bls key is not accepted before the upgrade
+	upgraded = false
+	_, _, err = s.oracleKeeper.CheckClaim(ctx.WithBlockHeight(15), &msgClaim)
+	s.Require().ErrorIs(err, types.ErrInvalidBlsSignature)
+}
+
+func (s *TestSuite) TestCheckClaimWithManyRotatedBlsKeys() {
+	s.oracleKeeper.SetParams(s.ctx, types.Params{
+		RelayerTimeout:     5,
+		RelayerRewardShare: 50,
+		RelayerInterval:    600,
+	})
+
+	// five of the six validators have rotated their bls keys, which is above the max rotating voters
+	pks := simtestutil.CreateTestPubKeys(6)
+	vals := make([]stakingtypes.Validator, len(pks))
+	prevBlsKeys := make([]bls.SecretKey, len(pks))
+	newBlsKeys := make([]bls.SecretKey, len(pks))
+	rotations := make(map[string]stakingtypes.BlsKeyRotation)
+	for i, pk := range pks {
+		vals[i] = newValidator(s.T(), sdk.AccAddress(pk.Address()), pk)
+		prevBlsKeys[i], _ = blst.RandKey()
+		newBlsKeys[i] = prevBlsKeys[i]
+		if i > 0 {
+			newBlsKeys[i], _ = blst.RandKey()
+			rotations[vals[i].OperatorAddress] = stakingtypes.BlsKeyRotation{
+				ValidatorAddress: vals[i].OperatorAddress,
+				PrevBlsKey:       prevBlsKeys[i].PublicKey().Marshal(),
+				BlsKey:           newBlsKeys[i].PublicKey().Marshal(),
+				Height:           10,
+				ExpiryHeight:     16,
+			}
+		}
+		vals[i].BlsKey = newBlsKeys[i].PublicKey().Marshal()
+	}
+
+	s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), gomock.Any()).Return(stakingtypes.HistoricalInfo{
+		Header: s.ctx.BlockHeader(),
+		Valset: vals,
+	}, true).AnyTimes()
+	s.stakingKeeper.EXPECT().GetBlsKeyRotation(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, operator sdk.AccAddress) (stakingtypes.BlsKeyRotation, bool) {
+			rotation, found := rotations[operator.String()]
+			return rotation, found
+		}).AnyTimes()
+
+	msgClaim := types.MsgClaim{
+		FromAddress: vals[0].RelayerAddress,
+		SrcChainId:  1,
+		DestChainId: 2,
+		Sequence:    1,
+		Timestamp:   1992,
+		Payload:     []byte("test payload"),
+	}
+
+	valBitSet := bitset.New(256)
+	for idx := range vals {
+		valBitSet.Set(uint(idx))
+	}
+	msgClaim.VoteAddressSet = valBitSet.Bytes()
+	blsSignBytes := msgClaim.GetBlsSignBytes()
+	aggSign := func(keys []bls.SecretKey) []byte {
+		sigs := make([]bls.Signature, 0, len(keys))
+		for _, key := range keys {
+			sigs = append(sigs, key.Sign(blsSignBytes[:]))
+		}
+		return bls.AggregateSignatures(sigs).Marshal()
+	}
+
+	// any relayer can relay the claim once the in-turn relayer times out
+	ctx := sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, s.ctx.Logger()).WithBlockTime(time.Unix(int64(msgClaim.Timestamp)+10, 0)).WithBlockHeight(15)
+
+	// the claim signed with the new bls keys is verified without the rotated bls keys
+	msgClaim.AggSignature = aggSign(newBlsKeys)
+	gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, _, err := s.oracleKeeper.CheckClaim(gasCtx, &msgClaim)
+	s.Require().NoError(err)
+	s.Require().Zero(gasCtx.GasMeter().GasConsumed())
+
+	// the claim signed with all the previous bls keys is verified with a single extra verification
+	msgClaim.AggSignature = aggSign(prevBlsKeys)
+	gasCtx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, _, err = s.oracleKeeper.CheckClaim(gasCtx, &msgClaim)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Gas(10000), gasCtx.GasMeter().GasConsumed())
+
+	// the claim signed with a mix of the previous and the new bls keys is rejected
+	msgClaim.AggSignature = aggSign(append(append([]bls.SecretKey{}, prevBlsKeys[:3]...), newBlsKeys[3:]...))
+	gasCtx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, _, err = s.oracleKeeper.CheckClaim(gasCtx, &msgClaim)
+	s.Require().ErrorIs(err, types.ErrInvalidBlsSignature)
+	s.Require().Equal(sdk.Gas(10000), gasCtx.GasMeter().GasConsumed())
+}
+
 func (s *TestSuite) TestKeeper_IsRelayerValid() {
 	s.oracleKeeper.SetParams(s.ctx, types.Params{
 		RelayerTimeout:     5,
@@ -50,6 +50,21 @@ func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
 }
 
+// GetBlsKeyRotation mocks base method.
+func (m *MockStakingKeeper) GetBlsKeyRotation(ctx types.Context, operator types.AccAddress) (types0.BlsKeyRotation, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlsKeyRotation", ctx, operator)
+	ret0, _ := ret[0].(types0.BlsKeyRotation)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetBlsKeyRotation indicates an expected call of GetBlsKeyRotation.
+func (mr *MockStakingKeeperMockRecorder) GetBlsKeyRotation(ctx, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlsKeyRotation", reflect.TypeOf((*MockStakingKeeper)(nil).GetBlsKeyRotation), ctx, operator)
+}
+
 // GetHistoricalInfo mocks base method.
 func (m *MockStakingKeeper) GetHistoricalInfo(ctx types.Context, height int64) (types0.HistoricalInfo, bool) {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@ import (
 type StakingKeeper interface {
 	GetLastValidators(ctx sdk.Context) (validators []types.Validator)
 	GetHistoricalInfo(ctx sdk.Context, height int64) (types.HistoricalInfo, bool)
+	GetBlsKeyRotation(ctx sdk.Context, operator sdk.AccAddress) (types.BlsKeyRotation, bool)
 	BondDenom(ctx sdk.Context) (res string)
 }
 
@@ -27,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryImpeachment(),
+		GetCmdQueryImpeachments(),
 	)
 
 	return slashingQueryCmd
@@ -108,6 +110,81 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryImpeachment implements the command to query the impeachment of a validator.
+func GetCmdQueryImpeachment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "impeachment [validator-addr]",
+		Short: "Query the impeachment record of a validator",
+		Long: strings.TrimSpace(`Use a validator's operator address to find the impeachment record of that validator:
+
+$ <appd> query slashing impeachment 0x91D7deA99716Cbb247E81F1cfB692009164a967E
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryImpeachmentRequest{ValidatorAddress: valAddr.String()}
+			res, err := queryClient.Impeachment(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Impeachment)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryImpeachments implements the command to query the impeachments of all validators.
+func GetCmdQueryImpeachments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "impeachments",
+		Short: "Query the impeachment records of all validators",
+		Long: strings.TrimSpace(`impeachment records of validators:
+
+$ <appd> query slashing impeachments
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryImpeachmentsRequest{Pagination: pageReq}
+			res, err := queryClient.Impeachments(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "impeachments")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -38,6 +38,10 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	for _, impeachment := range data.Impeachments {
+		keeper.SetImpeachment(ctx, impeachment)
+	}
+
 	if err := keeper.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -67,5 +71,11 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	genState := types.NewGenesisState(params, signingInfos, missedBlocks)
+	keeper.IterateImpeachments(ctx, func(impeachment types.Impeachment) (stop bool) {
+		genState.Impeachments = append(genState.Impeachments, impeachment)
+		return false
+	})
+
+	return genState
 }
@@ -26,7 +26,17 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 
 	keeper.SetValidatorSigningInfo(ctx, consAddr1, info1)
 	keeper.SetValidatorSigningInfo(ctx, consAddr2, info2)
+	impeachment := types.Impeachment{
+		ValidatorAddress: sdk.AccAddress(consAddr1).String(),
+		Height:           ctx.BlockHeight(),
+		Time:             ctx.BlockTime().UTC(),
+		SlashFraction:    sdk.NewDecWithPrec(1, 1),
+		SlashedTokens:    sdk.NewInt(100),
+		Reason:           types.ImpeachReason_IMPEACH_REASON_DOUBLE_SIGN,
+	}
+	keeper.SetImpeachment(ctx, impeachment)
 	genesisState := keeper.ExportGenesis(ctx)
+	require.Equal([]types.Impeachment{impeachment}, genesisState.Impeachments)
 
 	require.Equal(genesisState.Params, testutil.TestParams())
 	require.Len(genesisState.SigningInfos, 2)
@@ -54,4 +64,7 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	newInfo2, _ := keeper.GetValidatorSigningInfo(ctx, consAddr2)
 	require.Equal(info1, newInfo1)
 	require.Equal(info2, newInfo2)
+	newImpeachment, found := keeper.GetImpeachment(ctx, sdk.AccAddress(consAddr1))
+	require.True(found)
+	require.Equal(impeachment, newImpeachment)
 }
@@ -75,3 +75,53 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// Impeachment returns the impeachment record of a specific validator.
+func (k Keeper) Impeachment(c context.Context, req *types.QueryImpeachmentRequest) (*types.QueryImpeachmentResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.AccAddressFromHexUnsafe(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	impeachment, found := k.GetImpeachment(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "Impeachment not found for validator %s", req.ValidatorAddress)
+	}
+
+	return &types.QueryImpeachmentResponse{Impeachment: impeachment}, nil
+}
+
+// Impeachments returns the impeachment records of all validators.
+func (k Keeper) Impeachments(c context.Context, req *types.QueryImpeachmentsRequest) (*types.QueryImpeachmentsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	var impeachments []types.Impeachment
+
+	impeachmentStore := prefix.NewStore(store, types.ImpeachmentKeyPrefix)
+	pageRes, err := query.Paginate(impeachmentStore, req.Pagination, func(key []byte, value []byte) error {
+		var impeachment types.Impeachment
+		err := k.cdc.Unmarshal(value, &impeachment)
+		if err != nil {
+			return err
+		}
+		impeachments = append(impeachments, impeachment)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryImpeachmentsResponse{Impeachments: impeachments, Pagination: pageRes}, nil
+}
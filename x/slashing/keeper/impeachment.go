@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetImpeachment returns the impeachment record of the given validator.
+func (k Keeper) GetImpeachment(ctx sdk.Context, valAddr sdk.AccAddress) (impeachment types.Impeachment, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ImpeachmentKey(valAddr))
+	if bz == nil {
+		return impeachment, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &impeachment)
+	return impeachment, true
+}
+
+// SetImpeachment sets the impeachment record of a validator.
+func (k Keeper) SetImpeachment(ctx sdk.Context, impeachment types.Impeachment) {
+	valAddr := sdk.MustAccAddressFromHex(impeachment.ValidatorAddress)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ImpeachmentKey(valAddr), k.cdc.MustMarshal(&impeachment))
+}
+
+// IterateImpeachments iterates over the stored impeachment records.
+func (k Keeper) IterateImpeachments(ctx sdk.Context, handler func(impeachment types.Impeachment) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ImpeachmentKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var impeachment types.Impeachment
+		k.cdc.MustUnmarshal(iter.Value(), &impeachment)
+		if handler(impeachment) {
+			break
+		}
+	}
+}
+
+// validateInfractionHeight checks the infraction height of an impeachment isn't in the future and isn't older
+// than the max age of the evidence, which corresponds to the unbonding period, as the stake which has been
+// unbonded since can't be slashed anymore.
+func (k Keeper) validateInfractionHeight(ctx sdk.Context, infractionHeight int64) error {
+	if infractionHeight > ctx.BlockHeight() {
+		return types.ErrInvalidInfractionHeight.Wrapf("infraction height %d is in the future", infractionHeight)
+	}
+
+	cp := ctx.ConsensusParams()
+	if cp != nil && cp.Evidence != nil && ctx.BlockHeight()-infractionHeight > cp.Evidence.MaxAgeNumBlocks {
+		return types.ErrInvalidInfractionHeight.Wrapf(
+			"infraction height %d is older than the max age of %d blocks", infractionHeight, cp.Evidence.MaxAgeNumBlocks,
+		)
+	}
+
+	return nil
+}
+
+// SlashImpeachedValidator slashes the stake of an impeached validator by the given
+// fraction at the infraction height and returns the amount of burned tokens.
+func (k Keeper) SlashImpeachedValidator(
+	ctx sdk.Context, validator stakingtypes.ValidatorI, fraction sdk.Dec, infractionHeight int64,
+) (math.Int, error) {
+	if validator.IsUnbonded() {
+		return math.ZeroInt(), types.ErrSlashUnbondedValidator
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	// The power is derived from the tokens since a jailed validator has no consensus power.
+	power := sdk.TokensToConsensusPower(validator.GetTokens(), k.sk.PowerReduction(ctx))
+	return k.slash(ctx, consAddr, fraction, power, infractionHeight, stakingtypes.Infraction_INFRACTION_UNSPECIFIED, types.AttributeValueImpeachment), nil
+}
@@ -2,12 +2,14 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -79,6 +81,38 @@ func (k msgServer) Impeach(goCtx context.Context, msg *types.MsgImpeach) (*types
 		return nil, err
 	}
 
+	upgraded := ctx.IsUpgraded(upgradetypes.Nagqu)
+	if !upgraded && (msg.IsSlashing() || msg.Reason != types.ImpeachReason_IMPEACH_REASON_UNSPECIFIED ||
+		msg.Description != "" || msg.EvidenceReference != "" || msg.InfractionHeight != 0) {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "impeachment slashing is not enabled")
+	}
+
+	infractionHeight := ctx.BlockHeight()
+	if upgraded {
+		// a validator can only be impeached once, and a tombstoned validator was already removed for its misbehavior
+		if _, found := k.GetImpeachment(ctx, valAddr); found || k.IsTombstoned(ctx, consAddr) {
+			return nil, types.ErrValidatorImpeached
+		}
+
+		if msg.InfractionHeight != 0 {
+			infractionHeight = msg.InfractionHeight
+		}
+		if err := k.validateInfractionHeight(ctx, infractionHeight); err != nil {
+			return nil, err
+		}
+	}
+
+	// Slash the validator before jailing it, as the slashing is not allowed
+	// once the validator is unbonded.
+	slashFraction, slashedTokens := sdk.ZeroDec(), sdk.ZeroInt()
+	if msg.IsSlashing() {
+		slashFraction = msg.SlashFraction
+		slashedTokens, err = k.SlashImpeachedValidator(ctx, validator, slashFraction, infractionHeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Jail the validator if not already jailed. This will begin unbonding the
 	// validator if not already unbonding (tombstoned).
 	if !validator.IsJailed() {
@@ -88,5 +122,31 @@ func (k msgServer) Impeach(goCtx context.Context, msg *types.MsgImpeach) (*types
 	// Jail forever.
 	k.JailForever(ctx, consAddr)
 
+	if upgraded {
+		k.SetImpeachment(ctx, types.Impeachment{
+			ValidatorAddress:  valAddr.String(),
+			Height:            ctx.BlockHeight(),
+			Time:              ctx.BlockTime(),
+			SlashFraction:     slashFraction,
+			SlashedTokens:     slashedTokens,
+			Reason:            msg.Reason,
+			Description:       msg.Description,
+			EvidenceReference: msg.EvidenceReference,
+			InfractionHeight:  infractionHeight,
+		})
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeImpeach,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyReason, msg.Reason.String()),
+				sdk.NewAttribute(types.AttributeKeySlashFraction, slashFraction.String()),
+				sdk.NewAttribute(types.AttributeKeyBurnedCoins, slashedTokens.String()),
+				sdk.NewAttribute(types.AttributeKeyEvidenceReference, msg.EvidenceReference),
+				sdk.NewAttribute(types.AttributeKeyInfractionHeight, fmt.Sprintf("%d", infractionHeight)),
+			),
+		)
+	}
+
 	return &types.MsgImpeachResponse{}, nil
 }
@@ -3,10 +3,17 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/golang/mock/gomock"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func (s *KeeperTestSuite) TestUpdateParams() {
@@ -320,3 +327,145 @@ func (s *KeeperTestSuite) TestUnjail() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUnjailRetiredValidator() {
+	ctx := sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, s.ctx.Logger())
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	val, err := types.NewSimpleValidator(addr, pubKey, types.Description{Moniker: "test"})
+	s.Require().NoError(err)
+	val.Tokens = sdk.NewInt(1000)
+	val.DelegatorShares = sdk.NewDec(1)
+	val.Jailed = true
+
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), addr).Return(val)
+	s.stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, addr).Return(types.NewDelegation(addr, addr, sdk.NewDec(100)))
+	s.stakingKeeper.EXPECT().HasValidatorRetirement(gomock.Any(), addr).Return(true)
+
+	_, err = s.msgServer.Unjail(ctx, &slashingtypes.MsgUnjail{ValidatorAddr: addr.String()})
+	s.Require().ErrorIs(err, slashingtypes.ErrValidatorRetired)
+}
+
+func (s *KeeperTestSuite) TestImpeach() {
+	ctx := sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, s.ctx.Logger()).WithBlockHeight(100).WithConsensusParams(&tmproto.ConsensusParams{
+		Evidence: &tmproto.EvidenceParams{MaxAgeNumBlocks: 50},
+	})
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	fraction := sdk.NewDecWithPrec(1, 1)
+
+	newValidator := func(status types.BondStatus) types.Validator {
+		_, pubKey, addr := testdata.KeyTestPubAddr()
+		val, err := types.NewSimpleValidator(addr, pubKey, types.Description{Moniker: "test"})
+		s.Require().NoError(err)
+		val.Tokens = sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+		val.DelegatorShares = sdk.NewDec(1)
+		val.Status = status
+		return val
+	}
+
+	// the impeachment can only be signed by the gov module account
+	val := newValidator(types.Bonded)
+	_, err := s.msgServer.Impeach(ctx, slashingtypes.NewMsgImpeach(val.GetOperator(), val.GetOperator()))
+	s.Require().ErrorIs(err, slashingtypes.ErrSignerNotGovModule)
+
+	// the slashing is not enabled before the upgrade
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), val.GetOperator()).Return(val)
+	_, err = s.msgServer.Impeach(s.ctx, slashingtypes.NewMsgImpeachWithSlash(
+		val.GetOperator(), govAddr, fraction, 0, slashingtypes.ImpeachReason_IMPEACH_REASON_DOUBLE_RELAY, "", ""))
+	s.Require().ErrorContains(err, "impeachment slashing is not enabled")
+
+	// the impeachment without slashing is not recorded before the upgrade
+	consAddr, err := val.GetConsAddr()
+	s.Require().NoError(err)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), val.GetOperator()).Return(val)
+	s.stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return()
+	_, err = s.msgServer.Impeach(s.ctx, slashingtypes.NewMsgImpeach(val.GetOperator(), govAddr))
+	s.Require().NoError(err)
+	_, found := s.slashingKeeper.GetImpeachment(s.ctx, val.GetOperator())
+	s.Require().False(found)
+	info, found := s.slashingKeeper.GetValidatorSigningInfo(s.ctx, consAddr)
+	s.Require().True(found)
+	s.Require().Equal(time.Unix(253402300799, 0).UTC(), info.JailedUntil)
+
+	// the infraction height can't be in the future or older than the max age of the evidence
+	val = newValidator(types.Bonded)
+	for _, height := range []int64{101, 49} {
+		s.stakingKeeper.EXPECT().Validator(gomock.Any(), val.GetOperator()).Return(val)
+		_, err = s.msgServer.Impeach(ctx, slashingtypes.NewMsgImpeachWithSlash(
+			val.GetOperator(), govAddr, fraction, height, slashingtypes.ImpeachReason_IMPEACH_REASON_DOUBLE_RELAY, "", ""))
+		s.Require().ErrorIs(err, slashingtypes.ErrInvalidInfractionHeight)
+	}
+
+	// the validator is slashed at the infraction height before being jailed after the upgrade
+	consAddr, err = val.GetConsAddr()
+	s.Require().NoError(err)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), val.GetOperator()).Return(val)
+	s.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction)
+	gomock.InOrder(
+		s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, int64(50), int64(100), fraction,
+			types.Infraction_INFRACTION_UNSPECIFIED).Return(sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)),
+		s.stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return(),
+	)
+	_, err = s.msgServer.Impeach(ctx, slashingtypes.NewMsgImpeachWithSlash(
+		val.GetOperator(), govAddr, fraction, 50, slashingtypes.ImpeachReason_IMPEACH_REASON_DOUBLE_RELAY,
+		"relayed conflicting packages", "0xabcd"))
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Impeachment(ctx, &slashingtypes.QueryImpeachmentRequest{ValidatorAddress: val.GetOperator().String()})
+	s.Require().NoError(err)
+	s.Require().Equal(slashingtypes.Impeachment{
+		ValidatorAddress:  val.GetOperator().String(),
+		Height:            ctx.BlockHeight(),
+		Time:              ctx.BlockTime(),
+		SlashFraction:     fraction,
+		SlashedTokens:     sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction),
+		Reason:            slashingtypes.ImpeachReason_IMPEACH_REASON_DOUBLE_RELAY,
+		Description:       "relayed conflicting packages",
+		EvidenceReference: "0xabcd",
+		InfractionHeight:  50,
+	}, res.Impeachment)
+
+	// an impeached validator can't be impeached again
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), val.GetOperator()).Return(val)
+	_, err = s.msgServer.Impeach(ctx, slashingtypes.NewMsgImpeachWithSlash(
+		val.GetOperator(), govAddr, fraction, 0, slashingtypes.ImpeachReason_IMPEACH_REASON_DOUBLE_RELAY, "", ""))
+	s.Require().ErrorIs(err, slashingtypes.ErrValidatorImpeached)
+
+	// a tombstoned validator can't be impeached
+	val = newValidator(types.Unbonding)
+	consAddr, err = val.GetConsAddr()
+	s.Require().NoError(err)
+	s.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), true, 0))
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), val.GetOperator()).Return(val)
+	_, err = s.msgServer.Impeach(ctx, slashingtypes.NewMsgImpeachWithSlash(
+		val.GetOperator(), govAddr, fraction, 0, slashingtypes.ImpeachReason_IMPEACH_REASON_DOUBLE_SIGN, "", ""))
+	s.Require().ErrorIs(err, slashingtypes.ErrValidatorImpeached)
+
+	// an unbonded validator can't be slashed
+	val = newValidator(types.Unbonded)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), val.GetOperator()).Return(val)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = s.msgServer.Impeach(cacheCtx, slashingtypes.NewMsgImpeachWithSlash(
+		val.GetOperator(), govAddr, fraction, 0, slashingtypes.ImpeachReason_IMPEACH_REASON_MISBEHAVIOR, "", ""))
+	s.Require().ErrorIs(err, slashingtypes.ErrSlashUnbondedValidator)
+
+	// the impeachment of an unbonded validator without slashing is recorded at the current height
+	consAddr, err = val.GetConsAddr()
+	s.Require().NoError(err)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), val.GetOperator()).Return(val)
+	s.stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return()
+	_, err = s.msgServer.Impeach(ctx, slashingtypes.NewMsgImpeachWithSlash(
+		val.GetOperator(), govAddr, sdk.ZeroDec(), 0, slashingtypes.ImpeachReason_IMPEACH_REASON_MISBEHAVIOR, "", ""))
+	s.Require().NoError(err)
+	impeachment, found := s.slashingKeeper.GetImpeachment(ctx, val.GetOperator())
+	s.Require().True(found)
+	s.Require().Equal(ctx.BlockHeight(), impeachment.InfractionHeight)
+
+	impeachments, err := s.queryClient.Impeachments(ctx, &slashingtypes.QueryImpeachmentsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(impeachments.Impeachments, 2)
+}
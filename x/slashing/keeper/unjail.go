@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // Unjail calls the staking Unjail function to unjail a validator if the
@@ -33,6 +34,11 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.AccAddress) error {
 		return types.ErrValidatorNotJailed
 	}
 
+	// cannot be unjailed if it's leaving the validator set
+	if ctx.IsUpgraded(upgradetypes.Nagqu) && k.sk.HasValidatorRetirement(ctx, validatorAddr) {
+		return types.ErrValidatorRetired
+	}
+
 	consAddr, err := validator.GetConsAddr()
 	if err != nil {
 		return err
@@ -39,6 +39,12 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.ImpeachmentKeyPrefix):
+			var impeachmentA, impeachmentB types.Impeachment
+			cdc.MustUnmarshal(kvA.Value, &impeachmentA)
+			cdc.MustUnmarshal(kvB.Value, &impeachmentB)
+			return fmt.Sprintf("%v\n%v", impeachmentA, impeachmentB)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
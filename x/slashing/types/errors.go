@@ -14,4 +14,8 @@ var (
 	ErrSelfDelegationTooLowToUnjail = sdkerrors.Register(ModuleName, 7, "validator's self delegation less than minimum; cannot be unjailed")
 	ErrNoSigningInfoFound           = sdkerrors.Register(ModuleName, 8, "no validator signing info found")
 	ErrSignerNotGovModule           = sdkerrors.Register(ModuleName, 9, "signer is not gov module account")
+	ErrValidatorRetired             = sdkerrors.Register(ModuleName, 10, "validator is retiring or retired; cannot be unjailed")
+	ErrSlashUnbondedValidator       = sdkerrors.Register(ModuleName, 11, "validator is unbonded; cannot be slashed")
+	ErrValidatorImpeached           = sdkerrors.Register(ModuleName, 12, "validator is already impeached or tombstoned")
+	ErrInvalidInfractionHeight      = sdkerrors.Register(ModuleName, 13, "invalid infraction height")
 )
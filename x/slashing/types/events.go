@@ -4,15 +4,21 @@ package types
 const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
+	EventTypeImpeach  = "impeach"
 
-	AttributeKeyAddress      = "address"
-	AttributeKeyHeight       = "height"
-	AttributeKeyPower        = "power"
-	AttributeKeyReason       = "reason"
-	AttributeKeyJailed       = "jailed"
-	AttributeKeyMissedBlocks = "missed_blocks"
-	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyAddress           = "address"
+	AttributeKeyHeight            = "height"
+	AttributeKeyPower             = "power"
+	AttributeKeyReason            = "reason"
+	AttributeKeyJailed            = "jailed"
+	AttributeKeyMissedBlocks      = "missed_blocks"
+	AttributeKeyBurnedCoins       = "burned_coins"
+	AttributeKeyValidator         = "validator"
+	AttributeKeySlashFraction     = "slash_fraction"
+	AttributeKeyEvidenceReference = "evidence_reference"
+	AttributeKeyInfractionHeight  = "infraction_height"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
+	AttributeValueImpeachment      = "impeachment"
 )
@@ -56,6 +56,12 @@ type StakingKeeper interface {
 
 	// IsValidatorJailed returns if the validator is jailed.
 	IsValidatorJailed(ctx sdk.Context, addr sdk.ConsAddress) bool
+
+	// HasValidatorRetirement returns if the validator is retiring or retired.
+	HasValidatorRetirement(ctx sdk.Context, operator sdk.AccAddress) bool
+
+	// PowerReduction returns the amount of tokens per unit of consensus power.
+	PowerReduction(ctx sdk.Context) math.Int
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
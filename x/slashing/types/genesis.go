@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -32,6 +34,7 @@ func DefaultGenesisState() *GenesisState {
 		Params:       DefaultParams(),
 		SigningInfos: []SigningInfo{},
 		MissedBlocks: []ValidatorMissedBlocks{},
+		Impeachments: []Impeachment{},
 	}
 }
 
@@ -62,5 +65,24 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	impeached := make(map[string]bool, len(data.Impeachments))
+	for _, impeachment := range data.Impeachments {
+		if _, err := sdk.AccAddressFromHexUnsafe(impeachment.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid impeached validator address %s: %w", impeachment.ValidatorAddress, err)
+		}
+		if impeached[impeachment.ValidatorAddress] {
+			return fmt.Errorf("duplicate impeachment of validator %s", impeachment.ValidatorAddress)
+		}
+		impeached[impeachment.ValidatorAddress] = true
+
+		fraction := impeachment.SlashFraction
+		if fraction.IsNil() || fraction.IsNegative() || fraction.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("impeachment slash fraction should be less than or equal to one and greater than or equal to zero, is %s", fraction)
+		}
+		if impeachment.SlashedTokens.IsNil() || impeachment.SlashedTokens.IsNegative() {
+			return fmt.Errorf("impeachment slashed tokens should not be negative, is %s", impeachment.SlashedTokens)
+		}
+	}
+
 	return nil
 }
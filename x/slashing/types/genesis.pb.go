@@ -35,6 +35,8 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// impeachments defines the validators impeached by governance.
+	Impeachments []Impeachment `protobuf:"bytes,4,rep,name=impeachments,proto3" json:"impeachments"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +93,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetImpeachments() []Impeachment {
+	if m != nil {
+		return m.Impeachments
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -269,35 +278,36 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xb1, 0x8e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0x56, 0x14, 0xce, 0xb9, 0x1b, 0xb0, 0xc2, 0x11, 0x6e, 0xc8, 0x9d, 0x2a, 0x40,
-	0x27, 0xa4, 0x24, 0xba, 0x32, 0x32, 0x91, 0xe5, 0xc4, 0x80, 0x84, 0xd2, 0x13, 0x03, 0x03, 0x91,
-	0xd3, 0xf8, 0x7c, 0xd6, 0x35, 0x76, 0x95, 0xcf, 0x54, 0xe5, 0x0d, 0x18, 0x79, 0x05, 0x36, 0x46,
-	0x90, 0x78, 0x88, 0x8e, 0x15, 0x13, 0x13, 0x42, 0xed, 0xc0, 0x6b, 0x20, 0xec, 0x94, 0x06, 0xd4,
-	0xa8, 0x12, 0x4b, 0x12, 0xfb, 0xfb, 0x7d, 0xff, 0xef, 0x9f, 0x7f, 0x1c, 0xfc, 0x60, 0xa4, 0xa0,
-	0x54, 0x10, 0xc3, 0x98, 0xc2, 0x95, 0x90, 0x3c, 0x9e, 0x9e, 0xe5, 0x4c, 0xd3, 0xb3, 0x98, 0x33,
-	0xc9, 0x40, 0x40, 0x34, 0xa9, 0x94, 0x56, 0xe4, 0xae, 0xc5, 0xa2, 0x35, 0x16, 0xd5, 0xd8, 0x91,
-	0xc7, 0x15, 0x57, 0x86, 0x89, 0x7f, 0x3f, 0x59, 0xfc, 0xe8, 0x61, 0x9b, 0xea, 0x9f, 0x7e, 0xcb,
-	0xdd, 0xb3, 0x5c, 0x66, 0x05, 0xea, 0x19, 0xb6, 0x74, 0x9b, 0x96, 0x42, 0xaa, 0xd8, 0x5c, 0xed,
-	0x56, 0xff, 0x5d, 0x07, 0xef, 0x9f, 0x5b, 0x5b, 0x43, 0x4d, 0x35, 0x23, 0x09, 0xee, 0x4d, 0x68,
-	0x45, 0x4b, 0xf0, 0xd1, 0x09, 0x3a, 0x75, 0x07, 0xc7, 0x51, 0x8b, 0xcd, 0xe8, 0x85, 0xc1, 0x92,
-	0xbd, 0xf9, 0xf7, 0x63, 0xe7, 0xe3, 0xcf, 0x4f, 0x8f, 0x50, 0x5a, 0x77, 0x92, 0x0b, 0x7c, 0x00,
-	0x82, 0x4b, 0x21, 0x79, 0x26, 0xe4, 0xa5, 0x02, 0xbf, 0x73, 0xd2, 0x3d, 0x75, 0x07, 0xf7, 0x5b,
-	0xa5, 0x86, 0x96, 0x7e, 0x26, 0x2f, 0x55, 0x53, 0x6f, 0x1f, 0x36, 0xfb, 0x40, 0x5e, 0xe3, 0x83,
-	0x52, 0x00, 0xb0, 0x22, 0xcb, 0xc7, 0x6a, 0x74, 0x0d, 0x7e, 0xd7, 0xa8, 0x46, 0xad, 0xaa, 0x2f,
-	0xe9, 0x58, 0x14, 0x54, 0xab, 0xea, 0xb9, 0x69, 0x4b, 0x4c, 0xd7, 0x5f, 0xfa, 0x65, 0xa3, 0xd0,
-	0xff, 0x8c, 0xb0, 0xdb, 0x30, 0x42, 0x06, 0xf8, 0x26, 0x2d, 0x8a, 0x8a, 0x81, 0x8d, 0x62, 0x2f,
-	0xf1, 0xbf, 0x7e, 0x09, 0xbd, 0x7a, 0xd8, 0x53, 0x5b, 0x19, 0xea, 0x4a, 0x48, 0x9e, 0xae, 0x41,
-	0x22, 0xf1, 0xe1, 0x74, 0x3d, 0x35, 0x6b, 0x66, 0xe0, 0x77, 0x4c, 0x9a, 0xe1, 0x6e, 0xb3, 0x2d,
-	0x59, 0x78, 0xd3, 0x2d, 0x40, 0xff, 0x03, 0xc2, 0x77, 0xb6, 0xbe, 0xe6, 0x7f, 0xb9, 0xbf, 0xf8,
-	0x37, 0xe1, 0x5d, 0xdf, 0xad, 0x31, 0xb1, 0x3d, 0xd7, 0x27, 0xd8, 0x6d, 0x70, 0xc4, 0xc3, 0x37,
-	0x84, 0x2c, 0xd8, 0xcc, 0xd8, 0xea, 0xa6, 0x76, 0x41, 0x0e, 0x71, 0xcf, 0x36, 0x99, 0xa0, 0x6e,
-	0xa5, 0xf5, 0x2a, 0x39, 0x9f, 0x2f, 0x03, 0xb4, 0x58, 0x06, 0xe8, 0xc7, 0x32, 0x40, 0xef, 0x57,
-	0x81, 0xb3, 0x58, 0x05, 0xce, 0xb7, 0x55, 0xe0, 0xbc, 0x0a, 0xb9, 0xd0, 0x57, 0x6f, 0xf2, 0x68,
-	0xa4, 0xca, 0xfa, 0x94, 0xd7, 0xb7, 0x10, 0x8a, 0xeb, 0x78, 0xb6, 0xf9, 0x4f, 0xf4, 0xdb, 0x09,
-	0x83, 0xbc, 0x67, 0xce, 0xfb, 0xe3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x26, 0x14, 0xa7, 0x33,
-	0x9d, 0x03, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0x16, 0x0a, 0x73, 0xbb, 0x03, 0x56, 0x19, 0x61, 0x87, 0x6c, 0xaa, 0x00, 0x4d,
+	0x48, 0x4d, 0xb4, 0x72, 0xe4, 0x44, 0x2e, 0xd3, 0x0e, 0x48, 0xa8, 0x99, 0x38, 0x70, 0x20, 0x72,
+	0x12, 0xcf, 0xb5, 0x56, 0xdb, 0x51, 0x9e, 0xa9, 0xc6, 0xb7, 0xe0, 0x2b, 0x70, 0xe3, 0x08, 0x12,
+	0x1f, 0x62, 0xc7, 0x89, 0x13, 0x17, 0x10, 0x6a, 0x0f, 0x7c, 0x0d, 0x84, 0x9d, 0xd2, 0x0c, 0x2d,
+	0xaa, 0xb4, 0x4b, 0x12, 0xdb, 0xbf, 0xf7, 0x7f, 0xcf, 0xff, 0x97, 0x87, 0x1f, 0x67, 0x1a, 0xa4,
+	0x86, 0x10, 0x66, 0x14, 0xa6, 0x42, 0xf1, 0x70, 0x7e, 0x98, 0x32, 0x43, 0x0f, 0x43, 0xce, 0x14,
+	0x03, 0x01, 0x41, 0x51, 0x6a, 0xa3, 0xc9, 0x03, 0x87, 0x05, 0x2b, 0x2c, 0xa8, 0xb0, 0xdd, 0x01,
+	0xd7, 0x5c, 0x5b, 0x26, 0xfc, 0xfb, 0xe5, 0xf0, 0xdd, 0x27, 0x4d, 0xaa, 0xff, 0xe2, 0x1d, 0xf7,
+	0xd0, 0x71, 0x89, 0x13, 0xa8, 0x72, 0xb8, 0xa3, 0x7b, 0x54, 0x0a, 0xa5, 0x43, 0xfb, 0x74, 0x5b,
+	0xc3, 0x1f, 0x6d, 0xdc, 0x3f, 0x72, 0x65, 0xc5, 0x86, 0x1a, 0x46, 0x22, 0xdc, 0x2d, 0x68, 0x49,
+	0x25, 0x78, 0x68, 0x1f, 0x1d, 0xf4, 0xc6, 0x7b, 0x41, 0x43, 0x99, 0xc1, 0x2b, 0x8b, 0x45, 0x5b,
+	0x17, 0x3f, 0xf7, 0x5a, 0x9f, 0x7e, 0x7f, 0x7e, 0x8a, 0x26, 0x55, 0x24, 0x39, 0xc1, 0xdb, 0x20,
+	0xb8, 0x12, 0x8a, 0x27, 0x42, 0x9d, 0x6a, 0xf0, 0xda, 0xfb, 0x9d, 0x83, 0xde, 0xf8, 0x51, 0xa3,
+	0x54, 0xec, 0xe8, 0x63, 0x75, 0xaa, 0xeb, 0x7a, 0x7d, 0x58, 0xef, 0x03, 0x79, 0x8b, 0xb7, 0xa5,
+	0x00, 0x60, 0x79, 0x92, 0xce, 0x74, 0x76, 0x06, 0x5e, 0xc7, 0xaa, 0x06, 0x8d, 0xaa, 0xaf, 0xe9,
+	0x4c, 0xe4, 0xd4, 0xe8, 0xf2, 0xa5, 0x0d, 0x8b, 0x6c, 0xd4, 0x15, 0x7d, 0x59, 0x3b, 0x20, 0x31,
+	0xee, 0x0b, 0x59, 0x30, 0x9a, 0x4d, 0x25, 0x53, 0x06, 0xbc, 0x5b, 0x1b, 0x8a, 0x3e, 0x5e, 0xc3,
+	0x57, 0x44, 0xeb, 0x22, 0xc3, 0x2f, 0x08, 0xf7, 0x6a, 0xb7, 0x23, 0x63, 0x7c, 0x87, 0xe6, 0x79,
+	0xc9, 0xc0, 0xf9, 0xbb, 0x15, 0x79, 0xdf, 0xbe, 0x8e, 0x06, 0x55, 0x8a, 0x17, 0xee, 0x24, 0x36,
+	0xa5, 0x50, 0x7c, 0xb2, 0x02, 0x89, 0xc2, 0x3b, 0xf3, 0xd5, 0x55, 0x92, 0xba, 0xb1, 0x5e, 0xdb,
+	0xb6, 0x68, 0xb4, 0xd9, 0x81, 0x06, 0x83, 0x07, 0xf3, 0x6b, 0x80, 0xe1, 0x47, 0x84, 0xef, 0x5f,
+	0xeb, 0xdd, 0x8d, 0xaa, 0x3f, 0xf9, 0xbf, 0x6d, 0x9b, 0x7e, 0x86, 0x5a, 0xc6, 0xc6, 0x66, 0x0d,
+	0x9f, 0xe3, 0x5e, 0x8d, 0x23, 0x03, 0x7c, 0x5b, 0xa8, 0x9c, 0x9d, 0xdb, 0xb2, 0x3a, 0x13, 0xb7,
+	0x20, 0x3b, 0xb8, 0xeb, 0x82, 0xac, 0x51, 0x77, 0x27, 0xd5, 0x2a, 0x3a, 0xba, 0x58, 0xf8, 0xe8,
+	0x72, 0xe1, 0xa3, 0x5f, 0x0b, 0x1f, 0x7d, 0x58, 0xfa, 0xad, 0xcb, 0xa5, 0xdf, 0xfa, 0xbe, 0xf4,
+	0x5b, 0x6f, 0x46, 0x5c, 0x98, 0xe9, 0xbb, 0x34, 0xc8, 0xb4, 0xac, 0x46, 0xa7, 0x7a, 0x8d, 0x20,
+	0x3f, 0x0b, 0xcf, 0xd7, 0xc3, 0x67, 0xde, 0x17, 0x0c, 0xd2, 0xae, 0x1d, 0xa2, 0x67, 0x7f, 0x02,
+	0x00, 0x00, 0xff, 0xff, 0x9b, 0x4c, 0x62, 0xaa, 0xf2, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -320,6 +330,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Impeachments) > 0 {
+		for iNdEx := len(m.Impeachments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Impeachments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -514,6 +538,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Impeachments) > 0 {
+		for _, e := range m.Impeachments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -702,6 +732,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Impeachments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Impeachments = append(m.Impeachments, Impeachment{})
+			if err := m.Impeachments[len(m.Impeachments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -11,6 +11,12 @@ const (
 	TypeMsgUnjail = "unjail"
 )
 
+// Impeachment field limits
+const (
+	MaxImpeachDescriptionLength       = 1024
+	MaxImpeachEvidenceReferenceLength = 256
+)
+
 // verify interface at compile time
 var (
 	_ sdk.Msg = &MsgUnjail{}
@@ -85,6 +91,23 @@ func NewMsgImpeach(valAddr, from sdk.AccAddress) *MsgImpeach {
 	}
 }
 
+// NewMsgImpeachWithSlash creates a new MsgImpeach instance which also slashes the validator by the
+// given fraction at the infraction height and records the reason of the impeachment.
+func NewMsgImpeachWithSlash(
+	valAddr, from sdk.AccAddress, slashFraction sdk.Dec, infractionHeight int64, reason ImpeachReason,
+	description, evidenceReference string,
+) *MsgImpeach {
+	return &MsgImpeach{
+		ValidatorAddress:  valAddr.String(),
+		From:              from.String(),
+		SlashFraction:     slashFraction,
+		InfractionHeight:  infractionHeight,
+		Reason:            reason,
+		Description:       description,
+		EvidenceReference: evidenceReference,
+	}
+}
+
 // GetSigners implements the sdk.Msg interface.
 func (msg MsgImpeach) GetSigners() []sdk.AccAddress {
 	fromAddr, _ := sdk.AccAddressFromHexUnsafe(msg.From)
@@ -107,5 +130,30 @@ func (msg MsgImpeach) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
 	}
 
+	if !msg.SlashFraction.IsNil() && (msg.SlashFraction.IsNegative() || msg.SlashFraction.GT(sdk.OneDec())) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("slash fraction should be between zero and one, is %s", msg.SlashFraction)
+	}
+
+	if msg.InfractionHeight < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("infraction height should not be negative, is %d", msg.InfractionHeight)
+	}
+
+	if _, ok := ImpeachReason_name[int32(msg.Reason)]; !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid impeach reason: %d", msg.Reason)
+	}
+
+	if len(msg.Description) > MaxImpeachDescriptionLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("description is longer than %d", MaxImpeachDescriptionLength)
+	}
+
+	if len(msg.EvidenceReference) > MaxImpeachEvidenceReferenceLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("evidence reference is longer than %d", MaxImpeachEvidenceReferenceLength)
+	}
+
 	return nil
 }
+
+// IsSlashing returns true if the impeachment also slashes the validator.
+func (msg MsgImpeach) IsSlashing() bool {
+	return !msg.SlashFraction.IsNil() && msg.SlashFraction.IsPositive()
+}
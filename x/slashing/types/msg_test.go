@@ -18,3 +18,31 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgImpeachValidateBasic(t *testing.T) {
+	valAddr, from := sdk.AccAddress("val_________________"), sdk.AccAddress("from________________")
+	testCases := []struct {
+		name   string
+		msg    *MsgImpeach
+		expErr bool
+	}{
+		{"no slashing", NewMsgImpeach(valAddr, from), false},
+		{"valid slashing", NewMsgImpeachWithSlash(valAddr, from, sdk.NewDecWithPrec(5, 1), 10, ImpeachReason_IMPEACH_REASON_DOUBLE_SIGN, "desc", "ref"), false},
+		{"full slashing", NewMsgImpeachWithSlash(valAddr, from, sdk.OneDec(), 0, ImpeachReason_IMPEACH_REASON_UNSPECIFIED, "", ""), false},
+		{"negative fraction", NewMsgImpeachWithSlash(valAddr, from, sdk.NewDec(-1), 0, ImpeachReason_IMPEACH_REASON_DOUBLE_SIGN, "", ""), true},
+		{"fraction above one", NewMsgImpeachWithSlash(valAddr, from, sdk.NewDec(2), 0, ImpeachReason_IMPEACH_REASON_DOUBLE_SIGN, "", ""), true},
+		{"negative infraction height", NewMsgImpeachWithSlash(valAddr, from, sdk.ZeroDec(), -1, ImpeachReason_IMPEACH_REASON_DOUBLE_SIGN, "", ""), true},
+		{"invalid reason", NewMsgImpeachWithSlash(valAddr, from, sdk.ZeroDec(), 0, ImpeachReason(100), "", ""), true},
+		{"description too long", NewMsgImpeachWithSlash(valAddr, from, sdk.ZeroDec(), 0, ImpeachReason_IMPEACH_REASON_DOUBLE_SIGN, string(make([]byte, MaxImpeachDescriptionLength+1)), ""), true},
+		{"evidence reference too long", NewMsgImpeachWithSlash(valAddr, from, sdk.ZeroDec(), 0, ImpeachReason_IMPEACH_REASON_DOUBLE_SIGN, "", string(make([]byte, MaxImpeachEvidenceReferenceLength+1))), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +32,46 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ImpeachReason defines the reason a validator was impeached by governance.
+type ImpeachReason int32
+
+const (
+	// IMPEACH_REASON_UNSPECIFIED defines an unspecified reason.
+	ImpeachReason_IMPEACH_REASON_UNSPECIFIED ImpeachReason = 0
+	// IMPEACH_REASON_DOUBLE_RELAY defines a validator relaying conflicting cross chain packages.
+	ImpeachReason_IMPEACH_REASON_DOUBLE_RELAY ImpeachReason = 1
+	// IMPEACH_REASON_CHALLENGE_FAILURE defines a validator failing storage challenges.
+	ImpeachReason_IMPEACH_REASON_CHALLENGE_FAILURE ImpeachReason = 2
+	// IMPEACH_REASON_DOUBLE_SIGN defines a validator signing conflicting blocks.
+	ImpeachReason_IMPEACH_REASON_DOUBLE_SIGN ImpeachReason = 3
+	// IMPEACH_REASON_MISBEHAVIOR defines any other misbehavior of a validator.
+	ImpeachReason_IMPEACH_REASON_MISBEHAVIOR ImpeachReason = 4
+)
+
+var ImpeachReason_name = map[int32]string{
+	0: "IMPEACH_REASON_UNSPECIFIED",
+	1: "IMPEACH_REASON_DOUBLE_RELAY",
+	2: "IMPEACH_REASON_CHALLENGE_FAILURE",
+	3: "IMPEACH_REASON_DOUBLE_SIGN",
+	4: "IMPEACH_REASON_MISBEHAVIOR",
+}
+
+var ImpeachReason_value = map[string]int32{
+	"IMPEACH_REASON_UNSPECIFIED":       0,
+	"IMPEACH_REASON_DOUBLE_RELAY":      1,
+	"IMPEACH_REASON_CHALLENGE_FAILURE": 2,
+	"IMPEACH_REASON_DOUBLE_SIGN":       3,
+	"IMPEACH_REASON_MISBEHAVIOR":       4,
+}
+
+func (x ImpeachReason) String() string {
+	return proto.EnumName(ImpeachReason_name, int32(x))
+}
+
+func (ImpeachReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -181,9 +222,116 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+// Impeachment records a validator impeached by governance.
+type Impeachment struct {
+	// validator_address is the operator address of the impeached validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height is the block height at which the validator was impeached.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the validator was impeached.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// slash_fraction is the fraction of the validator stake slashed on impeachment.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// slashed_tokens is the amount of tokens burned by the slashing.
+	SlashedTokens cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=slashed_tokens,json=slashedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"slashed_tokens"`
+	// reason is the reason of the impeachment.
+	Reason ImpeachReason `protobuf:"varint,6,opt,name=reason,proto3,enum=cosmos.slashing.v1beta1.ImpeachReason" json:"reason,omitempty"`
+	// description is a free form description of the misbehavior.
+	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	// evidence_reference is an optional reference to the evidence of the misbehavior,
+	// e.g. a transaction hash or an uri.
+	EvidenceReference string `protobuf:"bytes,8,opt,name=evidence_reference,json=evidenceReference,proto3" json:"evidence_reference,omitempty"`
+	// infraction_height is the height of the misbehavior at which the validator was slashed.
+	InfractionHeight int64 `protobuf:"varint,9,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+}
+
+func (m *Impeachment) Reset()         { *m = Impeachment{} }
+func (m *Impeachment) String() string { return proto.CompactTextString(m) }
+func (*Impeachment) ProtoMessage()    {}
+func (*Impeachment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *Impeachment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Impeachment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Impeachment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Impeachment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Impeachment.Merge(m, src)
+}
+func (m *Impeachment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Impeachment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Impeachment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Impeachment proto.InternalMessageInfo
+
+func (m *Impeachment) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *Impeachment) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Impeachment) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Impeachment) GetReason() ImpeachReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ImpeachReason_IMPEACH_REASON_UNSPECIFIED
+}
+
+func (m *Impeachment) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Impeachment) GetEvidenceReference() string {
+	if m != nil {
+		return m.EvidenceReference
+	}
+	return ""
+}
+
+func (m *Impeachment) GetInfractionHeight() int64 {
+	if m != nil {
+		return m.InfractionHeight
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.slashing.v1beta1.ImpeachReason", ImpeachReason_name, ImpeachReason_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*Impeachment)(nil), "cosmos.slashing.v1beta1.Impeachment")
 }
 
 func init() {
@@ -191,46 +339,65 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x3f, 0x4f, 0xdb, 0x4e,
-	0x18, 0xce, 0x01, 0x3f, 0x7e, 0xf4, 0x42, 0x87, 0x5e, 0x43, 0x31, 0x51, 0x65, 0x07, 0x06, 0x14,
-	0x21, 0x61, 0x97, 0xb0, 0xb1, 0x35, 0x45, 0x55, 0xff, 0x49, 0x45, 0xa1, 0x7f, 0xa4, 0x0e, 0xb5,
-	0xce, 0xbe, 0xb3, 0x73, 0xc5, 0xbe, 0x8b, 0x7c, 0xe7, 0x42, 0xf7, 0x4e, 0x9d, 0x18, 0x19, 0x19,
-	0x19, 0x19, 0x3a, 0xf6, 0x03, 0x30, 0xa2, 0x4e, 0x55, 0x07, 0x5a, 0x85, 0x81, 0x7e, 0x8c, 0xca,
-	0x77, 0x76, 0x88, 0x40, 0xea, 0xc0, 0x92, 0xd8, 0xcf, 0xf3, 0xbc, 0xcf, 0x7b, 0xcf, 0xfb, 0x9e,
-	0xe1, 0x72, 0x28, 0x64, 0x2a, 0xa4, 0x27, 0x13, 0x2c, 0xfb, 0x8c, 0xc7, 0xde, 0xc7, 0xb5, 0x80,
-	0x2a, 0xbc, 0x36, 0x02, 0xdc, 0x41, 0x26, 0x94, 0x40, 0xf3, 0x46, 0xe7, 0x8e, 0xe0, 0x52, 0xd7,
-	0x6c, 0xc4, 0x22, 0x16, 0x5a, 0xe3, 0x15, 0x4f, 0x46, 0xde, 0xb4, 0x63, 0x21, 0xe2, 0x84, 0x7a,
-	0xfa, 0x2d, 0xc8, 0x23, 0x8f, 0xe4, 0x19, 0x56, 0x4c, 0xf0, 0x92, 0x77, 0xae, 0xf2, 0x8a, 0xa5,
-	0x54, 0x2a, 0x9c, 0x0e, 0x4a, 0xc1, 0x82, 0xe9, 0xe7, 0x1b, 0xe7, 0xb2, 0xb9, 0xa1, 0xee, 0xe0,
-	0x94, 0x71, 0xe1, 0xe9, 0x5f, 0x03, 0x2d, 0x7d, 0x9b, 0x80, 0x8d, 0x37, 0x38, 0x61, 0x04, 0x2b,
-	0x91, 0x6d, 0xb3, 0x98, 0x33, 0x1e, 0x3f, 0xe5, 0x91, 0x40, 0x1d, 0xf8, 0x3f, 0x26, 0x24, 0xa3,
-	0x52, 0x5a, 0xa0, 0x05, 0xda, 0xb7, 0xba, 0xd6, 0xf7, 0xaf, 0xab, 0x8d, 0xd2, 0xee, 0xa1, 0x61,
-	0xb6, 0x55, 0xc6, 0x78, 0xdc, 0xab, 0x84, 0x68, 0x11, 0xce, 0x4a, 0x85, 0x33, 0xe5, 0xf7, 0x29,
-	0x8b, 0xfb, 0xca, 0x9a, 0x68, 0x81, 0xf6, 0x64, 0xaf, 0xae, 0xb1, 0x27, 0x1a, 0x2a, 0x24, 0x8c,
-	0x13, 0xba, 0xe7, 0x8b, 0x28, 0x92, 0x54, 0x59, 0x93, 0x46, 0xa2, 0xb1, 0x97, 0x1a, 0x42, 0x2f,
-	0xe0, 0xec, 0x07, 0xcc, 0x12, 0x4a, 0xfc, 0x9c, 0x2b, 0x96, 0x58, 0x53, 0x2d, 0xd0, 0xae, 0x77,
-	0x9a, 0xae, 0x09, 0xee, 0x56, 0xc1, 0xdd, 0x57, 0x55, 0xf0, 0xee, 0xed, 0x93, 0x33, 0xa7, 0xb6,
-	0xff, 0xcb, 0x01, 0x47, 0x17, 0xc7, 0x2b, 0xa0, 0x57, 0x37, 0xe5, 0xaf, 0x8b, 0x6a, 0x64, 0x43,
-	0xa8, 0x44, 0x1a, 0x48, 0x25, 0x38, 0x25, 0xd6, 0x7f, 0x2d, 0xd0, 0x9e, 0xe9, 0x8d, 0x21, 0xa8,
-	0x03, 0xe7, 0x52, 0x26, 0x25, 0x25, 0x7e, 0x90, 0x88, 0x70, 0x47, 0xfa, 0xa1, 0xc8, 0xb9, 0xa2,
-	0x99, 0x35, 0xad, 0x4f, 0x76, 0xd7, 0x90, 0x5d, 0xcd, 0x3d, 0x32, 0xd4, 0xc6, 0xcc, 0xc1, 0xa1,
-	0x53, 0xfb, 0x73, 0xe8, 0x80, 0xa5, 0xcf, 0x53, 0x70, 0x7a, 0x0b, 0x67, 0x38, 0x95, 0xe8, 0x01,
-	0x6c, 0x48, 0x16, 0xf3, 0x4b, 0xa3, 0x5d, 0xc6, 0x89, 0xd8, 0xd5, 0xd3, 0x9b, 0xec, 0x21, 0xc3,
-	0x19, 0x9f, 0xb7, 0x9a, 0x41, 0x51, 0xd1, 0x9a, 0xfb, 0x65, 0xd5, 0x80, 0x66, 0x55, 0x49, 0x31,
-	0xb7, 0xd9, 0xee, 0x7a, 0x91, 0xea, 0xe7, 0x99, 0xb3, 0x1c, 0x33, 0xd5, 0xcf, 0x03, 0x37, 0x14,
-	0x69, 0xb9, 0xce, 0xf2, 0x6f, 0x55, 0x92, 0x1d, 0x4f, 0x7d, 0x1a, 0x50, 0xe9, 0x6e, 0xd2, 0xd0,
-	0x64, 0x47, 0x29, 0xe3, 0xdb, 0xda, 0x70, 0x8b, 0x66, 0x65, 0x9f, 0xf7, 0xf0, 0x1e, 0x11, 0xbb,
-	0xbc, 0xb8, 0x28, 0x7e, 0x31, 0x1a, 0xbf, 0xba, 0x52, 0x7a, 0xfa, 0xf5, 0xce, 0xc2, 0xb5, 0xd1,
-	0x6e, 0x96, 0x02, 0x33, 0xd9, 0x83, 0xd1, 0x64, 0x1b, 0x95, 0xcf, 0x33, 0xcc, 0x92, 0x4a, 0x84,
-	0x06, 0xb0, 0xa9, 0x2f, 0xb7, 0x1f, 0x65, 0x38, 0x2c, 0x10, 0x9f, 0x88, 0x3c, 0x48, 0xa8, 0x4e,
-	0xa6, 0xd7, 0x77, 0xc3, 0x30, 0xf3, 0xda, 0xf6, 0x71, 0xe9, 0xba, 0xa9, 0x4d, 0x8b, 0x70, 0x68,
-	0x07, 0xce, 0x5f, 0xeb, 0x68, 0x0e, 0xa6, 0x37, 0x7c, 0xc3, 0x76, 0x73, 0x57, 0xda, 0x19, 0xc7,
-	0x8d, 0xc5, 0x2f, 0x17, 0xc7, 0x2b, 0xf7, 0xc7, 0xca, 0xf6, 0x2e, 0xbf, 0x79, 0xb3, 0xfb, 0xee,
-	0xf3, 0xa3, 0xa1, 0x0d, 0x4e, 0x86, 0x36, 0x38, 0x1d, 0xda, 0xe0, 0xf7, 0xd0, 0x06, 0xfb, 0xe7,
-	0x76, 0xed, 0xf4, 0xdc, 0xae, 0xfd, 0x38, 0xb7, 0x6b, 0xef, 0x56, 0xff, 0x79, 0x88, 0x31, 0x37,
-	0x7d, 0x9e, 0x60, 0x5a, 0xaf, 0x61, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x46, 0x11,
-	0xae, 0x61, 0x04, 0x00, 0x00,
+	// 921 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xb1, 0x6f, 0xdb, 0xc6,
+	0x17, 0x16, 0x2d, 0xfd, 0x14, 0xfb, 0x64, 0x07, 0xf2, 0xfd, 0xe4, 0x58, 0x51, 0x0b, 0x4a, 0x31,
+	0x0a, 0xc3, 0x70, 0x21, 0x2a, 0x51, 0xb6, 0x00, 0x2d, 0x20, 0x59, 0x74, 0xcc, 0x56, 0xb1, 0x0d,
+	0x2a, 0x4e, 0xd0, 0x0e, 0x3d, 0x50, 0xe4, 0x89, 0xba, 0x4a, 0xbc, 0x13, 0x78, 0x27, 0x3b, 0xdd,
+	0x3b, 0x75, 0xca, 0x98, 0x31, 0x63, 0x86, 0x0e, 0x19, 0x82, 0x4e, 0xfd, 0x03, 0x32, 0x06, 0x99,
+	0x8a, 0x0e, 0x69, 0x61, 0x0f, 0xe9, 0x9f, 0x51, 0xf0, 0xee, 0x28, 0xcb, 0x4e, 0x5a, 0x34, 0x59,
+	0x24, 0xf2, 0x7d, 0xdf, 0xfb, 0xde, 0xbd, 0xef, 0xdd, 0x23, 0xd8, 0xf4, 0x19, 0x8f, 0x18, 0x6f,
+	0xf0, 0xb1, 0xc7, 0x87, 0x84, 0x86, 0x8d, 0xe3, 0x5b, 0x7d, 0x2c, 0xbc, 0x5b, 0xb3, 0x80, 0x35,
+	0x89, 0x99, 0x60, 0x70, 0x5d, 0xf1, 0xac, 0x59, 0x58, 0xf3, 0x2a, 0xa5, 0x90, 0x85, 0x4c, 0x72,
+	0x1a, 0xc9, 0x93, 0xa2, 0x57, 0xcc, 0x90, 0xb1, 0x70, 0x8c, 0x1b, 0xf2, 0xad, 0x3f, 0x1d, 0x34,
+	0x82, 0x69, 0xec, 0x09, 0xc2, 0xa8, 0xc6, 0xab, 0x97, 0x71, 0x41, 0x22, 0xcc, 0x85, 0x17, 0x4d,
+	0x34, 0xe1, 0xba, 0xaa, 0x87, 0x94, 0xb2, 0x2e, 0xae, 0xa0, 0x55, 0x2f, 0x22, 0x94, 0x35, 0xe4,
+	0xaf, 0x0a, 0x6d, 0xfc, 0xba, 0x00, 0x4a, 0x0f, 0xbc, 0x31, 0x09, 0x3c, 0xc1, 0xe2, 0x1e, 0x09,
+	0x29, 0xa1, 0xa1, 0x43, 0x07, 0x0c, 0x36, 0xc1, 0x15, 0x2f, 0x08, 0x62, 0xcc, 0x79, 0xd9, 0xa8,
+	0x19, 0x5b, 0x4b, 0xed, 0xf2, 0xeb, 0x17, 0xf5, 0x92, 0x96, 0x6b, 0x29, 0xa4, 0x27, 0x62, 0x42,
+	0x43, 0x37, 0x25, 0xc2, 0x1b, 0x60, 0x99, 0x0b, 0x2f, 0x16, 0x68, 0x88, 0x49, 0x38, 0x14, 0xe5,
+	0x85, 0x9a, 0xb1, 0x95, 0x75, 0x0b, 0x32, 0xb6, 0x27, 0x43, 0x09, 0x85, 0xd0, 0x00, 0x3f, 0x42,
+	0x6c, 0x30, 0xe0, 0x58, 0x94, 0xb3, 0x8a, 0x22, 0x63, 0x07, 0x32, 0x04, 0xbb, 0x60, 0xf9, 0x7b,
+	0x8f, 0x8c, 0x71, 0x80, 0xa6, 0x54, 0x90, 0x71, 0x39, 0x57, 0x33, 0xb6, 0x0a, 0xcd, 0x8a, 0xa5,
+	0x1a, 0xb7, 0xd2, 0xc6, 0xad, 0xfb, 0x69, 0xe3, 0xed, 0x95, 0x97, 0x6f, 0xaa, 0x99, 0xc7, 0x7f,
+	0x54, 0x8d, 0x67, 0x6f, 0x9f, 0x6f, 0x1b, 0x6e, 0x41, 0xa5, 0x1f, 0x25, 0xd9, 0xd0, 0x04, 0x40,
+	0xb0, 0xa8, 0xcf, 0x05, 0xa3, 0x38, 0x28, 0xff, 0xaf, 0x66, 0x6c, 0x2d, 0xba, 0x73, 0x11, 0xd8,
+	0x04, 0x6b, 0x11, 0xe1, 0x1c, 0x07, 0xa8, 0x3f, 0x66, 0xfe, 0x88, 0x23, 0x9f, 0x4d, 0xa9, 0xc0,
+	0x71, 0x39, 0x2f, 0x4f, 0xf6, 0x7f, 0x05, 0xb6, 0x25, 0xb6, 0xa3, 0xa0, 0x3b, 0x8b, 0x4f, 0x9e,
+	0x56, 0x33, 0x7f, 0x3d, 0xad, 0x1a, 0x1b, 0x3f, 0xe6, 0x40, 0xfe, 0xd0, 0x8b, 0xbd, 0x88, 0xc3,
+	0x9b, 0xa0, 0xc4, 0x49, 0x48, 0xcf, 0x85, 0x4e, 0x08, 0x0d, 0xd8, 0x89, 0x74, 0x2f, 0xeb, 0x42,
+	0x85, 0x29, 0x9d, 0x87, 0x12, 0x81, 0x83, 0xa4, 0x34, 0x45, 0x3a, 0x6b, 0x82, 0xe3, 0x34, 0x25,
+	0xf1, 0x6d, 0xb9, 0x7d, 0x3b, 0xe9, 0xea, 0xf7, 0x37, 0xd5, 0xcd, 0x90, 0x88, 0xe1, 0xb4, 0x6f,
+	0xf9, 0x2c, 0xd2, 0xe3, 0xd4, 0x7f, 0x75, 0x1e, 0x8c, 0x1a, 0xe2, 0x87, 0x09, 0xe6, 0x56, 0x07,
+	0xfb, 0xaa, 0x77, 0x18, 0x11, 0xda, 0x93, 0x82, 0x87, 0x38, 0xd6, 0x75, 0xbe, 0x03, 0xd7, 0x02,
+	0x76, 0x42, 0x93, 0x8b, 0x82, 0x12, 0x6b, 0x50, 0x7a, 0xa5, 0xa4, 0xfb, 0x85, 0xe6, 0xf5, 0x77,
+	0xac, 0xed, 0x68, 0x82, 0x72, 0xf6, 0xc9, 0xcc, 0xd9, 0x52, 0xaa, 0xf3, 0x95, 0x47, 0xc6, 0x29,
+	0x09, 0x4e, 0x40, 0x45, 0x5e, 0x6e, 0x34, 0x88, 0x3d, 0x3f, 0x89, 0xa0, 0x80, 0x4d, 0xfb, 0x63,
+	0x2c, 0x3b, 0x93, 0xe3, 0xfb, 0xc8, 0x66, 0xd6, 0xa5, 0xec, 0xae, 0x56, 0xed, 0x48, 0xd1, 0xa4,
+	0x39, 0x38, 0x02, 0xeb, 0xef, 0x54, 0x54, 0x07, 0x93, 0x13, 0xfe, 0xc8, 0x72, 0x6b, 0x97, 0xca,
+	0x29, 0xc5, 0x3b, 0x37, 0x7e, 0x7a, 0xfb, 0x7c, 0xfb, 0xd3, 0xb9, 0xb4, 0x47, 0xe7, 0x3b, 0xaf,
+	0x66, 0xbf, 0xf1, 0x73, 0x0e, 0x14, 0x9c, 0x68, 0x82, 0x3d, 0x7f, 0x18, 0x61, 0x2a, 0xa0, 0x0d,
+	0x56, 0x8f, 0xd3, 0xa5, 0x42, 0xff, 0x75, 0x8d, 0x8a, 0xb3, 0x14, 0x1d, 0x87, 0xd7, 0x40, 0xfe,
+	0xc2, 0x26, 0xe9, 0x37, 0xf8, 0x05, 0xc8, 0xc9, 0x5e, 0xb3, 0x1f, 0xba, 0x19, 0x32, 0x0d, 0x0e,
+	0xc1, 0xd5, 0x8b, 0xee, 0xc9, 0x19, 0x2d, 0xb5, 0x5b, 0x1f, 0x66, 0xda, 0xeb, 0x17, 0x75, 0xa0,
+	0x1b, 0x99, 0x59, 0xb8, 0x72, 0xc1, 0x42, 0xf8, 0x50, 0x57, 0xc2, 0x01, 0x12, 0x6c, 0x84, 0x29,
+	0x97, 0xe3, 0x59, 0x6a, 0xdf, 0xd4, 0x95, 0xd6, 0x54, 0x3e, 0x0f, 0x46, 0x16, 0x61, 0x8d, 0xc8,
+	0x13, 0x43, 0xcb, 0xa1, 0x62, 0x4e, 0xd8, 0xa1, 0x62, 0x5e, 0x18, 0x07, 0xf7, 0xa5, 0x0c, 0xfc,
+	0x12, 0xe4, 0x63, 0xec, 0x71, 0x46, 0xe5, 0x9a, 0x5e, 0x6d, 0x6e, 0x5a, 0xff, 0xf0, 0x95, 0xb5,
+	0xf4, 0x58, 0x5c, 0xc9, 0x76, 0x75, 0x16, 0xac, 0x81, 0x42, 0x80, 0xb9, 0x1f, 0x93, 0x89, 0xec,
+	0xff, 0x4a, 0x72, 0x2a, 0x77, 0x3e, 0x04, 0xeb, 0x00, 0xe2, 0x63, 0x12, 0x60, 0xea, 0x63, 0x14,
+	0xe3, 0x01, 0x8e, 0x93, 0xa7, 0xf2, 0xa2, 0x24, 0xae, 0xa6, 0x88, 0x9b, 0x02, 0xf0, 0x73, 0xb0,
+	0x4a, 0xe8, 0xec, 0x36, 0xea, 0xa9, 0x2d, 0xc9, 0xa9, 0x15, 0xcf, 0x01, 0xf5, 0x11, 0xdc, 0xfe,
+	0xc5, 0x00, 0x2b, 0x17, 0xce, 0x05, 0x4d, 0x50, 0x71, 0xee, 0x1d, 0xda, 0xad, 0x9d, 0x3d, 0xe4,
+	0xda, 0xad, 0xde, 0xc1, 0x3e, 0x3a, 0xda, 0xef, 0x1d, 0xda, 0x3b, 0xce, 0xae, 0x63, 0x77, 0x8a,
+	0x19, 0x58, 0x05, 0x9f, 0x5c, 0xc2, 0x3b, 0x07, 0x47, 0xed, 0xae, 0x8d, 0x5c, 0xbb, 0xdb, 0xfa,
+	0xa6, 0x68, 0xc0, 0xcf, 0x40, 0xed, 0x12, 0x61, 0x67, 0xaf, 0xd5, 0xed, 0xda, 0xfb, 0x77, 0x6d,
+	0xb4, 0xdb, 0x72, 0xba, 0x47, 0xae, 0x5d, 0x5c, 0x78, 0x4f, 0x19, 0x2d, 0xd3, 0x73, 0xee, 0xee,
+	0x17, 0xb3, 0xef, 0xc1, 0xef, 0x39, 0xbd, 0xb6, 0xbd, 0xd7, 0x7a, 0xe0, 0x1c, 0xb8, 0xc5, 0x5c,
+	0xfb, 0xeb, 0x67, 0xa7, 0xa6, 0xf1, 0xf2, 0xd4, 0x34, 0x5e, 0x9d, 0x9a, 0xc6, 0x9f, 0xa7, 0xa6,
+	0xf1, 0xf8, 0xcc, 0xcc, 0xbc, 0x3a, 0x33, 0x33, 0xbf, 0x9d, 0x99, 0x99, 0x6f, 0xeb, 0xff, 0x7a,
+	0x6f, 0xe6, 0xb6, 0x46, 0x5e, 0xa1, 0x7e, 0x5e, 0xde, 0xd7, 0xdb, 0x7f, 0x07, 0x00, 0x00, 0xff,
+	0xff, 0x09, 0x1a, 0x83, 0x1a, 0x49, 0x07, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -308,6 +475,54 @@ func (this *Params) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Impeachment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Impeachment)
+	if !ok {
+		that2, ok := that.(Impeachment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if !this.SlashedTokens.Equal(that1.SlashedTokens) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.EvidenceReference != that1.EvidenceReference {
+		return false
+	}
+	if this.InfractionHeight != that1.InfractionHeight {
+		return false
+	}
+	return true
+}
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -437,6 +652,93 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Impeachment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Impeachment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Impeachment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InfractionHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.EvidenceReference) > 0 {
+		i -= len(m.EvidenceReference)
+		copy(dAtA[i:], m.EvidenceReference)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.EvidenceReference)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SlashedTokens.Size()
+		i -= size
+		if _, err := m.SlashedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -495,6 +797,42 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *Impeachment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashedTokens.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Reason != 0 {
+		n += 1 + sovSlashing(uint64(m.Reason))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.EvidenceReference)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.InfractionHeight))
+	}
+	return n
+}
+
 func sovSlashing(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -894,6 +1232,310 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Impeachment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Impeachment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Impeachment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= ImpeachReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSlashing(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -209,6 +210,17 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 type MsgImpeach struct {
 	From             string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// slash_fraction is the fraction of the validator stake to slash, no slashing happens if it is zero.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// reason is the reason of the impeachment.
+	Reason ImpeachReason `protobuf:"varint,4,opt,name=reason,proto3,enum=cosmos.slashing.v1beta1.ImpeachReason" json:"reason,omitempty"`
+	// description is a free form description of the misbehavior.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// evidence_reference is an optional reference to the evidence of the misbehavior.
+	EvidenceReference string `protobuf:"bytes,6,opt,name=evidence_reference,json=evidenceReference,proto3" json:"evidence_reference,omitempty"`
+	// infraction_height is the height of the misbehavior at which the validator is slashed, the height of the
+	// impeachment is used if it is zero.
+	InfractionHeight int64 `protobuf:"varint,7,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
 }
 
 func (m *MsgImpeach) Reset()         { *m = MsgImpeach{} }
@@ -293,41 +305,50 @@ func init() {
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 540 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0x05, 0x52, 0xe5, 0xf8, 0x19, 0x13, 0xa9, 0xa9, 0x07, 0x3b, 0x32, 0x02, 0x45,
-	0x81, 0xd8, 0xb4, 0x54, 0x08, 0x65, 0x23, 0x12, 0x03, 0x42, 0x91, 0x50, 0x10, 0x12, 0xa2, 0x43,
-	0x75, 0x89, 0x8d, 0xe3, 0x12, 0xfb, 0x2c, 0xbf, 0x6b, 0xd5, 0x6e, 0x88, 0x09, 0x31, 0x31, 0x30,
-	0x30, 0xa1, 0x88, 0xa9, 0x1b, 0x19, 0xf8, 0x1f, 0xe8, 0x58, 0x31, 0x31, 0x55, 0x28, 0x19, 0x82,
-	0xf8, 0x2b, 0xd0, 0xf9, 0xce, 0x4e, 0x13, 0x35, 0x69, 0x97, 0xc4, 0xf7, 0xee, 0xf3, 0xbe, 0xef,
-	0x7d, 0xef, 0xdd, 0xe1, 0x72, 0x87, 0x42, 0x40, 0xc1, 0x86, 0x1e, 0x81, 0xae, 0x1f, 0x7a, 0xf6,
-	0xee, 0x5a, 0xdb, 0x65, 0x64, 0xcd, 0x66, 0x7b, 0x56, 0x14, 0x53, 0x46, 0xd5, 0x15, 0x41, 0x58,
-	0x29, 0x61, 0x49, 0x42, 0x2b, 0x7a, 0xd4, 0xa3, 0x09, 0x63, 0xf3, 0x2f, 0x81, 0x6b, 0x77, 0xe6,
-	0x09, 0x66, 0xf9, 0x82, 0x5b, 0x15, 0xdc, 0x96, 0x10, 0x90, 0x35, 0xc4, 0x96, 0xac, 0x68, 0x07,
-	0xc0, 0xb3, 0xf9, 0x9f, 0xdc, 0x28, 0x90, 0xc0, 0x0f, 0xa9, 0x9d, 0xfc, 0x8a, 0x90, 0xf9, 0x15,
-	0xe1, 0x7c, 0x13, 0xbc, 0x97, 0xe1, 0x36, 0xf1, 0x7b, 0xea, 0x26, 0xbe, 0xb6, 0x4b, 0x7a, 0xbe,
-	0x43, 0x18, 0x8d, 0xb7, 0x88, 0xe3, 0xc4, 0x25, 0x54, 0x46, 0x95, 0x7c, 0x63, 0xe3, 0xdf, 0xb1,
-	0xb1, 0xcc, 0xd7, 0x2e, 0xc0, 0xaf, 0x1f, 0xb5, 0xa2, 0x2c, 0xf7, 0x58, 0x44, 0x5e, 0xb0, 0xd8,
-	0x0f, 0xbd, 0x6f, 0xe3, 0x41, 0x35, 0x65, 0x0e, 0xc6, 0x83, 0x2a, 0x6a, 0x5d, 0xcd, 0xb4, 0x38,
-	0x58, 0xdf, 0xf8, 0xd0, 0x37, 0x94, 0x2f, 0x7d, 0x03, 0xbd, 0x1f, 0x0f, 0xaa, 0x33, 0x75, 0x3e,
-	0x8e, 0x07, 0x55, 0xa9, 0x5a, 0x03, 0xe7, 0xad, 0x9d, 0xb5, 0x64, 0xde, 0xc4, 0x85, 0x6c, 0xd1,
-	0x72, 0x21, 0xa2, 0x21, 0xb8, 0xe6, 0x4f, 0x84, 0xaf, 0xf3, 0x68, 0xe4, 0x10, 0xe6, 0x3e, 0x27,
-	0x31, 0x09, 0x40, 0x7d, 0x88, 0xf3, 0x64, 0x87, 0x75, 0x69, 0xec, 0xb3, 0x7d, 0xd9, 0x76, 0x69,
-	0x5e, 0xaf, 0xad, 0x09, 0xaa, 0x36, 0x70, 0x2e, 0x4a, 0x14, 0x4a, 0x4b, 0x65, 0x54, 0xb9, 0xbc,
-	0x6e, 0x58, 0x73, 0x06, 0x66, 0x89, 0x42, 0x8d, 0xfc, 0xe1, 0xb1, 0xa1, 0x08, 0x87, 0x32, 0xb3,
-	0xfe, 0x88, 0x5b, 0x9a, 0x68, 0x72, 0x37, 0xb7, 0x4f, 0xb8, 0xd9, 0x9b, 0x4c, 0x73, 0xa6, 0x6b,
-	0x73, 0x15, 0xaf, 0xcc, 0x84, 0x32, 0x93, 0x9f, 0x11, 0xc6, 0x4d, 0xf0, 0x9e, 0x06, 0x91, 0x4b,
-	0x3a, 0x5d, 0xf5, 0x1e, 0xbe, 0xf8, 0x26, 0xa6, 0xc1, 0x99, 0xd6, 0x12, 0x4a, 0x7d, 0x82, 0x0b,
-	0xd3, 0x27, 0xec, 0x82, 0x30, 0xb8, 0x28, 0xf5, 0xc6, 0xd4, 0xc0, 0x5c, 0x80, 0x7a, 0x81, 0xcf,
-	0xec, 0x6f, 0xdf, 0x50, 0xb8, 0xc1, 0x44, 0xd9, 0x2c, 0x62, 0x75, 0xd2, 0x55, 0xda, 0xec, 0xfa,
-	0xf7, 0x25, 0x7c, 0xa1, 0x09, 0x9e, 0xfa, 0x0a, 0xe7, 0xe4, 0x5d, 0x32, 0xe7, 0x9e, 0x63, 0x36,
-	0x4f, 0xad, 0x7a, 0x36, 0x93, 0x56, 0x50, 0xb7, 0xf1, 0x95, 0xa9, 0x79, 0x57, 0x16, 0xe6, 0x9e,
-	0x20, 0xb5, 0xfb, 0xe7, 0x25, 0xb3, 0x5a, 0x9b, 0x78, 0x39, 0x3d, 0xf6, 0x5b, 0x8b, 0x92, 0x25,
-	0xa4, 0xdd, 0x3d, 0x07, 0x94, 0x8a, 0x6b, 0x97, 0xde, 0xf1, 0xbb, 0xd3, 0x78, 0x76, 0x30, 0xd4,
-	0xd1, 0xe1, 0x50, 0x47, 0x47, 0x43, 0x1d, 0xfd, 0x19, 0xea, 0xe8, 0xd3, 0x48, 0x57, 0x8e, 0x46,
-	0xba, 0xf2, 0x7b, 0xa4, 0x2b, 0xaf, 0x6b, 0x9e, 0xcf, 0xba, 0x3b, 0x6d, 0xab, 0x43, 0x03, 0xf9,
-	0xb8, 0xed, 0xd3, 0x2f, 0x14, 0xdb, 0x8f, 0x5c, 0x68, 0xe7, 0x92, 0xd7, 0xfc, 0xe0, 0x7f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xd6, 0x17, 0x2b, 0xa9, 0x8f, 0x04, 0x00, 0x00,
+	// 678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0x50, 0x28, 0xe9, 0xa0, 0x48, 0x47, 0x12, 0x96, 0x1e, 0x76, 0x9b, 0x1a, 0x49, 0x53,
+	0xec, 0xae, 0x20, 0x31, 0x86, 0x83, 0x09, 0x0d, 0x1a, 0x8d, 0x21, 0x31, 0x6b, 0x4c, 0x8c, 0x1c,
+	0x9a, 0x61, 0x77, 0xd8, 0x5d, 0x64, 0x77, 0x36, 0x3b, 0x0b, 0x81, 0x9b, 0xf1, 0x64, 0x3c, 0x79,
+	0xf4, 0x64, 0x88, 0x27, 0x6e, 0xf6, 0xc0, 0xd1, 0xbb, 0x1c, 0x09, 0x27, 0xe3, 0x81, 0x98, 0x72,
+	0xa8, 0xf1, 0x57, 0x98, 0x99, 0x9d, 0xdd, 0x16, 0x92, 0x16, 0x2e, 0xed, 0xee, 0x7b, 0xdf, 0xfb,
+	0xde, 0xfb, 0xe6, 0x7d, 0xb3, 0xb0, 0x62, 0x51, 0xe6, 0x53, 0x66, 0xb0, 0x6d, 0xcc, 0x5c, 0x2f,
+	0x70, 0x8c, 0xdd, 0x85, 0x0d, 0x12, 0xe3, 0x05, 0x23, 0xde, 0xd3, 0xc3, 0x88, 0xc6, 0x14, 0xcd,
+	0x24, 0x08, 0x3d, 0x45, 0xe8, 0x12, 0x51, 0x9e, 0x76, 0xa8, 0x43, 0x05, 0xc6, 0xe0, 0x4f, 0x09,
+	0xbc, 0x3c, 0x37, 0x88, 0x30, 0xab, 0x4f, 0x70, 0xb3, 0x09, 0xae, 0x95, 0x10, 0xc8, 0x1e, 0x49,
+	0x4a, 0x76, 0x34, 0x7c, 0xc6, 0xab, 0xf9, 0x9f, 0x4c, 0x94, 0xb0, 0xef, 0x05, 0xd4, 0x10, 0xbf,
+	0x49, 0xa8, 0xfa, 0x15, 0xc0, 0xe2, 0x1a, 0x73, 0x5e, 0x07, 0x5b, 0xd8, 0xdb, 0x46, 0xeb, 0x70,
+	0x72, 0x17, 0x6f, 0x7b, 0x36, 0x8e, 0x69, 0xd4, 0xc2, 0xb6, 0x1d, 0x29, 0xa0, 0x02, 0x6a, 0xc5,
+	0xe6, 0xd2, 0xbf, 0x33, 0x6d, 0x9c, 0xbf, 0x13, 0xc6, 0x4e, 0x8f, 0x1a, 0xd3, 0xb2, 0xdd, 0x4a,
+	0x12, 0x79, 0x15, 0x47, 0x5e, 0xe0, 0x7c, 0xeb, 0xb6, 0xeb, 0x29, 0xe6, 0xb0, 0xdb, 0xae, 0x03,
+	0xf3, 0x66, 0xc6, 0xc5, 0x81, 0xcb, 0x4b, 0x1f, 0x0f, 0xb4, 0xdc, 0x97, 0x03, 0x0d, 0x7c, 0xe8,
+	0xb6, 0xeb, 0x97, 0xfa, 0x7c, 0xea, 0xb6, 0xeb, 0x92, 0xb5, 0xc1, 0xec, 0x77, 0x46, 0x36, 0x52,
+	0xf5, 0x36, 0x2c, 0x65, 0x2f, 0x26, 0x61, 0x21, 0x0d, 0x18, 0xa9, 0xfe, 0x04, 0xf0, 0x16, 0x8f,
+	0x86, 0x36, 0x8e, 0xc9, 0x4b, 0x1c, 0x61, 0x9f, 0xa1, 0x87, 0xb0, 0x88, 0x77, 0x62, 0x97, 0x46,
+	0x5e, 0xbc, 0x2f, 0xc7, 0x56, 0x06, 0xcd, 0x6a, 0xf6, 0xa0, 0xa8, 0x09, 0x0b, 0xa1, 0x60, 0x50,
+	0x46, 0x2a, 0xa0, 0x36, 0xb1, 0xa8, 0xe9, 0x03, 0x16, 0xa6, 0x27, 0x8d, 0x9a, 0xc5, 0xe3, 0x33,
+	0x2d, 0x97, 0x28, 0x94, 0x95, 0xcb, 0x8f, 0xb8, 0xa4, 0x1e, 0x27, 0x57, 0x73, 0xb7, 0x4f, 0xcd,
+	0x5e, 0x6f, 0x9b, 0x97, 0xa6, 0xae, 0xce, 0xc2, 0x99, 0x4b, 0xa1, 0x4c, 0xe4, 0x8f, 0x3c, 0x84,
+	0x6b, 0xcc, 0x79, 0xee, 0x87, 0x04, 0x5b, 0x2e, 0xba, 0x07, 0x47, 0x37, 0x23, 0xea, 0x5f, 0x29,
+	0x4d, 0xa0, 0xd0, 0x13, 0x58, 0xba, 0x78, 0xc2, 0x84, 0x25, 0x02, 0x87, 0x95, 0x4e, 0x5d, 0x58,
+	0x18, 0x61, 0x0c, 0xb9, 0x70, 0x52, 0x8c, 0xde, 0xda, 0x8c, 0xb0, 0x15, 0x7b, 0x34, 0x50, 0xf2,
+	0x82, 0x63, 0x85, 0x9f, 0xc1, 0xef, 0x33, 0x6d, 0xce, 0xf1, 0x62, 0x77, 0x67, 0x43, 0xb7, 0xa8,
+	0x2f, 0x3d, 0x68, 0xf4, 0xe9, 0x8e, 0xf7, 0x43, 0xc2, 0xf4, 0x55, 0x62, 0x9d, 0x1e, 0x35, 0xa0,
+	0xec, 0xb8, 0x4a, 0x2c, 0xe9, 0x0e, 0x41, 0xfc, 0x54, 0xf2, 0xa2, 0xc7, 0xb0, 0x10, 0x11, 0xcc,
+	0x68, 0xa0, 0x8c, 0x56, 0x40, 0x6d, 0x72, 0x71, 0x6e, 0xe0, 0x1a, 0xe4, 0x81, 0x98, 0x02, 0x6d,
+	0xca, 0x2a, 0x54, 0x81, 0x13, 0x36, 0x61, 0x56, 0xe4, 0x85, 0x62, 0xcc, 0x31, 0x3e, 0xa6, 0xd9,
+	0x1f, 0x42, 0x0d, 0x88, 0xc8, 0xae, 0x67, 0x93, 0xc0, 0x22, 0xad, 0x88, 0x6c, 0x92, 0x88, 0x3f,
+	0x29, 0x05, 0x01, 0x2c, 0xa5, 0x19, 0x33, 0x4d, 0xa0, 0x79, 0x58, 0xf2, 0x82, 0x54, 0x76, 0xcb,
+	0x25, 0x9e, 0xe3, 0xc6, 0xca, 0x78, 0x05, 0xd4, 0xf2, 0xe6, 0x54, 0x2f, 0xf1, 0x4c, 0xc4, 0x97,
+	0x4b, 0xdc, 0xdb, 0x7f, 0x0f, 0xb4, 0x1c, 0x37, 0x82, 0xd8, 0x40, 0x75, 0x1a, 0xa2, 0xde, 0xf6,
+	0xd2, 0xa5, 0x2e, 0x7e, 0x1f, 0x81, 0xf9, 0x35, 0xe6, 0xa0, 0x37, 0xb0, 0x20, 0xef, 0x5c, 0x75,
+	0xa0, 0xd0, 0xcc, 0xf7, 0xe5, 0xfa, 0xd5, 0x98, 0xb4, 0x03, 0xda, 0x82, 0x37, 0x2e, 0xdc, 0x8b,
+	0xda, 0xd0, 0xda, 0x3e, 0x64, 0xf9, 0xfe, 0x75, 0x91, 0x59, 0xaf, 0x75, 0x38, 0x9e, 0xda, 0xf3,
+	0xce, 0xb0, 0x62, 0x09, 0x2a, 0xcf, 0x5f, 0x03, 0x94, 0x92, 0x97, 0xc7, 0xde, 0x73, 0x9f, 0x34,
+	0x5f, 0x1c, 0x76, 0x54, 0x70, 0xdc, 0x51, 0xc1, 0x49, 0x47, 0x05, 0x7f, 0x3a, 0x2a, 0xf8, 0x7c,
+	0xae, 0xe6, 0x4e, 0xce, 0xd5, 0xdc, 0xaf, 0x73, 0x35, 0xf7, 0xb6, 0x31, 0xd4, 0x80, 0x7d, 0x17,
+	0x4f, 0x78, 0x71, 0xa3, 0x20, 0xbe, 0x7a, 0x0f, 0xfe, 0x07, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xa1,
+	0x20, 0x19, 0xb7, 0x05, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -749,6 +770,40 @@ func (m *MsgImpeach) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InfractionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EvidenceReference) > 0 {
+		i -= len(m.EvidenceReference)
+		copy(dAtA[i:], m.EvidenceReference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceReference)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
 		copy(dAtA[i:], m.ValidatorAddress)
@@ -860,6 +915,22 @@ func (m *MsgImpeach) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Reason != 0 {
+		n += 1 + sovTx(uint64(m.Reason))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EvidenceReference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovTx(uint64(m.InfractionHeight))
+	}
 	return n
 }
 
@@ -1268,6 +1339,142 @@ func (m *MsgImpeach) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= ImpeachReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12,10 +12,12 @@ import (
 )
 
 // BeginBlocker will persist the current header and validator set as a historical entry
-// and prune the oldest entry based on the HistoricalEntries parameter
+// and prune the oldest entry based on the HistoricalEntries parameter, it also retires
+// the previous bls keys of the expired bls key rotations.
 func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	k.RetireExpiredBlsKeys(ctx)
 	k.TrackHistoricalInfo(ctx)
 }
 
@@ -23,5 +25,7 @@ func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 func EndBlocker(ctx sdk.Context, k *keeper.Keeper) []abci.ValidatorUpdate {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	k.CompleteMatureValidatorRetirements(ctx)
+	k.ActivateMatureValidatorApplications(ctx)
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -37,6 +37,7 @@ const (
 	FlagAddressChallenger = "addr-challenger"
 	FlagBlsKey            = "bls-key"
 	FlagBlsProof          = "bls-proof"
+	FlagLegacyBlsProof    = "legacy"
 )
 
 // common flagsets to add to various functions
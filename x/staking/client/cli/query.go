@@ -37,6 +37,19 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorUnbondingDelegations(),
 		GetCmdQueryValidatorRedelegations(),
 		GetCmdQueryHistoricalInfo(),
+		GetCmdQueryBlsKeyRotation(),
+		GetCmdQueryBlsKeyRotations(),
+		GetCmdQueryValidatorKeyHistory(),
+		GetCmdQueryValidatorKeysAtHeight(),
+		GetCmdQueryValidatorByKeyAtHeight(),
+		GetCmdQueryValidatorApplication(),
+		GetCmdQueryValidatorApplications(),
+		GetCmdQueryValidatorRetirement(),
+		GetCmdQueryValidatorRetirements(),
+		GetCmdQueryTokenizeShareRecord(),
+		GetCmdQueryTokenizeShareRecords(),
+		GetCmdQueryTokenizeShareRecordsOwned(),
+		GetCmdQueryTotalLiquidStaked(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
 	)
@@ -673,6 +686,431 @@ $ %s query staking historical-info 5
 	return cmd
 }
 
+// GetCmdQueryBlsKeyRotation implements the bls key rotation query command.
+func GetCmdQueryBlsKeyRotation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bls-key-rotation [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the active bls key rotation of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the active bls key rotation of a validator, the previous bls key of the
+validator is still accepted until the rotation expires.
+
+Example:
+$ %s query staking bls-key-rotation 0x91D7deA99716Cbb247E81F1cfB692009164a967E
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryBlsKeyRotationRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.BlsKeyRotation(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Rotation)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBlsKeyRotations implements the query all active bls key rotations command.
+func GetCmdQueryBlsKeyRotations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bls-key-rotations",
+		Args:  cobra.NoArgs,
+		Short: "Query all the active bls key rotations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the active bls key rotations.
+
+Example:
+$ %s query staking bls-key-rotations
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BlsKeyRotations(cmd.Context(), &types.QueryBlsKeyRotationsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bls key rotations")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorKeyHistory implements the validator key history query command.
+func GetCmdQueryValidatorKeyHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-key-history [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the history of the relayer address, the challenger address and the bls key of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the history of the relayer address, the challenger address and the bls key of a
+validator, each record is effective from its height until the height of the next record.
+
+Example:
+$ %s query staking validator-key-history 0x91D7deA99716Cbb247E81F1cfB692009164a967E
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorKeyHistoryRequest{ValidatorAddr: addr.String(), Pagination: pageReq}
+			res, err := queryClient.ValidatorKeyHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator key history")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorKeysAtHeight implements the validator keys at height query command.
+func GetCmdQueryValidatorKeysAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-keys-at-height [validator-addr] [height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the relayer address, the challenger address and the bls key of a validator at a height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the relayer address, the challenger address and the bls key of a validator at a
+height, the current height is used if the height is 0.
+
+Example:
+$ %s query staking validator-keys-at-height 0x91D7deA99716Cbb247E81F1cfB692009164a967E 100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorKeysAtHeightRequest{ValidatorAddr: addr.String(), Height: height}
+			res, err := queryClient.ValidatorKeysAtHeight(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorByKeyAtHeight implements the query of the validator which had a relayer address, a
+// challenger address or a bls key at a height.
+func GetCmdQueryValidatorByKeyAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-by-key-at-height [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validator which had a relayer address, a challenger address or a bls key at a height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validator which had a relayer address, a challenger address or a bls key at a
+height, the current height is used if the height is 0. Exactly one of the keys must be provided.
+
+Example:
+$ %s query staking validator-by-key-at-height 100 --%s=0x91D7deA99716Cbb247E81F1cfB692009164a967E
+`,
+				version.AppName, FlagAddressRelayer,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			relayer, _ := cmd.Flags().GetString(FlagAddressRelayer)
+			challenger, _ := cmd.Flags().GetString(FlagAddressChallenger)
+			blsKey, _ := cmd.Flags().GetString(FlagBlsKey)
+
+			var record types.ValidatorKeyRecord
+			switch {
+			case relayer != "" && challenger == "" && blsKey == "":
+				res, err := queryClient.ValidatorByRelayerAtHeight(cmd.Context(), &types.QueryValidatorByRelayerAtHeightRequest{
+					RelayerAddr: relayer,
+					Height:      height,
+				})
+				if err != nil {
+					return err
+				}
+				record = res.Record
+			case relayer == "" && challenger != "" && blsKey == "":
+				res, err := queryClient.ValidatorByChallengerAtHeight(cmd.Context(), &types.QueryValidatorByChallengerAtHeightRequest{
+					ChallengerAddr: challenger,
+					Height:         height,
+				})
+				if err != nil {
+					return err
+				}
+				record = res.Record
+			case relayer == "" && challenger == "" && blsKey != "":
+				res, err := queryClient.ValidatorByBlsKeyAtHeight(cmd.Context(), &types.QueryValidatorByBlsKeyAtHeightRequest{
+					BlsKey: blsKey,
+					Height: height,
+				})
+				if err != nil {
+					return err
+				}
+				record = res.Record
+			default:
+				return fmt.Errorf("exactly one of --%s, --%s and --%s must be provided", FlagAddressRelayer, FlagAddressChallenger, FlagBlsKey)
+			}
+
+			return clientCtx.PrintProto(&record)
+		},
+	}
+
+	cmd.Flags().String(FlagAddressRelayer, "", "The relayer address to query for")
+	cmd.Flags().String(FlagAddressChallenger, "", "The challenger address to query for")
+	cmd.Flags().String(FlagBlsKey, "", "The hex encoded bls pubkey to query for")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorApplication implements the validator application query command.
+func GetCmdQueryValidatorApplication() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-application [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the pending application of a validator created without governance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending application of a validator created without governance.
+
+Example:
+$ %s query staking validator-application 0x91D7deA99716Cbb247E81F1cfB692009164a967E
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorApplicationRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.ValidatorApplication(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Application)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorApplications implements the query all pending validator applications command.
+func GetCmdQueryValidatorApplications() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-applications",
+		Args:  cobra.NoArgs,
+		Short: "Query all the pending applications of the validators created without governance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the pending applications of the validators created without governance.
+
+Example:
+$ %s query staking validator-applications
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorApplications(cmd.Context(), &types.QueryValidatorApplicationsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator applications")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorRetirement implements the validator retirement query command.
+func GetCmdQueryValidatorRetirement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-retirement [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the retirement of a retiring or retired validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the retirement of a retiring or retired validator.
+
+Example:
+$ %s query staking validator-retirement 0x91D7deA99716Cbb247E81F1cfB692009164a967E
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorRetirementRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.ValidatorRetirement(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Retirement)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorRetirements implements the query all validator retirements command.
+func GetCmdQueryValidatorRetirements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-retirements",
+		Args:  cobra.NoArgs,
+		Short: "Query the retirements of all the retiring and retired validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the retirements of all the retiring and retired validators.
+
+Example:
+$ %s query staking validator-retirements
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorRetirements(cmd.Context(), &types.QueryValidatorRetirementsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator retirements")
+
+	return cmd
+}
+
 // GetCmdQueryPool implements the pool query command.
 func GetCmdQueryPool() *cobra.Command {
 	cmd := &cobra.Command{
@@ -744,3 +1182,162 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryTokenizeShareRecord implements the query tokenize share record command.
+func GetCmdQueryTokenizeShareRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a tokenize share record by its id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a tokenize share record by its id.
+
+Example:
+$ %s query staking tokenize-share-record 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecord(cmd.Context(), &types.QueryTokenizeShareRecordRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecords implements the query all tokenize share records command.
+func GetCmdQueryTokenizeShareRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records",
+		Args:  cobra.NoArgs,
+		Short: "Query all the tokenize share records",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the tokenize share records.
+
+Example:
+$ %s query staking tokenize-share-records
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecords(cmd.Context(), &types.QueryTokenizeShareRecordsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokenize share records")
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordsOwned implements the query tokenize share records owned by an address command.
+func GetCmdQueryTokenizeShareRecordsOwned() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records-owned [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tokenize share records owned by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share records whose rewards are withdrawn by the owner.
+
+Example:
+$ %s query staking tokenize-share-records-owned 0x91D7deA99716Cbb247E81F1cfB692009164a967E
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordsOwned(cmd.Context(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: owner.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTotalLiquidStaked implements the query total liquid staked tokens command.
+func GetCmdQueryTotalLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query the total tokens of the tokenized delegations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total tokens of the tokenized delegations.
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStaked(cmd.Context(), &types.QueryTotalLiquidStakedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
@@ -14,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -48,11 +51,19 @@ func NewTxCmd() *cobra.Command {
 
 	stakingTxCmd.AddCommand(
 		NewCreateValidatorCmd(),
+		NewApplyValidatorCmd(),
+		NewApproveValidatorApplicationCmd(),
+		NewVetoValidatorApplicationCmd(),
+		NewRetireValidatorCmd(),
 		NewEditValidatorCmd(),
 		NewDelegateCmd(),
 
 		NewUnbondCmd(),
 		NewCancelUnbondingDelegation(),
+		NewTokenizeSharesCmd(),
+		NewRedeemTokensCmd(),
+		NewBlsProofCmd(),
+		NewAddressProofCmd(),
 	)
 
 	return stakingTxCmd
@@ -172,6 +183,175 @@ modify the related configrations as you need, where you can get the pubkey using
 	return cmd
 }
 
+// NewApplyValidatorCmd returns a CLI command handler for applying for a validator without governance.
+func NewApplyValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply-validator [path/to/create_validator.json]",
+		Short: "apply for a new validator without governance",
+		Args:  cobra.ExactArgs(1),
+		Long: fmt.Sprintf(`Apply for a new validator without governance by submitting a JSON file with the MsgCreateValidator of the
+new validator, the self-delegation is escrowed and the validator is activated at the end of the application period
+unless governance or the active validators veto it. The self-delegation must be at least the minimum permissionless
+self delegation of the staking params. The relayer_proof and the challenger_proof of the message must be generated
+by the relayer and the challenger with "%s tx staking address-proof" to prove they agree to serve the validator.`, version.AppName),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+$ %s tx staking apply-validator path/to/create_validator.json --from keyname
+
+Where create_validator.json contains a MsgCreateValidator in the same format as the message of the create validator
+proposal of "%s tx staking create-validator", the from field is set to the self delegator address.
+`, version.AppName, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(contents, &msg); err != nil {
+				return err
+			}
+
+			valMsg, ok := msg.(*types.MsgCreateValidator)
+			if !ok {
+				return fmt.Errorf("invalid create validator message")
+			}
+
+			delAddr, err := sdk.AccAddressFromHexUnsafe(valMsg.DelegatorAddress)
+			if err != nil {
+				return err
+			}
+			if !delAddr.Equals(clientCtx.GetFromAddress()) {
+				return fmt.Errorf("the from address should be the self delegator address: %s", delAddr.String())
+			}
+			valMsg.From = delAddr.String()
+
+			if err := valMsg.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid create validator message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), valMsg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// NewApproveValidatorApplicationCmd returns a CLI command handler for creating a MsgApproveValidatorApplication
+// transaction.
+func NewApproveValidatorApplicationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-validator-application [validator-addr]",
+		Short: "approve the application of a validator created without governance",
+		Args:  cobra.ExactArgs(1),
+		Long: `Approve the application of a validator created without governance with the operator key of an active
+validator, the validator is activated immediately once the approvals take more than 2/3 of the total power.`,
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s tx staking approve-validator-application 0x91D7deA99716Cbb247E81F1cfB692009164a967E --from mykey`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveValidatorApplication(clientCtx.GetFromAddress(), valAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewVetoValidatorApplicationCmd returns a CLI command handler for creating a MsgVetoValidatorApplication
+// transaction.
+func NewVetoValidatorApplicationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "veto-validator-application [validator-addr]",
+		Short: "veto the application of a validator created without governance",
+		Args:  cobra.ExactArgs(1),
+		Long: `Veto the application of a validator created without governance with the operator key of an active
+validator, the application is rejected and the self-delegation is refunded once the vetoes take more than 1/3 of
+the total power.`,
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s tx staking veto-validator-application 0x91D7deA99716Cbb247E81F1cfB692009164a967E --from mykey`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVetoValidatorApplication(clientCtx.GetFromAddress(), valAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRetireValidatorCmd returns a CLI command handler for creating a MsgRetireValidator transaction.
+func NewRetireValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire-validator",
+		Short: "retire the validator to leave the validator set",
+		Args:  cobra.NoArgs,
+		Long: `Retire the validator of the operator key to leave the validator set, the validator keeps its relayer and
+challenger duties until the end of the cooldown, and then it's jailed and its bls pubkey, relayer and challenger
+addresses are released. A retiring or retired validator can't change its keys or be unjailed.`,
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s tx staking retire-validator --from mykey`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRetireValidator(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
 // NewEditValidatorCmd returns a CLI command handler for creating a MsgEditValidator transaction.
 func NewEditValidatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -255,6 +435,143 @@ func NewEditValidatorCmd() *cobra.Command {
 	return cmd
 }
 
+// NewBlsProofCmd returns a CLI command handler for generating the BLS proof-of-possession of a validator.
+func NewBlsProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bls-proof [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Generate the BLS proof-of-possession of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Generate the BLS proof-of-possession of a validator with a BLS key of the keyring.
+The proof is bound to the chain ID and the operator address of the validator, the output can be used
+as the bls_key and bls_proof of a create validator proposal, or the flags of edit-validator.
+
+Example:
+$ %s keys add bls --keyring-backend test --algo eth_bls
+$ %s tx staking bls-proof 0x7b5Fe22B5446f7C62Ea27B8BD71CeF94e03f3dF2 --from bls --chain-id greenfield_9000-1
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			record, err := clientCtx.Keyring.Key(clientCtx.FromName)
+			if err != nil {
+				return err
+			}
+			pubKey, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+			if pubKey.Type() != bls.KeyType {
+				return fmt.Errorf("key %s is not a %s key", clientCtx.FromName, bls.KeyType)
+			}
+			blsPk := pubKey.Bytes()
+
+			signBytes := types.BlsProofSignBytes(clientCtx.ChainID, valAddr, blsPk)
+			if legacy, _ := cmd.Flags().GetBool(FlagLegacyBlsProof); legacy {
+				signBytes = types.LegacyBlsProofSignBytes(blsPk)
+			}
+			proof, _, err := clientCtx.Keyring.Sign(clientCtx.FromName, signBytes)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(struct {
+				BlsKey   string `json:"bls_key"`
+				BlsProof string `json:"bls_proof"`
+			}{
+				BlsKey:   hex.EncodeToString(blsPk),
+				BlsProof: hex.EncodeToString(proof),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of the BLS key with which to sign")
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().StringP(flags.FlagOutput, "o", "json", "Output format (text|json)")
+	cmd.Flags().Bool(FlagLegacyBlsProof, false, "Generate the proof of the BLS key only, which is accepted before the Nagqu upgrade")
+	flags.AddKeyringFlags(cmd.Flags())
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	_ = cmd.MarkFlagRequired(flags.FlagChainID)
+
+	return cmd
+}
+
+// NewAddressProofCmd returns a CLI command handler for generating the proof of the relayer or the challenger
+// of a validator applying without governance.
+func NewAddressProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-proof [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Generate the proof of the relayer or the challenger of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Generate the proof of the relayer or the challenger of a validator applying without governance.
+The proof is bound to the chain ID and the operator address of the validator, the output can be used as the
+relayer_proof or the challenger_proof of the message of apply-validator.
+
+Example:
+$ %s tx staking address-proof 0x7b5Fe22B5446f7C62Ea27B8BD71CeF94e03f3dF2 --from relayer --chain-id greenfield_9000-1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			signBytes := types.AddressProofSignBytes(clientCtx.ChainID, valAddr, clientCtx.GetFromAddress())
+			proof, _, err := clientCtx.Keyring.Sign(clientCtx.FromName, signBytes)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(struct {
+				Address string `json:"address"`
+				Proof   string `json:"proof"`
+			}{
+				Address: clientCtx.GetFromAddress().String(),
+				Proof:   hex.EncodeToString(proof),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of the relayer or the challenger key with which to sign")
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().StringP(flags.FlagOutput, "o", "json", "Output format (text|json)")
+	flags.AddKeyringFlags(cmd.Flags())
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	_ = cmd.MarkFlagRequired(flags.FlagChainID)
+
+	return cmd
+}
+
 // NewDelegateCmd returns a CLI command handler for creating a MsgDelegate transaction.
 func NewDelegateCmd() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
@@ -443,6 +760,91 @@ $ %s tx staking cancel-unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake
 	return cmd
 }
 
+// NewTokenizeSharesCmd returns a CLI command handler for creating a MsgTokenizeShares transaction.
+func NewTokenizeSharesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share [validator-addr] [amount] [reward-owner]",
+		Short: "Tokenize delegation to share tokens",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Tokenize an amount of the delegation to a validator into transferable share tokens, the rewards
+of the tokenized delegation are withdrawn by the reward owner.
+
+Example:
+$ %s tx staking tokenize-share 0x91D7deA99716Cbb247E81F1cfB692009164a967E 100stake 0x6D967dc83b625603c963713eABd5B43A281E595e --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromHexUnsafe(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeShares(delAddr, valAddr, amount, owner)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRedeemTokensCmd returns a CLI command handler for creating a MsgRedeemTokensForShares transaction.
+func NewRedeemTokensCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-tokens [amount]",
+		Short: "Redeem share tokens to delegation",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem an amount of share tokens back into a delegation to the validator of the share tokens.
+
+Example:
+$ %s tx staking redeem-tokens 100share/91d7dea99716cbb247e81f1cfb692009164a967e/1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemTokensForShares(clientCtx.GetFromAddress(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // Return the flagset, particular flags, and a description of defaults
 // this is anticipated to be used with the gen-tx
 func CreateValidatorMsgFlagSet(ipDefault string) (fs *flag.FlagSet, defaultsDesc string) {
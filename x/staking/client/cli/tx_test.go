@@ -2,6 +2,8 @@ package cli_test
 
 import (
 	"bytes"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"testing"
@@ -25,6 +27,7 @@ import (
 	testutilmod "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var PKs = simtestutil.CreateTestPubKeys(500)
@@ -536,3 +539,80 @@ func (s *CLITestSuite) TestNewCancelUnbondingDelegationCmd() {
 func TestCLITestSuite(t *testing.T) {
 	suite.Run(t, new(CLITestSuite))
 }
+
+func (s *CLITestSuite) TestNewBlsProofCmd() {
+	k, _, err := s.kr.NewMnemonic("bls", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.EthBLS)
+	s.Require().NoError(err)
+	pubKey, err := k.GetPubKey()
+	s.Require().NoError(err)
+	valAddr := s.addrs[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		signBytes []byte
+		expectErr bool
+	}{
+		{
+			"not a bls key",
+			[]string{
+				valAddr.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+				fmt.Sprintf("--%s=%s", flags.FlagChainID, sdktestutil.DefaultChainId),
+			},
+			nil, true,
+		},
+		{
+			"invalid validator address",
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, "bls"),
+				fmt.Sprintf("--%s=%s", flags.FlagChainID, sdktestutil.DefaultChainId),
+			},
+			nil, true,
+		},
+		{
+			"valid proof",
+			[]string{
+				valAddr.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, "bls"),
+				fmt.Sprintf("--%s=%s", flags.FlagChainID, sdktestutil.DefaultChainId),
+			},
+			types.BlsProofSignBytes(sdktestutil.DefaultChainId, valAddr, pubKey.Bytes()), false,
+		},
+		{
+			"valid legacy proof",
+			[]string{
+				valAddr.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, "bls"),
+				fmt.Sprintf("--%s=%s", flags.FlagChainID, sdktestutil.DefaultChainId),
+				fmt.Sprintf("--%s", cli.FlagLegacyBlsProof),
+			},
+			types.LegacyBlsProofSignBytes(pubKey.Bytes()), false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cli.NewBlsProofCmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err, out.String())
+
+			var res struct {
+				BlsKey   string `json:"bls_key"`
+				BlsProof string `json:"bls_proof"`
+			}
+			s.Require().NoError(json.Unmarshal(out.Bytes(), &res), out.String())
+			s.Require().Equal(hex.EncodeToString(pubKey.Bytes()), res.BlsKey)
+
+			proof, err := hex.DecodeString(res.BlsProof)
+			s.Require().NoError(err)
+			s.Require().True(pubKey.VerifySignature(tc.signBytes, proof))
+		})
+	}
+}
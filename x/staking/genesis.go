@@ -1,6 +1,7 @@
 package staking
 
 import (
+	"bytes"
 	"fmt"
 
 	tmtypes "github.com/cometbft/cometbft/types"
@@ -45,9 +46,184 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateBlsKeyRotations(data.Validators, data.BlsKeyRotations); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateValidatorKeyHistory(data.ValidatorKeyHistory); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateValidatorApplications(data.Validators, data.ValidatorApplications, data.Params.BondDenom); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateValidatorRetirements(data.Validators, data.ValidatorRetirements); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateTokenizeShareRecords(data.Validators, data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateBlsKeyRotations(validators []types.Validator, rotations []types.BlsKeyRotation) error {
+	blsKeys := make(map[string][]byte, len(validators))
+	for _, val := range validators {
+		blsKeys[val.OperatorAddress] = val.BlsKey
+	}
+
+	rotated := make(map[string]bool, len(rotations))
+	for _, rotation := range rotations {
+		blsKey, ok := blsKeys[rotation.ValidatorAddress]
+		if !ok {
+			return fmt.Errorf("bls key rotation of unknown validator in genesis state: %s", rotation.ValidatorAddress)
+		}
+
+		if rotated[rotation.ValidatorAddress] {
+			return fmt.Errorf("duplicate bls key rotation in genesis state: %s", rotation.ValidatorAddress)
+		}
+
+		if !bytes.Equal(rotation.BlsKey, blsKey) {
+			return fmt.Errorf("bls key rotation does not match the bls key of validator %s", rotation.ValidatorAddress)
+		}
+
+		if len(rotation.PrevBlsKey) != sdk.BLSPubKeyLength {
+			return fmt.Errorf("invalid previous bls key of bls key rotation of validator %s", rotation.ValidatorAddress)
+		}
+
+		if rotation.ExpiryHeight <= rotation.Height {
+			return fmt.Errorf("bls key rotation of validator %s expires before it's rotated", rotation.ValidatorAddress)
+		}
+
+		rotated[rotation.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateValidatorKeyHistory(records []types.ValidatorKeyRecord) error {
+	recorded := make(map[string]bool, len(records))
+	for _, record := range records {
+		if _, err := sdk.AccAddressFromHexUnsafe(record.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address of validator key record: %w", err)
+		}
+
+		if record.Height < 0 {
+			return fmt.Errorf("negative height of validator key record of validator %s", record.ValidatorAddress)
+		}
+
+		key := fmt.Sprintf("%s/%d", record.ValidatorAddress, record.Height)
+		if recorded[key] {
+			return fmt.Errorf("duplicate validator key record in genesis state: %s at height %d", record.ValidatorAddress, record.Height)
+		}
+		recorded[key] = true
+
+		// the keys of a removed validator are all empty
+		if record.RelayerAddress == "" && record.ChallengerAddress == "" && len(record.BlsKey) == 0 {
+			continue
+		}
+		if _, err := sdk.AccAddressFromHexUnsafe(record.RelayerAddress); err != nil {
+			return fmt.Errorf("invalid relayer address of validator key record of validator %s: %w", record.ValidatorAddress, err)
+		}
+		if _, err := sdk.AccAddressFromHexUnsafe(record.ChallengerAddress); err != nil {
+			return fmt.Errorf("invalid challenger address of validator key record of validator %s: %w", record.ValidatorAddress, err)
+		}
+		if len(record.BlsKey) != sdk.BLSPubKeyLength {
+			return fmt.Errorf("invalid bls key of validator key record of validator %s", record.ValidatorAddress)
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateValidatorApplications(validators []types.Validator, applications []types.ValidatorApplication, bondDenom string) error {
+	operators := make(map[string]bool, len(validators)+len(applications))
+	for _, val := range validators {
+		operators[val.OperatorAddress] = true
+	}
+
+	for _, application := range applications {
+		operator := application.Validator.OperatorAddress
+		if _, err := sdk.AccAddressFromHexUnsafe(operator); err != nil {
+			return fmt.Errorf("invalid validator address of validator application: %w", err)
+		}
+
+		if operators[operator] {
+			return fmt.Errorf("duplicate validator application in genesis state: %s", operator)
+		}
+		operators[operator] = true
+
+		if _, err := sdk.AccAddressFromHexUnsafe(application.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid delegator address of validator application %s: %w", operator, err)
+		}
+
+		if !application.Value.IsValid() || !application.Value.IsPositive() || application.Value.Denom != bondDenom {
+			return fmt.Errorf("invalid self delegation of validator application %s: %s", operator, application.Value)
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateValidatorRetirements(validators []types.Validator, retirements []types.ValidatorRetirement) error {
+	validatorMap := make(map[string]types.Validator, len(validators))
+	for _, val := range validators {
+		validatorMap[val.OperatorAddress] = val
+	}
+
+	retiredMap := make(map[string]bool, len(retirements))
+	for _, retirement := range retirements {
+		val, ok := validatorMap[retirement.ValidatorAddress]
+		if !ok {
+			return fmt.Errorf("validator retirement of unknown validator in genesis state: %s", retirement.ValidatorAddress)
+		}
+
+		if retiredMap[retirement.ValidatorAddress] {
+			return fmt.Errorf("duplicate validator retirement in genesis state: %s", retirement.ValidatorAddress)
+		}
+		retiredMap[retirement.ValidatorAddress] = true
+
+		// the keys of a retired validator are released
+		if retirement.Retired && (val.RelayerAddress != "" || val.ChallengerAddress != "" || len(val.BlsKey) != 0) {
+			return fmt.Errorf("retired validator still has keys in genesis state: %s", retirement.ValidatorAddress)
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateTokenizeShareRecords(validators []types.Validator, records []types.TokenizeShareRecord, lastID uint64) error {
+	validatorMap := make(map[string]bool, len(validators))
+	for _, val := range validators {
+		validatorMap[val.OperatorAddress] = true
+	}
+
+	ids := make(map[uint64]bool, len(records))
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if !validatorMap[record.Validator] {
+			return fmt.Errorf("tokenize share record %d of unknown validator in genesis state: %s", record.Id, record.Validator)
+		}
+
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: %d", record.Id)
+		}
+		ids[record.Id] = true
+
+		if record.Id == 0 || record.Id > lastID {
+			return fmt.Errorf("invalid id of tokenize share record in genesis state: %d, last id %d", record.Id, lastID)
+		}
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
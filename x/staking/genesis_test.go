@@ -1,6 +1,7 @@
 package staking_test
 
 import (
+	"bytes"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -20,6 +21,48 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0].Tokens = math.OneInt()
 	genValidators1[0].DelegatorShares = math.LegacyOneDec()
 
+	newRotatedValidators := func() []types.Validator {
+		pk := ed25519.GenPrivKey().PubKey()
+		val := testutil.NewValidator(t, sdk.AccAddress(pk.Address()), pk)
+		val.Tokens = math.OneInt()
+		val.DelegatorShares = math.LegacyOneDec()
+		val.BlsKey = bytes.Repeat([]byte{1}, sdk.BLSPubKeyLength)
+		return []types.Validator{val}
+	}
+	newRotation := func(val types.Validator) types.BlsKeyRotation {
+		return types.BlsKeyRotation{
+			ValidatorAddress: val.OperatorAddress,
+			PrevBlsKey:       bytes.Repeat([]byte{2}, sdk.BLSPubKeyLength),
+			BlsKey:           val.BlsKey,
+			Height:           1,
+			ExpiryHeight:     2,
+		}
+	}
+
+	newKeyRecord := func(val types.Validator, height int64) types.ValidatorKeyRecord {
+		return types.ValidatorKeyRecord{
+			ValidatorAddress:  val.OperatorAddress,
+			Height:            height,
+			RelayerAddress:    val.RelayerAddress,
+			ChallengerAddress: val.ChallengerAddress,
+			BlsKey:            val.BlsKey,
+		}
+	}
+
+	newApplication := func(val types.Validator) types.ValidatorApplication {
+		val.Tokens = math.ZeroInt()
+		val.DelegatorShares = math.LegacyZeroDec()
+		return types.ValidatorApplication{
+			Validator:        val,
+			DelegatorAddress: val.OperatorAddress,
+			Value:            sdk.NewInt64Coin(sdk.DefaultBondDenom, 1),
+		}
+	}
+
+	newRecord := func(id uint64, val types.Validator) types.TokenizeShareRecord {
+		return types.NewTokenizeShareRecord(id, val.GetOperator(), val.GetOperator())
+	}
+
 	tests := []struct {
 		name    string
 		mutate  func(*types.GenesisState)
@@ -40,6 +83,96 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate genesis bls key rotations
+		{"valid bls key rotation", func(data *types.GenesisState) {
+			data.Validators = newRotatedValidators()
+			data.BlsKeyRotations = []types.BlsKeyRotation{newRotation(data.Validators[0])}
+		}, false},
+		{"bls key rotation of unknown validator", func(data *types.GenesisState) {
+			data.BlsKeyRotations = []types.BlsKeyRotation{newRotation(newRotatedValidators()[0])}
+		}, true},
+		{"duplicate bls key rotation", func(data *types.GenesisState) {
+			data.Validators = newRotatedValidators()
+			rotation := newRotation(data.Validators[0])
+			data.BlsKeyRotations = []types.BlsKeyRotation{rotation, rotation}
+		}, true},
+		{"bls key rotation mismatches validator", func(data *types.GenesisState) {
+			data.Validators = newRotatedValidators()
+			rotation := newRotation(data.Validators[0])
+			rotation.BlsKey = rotation.PrevBlsKey
+			data.BlsKeyRotations = []types.BlsKeyRotation{rotation}
+		}, true},
+		{"bls key rotation expires before rotated", func(data *types.GenesisState) {
+			data.Validators = newRotatedValidators()
+			rotation := newRotation(data.Validators[0])
+			rotation.ExpiryHeight = rotation.Height
+			data.BlsKeyRotations = []types.BlsKeyRotation{rotation}
+		}, true},
+		// validate genesis validator key history
+		{"valid validator key history", func(data *types.GenesisState) {
+			val := newRotatedValidators()[0]
+			data.ValidatorKeyHistory = []types.ValidatorKeyRecord{
+				newKeyRecord(val, 0),
+				{ValidatorAddress: val.OperatorAddress, Height: 10},
+			}
+		}, false},
+		{"duplicate validator key record", func(data *types.GenesisState) {
+			record := newKeyRecord(newRotatedValidators()[0], 10)
+			data.ValidatorKeyHistory = []types.ValidatorKeyRecord{record, record}
+		}, true},
+		{"validator key record at negative height", func(data *types.GenesisState) {
+			data.ValidatorKeyHistory = []types.ValidatorKeyRecord{newKeyRecord(newRotatedValidators()[0], -1)}
+		}, true},
+		{"validator key record with invalid bls key", func(data *types.GenesisState) {
+			record := newKeyRecord(newRotatedValidators()[0], 0)
+			record.BlsKey = record.BlsKey[1:]
+			data.ValidatorKeyHistory = []types.ValidatorKeyRecord{record}
+		}, true},
+		{"valid validator application", func(data *types.GenesisState) {
+			data.ValidatorApplications = []types.ValidatorApplication{newApplication(newRotatedValidators()[0])}
+		}, false},
+		{"validator application of existing validator", func(data *types.GenesisState) {
+			data.Validators = genValidators1
+			data.ValidatorApplications = []types.ValidatorApplication{newApplication(genValidators1[0])}
+		}, true},
+		{"validator application with invalid self delegation", func(data *types.GenesisState) {
+			application := newApplication(newRotatedValidators()[0])
+			application.Value = sdk.NewInt64Coin("invalid", 1)
+			data.ValidatorApplications = []types.ValidatorApplication{application}
+		}, true},
+		{"valid validator retirement", func(data *types.GenesisState) {
+			data.Validators = newRotatedValidators()
+			data.ValidatorRetirements = []types.ValidatorRetirement{{ValidatorAddress: data.Validators[0].OperatorAddress}}
+		}, false},
+		{"validator retirement of unknown validator", func(data *types.GenesisState) {
+			data.ValidatorRetirements = []types.ValidatorRetirement{{ValidatorAddress: newRotatedValidators()[0].OperatorAddress}}
+		}, true},
+		{"retired validator with keys", func(data *types.GenesisState) {
+			data.Validators = newRotatedValidators()
+			data.ValidatorRetirements = []types.ValidatorRetirement{{ValidatorAddress: data.Validators[0].OperatorAddress, Retired: true}}
+		}, true},
+		// validate genesis tokenize share records
+		{"valid tokenize share record", func(data *types.GenesisState) {
+			data.Validators = newRotatedValidators()
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{newRecord(1, data.Validators[0])}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"tokenize share record of unknown validator", func(data *types.GenesisState) {
+			val := newRotatedValidators()[0]
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{newRecord(1, val)}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record after the last id", func(data *types.GenesisState) {
+			data.Validators = newRotatedValidators()
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{newRecord(2, data.Validators[0])}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			data.Validators = newRotatedValidators()
+			record := newRecord(1, data.Validators[0])
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record, record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetBlsKeyRotation gets the active bls key rotation of a validator
+func (k Keeper) GetBlsKeyRotation(ctx sdk.Context, operator sdk.AccAddress) (rotation types.BlsKeyRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetBlsKeyRotationKey(operator))
+	if value == nil {
+		return rotation, false
+	}
+
+	k.cdc.MustUnmarshal(value, &rotation)
+	return rotation, true
+}
+
+// SetBlsKeyRotation sets the active bls key rotation of a validator
+func (k Keeper) SetBlsKeyRotation(ctx sdk.Context, rotation types.BlsKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	operator := sdk.MustAccAddressFromHex(rotation.ValidatorAddress)
+	store.Set(types.GetBlsKeyRotationKey(operator), k.cdc.MustMarshal(&rotation))
+}
+
+// DeleteBlsKeyRotation deletes the active bls key rotation of a validator
+func (k Keeper) DeleteBlsKeyRotation(ctx sdk.Context, operator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBlsKeyRotationKey(operator))
+}
+
+// IterateBlsKeyRotations iterates over all the active bls key rotations, if the cb returns
+// true, the iterator will close and stop.
+func (k Keeper) IterateBlsKeyRotations(ctx sdk.Context, cb func(rotation types.BlsKeyRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BlsKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.BlsKeyRotation
+		k.cdc.MustUnmarshal(iterator.Value(), &rotation)
+		if cb(rotation) {
+			break
+		}
+	}
+}
+
+// GetAllBlsKeyRotations returns all the active bls key rotations, used during genesis dump
+func (k Keeper) GetAllBlsKeyRotations(ctx sdk.Context) (rotations []types.BlsKeyRotation) {
+	k.IterateBlsKeyRotations(ctx, func(rotation types.BlsKeyRotation) bool {
+		rotations = append(rotations, rotation)
+		return false
+	})
+
+	return rotations
+}
+
+// RotateBlsKey replaces the bls pubkey of the validator with the given one. The previous bls pubkey
+// stays reserved for the validator and is still accepted until the rotation expires, which is
+// BlsKeyOverlapBlocks blocks after the next historical info entry.
+func (k Keeper) RotateBlsKey(ctx sdk.Context, validator *types.Validator, blsPk []byte) error {
+	if _, found := k.GetBlsKeyRotation(ctx, validator.GetOperator()); found {
+		return types.ErrBlsKeyRotationInProgress
+	}
+
+	rotation := types.BlsKeyRotation{
+		ValidatorAddress: validator.OperatorAddress,
+		PrevBlsKey:       validator.BlsKey,
+		BlsKey:           blsPk,
+		Height:           ctx.BlockHeight(),
+		ExpiryHeight:     ctx.BlockHeight() + 1 + int64(k.BlsKeyOverlapBlocks(ctx)),
+	}
+	k.SetBlsKeyRotation(ctx, rotation)
+
+	// the index of the previous bls pubkey is kept until the rotation expires, so that the
+	// key can't be taken by another validator while it is still accepted.
+	validator.BlsKey = blsPk
+	if err := k.SetValidatorByBlsKey(ctx, *validator); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotateBlsKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, rotation.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyPrevBlsKey, hex.EncodeToString(rotation.PrevBlsKey)),
+			sdk.NewAttribute(types.AttributeKeyBlsKey, hex.EncodeToString(rotation.BlsKey)),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, fmt.Sprintf("%d", rotation.ExpiryHeight)),
+		),
+	)
+
+	return nil
+}
+
+// RetireExpiredBlsKeys retires the previous bls pubkeys of the bls key rotations which
+// have expired at the current height.
+func (k Keeper) RetireExpiredBlsKeys(ctx sdk.Context) {
+	var expired []types.BlsKeyRotation
+	k.IterateBlsKeyRotations(ctx, func(rotation types.BlsKeyRotation) bool {
+		if rotation.ExpiryHeight <= ctx.BlockHeight() {
+			expired = append(expired, rotation)
+		}
+		return false
+	})
+
+	for _, rotation := range expired {
+		k.retireBlsKey(ctx, rotation)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRetireBlsKey,
+				sdk.NewAttribute(types.AttributeKeyValidator, rotation.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyPrevBlsKey, hex.EncodeToString(rotation.PrevBlsKey)),
+			),
+		)
+	}
+}
+
+// retireBlsKey releases the previous bls pubkey of the rotation and deletes the rotation.
+func (k Keeper) retireBlsKey(ctx sdk.Context, rotation types.BlsKeyRotation) {
+	operator := sdk.MustAccAddressFromHex(rotation.ValidatorAddress)
+
+	store := ctx.KVStore(k.storeKey)
+	prevKey := types.GetValidatorByBlsKey(rotation.PrevBlsKey)
+	if opAddr := store.Get(prevKey); opAddr != nil && operator.Equals(sdk.AccAddress(opAddr)) {
+		store.Delete(prevKey)
+	}
+	k.DeleteBlsKeyRotation(ctx, operator)
+}
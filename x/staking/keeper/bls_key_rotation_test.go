@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"encoding/hex"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func (s *KeeperTestSuite) TestBlsKeyRotation() {
+	require := s.Require()
+
+	ctx := sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, s.ctx.Logger()).WithChainID(s.ctx.ChainID()).WithBlockHeight(10)
+
+	params := s.stakingKeeper.GetParams(ctx)
+	params.BlsKeyOverlapBlocks = 5
+	require.NoError(s.stakingKeeper.SetParams(ctx, params))
+
+	newBlsKey := func() ([]byte, func(valAddr sdk.AccAddress) string) {
+		blsSecretKey, err := bls.RandKey()
+		require.NoError(err)
+		blsPk := blsSecretKey.PublicKey().Marshal()
+		return blsPk, func(valAddr sdk.AccAddress) string {
+			signBytes := stakingtypes.BlsProofSignBytes(ctx.ChainID(), valAddr, blsPk)
+			return hex.EncodeToString(blsSecretKey.Sign(signBytes).Marshal())
+		}
+	}
+
+	valAddr := sdk.AccAddress(PKs[0].Address())
+	prevBlsKey, _ := newBlsKey()
+	validator := stakingtestutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator.BlsKey = prevBlsKey
+	s.stakingKeeper.SetValidator(ctx, validator)
+	require.NoError(s.stakingKeeper.SetValidatorByBlsKey(ctx, validator))
+
+	editBlsKey := func(valAddr sdk.AccAddress, blsPk []byte, proof string) error {
+		msg := stakingtypes.NewMsgEditValidator(
+			valAddr, stakingtypes.Description{}, nil, nil,
+			sdk.AccAddress{}, sdk.AccAddress{}, hex.EncodeToString(blsPk), proof,
+		)
+		_, err := s.msgServer.EditValidator(ctx, msg)
+		return err
+	}
+
+	// rotate the bls key
+	blsKey, sign := newBlsKey()
+	require.NoError(editBlsKey(valAddr, blsKey, sign(valAddr)))
+
+	validator, found := s.stakingKeeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.Equal(blsKey, validator.BlsKey)
+
+	rotation, found := s.stakingKeeper.GetBlsKeyRotation(ctx, valAddr)
+	require.True(found)
+	require.Equal(stakingtypes.BlsKeyRotation{
+		ValidatorAddress: valAddr.String(),
+		PrevBlsKey:       prevBlsKey,
+		BlsKey:           blsKey,
+		Height:           10,
+		ExpiryHeight:     16,
+	}, rotation)
+
+	// both the previous and the new bls keys are reserved for the validator
+	for _, blsPk := range [][]byte{prevBlsKey, blsKey} {
+		found, ok := s.stakingKeeper.GetValidatorByBlsKey(ctx, blsPk)
+		require.True(ok)
+		require.Equal(validator.OperatorAddress, found.OperatorAddress)
+	}
+
+	// the previous bls key can't be taken by another validator during the overlap window
+	otherAddr := sdk.AccAddress(PKs[1].Address())
+	other := stakingtestutil.NewValidator(s.T(), otherAddr, PKs[1])
+	s.stakingKeeper.SetValidator(ctx, other)
+	err := editBlsKey(otherAddr, prevBlsKey, hex.EncodeToString(make([]byte, 96)))
+	require.Error(err)
+
+	// only one rotation is allowed at a time
+	nextBlsKey, nextSign := newBlsKey()
+	require.ErrorIs(editBlsKey(valAddr, nextBlsKey, nextSign(valAddr)), stakingtypes.ErrBlsKeyRotationInProgress)
+
+	// query the rotations
+	res, err := s.queryClient.BlsKeyRotation(gocontext.Background(), &stakingtypes.QueryBlsKeyRotationRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.Equal(rotation, res.Rotation)
+	_, err = s.queryClient.BlsKeyRotation(gocontext.Background(), &stakingtypes.QueryBlsKeyRotationRequest{ValidatorAddr: otherAddr.String()})
+	require.Error(err)
+	allRes, err := s.queryClient.BlsKeyRotations(gocontext.Background(), &stakingtypes.QueryBlsKeyRotationsRequest{})
+	require.NoError(err)
+	require.Equal([]stakingtypes.BlsKeyRotation{rotation}, allRes.Rotations)
+
+	// the rotation is kept until it expires
+	s.stakingKeeper.RetireExpiredBlsKeys(ctx.WithBlockHeight(15))
+	_, found = s.stakingKeeper.GetBlsKeyRotation(ctx, valAddr)
+	require.True(found)
+
+	s.stakingKeeper.RetireExpiredBlsKeys(ctx.WithBlockHeight(16))
+	_, found = s.stakingKeeper.GetBlsKeyRotation(ctx, valAddr)
+	require.False(found)
+	_, found = s.stakingKeeper.GetValidatorByBlsKey(ctx, prevBlsKey)
+	require.False(found)
+	_, found = s.stakingKeeper.GetValidatorByBlsKey(ctx, blsKey)
+	require.True(found)
+
+	// the bls key can be rotated again once the previous rotation expired
+	require.NoError(editBlsKey(valAddr, nextBlsKey, nextSign(valAddr)))
+}
+
+func (s *KeeperTestSuite) TestBlsKeyRotationBeforeUpgrade() {
+	require := s.Require()
+
+	valAddr := sdk.AccAddress(PKs[0].Address())
+	validator := stakingtestutil.NewValidator(s.T(), valAddr, PKs[0])
+	s.stakingKeeper.SetValidator(s.ctx, validator)
+
+	blsSecretKey, err := bls.RandKey()
+	require.NoError(err)
+	blsPk := blsSecretKey.PublicKey().Marshal()
+	proof := hex.EncodeToString(blsSecretKey.Sign(stakingtypes.LegacyBlsProofSignBytes(blsPk)).Marshal())
+
+	msg := stakingtypes.NewMsgEditValidator(
+		valAddr, stakingtypes.Description{}, nil, nil,
+		sdk.AccAddress{}, sdk.AccAddress{}, hex.EncodeToString(blsPk), proof,
+	)
+	_, err = s.msgServer.EditValidator(s.ctx, msg)
+	require.NoError(err)
+
+	// the bls key is replaced immediately without a rotation
+	_, found := s.stakingKeeper.GetBlsKeyRotation(s.ctx, valAddr)
+	require.False(found)
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // GetDelegation returns a specific delegation.
@@ -626,11 +627,41 @@ func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time
 	return matureRedelegations
 }
 
+// DelegateCoins delegates the unbonded bond tokens of the delegator to the validator under the same rules as
+// MsgDelegate, it's used by the distribution module to auto compound the rewards of the delegator.
+func (k Keeper) DelegateCoins(ctx sdk.Context, delAddr, valAddr sdk.AccAddress, amount math.Int) (sdk.Dec, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoValidatorFound
+	}
+
+	if !ctx.IsUpgraded(upgradetypes.EnablePublicDelegationUpgrade) && !delAddr.Equals(validator.GetSelfDelegator()) {
+		return sdk.ZeroDec(), types.ErrDelegationNotAllowed
+	}
+
+	// NOTE: source funds are always unbonded
+	return k.Delegate(ctx, delAddr, amount, types.Unbonded, validator, true)
+}
+
 // Delegate performs a delegation, set/update everything necessary within the store.
 // tokenSrc indicates the bond status of the incoming funds.
 func (k Keeper) Delegate(
 	ctx sdk.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc types.BondStatus,
 	validator types.Validator, subtractAccount bool,
+) (newShares sdk.Dec, err error) {
+	// after the Nagqu upgrade, a retiring or retired validator doesn't accept new delegations
+	if ctx.IsUpgraded(upgradetypes.Nagqu) && k.HasValidatorRetirement(ctx, validator.GetOperator()) {
+		return math.LegacyZeroDec(), types.ErrValidatorRetired
+	}
+
+	return k.delegate(ctx, delAddr, bondAmt, tokenSrc, validator, subtractAccount)
+}
+
+// delegate performs a delegation without checking the retirement of the validator, it's used to move the
+// existing delegations back to the validator.
+func (k Keeper) delegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc types.BondStatus,
+	validator types.Validator, subtractAccount bool,
 ) (newShares sdk.Dec, err error) {
 	// In some situations, the exchange rate becomes invalid, e.g. if
 	// Validator loses all tokens due to slashing. In this case,
@@ -38,9 +38,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		// Manually set indices for the first time
 		k.SetValidatorByConsAddr(ctx, validator)
 		k.SetValidatorByPowerIndex(ctx, validator)
-		k.SetValidatorByRelayerAddress(ctx, validator)
-		k.SetValidatorByChallengerAddress(ctx, validator)
-		k.SetValidatorByBlsKey(ctx, validator)
+		// the keys of the retired validators are released
+		if validator.RelayerAddress != "" {
+			k.SetValidatorByRelayerAddress(ctx, validator)
+		}
+		if validator.ChallengerAddress != "" {
+			k.SetValidatorByChallengerAddress(ctx, validator)
+		}
+		if len(validator.BlsKey) != 0 {
+			if err := k.SetValidatorByBlsKey(ctx, validator); err != nil {
+				panic(err)
+			}
+		}
 		k.SetValidatorByPowerIndex(ctx, validator)
 
 		// Call the creation hook if not exported
@@ -104,6 +113,48 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, rotation := range data.BlsKeyRotations {
+		k.SetBlsKeyRotation(ctx, rotation)
+
+		// keep the previous bls pubkey reserved until the rotation expires
+		validator, found := k.GetValidator(ctx, sdk.MustAccAddressFromHex(rotation.ValidatorAddress))
+		if !found {
+			panic(fmt.Sprintf("validator %s not found", rotation.ValidatorAddress))
+		}
+		validator.BlsKey = rotation.PrevBlsKey
+		if err := k.SetValidatorByBlsKey(ctx, validator); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range data.ValidatorKeyHistory {
+		k.SetValidatorKeyRecord(ctx, record)
+	}
+
+	for _, application := range data.ValidatorApplications {
+		k.SetValidatorApplication(ctx, application)
+		k.InsertValidatorApplicationQueue(ctx, application)
+		notBondedTokens = notBondedTokens.Add(application.Value.Amount)
+	}
+
+	for _, retirement := range data.ValidatorRetirements {
+		k.SetValidatorRetirement(ctx, retirement)
+		if !retirement.Retired {
+			k.InsertValidatorRetirementQueue(ctx, retirement)
+		}
+	}
+
+	// the tokenized delegator shares of the validators are recomputed from the delegations of the records
+	for _, record := range data.TokenizeShareRecords {
+		k.SetTokenizeShareRecord(ctx, record)
+
+		valAddr := sdk.MustAccAddressFromHex(record.Validator)
+		if delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr); found {
+			k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(delegation.Shares))
+		}
+	}
+	k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -198,13 +249,20 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                k.GetParams(ctx),
+		LastTotalPower:        k.GetLastTotalPower(ctx),
+		LastValidatorPowers:   lastValidatorPowers,
+		Validators:            k.GetAllValidators(ctx),
+		Delegations:           k.GetAllDelegations(ctx),
+		UnbondingDelegations:  unbondingDelegations,
+		Redelegations:         redelegations,
+		Exported:              true,
+		BlsKeyRotations:       k.GetAllBlsKeyRotations(ctx),
+		ValidatorKeyHistory:   k.GetAllValidatorKeyHistory(ctx),
+		ValidatorApplications: k.GetAllValidatorApplications(ctx),
+		ValidatorRetirements:  k.GetAllValidatorRetirements(ctx),
+		TokenizeShareRecords:  k.GetAllTokenizeShareRecords(ctx),
+
+		LastTokenizeShareRecordId: k.GetLastTokenizeShareRecordID(ctx),
 	}
 }
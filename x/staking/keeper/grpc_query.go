@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -464,6 +465,267 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// BlsKeyRotation queries the active bls key rotation of a validator
+func (k Querier) BlsKeyRotation(c context.Context, req *types.QueryBlsKeyRotationRequest) (*types.QueryBlsKeyRotationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.AccAddressFromHexUnsafe(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rotation, found := k.GetBlsKeyRotation(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "bls key rotation of validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryBlsKeyRotationResponse{Rotation: rotation}, nil
+}
+
+// BlsKeyRotations queries all the active bls key rotations
+func (k Querier) BlsKeyRotations(c context.Context, req *types.QueryBlsKeyRotationsRequest) (*types.QueryBlsKeyRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	rotationStore := prefix.NewStore(store, types.BlsKeyRotationKey)
+
+	var rotations []types.BlsKeyRotation
+	pageRes, err := query.Paginate(rotationStore, req.Pagination, func(key []byte, value []byte) error {
+		var rotation types.BlsKeyRotation
+		if err := k.cdc.Unmarshal(value, &rotation); err != nil {
+			return err
+		}
+		rotations = append(rotations, rotation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBlsKeyRotationsResponse{Rotations: rotations, Pagination: pageRes}, nil
+}
+
+// ValidatorKeyHistory queries the history of the relayer address, the challenger address and the bls key of a
+// validator
+func (k Querier) ValidatorKeyHistory(c context.Context, req *types.QueryValidatorKeyHistoryRequest) (*types.QueryValidatorKeyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.AccAddressFromHexUnsafe(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	historyStore := prefix.NewStore(store, types.GetValidatorKeyHistoryPrefix(valAddr))
+
+	var records []types.ValidatorKeyRecord
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(key []byte, value []byte) error {
+		var record types.ValidatorKeyRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorKeyHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// ValidatorKeysAtHeight queries the relayer address, the challenger address and the bls key of a validator at
+// a height
+func (k Querier) ValidatorKeysAtHeight(c context.Context, req *types.QueryValidatorKeysAtHeightRequest) (*types.QueryValidatorKeysAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.AccAddressFromHexUnsafe(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height, err := queryHeight(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	record, found := k.GetValidatorKeysAtHeight(ctx, valAddr, height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "keys of validator %s at height %d not found", req.ValidatorAddr, height)
+	}
+
+	return &types.QueryValidatorKeysAtHeightResponse{Record: record}, nil
+}
+
+// ValidatorByRelayerAtHeight queries the validator whose relayer address was the given one at a height
+func (k Querier) ValidatorByRelayerAtHeight(c context.Context, req *types.QueryValidatorByRelayerAtHeightRequest) (*types.QueryValidatorByRelayerAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.RelayerAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "relayer address cannot be empty")
+	}
+
+	relayerAddr, err := sdk.AccAddressFromHexUnsafe(req.RelayerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height, err := queryHeight(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	record, found := k.GetValidatorByRelayerAtHeight(ctx, relayerAddr, height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator of relayer %s at height %d not found", req.RelayerAddr, height)
+	}
+
+	return &types.QueryValidatorByRelayerAtHeightResponse{Record: record}, nil
+}
+
+// ValidatorByChallengerAtHeight queries the validator whose challenger address was the given one at a height
+func (k Querier) ValidatorByChallengerAtHeight(c context.Context, req *types.QueryValidatorByChallengerAtHeightRequest) (*types.QueryValidatorByChallengerAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChallengerAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "challenger address cannot be empty")
+	}
+
+	challengerAddr, err := sdk.AccAddressFromHexUnsafe(req.ChallengerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height, err := queryHeight(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	record, found := k.GetValidatorByChallengerAtHeight(ctx, challengerAddr, height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator of challenger %s at height %d not found", req.ChallengerAddr, height)
+	}
+
+	return &types.QueryValidatorByChallengerAtHeightResponse{Record: record}, nil
+}
+
+// ValidatorByBlsKeyAtHeight queries the validator whose bls key was the given one at a height
+func (k Querier) ValidatorByBlsKeyAtHeight(c context.Context, req *types.QueryValidatorByBlsKeyAtHeightRequest) (*types.QueryValidatorByBlsKeyAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	blsPk, err := hex.DecodeString(req.BlsKey)
+	if err != nil || len(blsPk) != sdk.BLSPubKeyLength {
+		return nil, status.Error(codes.InvalidArgument, "invalid bls key")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height, err := queryHeight(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	record, found := k.GetValidatorByBlsKeyAtHeight(ctx, blsPk, height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator of bls key %s at height %d not found", req.BlsKey, height)
+	}
+
+	return &types.QueryValidatorByBlsKeyAtHeightResponse{Record: record}, nil
+}
+
+// ValidatorApplication queries the pending application of a validator created without governance
+func (k Querier) ValidatorApplication(c context.Context, req *types.QueryValidatorApplicationRequest) (*types.QueryValidatorApplicationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.AccAddressFromHexUnsafe(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	application, found := k.GetValidatorApplication(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator application %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorApplicationResponse{Application: application}, nil
+}
+
+// ValidatorApplications queries all the pending applications of the validators created without governance
+func (k Querier) ValidatorApplications(c context.Context, req *types.QueryValidatorApplicationsRequest) (*types.QueryValidatorApplicationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	applicationStore := prefix.NewStore(store, types.ValidatorApplicationKey)
+
+	var applications []types.ValidatorApplication
+	pageRes, err := query.Paginate(applicationStore, req.Pagination, func(key []byte, value []byte) error {
+		var application types.ValidatorApplication
+		if err := k.cdc.Unmarshal(value, &application); err != nil {
+			return err
+		}
+		applications = append(applications, application)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorApplicationsResponse{Applications: applications, Pagination: pageRes}, nil
+}
+
+// queryHeight returns the height to query at, the current height is used if the height is 0.
+func queryHeight(ctx sdk.Context, height int64) (int64, error) {
+	switch {
+	case height < 0:
+		return 0, status.Error(codes.InvalidArgument, "height cannot be negative")
+	case height == 0:
+		return ctx.BlockHeight(), nil
+	default:
+		return height, nil
+	}
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := sdk.AccAddressFromHexUnsafe(req.DelegatorAddr)
 	if err != nil {
@@ -611,3 +873,123 @@ func RedelegationsToRedelegationResponses(ctx sdk.Context, k *Keeper, redels typ
 
 	return resp, nil
 }
+
+// ValidatorRetirement queries the retirement of a retiring or retired validator
+func (k Querier) ValidatorRetirement(c context.Context, req *types.QueryValidatorRetirementRequest) (*types.QueryValidatorRetirementResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.AccAddressFromHexUnsafe(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retirement, found := k.GetValidatorRetirement(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator retirement %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorRetirementResponse{Retirement: retirement}, nil
+}
+
+// ValidatorRetirements queries the retirements of all the retiring and retired validators
+func (k Querier) ValidatorRetirements(c context.Context, req *types.QueryValidatorRetirementsRequest) (*types.QueryValidatorRetirementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	retirementStore := prefix.NewStore(store, types.ValidatorRetirementKey)
+
+	var retirements []types.ValidatorRetirement
+	pageRes, err := query.Paginate(retirementStore, req.Pagination, func(key []byte, value []byte) error {
+		var retirement types.ValidatorRetirement
+		if err := k.cdc.Unmarshal(value, &retirement); err != nil {
+			return err
+		}
+		retirements = append(retirements, retirement)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorRetirementsResponse{Retirements: retirements, Pagination: pageRes}, nil
+}
+
+// TokenizeShareRecord queries the tokenize share record by its id
+func (k Querier) TokenizeShareRecord(c context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, found := k.GetTokenizeShareRecord(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record}, nil
+}
+
+// TokenizeShareRecords queries all the tokenize share records
+func (k Querier) TokenizeShareRecords(c context.Context, req *types.QueryTokenizeShareRecordsRequest) (*types.QueryTokenizeShareRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	recordStore := prefix.NewStore(store, types.TokenizeShareRecordKey)
+
+	var records []types.TokenizeShareRecord
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key []byte, value []byte) error {
+		var record types.TokenizeShareRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// TokenizeShareRecordsOwned queries the tokenize share records owned by the address
+func (k Querier) TokenizeShareRecordsOwned(c context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := sdk.AccAddressFromHexUnsafe(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: k.GetTokenizeShareRecordsByOwner(ctx, owner)}, nil
+}
+
+// TotalLiquidStaked queries the total tokens of the tokenized delegations
+func (k Querier) TotalLiquidStaked(c context.Context, req *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTotalLiquidStakedResponse{Tokens: k.GetTotalLiquidStakedTokens(ctx)}, nil
+}
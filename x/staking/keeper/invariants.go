@@ -72,12 +72,18 @@ func ModuleAccountInvariants(k *Keeper) sdk.Invariant {
 			return false
 		})
 
+		k.IterateValidatorApplications(ctx, func(application types.ValidatorApplication) bool {
+			notBonded = notBonded.Add(application.Value.Amount)
+			return false
+		})
+
 		poolBonded := k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
 		poolNotBonded := k.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom)
 		broken := !poolBonded.Amount.Equal(bonded) || !poolNotBonded.Amount.Equal(notBonded)
 
 		// Bonded tokens should equal sum of tokens with bonded validators
 		// Not-bonded tokens should equal unbonding delegations	plus tokens on unbonded validators
+		// plus the escrowed self delegations of the validator applications
 		return sdk.FormatInvariant(types.ModuleName, "bonded and not bonded module account coins", fmt.Sprintf(
 			"\tPool's bonded tokens: %v\n"+
 				"\tsum of bonded tokens: %v\n"+
@@ -1,13 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"strconv"
 	"time"
 
 	"github.com/armon/go-metrics"
-	"github.com/cometbft/cometbft/crypto/tmhash"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -49,8 +50,10 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	}
 
 	// For genesis block, the signer should be the self delegator itself,
-	// for other blocks, the signer should be the gov module account.
+	// for other blocks, the signer should be the gov module account, or the
+	// self delegator to apply for a validator without governance.
 	govModuleAddr := k.authKeeper.GetModuleAddress(govtypes.ModuleName)
+	permissionless := false
 	if ctx.BlockHeight() == 0 {
 		signers := msg.GetSigners()
 		if len(signers) != 1 || !signers[0].Equals(delAddr) {
@@ -58,7 +61,9 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		}
 	} else {
 		signers := msg.GetSigners()
-		if len(signers) != 1 || !signers[0].Equals(govModuleAddr) {
+		if k.PermissionlessOnboardingEnabled(ctx) && len(signers) == 1 && signers[0].Equals(delAddr) {
+			permissionless = true
+		} else if len(signers) != 1 || !signers[0].Equals(govModuleAddr) {
 			return nil, types.ErrInvalidSigner
 		}
 	}
@@ -71,6 +76,11 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	if _, found := k.GetValidator(ctx, valAddr); found {
 		return nil, types.ErrValidatorOwnerExists
 	}
+	if ctx.IsUpgraded(upgradetypes.Nagqu) {
+		if _, found := k.GetValidatorApplication(ctx, valAddr); found {
+			return nil, types.ErrValidatorApplicationExists
+		}
+	}
 
 	// check to see if the pubkey has been registered before
 	pk, ok := msg.Pubkey.GetCachedValue().(cryptotypes.PubKey)
@@ -78,7 +88,8 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
 	}
 
-	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk)); found {
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk)); found ||
+		ctx.IsUpgraded(upgradetypes.Nagqu) && k.IsKeyReservedByValidatorApplication(ctx, types.GetValidatorByConsAddrKey(sdk.GetConsAddress(pk))) {
 		return nil, types.ErrValidatorPubKeyExists
 	}
 
@@ -87,7 +98,8 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	if err != nil {
 		return nil, err
 	}
-	if _, found := k.GetValidatorByRelayerAddr(ctx, relayerAddr); found {
+	if _, found := k.GetValidatorByRelayerAddr(ctx, relayerAddr); found ||
+		ctx.IsUpgraded(upgradetypes.Nagqu) && k.IsKeyReservedByValidatorApplication(ctx, types.GetValidatorByRelayerAddrKey(relayerAddr)) {
 		return nil, types.ErrValidatorRelayerAddressExists
 	}
 
@@ -96,7 +108,8 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	if err != nil {
 		return nil, err
 	}
-	if _, found := k.GetValidatorByChallengerAddr(ctx, challengerAddr); found {
+	if _, found := k.GetValidatorByChallengerAddr(ctx, challengerAddr); found ||
+		ctx.IsUpgraded(upgradetypes.Nagqu) && k.IsKeyReservedByValidatorApplication(ctx, types.GetValidatorByChallengerAddrKey(challengerAddr)) {
 		return nil, types.ErrValidatorChallengerAddressExists
 	}
 
@@ -105,7 +118,8 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	if err != nil || len(blsPk) != sdk.BLSPubKeyLength {
 		return nil, types.ErrValidatorInvalidBlsKey
 	}
-	if _, found := k.GetValidatorByBlsKey(ctx, blsPk); found {
+	if _, found := k.GetValidatorByBlsKey(ctx, blsPk); found ||
+		ctx.IsUpgraded(upgradetypes.Nagqu) && k.IsKeyReservedByValidatorApplication(ctx, types.GetValidatorByBlsKey(blsPk)) {
 		return nil, types.ErrValidatorBlsKeyExists
 	}
 
@@ -114,7 +128,7 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrValidatorInvalidBlsProof, err.Error())
 	}
-	err = k.CheckBlsProof(blsPk, blsProof)
+	err = k.CheckBlsProof(ctx, valAddr, blsPk, blsProof)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrValidatorInvalidBlsProof, err.Error())
 	}
@@ -169,6 +183,21 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 
 	validator.MinSelfDelegation = msg.MinSelfDelegation
 
+	// the validator is activated when the application ends unless it's vetoed
+	if permissionless {
+		// the relayer and the challenger must prove they agree to serve the validator
+		if err := k.CheckAddressProof(ctx, valAddr, relayerAddr, msg.RelayerProof); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrValidatorInvalidAddressProof, "relayer: %s", err.Error())
+		}
+		if err := k.CheckAddressProof(ctx, valAddr, challengerAddr, msg.ChallengerProof); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrValidatorInvalidAddressProof, "challenger: %s", err.Error())
+		}
+		if err := k.ApplyValidator(ctx, validator, delAddr, msg.Value); err != nil {
+			return nil, err
+		}
+		return &types.MsgCreateValidatorResponse{}, nil
+	}
+
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByConsAddr(ctx, validator)
 	k.SetValidatorByRelayerAddress(ctx, validator)
@@ -176,6 +205,9 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	k.SetValidatorByBlsKey(ctx, validator)
 	k.SetValidatorByConsAddr(ctx, validator)
 	k.SetNewValidatorByPowerIndex(ctx, validator)
+	if ctx.IsUpgraded(upgradetypes.Nagqu) {
+		k.SetValidatorKeyRecord(ctx, newValidatorKeyRecord(validator, ctx.BlockHeight()))
+	}
 
 	// call the after-creation hook
 	if err := k.Hooks().AfterValidatorCreated(ctx, validator.GetOperator()); err != nil {
@@ -232,6 +264,7 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 	if !found {
 		return nil, types.ErrNoValidatorFound
 	}
+	prevValidator := validator
 
 	// replace all editable fields (clients should autofill existing values)
 	description, err := validator.Description.UpdateDescription(msg.Description)
@@ -277,6 +310,8 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 			if tmpValidator.OperatorAddress != validator.OperatorAddress {
 				return nil, types.ErrValidatorRelayerAddressExists
 			}
+		} else if ctx.IsUpgraded(upgradetypes.Nagqu) && k.IsKeyReservedByValidatorApplication(ctx, types.GetValidatorByRelayerAddrKey(relayerAddr)) {
+			return nil, types.ErrValidatorRelayerAddressExists
 		} else {
 			k.DeleteValidatorByRelayerAddress(ctx, validator)
 			validator.RelayerAddress = relayerAddr.String()
@@ -294,6 +329,8 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 			if tmpValidator.OperatorAddress != validator.OperatorAddress {
 				return nil, types.ErrValidatorChallengerAddressExists
 			}
+		} else if ctx.IsUpgraded(upgradetypes.Nagqu) && k.IsKeyReservedByValidatorApplication(ctx, types.GetValidatorByChallengerAddrKey(challengerAddr)) {
+			return nil, types.ErrValidatorChallengerAddressExists
 		} else {
 			k.DeleteValidatorByChallengerAddress(ctx, validator)
 			validator.ChallengerAddress = challengerAddr.String()
@@ -312,7 +349,7 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		if err != nil {
 			return nil, sdkerrors.Wrap(types.ErrValidatorInvalidBlsProof, err.Error())
 		}
-		err = k.CheckBlsProof(blsPk, blsProof)
+		err = k.CheckBlsProof(ctx, valAddr, blsPk, blsProof)
 		if err != nil {
 			return nil, sdkerrors.Wrap(types.ErrValidatorInvalidBlsProof, err.Error())
 		}
@@ -321,6 +358,12 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 			if tmpValidator.OperatorAddress != validator.OperatorAddress {
 				return nil, types.ErrValidatorBlsKeyExists
 			}
+		} else if ctx.IsUpgraded(upgradetypes.Nagqu) && k.IsKeyReservedByValidatorApplication(ctx, types.GetValidatorByBlsKey(blsPk)) {
+			return nil, types.ErrValidatorBlsKeyExists
+		} else if ctx.IsUpgraded(upgradetypes.Nagqu) {
+			if err := k.RotateBlsKey(ctx, &validator, blsPk); err != nil {
+				return nil, err
+			}
 		} else {
 			k.DeleteValidatorByBlsKey(ctx, validator)
 			validator.BlsKey = blsPk
@@ -328,7 +371,20 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		}
 	}
 
+	if ctx.IsUpgraded(upgradetypes.Nagqu) {
+		// the keys of a retiring validator are kept until they are released at the end of the cooldown
+		if validator.RelayerAddress != prevValidator.RelayerAddress || validator.ChallengerAddress != prevValidator.ChallengerAddress ||
+			!bytes.Equal(validator.BlsKey, prevValidator.BlsKey) {
+			if k.HasValidatorRetirement(ctx, valAddr) {
+				return nil, types.ErrValidatorRetired
+			}
+		}
+	}
+
 	k.SetValidator(ctx, validator)
+	if ctx.IsUpgraded(upgradetypes.Nagqu) {
+		k.RecordValidatorKeys(ctx, validator)
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -652,8 +708,9 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
-// CheckBlsProof checks the BLS signature of the validator
-func (ms msgServer) CheckBlsProof(blsPk, sig []byte) error {
+// CheckBlsProof checks the BLS proof-of-possession of the validator. After the Nagqu upgrade, the
+// proof must sign the chain ID and the operator address besides the BLS key, see BlsProofSignBytes.
+func (ms msgServer) CheckBlsProof(ctx sdk.Context, operator sdk.AccAddress, blsPk, sig []byte) error {
 	if len(sig) != sdk.BLSSignatureLength {
 		return sdkerrors.Wrapf(sdkerrors.ErrorInvalidSigner, "signature length (actual: %d) doesn't match typical BLS signature 96 bytes", len(sig))
 	}
@@ -668,9 +725,196 @@ func (ms msgServer) CheckBlsProof(blsPk, sig []byte) error {
 		return sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, "BLS signature key is invalid")
 	}
 
-	sigHash := tmhash.Sum(blsPk)
+	sigHash := types.LegacyBlsProofSignBytes(blsPk)
+	if ctx.IsUpgraded(upgradetypes.Nagqu) {
+		sigHash = types.BlsProofSignBytes(ctx.ChainID(), operator, blsPk)
+	}
 	if !signature.Verify(blsPubKey, sigHash) {
 		return sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, "BLS signature verification is failed")
 	}
 	return nil
 }
+
+// CheckAddressProof checks the proof of the relayer or the challenger of a validator applying without
+// governance, which is a recoverable secp256k1 signature over AddressProofSignBytes by the address.
+func (ms msgServer) CheckAddressProof(ctx sdk.Context, operator, addr sdk.AccAddress, proof string) error {
+	sig, err := hex.DecodeString(proof)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, err.Error())
+	}
+	if len(sig) != ethcrypto.SignatureLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrorInvalidSigner, "signature length (actual: %d) doesn't match typical secp256k1 signature 65 bytes", len(sig))
+	}
+	if sig[ethcrypto.RecoveryIDOffset] == 27 || sig[ethcrypto.RecoveryIDOffset] == 28 {
+		sig[ethcrypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := ethcrypto.SigToPub(types.AddressProofSignBytes(ctx.ChainID(), operator, addr), sig)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, err.Error())
+	}
+	if !bytes.Equal(ethcrypto.PubkeyToAddress(*pubKey).Bytes(), addr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrorInvalidSigner, "the proof is not signed by %s", addr.String())
+	}
+	return nil
+}
+
+// ApproveValidatorApplication defines a method for governance or an active validator to approve the
+// application of a validator created without governance
+func (k msgServer) ApproveValidatorApplication(goCtx context.Context, msg *types.MsgApproveValidatorApplication) (*types.MsgApproveValidatorApplicationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ctx.IsUpgraded(upgradetypes.Nagqu) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "validator application is not enabled")
+	}
+
+	from, err := sdk.AccAddressFromHexUnsafe(msg.From)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ApproveValidatorApplication(ctx, from, valAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveValidatorApplicationResponse{}, nil
+}
+
+// VetoValidatorApplication defines a method for governance or an active validator to veto the application
+// of a validator created without governance
+func (k msgServer) VetoValidatorApplication(goCtx context.Context, msg *types.MsgVetoValidatorApplication) (*types.MsgVetoValidatorApplicationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ctx.IsUpgraded(upgradetypes.Nagqu) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "validator application is not enabled")
+	}
+
+	from, err := sdk.AccAddressFromHexUnsafe(msg.From)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.VetoValidatorApplication(ctx, from, valAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVetoValidatorApplicationResponse{}, nil
+}
+
+// RetireValidator defines a method for a validator to leave the validator set after a cooldown
+func (k msgServer) RetireValidator(goCtx context.Context, msg *types.MsgRetireValidator) (*types.MsgRetireValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ctx.IsUpgraded(upgradetypes.Nagqu) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "validator retirement is not enabled")
+	}
+
+	valAddr, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	retirement, err := k.Keeper.RetireValidator(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetireValidatorResponse{EndTime: retirement.EndTime}, nil
+}
+
+// TokenizeShares defines a method for tokenizing a delegation into transferable share tokens
+func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ctx.IsUpgraded(upgradetypes.Nagqu) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "delegation tokenization is not enabled")
+	}
+
+	valAddr, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromHexUnsafe(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromHexUnsafe(msg.TokenizedShareOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	record, shareToken, err := k.Keeper.TokenizeShares(ctx, delegatorAddress, validator, msg.Amount.Amount, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyNewShares, shareToken.String()),
+		),
+	})
+
+	return &types.MsgTokenizeSharesResponse{Amount: shareToken}, nil
+}
+
+// RedeemTokensForShares defines a method for redeeming share tokens back into a delegation
+func (k msgServer) RedeemTokensForShares(goCtx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ctx.IsUpgraded(upgradetypes.Nagqu) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "delegation tokenization is not enabled")
+	}
+
+	delegatorAddress, err := sdk.AccAddressFromHexUnsafe(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	record, amount, err := k.Keeper.RedeemTokensForShares(ctx, delegatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	})
+
+	return &types.MsgRedeemTokensForSharesResponse{Amount: amount}, nil
+}
@@ -1,10 +1,16 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func (s *KeeperTestSuite) TestMsgUpdateParams() {
@@ -129,3 +135,84 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgEditValidatorBlsProof() {
+	require := s.Require()
+
+	valAddr := sdk.AccAddress(PKs[0].Address())
+	otherAddr := sdk.AccAddress(PKs[1].Address())
+	validator := stakingtestutil.NewValidator(s.T(), valAddr, PKs[0])
+	s.stakingKeeper.SetValidator(s.ctx, validator)
+
+	blsSecretKey, err := bls.RandKey()
+	require.NoError(err)
+	blsPk := blsSecretKey.PublicKey().Marshal()
+	sign := func(signBytes []byte) string {
+		return hex.EncodeToString(blsSecretKey.Sign(signBytes).Marshal())
+	}
+	chainID := s.ctx.ChainID()
+
+	testCases := []struct {
+		name     string
+		upgraded bool
+		proof    string
+		expErr   bool
+	}{
+		{
+			name:     "legacy proof before upgrade",
+			upgraded: false,
+			proof:    sign(stakingtypes.LegacyBlsProofSignBytes(blsPk)),
+			expErr:   false,
+		},
+		{
+			name:     "legacy proof after upgrade",
+			upgraded: true,
+			proof:    sign(stakingtypes.LegacyBlsProofSignBytes(blsPk)),
+			expErr:   true,
+		},
+		{
+			name:     "proof of another operator",
+			upgraded: true,
+			proof:    sign(stakingtypes.BlsProofSignBytes(chainID, otherAddr, blsPk)),
+			expErr:   true,
+		},
+		{
+			name:     "proof of another chain",
+			upgraded: true,
+			proof:    sign(stakingtypes.BlsProofSignBytes("greenfield_5600-1", valAddr, blsPk)),
+			expErr:   true,
+		},
+		{
+			name:     "valid proof after upgrade",
+			upgraded: true,
+			proof:    sign(stakingtypes.BlsProofSignBytes(chainID, valAddr, blsPk)),
+			expErr:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			upgraded := tc.upgraded
+			ctx = sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+				return upgraded && name == upgradetypes.Nagqu
+			}, ctx.Logger()).WithChainID(chainID)
+
+			msg := stakingtypes.NewMsgEditValidator(
+				valAddr, stakingtypes.Description{}, nil, nil,
+				sdk.AccAddress{}, sdk.AccAddress{}, hex.EncodeToString(blsPk), tc.proof,
+			)
+			_, err := s.msgServer.EditValidator(ctx, msg)
+			if tc.expErr {
+				require.ErrorIs(err, stakingtypes.ErrValidatorInvalidBlsProof)
+				return
+			}
+
+			require.NoError(err)
+			validator, found := s.stakingKeeper.GetValidator(ctx, valAddr)
+			require.True(found)
+			require.Equal(blsPk, validator.BlsKey)
+		})
+	}
+}
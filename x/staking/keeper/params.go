@@ -54,6 +54,53 @@ func (k Keeper) MinSelfDelegation(ctx sdk.Context) (res math.Int) {
 	return k.GetParams(ctx).MinSelfDelegation
 }
 
+// BlsKeyOverlapBlocks - Number of blocks the previous bls key of a validator
+// is still accepted after it is rotated
+func (k Keeper) BlsKeyOverlapBlocks(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).BlsKeyOverlapBlocks
+}
+
+// MinPermissionlessSelfDelegation - Minimum self delegation of the validators created
+// without governance, the validators can only be created by governance if it is zero
+func (k Keeper) MinPermissionlessSelfDelegation(ctx sdk.Context) math.Int {
+	minSelfDelegation := k.GetParams(ctx).MinPermissionlessSelfDelegation
+	if minSelfDelegation.IsNil() {
+		return math.ZeroInt()
+	}
+	return minSelfDelegation
+}
+
+// ValidatorApplicationPeriod - The time duration governance or the active validators can
+// approve or veto the application of a validator created without governance
+func (k Keeper) ValidatorApplicationPeriod(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).ValidatorApplicationPeriod
+}
+
+// ValidatorRetirementCooldown - The time duration a retiring validator keeps its relayer and challenger duties
+func (k Keeper) ValidatorRetirementCooldown(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).ValidatorRetirementCooldown
+}
+
+// GlobalLiquidStakingCap - Maximum fraction of the total bonded tokens that can be tokenized,
+// the delegations can't be tokenized if it is zero
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	liquidStakingCap := k.GetParams(ctx).GlobalLiquidStakingCap
+	if liquidStakingCap.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return liquidStakingCap
+}
+
+// ValidatorLiquidStakingCap - Maximum fraction of the delegator shares of a validator that can be
+// tokenized, the delegations can't be tokenized if it is zero
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	liquidStakingCap := k.GetParams(ctx).ValidatorLiquidStakingCap
+	if liquidStakingCap.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return liquidStakingCap
+}
+
 // SetParams sets the x/staking module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err := params.Validate(); err != nil {
@@ -79,5 +126,16 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	}
 
 	k.cdc.MustUnmarshal(bz, &params)
+
+	// the parameter is absent in the params stored before it was introduced
+	if params.BlsKeyOverlapBlocks == 0 {
+		params.BlsKeyOverlapBlocks = types.DefaultBlsKeyOverlapBlocks
+	}
+	if params.ValidatorApplicationPeriod == 0 {
+		params.ValidatorApplicationPeriod = types.DefaultValidatorApplicationPeriod
+	}
+	if params.ValidatorRetirementCooldown == 0 {
+		params.ValidatorRetirementCooldown = types.DefaultValidatorRetirementCooldown
+	}
 	return params
 }
@@ -0,0 +1,360 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// A delegation can be tokenized into share tokens which are transferable like any other coins. The tokenized
+// delegation is moved to the account of a new tokenize share record, and the share tokens of the record are
+// minted 1:1 to the delegator shares of the record account, so the tokenized delegation is slashed like any other
+// delegation of the validator. The rewards of the tokenized delegation are withdrawn by the owner of the record,
+// and the share tokens can be redeemed back into a delegation by any holder of them.
+//
+// The tokenized delegator shares of the validators are tracked to cap the liquid staking, the tokens of all the
+// tokenized delegations can't exceed the global liquid staking cap of the total bonded tokens, and the tokenized
+// delegator shares of a validator can't exceed the validator liquid staking cap of its delegator shares.
+
+// GetTokenizeShareRecord gets the tokenize share record by id
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetTokenizeShareRecordKey(id))
+	if value == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(value, &record)
+	return record, true
+}
+
+// SetTokenizeShareRecord sets the tokenize share record and indexes it by the owner
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromHex(record.Owner)
+	store.Set(types.GetTokenizeShareRecordKey(record.Id), k.cdc.MustMarshal(&record))
+	store.Set(types.GetTokenizeShareRecordByOwnerKey(owner, record.Id), []byte{})
+}
+
+// DeleteTokenizeShareRecord deletes the tokenize share record and its owner index
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromHex(record.Owner)
+	store.Delete(types.GetTokenizeShareRecordKey(record.Id))
+	store.Delete(types.GetTokenizeShareRecordByOwnerKey(owner, record.Id))
+}
+
+// IterateTokenizeShareRecords iterates over all the tokenize share records, if the cb returns true, the
+// iterator will close and stop.
+func (k Keeper) IterateTokenizeShareRecords(ctx sdk.Context, cb func(record types.TokenizeShareRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records, used during genesis dump
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by the address
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetTokenizeShareRecordsByOwnerPrefix(owner)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+		record, found := k.GetTokenizeShareRecord(ctx, id)
+		if !found {
+			panic("tokenize share record of the owner index not found")
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetLastTokenizeShareRecordID gets the id of the last tokenize share record
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetValidatorLiquidShares gets the tokenized delegator shares of the validator
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, operator sdk.AccAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorLiquidSharesKey(operator))
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+
+	var shares sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &shares)
+	return shares.Dec
+}
+
+// SetValidatorLiquidShares sets the tokenized delegator shares of the validator, the entry is deleted
+// if the shares are zero
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, operator sdk.AccAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	if !shares.IsPositive() {
+		store.Delete(types.GetValidatorLiquidSharesKey(operator))
+		return
+	}
+
+	store.Set(types.GetValidatorLiquidSharesKey(operator), k.cdc.MustMarshal(&sdk.DecProto{Dec: shares}))
+}
+
+// IterateValidatorLiquidShares iterates over the tokenized delegator shares of the validators, if the cb
+// returns true, the iterator will close and stop.
+func (k Keeper) IterateValidatorLiquidShares(ctx sdk.Context, cb func(operator sdk.AccAddress, shares sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorLiquidSharesKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var shares sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &shares)
+		if cb(types.AddressFromValidatorLiquidSharesKey(iterator.Key()), shares.Dec) {
+			break
+		}
+	}
+}
+
+// GetTotalLiquidStakedTokens returns the tokens of all the tokenized delegations. The tokens are converted from
+// the tokenized delegator shares by the current exchange rates of the validators, so the slashed tokens are
+// not counted.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	tokens := math.LegacyZeroDec()
+	k.IterateValidatorLiquidShares(ctx, func(operator sdk.AccAddress, shares sdk.Dec) bool {
+		validator, found := k.GetValidator(ctx, operator)
+		if found {
+			tokens = tokens.Add(validator.TokensFromShares(shares))
+		}
+		return false
+	})
+
+	return tokens.TruncateInt()
+}
+
+// GetBondedLiquidStakedTokens returns the tokens of the tokenized delegations to the bonded validators, which are
+// the part of the total liquid staked tokens counted in the total bonded tokens.
+func (k Keeper) GetBondedLiquidStakedTokens(ctx sdk.Context) math.Int {
+	tokens := math.LegacyZeroDec()
+	k.IterateValidatorLiquidShares(ctx, func(operator sdk.AccAddress, shares sdk.Dec) bool {
+		validator, found := k.GetValidator(ctx, operator)
+		if found && validator.IsBonded() {
+			tokens = tokens.Add(validator.TokensFromShares(shares))
+		}
+		return false
+	})
+
+	return tokens.TruncateInt()
+}
+
+// TokenizeShares tokenizes the amount of the delegation to the validator into the share tokens of a new tokenize
+// share record owned by the owner, and sends the share tokens to the delegator.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, amount math.Int, owner sdk.AccAddress,
+) (types.TokenizeShareRecord, sdk.Coin, error) {
+	valAddr := validator.GetOperator()
+
+	// the self delegation secures the minimum self delegation of the validator
+	if delAddr.Equals(validator.GetSelfDelegator()) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, sdkerrors.Wrap(types.ErrInvalidTokenizedShares, "self delegation cannot be tokenized")
+	}
+
+	// the redelegated shares must be kept by the delegator until the redelegation completes to be slashed
+	// for the infractions of the source validator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, sdkerrors.Wrap(types.ErrInvalidTokenizedShares, "delegation with a redelegation in progress cannot be tokenized")
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	tokens, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// the tokens are returned to the delegator before they are delegated by the record account, so that the
+	// vesting coins can't be tokenized
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, tokens)
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), tokens))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, coins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	record := types.NewTokenizeShareRecord(k.GetLastTokenizeShareRecordID(ctx)+1, owner, valAddr)
+	recordAddr := record.GetModuleAddress()
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, recordAddr, coins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoValidatorFound
+	}
+	newShares, err := k.Delegate(ctx, recordAddr, tokens, types.Unbonded, validator, true)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if !shareToken.IsPositive() {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, sdkerrors.Wrap(types.ErrInvalidTokenizedShares, "too few shares to tokenize")
+	}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	k.SetTokenizeShareRecord(ctx, record)
+	k.SetLastTokenizeShareRecordID(ctx, record.Id)
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(newShares))
+
+	if err := k.checkLiquidStakingCaps(ctx, valAddr); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	return record, shareToken, nil
+}
+
+// RedeemTokensForShares burns the share tokens of the delegator and moves the delegator shares of the tokenize
+// share record they represent to the delegation of the delegator. The record is deleted and the remaining
+// balances of the record account are sent to the owner once all the share tokens are redeemed.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (types.TokenizeShareRecord, sdk.Coin, error) {
+	id, err := types.ParseTokenizeShareRecordID(shareToken.Denom)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found || record.GetShareTokenDenom() != shareToken.Denom {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrTokenizeShareRecordNotFound
+	}
+
+	valAddr := sdk.MustAccAddressFromHex(record.Validator)
+	recordAddr := record.GetModuleAddress()
+	delegation, found := k.GetDelegation(ctx, recordAddr, valAddr)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	// the share tokens are redeemed for their proportion of the delegator shares of the record, the last
+	// share tokens take all the remaining shares
+	supply := k.bankKeeper.GetSupply(ctx, shareToken.Denom).Amount
+	if shareToken.Amount.GT(supply) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, sdkerrors.Wrap(types.ErrInvalidTokenizedShares, "amount exceeds the supply of the share tokens")
+	}
+	shares := delegation.Shares
+	if shareToken.Amount.LT(supply) {
+		shares = delegation.Shares.MulInt(shareToken.Amount).QuoInt(supply)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoValidatorFound
+	}
+	// the unbonded tokens are kept in the pool of the validator status
+	tokenSrc := validator.GetStatus()
+
+	tokens, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares))
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if found {
+		// the tokenized shares of a retiring validator can still be redeemed
+		if _, err := k.delegate(ctx, delAddr, tokens, tokenSrc, validator, false); err != nil {
+			return types.TokenizeShareRecord{}, sdk.Coin{}, err
+		}
+	} else {
+		// the unbonded validator is removed with its last delegation, return the tokens to the delegator
+		coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), tokens))
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, coins); err != nil {
+			return types.TokenizeShareRecord{}, sdk.Coin{}, err
+		}
+	}
+
+	if _, found := k.GetDelegation(ctx, recordAddr, valAddr); !found {
+		balances := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+		if !balances.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, recordAddr, sdk.MustAccAddressFromHex(record.Owner), balances); err != nil {
+				return types.TokenizeShareRecord{}, sdk.Coin{}, err
+			}
+		}
+		k.DeleteTokenizeShareRecord(ctx, record)
+	}
+
+	return record, sdk.NewCoin(k.BondDenom(ctx), tokens), nil
+}
+
+// checkLiquidStakingCaps checks the tokenized delegations don't exceed the global liquid staking cap and the
+// liquid staking cap of the validator.
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, valAddr sdk.AccAddress) error {
+	validator := k.mustGetValidator(ctx, valAddr)
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr)
+	if liquidShares.GT(validator.DelegatorShares.Mul(k.ValidatorLiquidStakingCap(ctx))) {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	// the liquid staked tokens are compared with the bonded tokens, so only the bonded ones are counted
+	totalLiquidStaked := sdk.NewDecFromInt(k.GetBondedLiquidStakedTokens(ctx))
+	if totalLiquidStaked.GT(sdk.NewDecFromInt(k.TotalBondedTokens(ctx)).Mul(k.GlobalLiquidStakingCap(ctx))) {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	return nil
+}
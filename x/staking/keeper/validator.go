@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // get a single validator
@@ -266,7 +267,16 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.AccAddress) {
 	store.Delete(types.GetValidatorByChallengerAddrKey(validator.GetChallenger()))
 	store.Delete(types.GetValidatorByBlsKey(validator.BlsKey))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
+	if rotation, found := k.GetBlsKeyRotation(ctx, address); found {
+		k.retireBlsKey(ctx, rotation)
+	}
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	if ctx.IsUpgraded(upgradetypes.Nagqu) {
+		k.RecordRemovedValidatorKeys(ctx, validator)
+		if retirement, found := k.GetValidatorRetirement(ctx, address); found {
+			k.DeleteValidatorRetirement(ctx, retirement)
+		}
+	}
 
 	if err := k.Hooks().AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator()); err != nil {
 		k.Logger(ctx).Error("error in after validator removed hook", "error", err)
@@ -0,0 +1,384 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// The validators can be created without governance if the minimum permissionless self delegation is set. The
+// self delegation of such a validator is escrowed in the not bonded pool, and the validator is activated at the
+// end of the application period unless governance or the active validators veto it before that. Governance or
+// the active validators can also approve the application to activate the validator immediately.
+//
+// The approval of the active validators takes more than 2/3 of the last total power, and the veto of the active
+// validators takes more than 1/3 of the last total power, the votes are tallied with the current powers of the
+// voters whenever a vote is cast.
+//
+// The consensus address, the relayer address, the challenger address and the bls key of a pending application
+// are reserved until the application is activated or rejected, so they can't be taken by other validators.
+
+// PermissionlessOnboardingEnabled returns whether the validators can be created without governance.
+func (k Keeper) PermissionlessOnboardingEnabled(ctx sdk.Context) bool {
+	return ctx.IsUpgraded(upgradetypes.Nagqu) && k.MinPermissionlessSelfDelegation(ctx).IsPositive()
+}
+
+// GetValidatorApplication gets the pending application of a validator
+func (k Keeper) GetValidatorApplication(ctx sdk.Context, operator sdk.AccAddress) (application types.ValidatorApplication, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetValidatorApplicationKey(operator))
+	if value == nil {
+		return application, false
+	}
+
+	k.cdc.MustUnmarshal(value, &application)
+	return application, true
+}
+
+// SetValidatorApplication sets the pending application of a validator and reserves its keys
+func (k Keeper) SetValidatorApplication(ctx sdk.Context, application types.ValidatorApplication) {
+	store := ctx.KVStore(k.storeKey)
+	operator := application.Validator.GetOperator()
+	store.Set(types.GetValidatorApplicationKey(operator), k.cdc.MustMarshal(&application))
+
+	for _, indexKey := range validatorApplicationIndexKeys(application.Validator) {
+		store.Set(types.GetValidatorApplicationIndexKey(indexKey), operator)
+	}
+}
+
+// DeleteValidatorApplication deletes the pending application of a validator, releases its keys and removes it
+// from the queue
+func (k Keeper) DeleteValidatorApplication(ctx sdk.Context, application types.ValidatorApplication) {
+	store := ctx.KVStore(k.storeKey)
+	operator := application.Validator.GetOperator()
+	store.Delete(types.GetValidatorApplicationKey(operator))
+	store.Delete(types.GetValidatorApplicationQueueKey(application.EndTime, operator))
+
+	for _, indexKey := range validatorApplicationIndexKeys(application.Validator) {
+		store.Delete(types.GetValidatorApplicationIndexKey(indexKey))
+	}
+}
+
+// IsKeyReservedByValidatorApplication checks whether the key of the validator index is reserved by a pending
+// validator application
+func (k Keeper) IsKeyReservedByValidatorApplication(ctx sdk.Context, indexKey []byte) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorApplicationIndexKey(indexKey))
+}
+
+// InsertValidatorApplicationQueue inserts the pending application of a validator to the queue by its end time
+func (k Keeper) InsertValidatorApplicationQueue(ctx sdk.Context, application types.ValidatorApplication) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorApplicationQueueKey(application.EndTime, application.Validator.GetOperator()), []byte{})
+}
+
+// IterateValidatorApplications iterates over all the pending validator applications, if the cb returns
+// true, the iterator will close and stop.
+func (k Keeper) IterateValidatorApplications(ctx sdk.Context, cb func(application types.ValidatorApplication) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorApplicationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var application types.ValidatorApplication
+		k.cdc.MustUnmarshal(iterator.Value(), &application)
+		if cb(application) {
+			break
+		}
+	}
+}
+
+// GetAllValidatorApplications returns all the pending validator applications, used during genesis dump
+func (k Keeper) GetAllValidatorApplications(ctx sdk.Context) (applications []types.ValidatorApplication) {
+	k.IterateValidatorApplications(ctx, func(application types.ValidatorApplication) bool {
+		applications = append(applications, application)
+		return false
+	})
+
+	return applications
+}
+
+// ApplyValidator escrows the self delegation of the validator created without governance and queues its
+// application until the end of the application period.
+func (k Keeper) ApplyValidator(ctx sdk.Context, validator types.Validator, delAddr sdk.AccAddress, value sdk.Coin) error {
+	if value.Amount.LT(k.MinPermissionlessSelfDelegation(ctx)) {
+		return types.ErrPermissionlessSelfDelegation
+	}
+	if value.Amount.LT(validator.MinSelfDelegation) {
+		return types.ErrNotEnoughDelegationShares
+	}
+	if _, found := k.GetValidatorApplication(ctx, validator.GetOperator()); found {
+		return types.ErrValidatorApplicationExists
+	}
+
+	if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delAddr, types.NotBondedPoolName, sdk.NewCoins(value)); err != nil {
+		return err
+	}
+
+	application := types.ValidatorApplication{
+		Validator:        validator,
+		DelegatorAddress: delAddr.String(),
+		Value:            value,
+		EndTime:          ctx.BlockHeader().Time.Add(k.ValidatorApplicationPeriod(ctx)),
+	}
+	k.SetValidatorApplication(ctx, application)
+	k.InsertValidatorApplicationQueue(ctx, application)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeApplyValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, application.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, value.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, application.EndTime.Format(time.RFC3339)),
+		),
+	)
+
+	return nil
+}
+
+// ApproveValidatorApplication approves the pending application of a validator, the validator is activated
+// immediately if it is approved by governance or by the active validators of more than 2/3 of the last total power.
+func (k Keeper) ApproveValidatorApplication(ctx sdk.Context, voter, operator sdk.AccAddress) error {
+	application, found := k.GetValidatorApplication(ctx, operator)
+	if !found {
+		return types.ErrNoValidatorApplication
+	}
+
+	approved, err := k.voteValidatorApplication(ctx, &application, voter, true)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeApproveValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, application.Validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+		),
+	)
+
+	if approved {
+		k.activateValidator(ctx, application)
+	} else {
+		k.SetValidatorApplication(ctx, application)
+	}
+
+	return nil
+}
+
+// VetoValidatorApplication vetoes the pending application of a validator, the application is rejected
+// immediately if it is vetoed by governance or by the active validators of more than 1/3 of the last total power.
+func (k Keeper) VetoValidatorApplication(ctx sdk.Context, voter, operator sdk.AccAddress) error {
+	application, found := k.GetValidatorApplication(ctx, operator)
+	if !found {
+		return types.ErrNoValidatorApplication
+	}
+
+	vetoed, err := k.voteValidatorApplication(ctx, &application, voter, false)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVetoValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, application.Validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+		),
+	)
+
+	if vetoed {
+		k.rejectValidatorApplication(ctx, application, "vetoed")
+	} else {
+		k.SetValidatorApplication(ctx, application)
+	}
+
+	return nil
+}
+
+// ActivateMatureValidatorApplications activates the validators whose application periods have ended.
+func (k Keeper) ActivateMatureValidatorApplications(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.ValidatorApplicationQueueKey, sdk.PrefixEndBytes(types.GetValidatorApplicationQueueTimeKey(ctx.BlockHeader().Time)))
+	defer iterator.Close()
+
+	var mature []types.ValidatorApplication
+	for ; iterator.Valid(); iterator.Next() {
+		operator := sdk.AccAddress(types.AddressFromValidatorApplicationQueueKey(iterator.Key()))
+
+		application, found := k.GetValidatorApplication(ctx, operator)
+		if !found {
+			panic("validator application in the queue not found")
+		}
+		mature = append(mature, application)
+	}
+
+	for _, application := range mature {
+		k.activateValidator(ctx, application)
+	}
+}
+
+// voteValidatorApplication records the vote of the voter on the application, and returns whether the
+// application passes with the vote.
+func (k Keeper) voteValidatorApplication(ctx sdk.Context, application *types.ValidatorApplication, voter sdk.AccAddress, approve bool) (bool, error) {
+	if voter.String() == k.authority {
+		return true, nil
+	}
+
+	validator, found := k.GetValidator(ctx, voter)
+	if !found || !validator.IsBonded() || validator.IsJailed() {
+		return false, types.ErrInvalidApplicationVoter
+	}
+
+	approvals := removeAddress(application.Approvals, voter.String())
+	vetoes := removeAddress(application.Vetoes, voter.String())
+	if approve {
+		approvals = append(approvals, voter.String())
+	} else {
+		vetoes = append(vetoes, voter.String())
+	}
+	application.Approvals, application.Vetoes = approvals, vetoes
+
+	votes := vetoes
+	if approve {
+		votes = approvals
+	}
+	power := math.ZeroInt()
+	for _, addr := range votes {
+		operator := sdk.MustAccAddressFromHex(addr)
+		if validator, found := k.GetValidator(ctx, operator); found && validator.IsBonded() && !validator.IsJailed() {
+			power = power.AddRaw(k.GetLastValidatorPower(ctx, operator))
+		}
+	}
+
+	// more than 2/3 to approve and more than 1/3 to veto
+	totalPower := k.GetLastTotalPower(ctx)
+	if approve {
+		return power.MulRaw(3).GT(totalPower.MulRaw(2)), nil
+	}
+	return power.MulRaw(3).GT(totalPower), nil
+}
+
+// activateValidator creates the validator of the application with the escrowed self delegation, the
+// application is rejected if the validator can't be created anymore.
+func (k Keeper) activateValidator(ctx sdk.Context, application types.ValidatorApplication) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.createAppliedValidator(cacheCtx, application); err != nil {
+		k.rejectValidatorApplication(ctx, application, err.Error())
+		return
+	}
+	write()
+
+	k.DeleteValidatorApplication(ctx, application)
+}
+
+func (k Keeper) createAppliedValidator(ctx sdk.Context, application types.ValidatorApplication) error {
+	validator := application.Validator
+	operator := validator.GetOperator()
+
+	// the keys may have been taken during the application period
+	if _, found := k.GetValidator(ctx, operator); found {
+		return types.ErrValidatorOwnerExists
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetValidatorByConsAddr(ctx, consAddr); found {
+		return types.ErrValidatorPubKeyExists
+	}
+	if _, found := k.GetValidatorByRelayerAddr(ctx, validator.GetRelayer()); found {
+		return types.ErrValidatorRelayerAddressExists
+	}
+	if _, found := k.GetValidatorByChallengerAddr(ctx, validator.GetChallenger()); found {
+		return types.ErrValidatorChallengerAddressExists
+	}
+	if _, found := k.GetValidatorByBlsKey(ctx, validator.BlsKey); found {
+		return types.ErrValidatorBlsKeyExists
+	}
+
+	validator.Commission.UpdateTime = ctx.BlockHeader().Time
+
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+	k.SetValidatorByRelayerAddress(ctx, validator)
+	k.SetValidatorByChallengerAddress(ctx, validator)
+	if err := k.SetValidatorByBlsKey(ctx, validator); err != nil {
+		return err
+	}
+	k.SetNewValidatorByPowerIndex(ctx, validator)
+	k.SetValidatorKeyRecord(ctx, newValidatorKeyRecord(validator, ctx.BlockHeight()))
+
+	// call the after-creation hook
+	if err := k.Hooks().AfterValidatorCreated(ctx, operator); err != nil {
+		return err
+	}
+
+	// the escrowed self delegation is already in the not bonded pool
+	delAddr := sdk.MustAccAddressFromHex(application.DelegatorAddress)
+	if _, err := k.Delegate(ctx, delAddr, application.Value.Amount, types.Unbonded, validator, false); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeActivateValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, application.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, application.Value.String()),
+		),
+	)
+
+	return nil
+}
+
+// rejectValidatorApplication refunds the escrowed self delegation and deletes the application.
+func (k Keeper) rejectValidatorApplication(ctx sdk.Context, application types.ValidatorApplication, reason string) {
+	delAddr := sdk.MustAccAddressFromHex(application.DelegatorAddress)
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, sdk.NewCoins(application.Value)); err != nil {
+		panic(err)
+	}
+	k.DeleteValidatorApplication(ctx, application)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRejectValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, application.Validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, application.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, application.Value.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+}
+
+// validatorApplicationIndexKeys returns the keys of the validator indexes reserved by the application
+func validatorApplicationIndexKeys(validator types.Validator) [][]byte {
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		panic(err)
+	}
+	return [][]byte{
+		types.GetValidatorByConsAddrKey(consAddr),
+		types.GetValidatorByRelayerAddrKey(validator.GetRelayer()),
+		types.GetValidatorByChallengerAddrKey(validator.GetChallenger()),
+		types.GetValidatorByBlsKey(validator.BlsKey),
+	}
+}
+
+func removeAddress(addrs []string, addr string) []string {
+	res := make([]string, 0, len(addrs))
+	for _, a := range addrs {
+		if a != addr {
+			res = append(res, a)
+		}
+	}
+	return res
+}
@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"encoding/hex"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func (s *KeeperTestSuite) TestValidatorApplication() {
+	require := s.Require()
+
+	ctx := sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, s.ctx.Logger()).WithChainID(s.ctx.ChainID())
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	// the permissionless onboarding is disabled by default
+	require.False(s.stakingKeeper.PermissionlessOnboardingEnabled(ctx))
+
+	params := s.stakingKeeper.GetParams(ctx)
+	params.MinPermissionlessSelfDelegation = math.NewInt(1000)
+	params.ValidatorApplicationPeriod = time.Hour
+	s.stakingKeeper.SetParams(ctx, params)
+	require.True(s.stakingKeeper.PermissionlessOnboardingEnabled(ctx))
+	require.False(s.stakingKeeper.PermissionlessOnboardingEnabled(s.ctx))
+
+	bondDenom := s.stakingKeeper.BondDenom(ctx)
+	newValidator := func(i int) stakingtypes.Validator {
+		blsSecretKey, err := bls.RandKey()
+		require.NoError(err)
+
+		validator := stakingtestutil.NewValidator(s.T(), sdk.AccAddress(PKs[i].Address()), PKs[i])
+		validator.ChallengerAddress = sdk.AccAddress(PKs[i+100].Address()).String()
+		validator.BlsKey = blsSecretKey.PublicKey().Marshal()
+		return validator
+	}
+	apply := func(validator stakingtypes.Validator, amount int64) error {
+		return s.stakingKeeper.ApplyValidator(ctx, validator, validator.GetOperator(), sdk.NewInt64Coin(bondDenom, amount))
+	}
+
+	// the active validators of the powers 10, 10 and 20
+	active := make([]stakingtypes.Validator, 3)
+	for i, power := range []int64{10, 10, 20} {
+		validator := newValidator(i)
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = s.stakingKeeper.TokensFromConsensusPower(ctx, power)
+		s.stakingKeeper.SetValidator(ctx, validator)
+		s.stakingKeeper.SetLastValidatorPower(ctx, validator.GetOperator(), power)
+		active[i] = validator
+	}
+	s.stakingKeeper.SetLastTotalPower(ctx, math.NewInt(40))
+
+	// the self delegation should be at least the minimum
+	candidate := newValidator(10)
+	require.ErrorIs(apply(candidate, 999), stakingtypes.ErrPermissionlessSelfDelegation)
+
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any()).Return(nil).Times(4)
+	require.NoError(apply(candidate, 1000))
+	require.ErrorIs(apply(candidate, 1000), stakingtypes.ErrValidatorApplicationExists)
+
+	application, found := s.stakingKeeper.GetValidatorApplication(ctx, candidate.GetOperator())
+	require.True(found)
+	require.Equal(ctx.BlockHeader().Time.Add(time.Hour), application.EndTime)
+	_, found = s.stakingKeeper.GetValidator(ctx, candidate.GetOperator())
+	require.False(found)
+
+	// only governance and the active validators can vote
+	err := s.stakingKeeper.ApproveValidatorApplication(ctx, sdk.AccAddress(PKs[50].Address()), candidate.GetOperator())
+	require.ErrorIs(err, stakingtypes.ErrInvalidApplicationVoter)
+	err = s.stakingKeeper.ApproveValidatorApplication(ctx, govAddr, sdk.AccAddress(PKs[50].Address()))
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorApplication)
+
+	// the vote of 10 and the approval of 20 out of 40 powers don't pass
+	require.NoError(s.stakingKeeper.VetoValidatorApplication(ctx, active[0].GetOperator(), candidate.GetOperator()))
+	require.NoError(s.stakingKeeper.ApproveValidatorApplication(ctx, active[2].GetOperator(), candidate.GetOperator()))
+	application, found = s.stakingKeeper.GetValidatorApplication(ctx, candidate.GetOperator())
+	require.True(found)
+	require.Equal([]string{active[2].OperatorAddress}, application.Approvals)
+	require.Equal([]string{active[0].OperatorAddress}, application.Vetoes)
+
+	// the veto is replaced by the approval, the approvals of 30 out of 40 powers activate the validator
+	require.NoError(s.stakingKeeper.ApproveValidatorApplication(ctx, active[0].GetOperator(), candidate.GetOperator()))
+	_, found = s.stakingKeeper.GetValidatorApplication(ctx, candidate.GetOperator())
+	require.False(found)
+	validator, found := s.stakingKeeper.GetValidator(ctx, candidate.GetOperator())
+	require.True(found)
+	require.Equal(math.NewInt(1000), validator.Tokens)
+	delegation, found := s.stakingKeeper.GetDelegation(ctx, candidate.GetOperator(), candidate.GetOperator())
+	require.True(found)
+	require.Equal(sdk.NewDec(1000), delegation.Shares)
+	_, found = s.stakingKeeper.GetValidatorByBlsKey(ctx, candidate.BlsKey)
+	require.True(found)
+
+	// the vetoes of 20 out of 40 powers reject the application and refund the self delegation
+	vetoed := newValidator(11)
+	require.NoError(apply(vetoed, 2000))
+	require.NoError(s.stakingKeeper.VetoValidatorApplication(ctx, active[0].GetOperator(), vetoed.GetOperator()))
+	_, found = s.stakingKeeper.GetValidatorApplication(ctx, vetoed.GetOperator())
+	require.True(found)
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, vetoed.GetOperator(), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2000))).Return(nil)
+	require.NoError(s.stakingKeeper.VetoValidatorApplication(ctx, active[1].GetOperator(), vetoed.GetOperator()))
+	_, found = s.stakingKeeper.GetValidatorApplication(ctx, vetoed.GetOperator())
+	require.False(found)
+	_, found = s.stakingKeeper.GetValidator(ctx, vetoed.GetOperator())
+	require.False(found)
+
+	// the applications without enough votes are activated at the end of the application period
+	mature := newValidator(12)
+	require.NoError(apply(mature, 1000))
+	later := newValidator(13)
+	require.NoError(s.stakingKeeper.ApplyValidator(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)), later, later.GetOperator(), sdk.NewInt64Coin(bondDenom, 1000)))
+
+	res, err := s.queryClient.ValidatorApplications(gocontext.Background(), &stakingtypes.QueryValidatorApplicationsRequest{})
+	require.NoError(err)
+	require.Len(res.Applications, 2)
+	appRes, err := s.queryClient.ValidatorApplication(gocontext.Background(), &stakingtypes.QueryValidatorApplicationRequest{ValidatorAddr: mature.OperatorAddress})
+	require.NoError(err)
+	require.Equal(mature.OperatorAddress, appRes.Application.Validator.OperatorAddress)
+	_, err = s.queryClient.ValidatorApplication(gocontext.Background(), &stakingtypes.QueryValidatorApplicationRequest{ValidatorAddr: vetoed.OperatorAddress})
+	require.Error(err)
+
+	s.stakingKeeper.ActivateMatureValidatorApplications(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour - time.Second)))
+	require.Len(s.stakingKeeper.GetAllValidatorApplications(ctx), 2)
+
+	s.stakingKeeper.ActivateMatureValidatorApplications(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	_, found = s.stakingKeeper.GetValidator(ctx, mature.GetOperator())
+	require.True(found)
+	_, found = s.stakingKeeper.GetValidatorApplication(ctx, later.GetOperator())
+	require.True(found)
+
+	s.stakingKeeper.ActivateMatureValidatorApplications(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour + time.Minute)))
+	require.Empty(s.stakingKeeper.GetAllValidatorApplications(ctx))
+	_, found = s.stakingKeeper.GetValidator(ctx, later.GetOperator())
+	require.True(found)
+}
+
+func (s *KeeperTestSuite) TestMsgCreateValidatorPermissionless() {
+	require := s.Require()
+
+	ctx := sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, s.ctx.Logger()).WithChainID(s.ctx.ChainID()).WithBlockHeight(10)
+
+	valAddr := sdk.AccAddress(PKs[0].Address())
+	blsSecretKey, err := bls.RandKey()
+	require.NoError(err)
+	blsPk := blsSecretKey.PublicKey().Marshal()
+	blsProof := hex.EncodeToString(blsSecretKey.Sign(stakingtypes.BlsProofSignBytes(ctx.ChainID(), valAddr, blsPk)).Marshal())
+
+	relayerKey, err := ethsecp256k1.GenPrivKey()
+	require.NoError(err)
+	challengerKey, err := ethsecp256k1.GenPrivKey()
+	require.NoError(err)
+	relayerAddr, challengerAddr := sdk.AccAddress(relayerKey.PubKey().Address()), sdk.AccAddress(challengerKey.PubKey().Address())
+	addressProof := func(key *ethsecp256k1.PrivKey) string {
+		sig, err := key.Sign(stakingtypes.AddressProofSignBytes(ctx.ChainID(), valAddr, sdk.AccAddress(key.PubKey().Address())))
+		require.NoError(err)
+		return hex.EncodeToString(sig)
+	}
+
+	bondDenom := s.stakingKeeper.BondDenom(ctx)
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, PKs[0], sdk.NewInt64Coin(bondDenom, 1000), stakingtypes.Description{Moniker: "candidate"},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+		valAddr, valAddr, relayerAddr, challengerAddr, hex.EncodeToString(blsPk), blsProof,
+	)
+	require.NoError(err)
+
+	// the applications can't be approved or vetoed before the Nagqu upgrade
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, err = s.msgServer.ApproveValidatorApplication(s.ctx, stakingtypes.NewMsgApproveValidatorApplication(govAddr, valAddr))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = s.msgServer.VetoValidatorApplication(s.ctx, stakingtypes.NewMsgVetoValidatorApplication(govAddr, valAddr))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the self delegator can't create the validator if the permissionless onboarding is disabled
+	s.accountKeeper.EXPECT().GetModuleAddress(govtypes.ModuleName).Return(authtypes.NewModuleAddress(govtypes.ModuleName)).AnyTimes()
+	_, err = s.msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrInvalidSigner)
+
+	params := s.stakingKeeper.GetParams(ctx)
+	params.MinPermissionlessSelfDelegation = math.NewInt(1000)
+	s.stakingKeeper.SetParams(ctx, params)
+
+	// the relayer and the challenger must prove they agree to serve the validator
+	_, err = s.msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrValidatorInvalidAddressProof)
+	msg.RelayerProof = addressProof(relayerKey)
+	msg.ChallengerProof = addressProof(relayerKey)
+	_, err = s.msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrValidatorInvalidAddressProof)
+	msg.ChallengerProof = addressProof(challengerKey)
+
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), valAddr, stakingtypes.NotBondedPoolName, sdk.NewCoins(msg.Value)).Return(nil)
+	_, err = s.msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	application, found := s.stakingKeeper.GetValidatorApplication(ctx, valAddr)
+	require.True(found)
+	require.Equal("candidate", application.Validator.Description.Moniker)
+	require.Equal(msg.Value, application.Value)
+
+	// the operator address is reserved by the pending application
+	_, err = s.msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrValidatorApplicationExists)
+
+	// the keys are reserved by the pending application as well
+	otherAddr := sdk.AccAddress(PKs[3].Address())
+	otherBlsSecretKey, err := bls.RandKey()
+	require.NoError(err)
+	otherBlsPk := otherBlsSecretKey.PublicKey().Marshal()
+	otherBlsProof := hex.EncodeToString(otherBlsSecretKey.Sign(stakingtypes.BlsProofSignBytes(ctx.ChainID(), otherAddr, otherBlsPk)).Marshal())
+	newMsg := func(pk cryptotypes.PubKey, relayer, challenger sdk.AccAddress, blsPk []byte, blsProof string) *stakingtypes.MsgCreateValidator {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			otherAddr, pk, sdk.NewInt64Coin(bondDenom, 1000), stakingtypes.Description{Moniker: "other"},
+			stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+			otherAddr, otherAddr, relayer, challenger, hex.EncodeToString(blsPk), blsProof,
+		)
+		require.NoError(err)
+		return msg
+	}
+	relayer, challenger := sdk.AccAddress(PKs[4].Address()), sdk.AccAddress(PKs[5].Address())
+
+	_, err = s.msgServer.CreateValidator(ctx, newMsg(PKs[0], relayer, challenger, otherBlsPk, otherBlsProof))
+	require.ErrorIs(err, stakingtypes.ErrValidatorPubKeyExists)
+	_, err = s.msgServer.CreateValidator(ctx, newMsg(PKs[3], relayerAddr, challenger, otherBlsPk, otherBlsProof))
+	require.ErrorIs(err, stakingtypes.ErrValidatorRelayerAddressExists)
+	_, err = s.msgServer.CreateValidator(ctx, newMsg(PKs[3], relayer, challengerAddr, otherBlsPk, otherBlsProof))
+	require.ErrorIs(err, stakingtypes.ErrValidatorChallengerAddressExists)
+	_, err = s.msgServer.CreateValidator(ctx, newMsg(PKs[3], relayer, challenger, blsPk, otherBlsProof))
+	require.ErrorIs(err, stakingtypes.ErrValidatorBlsKeyExists)
+
+	// an active validator can't take the reserved keys either
+	validator := stakingtestutil.NewValidator(s.T(), otherAddr, PKs[3])
+	s.stakingKeeper.SetValidator(ctx, validator)
+	_, err = s.msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(
+		otherAddr, stakingtypes.Description{}, nil, nil, relayerAddr, sdk.AccAddress{}, "", "",
+	))
+	require.ErrorIs(err, stakingtypes.ErrValidatorRelayerAddressExists)
+	_, err = s.msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(
+		otherAddr, stakingtypes.Description{}, nil, nil, sdk.AccAddress{}, challengerAddr, "", "",
+	))
+	require.ErrorIs(err, stakingtypes.ErrValidatorChallengerAddressExists)
+
+	// the keys are released when the application is rejected
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, valAddr, sdk.NewCoins(msg.Value)).Return(nil)
+	require.NoError(s.stakingKeeper.VetoValidatorApplication(ctx, authtypes.NewModuleAddress(govtypes.ModuleName), valAddr))
+	require.False(s.stakingKeeper.IsKeyReservedByValidatorApplication(ctx, stakingtypes.GetValidatorByRelayerAddrKey(relayerAddr)))
+	require.False(s.stakingKeeper.IsKeyReservedByValidatorApplication(ctx, stakingtypes.GetValidatorByBlsKey(blsPk)))
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"bytes"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// The history of the relayer addresses, the challenger addresses and the bls keys is kept as the key records
+// of the validators, each record is a snapshot of the keys effective from its height until the height of the
+// next record. The keys of the validators created before the history was recorded are recorded at the height of
+// the Nagqu upgrade, the keys at the heights before the first record of a validator are unknown.
+//
+// The relayer addresses, the challenger addresses and the bls keys are also indexed by the heights they were
+// taken by the validators, an index is only valid if the key record of the validator at the height still
+// contains the key.
+
+// GetValidatorKeyRecord gets the key record of a validator effective from the exact height
+func (k Keeper) GetValidatorKeyRecord(ctx sdk.Context, operator sdk.AccAddress, height int64) (record types.ValidatorKeyRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetValidatorKeyHistoryKey(operator, height))
+	if value == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(value, &record)
+	return record, true
+}
+
+// SetValidatorKeyRecord sets the key record of a validator and indexes its keys by the height
+func (k Keeper) SetValidatorKeyRecord(ctx sdk.Context, record types.ValidatorKeyRecord) {
+	store := ctx.KVStore(k.storeKey)
+	operator := sdk.MustAccAddressFromHex(record.ValidatorAddress)
+	store.Set(types.GetValidatorKeyHistoryKey(operator, record.Height), k.cdc.MustMarshal(&record))
+
+	if record.RelayerAddress != "" {
+		relayer := sdk.MustAccAddressFromHex(record.RelayerAddress)
+		store.Set(types.GetRelayerAddrHistoryKey(relayer, record.Height), operator)
+	}
+	if record.ChallengerAddress != "" {
+		challenger := sdk.MustAccAddressFromHex(record.ChallengerAddress)
+		store.Set(types.GetChallengerAddrHistoryKey(challenger, record.Height), operator)
+	}
+	if len(record.BlsKey) != 0 {
+		store.Set(types.GetBlsKeyHistoryKey(record.BlsKey, record.Height), operator)
+	}
+}
+
+// HasValidatorKeyHistory checks whether any key record of a validator is recorded
+func (k Keeper) HasValidatorKeyHistory(ctx sdk.Context, operator sdk.AccAddress) bool {
+	return hasPrefix(ctx.KVStore(k.storeKey), types.GetValidatorKeyHistoryPrefix(operator))
+}
+
+// IterateValidatorKeyHistory iterates over the key records of all the validators, if the cb returns
+// true, the iterator will close and stop.
+func (k Keeper) IterateValidatorKeyHistory(ctx sdk.Context, cb func(record types.ValidatorKeyRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorKeyHistoryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ValidatorKeyRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllValidatorKeyHistory returns the key records of all the validators, used during genesis dump
+func (k Keeper) GetAllValidatorKeyHistory(ctx sdk.Context) (records []types.ValidatorKeyRecord) {
+	k.IterateValidatorKeyHistory(ctx, func(record types.ValidatorKeyRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// InitValidatorKeyHistory records the current keys of the validators which have no key record at the current
+// height, it should be called by the handler of the Nagqu upgrade.
+func (k Keeper) InitValidatorKeyHistory(ctx sdk.Context) {
+	for _, validator := range k.GetAllValidators(ctx) {
+		if k.HasValidatorKeyHistory(ctx, validator.GetOperator()) {
+			continue
+		}
+		k.SetValidatorKeyRecord(ctx, newValidatorKeyRecord(validator, ctx.BlockHeight()))
+	}
+}
+
+// RecordValidatorKeys records the keys of the validator at the current height if they are changed.
+func (k Keeper) RecordValidatorKeys(ctx sdk.Context, validator types.Validator) {
+	latest, found := k.GetValidatorKeysAtHeight(ctx, validator.GetOperator(), ctx.BlockHeight())
+
+	record := newValidatorKeyRecord(validator, ctx.BlockHeight())
+	if found && latest.RelayerAddress == record.RelayerAddress &&
+		latest.ChallengerAddress == record.ChallengerAddress && bytes.Equal(latest.BlsKey, record.BlsKey) {
+		return
+	}
+	k.SetValidatorKeyRecord(ctx, record)
+}
+
+// RecordRemovedValidatorKeys records that the keys of the removed validator are released at the current height.
+func (k Keeper) RecordRemovedValidatorKeys(ctx sdk.Context, validator types.Validator) {
+	removed := validator
+	removed.RelayerAddress = ""
+	removed.ChallengerAddress = ""
+	removed.BlsKey = nil
+	k.RecordValidatorKeys(ctx, removed)
+}
+
+// GetValidatorKeysAtHeight returns the key record of a validator effective at the height, it returns false if
+// the height is before the first record of the validator.
+func (k Keeper) GetValidatorKeysAtHeight(ctx sdk.Context, operator sdk.AccAddress, height int64) (record types.ValidatorKeyRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value, found := latestAtHeight(store, types.GetValidatorKeyHistoryPrefix(operator), height)
+	if !found {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(value, &record)
+	return record, true
+}
+
+// GetValidatorByRelayerAtHeight returns the key record of the validator whose relayer address was the given one
+// at the height.
+func (k Keeper) GetValidatorByRelayerAtHeight(ctx sdk.Context, relayer sdk.AccAddress, height int64) (types.ValidatorKeyRecord, bool) {
+	return k.getValidatorByKeyAtHeight(ctx, types.GetRelayerAddrHistoryPrefix(relayer), height,
+		func(record types.ValidatorKeyRecord) bool {
+			addr, err := sdk.AccAddressFromHexUnsafe(record.RelayerAddress)
+			return err == nil && addr.Equals(relayer)
+		},
+	)
+}
+
+// GetValidatorByChallengerAtHeight returns the key record of the validator whose challenger address was the given
+// one at the height.
+func (k Keeper) GetValidatorByChallengerAtHeight(ctx sdk.Context, challenger sdk.AccAddress, height int64) (types.ValidatorKeyRecord, bool) {
+	return k.getValidatorByKeyAtHeight(ctx, types.GetChallengerAddrHistoryPrefix(challenger), height,
+		func(record types.ValidatorKeyRecord) bool {
+			addr, err := sdk.AccAddressFromHexUnsafe(record.ChallengerAddress)
+			return err == nil && addr.Equals(challenger)
+		},
+	)
+}
+
+// GetValidatorByBlsKeyAtHeight returns the key record of the validator whose bls pubkey was the given one at the
+// height.
+func (k Keeper) GetValidatorByBlsKeyAtHeight(ctx sdk.Context, blsPk []byte, height int64) (types.ValidatorKeyRecord, bool) {
+	return k.getValidatorByKeyAtHeight(ctx, types.GetBlsKeyHistoryPrefix(blsPk), height,
+		func(record types.ValidatorKeyRecord) bool {
+			return bytes.Equal(record.BlsKey, blsPk)
+		},
+	)
+}
+
+// getValidatorByKeyAtHeight resolves the validator which took the key at the latest height not after the given
+// height, and checks that the key was still taken by the validator at the height.
+func (k Keeper) getValidatorByKeyAtHeight(
+	ctx sdk.Context, prefix []byte, height int64,
+	matches func(record types.ValidatorKeyRecord) bool,
+) (record types.ValidatorKeyRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	operator, found := latestAtHeight(store, prefix, height)
+	if !found {
+		return record, false
+	}
+
+	record, found = k.GetValidatorKeysAtHeight(ctx, operator, height)
+	if !found || !matches(record) {
+		return record, false
+	}
+	return record, true
+}
+
+// latestAtHeight returns the value of the latest entry of the height indexed prefix not after the height.
+func latestAtHeight(store storetypes.KVStore, prefix []byte, height int64) ([]byte, bool) {
+	if height < 0 {
+		return nil, false
+	}
+
+	end := append(sdk.CopyBytes(prefix), sdk.Uint64ToBigEndian(uint64(height)+1)...)
+	iterator := store.ReverseIterator(prefix, end)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil, false
+	}
+	return iterator.Value(), true
+}
+
+// hasPrefix checks whether any entry of the prefix exists.
+func hasPrefix(store storetypes.KVStore, prefix []byte) bool {
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+func newValidatorKeyRecord(validator types.Validator, height int64) types.ValidatorKeyRecord {
+	return types.ValidatorKeyRecord{
+		ValidatorAddress:  validator.OperatorAddress,
+		Height:            height,
+		RelayerAddress:    validator.RelayerAddress,
+		ChallengerAddress: validator.ChallengerAddress,
+		BlsKey:            validator.BlsKey,
+	}
+}
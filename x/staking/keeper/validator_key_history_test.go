@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"encoding/hex"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func (s *KeeperTestSuite) TestValidatorKeyHistory() {
+	require := s.Require()
+
+	ctx := sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, s.ctx.Logger()).WithChainID(s.ctx.ChainID())
+
+	newBlsKey := func() []byte {
+		blsSecretKey, err := bls.RandKey()
+		require.NoError(err)
+		return blsSecretKey.PublicKey().Marshal()
+	}
+
+	valAddr := sdk.AccAddress(PKs[0].Address())
+	relayer0, relayer1 := sdk.AccAddress(PKs[2].Address()), sdk.AccAddress(PKs[3].Address())
+	challenger0, challenger1 := sdk.AccAddress(PKs[4].Address()), sdk.AccAddress(PKs[5].Address())
+	blsKey0 := newBlsKey()
+
+	// a validator created before the history was recorded
+	validator := stakingtestutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator.RelayerAddress = relayer0.String()
+	validator.ChallengerAddress = challenger0.String()
+	validator.BlsKey = blsKey0
+	s.stakingKeeper.SetValidator(ctx, validator)
+	s.stakingKeeper.SetValidatorByRelayerAddress(ctx, validator)
+	s.stakingKeeper.SetValidatorByChallengerAddress(ctx, validator)
+	require.NoError(s.stakingKeeper.SetValidatorByBlsKey(ctx, validator))
+
+	// the keys are unknown until the history is initialized at the upgrade height
+	_, found := s.stakingKeeper.GetValidatorKeysAtHeight(ctx, valAddr, 5)
+	require.False(found)
+	_, found = s.stakingKeeper.GetValidatorByRelayerAtHeight(ctx, relayer0, 5)
+	require.False(found)
+
+	s.stakingKeeper.InitValidatorKeyHistory(ctx.WithBlockHeight(5))
+	s.stakingKeeper.InitValidatorKeyHistory(ctx.WithBlockHeight(6))
+
+	record0 := stakingtypes.ValidatorKeyRecord{
+		ValidatorAddress:  valAddr.String(),
+		Height:            5,
+		RelayerAddress:    relayer0.String(),
+		ChallengerAddress: challenger0.String(),
+		BlsKey:            blsKey0,
+	}
+
+	_, found = s.stakingKeeper.GetValidatorKeysAtHeight(ctx, valAddr, 4)
+	require.False(found)
+	record, found := s.stakingKeeper.GetValidatorKeysAtHeight(ctx, valAddr, 5)
+	require.True(found)
+	require.Equal(record0, record)
+	_, found = s.stakingKeeper.GetValidatorByRelayerAtHeight(ctx, relayer0, 4)
+	require.False(found)
+	record, found = s.stakingKeeper.GetValidatorByRelayerAtHeight(ctx, relayer0, 5)
+	require.True(found)
+	require.Equal(record0, record)
+	_, found = s.stakingKeeper.GetValidatorByRelayerAtHeight(ctx, relayer1, 5)
+	require.False(found)
+
+	editKeys := func(height int64, relayer, challenger sdk.AccAddress) {
+		msg := stakingtypes.NewMsgEditValidator(
+			valAddr, stakingtypes.Description{}, nil, nil,
+			relayer, challenger, "", "",
+		)
+		_, err := s.msgServer.EditValidator(ctx.WithBlockHeight(height), msg)
+		require.NoError(err)
+	}
+
+	// replace the relayer at height 10 and the challenger at height 20
+	editKeys(10, relayer1, sdk.AccAddress{})
+	editKeys(20, sdk.AccAddress{}, challenger1)
+	// a description only edit doesn't add a record
+	editKeys(25, sdk.AccAddress{}, sdk.AccAddress{})
+
+	record1 := record0
+	record1.Height = 10
+	record1.RelayerAddress = relayer1.String()
+	record2 := record1
+	record2.Height = 20
+	record2.ChallengerAddress = challenger1.String()
+
+	for _, tc := range []struct {
+		height int64
+		record stakingtypes.ValidatorKeyRecord
+	}{
+		{5, record0}, {9, record0}, {10, record1}, {19, record1}, {20, record2}, {100, record2},
+	} {
+		record, found = s.stakingKeeper.GetValidatorKeysAtHeight(ctx, valAddr, tc.height)
+		require.True(found)
+		require.Equal(tc.record, record, "height %d", tc.height)
+	}
+
+	// resolve the validator by the keys at heights
+	_, found = s.stakingKeeper.GetValidatorByRelayerAtHeight(ctx, relayer0, 10)
+	require.False(found)
+	record, found = s.stakingKeeper.GetValidatorByRelayerAtHeight(ctx, relayer0, 9)
+	require.True(found)
+	require.Equal(record0, record)
+	_, found = s.stakingKeeper.GetValidatorByRelayerAtHeight(ctx, relayer1, 9)
+	require.False(found)
+	record, found = s.stakingKeeper.GetValidatorByRelayerAtHeight(ctx, relayer1, 15)
+	require.True(found)
+	require.Equal(record1, record)
+	record, found = s.stakingKeeper.GetValidatorByChallengerAtHeight(ctx, challenger0, 19)
+	require.True(found)
+	require.Equal(record1, record)
+	_, found = s.stakingKeeper.GetValidatorByChallengerAtHeight(ctx, challenger0, 20)
+	require.False(found)
+	record, found = s.stakingKeeper.GetValidatorByBlsKeyAtHeight(ctx, blsKey0, 30)
+	require.True(found)
+	require.Equal(record2, record)
+
+	// the released relayer is taken by another validator at height 30
+	otherAddr := sdk.AccAddress(PKs[1].Address())
+	other := stakingtestutil.NewValidator(s.T(), otherAddr, PKs[1])
+	other.BlsKey = newBlsKey()
+	s.stakingKeeper.SetValidator(ctx, other)
+	msg := stakingtypes.NewMsgEditValidator(
+		otherAddr, stakingtypes.Description{}, nil, nil,
+		relayer0, sdk.AccAddress{}, "", "",
+	)
+	_, err := s.msgServer.EditValidator(ctx.WithBlockHeight(30), msg)
+	require.NoError(err)
+
+	record, found = s.stakingKeeper.GetValidatorByRelayerAtHeight(ctx, relayer0, 30)
+	require.True(found)
+	require.Equal(otherAddr.String(), record.ValidatorAddress)
+	record, found = s.stakingKeeper.GetValidatorByRelayerAtHeight(ctx, relayer0, 5)
+	require.True(found)
+	require.Equal(valAddr.String(), record.ValidatorAddress)
+
+	// query the history
+	historyRes, err := s.queryClient.ValidatorKeyHistory(gocontext.Background(), &stakingtypes.QueryValidatorKeyHistoryRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.Equal([]stakingtypes.ValidatorKeyRecord{record0, record1, record2}, historyRes.Records)
+
+	keysRes, err := s.queryClient.ValidatorKeysAtHeight(gocontext.Background(), &stakingtypes.QueryValidatorKeysAtHeightRequest{ValidatorAddr: valAddr.String(), Height: 15})
+	require.NoError(err)
+	require.Equal(record1, keysRes.Record)
+
+	relayerRes, err := s.queryClient.ValidatorByRelayerAtHeight(gocontext.Background(), &stakingtypes.QueryValidatorByRelayerAtHeightRequest{RelayerAddr: relayer1.String(), Height: 15})
+	require.NoError(err)
+	require.Equal(record1, relayerRes.Record)
+	_, err = s.queryClient.ValidatorByRelayerAtHeight(gocontext.Background(), &stakingtypes.QueryValidatorByRelayerAtHeightRequest{RelayerAddr: relayer1.String(), Height: 5})
+	require.Error(err)
+
+	challengerRes, err := s.queryClient.ValidatorByChallengerAtHeight(gocontext.Background(), &stakingtypes.QueryValidatorByChallengerAtHeightRequest{ChallengerAddr: challenger1.String(), Height: 20})
+	require.NoError(err)
+	require.Equal(record2, challengerRes.Record)
+
+	blsRes, err := s.queryClient.ValidatorByBlsKeyAtHeight(gocontext.Background(), &stakingtypes.QueryValidatorByBlsKeyAtHeightRequest{BlsKey: hex.EncodeToString(blsKey0), Height: 5})
+	require.NoError(err)
+	require.Equal(record0, blsRes.Record)
+	_, err = s.queryClient.ValidatorByBlsKeyAtHeight(gocontext.Background(), &stakingtypes.QueryValidatorByBlsKeyAtHeightRequest{BlsKey: "invalid"})
+	require.Error(err)
+
+	// the keys of a removed validator are released
+	s.stakingKeeper.RemoveValidator(ctx.WithBlockHeight(40), valAddr)
+	_, found = s.stakingKeeper.GetValidatorByChallengerAtHeight(ctx, challenger1, 40)
+	require.False(found)
+	record, found = s.stakingKeeper.GetValidatorByChallengerAtHeight(ctx, challenger1, 39)
+	require.True(found)
+	require.Equal(record2, record)
+	record, found = s.stakingKeeper.GetValidatorKeysAtHeight(ctx, valAddr, 40)
+	require.True(found)
+	require.Equal(stakingtypes.ValidatorKeyRecord{ValidatorAddress: valAddr.String(), Height: 40}, record)
+
+	// the history of both validators is exported with the genesis
+	require.Len(s.stakingKeeper.GetAllValidatorKeyHistory(ctx), 5)
+}
+
+func (s *KeeperTestSuite) TestValidatorKeyHistoryBeforeUpgrade() {
+	require := s.Require()
+
+	valAddr := sdk.AccAddress(PKs[0].Address())
+	validator := stakingtestutil.NewValidator(s.T(), valAddr, PKs[0])
+	s.stakingKeeper.SetValidator(s.ctx, validator)
+	s.stakingKeeper.SetValidatorByRelayerAddress(s.ctx, validator)
+
+	relayer := sdk.AccAddress(PKs[2].Address())
+	msg := stakingtypes.NewMsgEditValidator(
+		valAddr, stakingtypes.Description{}, nil, nil,
+		relayer, sdk.AccAddress{}, "", "",
+	)
+	_, err := s.msgServer.EditValidator(s.ctx, msg)
+	require.NoError(err)
+
+	// the history isn't recorded, the keys are unknown at any height
+	require.False(s.stakingKeeper.HasValidatorKeyHistory(s.ctx, valAddr))
+	_, found := s.stakingKeeper.GetValidatorKeysAtHeight(s.ctx, valAddr, s.ctx.BlockHeight())
+	require.False(found)
+}
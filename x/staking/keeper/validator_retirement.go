@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// A validator can retire itself to leave the validator set. The retiring validator keeps its relayer and
+// challenger duties during the cooldown so that the in-flight oracle claims and challenges can be finished,
+// and at the end of the cooldown it's jailed and its bls pubkey, relayer and challenger addresses are released
+// for other validators. The retirement is kept until the validator is removed, a retiring or retired validator
+// can't change its keys, be unjailed or accept new delegations.
+
+// GetValidatorRetirement gets the retirement of a validator
+func (k Keeper) GetValidatorRetirement(ctx sdk.Context, operator sdk.AccAddress) (retirement types.ValidatorRetirement, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetValidatorRetirementKey(operator))
+	if value == nil {
+		return retirement, false
+	}
+
+	k.cdc.MustUnmarshal(value, &retirement)
+	return retirement, true
+}
+
+// HasValidatorRetirement checks whether the validator is retiring or retired
+func (k Keeper) HasValidatorRetirement(ctx sdk.Context, operator sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorRetirementKey(operator))
+}
+
+// SetValidatorRetirement sets the retirement of a validator
+func (k Keeper) SetValidatorRetirement(ctx sdk.Context, retirement types.ValidatorRetirement) {
+	store := ctx.KVStore(k.storeKey)
+	operator := sdk.MustAccAddressFromHex(retirement.ValidatorAddress)
+	store.Set(types.GetValidatorRetirementKey(operator), k.cdc.MustMarshal(&retirement))
+}
+
+// DeleteValidatorRetirement deletes the retirement of a validator and removes it from the queue
+func (k Keeper) DeleteValidatorRetirement(ctx sdk.Context, retirement types.ValidatorRetirement) {
+	store := ctx.KVStore(k.storeKey)
+	operator := sdk.MustAccAddressFromHex(retirement.ValidatorAddress)
+	store.Delete(types.GetValidatorRetirementKey(operator))
+	store.Delete(types.GetValidatorRetirementQueueKey(retirement.EndTime, operator))
+}
+
+// InsertValidatorRetirementQueue inserts the retirement of a validator to the queue by its end time
+func (k Keeper) InsertValidatorRetirementQueue(ctx sdk.Context, retirement types.ValidatorRetirement) {
+	store := ctx.KVStore(k.storeKey)
+	operator := sdk.MustAccAddressFromHex(retirement.ValidatorAddress)
+	store.Set(types.GetValidatorRetirementQueueKey(retirement.EndTime, operator), []byte{})
+}
+
+// IterateValidatorRetirements iterates over the retirements of all the retiring and retired validators, if
+// the cb returns true, the iterator will close and stop.
+func (k Keeper) IterateValidatorRetirements(ctx sdk.Context, cb func(retirement types.ValidatorRetirement) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorRetirementKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var retirement types.ValidatorRetirement
+		k.cdc.MustUnmarshal(iterator.Value(), &retirement)
+		if cb(retirement) {
+			break
+		}
+	}
+}
+
+// GetAllValidatorRetirements returns the retirements of all the retiring and retired validators, used during
+// genesis dump
+func (k Keeper) GetAllValidatorRetirements(ctx sdk.Context) (retirements []types.ValidatorRetirement) {
+	k.IterateValidatorRetirements(ctx, func(retirement types.ValidatorRetirement) bool {
+		retirements = append(retirements, retirement)
+		return false
+	})
+
+	return retirements
+}
+
+// RetireValidator schedules the retirement of the validator at the end of the cooldown.
+func (k Keeper) RetireValidator(ctx sdk.Context, validator types.Validator) (types.ValidatorRetirement, error) {
+	if k.HasValidatorRetirement(ctx, validator.GetOperator()) {
+		return types.ValidatorRetirement{}, types.ErrValidatorRetired
+	}
+
+	retirement := types.ValidatorRetirement{
+		ValidatorAddress: validator.OperatorAddress,
+		EndTime:          ctx.BlockHeader().Time.Add(k.ValidatorRetirementCooldown(ctx)),
+	}
+	k.SetValidatorRetirement(ctx, retirement)
+	k.InsertValidatorRetirementQueue(ctx, retirement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRetireValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEndTime, retirement.EndTime.Format(time.RFC3339)),
+		),
+	)
+
+	return retirement, nil
+}
+
+// CompleteMatureValidatorRetirements retires the validators whose cooldowns have ended.
+func (k Keeper) CompleteMatureValidatorRetirements(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.ValidatorRetirementQueueKey, sdk.PrefixEndBytes(types.GetValidatorRetirementQueueTimeKey(ctx.BlockHeader().Time)))
+	defer iterator.Close()
+
+	var mature []types.ValidatorRetirement
+	for ; iterator.Valid(); iterator.Next() {
+		operator := sdk.AccAddress(types.AddressFromValidatorRetirementQueueKey(iterator.Key()))
+
+		retirement, found := k.GetValidatorRetirement(ctx, operator)
+		if !found {
+			panic("validator retirement in the queue not found")
+		}
+		mature = append(mature, retirement)
+	}
+
+	for _, retirement := range mature {
+		k.completeValidatorRetirement(ctx, retirement)
+	}
+}
+
+// completeValidatorRetirement jails the validator to remove it from the validator set, and releases its bls
+// pubkey, relayer and challenger addresses.
+func (k Keeper) completeValidatorRetirement(ctx sdk.Context, retirement types.ValidatorRetirement) {
+	operator := sdk.MustAccAddressFromHex(retirement.ValidatorAddress)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorRetirementQueueKey(retirement.EndTime, operator))
+
+	validator := k.mustGetValidator(ctx, operator)
+
+	if !validator.IsJailed() {
+		k.jailValidator(ctx, validator)
+		validator.Jailed = true
+	}
+
+	k.DeleteValidatorByRelayerAddress(ctx, validator)
+	k.DeleteValidatorByChallengerAddress(ctx, validator)
+	k.DeleteValidatorByBlsKey(ctx, validator)
+	if rotation, found := k.GetBlsKeyRotation(ctx, operator); found {
+		k.retireBlsKey(ctx, rotation)
+	}
+	validator.RelayerAddress = ""
+	validator.ChallengerAddress = ""
+	validator.BlsKey = nil
+	k.SetValidator(ctx, validator)
+	k.RecordValidatorKeys(ctx, validator)
+
+	retirement.Retired = true
+	k.SetValidatorRetirement(ctx, retirement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteRetirement,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+		),
+	)
+}
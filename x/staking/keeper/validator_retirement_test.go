@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func (s *KeeperTestSuite) TestRetireValidator() {
+	require := s.Require()
+
+	ctx := sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == upgradetypes.Nagqu
+	}, s.ctx.Logger()).WithChainID(s.ctx.ChainID()).WithBlockHeight(10)
+
+	params := s.stakingKeeper.GetParams(ctx)
+	params.ValidatorRetirementCooldown = time.Hour
+	s.stakingKeeper.SetParams(ctx, params)
+
+	blsSecretKey, err := bls.RandKey()
+	require.NoError(err)
+
+	valAddr := sdk.AccAddress(PKs[0].Address())
+	relayer, challenger := sdk.AccAddress(PKs[1].Address()), sdk.AccAddress(PKs[2].Address())
+	validator := stakingtestutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = s.stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	validator.RelayerAddress = relayer.String()
+	validator.ChallengerAddress = challenger.String()
+	validator.BlsKey = blsSecretKey.PublicKey().Marshal()
+	s.stakingKeeper.SetValidator(ctx, validator)
+	s.stakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+	s.stakingKeeper.SetValidatorByRelayerAddress(ctx, validator)
+	s.stakingKeeper.SetValidatorByChallengerAddress(ctx, validator)
+	require.NoError(s.stakingKeeper.SetValidatorByBlsKey(ctx, validator))
+	s.stakingKeeper.InitValidatorKeyHistory(ctx)
+
+	// the validator can't retire before the upgrade
+	msg := stakingtypes.NewMsgRetireValidator(valAddr)
+	_, err = s.msgServer.RetireValidator(s.ctx, msg)
+	require.Error(err)
+
+	res, err := s.msgServer.RetireValidator(ctx, msg)
+	require.NoError(err)
+	require.Equal(ctx.BlockTime().Add(time.Hour), res.EndTime)
+	_, err = s.msgServer.RetireValidator(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrValidatorRetired)
+
+	// the retiring validator doesn't accept new delegations
+	validator, found := s.stakingKeeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	_, err = s.stakingKeeper.Delegate(ctx, sdk.AccAddress(PKs[5].Address()), math.NewInt(100), stakingtypes.Unbonded, validator, true)
+	require.ErrorIs(err, stakingtypes.ErrValidatorRetired)
+
+	// the retiring validator keeps its duties and keys during the cooldown
+	s.stakingKeeper.CompleteMatureValidatorRetirements(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour - time.Second)))
+	validator, found = s.stakingKeeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.False(validator.IsJailed())
+	_, found = s.stakingKeeper.GetValidatorByRelayerAddr(ctx, relayer)
+	require.True(found)
+
+	editMsg := stakingtypes.NewMsgEditValidator(
+		valAddr, stakingtypes.Description{Moniker: "retiring"}, nil, nil,
+		sdk.AccAddress(PKs[3].Address()), sdk.AccAddress{}, "", "",
+	)
+	_, err = s.msgServer.EditValidator(ctx, editMsg)
+	require.ErrorIs(err, stakingtypes.ErrValidatorRetired)
+	editMsg.RelayerAddress = relayer.String()
+	_, err = s.msgServer.EditValidator(ctx, editMsg)
+	require.NoError(err)
+
+	retirement, found := s.stakingKeeper.GetValidatorRetirement(ctx, valAddr)
+	require.True(found)
+	require.False(retirement.Retired)
+
+	// the validator is jailed and its keys are released at the end of the cooldown
+	s.stakingKeeper.CompleteMatureValidatorRetirements(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithBlockHeight(20))
+	validator, found = s.stakingKeeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.True(validator.IsJailed())
+	require.Empty(validator.RelayerAddress)
+	require.Empty(validator.ChallengerAddress)
+	require.Empty(validator.BlsKey)
+	_, found = s.stakingKeeper.GetValidatorByRelayerAddr(ctx, relayer)
+	require.False(found)
+	_, found = s.stakingKeeper.GetValidatorByChallengerAddr(ctx, challenger)
+	require.False(found)
+	_, found = s.stakingKeeper.GetValidatorByBlsKey(ctx, blsSecretKey.PublicKey().Marshal())
+	require.False(found)
+
+	// the keys are recorded as released
+	record, found := s.stakingKeeper.GetValidatorByRelayerAtHeight(ctx, relayer, 19)
+	require.True(found)
+	require.Equal(valAddr.String(), record.ValidatorAddress)
+	_, found = s.stakingKeeper.GetValidatorByRelayerAtHeight(ctx, relayer, 20)
+	require.False(found)
+
+	retirementRes, err := s.queryClient.ValidatorRetirement(gocontext.Background(), &stakingtypes.QueryValidatorRetirementRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.True(retirementRes.Retirement.Retired)
+	retirementsRes, err := s.queryClient.ValidatorRetirements(gocontext.Background(), &stakingtypes.QueryValidatorRetirementsRequest{})
+	require.NoError(err)
+	require.Len(retirementsRes.Retirements, 1)
+
+	// the released relayer can be taken by another validator
+	otherAddr := sdk.AccAddress(PKs[4].Address())
+	other := stakingtestutil.NewValidator(s.T(), otherAddr, PKs[4])
+	s.stakingKeeper.SetValidator(ctx, other)
+	_, err = s.msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(
+		otherAddr, stakingtypes.Description{}, nil, nil, relayer, sdk.AccAddress{}, "", "",
+	))
+	require.NoError(err)
+	validator, found = s.stakingKeeper.GetValidatorByRelayerAddr(ctx, relayer)
+	require.True(found)
+	require.Equal(otherAddr.String(), validator.OperatorAddress)
+}
@@ -36,21 +36,33 @@ func TestMigrateJSON(t *testing.T) {
 
 	// Make sure about new param MinCommissionRate.
 	expected := `{
+	"bls_key_rotations": [],
 	"delegations": [],
 	"exported": false,
+	"last_tokenize_share_record_id": "0",
 	"last_total_power": "0",
 	"last_validator_powers": [],
 	"params": {
+		"bls_key_overlap_blocks": "100",
 		"bond_denom": "stake",
+		"global_liquid_staking_cap": "0.250000000000000000",
 		"historical_entries": 10000,
 		"max_entries": 7,
 		"max_validators": 100,
 		"min_commission_rate": "0.000000000000000000",
+		"min_permissionless_self_delegation": "0",
 		"min_self_delegation": "1",
-		"unbonding_time": "1814400s"
+		"unbonding_time": "1814400s",
+		"validator_application_period": "604800s",
+		"validator_liquid_staking_cap": "0.500000000000000000",
+		"validator_retirement_cooldown": "86400s"
 	},
 	"redelegations": [],
+	"tokenize_share_records": [],
 	"unbonding_delegations": [],
+	"validator_applications": [],
+	"validator_key_history": [],
+	"validator_retirements": [],
 	"validators": []
 }`
 
@@ -64,7 +64,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate, types.DefaultMinSelfDelegation)
+	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate, types.DefaultMinSelfDelegation, types.DefaultBlsKeyOverlapBlocks, types.DefaultMinPermissionlessSelfDelegation, types.DefaultValidatorApplicationPeriod, types.DefaultValidatorRetirementCooldown, types.DefaultGlobalLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
 
 	// validators & delegations
 	var (
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// BlsProofPurpose is the purpose tag of the BLS proof-of-possession of a validator, it separates
+// the proofs from the other messages signed by the BLS key of the validator, e.g. the votes of
+// the cross chain packages.
+const BlsProofPurpose = "GREENFIELD_VALIDATOR_BLS_POP_V1"
+
+// BlsProofSignBytes returns the digest the BLS key of a validator signs to prove its possession.
+// The proof is bound to the chain and the operator of the validator, so that it can't be replayed
+// by another operator or on another chain.
+func BlsProofSignBytes(chainID string, operator sdk.AccAddress, blsPk []byte) []byte {
+	bz := make([]byte, 0, len(BlsProofPurpose)+len(chainID)+len(operator)+len(blsPk)+2)
+	bz = append(bz, BlsProofPurpose...)
+	bz = append(bz, address.MustLengthPrefix([]byte(chainID))...)
+	bz = append(bz, address.MustLengthPrefix(operator)...)
+	bz = append(bz, blsPk...)
+	return tmhash.Sum(bz)
+}
+
+// LegacyBlsProofSignBytes returns the digest signed by the BLS proofs before the Nagqu upgrade,
+// which only covers the BLS key itself.
+func LegacyBlsProofSignBytes(blsPk []byte) []byte {
+	return tmhash.Sum(blsPk)
+}
+
+// AddressProofPurpose is the purpose tag of the proofs signed by the relayer and the challenger of
+// a validator applying without governance.
+const AddressProofPurpose = "GREENFIELD_VALIDATOR_ADDRESS_POP_V1"
+
+// AddressProofSignBytes returns the digest the relayer or the challenger of a validator signs to
+// prove it agrees to serve the validator. Like the BLS proof, it's bound to the chain and the operator.
+func AddressProofSignBytes(chainID string, operator, addr sdk.AccAddress) []byte {
+	bz := make([]byte, 0, len(AddressProofPurpose)+len(chainID)+len(operator)+len(addr)+2)
+	bz = append(bz, AddressProofPurpose...)
+	bz = append(bz, address.MustLengthPrefix([]byte(chainID))...)
+	bz = append(bz, address.MustLengthPrefix(operator)...)
+	bz = append(bz, addr...)
+	return tmhash.Sum(bz)
+}
@@ -0,0 +1,28 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestBlsProofSignBytes(t *testing.T) {
+	blsSecretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	blsPk := blsSecretKey.PublicKey().Marshal()
+	operator := valAddr1
+	chainID := "greenfield_9000-1"
+
+	signBytes := types.BlsProofSignBytes(chainID, operator, blsPk)
+	require.Len(t, signBytes, 32)
+	require.Equal(t, signBytes, types.BlsProofSignBytes(chainID, operator, blsPk))
+
+	// the proof is bound to the chain, the operator and the bls key
+	require.NotEqual(t, signBytes, types.BlsProofSignBytes("greenfield_5600-1", operator, blsPk))
+	require.NotEqual(t, signBytes, types.BlsProofSignBytes(chainID, valAddr2, blsPk))
+	require.NotEqual(t, signBytes, types.BlsProofSignBytes(chainID, operator, make([]byte, len(blsPk))))
+	require.NotEqual(t, signBytes, types.LegacyBlsProofSignBytes(blsPk))
+}
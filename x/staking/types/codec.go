@@ -23,6 +23,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgBeginRedelegate{}, "cosmos-sdk/MsgBeginRedelegate")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbondingDelegation{}, "cosmos-sdk/MsgCancelUnbondingDelegation")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/staking/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveValidatorApplication{}, "cosmos-sdk/MsgApproveValApplication")
+	legacy.RegisterAminoMsg(cdc, &MsgVetoValidatorApplication{}, "cosmos-sdk/MsgVetoValApplication")
+	legacy.RegisterAminoMsg(cdc, &MsgRetireValidator{}, "cosmos-sdk/MsgRetireValidator")
+	legacy.RegisterAminoMsg(cdc, &MsgTokenizeShares{}, "cosmos-sdk/MsgTokenizeShares")
+	legacy.RegisterAminoMsg(cdc, &MsgRedeemTokensForShares{}, "cosmos-sdk/MsgRedeemTokensForShares")
 
 	cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
 	cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "cosmos-sdk/StakeAuthorization/AllowList", nil)
@@ -41,6 +46,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBeginRedelegate{},
 		&MsgCancelUnbondingDelegation{},
 		&MsgUpdateParams{},
+		&MsgApproveValidatorApplication{},
+		&MsgVetoValidatorApplication{},
+		&MsgRetireValidator{},
+		&MsgTokenizeShares{},
+		&MsgRedeemTokensForShares{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
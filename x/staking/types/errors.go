@@ -59,4 +59,17 @@ var (
 	ErrInvalidSigner                    = errors.Register(ModuleName, 49, "invalid signer")
 	ErrInvalidMinSelfDelegation         = errors.Register(ModuleName, 50, "invalid minimum self delegation, must no less than the chain level minimum self delegation")
 	ErrValidatorInvalidBlsProof         = errors.Register(ModuleName, 51, "validator bls proof is invalid")
+	ErrBlsKeyRotationInProgress         = errors.Register(ModuleName, 52, "validator bls key rotation is in progress; wait for the previous bls key to be retired")
+	ErrValidatorApplicationExists       = errors.Register(ModuleName, 53, "validator application already exist for this operator address")
+	ErrNoValidatorApplication           = errors.Register(ModuleName, 54, "validator application does not exist")
+	ErrPermissionlessSelfDelegation     = errors.Register(ModuleName, 55, "self delegation is below the minimum self delegation of validators created without governance")
+	ErrInvalidApplicationVoter          = errors.Register(ModuleName, 56, "validator application can only be approved or vetoed by governance or active validators")
+	ErrValidatorRetired                 = errors.Register(ModuleName, 57, "validator is retiring or retired")
+
+	ErrTokenizeShareRecordNotFound       = errors.Register(ModuleName, 58, "tokenize share record not found")
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 59, "tokenizing the delegation exceeds the global liquid staking cap")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 60, "tokenizing the delegation exceeds the liquid staking cap of the validator")
+	ErrInvalidTokenizedShares            = errors.Register(ModuleName, 61, "invalid tokenized shares")
+
+	ErrValidatorInvalidAddressProof = errors.Register(ModuleName, 62, "validator relayer or challenger address proof is invalid")
 )
@@ -10,6 +10,17 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeRotateBlsKey              = "rotate_bls_key"
+	EventTypeRetireBlsKey              = "retire_bls_key"
+	EventTypeApplyValidator            = "apply_validator"
+	EventTypeApproveValidator          = "approve_validator"
+	EventTypeVetoValidator             = "veto_validator"
+	EventTypeActivateValidator         = "activate_validator"
+	EventTypeRejectValidator           = "reject_validator"
+	EventTypeRetireValidator           = "retire_validator"
+	EventTypeCompleteRetirement        = "complete_retirement"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemShares              = "redeem_shares"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -18,10 +29,17 @@ const (
 	AttributeKeyRelayerAddress    = "relayer_address"
 	AttributeKeyChallengerAddress = "challenger_address"
 	AttributeKeyBlsKey            = "bls_key"
+	AttributeKeyPrevBlsKey        = "prev_bls_key"
+	AttributeKeyExpiryHeight      = "expiry_height"
 	AttributeKeySrcValidator      = "source_validator"
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyEndTime           = "end_time"
+	AttributeKeyVoter             = "voter"
+	AttributeKeyReason            = "reason"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
 )
@@ -42,5 +42,10 @@ func (g GenesisState) UnpackInterfaces(c codectypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for i := range g.ValidatorApplications {
+		if err := g.ValidatorApplications[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
 	return nil
 }
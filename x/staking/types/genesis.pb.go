@@ -45,6 +45,19 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// bls_key_rotations defines the bls key rotations active at genesis.
+	BlsKeyRotations []BlsKeyRotation `protobuf:"bytes,9,rep,name=bls_key_rotations,json=blsKeyRotations,proto3" json:"bls_key_rotations"`
+	// validator_key_history defines the history of the relayer addresses, the challenger addresses and the
+	// bls keys of the validators.
+	ValidatorKeyHistory []ValidatorKeyRecord `protobuf:"bytes,10,rep,name=validator_key_history,json=validatorKeyHistory,proto3" json:"validator_key_history"`
+	// validator_applications defines the pending applications of the validators created without governance.
+	ValidatorApplications []ValidatorApplication `protobuf:"bytes,11,rep,name=validator_applications,json=validatorApplications,proto3" json:"validator_applications"`
+	// validator_retirements defines the retiring and the retired validators.
+	ValidatorRetirements []ValidatorRetirement `protobuf:"bytes,12,rep,name=validator_retirements,json=validatorRetirements,proto3" json:"validator_retirements"`
+	// tokenize_share_records defines the records of the tokenized delegations.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,13,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id defines the id of the last tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,14,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,6 +142,48 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetBlsKeyRotations() []BlsKeyRotation {
+	if m != nil {
+		return m.BlsKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorKeyHistory() []ValidatorKeyRecord {
+	if m != nil {
+		return m.ValidatorKeyHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorApplications() []ValidatorApplication {
+	if m != nil {
+		return m.ValidatorApplications
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorRetirements() []ValidatorRetirement {
+	if m != nil {
+		return m.ValidatorRetirements
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -180,39 +235,50 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc5, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x29, 0x32, 0x19, 0x9c, 0x10, 0x55, 0x28,
-	0x2a, 0xd4, 0x56, 0xd3, 0x8d, 0xad, 0x11, 0x02, 0x21, 0x55, 0xa2, 0x72, 0x29, 0x03, 0x12, 0x8a,
-	0x2e, 0xf5, 0xc9, 0x3d, 0xc5, 0xb9, 0xb3, 0x7c, 0xff, 0x94, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a,
-	0x32, 0x32, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0x00, 0x1f, 0x03, 0xf9, 0xce,
-	0x31, 0x87, 0x52, 0x77, 0x49, 0x7c, 0xfe, 0xbf, 0xf7, 0x7b, 0x4f, 0xf2, 0xff, 0xd0, 0xc6, 0x91,
-	0x90, 0x13, 0x21, 0x7d, 0x09, 0x64, 0xcc, 0x78, 0xe4, 0x9f, 0x6c, 0x8f, 0x28, 0x90, 0x6d, 0x3f,
-	0xa2, 0x9c, 0x4a, 0x26, 0xbd, 0x24, 0x15, 0x20, 0xf0, 0x7d, 0xad, 0xf2, 0x72, 0x95, 0x97, 0xab,
-	0x5a, 0xcd, 0x48, 0x44, 0x42, 0x49, 0xfc, 0xec, 0x49, 0xab, 0x5b, 0x65, 0xcc, 0x85, 0x5b, 0xab,
-	0x1e, 0x68, 0xd5, 0x50, 0xdb, 0xf3, 0x00, 0x3d, 0xba, 0x4b, 0x26, 0x8c, 0x0b, 0x5f, 0xfd, 0xea,
-	0x57, 0xdd, 0xcf, 0x55, 0xb4, 0xf6, 0x42, 0x77, 0x3a, 0x00, 0x02, 0x14, 0xef, 0xa2, 0x5a, 0x42,
-	0x52, 0x32, 0x91, 0x8e, 0xdd, 0xb1, 0x7b, 0x8d, 0xbe, 0xeb, 0x5d, 0xdd, 0xd1, 0xdb, 0x57, 0xaa,
-	0xc1, 0xea, 0xf9, 0x65, 0xdb, 0xfa, 0xf2, 0xfb, 0xeb, 0xa6, 0x1d, 0xe4, 0x46, 0xfc, 0x0e, 0xdd,
-	0x89, 0x89, 0x84, 0x21, 0x08, 0x20, 0xf1, 0x30, 0x11, 0xef, 0x69, 0xea, 0xdc, 0xe8, 0xd8, 0xbd,
-	0xb5, 0xc1, 0x4e, 0x26, 0xfe, 0x79, 0xd9, 0x7e, 0x14, 0x31, 0x38, 0x9e, 0x8e, 0xbc, 0x23, 0x31,
-	0xc9, 0x1b, 0xe6, 0x7f, 0x5b, 0x32, 0x1c, 0xfb, 0xf0, 0x21, 0xa1, 0xd2, 0x7b, 0xc9, 0x41, 0x63,
-	0x6f, 0x67, 0xb0, 0xd7, 0x19, 0x6b, 0x3f, 0x43, 0x61, 0x86, 0xd6, 0x15, 0xfe, 0x84, 0xc4, 0x2c,
-	0x24, 0x20, 0x52, 0x1d, 0x21, 0x9d, 0x4a, 0xa7, 0xd2, 0x6b, 0xf4, 0x37, 0xcb, 0x0a, 0xef, 0x11,
-	0x09, 0x6f, 0x16, 0x1e, 0x85, 0x32, 0xcb, 0xdf, 0x8b, 0x97, 0xc6, 0x12, 0xef, 0x21, 0x54, 0xa4,
-	0x48, 0xe7, 0xa6, 0xe2, 0x3f, 0x2c, 0xe3, 0x17, 0x66, 0x13, 0x6b, 0xf8, 0xf1, 0x2b, 0xd4, 0x08,
-	0x69, 0x4c, 0x23, 0x02, 0x4c, 0x70, 0xe9, 0x54, 0x15, 0xae, 0x5b, 0x86, 0x7b, 0x56, 0x48, 0x4d,
-	0x9e, 0x49, 0xc0, 0x63, 0xb4, 0x3e, 0xe5, 0x23, 0xc1, 0x43, 0xc6, 0xa3, 0xa1, 0x89, 0xae, 0x29,
-	0xf4, 0xe3, 0x32, 0xf4, 0xe1, 0xc2, 0x74, 0x75, 0x46, 0x73, 0xba, 0x3c, 0x97, 0xf8, 0x10, 0xdd,
-	0x4a, 0xa9, 0x19, 0xb2, 0xa2, 0x42, 0x36, 0xca, 0x42, 0x02, 0x43, 0x6c, 0xd2, 0xff, 0xa7, 0xe0,
-	0x16, 0xaa, 0xd3, 0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb1, 0x7b, 0xf5, 0xa0, 0x38, 0x77,
-	0x8f, 0x11, 0x5e, 0xfe, 0x68, 0xb8, 0x8f, 0x56, 0x48, 0x18, 0xa6, 0x54, 0xea, 0x15, 0x5d, 0x1d,
-	0x38, 0xdf, 0xbf, 0x6d, 0x35, 0xf3, 0x16, 0xbb, 0x7a, 0x72, 0x00, 0x29, 0xe3, 0x51, 0xb0, 0x10,
-	0xe2, 0x26, 0xaa, 0xfe, 0xdb, 0xc3, 0x4a, 0xa0, 0x0f, 0x4f, 0xeb, 0x1f, 0xcf, 0xda, 0xd6, 0x9f,
-	0xb3, 0xb6, 0x35, 0x78, 0x7e, 0x3e, 0x73, 0xed, 0x8b, 0x99, 0x6b, 0xff, 0x9a, 0xb9, 0xf6, 0xa7,
-	0xb9, 0x6b, 0x5d, 0xcc, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0xde, 0x3e, 0xb9, 0x76, 0x55, 0x4f, 0x8b,
-	0xcb, 0xa8, 0x96, 0x76, 0x54, 0x53, 0xb7, 0x6a, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x05, 0x1a, 0xb6, 0xff, 0x03, 0x00, 0x00,
+	// 682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0xe3, 0xcb, 0xbf, 0x64, 0x02, 0xdc, 0xcb, 0xdc, 0x80, 0x0c, 0xd2, 0x75, 0x72, 0x11,
+	0x42, 0x11, 0x05, 0x47, 0xc0, 0xae, 0xab, 0x12, 0x55, 0x6d, 0x51, 0x91, 0x8a, 0x0c, 0x74, 0x51,
+	0x09, 0x59, 0x93, 0x78, 0xe4, 0x8c, 0xe2, 0x78, 0xac, 0x99, 0x21, 0x25, 0x7d, 0x82, 0x2e, 0xfb,
+	0x08, 0x2c, 0xbb, 0xec, 0xa2, 0x0f, 0xc1, 0x12, 0x75, 0x55, 0x75, 0x81, 0x2a, 0x58, 0xb4, 0xdb,
+	0xbe, 0x41, 0xe5, 0x19, 0xc7, 0x99, 0xc8, 0x38, 0xdd, 0x24, 0x71, 0xce, 0xf7, 0xfd, 0xbe, 0x33,
+	0xe3, 0xa3, 0x03, 0x36, 0xda, 0x94, 0xf7, 0x28, 0x6f, 0x70, 0x81, 0xba, 0x24, 0xf4, 0x1b, 0xfd,
+	0xdd, 0x16, 0x16, 0x68, 0xb7, 0xe1, 0xe3, 0x10, 0x73, 0xc2, 0xed, 0x88, 0x51, 0x41, 0xe1, 0x8a,
+	0x52, 0xd9, 0x89, 0xca, 0x4e, 0x54, 0x6b, 0x15, 0x9f, 0xfa, 0x54, 0x4a, 0x1a, 0xf1, 0x2f, 0xa5,
+	0x5e, 0xcb, 0x63, 0x0e, 0xdd, 0x4a, 0xb5, 0xaa, 0x54, 0xae, 0xb2, 0x27, 0x01, 0xaa, 0xb4, 0x84,
+	0x7a, 0x24, 0xa4, 0x0d, 0xf9, 0xa9, 0xfe, 0x5a, 0xff, 0x55, 0x02, 0xf3, 0xcf, 0x55, 0x4f, 0x27,
+	0x02, 0x09, 0x0c, 0x0f, 0xc0, 0x6c, 0x84, 0x18, 0xea, 0x71, 0xd3, 0xa8, 0x19, 0xf5, 0xf2, 0x9e,
+	0x65, 0x3f, 0xdc, 0xa3, 0x7d, 0x2c, 0x55, 0xcd, 0xd2, 0xf5, 0x6d, 0xb5, 0xf0, 0xf1, 0xc7, 0xa7,
+	0x2d, 0xc3, 0x49, 0x8c, 0xf0, 0x1c, 0xfc, 0x13, 0x20, 0x2e, 0x5c, 0x41, 0x05, 0x0a, 0xdc, 0x88,
+	0xbe, 0xc5, 0xcc, 0xfc, 0xab, 0x66, 0xd4, 0xe7, 0x9b, 0xfb, 0xb1, 0xf8, 0xdb, 0x6d, 0x75, 0xd3,
+	0x27, 0xa2, 0x73, 0xd1, 0xb2, 0xdb, 0xb4, 0x97, 0x74, 0x98, 0x7c, 0xed, 0x70, 0xaf, 0xdb, 0x10,
+	0x83, 0x08, 0x73, 0xfb, 0x30, 0x14, 0x0a, 0xbb, 0x18, 0xc3, 0x4e, 0x63, 0xd6, 0x71, 0x8c, 0x82,
+	0x04, 0x2c, 0x4b, 0x7c, 0x1f, 0x05, 0xc4, 0x43, 0x82, 0x32, 0x15, 0xc1, 0xcd, 0xa9, 0xda, 0x54,
+	0xbd, 0xbc, 0xb7, 0x95, 0xd7, 0xf0, 0x11, 0xe2, 0xe2, 0xf5, 0xd0, 0x23, 0x51, 0x7a, 0xf3, 0xff,
+	0x06, 0x99, 0x32, 0x87, 0x47, 0x00, 0xa4, 0x29, 0xdc, 0x9c, 0x96, 0xfc, 0xff, 0xf3, 0xf8, 0xa9,
+	0x59, 0xc7, 0x6a, 0x7e, 0xf8, 0x0a, 0x94, 0x3d, 0x1c, 0x60, 0x1f, 0x09, 0x42, 0x43, 0x6e, 0xce,
+	0x48, 0xdc, 0x7a, 0x1e, 0xee, 0x69, 0x2a, 0xd5, 0x79, 0x3a, 0x01, 0x76, 0xc1, 0xf2, 0x45, 0xd8,
+	0xa2, 0xa1, 0x47, 0x42, 0xdf, 0xd5, 0xd1, 0xb3, 0x12, 0xfd, 0x28, 0x0f, 0x7d, 0x36, 0x34, 0x3d,
+	0x9c, 0x51, 0xb9, 0xc8, 0xd6, 0x39, 0x3c, 0x03, 0x0b, 0x0c, 0xeb, 0x21, 0x73, 0x32, 0x64, 0x23,
+	0x2f, 0xc4, 0xd1, 0xc4, 0x3a, 0x7d, 0x9c, 0x02, 0xd7, 0x40, 0x11, 0x5f, 0x46, 0x94, 0x09, 0xec,
+	0x99, 0xc5, 0x9a, 0x51, 0x2f, 0x3a, 0xe9, 0x33, 0x3c, 0x07, 0x4b, 0xad, 0x80, 0xbb, 0x5d, 0x3c,
+	0x70, 0x19, 0x15, 0x49, 0x6c, 0x49, 0xc6, 0x6e, 0xe6, 0xc5, 0x36, 0x03, 0xfe, 0x12, 0x0f, 0x9c,
+	0x44, 0xae, 0x07, 0xff, 0xdd, 0x1a, 0x2b, 0xf1, 0x78, 0x90, 0x46, 0x33, 0x14, 0x87, 0x74, 0x08,
+	0x17, 0x94, 0x0d, 0x4c, 0x30, 0x79, 0x90, 0xd2, 0x17, 0x1d, 0xd3, 0x70, 0x9b, 0x32, 0x6f, 0x6c,
+	0x90, 0xfa, 0x5a, 0xf9, 0x85, 0x22, 0xc2, 0x10, 0xac, 0x8c, 0xa2, 0x50, 0x14, 0x05, 0xa4, 0x9d,
+	0x1c, 0xa7, 0x2c, 0xb3, 0xb6, 0xff, 0x98, 0x75, 0x30, 0x32, 0xe9, 0x69, 0xa3, 0x13, 0x68, 0x02,
+	0x39, 0x19, 0xa3, 0x3c, 0x86, 0x05, 0x61, 0xb8, 0x87, 0x43, 0xc1, 0xcd, 0xf9, 0xc9, 0x93, 0x91,
+	0xc6, 0x39, 0xa9, 0x67, 0x6c, 0x32, 0xfa, 0xd9, 0x3a, 0x87, 0x01, 0x58, 0x11, 0xb4, 0x8b, 0x43,
+	0xf2, 0x0e, 0xbb, 0xbc, 0x83, 0x18, 0x76, 0x99, 0xbc, 0x15, 0x6e, 0x2e, 0x4c, 0x4e, 0x3b, 0x4d,
+	0x5c, 0x27, 0xb1, 0x29, 0x7b, 0x93, 0x15, 0x91, 0xad, 0x73, 0xf8, 0x04, 0xfc, 0x97, 0x6c, 0x97,
+	0x07, 0x22, 0x5d, 0xe2, 0x99, 0x8b, 0x35, 0xa3, 0x3e, 0xed, 0xac, 0xaa, 0xad, 0x91, 0x01, 0x1c,
+	0x7a, 0xeb, 0x1d, 0x00, 0xb3, 0xbb, 0x00, 0xee, 0x81, 0x39, 0xe4, 0x79, 0x0c, 0x73, 0xb5, 0xf9,
+	0x4a, 0x4d, 0xf3, 0xcb, 0xe7, 0x9d, 0x4a, 0xd2, 0xf9, 0x81, 0xaa, 0x9c, 0x08, 0x46, 0x42, 0xdf,
+	0x19, 0x0a, 0x61, 0x05, 0xcc, 0x8c, 0xd6, 0xdb, 0x94, 0xa3, 0x1e, 0x1e, 0x17, 0xdf, 0x5f, 0x55,
+	0x0b, 0x3f, 0xaf, 0xaa, 0x85, 0xe6, 0xb3, 0xeb, 0x3b, 0xcb, 0xb8, 0xb9, 0xb3, 0x8c, 0xef, 0x77,
+	0x96, 0xf1, 0xe1, 0xde, 0x2a, 0xdc, 0xdc, 0x5b, 0x85, 0xaf, 0xf7, 0x56, 0xe1, 0xcd, 0xf6, 0xc4,
+	0x0d, 0x78, 0x99, 0xee, 0x78, 0xb9, 0x0b, 0x5b, 0xb3, 0x72, 0x59, 0xef, 0xff, 0x0e, 0x00, 0x00,
+	0xff, 0xff, 0xe4, 0x4e, 0x15, 0x28, 0x56, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +301,81 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ValidatorRetirements) > 0 {
+		for iNdEx := len(m.ValidatorRetirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRetirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ValidatorApplications) > 0 {
+		for iNdEx := len(m.ValidatorApplications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorApplications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ValidatorKeyHistory) > 0 {
+		for iNdEx := len(m.ValidatorKeyHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorKeyHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BlsKeyRotations) > 0 {
+		for iNdEx := len(m.BlsKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlsKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -427,6 +568,39 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.BlsKeyRotations) > 0 {
+		for _, e := range m.BlsKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorKeyHistory) > 0 {
+		for _, e := range m.ValidatorKeyHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorApplications) > 0 {
+		for _, e := range m.ValidatorApplications {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorRetirements) > 0 {
+		for _, e := range m.ValidatorRetirements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
 	return n
 }
 
@@ -737,6 +911,195 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsKeyRotations = append(m.BlsKeyRotations, BlsKeyRotation{})
+			if err := m.BlsKeyRotations[len(m.BlsKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKeyHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorKeyHistory = append(m.ValidatorKeyHistory, ValidatorKeyRecord{})
+			if err := m.ValidatorKeyHistory[len(m.ValidatorKeyHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorApplications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorApplications = append(m.ValidatorApplications, ValidatorApplication{})
+			if err := m.ValidatorApplications[len(m.ValidatorApplications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRetirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorRetirements = append(m.ValidatorRetirements, ValidatorRetirement{})
+			if err := m.ValidatorRetirements[len(m.ValidatorRetirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
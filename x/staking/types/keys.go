@@ -38,6 +38,14 @@ var (
 	ValidatorsByRelayerAddrKey    = []byte{0x24} // prefix for each key to a validator index, by relayer address
 	ValidatorsByChallengerAddrKey = []byte{0x25} // prefix for each key to a validator index, by challenger address
 	ValidatorsByBlsKey            = []byte{0x26} // prefix for each key to a validator index, by relayer/challenger's bls pubkey
+	BlsKeyRotationKey             = []byte{0x27} // prefix for each key to an active bls key rotation, by operator address
+	ValidatorKeyHistoryKey        = []byte{0x28} // prefix for each key to a validator key record, by operator address and height
+	RelayerAddrHistoryKey         = []byte{0x29} // prefix for each key to a validator index, by relayer address and height
+	ChallengerAddrHistoryKey      = []byte{0x2A} // prefix for each key to a validator index, by challenger address and height
+	BlsKeyHistoryKey              = []byte{0x2B} // prefix for each key to a validator index, by bls pubkey and height
+	ValidatorApplicationKey       = []byte{0x2C} // prefix for each key to a pending validator application, by operator address
+	ValidatorRetirementKey        = []byte{0x2D} // prefix for each key to a validator retirement, by operator address
+	ValidatorApplicationIndexKey  = []byte{0x2E} // prefix for each key to a pending validator application index, by the key index of the validator
 
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
@@ -54,9 +62,17 @@ var (
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
+	ValidatorApplicationQueueKey = []byte{0x44} // prefix for the end timestamps in validator application queue
+	ValidatorRetirementQueueKey  = []byte{0x45} // prefix for the end timestamps in validator retirement queue
+
 	HistoricalInfoKey   = []byte{0x50} // prefix for the historical info
 	ValidatorUpdatesKey = []byte{0x61} // prefix for the end block validator updates key
 
+	TokenizeShareRecordKey         = []byte{0x81} // prefix for each key to a tokenize share record, by id
+	TokenizeShareRecordsByOwnerKey = []byte{0x82} // prefix for each key to a tokenize share record index, by owner address and id
+	LastTokenizeShareRecordIDKey   = []byte{0x83} // key for the id of the last tokenize share record
+	ValidatorLiquidSharesKey       = []byte{0x84} // prefix for each key to the tokenized delegator shares of a validator
+
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
 )
 
@@ -114,6 +130,144 @@ func GetValidatorByBlsKey(blsPk []byte) []byte {
 	return append(ValidatorsByBlsKey, address.MustLengthPrefix(blsPk)...)
 }
 
+// GetBlsKeyRotationKey creates the key for the active bls key rotation of the validator with address
+// VALUE: staking/BlsKeyRotation
+func GetBlsKeyRotationKey(operatorAddr sdk.AccAddress) []byte {
+	return append(BlsKeyRotationKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorKeyHistoryKey creates the key for the key record of the validator with address effective from the height
+// VALUE: staking/ValidatorKeyRecord
+func GetValidatorKeyHistoryKey(operatorAddr sdk.AccAddress, height int64) []byte {
+	return append(GetValidatorKeyHistoryPrefix(operatorAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetValidatorKeyHistoryPrefix creates the prefix for all the key records of the validator with address
+func GetValidatorKeyHistoryPrefix(operatorAddr sdk.AccAddress) []byte {
+	return append(ValidatorKeyHistoryKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetRelayerAddrHistoryKey creates the key for the validator with relayer address from the height
+// VALUE: validator operator address ([]byte)
+func GetRelayerAddrHistoryKey(relayerAddr sdk.AccAddress, height int64) []byte {
+	return append(GetRelayerAddrHistoryPrefix(relayerAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetRelayerAddrHistoryPrefix creates the prefix for all the validators with relayer address
+func GetRelayerAddrHistoryPrefix(relayerAddr sdk.AccAddress) []byte {
+	return append(RelayerAddrHistoryKey, address.MustLengthPrefix(relayerAddr)...)
+}
+
+// GetChallengerAddrHistoryKey creates the key for the validator with challenger address from the height
+// VALUE: validator operator address ([]byte)
+func GetChallengerAddrHistoryKey(challengerAddr sdk.AccAddress, height int64) []byte {
+	return append(GetChallengerAddrHistoryPrefix(challengerAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetChallengerAddrHistoryPrefix creates the prefix for all the validators with challenger address
+func GetChallengerAddrHistoryPrefix(challengerAddr sdk.AccAddress) []byte {
+	return append(ChallengerAddrHistoryKey, address.MustLengthPrefix(challengerAddr)...)
+}
+
+// GetBlsKeyHistoryKey creates the key for the validator with bls pubkey from the height
+// VALUE: validator operator address ([]byte)
+func GetBlsKeyHistoryKey(blsPk []byte, height int64) []byte {
+	return append(GetBlsKeyHistoryPrefix(blsPk), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetBlsKeyHistoryPrefix creates the prefix for all the validators with bls pubkey
+func GetBlsKeyHistoryPrefix(blsPk []byte) []byte {
+	return append(BlsKeyHistoryKey, address.MustLengthPrefix(blsPk)...)
+}
+
+// GetValidatorApplicationKey creates the key for the pending application of the validator with address
+// VALUE: staking/ValidatorApplication
+func GetValidatorApplicationKey(operatorAddr sdk.AccAddress) []byte {
+	return append(ValidatorApplicationKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorApplicationIndexKey creates the key for the pending application of the validator reserving the key
+// of the validator index, e.g. GetValidatorByRelayerAddrKey(relayerAddr)
+// VALUE: validator operator address ([]byte)
+func GetValidatorApplicationIndexKey(indexKey []byte) []byte {
+	return append(ValidatorApplicationIndexKey, indexKey...)
+}
+
+// GetValidatorApplicationQueueKey creates the key for the pending application of the validator with address in
+// the validator application queue
+// VALUE: none
+func GetValidatorApplicationQueueKey(endTime time.Time, operatorAddr sdk.AccAddress) []byte {
+	return append(GetValidatorApplicationQueueTimeKey(endTime), address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorApplicationQueueTimeKey creates the prefix for the pending validator applications ending at the time
+func GetValidatorApplicationQueueTimeKey(endTime time.Time) []byte {
+	bz := sdk.FormatTimeBytes(endTime)
+	return append(ValidatorApplicationQueueKey, bz...)
+}
+
+// AddressFromValidatorApplicationQueueKey creates the validator operator address from ValidatorApplicationQueueKey
+func AddressFromValidatorApplicationQueueKey(key []byte) []byte {
+	offset := len(ValidatorApplicationQueueKey) + len(sdk.FormatTimeBytes(time.Time{}))
+	kv.AssertKeyAtLeastLength(key, offset+2)
+	return key[offset+1:] // remove prefix bytes, end time bytes and address length
+}
+
+// GetValidatorRetirementKey creates the key for the retirement of the validator with address
+// VALUE: staking/ValidatorRetirement
+func GetValidatorRetirementKey(operatorAddr sdk.AccAddress) []byte {
+	return append(ValidatorRetirementKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorRetirementQueueKey creates the key for the retirement of the validator with address in the
+// validator retirement queue
+// VALUE: none
+func GetValidatorRetirementQueueKey(endTime time.Time, operatorAddr sdk.AccAddress) []byte {
+	return append(GetValidatorRetirementQueueTimeKey(endTime), address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorRetirementQueueTimeKey creates the prefix for the validator retirements ending at the time
+func GetValidatorRetirementQueueTimeKey(endTime time.Time) []byte {
+	bz := sdk.FormatTimeBytes(endTime)
+	return append(ValidatorRetirementQueueKey, bz...)
+}
+
+// AddressFromValidatorRetirementQueueKey creates the validator operator address from ValidatorRetirementQueueKey
+func AddressFromValidatorRetirementQueueKey(key []byte) []byte {
+	offset := len(ValidatorRetirementQueueKey) + len(sdk.FormatTimeBytes(time.Time{}))
+	kv.AssertKeyAtLeastLength(key, offset+2)
+	return key[offset+1:] // remove prefix bytes, end time bytes and address length
+}
+
+// GetTokenizeShareRecordKey creates the key for the tokenize share record with id
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordsByOwnerPrefix creates the prefix for the tokenize share records owned by the address
+func GetTokenizeShareRecordsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordsByOwnerKey, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordByOwnerKey creates the key for the tokenize share record with id owned by the address
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetValidatorLiquidSharesKey creates the key for the tokenized delegator shares of the validator with address
+// VALUE: sdk.Dec
+func GetValidatorLiquidSharesKey(operatorAddr sdk.AccAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// AddressFromValidatorLiquidSharesKey creates the validator operator address from ValidatorLiquidSharesKey
+func AddressFromValidatorLiquidSharesKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
 // AddressFromValidatorsKey creates the validator operator address from ValidatorsKey
 func AddressFromValidatorsKey(key []byte) []byte {
 	kv.AssertKeyAtLeastLength(key, 3)
@@ -123,6 +123,23 @@ func TestMsgCreateValidator(t *testing.T) {
 	}
 }
 
+func TestMsgCreateValidatorGetSigners(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	newMsg := func(from, relayer, challenger sdk.AccAddress) *types.MsgCreateValidator {
+		msg, err := types.NewMsgCreateValidator(
+			valAddr1, pk1, coinPos, types.NewDescription("a", "b", "c", "d", "e"),
+			types.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+			from, valAddr1, relayer, challenger, "", "",
+		)
+		require.NoError(t, err)
+		return msg
+	}
+
+	// the relayer and the challenger prove themselves with the relayer and challenger proofs rather than signing
+	require.Equal(t, []sdk.AccAddress{valAddr1}, newMsg(valAddr1, valAddr2, valAddr3).GetSigners())
+	require.Equal(t, []sdk.AccAddress{govAddr}, newMsg(govAddr, valAddr2, valAddr3).GetSigners())
+}
+
 // test ValidateBasic for MsgEditValidator
 func TestMsgEditValidator(t *testing.T) {
 	tests := []struct {
@@ -30,6 +30,18 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultBlsKeyOverlapBlocks is the number of blocks the previous bls key of a validator
+	// is still accepted after it is rotated.
+	DefaultBlsKeyOverlapBlocks uint64 = 100
+
+	// DefaultValidatorApplicationPeriod is the time duration governance or the active validators can
+	// approve or veto the application of a validator created without governance.
+	DefaultValidatorApplicationPeriod time.Duration = time.Hour * 24 * 7
+
+	// DefaultValidatorRetirementCooldown is the time duration a retiring validator keeps its relayer
+	// and challenger duties.
+	DefaultValidatorRetirementCooldown time.Duration = time.Hour * 24
 )
 
 // DefaultMinCommissionRate is set to 0%
@@ -38,16 +50,36 @@ var DefaultMinCommissionRate = math.LegacyZeroDec()
 // DefaultMinSelfDelegation defines the minimum self delegation for all validators
 var DefaultMinSelfDelegation = sdk.OneInt()
 
+// DefaultMinPermissionlessSelfDelegation is zero, the validators can only be created by governance by default
+var DefaultMinPermissionlessSelfDelegation = sdk.ZeroInt()
+
+// DefaultGlobalLiquidStakingCap is set to 25%
+var DefaultGlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+
+// DefaultValidatorLiquidStakingCap is set to 50%
+var DefaultValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec, minSelfDelegation math.Int) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	minCommissionRate sdk.Dec, minSelfDelegation math.Int, blsKeyOverlapBlocks uint64,
+	minPermissionlessSelfDelegation math.Int, validatorApplicationPeriod, validatorRetirementCooldown time.Duration,
+	globalLiquidStakingCap, validatorLiquidStakingCap sdk.Dec,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
-		MinSelfDelegation: minSelfDelegation,
+		UnbondingTime:                   unbondingTime,
+		MaxValidators:                   maxValidators,
+		MaxEntries:                      maxEntries,
+		HistoricalEntries:               historicalEntries,
+		BondDenom:                       bondDenom,
+		MinCommissionRate:               minCommissionRate,
+		MinSelfDelegation:               minSelfDelegation,
+		BlsKeyOverlapBlocks:             blsKeyOverlapBlocks,
+		MinPermissionlessSelfDelegation: minPermissionlessSelfDelegation,
+		ValidatorApplicationPeriod:      validatorApplicationPeriod,
+		ValidatorRetirementCooldown:     validatorRetirementCooldown,
+		GlobalLiquidStakingCap:          globalLiquidStakingCap,
+		ValidatorLiquidStakingCap:       validatorLiquidStakingCap,
 	}
 }
 
@@ -61,6 +93,12 @@ func DefaultParams() Params {
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
 		DefaultMinSelfDelegation,
+		DefaultBlsKeyOverlapBlocks,
+		DefaultMinPermissionlessSelfDelegation,
+		DefaultValidatorApplicationPeriod,
+		DefaultValidatorRetirementCooldown,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
 	)
 }
 
@@ -120,6 +158,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateBlsKeyOverlapBlocks(p.BlsKeyOverlapBlocks); err != nil {
+		return err
+	}
+
+	if err := validateMinPermissionlessSelfDelegation(p.MinPermissionlessSelfDelegation); err != nil {
+		return err
+	}
+
+	if err := validateValidatorApplicationPeriod(p.ValidatorApplicationPeriod); err != nil {
+		return err
+	}
+
+	if err := validateValidatorRetirementCooldown(p.ValidatorRetirementCooldown); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -232,3 +294,81 @@ func validateMinSelfDelegation(i interface{}) error {
 
 	return nil
 }
+
+func validateBlsKeyOverlapBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("bls key overlap blocks must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinPermissionlessSelfDelegation(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the parameter is absent in the params stored before it was introduced
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("minimum permissionless self delegation cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateValidatorApplicationPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("validator application period must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateValidatorRetirementCooldown(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("validator retirement cooldown must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the parameter is absent in the params stored before it was introduced
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
@@ -35,4 +35,19 @@ func TestValidateParams(t *testing.T) {
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate bls key overlap blocks
+	params = types.DefaultParams()
+	params.BlsKeyOverlapBlocks = 0
+	require.Error(t, params.Validate())
+
+	// validate validator application period
+	params = types.DefaultParams()
+	params.ValidatorApplicationPeriod = 0
+	require.Error(t, params.Validate())
+
+	// validate validator retirement cooldown
+	params = types.DefaultParams()
+	params.ValidatorRetirementCooldown = 0
+	require.Error(t, params.Validate())
 }
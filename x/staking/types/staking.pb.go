@@ -932,6 +932,25 @@ type Params struct {
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
 	// min_self_delegation defines the minimum self delegation for validators.
 	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation"`
+	// bls_key_overlap_blocks defines the number of blocks the previous bls key of a validator is still
+	// accepted after the key is rotated, the previous key is accepted until the next historical info
+	// entry if it is zero.
+	BlsKeyOverlapBlocks uint64 `protobuf:"varint,8,opt,name=bls_key_overlap_blocks,json=blsKeyOverlapBlocks,proto3" json:"bls_key_overlap_blocks,omitempty"`
+	// min_permissionless_self_delegation defines the minimum self delegation of the validators created without
+	// governance, the validators can only be created by governance if it is zero.
+	MinPermissionlessSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=min_permissionless_self_delegation,json=minPermissionlessSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_permissionless_self_delegation"`
+	// validator_application_period defines the time duration governance or the active validators can approve or
+	// veto the application of a validator created without governance.
+	ValidatorApplicationPeriod time.Duration `protobuf:"bytes,10,opt,name=validator_application_period,json=validatorApplicationPeriod,proto3,stdduration" json:"validator_application_period"`
+	// validator_retirement_cooldown defines the time duration a retiring validator keeps its relayer and challenger
+	// duties before it leaves the validator set and its keys are released.
+	ValidatorRetirementCooldown time.Duration `protobuf:"bytes,11,opt,name=validator_retirement_cooldown,json=validatorRetirementCooldown,proto3,stdduration" json:"validator_retirement_cooldown"`
+	// global_liquid_staking_cap defines the maximum fraction of the total bonded tokens that can be tokenized,
+	// the delegations can't be tokenized if it is zero.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap defines the maximum fraction of the delegator shares of a validator that can be
+	// tokenized, the delegations can't be tokenized if it is zero.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -1001,6 +1020,428 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetBlsKeyOverlapBlocks() uint64 {
+	if m != nil {
+		return m.BlsKeyOverlapBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorApplicationPeriod() time.Duration {
+	if m != nil {
+		return m.ValidatorApplicationPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorRetirementCooldown() time.Duration {
+	if m != nil {
+		return m.ValidatorRetirementCooldown
+	}
+	return 0
+}
+
+// BlsKeyRotation defines the rotation of the bls key of a validator, both the previous and the new bls
+// keys are accepted until the rotation expires.
+type BlsKeyRotation struct {
+	// validator_address defines the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// prev_bls_key defines the bls pubkey which is retired when the rotation expires.
+	PrevBlsKey []byte `protobuf:"bytes,2,opt,name=prev_bls_key,json=prevBlsKey,proto3" json:"prev_bls_key,omitempty"`
+	// bls_key defines the new bls pubkey of the validator.
+	BlsKey []byte `protobuf:"bytes,3,opt,name=bls_key,json=blsKey,proto3" json:"bls_key,omitempty"`
+	// height defines the height at which the bls key was rotated.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// expiry_height defines the height from which the previous bls key is no longer accepted.
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *BlsKeyRotation) Reset()         { *m = BlsKeyRotation{} }
+func (m *BlsKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*BlsKeyRotation) ProtoMessage()    {}
+func (*BlsKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
+}
+func (m *BlsKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlsKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlsKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlsKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlsKeyRotation.Merge(m, src)
+}
+func (m *BlsKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlsKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlsKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlsKeyRotation proto.InternalMessageInfo
+
+func (m *BlsKeyRotation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *BlsKeyRotation) GetPrevBlsKey() []byte {
+	if m != nil {
+		return m.PrevBlsKey
+	}
+	return nil
+}
+
+func (m *BlsKeyRotation) GetBlsKey() []byte {
+	if m != nil {
+		return m.BlsKey
+	}
+	return nil
+}
+
+func (m *BlsKeyRotation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlsKeyRotation) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// ValidatorApplication defines the application of a validator created without governance, the self delegation
+// is escrowed until the validator is activated at the end of the application period unless it is approved or
+// vetoed before that.
+type ValidatorApplication struct {
+	// validator defines the validator to be activated.
+	Validator Validator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator"`
+	// delegator_address defines the address of the self delegator.
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// value defines the escrowed self delegation.
+	Value types2.Coin `protobuf:"bytes,3,opt,name=value,proto3" json:"value"`
+	// end_time defines the time at which the validator is activated if it is not vetoed.
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// approvals defines the operator addresses of the active validators which approved the application.
+	Approvals []string `protobuf:"bytes,5,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// vetoes defines the operator addresses of the active validators which vetoed the application.
+	Vetoes []string `protobuf:"bytes,6,rep,name=vetoes,proto3" json:"vetoes,omitempty"`
+}
+
+func (m *ValidatorApplication) Reset()         { *m = ValidatorApplication{} }
+func (m *ValidatorApplication) String() string { return proto.CompactTextString(m) }
+func (*ValidatorApplication) ProtoMessage()    {}
+func (*ValidatorApplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
+}
+func (m *ValidatorApplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorApplication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorApplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorApplication.Merge(m, src)
+}
+func (m *ValidatorApplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorApplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorApplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorApplication proto.InternalMessageInfo
+
+func (m *ValidatorApplication) GetValidator() Validator {
+	if m != nil {
+		return m.Validator
+	}
+	return Validator{}
+}
+
+func (m *ValidatorApplication) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorApplication) GetValue() types2.Coin {
+	if m != nil {
+		return m.Value
+	}
+	return types2.Coin{}
+}
+
+func (m *ValidatorApplication) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *ValidatorApplication) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *ValidatorApplication) GetVetoes() []string {
+	if m != nil {
+		return m.Vetoes
+	}
+	return nil
+}
+
+// ValidatorRetirement defines the retirement of a validator, the validator keeps its relayer and challenger
+// duties until the end of the cooldown, and then it's jailed and its bls key, relayer and challenger addresses
+// are released.
+type ValidatorRetirement struct {
+	// validator_address defines the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// end_time defines the time at which the cooldown ends.
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// retired defines whether the cooldown has ended and the validator is retired.
+	Retired bool `protobuf:"varint,3,opt,name=retired,proto3" json:"retired,omitempty"`
+}
+
+func (m *ValidatorRetirement) Reset()         { *m = ValidatorRetirement{} }
+func (m *ValidatorRetirement) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRetirement) ProtoMessage()    {}
+func (*ValidatorRetirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
+}
+func (m *ValidatorRetirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRetirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRetirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRetirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRetirement.Merge(m, src)
+}
+func (m *ValidatorRetirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRetirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRetirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRetirement proto.InternalMessageInfo
+
+func (m *ValidatorRetirement) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorRetirement) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *ValidatorRetirement) GetRetired() bool {
+	if m != nil {
+		return m.Retired
+	}
+	return false
+}
+
+// TokenizeShareRecord defines a tokenized delegation. The delegation is held by the record account, and the
+// share tokens of the record are minted 1:1 to the shares of the delegation. The rewards of the delegation are
+// withdrawn by the owner of the record.
+type TokenizeShareRecord struct {
+	// id defines the id of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner defines the address of the owner of the record who withdraws the rewards.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account defines the name the address of the record account is derived from.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator defines the operator address of the validator of the delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// ValidatorKeyRecord defines the relayer address, the challenger address and the bls key of a validator
+// effective from a height, a record is added whenever any of them is changed.
+type ValidatorKeyRecord struct {
+	// validator_address defines the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height defines the height from which the keys are effective, the keys of the validators created
+	// before the history was recorded are recorded at the height of the upgrade.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// relayer_address defines the relayer address of the validator, it's empty if the validator was removed.
+	RelayerAddress string `protobuf:"bytes,3,opt,name=relayer_address,json=relayerAddress,proto3" json:"relayer_address,omitempty"`
+	// challenger_address defines the challenger address of the validator, it's empty if the validator was removed.
+	ChallengerAddress string `protobuf:"bytes,4,opt,name=challenger_address,json=challengerAddress,proto3" json:"challenger_address,omitempty"`
+	// bls_key defines the bls pubkey of the validator, it's empty if the validator was removed.
+	BlsKey []byte `protobuf:"bytes,5,opt,name=bls_key,json=blsKey,proto3" json:"bls_key,omitempty"`
+}
+
+func (m *ValidatorKeyRecord) Reset()         { *m = ValidatorKeyRecord{} }
+func (m *ValidatorKeyRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorKeyRecord) ProtoMessage()    {}
+func (*ValidatorKeyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *ValidatorKeyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorKeyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorKeyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorKeyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorKeyRecord.Merge(m, src)
+}
+func (m *ValidatorKeyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorKeyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorKeyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorKeyRecord proto.InternalMessageInfo
+
+func (m *ValidatorKeyRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorKeyRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValidatorKeyRecord) GetRelayerAddress() string {
+	if m != nil {
+		return m.RelayerAddress
+	}
+	return ""
+}
+
+func (m *ValidatorKeyRecord) GetChallengerAddress() string {
+	if m != nil {
+		return m.ChallengerAddress
+	}
+	return ""
+}
+
+func (m *ValidatorKeyRecord) GetBlsKey() []byte {
+	if m != nil {
+		return m.BlsKey
+	}
+	return nil
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1011,7 +1452,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,7 +1507,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,7 +1555,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1168,7 +1609,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1207,7 +1648,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1262,6 +1703,11 @@ func init() {
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
 	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*BlsKeyRotation)(nil), "cosmos.staking.v1beta1.BlsKeyRotation")
+	proto.RegisterType((*ValidatorApplication)(nil), "cosmos.staking.v1beta1.ValidatorApplication")
+	proto.RegisterType((*ValidatorRetirement)(nil), "cosmos.staking.v1beta1.ValidatorRetirement")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*ValidatorKeyRecord)(nil), "cosmos.staking.v1beta1.ValidatorKeyRecord")
 	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
@@ -1274,130 +1720,159 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1966 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x52, 0x0c, 0x25, 0x3e, 0x52, 0x22, 0x39, 0x76, 0x6c, 0x9a, 0x6e, 0x45, 0x86, 0x49,
-	0x13, 0xc5, 0x88, 0xa9, 0xda, 0x05, 0x7a, 0x50, 0x83, 0x16, 0xa2, 0x28, 0xdb, 0x4c, 0x1c, 0x89,
-	0x58, 0x4a, 0x4a, 0xd3, 0xa2, 0x58, 0x0c, 0x77, 0x47, 0xd4, 0x56, 0xfb, 0x43, 0xec, 0x0c, 0x1d,
-	0x11, 0xe8, 0xa1, 0x28, 0x50, 0xc0, 0xd0, 0xa1, 0x08, 0xd0, 0x4b, 0x2e, 0x06, 0x0c, 0x34, 0x87,
-	0x1e, 0x52, 0x20, 0x87, 0xa0, 0xd7, 0xa2, 0x87, 0x02, 0x69, 0x2f, 0x35, 0x72, 0x2a, 0x8a, 0x42,
-	0x2d, 0xec, 0x43, 0x8a, 0x9e, 0x8a, 0xde, 0x5b, 0x14, 0x33, 0x3b, 0xfb, 0x43, 0x52, 0xb4, 0x24,
-	0x43, 0x09, 0x02, 0xe4, 0x22, 0x71, 0x66, 0xde, 0xfb, 0xf6, 0xbd, 0xef, 0xfd, 0xcc, 0x0f, 0xbc,
-	0xa4, 0xbb, 0xd4, 0x76, 0xe9, 0x32, 0x65, 0x78, 0xdf, 0x74, 0x7a, 0xcb, 0xf7, 0x6e, 0x74, 0x09,
-	0xc3, 0x37, 0x82, 0x71, 0xbd, 0xef, 0xb9, 0xcc, 0x45, 0x97, 0x7c, 0xa9, 0x7a, 0x30, 0x2b, 0xa5,
-	0xca, 0x17, 0x7b, 0x6e, 0xcf, 0x15, 0x22, 0xcb, 0xfc, 0x97, 0x2f, 0x5d, 0xbe, 0xd2, 0x73, 0xdd,
-	0x9e, 0x45, 0x96, 0xc5, 0xa8, 0x3b, 0xd8, 0x5d, 0xc6, 0xce, 0x50, 0x2e, 0x2d, 0x8e, 0x2f, 0x19,
-	0x03, 0x0f, 0x33, 0xd3, 0x75, 0xe4, 0x7a, 0x65, 0x7c, 0x9d, 0x99, 0x36, 0xa1, 0x0c, 0xdb, 0xfd,
-	0x00, 0xdb, 0xb7, 0x44, 0xf3, 0x3f, 0x2a, 0xcd, 0x92, 0xd8, 0xd2, 0x95, 0x2e, 0xa6, 0x24, 0xf4,
-	0x43, 0x77, 0xcd, 0x00, 0xbb, 0x88, 0x6d, 0xd3, 0x71, 0x97, 0xc5, 0x5f, 0x39, 0xf5, 0x35, 0x46,
-	0x1c, 0x83, 0x78, 0xb6, 0xe9, 0xb0, 0x65, 0x36, 0xec, 0x13, 0xea, 0xff, 0x95, 0xab, 0x57, 0x63,
-	0xab, 0xb8, 0xab, 0x9b, 0xf1, 0xc5, 0xda, 0x2f, 0x15, 0x58, 0xb8, 0x63, 0x52, 0xe6, 0x7a, 0xa6,
-	0x8e, 0xad, 0x96, 0xb3, 0xeb, 0xa2, 0xef, 0x40, 0x7a, 0x8f, 0x60, 0x83, 0x78, 0x25, 0xa5, 0xaa,
-	0x2c, 0x65, 0x6f, 0x96, 0xea, 0x11, 0x40, 0xdd, 0xd7, 0xbd, 0x23, 0xd6, 0x1b, 0x99, 0x4f, 0x8e,
-	0x2a, 0x89, 0x5f, 0x7f, 0xf6, 0xd1, 0x35, 0x45, 0x95, 0x2a, 0xa8, 0x09, 0xe9, 0x7b, 0xd8, 0xa2,
-	0x84, 0x95, 0x92, 0xd5, 0x99, 0xa5, 0xec, 0xcd, 0x17, 0xea, 0xc7, 0x73, 0x5e, 0xdf, 0xc1, 0x96,
-	0x69, 0x60, 0xe6, 0x8e, 0xa2, 0xf8, 0xba, 0xb5, 0x0f, 0x93, 0x90, 0x5f, 0x73, 0x6d, 0xdb, 0xa4,
-	0xd4, 0x74, 0x1d, 0x15, 0x33, 0x42, 0x51, 0x1b, 0x52, 0x1e, 0x66, 0x44, 0x18, 0x95, 0x69, 0xbc,
-	0xce, 0x95, 0xfe, 0x7a, 0x54, 0x79, 0xb9, 0x67, 0xb2, 0xbd, 0x41, 0xb7, 0xae, 0xbb, 0xb6, 0xa4,
-	0x51, 0xfe, 0xbb, 0x4e, 0x8d, 0x7d, 0xe9, 0x69, 0x93, 0xe8, 0x9f, 0x7e, 0x7c, 0x1d, 0xa4, 0x21,
-	0x4d, 0xa2, 0xab, 0x02, 0x09, 0xbd, 0x0d, 0x73, 0x36, 0x3e, 0xd0, 0x04, 0x6a, 0xf2, 0x1c, 0x50,
-	0x67, 0x6d, 0x7c, 0xc0, 0x6d, 0x45, 0x06, 0xe4, 0x39, 0xb0, 0xbe, 0x87, 0x9d, 0x1e, 0xf1, 0xf1,
-	0x67, 0xce, 0x01, 0x7f, 0xde, 0xc6, 0x07, 0x6b, 0x02, 0x93, 0x7f, 0x65, 0x65, 0xee, 0xfd, 0x87,
-	0x95, 0xc4, 0x3f, 0x1f, 0x56, 0x94, 0xda, 0x1f, 0x14, 0x80, 0x88, 0x2e, 0x84, 0xa1, 0xa0, 0x87,
-	0x23, 0xf1, 0x79, 0x2a, 0x43, 0xf9, 0xca, 0xb4, 0x68, 0x8c, 0x91, 0xdd, 0x98, 0xe7, 0x86, 0x3e,
-	0x3a, 0xaa, 0x28, 0x7e, 0x5c, 0xf2, 0xfa, 0x58, 0x30, 0xde, 0x80, 0xec, 0xa0, 0x6f, 0x60, 0x46,
-	0x34, 0x9e, 0xd9, 0x82, 0xbd, 0xec, 0xcd, 0x72, 0xdd, 0x4f, 0xfb, 0x7a, 0x90, 0xf6, 0xf5, 0xad,
-	0x20, 0xed, 0x7d, 0xc0, 0xf7, 0xfe, 0x1e, 0x00, 0x82, 0xaf, 0xcd, 0xd7, 0x63, 0x7e, 0x7c, 0xa8,
-	0x40, 0xb6, 0x49, 0xa8, 0xee, 0x99, 0x7d, 0x5e, 0x4c, 0xa8, 0x04, 0xb3, 0xb6, 0xeb, 0x98, 0xfb,
-	0x32, 0x15, 0x33, 0x6a, 0x30, 0x44, 0x65, 0x98, 0x33, 0x0d, 0xe2, 0x30, 0x93, 0x0d, 0xfd, 0xd0,
-	0xa9, 0xe1, 0x98, 0x6b, 0xbd, 0x4b, 0xba, 0xd4, 0x0c, 0x58, 0x57, 0x83, 0x21, 0x7a, 0x15, 0x0a,
-	0x94, 0xe8, 0x03, 0xcf, 0x64, 0x43, 0x4d, 0x77, 0x1d, 0x86, 0x75, 0x56, 0x4a, 0x09, 0x91, 0x7c,
-	0x30, 0xbf, 0xe6, 0x4f, 0x73, 0x10, 0x83, 0x30, 0x6c, 0x5a, 0xb4, 0xf4, 0x9c, 0x0f, 0x22, 0x87,
-	0x31, 0x73, 0x3f, 0xc8, 0x40, 0x26, 0x4c, 0x63, 0xb4, 0x06, 0x05, 0xb7, 0x4f, 0x3c, 0xfe, 0x5b,
-	0xc3, 0x86, 0xe1, 0x11, 0x4a, 0x65, 0xae, 0x96, 0x3e, 0xfd, 0xf8, 0xfa, 0x45, 0x49, 0xfc, 0xaa,
-	0xbf, 0xd2, 0x61, 0x9e, 0xe9, 0xf4, 0xd4, 0x7c, 0xa0, 0x21, 0xa7, 0xd1, 0x3b, 0x3c, 0x74, 0x0e,
-	0x25, 0x0e, 0x1d, 0x50, 0xad, 0x3f, 0xe8, 0xee, 0x93, 0xa1, 0x24, 0xf7, 0xe2, 0x04, 0xb9, 0xab,
-	0xce, 0xb0, 0x51, 0xfa, 0x53, 0x04, 0xad, 0x7b, 0xc3, 0x3e, 0x73, 0xeb, 0xed, 0x41, 0xf7, 0x4d,
-	0x32, 0xe4, 0x21, 0x93, 0x38, 0x6d, 0x01, 0x83, 0x2e, 0x41, 0xfa, 0xc7, 0xd8, 0xb4, 0x88, 0x21,
-	0x58, 0x99, 0x53, 0xe5, 0x08, 0xad, 0x40, 0x9a, 0x32, 0xcc, 0x06, 0x54, 0x50, 0xb1, 0x70, 0xb3,
-	0x36, 0x2d, 0x47, 0x1a, 0xae, 0x63, 0x74, 0x84, 0xa4, 0x2a, 0x35, 0xd0, 0x16, 0xa4, 0x99, 0xbb,
-	0x4f, 0x1c, 0x49, 0xd2, 0x99, 0xf2, 0xbb, 0xe5, 0xb0, 0x58, 0x7e, 0xb7, 0x1c, 0xa6, 0x4a, 0x2c,
-	0xd4, 0x83, 0x82, 0x41, 0x2c, 0xd2, 0x13, 0x54, 0xd2, 0x3d, 0xec, 0x11, 0x5a, 0x4a, 0x9f, 0x43,
-	0xfd, 0xe4, 0x43, 0xd4, 0x8e, 0x00, 0x45, 0x6d, 0xc8, 0x1a, 0x51, 0xba, 0x95, 0x66, 0x05, 0xd1,
-	0x2f, 0x4e, 0xf3, 0x3f, 0x96, 0x99, 0xf1, 0x9e, 0x15, 0x87, 0xe0, 0x19, 0x36, 0x70, 0xba, 0xae,
-	0x63, 0x98, 0x4e, 0x4f, 0xdb, 0x23, 0x66, 0x6f, 0x8f, 0x95, 0xe6, 0xaa, 0xca, 0xd2, 0x8c, 0x9a,
-	0x0f, 0xe7, 0xef, 0x88, 0x69, 0xd4, 0x86, 0x85, 0x48, 0x54, 0x54, 0x51, 0xe6, 0xac, 0x55, 0x34,
-	0x1f, 0x02, 0x70, 0x11, 0xf4, 0x16, 0x40, 0x54, 0xa7, 0x25, 0x10, 0x68, 0xb5, 0x93, 0x2b, 0x3e,
-	0xee, 0x4c, 0x0c, 0x00, 0x59, 0x70, 0xc1, 0x36, 0x1d, 0x8d, 0x12, 0x6b, 0x57, 0x93, 0xcc, 0x71,
-	0xdc, 0xec, 0x39, 0x44, 0xba, 0x68, 0x9b, 0x4e, 0x87, 0x58, 0xbb, 0xcd, 0x10, 0x16, 0xbd, 0x0e,
-	0x57, 0x23, 0x3a, 0x5c, 0x47, 0xdb, 0x73, 0x2d, 0x43, 0xf3, 0xc8, 0xae, 0xa6, 0xbb, 0x03, 0x87,
-	0x95, 0x72, 0x82, 0xc4, 0xcb, 0xa1, 0xc8, 0xa6, 0x73, 0xc7, 0xb5, 0x0c, 0x95, 0xec, 0xae, 0xf1,
-	0x65, 0xf4, 0x22, 0x44, 0x5c, 0x68, 0xa6, 0x41, 0x4b, 0xf3, 0xd5, 0x99, 0xa5, 0x94, 0x9a, 0x0b,
-	0x27, 0x5b, 0x06, 0x45, 0x0d, 0x5e, 0xfe, 0xbe, 0x33, 0x61, 0x85, 0x2e, 0x9c, 0x50, 0xa1, 0x0b,
-	0xd4, 0x37, 0x33, 0x28, 0xd0, 0x55, 0xc8, 0x7b, 0xc4, 0xc2, 0x43, 0x12, 0x15, 0x79, 0xfe, 0x24,
-	0x08, 0xa9, 0x10, 0x40, 0xdc, 0x06, 0xa4, 0xef, 0x61, 0xcb, 0x22, 0x4e, 0x2f, 0x86, 0x52, 0x38,
-	0x01, 0xa5, 0x18, 0xe9, 0x04, 0x40, 0x97, 0x61, 0xb6, 0x6b, 0x51, 0x8d, 0xf7, 0x88, 0x62, 0x55,
-	0x59, 0xca, 0xa9, 0xe9, 0xae, 0x45, 0xdf, 0x24, 0xc3, 0x95, 0xdc, 0xfd, 0x87, 0x95, 0x84, 0x6c,
-	0x53, 0x89, 0x5a, 0x1b, 0x72, 0x3b, 0x38, 0x70, 0x80, 0x50, 0xf4, 0x6d, 0xc8, 0xe0, 0x60, 0x50,
-	0x52, 0xaa, 0x33, 0x4f, 0xfd, 0x6c, 0x24, 0xea, 0x37, 0xbe, 0x9f, 0xfe, 0xad, 0xaa, 0xd4, 0x3e,
-	0x50, 0x20, 0xdd, 0xdc, 0x69, 0x63, 0xd3, 0x43, 0xeb, 0x50, 0x8c, 0x6a, 0xf5, 0xb4, 0x6d, 0x2f,
-	0x2a, 0xef, 0xc0, 0x95, 0x75, 0x28, 0xde, 0x0b, 0x3a, 0x69, 0x08, 0x93, 0x3c, 0x09, 0x26, 0x54,
-	0x91, 0xf3, 0x63, 0x8e, 0xbf, 0x01, 0xb3, 0xbe, 0x95, 0x14, 0x7d, 0x0f, 0x9e, 0xeb, 0xf3, 0x1f,
-	0xc2, 0xdf, 0xec, 0xcd, 0xc5, 0xa9, 0x35, 0x2e, 0xe4, 0xe3, 0x15, 0xe1, 0xeb, 0xd5, 0xfe, 0xab,
-	0x00, 0x34, 0x77, 0x76, 0xb6, 0x3c, 0xb3, 0x6f, 0x11, 0x76, 0x5e, 0x6e, 0xdf, 0x85, 0xe7, 0x23,
-	0xb7, 0xa9, 0xa7, 0x9f, 0xda, 0xf5, 0x0b, 0xa1, 0x5a, 0xc7, 0xd3, 0x8f, 0x45, 0x33, 0x28, 0x0b,
-	0xd1, 0x66, 0x4e, 0x8d, 0xd6, 0xa4, 0xec, 0x78, 0x2e, 0xbf, 0x0f, 0xd9, 0xc8, 0x7d, 0x8a, 0x5a,
-	0x30, 0xc7, 0xe4, 0x6f, 0x49, 0x69, 0x6d, 0x3a, 0xa5, 0x81, 0x5a, 0x9c, 0xd6, 0x50, 0xbd, 0xf6,
-	0x3f, 0xce, 0x6c, 0xd4, 0x07, 0xbe, 0x54, 0x09, 0xc5, 0x37, 0x38, 0xb9, 0x01, 0x9d, 0xc7, 0x01,
-	0x4e, 0x62, 0x8d, 0x51, 0x7b, 0x3f, 0x09, 0x17, 0xb6, 0x83, 0x3e, 0xf5, 0xa5, 0x65, 0x62, 0x1b,
-	0x66, 0x89, 0xc3, 0x3c, 0x53, 0x50, 0xc1, 0x03, 0xfe, 0xcd, 0x69, 0x01, 0x3f, 0xc6, 0x97, 0x75,
-	0x87, 0x79, 0xc3, 0x78, 0xf8, 0x03, 0xac, 0x31, 0x2a, 0x7e, 0x3f, 0x03, 0xa5, 0x69, 0xea, 0xe8,
-	0x15, 0xc8, 0xeb, 0x1e, 0x11, 0x13, 0xc1, 0xd6, 0xaa, 0x88, 0x5d, 0x61, 0x21, 0x98, 0x96, 0x3b,
-	0xab, 0x0a, 0xfc, 0xbc, 0xca, 0xb3, 0x8b, 0x8b, 0x3e, 0xdb, 0x01, 0x75, 0x21, 0x42, 0x10, 0x7b,
-	0x2b, 0x81, 0xbc, 0xe9, 0x98, 0xcc, 0xc4, 0x96, 0xd6, 0xc5, 0x16, 0x76, 0xf4, 0x67, 0x39, 0xd2,
-	0x4f, 0x6e, 0x84, 0x0b, 0x12, 0xb4, 0xe1, 0x63, 0xa2, 0x1d, 0x98, 0x0d, 0xe0, 0x53, 0xe7, 0x00,
-	0x1f, 0x80, 0xa1, 0x17, 0x20, 0x17, 0xdf, 0x1f, 0xc5, 0x71, 0x2d, 0xa5, 0x66, 0x63, 0xdb, 0xe3,
-	0x49, 0x1b, 0x70, 0xfa, 0xa9, 0x1b, 0x70, 0xec, 0x54, 0xfc, 0xbb, 0x19, 0x28, 0xaa, 0xc4, 0xf8,
-	0x0a, 0x06, 0xef, 0x87, 0x00, 0x7e, 0x81, 0xf3, 0xe6, 0xfb, 0x0c, 0xf1, 0x9b, 0x6c, 0x18, 0x19,
-	0x1f, 0xaf, 0x49, 0xd9, 0x17, 0x19, 0xc1, 0x3f, 0x27, 0x21, 0x17, 0x8f, 0xe0, 0x57, 0x60, 0xb7,
-	0x43, 0x1b, 0x51, 0x7b, 0x4b, 0x89, 0xf6, 0xf6, 0xea, 0xb4, 0xf6, 0x36, 0x91, 0xdb, 0xa7, 0xe8,
-	0x6b, 0x3f, 0x4f, 0x41, 0xba, 0x8d, 0x3d, 0x6c, 0x53, 0xb4, 0x39, 0x71, 0xec, 0xf7, 0xaf, 0xe6,
-	0x57, 0x26, 0xd2, 0xbb, 0x29, 0xdf, 0x94, 0xfc, 0xec, 0x7e, 0x7f, 0xda, 0xa9, 0xff, 0x1b, 0xb0,
-	0x60, 0xe3, 0x03, 0x2d, 0x74, 0xca, 0xa7, 0x73, 0x5e, 0xbc, 0x16, 0x84, 0xb7, 0x53, 0x8a, 0x2a,
-	0x90, 0xe5, 0x62, 0x51, 0x0f, 0xe7, 0x32, 0x60, 0xe3, 0x83, 0x75, 0x7f, 0x06, 0x5d, 0x07, 0xb4,
-	0x17, 0x3e, 0x04, 0x69, 0x11, 0x19, 0x5c, 0xae, 0x18, 0xad, 0x04, 0xe2, 0x5f, 0x07, 0xe0, 0x56,
-	0x68, 0x06, 0x71, 0x5c, 0x5b, 0xde, 0x91, 0x33, 0x7c, 0xa6, 0xc9, 0x27, 0xd0, 0x4f, 0xfc, 0xcb,
-	0xc3, 0xd8, 0x3b, 0x84, 0xbc, 0xc6, 0xdd, 0x3d, 0x5b, 0x51, 0xfc, 0xe7, 0xa8, 0x52, 0x1e, 0x62,
-	0xdb, 0x5a, 0xa9, 0x1d, 0x03, 0x59, 0x13, 0x97, 0x89, 0xd1, 0xf7, 0x8b, 0x69, 0x57, 0x97, 0xd9,
-	0xcf, 0xe5, 0xea, 0xb2, 0xb2, 0x14, 0x54, 0xce, 0xe1, 0x67, 0x1f, 0x5d, 0xbb, 0x1a, 0xc3, 0x38,
-	0x08, 0xdf, 0x23, 0xfd, 0xe0, 0xd7, 0x7e, 0xa3, 0x00, 0x8a, 0x14, 0x55, 0x42, 0xfb, 0xfc, 0x92,
-	0xce, 0x2f, 0x6e, 0x31, 0x2b, 0x95, 0xa7, 0x5f, 0xdc, 0x22, 0xfd, 0x91, 0x8b, 0x5b, 0xac, 0x5c,
-	0xbf, 0x1b, 0x6d, 0x22, 0x49, 0x99, 0x5b, 0x12, 0xab, 0x8b, 0x29, 0x89, 0xdd, 0x00, 0xcd, 0x11,
-	0x88, 0x40, 0x29, 0xec, 0x04, 0x89, 0xda, 0x91, 0x02, 0x57, 0x26, 0xf2, 0x3d, 0x34, 0x5b, 0x07,
-	0xe4, 0xc5, 0x16, 0x45, 0xce, 0x0c, 0xa5, 0xf9, 0xcf, 0x56, 0x3e, 0x45, 0x6f, 0x62, 0xe3, 0xf8,
-	0x9c, 0x76, 0xc4, 0x95, 0x94, 0x68, 0x75, 0x7f, 0x54, 0xe0, 0x62, 0xdc, 0xa2, 0xd0, 0xb7, 0x0e,
-	0xe4, 0xe2, 0xb6, 0x48, 0xaf, 0x5e, 0x3a, 0x8d, 0x57, 0x71, 0x87, 0x46, 0x40, 0xb8, 0x2f, 0x41,
-	0x5d, 0xf9, 0xaf, 0xa3, 0x37, 0x4e, 0xcd, 0x52, 0x60, 0xd8, 0xb1, 0xcd, 0x26, 0x25, 0x82, 0xf5,
-	0x8b, 0x24, 0xa4, 0xda, 0xae, 0x6b, 0xa1, 0x9f, 0x29, 0x50, 0x74, 0x5c, 0xa6, 0xf1, 0x6a, 0x24,
-	0x86, 0x26, 0x5f, 0x68, 0xfc, 0x7e, 0xbd, 0x73, 0x36, 0xf6, 0xfe, 0x75, 0x54, 0x99, 0x84, 0x1a,
-	0xa5, 0x54, 0xbe, 0x10, 0x3a, 0x2e, 0x6b, 0x08, 0xa1, 0x2d, 0xff, 0x11, 0xe7, 0x5d, 0x98, 0x1f,
-	0xfd, 0xbe, 0xdf, 0xe4, 0xd5, 0x33, 0x7f, 0x7f, 0xfe, 0xc4, 0x6f, 0xe7, 0xba, 0xb1, 0x0f, 0xaf,
-	0xcc, 0xf1, 0xc0, 0xfe, 0x9b, 0x07, 0xf7, 0x1d, 0x28, 0x84, 0x0d, 0x70, 0x5b, 0xbc, 0x37, 0xf2,
-	0xd3, 0xf0, 0xac, 0xff, 0xf4, 0x18, 0xdc, 0x5b, 0xaa, 0xf1, 0xd7, 0x6d, 0xdc, 0xd5, 0xcd, 0xfa,
-	0x98, 0xce, 0x08, 0xe3, 0x52, 0xf7, 0xda, 0x6f, 0x15, 0x80, 0xe8, 0x3d, 0x0c, 0xbd, 0x06, 0x97,
-	0x1b, 0x9b, 0x1b, 0x4d, 0xad, 0xb3, 0xb5, 0xba, 0xb5, 0xdd, 0xd1, 0xb6, 0x37, 0x3a, 0xed, 0xf5,
-	0xb5, 0xd6, 0xad, 0xd6, 0x7a, 0xb3, 0x90, 0x28, 0xe7, 0x0f, 0x1f, 0x54, 0xb3, 0xdb, 0x0e, 0xed,
-	0x13, 0xdd, 0xdc, 0x35, 0x89, 0x81, 0x5e, 0x86, 0x8b, 0xa3, 0xd2, 0x7c, 0xb4, 0xde, 0x2c, 0x28,
-	0xe5, 0xdc, 0xe1, 0x83, 0xea, 0x9c, 0x7f, 0x00, 0x26, 0x06, 0x5a, 0x82, 0xe7, 0x27, 0xe5, 0x5a,
-	0x1b, 0xb7, 0x0b, 0xc9, 0xf2, 0xfc, 0xe1, 0x83, 0x6a, 0x26, 0x3c, 0x29, 0xa3, 0x1a, 0xa0, 0xb8,
-	0xa4, 0xc4, 0x9b, 0x29, 0xc3, 0xe1, 0x83, 0x6a, 0xda, 0x0f, 0x4b, 0x39, 0x75, 0xff, 0x57, 0x8b,
-	0x89, 0x6b, 0x3f, 0x02, 0x68, 0x39, 0xbb, 0x1e, 0xd6, 0x45, 0x42, 0x96, 0xe1, 0x52, 0x6b, 0xe3,
-	0x96, 0xba, 0xba, 0xb6, 0xd5, 0xda, 0xdc, 0x18, 0x35, 0x7b, 0x6c, 0xad, 0xb9, 0xb9, 0xdd, 0xb8,
-	0xbb, 0xae, 0x75, 0x5a, 0xb7, 0x37, 0x0a, 0x0a, 0xba, 0x0c, 0x17, 0x46, 0xd6, 0xde, 0xde, 0xd8,
-	0x6a, 0xbd, 0xb5, 0x5e, 0x48, 0x36, 0x6e, 0x7d, 0xf2, 0x78, 0x51, 0x79, 0xf4, 0x78, 0x51, 0xf9,
-	0xc7, 0xe3, 0x45, 0xe5, 0xbd, 0x27, 0x8b, 0x89, 0x47, 0x4f, 0x16, 0x13, 0x7f, 0x79, 0xb2, 0x98,
-	0xf8, 0xc1, 0x6b, 0x4f, 0x0d, 0x78, 0xd4, 0x29, 0x45, 0xe8, 0xbb, 0x69, 0xb1, 0x0b, 0x7e, 0xeb,
-	0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8d, 0xb4, 0xf5, 0xa9, 0xd8, 0x19, 0x00, 0x00,
+	// 2431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4f, 0x68, 0x63, 0xc7,
+	0xfd, 0xf7, 0x93, 0xb4, 0xb2, 0xf5, 0x95, 0x6c, 0xd9, 0x63, 0xc7, 0xab, 0xd5, 0x26, 0xb6, 0xa2,
+	0xe4, 0x97, 0x38, 0x4b, 0x56, 0x4e, 0x36, 0x90, 0x83, 0x7f, 0xa1, 0xc5, 0xb2, 0x9c, 0xac, 0x92,
+	0x8d, 0x2d, 0x9e, 0xff, 0xa4, 0x69, 0x29, 0x8f, 0xd1, 0x7b, 0x63, 0x79, 0xe2, 0xa7, 0x37, 0xea,
+	0x9b, 0x27, 0xaf, 0x55, 0x5a, 0x28, 0x3d, 0xa5, 0x7b, 0x28, 0x81, 0x52, 0xd8, 0xcb, 0x42, 0xa0,
+	0x39, 0xf4, 0x90, 0x42, 0x0e, 0xa1, 0xa7, 0x42, 0xe9, 0xa1, 0x90, 0xf6, 0x92, 0x90, 0x53, 0x29,
+	0xc5, 0x2d, 0xd9, 0x43, 0x4a, 0x4f, 0xa5, 0xf7, 0x96, 0xf2, 0x66, 0xe6, 0xfd, 0x91, 0x64, 0xaf,
+	0xed, 0x8d, 0x52, 0x02, 0xb9, 0xd8, 0x9a, 0x99, 0xef, 0xf7, 0x33, 0xf3, 0xfd, 0x3b, 0xdf, 0xf9,
+	0x3e, 0x78, 0xd2, 0x64, 0xbc, 0xcd, 0xf8, 0x32, 0xf7, 0xf0, 0x01, 0x75, 0x5a, 0xcb, 0x87, 0xcf,
+	0x37, 0x89, 0x87, 0x9f, 0x0f, 0xc6, 0x95, 0x8e, 0xcb, 0x3c, 0x86, 0xe6, 0x25, 0x55, 0x25, 0x98,
+	0x55, 0x54, 0xc5, 0xb9, 0x16, 0x6b, 0x31, 0x41, 0xb2, 0xec, 0xff, 0x92, 0xd4, 0xc5, 0x2b, 0x2d,
+	0xc6, 0x5a, 0x36, 0x59, 0x16, 0xa3, 0x66, 0x77, 0x6f, 0x19, 0x3b, 0x3d, 0xb5, 0xb4, 0x30, 0xb8,
+	0x64, 0x75, 0x5d, 0xec, 0x51, 0xe6, 0xa8, 0xf5, 0xc5, 0xc1, 0x75, 0x8f, 0xb6, 0x09, 0xf7, 0x70,
+	0xbb, 0x13, 0x60, 0xcb, 0x93, 0x18, 0x72, 0x53, 0x75, 0x2c, 0x85, 0xad, 0x44, 0x69, 0x62, 0x4e,
+	0x42, 0x39, 0x4c, 0x46, 0x03, 0xec, 0x19, 0xdc, 0xa6, 0x0e, 0x5b, 0x16, 0x7f, 0xd5, 0xd4, 0xa3,
+	0x1e, 0x71, 0x2c, 0xe2, 0xb6, 0xa9, 0xe3, 0x2d, 0x7b, 0xbd, 0x0e, 0xe1, 0xf2, 0xaf, 0x5a, 0xbd,
+	0x1a, 0x5b, 0xc5, 0x4d, 0x93, 0xc6, 0x17, 0xcb, 0x3f, 0xd3, 0x60, 0xea, 0x26, 0xe5, 0x1e, 0x73,
+	0xa9, 0x89, 0xed, 0xba, 0xb3, 0xc7, 0xd0, 0xff, 0x43, 0x7a, 0x9f, 0x60, 0x8b, 0xb8, 0x05, 0xad,
+	0xa4, 0x2d, 0x65, 0x6f, 0x14, 0x2a, 0x11, 0x40, 0x45, 0xf2, 0xde, 0x14, 0xeb, 0xd5, 0xcc, 0x47,
+	0xc7, 0x8b, 0x63, 0xbf, 0xfc, 0xfc, 0x83, 0x6b, 0x9a, 0xae, 0x58, 0x50, 0x0d, 0xd2, 0x87, 0xd8,
+	0xe6, 0xc4, 0x2b, 0x24, 0x4a, 0xc9, 0xa5, 0xec, 0x8d, 0xc7, 0x2b, 0x27, 0xeb, 0xbc, 0xb2, 0x8b,
+	0x6d, 0x6a, 0x61, 0x8f, 0xf5, 0xa3, 0x48, 0xde, 0xf2, 0xfb, 0x09, 0xc8, 0xaf, 0xb1, 0x76, 0x9b,
+	0x72, 0x4e, 0x99, 0xa3, 0x63, 0x8f, 0x70, 0xd4, 0x80, 0x94, 0x8b, 0x3d, 0x22, 0x0e, 0x95, 0xa9,
+	0xbe, 0xe4, 0x33, 0xfd, 0xf9, 0x78, 0xf1, 0xa9, 0x16, 0xf5, 0xf6, 0xbb, 0xcd, 0x8a, 0xc9, 0xda,
+	0x4a, 0x8d, 0xea, 0xdf, 0x75, 0x6e, 0x1d, 0x28, 0x49, 0x6b, 0xc4, 0xfc, 0xf4, 0xc3, 0xeb, 0xa0,
+	0x0e, 0x52, 0x23, 0xa6, 0x2e, 0x90, 0xd0, 0x1b, 0x30, 0xd1, 0xc6, 0x47, 0x86, 0x40, 0x4d, 0x8c,
+	0x00, 0x75, 0xbc, 0x8d, 0x8f, 0xfc, 0xb3, 0x22, 0x0b, 0xf2, 0x3e, 0xb0, 0xb9, 0x8f, 0x9d, 0x16,
+	0x91, 0xf8, 0xc9, 0x11, 0xe0, 0x4f, 0xb6, 0xf1, 0xd1, 0x9a, 0xc0, 0xf4, 0x77, 0x59, 0x99, 0xb8,
+	0xfb, 0xee, 0xe2, 0xd8, 0xdf, 0xdf, 0x5d, 0xd4, 0xca, 0xbf, 0xd7, 0x00, 0x22, 0x75, 0x21, 0x0c,
+	0xd3, 0x66, 0x38, 0x12, 0xdb, 0x73, 0x65, 0xca, 0xa7, 0x4f, 0xb3, 0xc6, 0x80, 0xb2, 0xab, 0x93,
+	0xfe, 0x41, 0x3f, 0x39, 0x5e, 0xd4, 0xa4, 0x5d, 0xf2, 0xe6, 0x80, 0x31, 0x5e, 0x85, 0x6c, 0xb7,
+	0x63, 0x61, 0x8f, 0x18, 0xbe, 0x67, 0x0b, 0xed, 0x65, 0x6f, 0x14, 0x2b, 0xd2, 0xed, 0x2b, 0x81,
+	0xdb, 0x57, 0xb6, 0x03, 0xb7, 0x97, 0x80, 0xef, 0xfc, 0x35, 0x00, 0x04, 0xc9, 0xed, 0xaf, 0xc7,
+	0xe4, 0x78, 0x5f, 0x83, 0x6c, 0x8d, 0x70, 0xd3, 0xa5, 0x1d, 0x3f, 0x98, 0x50, 0x01, 0xc6, 0xdb,
+	0xcc, 0xa1, 0x07, 0xca, 0x15, 0x33, 0x7a, 0x30, 0x44, 0x45, 0x98, 0xa0, 0x16, 0x71, 0x3c, 0xea,
+	0xf5, 0xa4, 0xe9, 0xf4, 0x70, 0xec, 0x73, 0xdd, 0x26, 0x4d, 0x4e, 0x03, 0xad, 0xeb, 0xc1, 0x10,
+	0x3d, 0x03, 0xd3, 0x9c, 0x98, 0x5d, 0x97, 0x7a, 0x3d, 0xc3, 0x64, 0x8e, 0x87, 0x4d, 0xaf, 0x90,
+	0x12, 0x24, 0xf9, 0x60, 0x7e, 0x4d, 0x4e, 0xfb, 0x20, 0x16, 0xf1, 0x30, 0xb5, 0x79, 0xe1, 0x92,
+	0x04, 0x51, 0xc3, 0xd8, 0x71, 0xdf, 0xcb, 0x40, 0x26, 0x74, 0x63, 0xb4, 0x06, 0xd3, 0xac, 0x43,
+	0x5c, 0xff, 0xb7, 0x81, 0x2d, 0xcb, 0x25, 0x9c, 0x2b, 0x5f, 0x2d, 0x7c, 0xfa, 0xe1, 0xf5, 0x39,
+	0xa5, 0xf8, 0x55, 0xb9, 0xb2, 0xe5, 0xb9, 0xd4, 0x69, 0xe9, 0xf9, 0x80, 0x43, 0x4d, 0xa3, 0x37,
+	0x7d, 0xd3, 0x39, 0x9c, 0x38, 0xbc, 0xcb, 0x8d, 0x4e, 0xb7, 0x79, 0x40, 0x7a, 0x4a, 0xb9, 0x73,
+	0x43, 0xca, 0x5d, 0x75, 0x7a, 0xd5, 0xc2, 0x1f, 0x23, 0x68, 0xd3, 0xed, 0x75, 0x3c, 0x56, 0x69,
+	0x74, 0x9b, 0xaf, 0x91, 0x9e, 0x6f, 0x32, 0x85, 0xd3, 0x10, 0x30, 0x68, 0x1e, 0xd2, 0x6f, 0x61,
+	0x6a, 0x13, 0x4b, 0x68, 0x65, 0x42, 0x57, 0x23, 0xb4, 0x02, 0x69, 0xee, 0x61, 0xaf, 0xcb, 0x85,
+	0x2a, 0xa6, 0x6e, 0x94, 0x4f, 0xf3, 0x91, 0x2a, 0x73, 0xac, 0x2d, 0x41, 0xa9, 0x2b, 0x0e, 0xb4,
+	0x0d, 0x69, 0x8f, 0x1d, 0x10, 0x47, 0x29, 0xe9, 0x42, 0xfe, 0x5d, 0x77, 0xbc, 0x98, 0x7f, 0xd7,
+	0x1d, 0x4f, 0x57, 0x58, 0xa8, 0x05, 0xd3, 0x16, 0xb1, 0x49, 0x4b, 0xa8, 0x92, 0xef, 0x63, 0x97,
+	0xf0, 0x42, 0x7a, 0x04, 0xf1, 0x93, 0x0f, 0x51, 0xb7, 0x04, 0x28, 0x6a, 0x40, 0xd6, 0x8a, 0xdc,
+	0xad, 0x30, 0x2e, 0x14, 0xfd, 0xc4, 0x69, 0xf2, 0xc7, 0x3c, 0x33, 0x9e, 0xb3, 0xe2, 0x10, 0xbe,
+	0x87, 0x75, 0x9d, 0x26, 0x73, 0x2c, 0xea, 0xb4, 0x8c, 0x7d, 0x42, 0x5b, 0xfb, 0x5e, 0x61, 0xa2,
+	0xa4, 0x2d, 0x25, 0xf5, 0x7c, 0x38, 0x7f, 0x53, 0x4c, 0xa3, 0x06, 0x4c, 0x45, 0xa4, 0x22, 0x8a,
+	0x32, 0x17, 0x8d, 0xa2, 0xc9, 0x10, 0xc0, 0x27, 0x41, 0xaf, 0x03, 0x44, 0x71, 0x5a, 0x00, 0x81,
+	0x56, 0x3e, 0x3b, 0xe2, 0xe3, 0xc2, 0xc4, 0x00, 0x90, 0x0d, 0xb3, 0x6d, 0xea, 0x18, 0x9c, 0xd8,
+	0x7b, 0x86, 0xd2, 0x9c, 0x8f, 0x9b, 0x1d, 0x81, 0xa5, 0x67, 0xda, 0xd4, 0xd9, 0x22, 0xf6, 0x5e,
+	0x2d, 0x84, 0x45, 0x2f, 0xc1, 0xd5, 0x48, 0x1d, 0xcc, 0x31, 0xf6, 0x99, 0x6d, 0x19, 0x2e, 0xd9,
+	0x33, 0x4c, 0xd6, 0x75, 0xbc, 0x42, 0x4e, 0x28, 0xf1, 0x72, 0x48, 0xb2, 0xe9, 0xdc, 0x64, 0xb6,
+	0xa5, 0x93, 0xbd, 0x35, 0x7f, 0x19, 0x3d, 0x01, 0x91, 0x2e, 0x0c, 0x6a, 0xf1, 0xc2, 0x64, 0x29,
+	0xb9, 0x94, 0xd2, 0x73, 0xe1, 0x64, 0xdd, 0xe2, 0xa8, 0xea, 0x87, 0xbf, 0x14, 0x26, 0x8c, 0xd0,
+	0xa9, 0x33, 0x22, 0x74, 0x8a, 0xcb, 0x63, 0x06, 0x01, 0xba, 0x0a, 0x79, 0x97, 0xd8, 0xb8, 0x47,
+	0xa2, 0x20, 0xcf, 0x9f, 0x05, 0xa1, 0x18, 0x02, 0x88, 0x57, 0x00, 0x99, 0xfb, 0xd8, 0xb6, 0x89,
+	0xd3, 0x8a, 0xa1, 0x4c, 0x9f, 0x81, 0x32, 0x13, 0xf1, 0x04, 0x40, 0x97, 0x61, 0xbc, 0x69, 0x73,
+	0xc3, 0xcf, 0x11, 0x33, 0x25, 0x6d, 0x29, 0xa7, 0xa7, 0x9b, 0x36, 0x7f, 0x8d, 0xf4, 0x56, 0x72,
+	0x6f, 0xbf, 0xbb, 0x38, 0xa6, 0xd2, 0xd4, 0x58, 0xb9, 0x01, 0xb9, 0x5d, 0x1c, 0x08, 0x40, 0x38,
+	0x7a, 0x11, 0x32, 0x38, 0x18, 0x14, 0xb4, 0x52, 0xf2, 0x81, 0xdb, 0x46, 0xa4, 0x32, 0xf1, 0xfd,
+	0xe8, 0x2f, 0x25, 0xad, 0xfc, 0x9e, 0x06, 0xe9, 0xda, 0x6e, 0x03, 0x53, 0x17, 0xad, 0xc3, 0x4c,
+	0x14, 0xab, 0xe7, 0x4d, 0x7b, 0x51, 0x78, 0x07, 0xa2, 0xac, 0xc3, 0xcc, 0x61, 0x90, 0x49, 0x43,
+	0x98, 0xc4, 0x59, 0x30, 0x21, 0x8b, 0x9a, 0x1f, 0x10, 0xfc, 0x55, 0x18, 0x97, 0xa7, 0xe4, 0xe8,
+	0x9b, 0x70, 0xa9, 0xe3, 0xff, 0x10, 0xf2, 0x66, 0x6f, 0x2c, 0x9c, 0x1a, 0xe3, 0x82, 0x3e, 0x1e,
+	0x11, 0x92, 0xaf, 0xfc, 0x6f, 0x0d, 0xa0, 0xb6, 0xbb, 0xbb, 0xed, 0xd2, 0x8e, 0x4d, 0xbc, 0x51,
+	0x89, 0x7d, 0x0b, 0x1e, 0x89, 0xc4, 0xe6, 0xae, 0x79, 0x6e, 0xd1, 0x67, 0x43, 0xb6, 0x2d, 0xd7,
+	0x3c, 0x11, 0xcd, 0xe2, 0x5e, 0x88, 0x96, 0x3c, 0x37, 0x5a, 0x8d, 0x7b, 0x27, 0xeb, 0xf2, 0x5b,
+	0x90, 0x8d, 0xc4, 0xe7, 0xa8, 0x0e, 0x13, 0x9e, 0xfa, 0xad, 0x54, 0x5a, 0x3e, 0x5d, 0xa5, 0x01,
+	0x5b, 0x5c, 0xad, 0x21, 0x7b, 0xf9, 0x3f, 0xbe, 0x66, 0xa3, 0x3c, 0xf0, 0x95, 0x72, 0x28, 0xff,
+	0x82, 0x53, 0x17, 0xd0, 0x28, 0x0a, 0x38, 0x85, 0x35, 0xa0, 0xda, 0xb7, 0x13, 0x30, 0xbb, 0x13,
+	0xe4, 0xa9, 0xaf, 0xac, 0x26, 0x76, 0x60, 0x9c, 0x38, 0x9e, 0x4b, 0x85, 0x2a, 0x7c, 0x83, 0x3f,
+	0x77, 0x9a, 0xc1, 0x4f, 0x90, 0x65, 0xdd, 0xf1, 0xdc, 0x5e, 0xdc, 0xfc, 0x01, 0xd6, 0x80, 0x2a,
+	0x7e, 0x97, 0x84, 0xc2, 0x69, 0xec, 0xe8, 0x69, 0xc8, 0x9b, 0x2e, 0x11, 0x13, 0xc1, 0xd5, 0xaa,
+	0x89, 0x5b, 0x61, 0x2a, 0x98, 0x56, 0x37, 0xab, 0x0e, 0x7e, 0xbd, 0xea, 0x7b, 0x97, 0x4f, 0xfa,
+	0x70, 0x05, 0xea, 0x54, 0x84, 0x20, 0xee, 0x56, 0x02, 0x79, 0xea, 0x50, 0x8f, 0x62, 0xdb, 0x68,
+	0x62, 0x1b, 0x3b, 0xe6, 0xc3, 0x94, 0xf4, 0xc3, 0x17, 0xe1, 0x94, 0x02, 0xad, 0x4a, 0x4c, 0xb4,
+	0x0b, 0xe3, 0x01, 0x7c, 0x6a, 0x04, 0xf0, 0x01, 0x18, 0x7a, 0x1c, 0x72, 0xf1, 0xfb, 0x51, 0x94,
+	0x6b, 0x29, 0x3d, 0x1b, 0xbb, 0x1e, 0xcf, 0xba, 0x80, 0xd3, 0x0f, 0xbc, 0x80, 0x63, 0x55, 0xf1,
+	0x6f, 0x93, 0x30, 0xa3, 0x13, 0xeb, 0x6b, 0x68, 0xbc, 0xef, 0x00, 0xc8, 0x00, 0xf7, 0x93, 0xef,
+	0x43, 0xd8, 0x6f, 0x38, 0x61, 0x64, 0x24, 0x5e, 0x8d, 0x7b, 0xff, 0x4b, 0x0b, 0x7e, 0x9c, 0x80,
+	0x5c, 0xdc, 0x82, 0x5f, 0x83, 0xdb, 0x0e, 0x6d, 0x44, 0xe9, 0x2d, 0x25, 0xd2, 0xdb, 0x33, 0xa7,
+	0xa5, 0xb7, 0x21, 0xdf, 0x3e, 0x47, 0x5e, 0xbb, 0x9b, 0x81, 0x74, 0x03, 0xbb, 0xb8, 0xcd, 0xd1,
+	0xe6, 0x50, 0xd9, 0x2f, 0x9f, 0xe6, 0x57, 0x86, 0xdc, 0xbb, 0xa6, 0x7a, 0x4a, 0xd2, 0xbb, 0xef,
+	0x9e, 0x56, 0xf5, 0xff, 0x1f, 0x4c, 0xb5, 0xf1, 0x91, 0x11, 0x0a, 0x25, 0xd5, 0x39, 0x29, 0xba,
+	0x05, 0xe1, 0xeb, 0x94, 0xa3, 0x45, 0xc8, 0xfa, 0x64, 0x51, 0x0e, 0xf7, 0x69, 0xa0, 0x8d, 0x8f,
+	0xd6, 0xe5, 0x0c, 0xba, 0x0e, 0x68, 0x3f, 0x6c, 0x04, 0x19, 0x91, 0x32, 0x7c, 0xba, 0x99, 0x68,
+	0x25, 0x20, 0x7f, 0x0c, 0xc0, 0x3f, 0x85, 0x61, 0x11, 0x87, 0xb5, 0xd5, 0x1b, 0x39, 0xe3, 0xcf,
+	0xd4, 0xfc, 0x09, 0xf4, 0x03, 0xf9, 0x78, 0x18, 0xe8, 0x43, 0xa8, 0x67, 0xdc, 0xad, 0x8b, 0x05,
+	0xc5, 0xbf, 0x8e, 0x17, 0x8b, 0x3d, 0xdc, 0xb6, 0x57, 0xca, 0x27, 0x40, 0x96, 0xc5, 0x63, 0xa2,
+	0xbf, 0x7f, 0x71, 0xda, 0xd3, 0x65, 0xfc, 0xcb, 0x79, 0xba, 0xbc, 0x00, 0xf3, 0xaa, 0x0e, 0x37,
+	0xd8, 0x21, 0x71, 0x6d, 0xdc, 0x31, 0x9a, 0x36, 0x33, 0x0f, 0xb8, 0x78, 0xfa, 0xa5, 0xf4, 0x59,
+	0x59, 0x96, 0x6f, 0xca, 0xb5, 0xaa, 0x58, 0x42, 0x3f, 0xd1, 0x40, 0x88, 0xd3, 0x21, 0xae, 0x3a,
+	0xb9, 0x4d, 0x38, 0x1f, 0x3a, 0x72, 0x66, 0x04, 0x47, 0x5e, 0x6c, 0x53, 0xa7, 0xd1, 0xb7, 0xcd,
+	0x80, 0x00, 0x6f, 0xc1, 0xa3, 0xb1, 0x12, 0xa1, 0xd3, 0xb1, 0xa9, 0x29, 0x33, 0x75, 0x87, 0xb8,
+	0x94, 0x59, 0xea, 0x29, 0x79, 0x7e, 0x0f, 0x2d, 0x46, 0xd5, 0x43, 0x04, 0xd6, 0x10, 0x58, 0xc8,
+	0x86, 0xc7, 0xa2, 0xbd, 0x5c, 0xe2, 0x51, 0x97, 0xb4, 0x89, 0xe3, 0x19, 0x26, 0x63, 0xb6, 0xc5,
+	0x6e, 0xcb, 0xf7, 0xe5, 0x45, 0x36, 0xbb, 0x1a, 0xc2, 0xe9, 0x21, 0xda, 0x9a, 0x02, 0x43, 0xb7,
+	0xe1, 0x4a, 0xcb, 0x66, 0x4d, 0x6c, 0x1b, 0x36, 0xfd, 0x5e, 0x97, 0x5a, 0x86, 0x8a, 0x66, 0xc3,
+	0xc4, 0x1d, 0xf1, 0xa6, 0xfc, 0xa2, 0x19, 0x7a, 0x5e, 0xc2, 0xdf, 0x12, 0xe8, 0x5b, 0x12, 0x7c,
+	0x0d, 0x77, 0xd0, 0x0f, 0xe3, 0x2a, 0x3d, 0x61, 0xef, 0xc9, 0x11, 0xec, 0x7d, 0x25, 0xdc, 0x61,
+	0x70, 0xfb, 0x95, 0xa5, 0x20, 0x99, 0xdf, 0xf9, 0xfc, 0x83, 0x6b, 0x57, 0x63, 0x58, 0x47, 0x61,
+	0x8b, 0x5c, 0xe6, 0xa3, 0xf2, 0xc7, 0x1a, 0x4c, 0x55, 0x85, 0x7f, 0xea, 0xcc, 0x0b, 0xd3, 0xfd,
+	0x70, 0xc5, 0xa8, 0x5d, 0xb8, 0x62, 0x2c, 0x41, 0xae, 0xe3, 0x92, 0x43, 0x23, 0x78, 0xa3, 0x26,
+	0xc4, 0x1b, 0x15, 0xfc, 0x39, 0xb9, 0x61, 0xfc, 0x01, 0x9b, 0x8c, 0x3f, 0x60, 0xd1, 0x3c, 0xa4,
+	0x55, 0x91, 0x90, 0x12, 0x97, 0x96, 0x1a, 0xf9, 0xcf, 0x7c, 0x72, 0xd4, 0xa1, 0x6e, 0x2f, 0xa8,
+	0x21, 0x2e, 0x89, 0xe5, 0x9c, 0x9c, 0x94, 0x15, 0x44, 0xf9, 0xe7, 0x49, 0x98, 0xdb, 0x3d, 0xc1,
+	0x01, 0xd1, 0xab, 0x90, 0x09, 0x0f, 0xa9, 0xb2, 0xee, 0xc5, 0xda, 0xd3, 0x11, 0xfb, 0xc9, 0x57,
+	0x62, 0xe2, 0xc2, 0x57, 0xe2, 0x0a, 0x5c, 0x3a, 0xc4, 0x76, 0x57, 0xd6, 0x23, 0xbe, 0xd7, 0x2b,
+	0xbe, 0x26, 0xe6, 0x24, 0xd6, 0xaa, 0xa1, 0x7d, 0x4d, 0x1a, 0xc9, 0x82, 0x6a, 0x30, 0x41, 0x1c,
+	0x4b, 0xde, 0x21, 0xa9, 0x8b, 0x96, 0x48, 0xe3, 0xc4, 0xb1, 0xc4, 0xf5, 0xf1, 0x22, 0x64, 0x70,
+	0xa7, 0xe3, 0xb2, 0x43, 0x2c, 0x5a, 0x9d, 0x67, 0x75, 0x03, 0x02, 0x52, 0xf4, 0x1c, 0xa4, 0x0f,
+	0x89, 0xc7, 0x44, 0x6b, 0xee, 0xc1, 0x4c, 0x8a, 0xae, 0xfc, 0x1b, 0x0d, 0x66, 0x77, 0x87, 0x63,
+	0x75, 0x54, 0xee, 0x16, 0x57, 0x47, 0xe2, 0xa1, 0xd5, 0x51, 0x80, 0x71, 0x99, 0x94, 0x82, 0x36,
+	0x69, 0x30, 0x2c, 0x7f, 0xa8, 0xc1, 0xec, 0x36, 0x3b, 0x20, 0x0e, 0xfd, 0x3e, 0x11, 0xfd, 0x43,
+	0x9d, 0x98, 0xcc, 0xb5, 0xd0, 0x14, 0x24, 0xa8, 0x25, 0xce, 0x9b, 0xd2, 0x13, 0xd4, 0x42, 0x15,
+	0xb8, 0xc4, 0x6e, 0x3b, 0xc4, 0x3d, 0xd3, 0x1b, 0x24, 0x99, 0xb8, 0xbf, 0x99, 0xd5, 0xb5, 0x89,
+	0x81, 0x4d, 0x59, 0xa8, 0xc9, 0xae, 0xf5, 0xa4, 0x9c, 0x5d, 0x95, 0x93, 0xbe, 0x9d, 0x22, 0xe7,
+	0x4d, 0x9d, 0x01, 0x1d, 0x91, 0x96, 0xef, 0x26, 0x00, 0x85, 0x5a, 0xf7, 0xa3, 0x5c, 0x9e, 0x7a,
+	0x44, 0x4a, 0x8f, 0x02, 0x35, 0xd1, 0x17, 0xa8, 0x27, 0xb4, 0xc9, 0x92, 0x23, 0x69, 0x93, 0xa5,
+	0xbe, 0x50, 0x9b, 0xec, 0x52, 0x3c, 0xcb, 0x94, 0x7f, 0xa5, 0x01, 0x8a, 0x6e, 0x41, 0x9d, 0xf0,
+	0x0e, 0x73, 0xb8, 0x68, 0xa3, 0xc6, 0x2e, 0x60, 0xed, 0xc1, 0x6d, 0xd4, 0x88, 0xbf, 0xaf, 0x8d,
+	0x1a, 0x2b, 0x9e, 0xbf, 0x11, 0x3d, 0xe9, 0x12, 0x17, 0x08, 0xf2, 0x80, 0x29, 0xac, 0xcb, 0xc7,
+	0xca, 0xc7, 0x1a, 0x5c, 0x19, 0xaa, 0x3e, 0xc3, 0x63, 0x9b, 0x80, 0xdc, 0xd8, 0xa2, 0xa8, 0xe0,
+	0x7a, 0xea, 0xf8, 0x0f, 0x57, 0xcc, 0xce, 0xb8, 0x43, 0xcf, 0xb8, 0x2f, 0xe9, 0x7d, 0xba, 0x92,
+	0x12, 0x0f, 0x8f, 0x3f, 0x68, 0x30, 0x17, 0x3f, 0x51, 0x28, 0xdb, 0x16, 0xe4, 0xe2, 0x67, 0x51,
+	0x52, 0x3d, 0x79, 0x1e, 0xa9, 0xe2, 0x02, 0xf5, 0x81, 0xf8, 0xb2, 0x04, 0x55, 0xae, 0xfc, 0x56,
+	0xf9, 0xfc, 0xb9, 0xb5, 0x14, 0x1c, 0xec, 0xc4, 0xd2, 0x3f, 0x25, 0x8c, 0xf5, 0xd3, 0x04, 0xa4,
+	0x1a, 0x8c, 0xd9, 0xe8, 0xc7, 0x1a, 0xcc, 0x38, 0xcc, 0x33, 0xfc, 0xda, 0x98, 0x58, 0x86, 0xfa,
+	0x5e, 0x22, 0x43, 0x6d, 0xf7, 0x62, 0xda, 0xfb, 0xc7, 0xf1, 0xe2, 0x30, 0x54, 0xbf, 0x4a, 0xd5,
+	0xf7, 0x3a, 0x87, 0x79, 0x55, 0x41, 0xb4, 0x2d, 0x3f, 0xa9, 0xdc, 0x86, 0xc9, 0xfe, 0xfd, 0x65,
+	0x72, 0xd2, 0x2f, 0xbc, 0xff, 0xe4, 0x99, 0x7b, 0xe7, 0x9a, 0xb1, 0x8d, 0x57, 0x26, 0x7c, 0xc3,
+	0xfe, 0xd3, 0x37, 0xee, 0x9b, 0x30, 0x1d, 0xe6, 0xa1, 0x1d, 0xf1, 0xf5, 0x8f, 0xa3, 0x75, 0x18,
+	0x97, 0x1f, 0x02, 0x83, 0x2e, 0x62, 0x29, 0xfe, 0xad, 0x19, 0x37, 0x4d, 0x5a, 0x19, 0xe0, 0xe9,
+	0xd3, 0xb8, 0xe2, 0xbd, 0xf6, 0x6b, 0x0d, 0x20, 0xfa, 0x3a, 0x85, 0x9e, 0x85, 0xcb, 0xd5, 0xcd,
+	0x8d, 0x9a, 0xb1, 0xb5, 0xbd, 0xba, 0xbd, 0xb3, 0x65, 0xec, 0x6c, 0x6c, 0x35, 0xd6, 0xd7, 0xea,
+	0x2f, 0xd7, 0xd7, 0x6b, 0xd3, 0x63, 0xc5, 0xfc, 0x9d, 0x7b, 0xa5, 0xec, 0x8e, 0xc3, 0x3b, 0xc4,
+	0xa4, 0x7b, 0x94, 0x58, 0xe8, 0x29, 0x98, 0xeb, 0xa7, 0xf6, 0x47, 0xeb, 0xb5, 0x69, 0xad, 0x98,
+	0xbb, 0x73, 0xaf, 0x34, 0x21, 0xdb, 0x51, 0xc4, 0x42, 0x4b, 0xf0, 0xc8, 0x30, 0x5d, 0x7d, 0xe3,
+	0x95, 0xe9, 0x44, 0x71, 0xf2, 0xce, 0xbd, 0x52, 0x26, 0xec, 0x5b, 0xa1, 0x32, 0xa0, 0x38, 0xa5,
+	0xc2, 0x4b, 0x16, 0xe1, 0xce, 0xbd, 0x52, 0x5a, 0x9a, 0xa5, 0x98, 0x7a, 0xfb, 0x17, 0x0b, 0x63,
+	0xd7, 0xbe, 0x0b, 0x50, 0x77, 0xf6, 0x5c, 0x6c, 0x0a, 0x87, 0x2c, 0xc2, 0x7c, 0x7d, 0xe3, 0x65,
+	0x7d, 0x75, 0x6d, 0xbb, 0xbe, 0xb9, 0xd1, 0x7f, 0xec, 0x81, 0xb5, 0xda, 0xe6, 0x4e, 0xf5, 0xd6,
+	0xba, 0xb1, 0x55, 0x7f, 0x65, 0x63, 0x5a, 0x43, 0x97, 0x61, 0xb6, 0x6f, 0xed, 0x8d, 0x8d, 0xed,
+	0xfa, 0xeb, 0xeb, 0xd3, 0x89, 0xea, 0xcb, 0x1f, 0x7d, 0xb6, 0xa0, 0x7d, 0xf2, 0xd9, 0x82, 0xf6,
+	0xb7, 0xcf, 0x16, 0xb4, 0x77, 0xee, 0x2f, 0x8c, 0x7d, 0x72, 0x7f, 0x61, 0xec, 0x4f, 0xf7, 0x17,
+	0xc6, 0xbe, 0xfd, 0xec, 0x03, 0x0d, 0x1e, 0x15, 0x89, 0xc2, 0xf4, 0xcd, 0xb4, 0xb8, 0x40, 0x5f,
+	0xf8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf6, 0x92, 0x48, 0x8b, 0x66, 0x21, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1406,701 +1881,729 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11090 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x69, 0x90, 0x24, 0xc7,
-		0x75, 0xde, 0xf4, 0x31, 0x7d, 0xbc, 0xbe, 0x6a, 0x72, 0x06, 0xbb, 0xb3, 0xbd, 0xc4, 0xcc, 0xa0,
-		0x40, 0x00, 0x8b, 0x05, 0x30, 0x0b, 0x2c, 0xb0, 0x0b, 0x60, 0x96, 0x24, 0xd8, 0xdd, 0xd3, 0x3b,
-		0xdb, 0xbb, 0x73, 0xb1, 0xba, 0x67, 0x71, 0xc8, 0x62, 0xa9, 0xa6, 0x3a, 0x67, 0xa6, 0xb0, 0xdd,
-		0x55, 0xcd, 0xaa, 0xea, 0xdd, 0x19, 0x84, 0xc2, 0x01, 0x89, 0x96, 0x4c, 0xad, 0x65, 0x99, 0xb2,
-		0x1c, 0x12, 0x75, 0xac, 0x4c, 0xea, 0xa4, 0x2e, 0x5b, 0x97, 0x75, 0x58, 0x61, 0x59, 0x72, 0x58,
-		0xb2, 0xa4, 0x50, 0xd8, 0xb4, 0x7e, 0xd8, 0x0a, 0x85, 0x0c, 0x4b, 0xa4, 0xc2, 0xa2, 0x25, 0xca,
-		0x3a, 0x4c, 0x85, 0xed, 0x60, 0x48, 0xe1, 0xc8, 0xab, 0x8e, 0x3e, 0xa6, 0x7a, 0x96, 0x00, 0x2c,
-		0x87, 0xfe, 0xec, 0x76, 0x65, 0xbe, 0xf7, 0x55, 0xe6, 0xcb, 0x97, 0x2f, 0xdf, 0x7b, 0x99, 0x95,
-		0x03, 0xbf, 0x5f, 0x85, 0xa5, 0x7d, 0xcb, 0xda, 0xef, 0xe0, 0x0b, 0x3d, 0xdb, 0x72, 0xad, 0xdd,
-		0xfe, 0xde, 0x85, 0x36, 0x76, 0x74, 0xdb, 0xe8, 0xb9, 0x96, 0xbd, 0x4c, 0xcb, 0x50, 0x89, 0x51,
-		0x2c, 0x0b, 0x0a, 0x79, 0x03, 0x66, 0xae, 0x1a, 0x1d, 0xbc, 0xea, 0x11, 0x36, 0xb1, 0x8b, 0x5e,
-		0x80, 0xe4, 0x9e, 0xd1, 0xc1, 0xf3, 0xb1, 0xa5, 0xc4, 0xb9, 0xdc, 0xc5, 0xf7, 0x2e, 0x0f, 0x30,
-		0x2d, 0x87, 0x39, 0xb6, 0x49, 0xb1, 0x42, 0x39, 0xe4, 0xbf, 0x4e, 0xc2, 0xec, 0x88, 0x5a, 0x84,
-		0x20, 0x69, 0x6a, 0x5d, 0x82, 0x18, 0x3b, 0x97, 0x55, 0xe8, 0x6f, 0x34, 0x0f, 0xe9, 0x9e, 0xa6,
-		0xdf, 0xd2, 0xf6, 0xf1, 0x7c, 0x9c, 0x16, 0x8b, 0x47, 0xb4, 0x00, 0xd0, 0xc6, 0x3d, 0x6c, 0xb6,
-		0xb1, 0xa9, 0x1f, 0xcd, 0x27, 0x96, 0x12, 0xe7, 0xb2, 0x4a, 0xa0, 0x04, 0x3d, 0x01, 0x33, 0xbd,
-		0xfe, 0x6e, 0xc7, 0xd0, 0xd5, 0x00, 0x19, 0x2c, 0x25, 0xce, 0x4d, 0x2b, 0x12, 0xab, 0x58, 0xf5,
-		0x89, 0x1f, 0x83, 0xd2, 0x1d, 0xac, 0xdd, 0x0a, 0x92, 0xe6, 0x28, 0x69, 0x91, 0x14, 0x07, 0x08,
-		0x6b, 0x90, 0xef, 0x62, 0xc7, 0xd1, 0xf6, 0xb1, 0xea, 0x1e, 0xf5, 0xf0, 0x7c, 0x92, 0xf6, 0x7e,
-		0x69, 0xa8, 0xf7, 0x83, 0x3d, 0xcf, 0x71, 0xae, 0xd6, 0x51, 0x0f, 0xa3, 0x0a, 0x64, 0xb1, 0xd9,
-		0xef, 0x32, 0x84, 0xe9, 0x31, 0xf2, 0xab, 0x9b, 0xfd, 0xee, 0x20, 0x4a, 0x86, 0xb0, 0x71, 0x88,
-		0xb4, 0x83, 0xed, 0xdb, 0x86, 0x8e, 0xe7, 0x53, 0x14, 0xe0, 0xb1, 0x21, 0x80, 0x26, 0xab, 0x1f,
-		0xc4, 0x10, 0x7c, 0xa8, 0x06, 0x59, 0x7c, 0xe8, 0x62, 0xd3, 0x31, 0x2c, 0x73, 0x3e, 0x4d, 0x41,
-		0x1e, 0x19, 0x31, 0x8a, 0xb8, 0xd3, 0x1e, 0x84, 0xf0, 0xf9, 0xd0, 0x65, 0x48, 0x5b, 0x3d, 0xd7,
-		0xb0, 0x4c, 0x67, 0x3e, 0xb3, 0x14, 0x3b, 0x97, 0xbb, 0xf8, 0x9e, 0x91, 0x8a, 0xb0, 0xc5, 0x68,
-		0x14, 0x41, 0x8c, 0x1a, 0x20, 0x39, 0x56, 0xdf, 0xd6, 0xb1, 0xaa, 0x5b, 0x6d, 0xac, 0x1a, 0xe6,
-		0x9e, 0x35, 0x9f, 0xa5, 0x00, 0x8b, 0xc3, 0x1d, 0xa1, 0x84, 0x35, 0xab, 0x8d, 0x1b, 0xe6, 0x9e,
-		0xa5, 0x14, 0x9d, 0xd0, 0x33, 0x3a, 0x05, 0x29, 0xe7, 0xc8, 0x74, 0xb5, 0xc3, 0xf9, 0x3c, 0xd5,
-		0x10, 0xfe, 0x44, 0x54, 0x07, 0xb7, 0x0d, 0xf2, 0xba, 0xf9, 0x02, 0x53, 0x1d, 0xfe, 0x28, 0xff,
-		0x7c, 0x0a, 0x4a, 0x93, 0x28, 0xdf, 0x15, 0x98, 0xde, 0x23, 0xfd, 0x9f, 0x8f, 0x9f, 0x44, 0x3a,
-		0x8c, 0x27, 0x2c, 0xde, 0xd4, 0x7d, 0x8a, 0xb7, 0x02, 0x39, 0x13, 0x3b, 0x2e, 0x6e, 0x33, 0x5d,
-		0x49, 0x4c, 0xa8, 0x6d, 0xc0, 0x98, 0x86, 0x95, 0x2d, 0x79, 0x5f, 0xca, 0xf6, 0x0a, 0x94, 0xbc,
-		0x26, 0xa9, 0xb6, 0x66, 0xee, 0x0b, 0xad, 0xbd, 0x10, 0xd5, 0x92, 0xe5, 0xba, 0xe0, 0x53, 0x08,
-		0x9b, 0x52, 0xc4, 0xa1, 0x67, 0xb4, 0x0a, 0x60, 0x99, 0xd8, 0xda, 0x53, 0xdb, 0x58, 0xef, 0xcc,
-		0x67, 0xc6, 0x48, 0x69, 0x8b, 0x90, 0x0c, 0x49, 0xc9, 0x62, 0xa5, 0x7a, 0x07, 0xbd, 0xe8, 0x2b,
-		0x61, 0x7a, 0x8c, 0x0e, 0x6d, 0xb0, 0xe9, 0x37, 0xa4, 0x87, 0x3b, 0x50, 0xb4, 0x31, 0x99, 0x11,
-		0xb8, 0xcd, 0x7b, 0x96, 0xa5, 0x8d, 0x58, 0x8e, 0xec, 0x99, 0xc2, 0xd9, 0x58, 0xc7, 0x0a, 0x76,
-		0xf0, 0x11, 0x3d, 0x0c, 0x5e, 0x81, 0x4a, 0xd5, 0x0a, 0xa8, 0x7d, 0xca, 0x8b, 0xc2, 0x4d, 0xad,
-		0x8b, 0xcb, 0x6f, 0x40, 0x31, 0x2c, 0x1e, 0x34, 0x07, 0xd3, 0x8e, 0xab, 0xd9, 0x2e, 0xd5, 0xc2,
-		0x69, 0x85, 0x3d, 0x20, 0x09, 0x12, 0xd8, 0x6c, 0x53, 0xfb, 0x37, 0xad, 0x90, 0x9f, 0xe8, 0x83,
-		0x7e, 0x87, 0x13, 0xb4, 0xc3, 0x8f, 0x0e, 0x8f, 0x68, 0x08, 0x79, 0xb0, 0xdf, 0xe5, 0xe7, 0xa1,
-		0x10, 0xea, 0xc0, 0xa4, 0xaf, 0x96, 0xbf, 0x1a, 0x1e, 0x18, 0x09, 0x8d, 0x5e, 0x81, 0xb9, 0xbe,
-		0x69, 0x98, 0x2e, 0xb6, 0x7b, 0x36, 0x26, 0x1a, 0xcb, 0x5e, 0x35, 0xff, 0x87, 0xe9, 0x31, 0x3a,
-		0xb7, 0x13, 0xa4, 0x66, 0x28, 0xca, 0x6c, 0x7f, 0xb8, 0xf0, 0x7c, 0x36, 0xf3, 0xf9, 0xb4, 0xf4,
-		0xe6, 0x9b, 0x6f, 0xbe, 0x19, 0x97, 0x7f, 0x39, 0x05, 0x73, 0xa3, 0xe6, 0xcc, 0xc8, 0xe9, 0x7b,
-		0x0a, 0x52, 0x66, 0xbf, 0xbb, 0x8b, 0x6d, 0x2a, 0xa4, 0x69, 0x85, 0x3f, 0xa1, 0x0a, 0x4c, 0x77,
-		0xb4, 0x5d, 0xdc, 0x99, 0x4f, 0x2e, 0xc5, 0xce, 0x15, 0x2f, 0x3e, 0x31, 0xd1, 0xac, 0x5c, 0x5e,
-		0x27, 0x2c, 0x0a, 0xe3, 0x44, 0x1f, 0x80, 0x24, 0x37, 0xde, 0x04, 0xe1, 0xfc, 0x64, 0x08, 0x64,
-		0x2e, 0x29, 0x94, 0x0f, 0x9d, 0x85, 0x2c, 0xf9, 0x9f, 0xe9, 0x46, 0x8a, 0xb6, 0x39, 0x43, 0x0a,
-		0x88, 0x5e, 0xa0, 0x32, 0x64, 0xe8, 0x34, 0x69, 0x63, 0xb1, 0xe8, 0x79, 0xcf, 0x44, 0xb1, 0xda,
-		0x78, 0x4f, 0xeb, 0x77, 0x5c, 0xf5, 0xb6, 0xd6, 0xe9, 0x63, 0xaa, 0xf0, 0x59, 0x25, 0xcf, 0x0b,
-		0x6f, 0x92, 0x32, 0xb4, 0x08, 0x39, 0x36, 0xab, 0x0c, 0xb3, 0x8d, 0x0f, 0xa9, 0x5d, 0x9d, 0x56,
-		0xd8, 0x44, 0x6b, 0x90, 0x12, 0xf2, 0xfa, 0xd7, 0x1d, 0xcb, 0x14, 0xaa, 0x49, 0x5f, 0x41, 0x0a,
-		0xe8, 0xeb, 0x9f, 0x1f, 0x34, 0xe9, 0x0f, 0x8e, 0xee, 0xde, 0xd0, 0x5c, 0x7a, 0x0c, 0x4a, 0x94,
-		0xe2, 0x59, 0x3e, 0xf4, 0x5a, 0x67, 0x7e, 0x66, 0x29, 0x76, 0x2e, 0xa3, 0x14, 0x59, 0xf1, 0x16,
-		0x2f, 0x95, 0x7f, 0x26, 0x0e, 0x49, 0x6a, 0x58, 0x4a, 0x90, 0x6b, 0xbd, 0xba, 0x5d, 0x57, 0x57,
-		0xb7, 0x76, 0xaa, 0xeb, 0x75, 0x29, 0x86, 0x8a, 0x00, 0xb4, 0xe0, 0xea, 0xfa, 0x56, 0xa5, 0x25,
-		0xc5, 0xbd, 0xe7, 0xc6, 0x66, 0xeb, 0xf2, 0x73, 0x52, 0xc2, 0x63, 0xd8, 0x61, 0x05, 0xc9, 0x20,
-		0xc1, 0xb3, 0x17, 0xa5, 0x69, 0x24, 0x41, 0x9e, 0x01, 0x34, 0x5e, 0xa9, 0xaf, 0x5e, 0x7e, 0x4e,
-		0x4a, 0x85, 0x4b, 0x9e, 0xbd, 0x28, 0xa5, 0x51, 0x01, 0xb2, 0xb4, 0xa4, 0xba, 0xb5, 0xb5, 0x2e,
-		0x65, 0x3c, 0xcc, 0x66, 0x4b, 0x69, 0x6c, 0xae, 0x49, 0x59, 0x0f, 0x73, 0x4d, 0xd9, 0xda, 0xd9,
-		0x96, 0xc0, 0x43, 0xd8, 0xa8, 0x37, 0x9b, 0x95, 0xb5, 0xba, 0x94, 0xf3, 0x28, 0xaa, 0xaf, 0xb6,
-		0xea, 0x4d, 0x29, 0x1f, 0x6a, 0xd6, 0xb3, 0x17, 0xa5, 0x82, 0xf7, 0x8a, 0xfa, 0xe6, 0xce, 0x86,
-		0x54, 0x44, 0x33, 0x50, 0x60, 0xaf, 0x10, 0x8d, 0x28, 0x0d, 0x14, 0x5d, 0x7e, 0x4e, 0x92, 0xfc,
-		0x86, 0x30, 0x94, 0x99, 0x50, 0xc1, 0xe5, 0xe7, 0x24, 0x24, 0xd7, 0x60, 0x9a, 0xaa, 0x21, 0x42,
-		0x50, 0x5c, 0xaf, 0x54, 0xeb, 0xeb, 0xea, 0xd6, 0x76, 0xab, 0xb1, 0xb5, 0x59, 0x59, 0x97, 0x62,
-		0x7e, 0x99, 0x52, 0xff, 0xd0, 0x4e, 0x43, 0xa9, 0xaf, 0x4a, 0xf1, 0x60, 0xd9, 0x76, 0xbd, 0xd2,
-		0xaa, 0xaf, 0x4a, 0x09, 0x59, 0x87, 0xb9, 0x51, 0x06, 0x75, 0xe4, 0x14, 0x0a, 0xe8, 0x42, 0x7c,
-		0x8c, 0x2e, 0x50, 0xac, 0x41, 0x5d, 0x90, 0x3f, 0x17, 0x87, 0xd9, 0x11, 0x8b, 0xca, 0xc8, 0x97,
-		0xbc, 0x04, 0xd3, 0x4c, 0x97, 0xd9, 0x32, 0xfb, 0xf8, 0xc8, 0xd5, 0x89, 0x6a, 0xf6, 0xd0, 0x52,
-		0x4b, 0xf9, 0x82, 0x4e, 0x48, 0x62, 0x8c, 0x13, 0x42, 0x20, 0x86, 0x14, 0xf6, 0x2b, 0x87, 0x8c,
-		0x3f, 0x5b, 0x1f, 0x2f, 0x4f, 0xb2, 0x3e, 0xd2, 0xb2, 0x93, 0x2d, 0x02, 0xd3, 0x23, 0x16, 0x81,
-		0x2b, 0x30, 0x33, 0x04, 0x34, 0xb1, 0x31, 0xfe, 0x68, 0x0c, 0xe6, 0xc7, 0x09, 0x27, 0xc2, 0x24,
-		0xc6, 0x43, 0x26, 0xf1, 0xca, 0xa0, 0x04, 0x1f, 0x1a, 0x3f, 0x08, 0x43, 0x63, 0xfd, 0x83, 0x31,
-		0x38, 0x35, 0xda, 0xd9, 0x1c, 0xd9, 0x86, 0x0f, 0x40, 0xaa, 0x8b, 0xdd, 0x03, 0x4b, 0xb8, 0x55,
-		0x8f, 0x8e, 0x58, 0xac, 0x49, 0xf5, 0xe0, 0x60, 0x73, 0xae, 0xe0, 0x6a, 0x9f, 0x18, 0xe7, 0x31,
-		0xb2, 0xd6, 0x0c, 0xb5, 0xf4, 0x1b, 0xe2, 0xf0, 0xc0, 0x48, 0xf0, 0x91, 0x0d, 0x7d, 0x10, 0xc0,
-		0x30, 0x7b, 0x7d, 0x97, 0xb9, 0x4e, 0xcc, 0x12, 0x67, 0x69, 0x09, 0x35, 0x5e, 0xc4, 0xca, 0xf6,
-		0x5d, 0xaf, 0x3e, 0x41, 0xeb, 0x81, 0x15, 0x51, 0x82, 0x17, 0xfc, 0x86, 0x26, 0x69, 0x43, 0x17,
-		0xc6, 0xf4, 0x74, 0x48, 0x31, 0x9f, 0x06, 0x49, 0xef, 0x18, 0xd8, 0x74, 0x55, 0xc7, 0xb5, 0xb1,
-		0xd6, 0x35, 0xcc, 0x7d, 0xba, 0xd4, 0x64, 0x56, 0xa6, 0xf7, 0xb4, 0x8e, 0x83, 0x95, 0x12, 0xab,
-		0x6e, 0x8a, 0x5a, 0xc2, 0x41, 0x15, 0xc8, 0x0e, 0x70, 0xa4, 0x42, 0x1c, 0xac, 0xda, 0xe3, 0x90,
-		0xbf, 0x39, 0x0b, 0xb9, 0x80, 0x6b, 0x8e, 0x1e, 0x82, 0xfc, 0xeb, 0xda, 0x6d, 0x4d, 0x15, 0xe1,
-		0x16, 0x93, 0x44, 0x8e, 0x94, 0x6d, 0xf3, 0x90, 0xeb, 0x69, 0x98, 0xa3, 0x24, 0x56, 0xdf, 0xc5,
-		0xb6, 0xaa, 0x77, 0x34, 0xc7, 0xa1, 0x42, 0xcb, 0x50, 0x52, 0x44, 0xea, 0xb6, 0x48, 0x55, 0x4d,
-		0xd4, 0xa0, 0x4b, 0x30, 0x4b, 0x39, 0xba, 0xfd, 0x8e, 0x6b, 0xf4, 0x3a, 0x58, 0x25, 0x01, 0xa0,
-		0x43, 0x97, 0x1c, 0xaf, 0x65, 0x33, 0x84, 0x62, 0x83, 0x13, 0x90, 0x16, 0x39, 0x68, 0x15, 0x1e,
-		0xa4, 0x6c, 0xfb, 0xd8, 0xc4, 0xb6, 0xe6, 0x62, 0x15, 0x7f, 0xa4, 0xaf, 0x75, 0x1c, 0x55, 0x33,
-		0xdb, 0xea, 0x81, 0xe6, 0x1c, 0xcc, 0xcf, 0x11, 0x80, 0x6a, 0x7c, 0x3e, 0xa6, 0x9c, 0x21, 0x84,
-		0x6b, 0x9c, 0xae, 0x4e, 0xc9, 0x2a, 0x66, 0xfb, 0x9a, 0xe6, 0x1c, 0xa0, 0x15, 0x38, 0x45, 0x51,
-		0x1c, 0xd7, 0x36, 0xcc, 0x7d, 0x55, 0x3f, 0xc0, 0xfa, 0x2d, 0xb5, 0xef, 0xee, 0xbd, 0x30, 0x7f,
-		0x36, 0xf8, 0x7e, 0xda, 0xc2, 0x26, 0xa5, 0xa9, 0x11, 0x92, 0x1d, 0x77, 0xef, 0x05, 0xd4, 0x84,
-		0x3c, 0x19, 0x8c, 0xae, 0xf1, 0x06, 0x56, 0xf7, 0x2c, 0x9b, 0xae, 0xa1, 0xc5, 0x11, 0xa6, 0x29,
-		0x20, 0xc1, 0xe5, 0x2d, 0xce, 0xb0, 0x61, 0xb5, 0xf1, 0xca, 0x74, 0x73, 0xbb, 0x5e, 0x5f, 0x55,
-		0x72, 0x02, 0xe5, 0xaa, 0x65, 0x13, 0x85, 0xda, 0xb7, 0x3c, 0x01, 0xe7, 0x98, 0x42, 0xed, 0x5b,
-		0x42, 0xbc, 0x97, 0x60, 0x56, 0xd7, 0x59, 0x9f, 0x0d, 0x5d, 0xe5, 0x61, 0x9a, 0x33, 0x2f, 0x85,
-		0x84, 0xa5, 0xeb, 0x6b, 0x8c, 0x80, 0xeb, 0xb8, 0x83, 0x5e, 0x84, 0x07, 0x7c, 0x61, 0x05, 0x19,
-		0x67, 0x86, 0x7a, 0x39, 0xc8, 0x7a, 0x09, 0x66, 0x7b, 0x47, 0xc3, 0x8c, 0x28, 0xf4, 0xc6, 0xde,
-		0xd1, 0x20, 0xdb, 0xf3, 0x30, 0xd7, 0x3b, 0xe8, 0x0d, 0xf3, 0x9d, 0x0f, 0xf2, 0xa1, 0xde, 0x41,
-		0x6f, 0x90, 0xf1, 0x11, 0x1a, 0xb3, 0xdb, 0x58, 0xd7, 0x5c, 0xdc, 0x9e, 0x3f, 0x1d, 0x24, 0x0f,
-		0x54, 0xa0, 0x65, 0x90, 0x74, 0x5d, 0xc5, 0xa6, 0xb6, 0xdb, 0xc1, 0xaa, 0x66, 0x63, 0x53, 0x73,
-		0xe6, 0x17, 0x29, 0x71, 0xd2, 0xb5, 0xfb, 0x58, 0x29, 0xea, 0x7a, 0x9d, 0x56, 0x56, 0x68, 0x1d,
-		0x3a, 0x0f, 0x33, 0xd6, 0xee, 0xeb, 0x3a, 0xd3, 0x48, 0xb5, 0x67, 0xe3, 0x3d, 0xe3, 0x70, 0xfe,
-		0xbd, 0x54, 0xbc, 0x25, 0x52, 0x41, 0xf5, 0x71, 0x9b, 0x16, 0xa3, 0xc7, 0x41, 0xd2, 0x9d, 0x03,
-		0xcd, 0xee, 0x51, 0x93, 0xec, 0xf4, 0x34, 0x1d, 0xcf, 0x3f, 0xc2, 0x48, 0x59, 0xf9, 0xa6, 0x28,
-		0x26, 0x33, 0xc2, 0xb9, 0x63, 0xec, 0xb9, 0x02, 0xf1, 0x31, 0x36, 0x23, 0x68, 0x19, 0x47, 0x3b,
-		0x07, 0x12, 0x91, 0x44, 0xe8, 0xc5, 0xe7, 0x28, 0x59, 0xb1, 0x77, 0xd0, 0x0b, 0xbe, 0xf7, 0x61,
-		0x28, 0x10, 0x4a, 0xff, 0xa5, 0x8f, 0x33, 0xc7, 0xad, 0x77, 0x10, 0x78, 0xe3, 0x73, 0x70, 0x8a,
-		0x10, 0x75, 0xb1, 0xab, 0xb5, 0x35, 0x57, 0x0b, 0x50, 0x3f, 0x49, 0xa9, 0x89, 0xd8, 0x37, 0x78,
-		0x65, 0xa8, 0x9d, 0x76, 0x7f, 0xf7, 0xc8, 0x53, 0xac, 0xa7, 0x58, 0x3b, 0x49, 0x99, 0x50, 0xad,
-		0x77, 0xcc, 0x39, 0x97, 0x57, 0x20, 0x1f, 0xd4, 0x7b, 0x94, 0x05, 0xa6, 0xf9, 0x52, 0x8c, 0x38,
-		0x41, 0xb5, 0xad, 0x55, 0xe2, 0xbe, 0xbc, 0x56, 0x97, 0xe2, 0xc4, 0x8d, 0x5a, 0x6f, 0xb4, 0xea,
-		0xaa, 0xb2, 0xb3, 0xd9, 0x6a, 0x6c, 0xd4, 0xa5, 0x44, 0xc0, 0xb1, 0xbf, 0x9e, 0xcc, 0x3c, 0x2a,
-		0x3d, 0x26, 0xff, 0x42, 0x02, 0x8a, 0xe1, 0x48, 0x0d, 0xbd, 0x0f, 0x4e, 0x8b, 0x84, 0x8b, 0x83,
-		0x5d, 0xf5, 0x8e, 0x61, 0xd3, 0x09, 0xd9, 0xd5, 0xd8, 0xe2, 0xe8, 0xe9, 0xcf, 0x1c, 0xa7, 0x6a,
-		0x62, 0xf7, 0x65, 0xc3, 0x26, 0xd3, 0xad, 0xab, 0xb9, 0x68, 0x1d, 0x16, 0x4d, 0x4b, 0x75, 0x5c,
-		0xcd, 0x6c, 0x6b, 0x76, 0x5b, 0xf5, 0x53, 0x5d, 0xaa, 0xa6, 0xeb, 0xd8, 0x71, 0x2c, 0xb6, 0x10,
-		0x7a, 0x28, 0xef, 0x31, 0xad, 0x26, 0x27, 0xf6, 0x57, 0x88, 0x0a, 0x27, 0x1d, 0x50, 0xdf, 0xc4,
-		0x38, 0xf5, 0x3d, 0x0b, 0xd9, 0xae, 0xd6, 0x53, 0xb1, 0xe9, 0xda, 0x47, 0xd4, 0x3f, 0xcf, 0x28,
-		0x99, 0xae, 0xd6, 0xab, 0x93, 0x67, 0x74, 0x13, 0x1e, 0xf5, 0x49, 0xd5, 0x0e, 0xde, 0xd7, 0xf4,
-		0x23, 0x95, 0x3a, 0xe3, 0x34, 0x6d, 0xa0, 0xea, 0x96, 0xb9, 0xd7, 0x31, 0x74, 0xd7, 0xa1, 0xf6,
-		0x81, 0xd9, 0x38, 0xd9, 0xe7, 0x58, 0xa7, 0x0c, 0xd7, 0x1d, 0xcb, 0xa4, 0x3e, 0x78, 0x4d, 0x50,
-		0xbf, 0x2b, 0xe1, 0xd7, 0xf5, 0x64, 0x26, 0x29, 0x4d, 0x5f, 0x4f, 0x66, 0xa6, 0xa5, 0xd4, 0xf5,
-		0x64, 0x26, 0x25, 0xa5, 0xaf, 0x27, 0x33, 0x19, 0x29, 0x7b, 0x3d, 0x99, 0xc9, 0x4a, 0x20, 0xff,
-		0x6c, 0x06, 0xf2, 0xc1, 0xc8, 0x80, 0x04, 0x5a, 0x3a, 0x5d, 0x1b, 0x63, 0xd4, 0x7a, 0x3e, 0x7c,
-		0x6c, 0x1c, 0xb1, 0x5c, 0x23, 0x8b, 0xe6, 0x4a, 0x8a, 0xb9, 0xe1, 0x0a, 0xe3, 0x24, 0x0e, 0x0b,
-		0x51, 0x6b, 0xcc, 0xdc, 0x9e, 0x8c, 0xc2, 0x9f, 0xd0, 0x1a, 0xa4, 0x5e, 0x77, 0x28, 0x76, 0x8a,
-		0x62, 0xbf, 0xf7, 0x78, 0xec, 0xeb, 0x4d, 0x0a, 0x9e, 0xbd, 0xde, 0x54, 0x37, 0xb7, 0x94, 0x8d,
-		0xca, 0xba, 0xc2, 0xd9, 0xd1, 0x19, 0x48, 0x76, 0xb4, 0x37, 0x8e, 0xc2, 0xcb, 0x2b, 0x2d, 0x42,
-		0xcb, 0x50, 0xea, 0x9b, 0xb7, 0xb1, 0x6d, 0xec, 0x19, 0x64, 0xa8, 0x08, 0x55, 0x29, 0x48, 0x55,
-		0xf4, 0x6b, 0xd7, 0x09, 0xfd, 0x84, 0xea, 0x71, 0x06, 0x92, 0x77, 0xb0, 0x76, 0x2b, 0xbc, 0x08,
-		0xd2, 0x22, 0x74, 0x0e, 0xf2, 0x6d, 0xbc, 0xdb, 0xdf, 0x57, 0x6d, 0xdc, 0xd6, 0x74, 0x37, 0x6c,
-		0xfa, 0x73, 0xb4, 0x4a, 0xa1, 0x35, 0xe8, 0x06, 0x64, 0xc9, 0x18, 0x99, 0x74, 0x8c, 0x67, 0xa8,
-		0x08, 0x9e, 0x3a, 0x5e, 0x04, 0x7c, 0x88, 0x05, 0x93, 0xe2, 0xf3, 0xa3, 0xab, 0x90, 0x72, 0x35,
-		0x7b, 0x1f, 0xbb, 0xd4, 0xf2, 0x17, 0x47, 0x24, 0x3f, 0x46, 0x20, 0xb5, 0x28, 0x07, 0x8d, 0x69,
-		0x39, 0xf7, 0x3b, 0x68, 0x65, 0x2e, 0xc0, 0x34, 0x55, 0x0f, 0x04, 0xc0, 0x15, 0x44, 0x9a, 0x42,
-		0x19, 0x48, 0xd6, 0xb6, 0x14, 0x62, 0x69, 0x24, 0xc8, 0xb3, 0x52, 0x75, 0xbb, 0x51, 0xaf, 0xd5,
-		0xa5, 0xb8, 0x7c, 0x09, 0x52, 0x6c, 0xcc, 0x89, 0x15, 0xf2, 0x46, 0x5d, 0x9a, 0xe2, 0x8f, 0x1c,
-		0x23, 0x26, 0x6a, 0x77, 0x36, 0xaa, 0x75, 0x45, 0x8a, 0xcb, 0x3b, 0x50, 0x1a, 0x90, 0x13, 0x7a,
-		0x00, 0x66, 0x94, 0x7a, 0xab, 0xbe, 0x49, 0xe2, 0x2c, 0x75, 0x67, 0xf3, 0xc6, 0xe6, 0xd6, 0xcb,
-		0x9b, 0xd2, 0x54, 0xb8, 0x58, 0x98, 0xb4, 0x18, 0x9a, 0x03, 0xc9, 0x2f, 0x6e, 0x6e, 0xed, 0x28,
-		0xb4, 0x35, 0xdf, 0x18, 0x07, 0x69, 0x50, 0x6a, 0xe8, 0x34, 0xcc, 0xb6, 0x2a, 0xca, 0x5a, 0xbd,
-		0xa5, 0xb2, 0xd8, 0xd1, 0x83, 0x9e, 0x03, 0x29, 0x58, 0x71, 0xb5, 0x41, 0x43, 0xe3, 0x45, 0x38,
-		0x1b, 0x2c, 0xad, 0xbf, 0xd2, 0xaa, 0x6f, 0x36, 0xe9, 0xcb, 0x2b, 0x9b, 0x6b, 0xc4, 0xbe, 0x0e,
-		0xe0, 0x89, 0x68, 0x35, 0x41, 0x9a, 0x1a, 0xc6, 0xab, 0xaf, 0xaf, 0x4a, 0xc9, 0xc1, 0xe2, 0xad,
-		0xcd, 0xfa, 0xd6, 0x55, 0x69, 0x7a, 0xf0, 0xed, 0x34, 0x82, 0x4d, 0xa1, 0x32, 0x9c, 0x1a, 0x2c,
-		0x55, 0xeb, 0x9b, 0x2d, 0xe5, 0x55, 0x29, 0x3d, 0xf8, 0xe2, 0x66, 0x5d, 0xb9, 0xd9, 0xa8, 0xd5,
-		0xa5, 0x0c, 0x3a, 0x05, 0x28, 0xdc, 0xa2, 0xd6, 0xb5, 0xad, 0x55, 0x29, 0x3b, 0x64, 0x51, 0x64,
-		0x07, 0xf2, 0xc1, 0x30, 0xf2, 0xdd, 0xc9, 0x25, 0x7d, 0x22, 0x0e, 0xb9, 0x40, 0x58, 0x48, 0xfc,
-		0x79, 0xad, 0xd3, 0xb1, 0xee, 0xa8, 0x5a, 0xc7, 0xd0, 0x1c, 0x6e, 0x6f, 0x80, 0x16, 0x55, 0x48,
-		0xc9, 0xa4, 0xf3, 0x7b, 0x72, 0x0b, 0x9f, 0xfa, 0x9b, 0x68, 0xe1, 0xa7, 0xa5, 0x94, 0xfc, 0xdd,
-		0x31, 0x90, 0x06, 0xe3, 0xbd, 0x81, 0xee, 0xc7, 0xc6, 0x75, 0xff, 0x5d, 0x19, 0xbb, 0xef, 0x8a,
-		0x41, 0x31, 0x1c, 0xe4, 0x0d, 0x34, 0xef, 0xa1, 0xff, 0xa7, 0xcd, 0xfb, 0xbd, 0x38, 0x14, 0x42,
-		0xa1, 0xdd, 0xa4, 0xad, 0xfb, 0x08, 0xcc, 0x18, 0x6d, 0xdc, 0xed, 0x59, 0x2e, 0x36, 0xf5, 0x23,
-		0xb5, 0x83, 0x6f, 0xe3, 0xce, 0xbc, 0x4c, 0x8d, 0xf2, 0x85, 0xe3, 0x83, 0xc7, 0xe5, 0x86, 0xcf,
-		0xb7, 0x4e, 0xd8, 0x56, 0x66, 0x1b, 0xab, 0xf5, 0x8d, 0xed, 0xad, 0x56, 0x7d, 0xb3, 0xf6, 0xaa,
-		0xb0, 0x2e, 0x8a, 0x64, 0x0c, 0x90, 0xbd, 0x83, 0x46, 0x7b, 0x1b, 0xa4, 0xc1, 0x46, 0x11, 0x5b,
-		0x31, 0xa2, 0x59, 0xd2, 0x14, 0x9a, 0x85, 0xd2, 0xe6, 0x96, 0xda, 0x6c, 0xac, 0xd6, 0xd5, 0xfa,
-		0xd5, 0xab, 0xf5, 0x5a, 0xab, 0xc9, 0xd2, 0x81, 0x1e, 0x75, 0x4b, 0x8a, 0x07, 0x45, 0xfc, 0x1d,
-		0x09, 0x98, 0x1d, 0xd1, 0x12, 0x54, 0xe1, 0x81, 0x3c, 0xcb, 0x2d, 0x3c, 0x35, 0x49, 0xeb, 0x97,
-		0x89, 0x2b, 0xbd, 0xad, 0xd9, 0x2e, 0x8f, 0xfb, 0x1f, 0x07, 0x22, 0x25, 0xd3, 0x25, 0x2b, 0xbb,
-		0xcd, 0xd3, 0xac, 0x2c, 0xba, 0x2f, 0xf9, 0xe5, 0x2c, 0xd3, 0xfa, 0x24, 0xa0, 0x9e, 0xe5, 0x18,
-		0xae, 0x71, 0x1b, 0xab, 0x86, 0x29, 0x72, 0xb2, 0x24, 0xda, 0x4f, 0x2a, 0x92, 0xa8, 0x69, 0x98,
-		0xae, 0x47, 0x6d, 0xe2, 0x7d, 0x6d, 0x80, 0x9a, 0x78, 0x1e, 0x09, 0x45, 0x12, 0x35, 0x1e, 0xf5,
-		0x43, 0x90, 0x6f, 0x5b, 0x7d, 0x12, 0x02, 0x31, 0x3a, 0x62, 0x2d, 0x62, 0x4a, 0x8e, 0x95, 0x79,
-		0x24, 0x3c, 0xb8, 0xf5, 0x93, 0xc1, 0x79, 0x25, 0xc7, 0xca, 0x18, 0xc9, 0x63, 0x50, 0xd2, 0xf6,
-		0xf7, 0x6d, 0x02, 0x2e, 0x80, 0x58, 0xb8, 0x5e, 0xf4, 0x8a, 0x29, 0x61, 0xf9, 0x3a, 0x64, 0x84,
-		0x1c, 0x88, 0x07, 0x4b, 0x24, 0xa1, 0xf6, 0x58, 0x0e, 0x2a, 0x7e, 0x2e, 0xab, 0x64, 0x4c, 0x51,
-		0xf9, 0x10, 0xe4, 0x0d, 0x47, 0xf5, 0xf7, 0xb6, 0xe2, 0x4b, 0xf1, 0x73, 0x19, 0x25, 0x67, 0x38,
-		0xde, 0xbe, 0x80, 0xfc, 0x83, 0x71, 0x28, 0x86, 0x77, 0xed, 0xd0, 0x2a, 0x64, 0x3a, 0x96, 0xae,
-		0x51, 0xd5, 0x62, 0x5b, 0xc6, 0xe7, 0x22, 0x36, 0xfa, 0x96, 0xd7, 0x39, 0xbd, 0xe2, 0x71, 0x96,
-		0xff, 0x7d, 0x0c, 0x32, 0xa2, 0x18, 0x9d, 0x82, 0x64, 0x4f, 0x73, 0x0f, 0x28, 0xdc, 0x74, 0x35,
-		0x2e, 0xc5, 0x14, 0xfa, 0x4c, 0xca, 0x9d, 0x9e, 0x66, 0x52, 0x15, 0xe0, 0xe5, 0xe4, 0x99, 0x8c,
-		0x6b, 0x07, 0x6b, 0x6d, 0x9a, 0x0b, 0xb0, 0xba, 0x5d, 0x6c, 0xba, 0x8e, 0x18, 0x57, 0x5e, 0x5e,
-		0xe3, 0xc5, 0xe8, 0x09, 0x98, 0x71, 0x6d, 0xcd, 0xe8, 0x84, 0x68, 0x93, 0x94, 0x56, 0x12, 0x15,
-		0x1e, 0xf1, 0x0a, 0x9c, 0x11, 0xb8, 0x6d, 0xec, 0x6a, 0xfa, 0x01, 0x6e, 0xfb, 0x4c, 0x29, 0x9a,
-		0xf3, 0x3b, 0xcd, 0x09, 0x56, 0x79, 0xbd, 0xe0, 0x95, 0x3f, 0x13, 0x87, 0x19, 0x91, 0xbd, 0x68,
-		0x7b, 0xc2, 0xda, 0x00, 0xd0, 0x4c, 0xd3, 0x72, 0x83, 0xe2, 0x1a, 0x56, 0xe5, 0x21, 0xbe, 0xe5,
-		0x8a, 0xc7, 0xa4, 0x04, 0x00, 0xca, 0x7f, 0x1c, 0x03, 0xf0, 0xab, 0xc6, 0xca, 0x6d, 0x11, 0x72,
-		0x7c, 0x4f, 0x96, 0x6e, 0xec, 0xb3, 0x84, 0x17, 0xb0, 0xa2, 0xab, 0x46, 0x87, 0xa6, 0x25, 0x77,
-		0xf1, 0xbe, 0x61, 0xf2, 0xfd, 0x14, 0xf6, 0x20, 0xd2, 0x92, 0x49, 0x7f, 0x7b, 0x4a, 0x81, 0x8c,
-		0x83, 0xbb, 0x9a, 0xe9, 0x1a, 0x3a, 0xdf, 0x21, 0xb9, 0x7c, 0xa2, 0xc6, 0x2f, 0x37, 0x39, 0xb7,
-		0xe2, 0xe1, 0xc8, 0xe7, 0x20, 0x23, 0x4a, 0x89, 0xe3, 0xb7, 0xb9, 0xb5, 0x59, 0x97, 0xa6, 0x50,
-		0x1a, 0x12, 0xcd, 0x7a, 0x4b, 0x8a, 0x91, 0xb0, 0xb3, 0xb2, 0xde, 0xa8, 0x34, 0xa5, 0x78, 0xf5,
-		0xef, 0xc2, 0xac, 0x6e, 0x75, 0x07, 0x5f, 0x58, 0x95, 0x06, 0x52, 0x7e, 0xce, 0xb5, 0xd8, 0x6b,
-		0x4f, 0x71, 0xa2, 0x7d, 0xab, 0xa3, 0x99, 0xfb, 0xcb, 0x96, 0xbd, 0xef, 0x1f, 0x8b, 0x20, 0xd1,
-		0x81, 0x13, 0x38, 0x1c, 0xd1, 0xdb, 0xfd, 0xdf, 0xb1, 0xd8, 0xf7, 0xc4, 0x13, 0x6b, 0xdb, 0xd5,
-		0x1f, 0x8e, 0x97, 0xd7, 0x18, 0xe3, 0xb6, 0xe8, 0x8e, 0x82, 0xf7, 0x3a, 0x58, 0x27, 0x8d, 0x87,
-		0x3f, 0x79, 0x02, 0xe6, 0xf6, 0xad, 0x7d, 0x8b, 0x22, 0x5d, 0x20, 0xbf, 0xf8, 0xb9, 0x8a, 0xac,
-		0x57, 0x5a, 0x8e, 0x3c, 0x84, 0xb1, 0xb2, 0x09, 0xb3, 0x9c, 0x58, 0xa5, 0xdb, 0xb7, 0x2c, 0xb9,
-		0x80, 0x8e, 0xcd, 0x6c, 0xcf, 0xff, 0xc4, 0x1f, 0x50, 0xaf, 0x44, 0x99, 0xe1, 0xac, 0xa4, 0x8e,
-		0xe5, 0x1f, 0x56, 0x14, 0x78, 0x20, 0x84, 0xc7, 0x6c, 0x04, 0xb6, 0x23, 0x10, 0x7f, 0x85, 0x23,
-		0xce, 0x06, 0x10, 0x9b, 0x9c, 0x75, 0xa5, 0x06, 0x85, 0x93, 0x60, 0xfd, 0x2a, 0xc7, 0xca, 0xe3,
-		0x20, 0xc8, 0x1a, 0x94, 0x28, 0x88, 0xde, 0x77, 0x5c, 0xab, 0x4b, 0x0d, 0xf0, 0xf1, 0x30, 0xff,
-		0xee, 0x0f, 0xd8, 0xa4, 0x2d, 0x12, 0xb6, 0x9a, 0xc7, 0xb5, 0xb2, 0x02, 0x74, 0xc7, 0xba, 0x8d,
-		0xf5, 0x4e, 0x04, 0xc2, 0xaf, 0xf1, 0x86, 0x78, 0xf4, 0x2b, 0x37, 0x61, 0x8e, 0xfc, 0xa6, 0xf6,
-		0x31, 0xd8, 0x92, 0xe8, 0x34, 0xf8, 0xfc, 0x7f, 0xfc, 0x28, 0xb3, 0x0b, 0xb3, 0x1e, 0x40, 0xa0,
-		0x4d, 0x81, 0x51, 0xdc, 0xc7, 0xae, 0x8b, 0x6d, 0x47, 0xd5, 0x3a, 0xa3, 0x9a, 0x17, 0xc8, 0x23,
-		0xce, 0x7f, 0xfb, 0x17, 0xc2, 0xa3, 0xb8, 0xc6, 0x38, 0x2b, 0x9d, 0xce, 0xca, 0x0e, 0x9c, 0x1e,
-		0xa1, 0x15, 0x13, 0x60, 0x7e, 0x07, 0xc7, 0x9c, 0x1b, 0xd2, 0x0c, 0x02, 0xbb, 0x0d, 0xa2, 0xdc,
-		0x1b, 0xcb, 0x09, 0x30, 0xbf, 0x93, 0x63, 0x22, 0xce, 0x2b, 0x86, 0x94, 0x20, 0x5e, 0x87, 0x99,
-		0xdb, 0xd8, 0xde, 0xb5, 0x1c, 0x9e, 0xbb, 0x9d, 0x00, 0xee, 0xbb, 0x38, 0x5c, 0x89, 0x33, 0xd2,
-		0x64, 0x2e, 0xc1, 0x7a, 0x11, 0x32, 0x7b, 0x9a, 0x8e, 0x27, 0x80, 0xb8, 0xc7, 0x21, 0xd2, 0x84,
-		0x9e, 0xb0, 0x56, 0x20, 0xbf, 0x6f, 0xf1, 0x25, 0x32, 0x9a, 0xfd, 0xbb, 0x39, 0x7b, 0x4e, 0xf0,
-		0x70, 0x88, 0x9e, 0xd5, 0xeb, 0x77, 0xc8, 0xfa, 0x19, 0x0d, 0xf1, 0x4f, 0x05, 0x84, 0xe0, 0xe1,
-		0x10, 0x27, 0x10, 0xeb, 0x27, 0x05, 0x84, 0x13, 0x90, 0xe7, 0x4b, 0x90, 0xb3, 0xcc, 0xce, 0x91,
-		0x65, 0x4e, 0xd2, 0x88, 0x4f, 0x71, 0x04, 0xe0, 0x2c, 0x04, 0xe0, 0x0a, 0x64, 0x27, 0x1d, 0x88,
-		0xef, 0xff, 0x82, 0x98, 0x1e, 0x62, 0x04, 0xd6, 0xa0, 0x24, 0x0c, 0x94, 0x61, 0x99, 0x13, 0x40,
-		0xfc, 0x00, 0x87, 0x28, 0x06, 0xd8, 0x78, 0x37, 0x5c, 0xec, 0xb8, 0xfb, 0x78, 0x12, 0x90, 0x1f,
-		0x14, 0xdd, 0xe0, 0x2c, 0x5c, 0x94, 0xbb, 0xd8, 0xd4, 0x0f, 0x26, 0x43, 0xf8, 0xb4, 0x10, 0xa5,
-		0xe0, 0x21, 0x10, 0x35, 0x28, 0x74, 0x35, 0xdb, 0x39, 0xd0, 0x3a, 0x13, 0x0d, 0xc7, 0x0f, 0x71,
-		0x8c, 0xbc, 0xc7, 0xc4, 0x25, 0xd2, 0x37, 0x4f, 0x02, 0xf3, 0xc3, 0x42, 0x22, 0x01, 0x36, 0x3e,
-		0xf5, 0x1c, 0x97, 0x26, 0xba, 0x4f, 0x82, 0xf6, 0x23, 0x62, 0xea, 0x31, 0xde, 0x8d, 0x20, 0xe2,
-		0x15, 0xc8, 0x3a, 0xc6, 0x1b, 0x13, 0xc1, 0xfc, 0xa8, 0x18, 0x69, 0xca, 0x40, 0x98, 0x5f, 0x85,
-		0x33, 0x23, 0x97, 0x89, 0x09, 0xc0, 0x7e, 0x8c, 0x83, 0x9d, 0x1a, 0xb1, 0x54, 0x70, 0x93, 0x70,
-		0x52, 0xc8, 0x7f, 0x26, 0x4c, 0x02, 0x1e, 0xc0, 0xda, 0x26, 0x41, 0x8b, 0xa3, 0xed, 0x9d, 0x4c,
-		0x6a, 0xff, 0x5c, 0x48, 0x8d, 0xf1, 0x86, 0xa4, 0xd6, 0x82, 0x53, 0x1c, 0xf1, 0x64, 0xe3, 0xfa,
-		0xe3, 0xc2, 0xb0, 0x32, 0xee, 0x9d, 0xf0, 0xe8, 0x7e, 0x05, 0x94, 0x3d, 0x71, 0x0a, 0xef, 0xd8,
-		0x51, 0xbb, 0x5a, 0x6f, 0x02, 0xe4, 0x9f, 0xe0, 0xc8, 0xc2, 0xe2, 0x7b, 0xee, 0xb5, 0xb3, 0xa1,
-		0xf5, 0x08, 0xf8, 0x2b, 0x30, 0x2f, 0xc0, 0xfb, 0xa6, 0x8d, 0x75, 0x6b, 0xdf, 0x34, 0xde, 0xc0,
-		0xed, 0x09, 0xa0, 0x7f, 0x72, 0x60, 0xa8, 0x76, 0x02, 0xec, 0x04, 0xb9, 0x01, 0x92, 0xe7, 0xab,
-		0xa8, 0x46, 0xb7, 0x67, 0xd9, 0x6e, 0x04, 0xe2, 0x4f, 0x89, 0x91, 0xf2, 0xf8, 0x1a, 0x94, 0x6d,
-		0xa5, 0x0e, 0xec, 0xf4, 0xc7, 0xa4, 0x2a, 0xf9, 0xd3, 0x1c, 0xa8, 0xe0, 0x73, 0x71, 0xc3, 0xa1,
-		0x5b, 0xdd, 0x9e, 0x66, 0x4f, 0x62, 0xff, 0xfe, 0x85, 0x30, 0x1c, 0x9c, 0x85, 0x1b, 0x0e, 0xe2,
-		0xd1, 0x91, 0xd5, 0x7e, 0x02, 0x84, 0x9f, 0x11, 0x86, 0x43, 0xf0, 0x70, 0x08, 0xe1, 0x30, 0x4c,
-		0x00, 0xf1, 0xb3, 0x02, 0x42, 0xf0, 0x10, 0x88, 0x0f, 0xf9, 0x0b, 0xad, 0x8d, 0xf7, 0x0d, 0xc7,
-		0xb5, 0x99, 0x4b, 0x7e, 0x3c, 0xd4, 0xcf, 0x7d, 0x21, 0xec, 0x84, 0x29, 0x01, 0x56, 0x62, 0x89,
-		0xf8, 0xd6, 0x07, 0x0d, 0xd9, 0xa2, 0x1b, 0xf6, 0xf3, 0xc2, 0x12, 0x05, 0xd8, 0x48, 0xdb, 0x02,
-		0x1e, 0x22, 0x11, 0xbb, 0x4e, 0x02, 0x95, 0x09, 0xe0, 0xfe, 0xe5, 0x40, 0xe3, 0x9a, 0x82, 0x97,
-		0x60, 0x06, 0xfc, 0x9f, 0xbe, 0x79, 0x0b, 0x1f, 0x4d, 0xa4, 0x9d, 0xbf, 0x30, 0xe0, 0xff, 0xec,
-		0x30, 0x4e, 0x66, 0x43, 0x4a, 0x03, 0xfe, 0x14, 0x8a, 0x3a, 0xeb, 0x37, 0xff, 0x35, 0x5f, 0xe4,
-		0xfd, 0x0d, 0xbb, 0x53, 0x2b, 0xeb, 0x44, 0xc9, 0xc3, 0x4e, 0x4f, 0x34, 0xd8, 0x47, 0xbf, 0xe8,
-		0xe9, 0x79, 0xc8, 0xe7, 0x59, 0xb9, 0x0a, 0x85, 0x90, 0xc3, 0x13, 0x0d, 0xf5, 0xf7, 0x38, 0x54,
-		0x3e, 0xe8, 0xef, 0xac, 0x5c, 0x82, 0x24, 0x71, 0x5e, 0xa2, 0xd9, 0xbf, 0x8e, 0xb3, 0x53, 0xf2,
-		0x95, 0xf7, 0x43, 0x46, 0x38, 0x2d, 0xd1, 0xac, 0x5f, 0xcf, 0x59, 0x3d, 0x16, 0xc2, 0x2e, 0x1c,
-		0x96, 0x68, 0xf6, 0xbf, 0x2f, 0xd8, 0x05, 0x0b, 0x61, 0x9f, 0x5c, 0x84, 0xbf, 0xf4, 0x0f, 0x92,
-		0x7c, 0xd1, 0x11, 0xb2, 0xbb, 0x02, 0x69, 0xee, 0xa9, 0x44, 0x73, 0x7f, 0x03, 0x7f, 0xb9, 0xe0,
-		0x58, 0x79, 0x1e, 0xa6, 0x27, 0x14, 0xf8, 0x3f, 0xe4, 0xac, 0x8c, 0x7e, 0xa5, 0x06, 0xb9, 0x80,
-		0x77, 0x12, 0xcd, 0xfe, 0x4d, 0x9c, 0x3d, 0xc8, 0x45, 0x9a, 0xce, 0xbd, 0x93, 0x68, 0x80, 0x7f,
-		0x24, 0x9a, 0xce, 0x39, 0x88, 0xd8, 0x84, 0x63, 0x12, 0xcd, 0xfd, 0x71, 0x21, 0x75, 0xc1, 0xb2,
-		0xf2, 0x12, 0x64, 0xbd, 0xc5, 0x26, 0x9a, 0xff, 0x9b, 0x39, 0xbf, 0xcf, 0x43, 0x24, 0x10, 0x58,
-		0xec, 0xa2, 0x21, 0xfe, 0xb1, 0x90, 0x40, 0x80, 0x8b, 0x4c, 0xa3, 0x41, 0x07, 0x26, 0x1a, 0xe9,
-		0x5b, 0xc4, 0x34, 0x1a, 0xf0, 0x5f, 0xc8, 0x68, 0x52, 0x9b, 0x1f, 0x0d, 0xf1, 0x4f, 0xc4, 0x68,
-		0x52, 0x7a, 0xd2, 0x8c, 0x41, 0x8f, 0x20, 0x1a, 0xe3, 0xdb, 0x44, 0x33, 0x06, 0x1c, 0x82, 0x95,
-		0x6d, 0x40, 0xc3, 0xde, 0x40, 0x34, 0xde, 0x27, 0x38, 0xde, 0xcc, 0x90, 0x33, 0xb0, 0xf2, 0x32,
-		0x9c, 0x1a, 0xed, 0x09, 0x44, 0xa3, 0x7e, 0xfb, 0x17, 0x07, 0x62, 0xb7, 0xa0, 0x23, 0xb0, 0xd2,
-		0xf2, 0x97, 0x94, 0xa0, 0x17, 0x10, 0x0d, 0xfb, 0x1d, 0x5f, 0x0c, 0x1b, 0xee, 0xa0, 0x13, 0xb0,
-		0x52, 0x01, 0xf0, 0x17, 0xe0, 0x68, 0xac, 0xef, 0xe2, 0x58, 0x01, 0x26, 0x32, 0x35, 0xf8, 0xfa,
-		0x1b, 0xcd, 0x7f, 0x4f, 0x4c, 0x0d, 0xce, 0x41, 0xa6, 0x86, 0x58, 0x7a, 0xa3, 0xb9, 0xbf, 0x5b,
-		0x4c, 0x0d, 0xc1, 0x42, 0x34, 0x3b, 0xb0, 0xba, 0x45, 0x23, 0x7c, 0x4a, 0x68, 0x76, 0x80, 0x6b,
-		0x65, 0x13, 0x66, 0x86, 0x16, 0xc4, 0x68, 0xa8, 0xef, 0xe1, 0x50, 0xd2, 0xe0, 0x7a, 0x18, 0x5c,
-		0xbc, 0xf8, 0x62, 0x18, 0x8d, 0xf6, 0xbd, 0x03, 0x8b, 0x17, 0x5f, 0x0b, 0x57, 0xae, 0x40, 0xc6,
-		0xec, 0x77, 0x3a, 0x64, 0xf2, 0xa0, 0xe3, 0xcf, 0xe7, 0xce, 0xff, 0xf7, 0x2f, 0x71, 0xe9, 0x08,
-		0x86, 0x95, 0x4b, 0x30, 0x8d, 0xbb, 0xbb, 0xb8, 0x1d, 0xc5, 0xf9, 0x47, 0x5f, 0x12, 0x06, 0x93,
-		0x50, 0xaf, 0xbc, 0x04, 0xc0, 0x52, 0x23, 0x74, 0xe3, 0x3c, 0x82, 0xf7, 0x8f, 0xbf, 0xc4, 0x0f,
-		0xc4, 0xf9, 0x2c, 0x3e, 0x00, 0x3b, 0x5e, 0x77, 0x3c, 0xc0, 0x17, 0xc2, 0x00, 0x74, 0x44, 0x5e,
-		0x84, 0xf4, 0xeb, 0x8e, 0x65, 0xba, 0xda, 0x7e, 0x14, 0xf7, 0x9f, 0x70, 0x6e, 0x41, 0x4f, 0x04,
-		0xd6, 0xb5, 0x6c, 0xec, 0x6a, 0xfb, 0x4e, 0x14, 0xef, 0xff, 0xe0, 0xbc, 0x1e, 0x03, 0x61, 0xd6,
-		0x35, 0xc7, 0x9d, 0xa4, 0xdf, 0x7f, 0x2a, 0x98, 0x05, 0x03, 0x69, 0x34, 0xf9, 0x7d, 0x0b, 0x1f,
-		0x45, 0xf1, 0xfe, 0x99, 0x68, 0x34, 0xa7, 0x5f, 0x79, 0x3f, 0x64, 0xc9, 0x4f, 0x76, 0xca, 0x35,
-		0x82, 0xf9, 0xcf, 0x39, 0xb3, 0xcf, 0x41, 0xde, 0xec, 0xb8, 0x6d, 0xd7, 0x88, 0x16, 0xf6, 0x5f,
-		0xf0, 0x91, 0x16, 0xf4, 0x2b, 0x15, 0xc8, 0x39, 0x6e, 0xbb, 0xdd, 0xe7, 0xfe, 0x69, 0x04, 0xfb,
-		0xff, 0xfc, 0x92, 0x97, 0xb2, 0xf0, 0x78, 0xc8, 0x68, 0xdf, 0xb9, 0xe5, 0xf6, 0x2c, 0xba, 0xdf,
-		0x12, 0x85, 0xf0, 0x45, 0x8e, 0x10, 0x60, 0x59, 0xa9, 0x41, 0x9e, 0xf4, 0xc5, 0xc6, 0x3d, 0x4c,
-		0x37, 0xc7, 0x22, 0x20, 0xfe, 0x92, 0x0b, 0x20, 0xc4, 0x54, 0xfd, 0xaa, 0x5f, 0xfb, 0xec, 0x42,
-		0xec, 0x33, 0x9f, 0x5d, 0x88, 0xfd, 0xde, 0x67, 0x17, 0x62, 0x1f, 0xff, 0xdc, 0xc2, 0xd4, 0x67,
-		0x3e, 0xb7, 0x30, 0xf5, 0xdb, 0x9f, 0x5b, 0x98, 0x1a, 0x9d, 0x25, 0x86, 0x35, 0x6b, 0xcd, 0x62,
-		0xf9, 0xe1, 0xd7, 0x1e, 0xd9, 0x37, 0xdc, 0x83, 0xfe, 0xee, 0xb2, 0x6e, 0x75, 0x2f, 0xe8, 0x96,
-		0xd3, 0xb5, 0x9c, 0x0b, 0xe1, 0xbc, 0x2e, 0xfd, 0x05, 0x7f, 0x15, 0x23, 0x31, 0x73, 0x38, 0x9d,
-		0xab, 0x99, 0x47, 0xe3, 0x3e, 0xa6, 0xbb, 0x0c, 0x89, 0x8a, 0x79, 0x84, 0xce, 0x30, 0x03, 0xa7,
-		0xf6, 0xed, 0x0e, 0x3f, 0x6a, 0x99, 0x26, 0xcf, 0x3b, 0x76, 0x07, 0xcd, 0xf9, 0xe7, 0xa1, 0x63,
-		0xe7, 0xf2, 0xfc, 0x90, 0x73, 0xf5, 0x9b, 0x62, 0x27, 0xeb, 0x49, 0xa6, 0x62, 0x1e, 0xd1, 0x8e,
-		0x6c, 0xc7, 0x5e, 0x7b, 0x32, 0x32, 0xcf, 0x7d, 0xcb, 0xb4, 0xee, 0x98, 0xa4, 0xd9, 0xbd, 0x5d,
-		0x91, 0xe3, 0x5e, 0x18, 0xcc, 0x71, 0xbf, 0x8c, 0x3b, 0x9d, 0x1b, 0x84, 0xae, 0x45, 0x58, 0x76,
-		0x53, 0xec, 0x54, 0x3f, 0x7c, 0x4b, 0x1c, 0x16, 0x86, 0xd2, 0xd9, 0x5c, 0x09, 0xc6, 0x09, 0x61,
-		0x05, 0x32, 0xab, 0x42, 0xb7, 0xe6, 0x21, 0xed, 0x60, 0xdd, 0x32, 0xdb, 0x0e, 0x15, 0x44, 0x42,
-		0x11, 0x8f, 0x44, 0x10, 0xa6, 0x66, 0x5a, 0x0e, 0x3f, 0xac, 0xcc, 0x1e, 0xaa, 0xdf, 0x79, 0x42,
-		0x41, 0x14, 0xc4, 0x9b, 0x84, 0x34, 0x9e, 0x99, 0x50, 0x1a, 0xa2, 0x13, 0xa1, 0xcc, 0xff, 0xa4,
-		0x52, 0xf9, 0xb6, 0x38, 0x2c, 0x0e, 0x4a, 0x85, 0xcc, 0x2c, 0xc7, 0xd5, 0xba, 0xbd, 0x71, 0x62,
-		0xb9, 0x02, 0xd9, 0x96, 0xa0, 0x39, 0xb1, 0x5c, 0xee, 0x9d, 0x50, 0x2e, 0x45, 0xef, 0x55, 0x42,
-		0x30, 0x17, 0x27, 0x14, 0x8c, 0xd7, 0x8f, 0xfb, 0x92, 0xcc, 0xff, 0x49, 0xc1, 0x19, 0x36, 0x9d,
-		0x54, 0x36, 0x95, 0xd8, 0x03, 0x97, 0x49, 0x3e, 0x58, 0x15, 0xbd, 0x4f, 0x22, 0xdf, 0x80, 0xd9,
-		0x06, 0xb1, 0x16, 0x24, 0x0a, 0xf2, 0x77, 0x78, 0x46, 0x9e, 0xe7, 0x5e, 0x0a, 0x39, 0xfc, 0x7c,
-		0x7f, 0x2b, 0x58, 0x24, 0x7f, 0x4d, 0x0c, 0xa4, 0xa6, 0xae, 0x75, 0x34, 0xfb, 0xcb, 0x85, 0x42,
-		0xcf, 0x03, 0xb0, 0xe3, 0x1e, 0xde, 0x87, 0x7b, 0xc5, 0x8b, 0xf3, 0xcb, 0xc1, 0xce, 0x2d, 0xb3,
-		0x37, 0xd1, 0x13, 0x54, 0x59, 0x4a, 0x4b, 0x7e, 0x9e, 0x7f, 0x05, 0xc0, 0xaf, 0x40, 0x67, 0xe1,
-		0x74, 0xb3, 0x56, 0x59, 0xaf, 0x28, 0xe2, 0x90, 0x50, 0x73, 0xbb, 0x5e, 0x6b, 0x5c, 0x6d, 0xd4,
-		0x57, 0xa5, 0x29, 0x74, 0x0a, 0x50, 0xb0, 0xd2, 0x3b, 0xd4, 0xf4, 0x00, 0xcc, 0x04, 0xcb, 0xd9,
-		0x57, 0x2a, 0x71, 0xe2, 0x29, 0x1a, 0xdd, 0x5e, 0x07, 0xd3, 0x9d, 0x47, 0xd5, 0x10, 0x52, 0x8b,
-		0x76, 0x42, 0x7e, 0xfd, 0x3f, 0xb1, 0x2f, 0x17, 0x66, 0x7d, 0x76, 0x4f, 0xe6, 0x2b, 0xeb, 0x30,
-		0xa3, 0xe9, 0x3a, 0xee, 0x85, 0x20, 0x23, 0x4c, 0x35, 0x01, 0xa4, 0x7b, 0xa9, 0x9c, 0xd3, 0x47,
-		0x7b, 0x1e, 0x52, 0x0e, 0xed, 0x7d, 0x14, 0xc4, 0x6f, 0x70, 0x08, 0x4e, 0xbe, 0x62, 0xc2, 0x0c,
-		0xf1, 0xfc, 0x34, 0x1b, 0x07, 0x9a, 0x71, 0x7c, 0x9e, 0xe1, 0x5f, 0xfd, 0xd4, 0xd3, 0x74, 0x67,
-		0xf5, 0xa1, 0xf0, 0xb0, 0x8c, 0x50, 0x27, 0x45, 0xe2, 0xd8, 0x7e, 0x43, 0x31, 0x14, 0xc5, 0xfb,
-		0x78, 0x83, 0x8f, 0x7f, 0xd9, 0x2f, 0xf2, 0x97, 0x2d, 0x8c, 0xd2, 0x81, 0xc0, 0x9b, 0x0a, 0x1c,
-		0x95, 0x55, 0x54, 0xeb, 0xe3, 0xe6, 0xf4, 0x6b, 0x4f, 0x0c, 0xaf, 0x4e, 0xec, 0xbf, 0xa7, 0x28,
-		0xf2, 0x95, 0xe0, 0x6b, 0xbc, 0xb9, 0xf7, 0x3b, 0x09, 0x98, 0xd1, 0xba, 0x86, 0x69, 0x5d, 0xa0,
-		0xff, 0xf2, 0x39, 0x37, 0x4d, 0x1f, 0x26, 0xd8, 0x94, 0xbc, 0xcc, 0xa6, 0x42, 0xb4, 0xc6, 0xfc,
-		0xf9, 0x37, 0xfe, 0xc0, 0xb4, 0x3f, 0x5d, 0x56, 0x36, 0x40, 0x12, 0x87, 0x78, 0xb1, 0xa9, 0x5b,
-		0xed, 0x89, 0xb2, 0x14, 0x7f, 0x21, 0x30, 0x44, 0x7e, 0xab, 0xce, 0x59, 0x57, 0xde, 0x07, 0x19,
-		0x0f, 0x26, 0xca, 0x33, 0x11, 0x20, 0x1e, 0x07, 0xf1, 0x4b, 0xd8, 0xcc, 0x9c, 0xc4, 0x0b, 0xfd,
-		0xa2, 0xe0, 0x67, 0x33, 0x74, 0x93, 0xf4, 0x66, 0x0d, 0x8a, 0x6d, 0xcb, 0x74, 0x55, 0xab, 0x6b,
-		0xb8, 0xb8, 0xdb, 0x73, 0x23, 0xfd, 0xba, 0xbf, 0x64, 0x20, 0x19, 0xa5, 0x40, 0xf8, 0xb6, 0x04,
-		0xdb, 0x7d, 0x0d, 0xae, 0xd3, 0xbe, 0xc5, 0x6d, 0xb1, 0x7b, 0xc8, 0x06, 0xd1, 0x1b, 0xdc, 0x3f,
-		0x4d, 0xc0, 0x02, 0x27, 0xde, 0xd5, 0x1c, 0x7c, 0xe1, 0xf6, 0x33, 0xbb, 0xd8, 0xd5, 0x9e, 0xb9,
-		0xa0, 0x5b, 0x86, 0x58, 0x88, 0x67, 0xb9, 0xad, 0x25, 0xf5, 0xcb, 0xbc, 0xbe, 0x3c, 0x72, 0xb7,
-		0xba, 0x3c, 0xde, 0x46, 0x97, 0x87, 0x55, 0x48, 0x7e, 0x0d, 0x92, 0x35, 0xcb, 0x30, 0xc9, 0xd2,
-		0xd4, 0xc6, 0xa6, 0xd5, 0xe5, 0xd6, 0x92, 0x3d, 0xa0, 0xcb, 0x90, 0xd2, 0xba, 0x56, 0xdf, 0x74,
-		0x99, 0xa5, 0xac, 0x2e, 0xfc, 0xda, 0x5b, 0x8b, 0x53, 0xbf, 0xf3, 0xd6, 0x62, 0xa2, 0x61, 0xba,
-		0xbf, 0xf5, 0xd3, 0x4f, 0x01, 0x47, 0x6f, 0x98, 0xee, 0xa7, 0xff, 0xf0, 0xc7, 0xcf, 0xc7, 0x14,
-		0x4e, 0xbd, 0x92, 0xfc, 0xfc, 0x27, 0x17, 0x63, 0xf2, 0x2b, 0x90, 0x5e, 0xc5, 0xfa, 0x31, 0xf0,
-		0xcf, 0x0c, 0xc0, 0x9f, 0x11, 0xf0, 0xab, 0x58, 0x0f, 0xc0, 0xaf, 0x62, 0x7d, 0x00, 0xf9, 0x79,
-		0xc8, 0x34, 0x4c, 0x97, 0x7d, 0x01, 0xf4, 0x04, 0x24, 0x0c, 0x93, 0x1d, 0x2a, 0x0f, 0x20, 0x0c,
-		0x35, 0x50, 0x21, 0x54, 0x84, 0x71, 0x15, 0xeb, 0x1e, 0x63, 0x1b, 0xeb, 0x83, 0x8c, 0xc3, 0xaf,
-		0x26, 0x54, 0xd5, 0xd5, 0xdf, 0xfe, 0xfd, 0x85, 0xa9, 0x37, 0x3f, 0xbb, 0x30, 0x35, 0x76, 0xe8,
-		0xe5, 0xe8, 0xa1, 0xf7, 0x46, 0xfc, 0x07, 0x92, 0xf0, 0x20, 0xfd, 0x30, 0xd4, 0xee, 0x1a, 0xa6,
-		0x7b, 0x41, 0xb7, 0x8f, 0x7a, 0xae, 0x45, 0xe6, 0xaf, 0xb5, 0xc7, 0x07, 0x7c, 0xc6, 0xaf, 0x5e,
-		0x66, 0xd5, 0xa3, 0x87, 0x5b, 0xde, 0x83, 0xe9, 0x6d, 0xc2, 0x47, 0x44, 0xec, 0x5a, 0xae, 0xd6,
-		0xe1, 0x4e, 0x07, 0x7b, 0x20, 0xa5, 0xec, 0x63, 0xd2, 0x38, 0x2b, 0x35, 0xc4, 0x77, 0xa4, 0x1d,
-		0xac, 0xed, 0xb1, 0x6f, 0x72, 0x12, 0xd4, 0x5b, 0xcd, 0x90, 0x02, 0xfa, 0xf9, 0xcd, 0x1c, 0x4c,
-		0x6b, 0x7d, 0x76, 0x6e, 0x26, 0x41, 0xdc, 0x58, 0xfa, 0x20, 0xdf, 0x80, 0x34, 0xdf, 0x3e, 0x47,
-		0x12, 0x24, 0x6e, 0xe1, 0x23, 0xfa, 0x9e, 0xbc, 0x42, 0x7e, 0xa2, 0x65, 0x98, 0xa6, 0x8d, 0xe7,
-		0x1f, 0x1b, 0xce, 0x2f, 0x0f, 0xb5, 0x7e, 0x99, 0x36, 0x52, 0x61, 0x64, 0xf2, 0x75, 0xc8, 0xac,
-		0x5a, 0x44, 0x0b, 0xc3, 0x68, 0x59, 0x86, 0x46, 0xdb, 0xdc, 0xeb, 0x73, 0xad, 0x50, 0xd8, 0x03,
-		0x3a, 0x05, 0x29, 0xf6, 0x8d, 0x16, 0x3f, 0xfb, 0xc3, 0x9f, 0xe4, 0x1a, 0xa4, 0x29, 0xf6, 0x56,
-		0x8f, 0xac, 0xf8, 0xde, 0xb1, 0xf5, 0x2c, 0xff, 0x62, 0x97, 0xc3, 0xc7, 0xfd, 0xc6, 0x22, 0x48,
-		0xb6, 0x35, 0x57, 0xe3, 0xfd, 0xa6, 0xbf, 0xe5, 0x0f, 0x40, 0x86, 0x83, 0x38, 0xe8, 0x22, 0x24,
-		0xac, 0x9e, 0xc3, 0x4f, 0xef, 0x94, 0xc7, 0x75, 0x65, 0xab, 0x57, 0x4d, 0x12, 0x9d, 0x51, 0x08,
-		0x71, 0x75, 0x73, 0xac, 0x5a, 0x3c, 0x17, 0x52, 0x8b, 0x2e, 0x76, 0x77, 0xf7, 0x5c, 0xff, 0x07,
-		0x1b, 0xce, 0x21, 0x55, 0xf0, 0x14, 0xe5, 0x5e, 0x1c, 0x16, 0x02, 0xb5, 0xb7, 0xb1, 0xed, 0x18,
-		0x96, 0xc9, 0xb4, 0x89, 0x6b, 0x0a, 0x0a, 0x34, 0x90, 0xd7, 0x8f, 0x51, 0x95, 0xf7, 0x43, 0xa2,
-		0xd2, 0xeb, 0xa1, 0x32, 0x64, 0xe8, 0xb3, 0x6e, 0x31, 0x5d, 0x49, 0x2a, 0xde, 0x33, 0xa9, 0x73,
-		0xac, 0x3d, 0xf7, 0x8e, 0x66, 0x7b, 0x9f, 0x30, 0x8b, 0x67, 0xf9, 0x45, 0xc8, 0xd6, 0x2c, 0xd3,
-		0xc1, 0xa6, 0xd3, 0xa7, 0xae, 0xec, 0x6e, 0xc7, 0xd2, 0x6f, 0x71, 0x04, 0xf6, 0x40, 0x84, 0xad,
-		0xf5, 0x7a, 0x94, 0x33, 0xa9, 0x90, 0x9f, 0x6c, 0xbe, 0x56, 0xb7, 0xc6, 0x8a, 0xe7, 0xd2, 0xc9,
-		0xc4, 0xc3, 0x3b, 0xe8, 0xfb, 0xa4, 0x31, 0x78, 0xcf, 0xf0, 0x44, 0xba, 0x85, 0x8f, 0x9c, 0x93,
-		0xce, 0xa3, 0x57, 0x20, 0xbb, 0x4d, 0x6f, 0x17, 0xb9, 0x81, 0x8f, 0x50, 0x19, 0xd2, 0xb8, 0x7d,
-		0xf1, 0xd2, 0xa5, 0x67, 0x5e, 0x64, 0x5a, 0x7e, 0x6d, 0x4a, 0x11, 0x05, 0x68, 0x01, 0xb2, 0x0e,
-		0xd6, 0x7b, 0x17, 0x2f, 0x5d, 0xbe, 0xf5, 0x0c, 0x53, 0xab, 0x6b, 0x53, 0x8a, 0x5f, 0xb4, 0x92,
-		0x21, 0x3d, 0xfe, 0xfc, 0xa7, 0x16, 0x63, 0xd5, 0x69, 0x48, 0x38, 0xfd, 0xee, 0x3b, 0xa6, 0x1b,
-		0xdf, 0x3a, 0x0d, 0x4b, 0x81, 0x5a, 0xb6, 0xb8, 0xdc, 0xd6, 0x3a, 0x46, 0x5b, 0xf3, 0xef, 0x84,
-		0x91, 0x02, 0xfd, 0xa7, 0x14, 0x63, 0x56, 0x8d, 0x63, 0xa5, 0x28, 0xff, 0x64, 0x0c, 0xf2, 0x37,
-		0x05, 0x72, 0x13, 0xbb, 0xe8, 0x0a, 0x80, 0xf7, 0x26, 0x31, 0x55, 0xce, 0x2e, 0x0f, 0xbe, 0x6b,
-		0xd9, 0xe3, 0x51, 0x02, 0xe4, 0xe8, 0x79, 0xaa, 0x80, 0x3d, 0xcb, 0xe1, 0x9f, 0xb3, 0x46, 0xb0,
-		0x7a, 0xc4, 0xe8, 0x49, 0x40, 0xd4, 0xaa, 0xa9, 0xb7, 0x2d, 0xd7, 0x30, 0xf7, 0xd5, 0x9e, 0x75,
-		0x87, 0x5f, 0x12, 0x90, 0x50, 0x24, 0x5a, 0x73, 0x93, 0x56, 0x6c, 0x93, 0x72, 0xd2, 0xe8, 0xac,
-		0x87, 0x42, 0xa2, 0x32, 0xad, 0xdd, 0xb6, 0xb1, 0xe3, 0x70, 0xc3, 0x25, 0x1e, 0xd1, 0x15, 0x48,
-		0xf7, 0xfa, 0xbb, 0xaa, 0xb0, 0x12, 0xb9, 0x8b, 0xef, 0x19, 0x35, 0xe7, 0x85, 0x6e, 0xf0, 0x59,
-		0x9f, 0xea, 0xf5, 0x77, 0x89, 0xa6, 0x3c, 0x04, 0xf9, 0x11, 0x8d, 0xc9, 0xdd, 0xf6, 0xdb, 0x41,
-		0x2f, 0xb4, 0xe1, 0x3d, 0x50, 0x7b, 0xb6, 0x61, 0xd9, 0x86, 0x7b, 0x44, 0x4f, 0xdd, 0x25, 0x14,
-		0x49, 0x54, 0x6c, 0xf3, 0x72, 0xf9, 0x16, 0x94, 0x9a, 0xd4, 0x5b, 0xf7, 0x5b, 0x7e, 0xc9, 0x6f,
-		0x5f, 0x2c, 0xba, 0x7d, 0x63, 0x5b, 0x16, 0x1f, 0x6a, 0x59, 0x75, 0x63, 0xac, 0x66, 0x3e, 0x7b,
-		0x32, 0xcd, 0x0c, 0xaf, 0x6e, 0x3f, 0x52, 0x0e, 0x4d, 0x4a, 0xee, 0xf5, 0x04, 0x4c, 0xd6, 0xa4,
-		0x4a, 0x19, 0x15, 0x88, 0x97, 0x8f, 0x5f, 0x44, 0xcb, 0x11, 0xa6, 0xb3, 0x1c, 0x39, 0x7d, 0xe4,
-		0x17, 0xa1, 0xb0, 0xad, 0xd9, 0x6e, 0x13, 0xbb, 0xd7, 0xb0, 0xd6, 0xc6, 0x76, 0x78, 0x95, 0x2d,
-		0x88, 0x55, 0x16, 0x41, 0x92, 0x2e, 0xa5, 0x6c, 0x95, 0xa1, 0xbf, 0xe5, 0x03, 0x48, 0xd2, 0x63,
-		0xb7, 0xde, 0x0a, 0xcc, 0x39, 0xd8, 0x0a, 0x4c, 0xec, 0xe7, 0x91, 0x8b, 0x1d, 0x91, 0x2b, 0xa2,
-		0x0f, 0xe8, 0x39, 0xb1, 0x8e, 0x26, 0x8e, 0x5f, 0x47, 0xb9, 0x12, 0xf2, 0xd5, 0xb4, 0x03, 0xe9,
-		0x2a, 0x31, 0xbf, 0x8d, 0x55, 0xaf, 0x21, 0x31, 0xbf, 0x21, 0x68, 0x03, 0x4a, 0x3d, 0xcd, 0x76,
-		0xe9, 0x67, 0x78, 0x07, 0xb4, 0x17, 0x5c, 0xcf, 0x17, 0x87, 0x67, 0x5d, 0xa8, 0xb3, 0xfc, 0x2d,
-		0x85, 0x5e, 0xb0, 0x50, 0xfe, 0x6f, 0x49, 0x48, 0x71, 0x61, 0xbc, 0x1f, 0xd2, 0x5c, 0xac, 0x5c,
-		0x33, 0x1f, 0x5c, 0x1e, 0x5e, 0x8c, 0x96, 0xbd, 0x45, 0x83, 0xe3, 0x09, 0x1e, 0xf4, 0x28, 0x64,
-		0xf4, 0x03, 0xcd, 0x30, 0x55, 0xa3, 0xcd, 0x1d, 0xc0, 0xdc, 0x67, 0xdf, 0x5a, 0x4c, 0xd7, 0x48,
-		0x59, 0x63, 0x55, 0x49, 0xd3, 0xca, 0x46, 0x9b, 0xac, 0xfc, 0x07, 0xd8, 0xd8, 0x3f, 0x70, 0xf9,
-		0xec, 0xe2, 0x4f, 0xe8, 0x05, 0x48, 0x12, 0x85, 0xe0, 0x1f, 0x69, 0x97, 0x87, 0x7c, 0x79, 0x2f,
-		0x4f, 0x52, 0xcd, 0x90, 0x17, 0x7f, 0xfc, 0xbf, 0x2e, 0xc6, 0x14, 0xca, 0x81, 0x6a, 0x50, 0xe8,
-		0x68, 0x8e, 0xab, 0xd2, 0x55, 0x8b, 0xbc, 0x7e, 0x9a, 0x42, 0x9c, 0x19, 0x16, 0x08, 0x17, 0x2c,
-		0x6f, 0x7a, 0x8e, 0x70, 0xb1, 0xa2, 0x36, 0x3a, 0x07, 0x12, 0x05, 0xd1, 0xad, 0x6e, 0xd7, 0x70,
-		0x99, 0x2f, 0x95, 0xa2, 0x72, 0x2f, 0x92, 0xf2, 0x1a, 0x2d, 0xa6, 0x1e, 0xd5, 0x59, 0xc8, 0xd2,
-		0xcf, 0x42, 0x29, 0x09, 0x3b, 0xeb, 0x9d, 0x21, 0x05, 0xb4, 0xf2, 0x31, 0x28, 0xf9, 0xb6, 0x91,
-		0x91, 0x64, 0x18, 0x8a, 0x5f, 0x4c, 0x09, 0x9f, 0x86, 0x39, 0x13, 0x1f, 0xd2, 0xd3, 0xe7, 0x21,
-		0xea, 0x2c, 0xa5, 0x46, 0xa4, 0xee, 0x66, 0x98, 0xe3, 0x11, 0x28, 0xea, 0x42, 0xf8, 0x8c, 0x16,
-		0x28, 0x6d, 0xc1, 0x2b, 0xa5, 0x64, 0x67, 0x20, 0xa3, 0xf5, 0x7a, 0x8c, 0x20, 0xc7, 0x6d, 0x63,
-		0xaf, 0x47, 0xab, 0xce, 0xc3, 0x0c, 0xed, 0xa3, 0x8d, 0x9d, 0x7e, 0xc7, 0xe5, 0x20, 0x79, 0x4a,
-		0x53, 0x22, 0x15, 0x0a, 0x2b, 0xa7, 0xb4, 0x0f, 0x43, 0x01, 0xdf, 0x36, 0xda, 0xd8, 0xd4, 0x31,
-		0xa3, 0x2b, 0x50, 0xba, 0xbc, 0x28, 0xa4, 0x44, 0x8f, 0x83, 0x67, 0xf3, 0x54, 0x61, 0x8f, 0x8b,
-		0x0c, 0x4f, 0x94, 0x57, 0x58, 0xb1, 0x3c, 0x0f, 0xc9, 0x55, 0xcd, 0xd5, 0x88, 0x53, 0xe1, 0x1e,
-		0xb2, 0x45, 0x26, 0xaf, 0x90, 0x9f, 0xf2, 0xe7, 0xe3, 0x90, 0xbc, 0x69, 0xb9, 0x18, 0x3d, 0x1b,
-		0x70, 0xf8, 0x8a, 0xa3, 0xf4, 0xb9, 0x69, 0xec, 0x9b, 0xb8, 0xbd, 0xe1, 0xec, 0x07, 0xee, 0x70,
-		0xf1, 0xd5, 0x29, 0x1e, 0x52, 0xa7, 0x39, 0x98, 0xb6, 0xad, 0xbe, 0xd9, 0x16, 0xa7, 0xa4, 0xe9,
-		0x03, 0xaa, 0x43, 0xc6, 0xd3, 0x92, 0x64, 0x94, 0x96, 0x94, 0x88, 0x96, 0x10, 0x1d, 0xe6, 0x05,
-		0x4a, 0x7a, 0x97, 0x2b, 0x4b, 0x15, 0xb2, 0x9e, 0xf1, 0xe2, 0xda, 0x36, 0x99, 0xc2, 0xfa, 0x6c,
-		0x64, 0x21, 0xf1, 0xc6, 0xde, 0x13, 0x1e, 0xd3, 0x38, 0xc9, 0xab, 0xe0, 0xd2, 0x0b, 0xa9, 0x15,
-		0xbf, 0x4f, 0x26, 0x4d, 0xfb, 0xe5, 0xab, 0x15, 0xbb, 0x53, 0xe6, 0x3d, 0x90, 0x75, 0x8c, 0x7d,
-		0x53, 0x73, 0xfb, 0x36, 0xe6, 0x9a, 0xe7, 0x17, 0xc8, 0xbf, 0x14, 0x83, 0x14, 0xd3, 0xe4, 0x80,
-		0xdc, 0x62, 0xa3, 0xe5, 0x16, 0x1f, 0x27, 0xb7, 0xc4, 0xfd, 0xcb, 0xad, 0x02, 0xe0, 0x35, 0xc6,
-		0xe1, 0xd7, 0x7c, 0x8c, 0xf0, 0x16, 0x58, 0x13, 0x9b, 0xc6, 0x3e, 0x9f, 0xa8, 0x01, 0x26, 0xf9,
-		0xbf, 0xc4, 0x88, 0xe3, 0xca, 0xeb, 0x51, 0x05, 0x0a, 0xa2, 0x5d, 0xea, 0x5e, 0x47, 0xdb, 0xe7,
-		0xba, 0xf3, 0xe0, 0xd8, 0xc6, 0x5d, 0xed, 0x68, 0xfb, 0x4a, 0x8e, 0xb7, 0x87, 0x3c, 0x8c, 0x1e,
-		0x87, 0xf8, 0x98, 0x71, 0x08, 0x0d, 0x7c, 0xe2, 0xfe, 0x06, 0x3e, 0x34, 0x44, 0xc9, 0xc1, 0x21,
-		0xfa, 0xa9, 0x38, 0x0d, 0x5e, 0x7a, 0x96, 0xa3, 0x75, 0xde, 0x8d, 0x19, 0x71, 0x16, 0xb2, 0x3d,
-		0xab, 0xa3, 0xb2, 0x1a, 0xf6, 0xf5, 0x40, 0xa6, 0x67, 0x75, 0x94, 0xa1, 0x61, 0x9f, 0x7e, 0x9b,
-		0xa6, 0x4b, 0xea, 0x6d, 0x90, 0x5a, 0x7a, 0x50, 0x6a, 0x36, 0xe4, 0x99, 0x28, 0xf8, 0x5a, 0xf6,
-		0x34, 0x91, 0x01, 0x5d, 0x1c, 0x63, 0xc3, 0x6b, 0x2f, 0x6b, 0x36, 0xa3, 0x54, 0x38, 0x1d, 0xe1,
-		0x60, 0xa6, 0x7f, 0x54, 0xd4, 0x1b, 0x54, 0x4b, 0x85, 0xd3, 0xc9, 0xdf, 0x1a, 0x03, 0x58, 0x27,
-		0x92, 0xa5, 0xfd, 0x25, 0xab, 0x90, 0x43, 0x9b, 0xa0, 0x86, 0xde, 0xbc, 0x30, 0x6e, 0xd0, 0xf8,
-		0xfb, 0xf3, 0x4e, 0xb0, 0xdd, 0x35, 0x28, 0xf8, 0xca, 0xe8, 0x60, 0xd1, 0x98, 0x85, 0x63, 0x3c,
-		0xea, 0x26, 0x76, 0x95, 0xfc, 0xed, 0xc0, 0x93, 0xfc, 0x6f, 0x62, 0x90, 0xa5, 0x6d, 0xda, 0xc0,
-		0xae, 0x16, 0x1a, 0xc3, 0xd8, 0xfd, 0x8f, 0xe1, 0x83, 0x00, 0x0c, 0xc6, 0x31, 0xde, 0xc0, 0x5c,
-		0xb3, 0xb2, 0xb4, 0xa4, 0x69, 0xbc, 0x81, 0xd1, 0x65, 0x4f, 0xe0, 0x89, 0xe3, 0x05, 0x2e, 0x3c,
-		0x6e, 0x2e, 0xf6, 0xd3, 0x90, 0xa6, 0xd7, 0xe2, 0x1d, 0x3a, 0xdc, 0x89, 0x4e, 0x99, 0xfd, 0x6e,
-		0xeb, 0xd0, 0x91, 0x5f, 0x87, 0x74, 0xeb, 0x90, 0xe5, 0x42, 0xce, 0x42, 0xd6, 0xb6, 0x2c, 0xbe,
-		0x26, 0x33, 0x5f, 0x28, 0x43, 0x0a, 0xe8, 0x12, 0x24, 0xe2, 0xff, 0xb8, 0x1f, 0xff, 0xfb, 0x09,
-		0x8c, 0xc4, 0x64, 0x09, 0x8c, 0x0f, 0x40, 0x4a, 0xc1, 0xb7, 0xb1, 0xd6, 0x19, 0x6b, 0x15, 0x43,
-		0xda, 0x17, 0x1f, 0xd0, 0xbe, 0xf3, 0xff, 0x39, 0x06, 0xb9, 0x80, 0x7d, 0x41, 0xcf, 0xc0, 0x03,
-		0xd5, 0xf5, 0xad, 0xda, 0x0d, 0xb5, 0xb1, 0xaa, 0x5e, 0x5d, 0xaf, 0xac, 0xf9, 0x1f, 0xd8, 0x95,
-		0x4f, 0xdd, 0xbd, 0xb7, 0x84, 0x02, 0xb4, 0x3b, 0x26, 0xdd, 0xcc, 0x41, 0x17, 0x60, 0x2e, 0xcc,
-		0x52, 0xa9, 0x36, 0xeb, 0x9b, 0x2d, 0x29, 0x56, 0x7e, 0xe0, 0xee, 0xbd, 0xa5, 0x99, 0x00, 0x47,
-		0x65, 0xd7, 0xc1, 0xa6, 0x3b, 0xcc, 0x50, 0xdb, 0xda, 0xd8, 0x68, 0xb4, 0xa4, 0xf8, 0x10, 0x03,
-		0x37, 0xf8, 0x8f, 0xc3, 0x4c, 0x98, 0x61, 0xb3, 0xb1, 0x2e, 0x25, 0xca, 0xe8, 0xee, 0xbd, 0xa5,
-		0x62, 0x80, 0x7a, 0xd3, 0xe8, 0x94, 0x33, 0x1f, 0xfb, 0xde, 0x85, 0xa9, 0x4f, 0x7f, 0xdf, 0x42,
-		0x8c, 0xf4, 0xac, 0x10, 0xb2, 0x31, 0xe8, 0x49, 0x38, 0xdd, 0x6c, 0xac, 0x6d, 0xd6, 0x57, 0xd5,
-		0x8d, 0xe6, 0xda, 0xc0, 0x37, 0xd3, 0xe5, 0xd2, 0xdd, 0x7b, 0x4b, 0x39, 0xde, 0xa5, 0x71, 0xd4,
-		0xdb, 0x4a, 0xfd, 0xe6, 0x56, 0xab, 0x2e, 0xc5, 0x18, 0xf5, 0xb6, 0x8d, 0x6f, 0x5b, 0x2e, 0xbb,
-		0x91, 0xf3, 0x69, 0x38, 0x33, 0x82, 0xda, 0xeb, 0xd8, 0xcc, 0xdd, 0x7b, 0x4b, 0x85, 0x6d, 0x1b,
-		0xb3, 0xf9, 0x47, 0x39, 0x96, 0x61, 0x7e, 0x98, 0x63, 0x6b, 0x7b, 0xab, 0x59, 0x59, 0x97, 0x96,
-		0xca, 0xd2, 0xdd, 0x7b, 0x4b, 0x79, 0x61, 0x4c, 0x09, 0xbd, 0xdf, 0xb3, 0x77, 0x2a, 0x5a, 0xfa,
-		0xfa, 0x74, 0x28, 0x17, 0xc8, 0xe2, 0x90, 0x9e, 0x66, 0x6b, 0xdd, 0x93, 0x86, 0x4b, 0x11, 0xbb,
-		0xb9, 0xf2, 0x9b, 0x71, 0x28, 0x79, 0xce, 0xf8, 0x36, 0x7d, 0x03, 0x7a, 0x36, 0x98, 0xc7, 0xc9,
-		0x8d, 0x5d, 0x06, 0x19, 0xb5, 0x48, 0xf3, 0xbc, 0x0f, 0x32, 0xc2, 0xa9, 0xe3, 0xe6, 0x66, 0x69,
-		0x98, 0xaf, 0xce, 0x29, 0x38, 0xab, 0xc7, 0x81, 0x5e, 0x82, 0xac, 0x67, 0x7c, 0xbc, 0x5b, 0xab,
-		0xc6, 0x5b, 0x2b, 0xce, 0xef, 0xf3, 0xa0, 0x17, 0xfd, 0xb0, 0x23, 0x39, 0x2e, 0x90, 0xb9, 0xc9,
-		0x08, 0x38, 0xb3, 0xa0, 0x97, 0x1b, 0x7c, 0xda, 0xf1, 0xde, 0xd3, 0x1b, 0x3f, 0x0e, 0x55, 0x16,
-		0x89, 0xb1, 0xf9, 0x9b, 0xe9, 0x6a, 0x87, 0x55, 0x1a, 0x8c, 0x9d, 0x86, 0x34, 0xa9, 0xdc, 0xe7,
-		0xdf, 0x94, 0x27, 0x94, 0x54, 0x57, 0x3b, 0x5c, 0xd3, 0x9c, 0xeb, 0xc9, 0x4c, 0x42, 0x4a, 0xca,
-		0x3f, 0x14, 0x83, 0x62, 0xb8, 0x8f, 0xe8, 0x09, 0x40, 0x84, 0x43, 0xdb, 0xc7, 0x2a, 0x31, 0x51,
-		0x54, 0x58, 0x02, 0xb7, 0xd4, 0xd5, 0x0e, 0x2b, 0xfb, 0x78, 0xb3, 0xdf, 0xa5, 0x0d, 0x70, 0xd0,
-		0x06, 0x48, 0x82, 0x58, 0x8c, 0x13, 0x17, 0xe6, 0x99, 0xe1, 0x3b, 0x2c, 0x39, 0x01, 0x5b, 0xe8,
-		0x3e, 0x41, 0x16, 0xba, 0x22, 0xc3, 0xf3, 0x76, 0xe3, 0x43, 0x5d, 0x49, 0x84, 0xbb, 0x22, 0xbf,
-		0x04, 0xa5, 0x01, 0x79, 0x22, 0x19, 0x0a, 0x3c, 0xab, 0x40, 0x77, 0x3a, 0x99, 0x7f, 0x9d, 0x55,
-		0x72, 0x2c, 0x7b, 0x40, 0x77, 0x7e, 0x57, 0x32, 0x3f, 0xf7, 0xc9, 0xc5, 0x18, 0x4d, 0xb8, 0x3f,
-		0x01, 0x85, 0x90, 0x44, 0x45, 0xa6, 0x2f, 0xe6, 0x67, 0xfa, 0x7c, 0xe2, 0xd7, 0x20, 0x4f, 0x0c,
-		0x2d, 0x6e, 0x73, 0xda, 0x47, 0xa1, 0xc4, 0x16, 0x82, 0x41, 0x59, 0x33, 0x4f, 0x6c, 0x43, 0x08,
-		0x5c, 0x16, 0xae, 0x59, 0x58, 0xec, 0x39, 0x41, 0xb5, 0xa6, 0x39, 0xd5, 0x0f, 0x7d, 0xfa, 0xb3,
-		0x0b, 0xb1, 0x77, 0x66, 0x22, 0xfe, 0x6a, 0x0b, 0xce, 0x06, 0x2a, 0xb5, 0x5d, 0xdd, 0x08, 0x65,
-		0x2d, 0x4a, 0x01, 0x25, 0x23, 0x95, 0x51, 0xd9, 0x87, 0x63, 0x73, 0x20, 0xc7, 0x27, 0xdc, 0xca,
-		0xc7, 0x5b, 0x84, 0xe8, 0xc4, 0xc8, 0xe8, 0x1c, 0xe7, 0x2f, 0x65, 0x21, 0xad, 0xe0, 0x8f, 0xf4,
-		0xb1, 0xe3, 0xa2, 0x8b, 0x90, 0xc4, 0xfa, 0x81, 0x35, 0x2a, 0xa5, 0x44, 0x3a, 0xb7, 0xcc, 0xe9,
-		0xea, 0xfa, 0x81, 0x75, 0x6d, 0x4a, 0xa1, 0xb4, 0xe8, 0x12, 0x4c, 0xef, 0x75, 0xfa, 0x3c, 0xcf,
-		0x31, 0x60, 0x2c, 0x82, 0x4c, 0x57, 0x09, 0xd1, 0xb5, 0x29, 0x85, 0x51, 0x93, 0x57, 0xd1, 0x7b,
-		0x82, 0x13, 0xc7, 0xbf, 0xaa, 0x61, 0xee, 0xd1, 0x57, 0x11, 0x5a, 0x54, 0x05, 0x30, 0x4c, 0xc3,
-		0x55, 0x69, 0x0e, 0x80, 0x7b, 0x92, 0x0f, 0x8d, 0xe7, 0x34, 0x5c, 0x9a, 0x35, 0xb8, 0x36, 0xa5,
-		0x64, 0x0d, 0xf1, 0x40, 0x9a, 0xfb, 0x91, 0x3e, 0xb6, 0x8f, 0xb8, 0x03, 0x39, 0xb6, 0xb9, 0x1f,
-		0x22, 0x44, 0xa4, 0xb9, 0x94, 0x1a, 0xd5, 0x21, 0x47, 0x3f, 0x90, 0x65, 0xf3, 0x97, 0xdf, 0x4c,
-		0x2b, 0x8f, 0x63, 0xae, 0x12, 0x52, 0x3a, 0xa5, 0xaf, 0x4d, 0x29, 0xb0, 0xeb, 0x3d, 0x11, 0x23,
-		0xc9, 0x6e, 0x2e, 0x73, 0x0f, 0xf9, 0x7d, 0x9c, 0x8b, 0xe3, 0x30, 0xe8, 0xf5, 0x65, 0xad, 0xc3,
-		0x6b, 0x53, 0x4a, 0x5a, 0x67, 0x3f, 0x49, 0xff, 0xdb, 0xb8, 0x63, 0xdc, 0xc6, 0x36, 0xe1, 0xcf,
-		0x1e, 0xdf, 0xff, 0x55, 0x46, 0x49, 0x11, 0xb2, 0x6d, 0xf1, 0x40, 0x0c, 0x2d, 0x36, 0xdb, 0xbc,
-		0x1b, 0x30, 0x6c, 0xa7, 0x43, 0xe3, 0x6c, 0xb6, 0x45, 0x27, 0x32, 0x98, 0xff, 0x46, 0x2f, 0x78,
-		0x1e, 0x6e, 0x6e, 0xd8, 0xa9, 0x0c, 0x75, 0x80, 0xe5, 0x3a, 0xa6, 0x84, 0xa7, 0x8b, 0x36, 0xa1,
-		0xd8, 0x31, 0x1c, 0x57, 0x75, 0x4c, 0xad, 0xe7, 0x1c, 0x58, 0xae, 0x43, 0x93, 0x06, 0xb9, 0x8b,
-		0x8f, 0x8c, 0x43, 0x58, 0x37, 0x1c, 0xb7, 0x29, 0x88, 0xaf, 0x4d, 0x29, 0x85, 0x4e, 0xb0, 0x80,
-		0xe0, 0x59, 0x7b, 0x7b, 0xd8, 0xf6, 0x00, 0x69, 0x72, 0xe1, 0x18, 0xbc, 0x2d, 0x42, 0x2d, 0xf8,
-		0x09, 0x9e, 0x15, 0x2c, 0x40, 0x5f, 0x01, 0xb3, 0x1d, 0x4b, 0x6b, 0x7b, 0x70, 0xaa, 0x7e, 0xd0,
-		0x37, 0x6f, 0xd1, 0x4c, 0x44, 0xee, 0xe2, 0xe3, 0x63, 0x1b, 0x69, 0x69, 0x6d, 0x01, 0x51, 0x23,
-		0x0c, 0xd7, 0xa6, 0x94, 0x99, 0xce, 0x60, 0x21, 0xfa, 0x30, 0xcc, 0x69, 0xbd, 0x5e, 0xe7, 0x68,
-		0x10, 0xbd, 0x44, 0xd1, 0xcf, 0x8f, 0x43, 0xaf, 0x10, 0x9e, 0x41, 0x78, 0xa4, 0x0d, 0x95, 0xa2,
-		0x16, 0x48, 0x3d, 0x1b, 0xd3, 0x6f, 0x6a, 0x7a, 0xdc, 0x55, 0xa1, 0x37, 0x0e, 0xe5, 0x2e, 0x3e,
-		0x36, 0x0e, 0x7b, 0x9b, 0xd1, 0x0b, 0xcf, 0xe6, 0xda, 0x94, 0x52, 0xea, 0x85, 0x8b, 0x18, 0xaa,
-		0xa5, 0x63, 0x7a, 0x21, 0x1a, 0x47, 0x9d, 0x89, 0x42, 0xa5, 0xf4, 0x61, 0xd4, 0x50, 0x11, 0xd5,
-		0x41, 0xf7, 0x40, 0x65, 0xf3, 0x10, 0x45, 0xe8, 0xa0, 0x7b, 0x20, 0xa6, 0x62, 0x06, 0xf3, 0xdf,
-		0xd5, 0x34, 0x3f, 0x54, 0xc7, 0x6f, 0x9c, 0x79, 0x0c, 0x72, 0x01, 0xcb, 0x84, 0xe6, 0x21, 0xcd,
-		0x0f, 0x19, 0x88, 0xc3, 0x78, 0xfc, 0x51, 0x2e, 0x42, 0x3e, 0x68, 0x8d, 0xe4, 0x8f, 0xc7, 0x3c,
-		0x4e, 0xfa, 0xa9, 0xfe, 0x7c, 0x38, 0x77, 0x99, 0xf5, 0xd3, 0x92, 0x0f, 0x8b, 0x65, 0x48, 0xd4,
-		0xb3, 0xed, 0xac, 0x3c, 0x2d, 0xe4, 0xab, 0x20, 0x5a, 0x84, 0x5c, 0xef, 0x62, 0xcf, 0x23, 0x49,
-		0x50, 0x12, 0xe8, 0x5d, 0xec, 0x09, 0x82, 0x87, 0x20, 0x4f, 0xfa, 0xa6, 0x06, 0x3d, 0x95, 0xac,
-		0x92, 0x23, 0x65, 0x9c, 0x44, 0xfe, 0xcd, 0x38, 0x48, 0x83, 0x16, 0xcc, 0x4b, 0x6a, 0xc6, 0x4e,
-		0x9c, 0xd4, 0x3c, 0x33, 0x98, 0x4e, 0xf5, 0x33, 0xa8, 0xeb, 0x20, 0xf9, 0x89, 0x40, 0xb6, 0x92,
-		0x8c, 0xf7, 0xbc, 0x06, 0x5c, 0x44, 0xa5, 0xa4, 0x0f, 0xf8, 0x8c, 0x57, 0x43, 0x9b, 0x3f, 0xe2,
-		0x26, 0xfd, 0xc1, 0x41, 0xf5, 0x1c, 0x8e, 0x9d, 0x5e, 0x5b, 0x73, 0xb1, 0x48, 0xcc, 0x04, 0xf6,
-		0x81, 0x1e, 0x85, 0x92, 0xd6, 0xeb, 0xa9, 0x8e, 0xab, 0xb9, 0x98, 0xfb, 0x05, 0xd3, 0x2c, 0x3f,
-		0xa9, 0xf5, 0x7a, 0x4d, 0x52, 0xca, 0xfc, 0x82, 0x47, 0xa0, 0x48, 0x8c, 0xba, 0xa1, 0x75, 0x54,
-		0x1e, 0x6a, 0xa5, 0x98, 0xfb, 0xc0, 0x4b, 0xaf, 0xd1, 0x42, 0xb9, 0xed, 0x8d, 0x38, 0xd5, 0x1c,
-		0x2f, 0xce, 0x8b, 0x05, 0xe2, 0x3c, 0xc4, 0xaf, 0x50, 0x60, 0xf2, 0x11, 0xd7, 0x4e, 0x8c, 0x4e,
-		0x2f, 0xcf, 0xd1, 0x98, 0xf0, 0x36, 0xcb, 0xb8, 0x64, 0x14, 0xf6, 0x20, 0x7f, 0x34, 0x0e, 0x33,
-		0x43, 0xa6, 0x7f, 0x64, 0xde, 0xdd, 0x0f, 0x70, 0xe3, 0x27, 0x0a, 0x70, 0x6f, 0x84, 0xf3, 0xca,
-		0x81, 0xa5, 0xf3, 0xec, 0x90, 0x90, 0x99, 0xe1, 0x25, 0x0a, 0xcd, 0x41, 0x02, 0xa9, 0x67, 0xaa,
-		0xe6, 0x3b, 0x30, 0xb7, 0x7b, 0xf4, 0x86, 0x66, 0xba, 0x86, 0x89, 0xd5, 0xa1, 0x51, 0x1b, 0x5e,
-		0x8b, 0x37, 0x0c, 0x67, 0x17, 0x1f, 0x68, 0xb7, 0x0d, 0x4b, 0x34, 0x6b, 0xd6, 0xe3, 0xf7, 0xb3,
-		0xcb, 0xb2, 0x02, 0xc5, 0xf0, 0xda, 0x85, 0x8a, 0x10, 0x77, 0x0f, 0x79, 0xff, 0xe3, 0xee, 0x21,
-		0x7a, 0x9a, 0x27, 0xa2, 0xe2, 0x34, 0x11, 0x35, 0xfc, 0x22, 0xce, 0xe7, 0x67, 0xa1, 0x64, 0xd9,
-		0x9b, 0x0d, 0xde, 0x7a, 0x36, 0x88, 0x2a, 0x3f, 0x0e, 0xa5, 0x81, 0x05, 0x6b, 0x5c, 0x00, 0x2e,
-		0x97, 0xa0, 0x10, 0x5a, 0x9d, 0xe4, 0x53, 0x30, 0x37, 0x6a, 0xb1, 0x91, 0x0f, 0xbc, 0xf2, 0xd0,
-		0xa2, 0x81, 0x2e, 0x41, 0xc6, 0x5b, 0x6d, 0x46, 0xa4, 0x41, 0x68, 0x2f, 0x04, 0xb1, 0xe2, 0x91,
-		0x86, 0xb2, 0xe9, 0xf1, 0x50, 0x36, 0x5d, 0xfe, 0x2a, 0x98, 0x1f, 0xb7, 0x92, 0x0c, 0x74, 0x23,
-		0xe9, 0x69, 0xe1, 0x29, 0x48, 0xf1, 0x6b, 0x13, 0xe3, 0x74, 0xff, 0x88, 0x3f, 0x11, 0xed, 0x64,
-		0xab, 0x4a, 0x82, 0x6d, 0x2b, 0xd1, 0x07, 0x59, 0x85, 0x33, 0x63, 0x57, 0x93, 0xf1, 0x3b, 0x51,
-		0x0c, 0x88, 0xef, 0x44, 0xe9, 0xa2, 0x39, 0x0e, 0xed, 0xab, 0x38, 0x6d, 0xc1, 0x9e, 0xe4, 0x4f,
-		0x24, 0xe0, 0xd4, 0xe8, 0x35, 0x05, 0x2d, 0x41, 0x9e, 0x38, 0xee, 0x6e, 0xd8, 0xc7, 0x87, 0xae,
-		0x76, 0xd8, 0xe2, 0x0e, 0x3e, 0xcf, 0xe4, 0xc7, 0xbd, 0x4c, 0x3e, 0xda, 0x81, 0x99, 0x8e, 0xa5,
-		0x6b, 0x1d, 0x35, 0xa0, 0xf1, 0x5c, 0xd9, 0x1f, 0x1e, 0x12, 0x76, 0x9d, 0x5d, 0xa6, 0xde, 0x1e,
-		0x52, 0xfa, 0x12, 0xc5, 0x58, 0xf7, 0x34, 0x1f, 0xad, 0x42, 0xae, 0xeb, 0x2b, 0xf2, 0x09, 0x94,
-		0x3d, 0xc8, 0x16, 0x18, 0x92, 0xe9, 0x91, 0xfb, 0x4e, 0xa9, 0x13, 0x9b, 0xe8, 0x71, 0x5b, 0x38,
-		0xe9, 0xb1, 0x5b, 0x38, 0xa3, 0xf6, 0x4b, 0x32, 0xa3, 0xf7, 0x4b, 0x3e, 0x16, 0x1c, 0x9a, 0xf0,
-		0x2a, 0x3c, 0xb4, 0x85, 0x82, 0x9a, 0x30, 0xc7, 0xf9, 0xdb, 0x21, 0xd9, 0xc7, 0x27, 0x35, 0x34,
-		0x48, 0xb0, 0x8f, 0x17, 0x7b, 0xe2, 0xfe, 0xc4, 0x2e, 0x6c, 0x69, 0x32, 0x60, 0x4b, 0xff, 0x3f,
-		0x1b, 0x8a, 0x27, 0x7c, 0x33, 0xc5, 0xfd, 0x18, 0xe2, 0x6f, 0xd8, 0xac, 0x48, 0x9c, 0x3f, 0xe0,
-		0x8f, 0xf2, 0xb7, 0x02, 0x64, 0x14, 0xec, 0xf4, 0xc8, 0x2a, 0x8b, 0xaa, 0x90, 0xc5, 0x87, 0x3a,
-		0xee, 0xb9, 0xfe, 0xa6, 0xea, 0xa8, 0xd0, 0x83, 0x51, 0xd7, 0x05, 0x25, 0xf1, 0xfb, 0x3d, 0x36,
-		0xf4, 0x2c, 0x0f, 0xed, 0xc6, 0x47, 0x69, 0x9c, 0x3d, 0x18, 0xdb, 0x5d, 0x16, 0xb1, 0x5d, 0x62,
-		0xac, 0xab, 0xcf, 0xb8, 0x06, 0x82, 0xbb, 0x67, 0x79, 0x70, 0x97, 0x8c, 0x78, 0x59, 0x28, 0xba,
-		0xab, 0x85, 0xa2, 0xbb, 0x54, 0x44, 0x37, 0xc7, 0x84, 0x77, 0x97, 0x45, 0x78, 0x97, 0x8e, 0x68,
-		0xf1, 0x40, 0x7c, 0x77, 0x35, 0x1c, 0xdf, 0x65, 0xc6, 0x58, 0x1b, 0xc1, 0x3d, 0x36, 0xc0, 0x7b,
-		0x7f, 0x20, 0xc0, 0xcb, 0x8e, 0xf5, 0x6c, 0x19, 0xc8, 0x88, 0x08, 0xaf, 0x16, 0x8a, 0xf0, 0x20,
-		0x42, 0x06, 0x63, 0x42, 0xbc, 0x0f, 0x06, 0x43, 0xbc, 0xdc, 0xd8, 0x28, 0x91, 0x8f, 0xf7, 0xa8,
-		0x18, 0xef, 0x45, 0x2f, 0xc6, 0xcb, 0x8f, 0x0d, 0x52, 0x79, 0x1f, 0x06, 0x83, 0xbc, 0xad, 0xa1,
-		0x20, 0xaf, 0xc0, 0xff, 0xa8, 0xc9, 0x38, 0x88, 0x88, 0x28, 0x6f, 0x6b, 0x28, 0xca, 0x2b, 0x46,
-		0x00, 0x46, 0x84, 0x79, 0x7f, 0x67, 0x74, 0x98, 0x37, 0x3e, 0x10, 0xe3, 0xcd, 0x9c, 0x2c, 0xce,
-		0x53, 0xc7, 0xc4, 0x79, 0x2c, 0x16, 0x7b, 0x62, 0x2c, 0xfc, 0xc4, 0x81, 0xde, 0xce, 0x88, 0x40,
-		0x8f, 0x85, 0x64, 0xe7, 0xc6, 0x82, 0x4f, 0x10, 0xe9, 0xed, 0x8c, 0x88, 0xf4, 0x50, 0x24, 0x6c,
-		0x64, 0xa8, 0xf7, 0xc1, 0x60, 0xa8, 0x37, 0x1b, 0xa5, 0x8b, 0x11, 0xb1, 0xde, 0xb4, 0x94, 0x92,
-		0x1f, 0x27, 0x9e, 0xf6, 0x80, 0xa5, 0x23, 0xee, 0x0a, 0xb6, 0x6d, 0xcb, 0x16, 0x27, 0x89, 0xe9,
-		0x83, 0x7c, 0x8e, 0xf8, 0xfe, 0xbe, 0x55, 0x3b, 0x26, 0x2e, 0xa4, 0x6e, 0x61, 0xc0, 0x92, 0xc9,
-		0x3f, 0x17, 0xf3, 0x79, 0xa9, 0xcb, 0x1c, 0x8c, 0x1b, 0xb2, 0x3c, 0x6e, 0x08, 0x44, 0x8b, 0xf1,
-		0x70, 0xb4, 0xb8, 0x08, 0x39, 0xe2, 0xee, 0x0d, 0x04, 0x82, 0x5a, 0xcf, 0x0b, 0x04, 0xc5, 0x11,
-		0x0a, 0x16, 0x53, 0xf2, 0x55, 0x8c, 0xed, 0x5c, 0x95, 0xbc, 0xe3, 0x24, 0x2c, 0x84, 0x41, 0x4f,
-		0xc1, 0x6c, 0x80, 0xd6, 0x73, 0x23, 0x59, 0x54, 0x24, 0x79, 0xd4, 0x15, 0xee, 0x4f, 0xfe, 0xdb,
-		0x98, 0x2f, 0x21, 0x3f, 0x82, 0x1c, 0x15, 0xec, 0xc5, 0xde, 0xa6, 0x60, 0x2f, 0x7e, 0xdf, 0xc1,
-		0x5e, 0xd0, 0x2d, 0x4e, 0x84, 0xdd, 0xe2, 0xff, 0x15, 0xf3, 0xc7, 0xc4, 0x0b, 0xdd, 0x74, 0xab,
-		0x8d, 0xb9, 0xa3, 0x4a, 0x7f, 0x13, 0x1f, 0xa6, 0x63, 0xed, 0x73, 0x77, 0x94, 0xfc, 0x24, 0x54,
-		0xde, 0xd2, 0x93, 0xe5, 0x2b, 0x8b, 0xe7, 0xe3, 0x4e, 0x07, 0xcf, 0x3b, 0xf3, 0x43, 0xc0, 0x29,
-		0xff, 0x10, 0xb0, 0xf7, 0xad, 0x5e, 0x3a, 0xf0, 0xad, 0x1e, 0x7a, 0x01, 0xb2, 0x34, 0x83, 0xab,
-		0x5a, 0x3d, 0xf1, 0x47, 0x74, 0xce, 0x8e, 0x3f, 0x00, 0xec, 0xd0, 0xa3, 0x89, 0xec, 0xd0, 0xb0,
-		0xef, 0xa0, 0x64, 0x07, 0xb7, 0x01, 0x49, 0xeb, 0xd9, 0x65, 0xf0, 0xc0, 0x3f, 0xf4, 0x14, 0x05,
-		0xf2, 0x87, 0x01, 0x0d, 0x2f, 0x33, 0xe8, 0x1a, 0xa4, 0xf0, 0x6d, 0x7a, 0x09, 0x21, 0x3b, 0x58,
-		0x79, 0x6a, 0xd8, 0x13, 0x26, 0xd5, 0xd5, 0x79, 0x22, 0xe4, 0x3f, 0x7a, 0x6b, 0x51, 0x62, 0xd4,
-		0x4f, 0x7a, 0xdf, 0x35, 0x28, 0x9c, 0x5f, 0xfe, 0xdd, 0x38, 0x71, 0x44, 0x42, 0x4b, 0xd0, 0x48,
-		0xd9, 0x8e, 0xda, 0x12, 0x9d, 0x4c, 0xde, 0x0b, 0x00, 0xfb, 0x9a, 0xa3, 0xde, 0xd1, 0x4c, 0x17,
-		0xb7, 0xb9, 0xd0, 0x03, 0x25, 0xa8, 0x0c, 0x19, 0xf2, 0xd4, 0x77, 0x70, 0x9b, 0x47, 0xed, 0xde,
-		0x73, 0xa0, 0x9f, 0xe9, 0x2f, 0xaf, 0x9f, 0x61, 0x29, 0x67, 0x06, 0xa4, 0x1c, 0x88, 0x65, 0xb2,
-		0xc1, 0x58, 0x86, 0x1d, 0x84, 0xe6, 0xe7, 0x31, 0x81, 0xb5, 0x4d, 0x3c, 0xa3, 0x87, 0xa1, 0xd0,
-		0xc5, 0xdd, 0x9e, 0x65, 0x75, 0x54, 0x66, 0x6e, 0xd8, 0x5f, 0x7d, 0xc8, 0xf3, 0xc2, 0x3a, 0xb5,
-		0x3a, 0x5f, 0x17, 0xf7, 0xe7, 0x9f, 0x1f, 0xb3, 0xfe, 0xad, 0x13, 0x30, 0xbd, 0x26, 0x7b, 0xd0,
-		0xc9, 0x40, 0xcd, 0xe0, 0x29, 0x99, 0x3e, 0x35, 0x0b, 0x42, 0xa1, 0x27, 0xb5, 0x1f, 0xfe, 0x69,
-		0x1a, 0x56, 0xec, 0xa0, 0x57, 0xe1, 0xf4, 0x80, 0x6d, 0xf3, 0xa0, 0xe3, 0x93, 0x9a, 0xb8, 0x07,
-		0xc2, 0x26, 0x4e, 0x40, 0xfb, 0xc2, 0x4a, 0x7c, 0x99, 0xb3, 0xae, 0x01, 0xc5, 0xb0, 0xcf, 0x34,
-		0x72, 0xf8, 0xe9, 0x5f, 0x19, 0x72, 0x35, 0xc3, 0x54, 0x43, 0xd9, 0xa7, 0x3c, 0x2b, 0xe4, 0x39,
-		0xad, 0x6d, 0x78, 0x60, 0xa4, 0xef, 0x84, 0x9e, 0x87, 0xac, 0xef, 0x76, 0x31, 0xa9, 0x1e, 0x93,
-		0x9d, 0xf0, 0x69, 0xe5, 0x5f, 0x8c, 0xf9, 0x90, 0xe1, 0x7c, 0x47, 0x1d, 0x52, 0xec, 0x98, 0x1f,
-		0x3f, 0x3c, 0xf4, 0xd4, 0x64, 0x5e, 0xd7, 0x32, 0x3b, 0x03, 0xa8, 0x70, 0x66, 0xf9, 0xc3, 0x90,
-		0x62, 0x25, 0x28, 0x07, 0x69, 0xff, 0x9e, 0x60, 0x80, 0x54, 0xa5, 0x56, 0xab, 0x6f, 0x8b, 0x4b,
-		0x3f, 0xab, 0x5b, 0x4a, 0x4b, 0x8a, 0x93, 0x62, 0xa5, 0x7e, 0xbd, 0x5e, 0x6b, 0x49, 0x09, 0x34,
-		0x03, 0x05, 0xf6, 0x5b, 0xbd, 0xba, 0xa5, 0x6c, 0x54, 0x5a, 0x52, 0x32, 0x50, 0xd4, 0xac, 0x6f,
-		0xae, 0xd6, 0x15, 0x69, 0x5a, 0x7e, 0x06, 0xce, 0x8c, 0xf5, 0xd3, 0xfc, 0x64, 0x46, 0x2c, 0x90,
-		0xcc, 0x90, 0x3f, 0x11, 0x87, 0xf2, 0x78, 0xe7, 0x0b, 0x5d, 0x1f, 0xe8, 0xf8, 0xc5, 0x13, 0x78,
-		0x6e, 0x03, 0xbd, 0x47, 0x8f, 0x40, 0xd1, 0xc6, 0x7b, 0xd8, 0xd5, 0x0f, 0x98, 0x33, 0xc8, 0x96,
-		0xcc, 0x82, 0x52, 0xe0, 0xa5, 0x94, 0xc9, 0x61, 0x64, 0xaf, 0x63, 0xdd, 0x55, 0x99, 0x2d, 0x72,
-		0xf8, 0x1f, 0x42, 0x2d, 0xb0, 0xd2, 0x26, 0x2b, 0x94, 0xbf, 0xea, 0x44, 0xb2, 0xcc, 0xc2, 0xb4,
-		0x52, 0x6f, 0x29, 0xaf, 0x4a, 0x09, 0x84, 0xa0, 0x48, 0x7f, 0xaa, 0xcd, 0xcd, 0xca, 0x76, 0xf3,
-		0xda, 0x16, 0x91, 0xe5, 0x2c, 0x94, 0x84, 0x2c, 0x45, 0xe1, 0xb4, 0xfc, 0x04, 0x9c, 0x1e, 0xe3,
-		0x39, 0x8e, 0x38, 0x77, 0xf9, 0xa9, 0x58, 0x90, 0x3a, 0xec, 0xfd, 0x6d, 0x41, 0xca, 0x71, 0x35,
-		0xb7, 0xef, 0x70, 0x21, 0x3e, 0x3f, 0xa9, 0x2b, 0xb9, 0x2c, 0x7e, 0x34, 0x29, 0xbb, 0xc2, 0x61,
-		0xe4, 0x4b, 0x50, 0x0c, 0xd7, 0x8c, 0x97, 0x81, 0xaf, 0x44, 0x71, 0xd9, 0x0e, 0x98, 0x22, 0x11,
-		0x7b, 0x1f, 0xe3, 0x4e, 0xc4, 0x7d, 0xeb, 0x1b, 0xb2, 0x71, 0x89, 0xc1, 0x45, 0xa4, 0x0c, 0x19,
-		0x9b, 0xe3, 0xf2, 0xcc, 0x84, 0xf7, 0x2c, 0xbf, 0x0a, 0x10, 0x48, 0xb9, 0x7a, 0xa7, 0xe6, 0x62,
-		0xc1, 0x53, 0x73, 0x97, 0x60, 0xfa, 0xb6, 0xc5, 0xec, 0xd4, 0xe8, 0xc9, 0x7a, 0xd3, 0x72, 0x71,
-		0x20, 0xbf, 0xc2, 0xa8, 0x65, 0x03, 0xd0, 0x70, 0xda, 0x6b, 0xcc, 0x2b, 0xde, 0x1f, 0x7e, 0xc5,
-		0x43, 0x63, 0x13, 0x68, 0xa3, 0x5f, 0xf5, 0x06, 0x4c, 0x53, 0x0b, 0x37, 0xf2, 0x33, 0xaa, 0xaf,
-		0x04, 0xd0, 0x5c, 0xd7, 0x36, 0x76, 0xfb, 0xfe, 0x0b, 0x16, 0x47, 0x5b, 0xc8, 0x8a, 0xa0, 0xab,
-		0xbe, 0x87, 0x9b, 0xca, 0x39, 0x9f, 0x35, 0x60, 0x2e, 0x03, 0x80, 0xf2, 0x26, 0x14, 0xc3, 0xbc,
-		0xa3, 0x3f, 0x0b, 0xf3, 0xaf, 0x57, 0xc8, 0x0a, 0x97, 0xcd, 0x73, 0xf8, 0xf8, 0x95, 0x27, 0xf4,
-		0x41, 0xbe, 0x1b, 0x83, 0x4c, 0xeb, 0x90, 0xcf, 0x9d, 0x63, 0x0e, 0xae, 0xfa, 0xdf, 0xc6, 0x79,
-		0xf9, 0x50, 0x96, 0x72, 0x4e, 0x78, 0x89, 0xec, 0x0f, 0x7a, 0xd6, 0x21, 0x39, 0x69, 0x8c, 0x2e,
-		0x12, 0xfa, 0xdc, 0x22, 0x5e, 0x99, 0xec, 0x3b, 0x94, 0x39, 0x98, 0x0e, 0x7e, 0x43, 0xc2, 0x1e,
-		0xe4, 0x76, 0xe0, 0xe8, 0x06, 0x5b, 0xaa, 0x82, 0x1f, 0xac, 0xc4, 0x4e, 0xfc, 0xc1, 0x8a, 0xf7,
-		0x96, 0x78, 0xf0, 0x2d, 0xb7, 0x21, 0x23, 0x94, 0x02, 0x7d, 0x20, 0x78, 0x3e, 0x47, 0x6c, 0x43,
-		0x8d, 0x5d, 0xb0, 0x39, 0x7c, 0xe0, 0x78, 0xce, 0x79, 0x98, 0xe1, 0xc7, 0x1a, 0xfd, 0x58, 0x86,
-		0xff, 0x55, 0x86, 0x12, 0xab, 0x58, 0x17, 0x81, 0x8c, 0xfc, 0xfd, 0x31, 0x90, 0x06, 0xb5, 0xf2,
-		0xdd, 0x6c, 0x00, 0x31, 0xc4, 0x44, 0xfb, 0x03, 0xd7, 0xa2, 0xb3, 0x91, 0x2f, 0x90, 0x52, 0xff,
-		0x62, 0xf4, 0x8f, 0xc6, 0x21, 0x17, 0x48, 0x5b, 0xa2, 0xe7, 0x42, 0xc7, 0x6c, 0x97, 0x8e, 0x4b,
-		0x71, 0x06, 0xce, 0xd9, 0x86, 0x3a, 0x16, 0x3f, 0x79, 0xc7, 0xde, 0xfe, 0x0f, 0x21, 0x46, 0x7f,
-		0x51, 0x35, 0x3d, 0xe6, 0x8b, 0xaa, 0xaf, 0x8d, 0x41, 0xc6, 0x73, 0x17, 0x4e, 0xba, 0x61, 0x71,
-		0x0a, 0x52, 0x7c, 0x45, 0x64, 0x3b, 0x16, 0xfc, 0x69, 0x64, 0xba, 0xb7, 0x0c, 0x19, 0xf1, 0xb7,
-		0xb4, 0x78, 0xf0, 0xeb, 0x3d, 0x9f, 0x7f, 0x11, 0x72, 0x81, 0xbd, 0x23, 0x62, 0x27, 0x36, 0xeb,
-		0x2f, 0x4b, 0x53, 0xe5, 0xf4, 0xdd, 0x7b, 0x4b, 0x89, 0x4d, 0x7c, 0x87, 0xcc, 0x30, 0xa5, 0x5e,
-		0xbb, 0x56, 0xaf, 0xdd, 0x90, 0x62, 0xe5, 0xdc, 0xdd, 0x7b, 0x4b, 0x69, 0x05, 0xd3, 0xa4, 0xdb,
-		0xf9, 0x1b, 0x50, 0x1a, 0x18, 0x98, 0xf0, 0x9a, 0x82, 0xa0, 0xb8, 0xba, 0xb3, 0xbd, 0xde, 0xa8,
-		0x55, 0x5a, 0x75, 0x95, 0x1d, 0x39, 0x44, 0xa7, 0x61, 0x76, 0xbd, 0xb1, 0x76, 0xad, 0xa5, 0xd6,
-		0xd6, 0x1b, 0xf5, 0xcd, 0x96, 0x5a, 0x69, 0xb5, 0x2a, 0xb5, 0x1b, 0x52, 0xfc, 0xe2, 0x6f, 0xe6,
-		0xa0, 0x54, 0xa9, 0xd6, 0x1a, 0xc4, 0x27, 0x30, 0xf8, 0xcd, 0xf6, 0x35, 0x48, 0xd2, 0xf4, 0xc3,
-		0xb1, 0xc7, 0x69, 0xca, 0xc7, 0x67, 0x64, 0xd1, 0x55, 0x98, 0xa6, 0x99, 0x09, 0x74, 0xfc, 0xf9,
-		0x9a, 0x72, 0x44, 0x8a, 0x96, 0x34, 0x86, 0x4e, 0xa7, 0x63, 0x0f, 0xdc, 0x94, 0x8f, 0xcf, 0xd8,
-		0x22, 0x05, 0xb2, 0x7e, 0x64, 0x13, 0x7d, 0x00, 0xa5, 0x3c, 0x81, 0x75, 0x44, 0xeb, 0x90, 0x16,
-		0xc1, 0x68, 0xd4, 0x91, 0x98, 0x72, 0x64, 0x4a, 0x95, 0x88, 0x8b, 0xad, 0xf2, 0xc7, 0x9f, 0xef,
-		0x29, 0x47, 0xe4, 0x87, 0x51, 0xc3, 0xfb, 0xd0, 0x21, 0xe2, 0x98, 0x4b, 0x39, 0x2a, 0x45, 0x4a,
-		0x84, 0xe6, 0xa7, 0x63, 0xa2, 0x4f, 0x2d, 0x95, 0x27, 0x48, 0x7d, 0xa3, 0x1d, 0x80, 0x40, 0x8a,
-		0x60, 0x82, 0xe3, 0x48, 0xe5, 0x49, 0x52, 0xda, 0x68, 0x0b, 0x32, 0x5e, 0xc4, 0x16, 0x79, 0x38,
-		0xa8, 0x1c, 0x9d, 0x5b, 0x46, 0x1f, 0x86, 0x42, 0x38, 0x52, 0x99, 0xec, 0xc8, 0x4f, 0x79, 0xc2,
-		0xa4, 0x31, 0xc1, 0x0f, 0x87, 0x2d, 0x93, 0x1d, 0x01, 0x2a, 0x4f, 0x98, 0x43, 0x46, 0xaf, 0xc3,
-		0xcc, 0x70, 0x58, 0x31, 0xf9, 0x89, 0xa0, 0xf2, 0x09, 0xb2, 0xca, 0xa8, 0x0b, 0x68, 0x44, 0x38,
-		0x72, 0x82, 0x03, 0x42, 0xe5, 0x93, 0x24, 0x99, 0x51, 0x1b, 0x4a, 0x83, 0x3e, 0xfe, 0xa4, 0x07,
-		0x86, 0xca, 0x13, 0x27, 0x9c, 0xd9, 0x5b, 0xc2, 0xb1, 0xc1, 0xa4, 0x07, 0x88, 0xca, 0x13, 0xe7,
-		0x9f, 0xa9, 0xde, 0x0a, 0xf7, 0x3e, 0xf2, 0x40, 0x51, 0x39, 0x3a, 0x0f, 0x5d, 0xad, 0x8c, 0x3d,
-		0x54, 0xfa, 0xd8, 0xb1, 0x87, 0x4a, 0xfd, 0x63, 0xa2, 0xde, 0x41, 0xd2, 0xdf, 0x7e, 0x16, 0xde,
-		0xcb, 0x6f, 0x80, 0x70, 0x5c, 0xed, 0x96, 0x61, 0xee, 0x7b, 0x57, 0x7a, 0xf0, 0x67, 0x7e, 0xa2,
-		0xf4, 0x14, 0xbf, 0x65, 0x42, 0x94, 0x46, 0x5c, 0xec, 0x31, 0xf6, 0xb2, 0xb2, 0xa8, 0x93, 0xdf,
-		0xd1, 0xe7, 0x45, 0x8f, 0xb9, 0x34, 0x24, 0xe2, 0x6a, 0x92, 0x11, 0x97, 0x8a, 0x44, 0x9c, 0x7c,
-		0x3d, 0xee, 0x90, 0xad, 0xfc, 0x2d, 0x31, 0x28, 0x5e, 0x33, 0x1c, 0xd7, 0xb2, 0x0d, 0x5d, 0xeb,
-		0xd0, 0x25, 0xe8, 0xca, 0xa4, 0x5f, 0xe6, 0x54, 0xb3, 0xc4, 0xbb, 0xe1, 0x37, 0x91, 0xf0, 0xc3,
-		0x34, 0xab, 0x90, 0xba, 0xad, 0x75, 0xd8, 0x77, 0x31, 0xc1, 0x3b, 0x83, 0x06, 0x65, 0x1e, 0x70,
-		0xbb, 0x82, 0x28, 0x8c, 0x57, 0xfe, 0x51, 0x7a, 0x72, 0xbe, 0xdb, 0x35, 0x1c, 0xf6, 0xe7, 0xf3,
-		0x5d, 0xec, 0xa0, 0x6d, 0x48, 0xda, 0x9a, 0xcb, 0xa3, 0xa4, 0xea, 0xfb, 0xf8, 0xfd, 0x21, 0x8f,
-		0x46, 0xdf, 0x02, 0xb2, 0x3c, 0x7c, 0xc5, 0x08, 0x45, 0x42, 0x2f, 0x43, 0xa6, 0xab, 0x1d, 0xaa,
-		0x14, 0x35, 0xfe, 0x36, 0xa0, 0xa6, 0xbb, 0xda, 0x21, 0x69, 0x2b, 0x99, 0x92, 0x04, 0x58, 0x3f,
-		0xd0, 0xcc, 0x7d, 0xcc, 0xf0, 0x13, 0x6f, 0x03, 0x7e, 0xa1, 0xab, 0x1d, 0xd6, 0x28, 0x26, 0x79,
-		0xcb, 0x4a, 0xe6, 0x13, 0x9f, 0x5c, 0x9c, 0xa2, 0x07, 0xc0, 0x7f, 0x25, 0xc6, 0x03, 0x62, 0x2a,
-		0x2e, 0xa4, 0x81, 0xa4, 0x7b, 0x4f, 0xf4, 0xf5, 0x62, 0x43, 0xe2, 0xb1, 0x71, 0xa3, 0x31, 0x20,
-		0xec, 0x6a, 0x81, 0x34, 0xf4, 0x33, 0x6f, 0x2d, 0xc6, 0xd8, 0xb8, 0x94, 0xf4, 0x81, 0xc1, 0xb8,
-		0x0e, 0x39, 0x96, 0x07, 0x54, 0xa9, 0x23, 0x1c, 0x8f, 0x74, 0x84, 0x0b, 0xc2, 0x11, 0x66, 0x80,
-		0xc0, 0xb8, 0x49, 0x7d, 0xa0, 0x1f, 0x3f, 0x1a, 0x83, 0xdc, 0x6a, 0xe0, 0x6e, 0xb0, 0x79, 0x48,
-		0x77, 0x2d, 0xd3, 0xb8, 0x85, 0x6d, 0x6f, 0x57, 0x89, 0x3d, 0x12, 0x87, 0x95, 0xfd, 0x89, 0x29,
-		0xf7, 0x48, 0xdc, 0x9b, 0x21, 0x9e, 0x09, 0xd7, 0x1d, 0xbc, 0xeb, 0x18, 0x42, 0xea, 0x8a, 0x78,
-		0x44, 0x8f, 0x83, 0xe4, 0x60, 0xbd, 0x6f, 0x1b, 0xee, 0x91, 0xaa, 0x5b, 0xa6, 0xab, 0xe9, 0x2e,
-		0xcf, 0xfd, 0x96, 0x44, 0x79, 0x8d, 0x15, 0x13, 0x90, 0x36, 0x76, 0x35, 0xa3, 0xc3, 0xce, 0xc8,
-		0x65, 0x15, 0xf1, 0x18, 0x68, 0xee, 0xf7, 0x65, 0x83, 0x81, 0x66, 0x0d, 0x24, 0xab, 0x87, 0xed,
-		0xd0, 0x47, 0x8a, 0x4c, 0x57, 0xe7, 0x7f, 0xeb, 0xa7, 0x9f, 0x9a, 0xe3, 0x82, 0xe7, 0x27, 0x16,
-		0xd8, 0x75, 0xd8, 0x4a, 0x49, 0x70, 0x88, 0xaf, 0x17, 0x5f, 0x0d, 0xed, 0x25, 0xf5, 0x77, 0xfd,
-		0x4b, 0x12, 0xe6, 0x86, 0x84, 0x5b, 0x31, 0x8f, 0xaa, 0xf3, 0xbf, 0xe1, 0x43, 0xfb, 0x81, 0xe8,
-		0x0d, 0x7c, 0x14, 0xdc, 0x58, 0xa2, 0x30, 0x24, 0x1e, 0x78, 0x5d, 0x33, 0x3a, 0xe2, 0x2f, 0xf2,
-		0x29, 0xfc, 0x09, 0xad, 0x78, 0x89, 0xa4, 0x24, 0x0d, 0xae, 0xe4, 0x71, 0x3a, 0x52, 0xb5, 0xcc,
-		0x76, 0x38, 0x67, 0x84, 0x5a, 0x90, 0x72, 0xad, 0x5b, 0xd8, 0xe4, 0x42, 0x3a, 0x91, 0x7e, 0x0f,
-		0xdf, 0x18, 0xc4, 0xb1, 0xd0, 0x3e, 0x48, 0x6d, 0xdc, 0xc1, 0xfb, 0xec, 0x13, 0xbb, 0x03, 0xcd,
-		0xc6, 0xec, 0xb3, 0xdb, 0x2f, 0x77, 0xfe, 0x94, 0x3c, 0xd4, 0x26, 0x05, 0x45, 0xdb, 0xe1, 0xdb,
-		0xe9, 0xd2, 0xfc, 0x64, 0xc2, 0x98, 0xfe, 0x07, 0x34, 0x33, 0x68, 0xb3, 0x42, 0xb7, 0xd9, 0x3d,
-		0x0e, 0x52, 0xdf, 0xdc, 0xb5, 0x4c, 0xfa, 0x47, 0xae, 0x78, 0xb8, 0x96, 0x61, 0x7b, 0x8f, 0x5e,
-		0x39, 0xdf, 0x7b, 0xdc, 0x86, 0xa2, 0x4f, 0x4a, 0x67, 0x51, 0xf6, 0xa4, 0xb3, 0xa8, 0xe0, 0x01,
-		0x10, 0x12, 0xb4, 0x01, 0xe0, 0xcf, 0x53, 0xef, 0x84, 0x43, 0xe4, 0x8c, 0x0f, 0x76, 0x26, 0x00,
-		0x80, 0x3a, 0x30, 0xdb, 0x35, 0x4c, 0xd5, 0xc1, 0x9d, 0x3d, 0x95, 0x4b, 0x8e, 0xe0, 0xe6, 0xde,
-		0x86, 0x91, 0x9e, 0xe9, 0x1a, 0x66, 0x13, 0x77, 0xf6, 0x56, 0x3d, 0x58, 0xf4, 0x3e, 0x38, 0xeb,
-		0x8b, 0xc3, 0x32, 0xd5, 0x03, 0xab, 0xd3, 0x56, 0x6d, 0xbc, 0xa7, 0xea, 0xf4, 0xc2, 0xaa, 0x3c,
-		0x15, 0xe2, 0x69, 0x8f, 0x64, 0xcb, 0xbc, 0x66, 0x75, 0xda, 0x0a, 0xde, 0xab, 0x91, 0x6a, 0xf4,
-		0x30, 0xf8, 0xb2, 0x50, 0x8d, 0xb6, 0x33, 0x5f, 0x58, 0x4a, 0x9c, 0x4b, 0x2a, 0x79, 0xaf, 0xb0,
-		0xd1, 0x76, 0x50, 0x95, 0x4c, 0x7f, 0xd6, 0x99, 0xd0, 0xb7, 0xf0, 0xc7, 0xcd, 0xd0, 0xa2, 0xc3,
-		0x9a, 0x29, 0x26, 0x68, 0x05, 0x4a, 0x36, 0xee, 0x68, 0x47, 0x81, 0x33, 0x49, 0xa5, 0x28, 0x08,
-		0xce, 0x20, 0x20, 0xd6, 0x00, 0xe9, 0x07, 0x5a, 0xa7, 0x83, 0xd9, 0x1f, 0xf7, 0xe0, 0x28, 0x52,
-		0x04, 0xca, 0x8c, 0xcf, 0x23, 0x80, 0x4e, 0x43, 0x7a, 0xb7, 0xe3, 0xd0, 0xbc, 0xd4, 0x0c, 0x0d,
-		0xda, 0x53, 0xbb, 0x1d, 0xe7, 0x06, 0x3e, 0x5a, 0xc9, 0x7f, 0xec, 0x93, 0x8b, 0x53, 0xdc, 0x4c,
-		0x4d, 0xc9, 0xdb, 0xf4, 0x2e, 0x19, 0xce, 0x84, 0x1d, 0x74, 0x19, 0xb2, 0x9a, 0x78, 0x60, 0x5f,
-		0x21, 0x1d, 0xf3, 0x5a, 0x9f, 0x94, 0x19, 0xbe, 0x37, 0x7f, 0x77, 0x29, 0x26, 0x7f, 0x5f, 0x0c,
-		0x52, 0xab, 0x37, 0xb7, 0x35, 0xc3, 0x46, 0x75, 0x98, 0xf1, 0xe7, 0xea, 0xa4, 0x66, 0xcf, 0x9f,
-		0xde, 0xa2, 0x2b, 0xf5, 0x71, 0x9f, 0x78, 0x1f, 0x0b, 0x33, 0xf8, 0xf1, 0xf7, 0x40, 0xc7, 0xaf,
-		0x43, 0x9a, 0xb5, 0xd2, 0x41, 0x2f, 0xc1, 0x74, 0x8f, 0xfc, 0xe0, 0x5b, 0x37, 0x0b, 0x63, 0xe7,
-		0x38, 0xa5, 0x0f, 0xce, 0x08, 0xc6, 0x27, 0xff, 0x55, 0x0c, 0x60, 0xf5, 0xe6, 0xcd, 0x96, 0x6d,
-		0xf4, 0x3a, 0xd8, 0x7d, 0xbb, 0xba, 0xbd, 0x0e, 0x0f, 0x04, 0x3e, 0x26, 0xb6, 0xf5, 0x89, 0xbb,
-		0x3e, 0xeb, 0x7f, 0x4e, 0x6c, 0xeb, 0x23, 0xd1, 0xda, 0x8e, 0xeb, 0xa1, 0x25, 0x26, 0x46, 0x5b,
-		0x75, 0xdc, 0xd1, 0xb2, 0x7c, 0x05, 0x72, 0x7e, 0xf7, 0x1d, 0xd4, 0x80, 0x8c, 0xcb, 0x7f, 0x73,
-		0x91, 0xca, 0xe3, 0x45, 0x2a, 0xd8, 0x82, 0x62, 0xf5, 0xd8, 0xe5, 0xbf, 0x26, 0x92, 0xf5, 0xed,
-		0xc0, 0xdf, 0x28, 0x85, 0x22, 0x0b, 0x1c, 0x5f, 0x80, 0xde, 0x0e, 0x07, 0x8e, 0x63, 0x0d, 0x88,
-		0xf6, 0x63, 0x71, 0x98, 0xdd, 0x11, 0x76, 0xea, 0x6f, 0xac, 0x24, 0x76, 0x20, 0x8d, 0x4d, 0xd7,
-		0x36, 0xb0, 0xd8, 0xaf, 0x7d, 0x7a, 0xdc, 0x80, 0x8f, 0xe8, 0x0b, 0xfd, 0x93, 0xee, 0xc1, 0xe1,
-		0x17, 0x58, 0x03, 0xa2, 0xf8, 0xe5, 0x04, 0xcc, 0x8f, 0x63, 0x47, 0x8f, 0x41, 0x49, 0xb7, 0x31,
-		0x2d, 0x50, 0x43, 0xfb, 0x0b, 0x45, 0x51, 0xcc, 0x57, 0x56, 0x05, 0x88, 0xbf, 0x4a, 0xb4, 0x8b,
-		0x90, 0xde, 0x9f, 0x83, 0x5a, 0xf4, 0x11, 0xe8, 0xda, 0x8a, 0xa1, 0x24, 0xbe, 0x89, 0xd8, 0xd5,
-		0x3a, 0x9a, 0xa9, 0xdf, 0x8f, 0x4b, 0x3f, 0xbc, 0x10, 0x8a, 0x0f, 0x2d, 0xaa, 0x0c, 0x13, 0xdd,
-		0x84, 0xb4, 0x80, 0x4f, 0xbe, 0x0d, 0xf0, 0x02, 0x0c, 0x3d, 0x04, 0xf9, 0xe0, 0xfa, 0x48, 0xdd,
-		0xb5, 0xa4, 0x92, 0x0b, 0x2c, 0x8f, 0x51, 0x0b, 0x70, 0xea, 0xd8, 0x05, 0x38, 0xe0, 0x15, 0xff,
-		0xeb, 0x04, 0xcc, 0x28, 0xb8, 0xfd, 0xb7, 0x70, 0xf0, 0xbe, 0x02, 0x80, 0x4d, 0x70, 0x62, 0x7c,
-		0xef, 0x63, 0xfc, 0x86, 0x0d, 0x46, 0x96, 0xe1, 0xad, 0x3a, 0xee, 0xbb, 0x39, 0x82, 0xff, 0x21,
-		0x0e, 0xf9, 0xe0, 0x08, 0xfe, 0x2d, 0x58, 0xed, 0xd0, 0xa6, 0x6f, 0xde, 0xd8, 0xd7, 0x0b, 0x8f,
-		0x8f, 0x33, 0x6f, 0x43, 0xba, 0x3d, 0x81, 0x5d, 0xfb, 0xba, 0x24, 0xa4, 0xf8, 0xf9, 0xbe, 0xad,
-		0x21, 0xb7, 0x3f, 0x16, 0xf5, 0x11, 0x7a, 0x41, 0x7c, 0x84, 0x3e, 0xd2, 0xeb, 0x7f, 0x04, 0x8a,
-		0x5d, 0xed, 0x50, 0x0d, 0x1d, 0x1a, 0x8c, 0x9d, 0x2b, 0xd0, 0x6c, 0x81, 0x7f, 0xa2, 0x1e, 0x2d,
-		0x42, 0x8e, 0x90, 0xf9, 0x36, 0x9c, 0xd0, 0x40, 0x57, 0x3b, 0xac, 0xb3, 0x12, 0xf4, 0x14, 0xa0,
-		0x03, 0x2f, 0x11, 0xa4, 0xfa, 0xc2, 0x20, 0x74, 0x33, 0x7e, 0x8d, 0x20, 0x7f, 0x10, 0x80, 0xb4,
-		0x42, 0x65, 0xd7, 0xcc, 0xf2, 0xbb, 0x7f, 0x49, 0xc9, 0x2a, 0xbd, 0x6a, 0xf6, 0xab, 0x59, 0xf0,
-		0x30, 0x90, 0x87, 0xe0, 0x61, 0xdc, 0xfa, 0xc9, 0x26, 0xc5, 0x5f, 0xbc, 0xb5, 0x58, 0x3e, 0xd2,
-		0xba, 0x9d, 0x15, 0x79, 0x04, 0xa4, 0x4c, 0x83, 0x89, 0x70, 0xfe, 0x62, 0x5c, 0xe8, 0x92, 0x7e,
-		0x47, 0x42, 0x97, 0x95, 0x73, 0x62, 0xe6, 0xdc, 0xfd, 0xc3, 0x1f, 0x3f, 0x7f, 0x36, 0x80, 0x71,
-		0xe8, 0xe5, 0x23, 0xd9, 0xe0, 0xcb, 0x3f, 0x16, 0x03, 0xe4, 0x33, 0x7a, 0x1f, 0x21, 0x6c, 0xd0,
-		0xa3, 0xe9, 0xa2, 0x95, 0xb1, 0xe3, 0x03, 0x37, 0x9f, 0x3f, 0x14, 0xb8, 0x05, 0xa6, 0xeb, 0x07,
-		0xfc, 0x45, 0x44, 0x5c, 0x70, 0x30, 0xe2, 0x3a, 0xe3, 0xe5, 0x9a, 0x65, 0x84, 0x20, 0x04, 0x93,
-		0x67, 0x09, 0xa6, 0xe4, 0xb7, 0x62, 0x70, 0x66, 0x48, 0xdf, 0xbd, 0x66, 0xeb, 0x80, 0xec, 0x40,
-		0x25, 0xd5, 0x19, 0xb1, 0x45, 0x7e, 0x7f, 0xd3, 0x67, 0xc6, 0x1e, 0x5a, 0x38, 0xde, 0xa1, 0x15,
-		0x91, 0x5f, 0x6c, 0xfc, 0xeb, 0x31, 0x98, 0x0b, 0xb6, 0xc8, 0xeb, 0x5b, 0x13, 0xf2, 0xc1, 0xb6,
-		0xf0, 0x5e, 0xbd, 0x77, 0x92, 0x5e, 0x05, 0x3b, 0x14, 0x02, 0x21, 0x7d, 0x11, 0xf3, 0x8a, 0x65,
-		0x47, 0x9f, 0x99, 0x58, 0x4a, 0xde, 0x56, 0xd2, 0x28, 0x63, 0x93, 0xa4, 0x83, 0xf5, 0x4d, 0x71,
-		0x48, 0x6e, 0x5b, 0x56, 0x07, 0x7d, 0x6d, 0x0c, 0x66, 0x4c, 0xcb, 0x55, 0xc9, 0x6c, 0xc4, 0x6d,
-		0x95, 0x67, 0x68, 0x98, 0xbd, 0xbe, 0x79, 0x32, 0xe9, 0xfd, 0xd1, 0x5b, 0x8b, 0xc3, 0x50, 0xa3,
-		0x6e, 0xa2, 0x2e, 0x99, 0x96, 0x5b, 0xa5, 0x44, 0x2d, 0x96, 0xc4, 0xb9, 0x03, 0x85, 0xf0, 0xfb,
-		0x99, 0x91, 0x57, 0x4e, 0xfc, 0xfe, 0x42, 0xe4, 0xbb, 0xf3, 0xbb, 0x81, 0x17, 0xb3, 0xfb, 0x60,
-		0xff, 0x8c, 0x0c, 0xee, 0xab, 0x20, 0xdd, 0x1c, 0x3c, 0xd0, 0x58, 0x87, 0xf4, 0x49, 0xcf, 0x46,
-		0x06, 0x25, 0xce, 0x79, 0xcf, 0xff, 0x4c, 0x0c, 0xc0, 0xcf, 0x87, 0xa1, 0x27, 0xe1, 0x74, 0x75,
-		0x6b, 0x73, 0x55, 0x6d, 0xb6, 0x2a, 0xad, 0x9d, 0x66, 0xf8, 0xaf, 0x0f, 0x88, 0x2b, 0x77, 0x9c,
-		0x1e, 0xd6, 0x8d, 0x3d, 0x03, 0xb7, 0xd1, 0xa3, 0x30, 0x17, 0xa6, 0x26, 0x4f, 0xf5, 0x55, 0x29,
-		0x56, 0xce, 0xdf, 0xbd, 0xb7, 0x94, 0x61, 0x0e, 0x30, 0x6e, 0xa3, 0x73, 0xf0, 0xc0, 0x30, 0x5d,
-		0x63, 0x73, 0x4d, 0x8a, 0x97, 0x0b, 0x77, 0xef, 0x2d, 0x65, 0x3d, 0x4f, 0x19, 0xc9, 0x80, 0x82,
-		0x94, 0x1c, 0x2f, 0x51, 0x86, 0xbb, 0xf7, 0x96, 0x52, 0x6c, 0x58, 0xca, 0xc9, 0x8f, 0x7d, 0xef,
-		0xc2, 0xd4, 0xf9, 0xaf, 0x04, 0x68, 0x98, 0x7b, 0xb6, 0x46, 0xff, 0x04, 0x37, 0x2a, 0xc3, 0xa9,
-		0xc6, 0xe6, 0x55, 0xa5, 0x52, 0x6b, 0x35, 0xb6, 0x36, 0x07, 0xfe, 0x68, 0x42, 0xb8, 0x6e, 0x75,
-		0x6b, 0xa7, 0xba, 0x5e, 0x57, 0x9b, 0x8d, 0xb5, 0x4d, 0xb6, 0x49, 0x1f, 0xaa, 0x7b, 0x79, 0xb3,
-		0xd5, 0xd8, 0xa8, 0x4b, 0xf1, 0xea, 0xd5, 0xb1, 0xbb, 0x3a, 0x4f, 0x1e, 0x3b, 0xe0, 0xbe, 0xa5,
-		0x0c, 0x6d, 0xed, 0xfc, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x24, 0x72, 0x83, 0x88, 0x9d,
-		0x00, 0x00,
+		// 11539 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x79, 0x90, 0x1c, 0x47,
+		0x76, 0x1f, 0x3c, 0x7d, 0x4c, 0x1f, 0xaf, 0xa7, 0xbb, 0x6b, 0x72, 0x86, 0xc0, 0xa0, 0x41, 0x62,
+		0x86, 0xc5, 0x25, 0x09, 0x82, 0xe4, 0x80, 0x04, 0x09, 0x90, 0x1c, 0xec, 0x2e, 0xb7, 0xbb, 0xa7,
+		0x01, 0x0c, 0x30, 0xd7, 0x56, 0xf7, 0x80, 0x87, 0x3e, 0x6d, 0xa9, 0xa6, 0x3a, 0x67, 0xa6, 0x88,
+		0xee, 0xaa, 0xda, 0xaa, 0xea, 0xc1, 0x0c, 0x43, 0xdf, 0x17, 0x94, 0xf6, 0x93, 0xbe, 0x15, 0x3e,
+		0x59, 0x5e, 0x59, 0xb6, 0x44, 0x1d, 0x90, 0x77, 0x75, 0xae, 0x2e, 0x5b, 0xc7, 0x5a, 0x87, 0x65,
+		0xcb, 0x92, 0xc3, 0x92, 0x25, 0x85, 0xc2, 0x5a, 0xeb, 0x0f, 0x5b, 0xa1, 0x90, 0x69, 0x69, 0x57,
+		0x21, 0xad, 0xa5, 0x95, 0x75, 0x78, 0x15, 0xb6, 0x63, 0x43, 0x0a, 0x47, 0x5e, 0x75, 0xf4, 0x31,
+		0xd5, 0x83, 0x05, 0xd7, 0x72, 0xe8, 0x1f, 0xa0, 0x2b, 0xeb, 0xbd, 0x5f, 0x66, 0xbe, 0x7c, 0xf9,
+		0xf2, 0xbd, 0x97, 0x59, 0x39, 0xf0, 0xfb, 0x35, 0x58, 0xd8, 0xb5, 0xac, 0xdd, 0x0e, 0x3e, 0x6f,
+		0x3b, 0x96, 0x67, 0x6d, 0xf7, 0x76, 0xce, 0xb7, 0xb1, 0xab, 0x3b, 0x86, 0xed, 0x59, 0xce, 0x22,
+		0x2d, 0x43, 0x65, 0x46, 0xb1, 0x28, 0x28, 0xe4, 0x35, 0x98, 0xbe, 0x62, 0x74, 0xf0, 0xb2, 0x4f,
+		0xd8, 0xc4, 0x1e, 0x7a, 0x11, 0xd2, 0x3b, 0x46, 0x07, 0xcf, 0x25, 0x16, 0x52, 0x67, 0x0b, 0x17,
+		0xde, 0xb3, 0xd8, 0xc7, 0xb4, 0x18, 0xe5, 0xd8, 0x24, 0xc5, 0x0a, 0xe5, 0x90, 0xff, 0x26, 0x0d,
+		0x33, 0x43, 0xde, 0x22, 0x04, 0x69, 0x53, 0xeb, 0x12, 0xc4, 0xc4, 0xd9, 0xbc, 0x42, 0x7f, 0xa3,
+		0x39, 0xc8, 0xda, 0x9a, 0x7e, 0x4b, 0xdb, 0xc5, 0x73, 0x49, 0x5a, 0x2c, 0x1e, 0xd1, 0x19, 0x80,
+		0x36, 0xb6, 0xb1, 0xd9, 0xc6, 0xa6, 0x7e, 0x38, 0x97, 0x5a, 0x48, 0x9d, 0xcd, 0x2b, 0xa1, 0x12,
+		0xf4, 0x24, 0x4c, 0xdb, 0xbd, 0xed, 0x8e, 0xa1, 0xab, 0x21, 0x32, 0x58, 0x48, 0x9d, 0x9d, 0x54,
+		0x24, 0xf6, 0x62, 0x39, 0x20, 0x7e, 0x1c, 0xca, 0xb7, 0xb1, 0x76, 0x2b, 0x4c, 0x5a, 0xa0, 0xa4,
+		0x25, 0x52, 0x1c, 0x22, 0xac, 0xc3, 0x54, 0x17, 0xbb, 0xae, 0xb6, 0x8b, 0x55, 0xef, 0xd0, 0xc6,
+		0x73, 0x69, 0xda, 0xfb, 0x85, 0x81, 0xde, 0xf7, 0xf7, 0xbc, 0xc0, 0xb9, 0x5a, 0x87, 0x36, 0x46,
+		0x55, 0xc8, 0x63, 0xb3, 0xd7, 0x65, 0x08, 0x93, 0x23, 0xe4, 0xd7, 0x30, 0x7b, 0xdd, 0x7e, 0x94,
+		0x1c, 0x61, 0xe3, 0x10, 0x59, 0x17, 0x3b, 0xfb, 0x86, 0x8e, 0xe7, 0x32, 0x14, 0xe0, 0xf1, 0x01,
+		0x80, 0x26, 0x7b, 0xdf, 0x8f, 0x21, 0xf8, 0x50, 0x1d, 0xf2, 0xf8, 0xc0, 0xc3, 0xa6, 0x6b, 0x58,
+		0xe6, 0x5c, 0x96, 0x82, 0x3c, 0x3a, 0x64, 0x14, 0x71, 0xa7, 0xdd, 0x0f, 0x11, 0xf0, 0xa1, 0x4b,
+		0x90, 0xb5, 0x6c, 0xcf, 0xb0, 0x4c, 0x77, 0x2e, 0xb7, 0x90, 0x38, 0x5b, 0xb8, 0xf0, 0xe0, 0x50,
+		0x45, 0xd8, 0x60, 0x34, 0x8a, 0x20, 0x46, 0x2b, 0x20, 0xb9, 0x56, 0xcf, 0xd1, 0xb1, 0xaa, 0x5b,
+		0x6d, 0xac, 0x1a, 0xe6, 0x8e, 0x35, 0x97, 0xa7, 0x00, 0xf3, 0x83, 0x1d, 0xa1, 0x84, 0x75, 0xab,
+		0x8d, 0x57, 0xcc, 0x1d, 0x4b, 0x29, 0xb9, 0x91, 0x67, 0x74, 0x02, 0x32, 0xee, 0xa1, 0xe9, 0x69,
+		0x07, 0x73, 0x53, 0x54, 0x43, 0xf8, 0x13, 0x51, 0x1d, 0xdc, 0x36, 0x48, 0x75, 0x73, 0x45, 0xa6,
+		0x3a, 0xfc, 0x51, 0xfe, 0xb9, 0x0c, 0x94, 0xc7, 0x51, 0xbe, 0xcb, 0x30, 0xb9, 0x43, 0xfa, 0x3f,
+		0x97, 0x3c, 0x8e, 0x74, 0x18, 0x4f, 0x54, 0xbc, 0x99, 0x7b, 0x14, 0x6f, 0x15, 0x0a, 0x26, 0x76,
+		0x3d, 0xdc, 0x66, 0xba, 0x92, 0x1a, 0x53, 0xdb, 0x80, 0x31, 0x0d, 0x2a, 0x5b, 0xfa, 0x9e, 0x94,
+		0xed, 0x55, 0x28, 0xfb, 0x4d, 0x52, 0x1d, 0xcd, 0xdc, 0x15, 0x5a, 0x7b, 0x3e, 0xae, 0x25, 0x8b,
+		0x0d, 0xc1, 0xa7, 0x10, 0x36, 0xa5, 0x84, 0x23, 0xcf, 0x68, 0x19, 0xc0, 0x32, 0xb1, 0xb5, 0xa3,
+		0xb6, 0xb1, 0xde, 0x99, 0xcb, 0x8d, 0x90, 0xd2, 0x06, 0x21, 0x19, 0x90, 0x92, 0xc5, 0x4a, 0xf5,
+		0x0e, 0x7a, 0x29, 0x50, 0xc2, 0xec, 0x08, 0x1d, 0x5a, 0x63, 0xd3, 0x6f, 0x40, 0x0f, 0xb7, 0xa0,
+		0xe4, 0x60, 0x32, 0x23, 0x70, 0x9b, 0xf7, 0x2c, 0x4f, 0x1b, 0xb1, 0x18, 0xdb, 0x33, 0x85, 0xb3,
+		0xb1, 0x8e, 0x15, 0x9d, 0xf0, 0x23, 0x7a, 0x04, 0xfc, 0x02, 0x95, 0xaa, 0x15, 0x50, 0xfb, 0x34,
+		0x25, 0x0a, 0xd7, 0xb5, 0x2e, 0xae, 0xbc, 0x09, 0xa5, 0xa8, 0x78, 0xd0, 0x2c, 0x4c, 0xba, 0x9e,
+		0xe6, 0x78, 0x54, 0x0b, 0x27, 0x15, 0xf6, 0x80, 0x24, 0x48, 0x61, 0xb3, 0x4d, 0xed, 0xdf, 0xa4,
+		0x42, 0x7e, 0xa2, 0x0f, 0x04, 0x1d, 0x4e, 0xd1, 0x0e, 0x3f, 0x36, 0x38, 0xa2, 0x11, 0xe4, 0xfe,
+		0x7e, 0x57, 0x5e, 0x80, 0x62, 0xa4, 0x03, 0xe3, 0x56, 0x2d, 0x7f, 0x35, 0x3c, 0x30, 0x14, 0x1a,
+		0xbd, 0x0a, 0xb3, 0x3d, 0xd3, 0x30, 0x3d, 0xec, 0xd8, 0x0e, 0x26, 0x1a, 0xcb, 0xaa, 0x9a, 0xfb,
+		0xa3, 0xec, 0x08, 0x9d, 0xdb, 0x0a, 0x53, 0x33, 0x14, 0x65, 0xa6, 0x37, 0x58, 0x78, 0x2e, 0x9f,
+		0xfb, 0x5c, 0x56, 0x7a, 0xeb, 0xad, 0xb7, 0xde, 0x4a, 0xca, 0xbf, 0x94, 0x81, 0xd9, 0x61, 0x73,
+		0x66, 0xe8, 0xf4, 0x3d, 0x01, 0x19, 0xb3, 0xd7, 0xdd, 0xc6, 0x0e, 0x15, 0xd2, 0xa4, 0xc2, 0x9f,
+		0x50, 0x15, 0x26, 0x3b, 0xda, 0x36, 0xee, 0xcc, 0xa5, 0x17, 0x12, 0x67, 0x4b, 0x17, 0x9e, 0x1c,
+		0x6b, 0x56, 0x2e, 0xae, 0x12, 0x16, 0x85, 0x71, 0xa2, 0xf7, 0x43, 0x9a, 0x1b, 0x6f, 0x82, 0x70,
+		0x6e, 0x3c, 0x04, 0x32, 0x97, 0x14, 0xca, 0x87, 0x4e, 0x43, 0x9e, 0xfc, 0xcf, 0x74, 0x23, 0x43,
+		0xdb, 0x9c, 0x23, 0x05, 0x44, 0x2f, 0x50, 0x05, 0x72, 0x74, 0x9a, 0xb4, 0xb1, 0x58, 0xf4, 0xfc,
+		0x67, 0xa2, 0x58, 0x6d, 0xbc, 0xa3, 0xf5, 0x3a, 0x9e, 0xba, 0xaf, 0x75, 0x7a, 0x98, 0x2a, 0x7c,
+		0x5e, 0x99, 0xe2, 0x85, 0x37, 0x49, 0x19, 0x9a, 0x87, 0x02, 0x9b, 0x55, 0x86, 0xd9, 0xc6, 0x07,
+		0xd4, 0xae, 0x4e, 0x2a, 0x6c, 0xa2, 0xad, 0x90, 0x12, 0x52, 0xfd, 0x1b, 0xae, 0x65, 0x0a, 0xd5,
+		0xa4, 0x55, 0x90, 0x02, 0x5a, 0xfd, 0x0b, 0xfd, 0x26, 0xfd, 0xa1, 0xe1, 0xdd, 0x1b, 0x98, 0x4b,
+		0x8f, 0x43, 0x99, 0x52, 0x3c, 0xc7, 0x87, 0x5e, 0xeb, 0xcc, 0x4d, 0x2f, 0x24, 0xce, 0xe6, 0x94,
+		0x12, 0x2b, 0xde, 0xe0, 0xa5, 0xf2, 0x4f, 0x27, 0x21, 0x4d, 0x0d, 0x4b, 0x19, 0x0a, 0xad, 0xd7,
+		0x36, 0x1b, 0xea, 0xf2, 0xc6, 0x56, 0x6d, 0xb5, 0x21, 0x25, 0x50, 0x09, 0x80, 0x16, 0x5c, 0x59,
+		0xdd, 0xa8, 0xb6, 0xa4, 0xa4, 0xff, 0xbc, 0xb2, 0xde, 0xba, 0xf4, 0xbc, 0x94, 0xf2, 0x19, 0xb6,
+		0x58, 0x41, 0x3a, 0x4c, 0xf0, 0xdc, 0x05, 0x69, 0x12, 0x49, 0x30, 0xc5, 0x00, 0x56, 0x5e, 0x6d,
+		0x2c, 0x5f, 0x7a, 0x5e, 0xca, 0x44, 0x4b, 0x9e, 0xbb, 0x20, 0x65, 0x51, 0x11, 0xf2, 0xb4, 0xa4,
+		0xb6, 0xb1, 0xb1, 0x2a, 0xe5, 0x7c, 0xcc, 0x66, 0x4b, 0x59, 0x59, 0xbf, 0x2a, 0xe5, 0x7d, 0xcc,
+		0xab, 0xca, 0xc6, 0xd6, 0xa6, 0x04, 0x3e, 0xc2, 0x5a, 0xa3, 0xd9, 0xac, 0x5e, 0x6d, 0x48, 0x05,
+		0x9f, 0xa2, 0xf6, 0x5a, 0xab, 0xd1, 0x94, 0xa6, 0x22, 0xcd, 0x7a, 0xee, 0x82, 0x54, 0xf4, 0xab,
+		0x68, 0xac, 0x6f, 0xad, 0x49, 0x25, 0x34, 0x0d, 0x45, 0x56, 0x85, 0x68, 0x44, 0xb9, 0xaf, 0xe8,
+		0xd2, 0xf3, 0x92, 0x14, 0x34, 0x84, 0xa1, 0x4c, 0x47, 0x0a, 0x2e, 0x3d, 0x2f, 0x21, 0xb9, 0x0e,
+		0x93, 0x54, 0x0d, 0x11, 0x82, 0xd2, 0x6a, 0xb5, 0xd6, 0x58, 0x55, 0x37, 0x36, 0x5b, 0x2b, 0x1b,
+		0xeb, 0xd5, 0x55, 0x29, 0x11, 0x94, 0x29, 0x8d, 0x0f, 0x6e, 0xad, 0x28, 0x8d, 0x65, 0x29, 0x19,
+		0x2e, 0xdb, 0x6c, 0x54, 0x5b, 0x8d, 0x65, 0x29, 0x25, 0xeb, 0x30, 0x3b, 0xcc, 0xa0, 0x0e, 0x9d,
+		0x42, 0x21, 0x5d, 0x48, 0x8e, 0xd0, 0x05, 0x8a, 0xd5, 0xaf, 0x0b, 0xf2, 0x67, 0x93, 0x30, 0x33,
+		0x64, 0x51, 0x19, 0x5a, 0xc9, 0xcb, 0x30, 0xc9, 0x74, 0x99, 0x2d, 0xb3, 0x4f, 0x0c, 0x5d, 0x9d,
+		0xa8, 0x66, 0x0f, 0x2c, 0xb5, 0x94, 0x2f, 0xec, 0x84, 0xa4, 0x46, 0x38, 0x21, 0x04, 0x62, 0x40,
+		0x61, 0xbf, 0x72, 0xc0, 0xf8, 0xb3, 0xf5, 0xf1, 0xd2, 0x38, 0xeb, 0x23, 0x2d, 0x3b, 0xde, 0x22,
+		0x30, 0x39, 0x64, 0x11, 0xb8, 0x0c, 0xd3, 0x03, 0x40, 0x63, 0x1b, 0xe3, 0x8f, 0x24, 0x60, 0x6e,
+		0x94, 0x70, 0x62, 0x4c, 0x62, 0x32, 0x62, 0x12, 0x2f, 0xf7, 0x4b, 0xf0, 0xe1, 0xd1, 0x83, 0x30,
+		0x30, 0xd6, 0x3f, 0x98, 0x80, 0x13, 0xc3, 0x9d, 0xcd, 0xa1, 0x6d, 0x78, 0x3f, 0x64, 0xba, 0xd8,
+		0xdb, 0xb3, 0x84, 0x5b, 0xf5, 0xd8, 0x90, 0xc5, 0x9a, 0xbc, 0xee, 0x1f, 0x6c, 0xce, 0x15, 0x5e,
+		0xed, 0x53, 0xa3, 0x3c, 0x46, 0xd6, 0x9a, 0x81, 0x96, 0x7e, 0x43, 0x12, 0x1e, 0x18, 0x0a, 0x3e,
+		0xb4, 0xa1, 0x0f, 0x01, 0x18, 0xa6, 0xdd, 0xf3, 0x98, 0xeb, 0xc4, 0x2c, 0x71, 0x9e, 0x96, 0x50,
+		0xe3, 0x45, 0xac, 0x6c, 0xcf, 0xf3, 0xdf, 0xa7, 0xe8, 0x7b, 0x60, 0x45, 0x94, 0xe0, 0xc5, 0xa0,
+		0xa1, 0x69, 0xda, 0xd0, 0x33, 0x23, 0x7a, 0x3a, 0xa0, 0x98, 0xcf, 0x80, 0xa4, 0x77, 0x0c, 0x6c,
+		0x7a, 0xaa, 0xeb, 0x39, 0x58, 0xeb, 0x1a, 0xe6, 0x2e, 0x5d, 0x6a, 0x72, 0x4b, 0x93, 0x3b, 0x5a,
+		0xc7, 0xc5, 0x4a, 0x99, 0xbd, 0x6e, 0x8a, 0xb7, 0x84, 0x83, 0x2a, 0x90, 0x13, 0xe2, 0xc8, 0x44,
+		0x38, 0xd8, 0x6b, 0x9f, 0x43, 0xfe, 0xe6, 0x3c, 0x14, 0x42, 0xae, 0x39, 0x7a, 0x18, 0xa6, 0xde,
+		0xd0, 0xf6, 0x35, 0x55, 0x84, 0x5b, 0x4c, 0x12, 0x05, 0x52, 0xb6, 0xc9, 0x43, 0xae, 0x67, 0x60,
+		0x96, 0x92, 0x58, 0x3d, 0x0f, 0x3b, 0xaa, 0xde, 0xd1, 0x5c, 0x97, 0x0a, 0x2d, 0x47, 0x49, 0x11,
+		0x79, 0xb7, 0x41, 0x5e, 0xd5, 0xc5, 0x1b, 0x74, 0x11, 0x66, 0x28, 0x47, 0xb7, 0xd7, 0xf1, 0x0c,
+		0xbb, 0x83, 0x55, 0x12, 0x00, 0xba, 0x74, 0xc9, 0xf1, 0x5b, 0x36, 0x4d, 0x28, 0xd6, 0x38, 0x01,
+		0x69, 0x91, 0x8b, 0x96, 0xe1, 0x21, 0xca, 0xb6, 0x8b, 0x4d, 0xec, 0x68, 0x1e, 0x56, 0xf1, 0x87,
+		0x7b, 0x5a, 0xc7, 0x55, 0x35, 0xb3, 0xad, 0xee, 0x69, 0xee, 0xde, 0xdc, 0x2c, 0x01, 0xa8, 0x25,
+		0xe7, 0x12, 0xca, 0x29, 0x42, 0x78, 0x95, 0xd3, 0x35, 0x28, 0x59, 0xd5, 0x6c, 0x5f, 0xd3, 0xdc,
+		0x3d, 0xb4, 0x04, 0x27, 0x28, 0x8a, 0xeb, 0x39, 0x86, 0xb9, 0xab, 0xea, 0x7b, 0x58, 0xbf, 0xa5,
+		0xf6, 0xbc, 0x9d, 0x17, 0xe7, 0x4e, 0x87, 0xeb, 0xa7, 0x2d, 0x6c, 0x52, 0x9a, 0x3a, 0x21, 0xd9,
+		0xf2, 0x76, 0x5e, 0x44, 0x4d, 0x98, 0x22, 0x83, 0xd1, 0x35, 0xde, 0xc4, 0xea, 0x8e, 0xe5, 0xd0,
+		0x35, 0xb4, 0x34, 0xc4, 0x34, 0x85, 0x24, 0xb8, 0xb8, 0xc1, 0x19, 0xd6, 0xac, 0x36, 0x5e, 0x9a,
+		0x6c, 0x6e, 0x36, 0x1a, 0xcb, 0x4a, 0x41, 0xa0, 0x5c, 0xb1, 0x1c, 0xa2, 0x50, 0xbb, 0x96, 0x2f,
+		0xe0, 0x02, 0x53, 0xa8, 0x5d, 0x4b, 0x88, 0xf7, 0x22, 0xcc, 0xe8, 0x3a, 0xeb, 0xb3, 0xa1, 0xab,
+		0x3c, 0x4c, 0x73, 0xe7, 0xa4, 0x88, 0xb0, 0x74, 0xfd, 0x2a, 0x23, 0xe0, 0x3a, 0xee, 0xa2, 0x97,
+		0xe0, 0x81, 0x40, 0x58, 0x61, 0xc6, 0xe9, 0x81, 0x5e, 0xf6, 0xb3, 0x5e, 0x84, 0x19, 0xfb, 0x70,
+		0x90, 0x11, 0x45, 0x6a, 0xb4, 0x0f, 0xfb, 0xd9, 0x5e, 0x80, 0x59, 0x7b, 0xcf, 0x1e, 0xe4, 0x3b,
+		0x17, 0xe6, 0x43, 0xf6, 0x9e, 0xdd, 0xcf, 0xf8, 0x28, 0x8d, 0xd9, 0x1d, 0xac, 0x6b, 0x1e, 0x6e,
+		0xcf, 0x9d, 0x0c, 0x93, 0x87, 0x5e, 0xa0, 0x45, 0x90, 0x74, 0x5d, 0xc5, 0xa6, 0xb6, 0xdd, 0xc1,
+		0xaa, 0xe6, 0x60, 0x53, 0x73, 0xe7, 0xe6, 0x29, 0x71, 0xda, 0x73, 0x7a, 0x58, 0x29, 0xe9, 0x7a,
+		0x83, 0xbe, 0xac, 0xd2, 0x77, 0xe8, 0x1c, 0x4c, 0x5b, 0xdb, 0x6f, 0xe8, 0x4c, 0x23, 0x55, 0xdb,
+		0xc1, 0x3b, 0xc6, 0xc1, 0xdc, 0x7b, 0xa8, 0x78, 0xcb, 0xe4, 0x05, 0xd5, 0xc7, 0x4d, 0x5a, 0x8c,
+		0x9e, 0x00, 0x49, 0x77, 0xf7, 0x34, 0xc7, 0xa6, 0x26, 0xd9, 0xb5, 0x35, 0x1d, 0xcf, 0x3d, 0xca,
+		0x48, 0x59, 0xf9, 0xba, 0x28, 0x26, 0x33, 0xc2, 0xbd, 0x6d, 0xec, 0x78, 0x02, 0xf1, 0x71, 0x36,
+		0x23, 0x68, 0x19, 0x47, 0x3b, 0x0b, 0x12, 0x91, 0x44, 0xa4, 0xe2, 0xb3, 0x94, 0xac, 0x64, 0xef,
+		0xd9, 0xe1, 0x7a, 0x1f, 0x81, 0x22, 0xa1, 0x0c, 0x2a, 0x7d, 0x82, 0x39, 0x6e, 0xf6, 0x5e, 0xa8,
+		0xc6, 0xe7, 0xe1, 0x04, 0x21, 0xea, 0x62, 0x4f, 0x6b, 0x6b, 0x9e, 0x16, 0xa2, 0x7e, 0x8a, 0x52,
+		0x13, 0xb1, 0xaf, 0xf1, 0x97, 0x91, 0x76, 0x3a, 0xbd, 0xed, 0x43, 0x5f, 0xb1, 0x9e, 0x66, 0xed,
+		0x24, 0x65, 0x42, 0xb5, 0xde, 0x35, 0xe7, 0x5c, 0x5e, 0x82, 0xa9, 0xb0, 0xde, 0xa3, 0x3c, 0x30,
+		0xcd, 0x97, 0x12, 0xc4, 0x09, 0xaa, 0x6f, 0x2c, 0x13, 0xf7, 0xe5, 0xf5, 0x86, 0x94, 0x24, 0x6e,
+		0xd4, 0xea, 0x4a, 0xab, 0xa1, 0x2a, 0x5b, 0xeb, 0xad, 0x95, 0xb5, 0x86, 0x94, 0x0a, 0x39, 0xf6,
+		0xd7, 0xd3, 0xb9, 0xc7, 0xa4, 0xc7, 0xe5, 0x9f, 0x4f, 0x41, 0x29, 0x1a, 0xa9, 0xa1, 0xf7, 0xc2,
+		0x49, 0x91, 0x70, 0x71, 0xb1, 0xa7, 0xde, 0x36, 0x1c, 0x3a, 0x21, 0xbb, 0x1a, 0x5b, 0x1c, 0x7d,
+		0xfd, 0x99, 0xe5, 0x54, 0x4d, 0xec, 0xbd, 0x62, 0x38, 0x64, 0xba, 0x75, 0x35, 0x0f, 0xad, 0xc2,
+		0xbc, 0x69, 0xa9, 0xae, 0xa7, 0x99, 0x6d, 0xcd, 0x69, 0xab, 0x41, 0xaa, 0x4b, 0xd5, 0x74, 0x1d,
+		0xbb, 0xae, 0xc5, 0x16, 0x42, 0x1f, 0xe5, 0x41, 0xd3, 0x6a, 0x72, 0xe2, 0x60, 0x85, 0xa8, 0x72,
+		0xd2, 0x3e, 0xf5, 0x4d, 0x8d, 0x52, 0xdf, 0xd3, 0x90, 0xef, 0x6a, 0xb6, 0x8a, 0x4d, 0xcf, 0x39,
+		0xa4, 0xfe, 0x79, 0x4e, 0xc9, 0x75, 0x35, 0xbb, 0x41, 0x9e, 0xd1, 0x4d, 0x78, 0x2c, 0x20, 0x55,
+		0x3b, 0x78, 0x57, 0xd3, 0x0f, 0x55, 0xea, 0x8c, 0xd3, 0xb4, 0x81, 0xaa, 0x5b, 0xe6, 0x4e, 0xc7,
+		0xd0, 0x3d, 0x97, 0xda, 0x07, 0x66, 0xe3, 0xe4, 0x80, 0x63, 0x95, 0x32, 0x5c, 0x77, 0x2d, 0x93,
+		0xfa, 0xe0, 0x75, 0x41, 0xfd, 0x65, 0x09, 0xbf, 0xae, 0xa7, 0x73, 0x69, 0x69, 0xf2, 0x7a, 0x3a,
+		0x37, 0x29, 0x65, 0xae, 0xa7, 0x73, 0x19, 0x29, 0x7b, 0x3d, 0x9d, 0xcb, 0x49, 0xf9, 0xeb, 0xe9,
+		0x5c, 0x5e, 0x02, 0xf9, 0x67, 0x72, 0x30, 0x15, 0x8e, 0x0c, 0x48, 0xa0, 0xa5, 0xd3, 0xb5, 0x31,
+		0x41, 0xad, 0xe7, 0x23, 0x47, 0xc6, 0x11, 0x8b, 0x75, 0xb2, 0x68, 0x2e, 0x65, 0x98, 0x1b, 0xae,
+		0x30, 0x4e, 0xe2, 0xb0, 0x10, 0xb5, 0xc6, 0xcc, 0xed, 0xc9, 0x29, 0xfc, 0x09, 0x5d, 0x85, 0xcc,
+		0x1b, 0x2e, 0xc5, 0xce, 0x50, 0xec, 0xf7, 0x1c, 0x8d, 0x7d, 0xbd, 0x49, 0xc1, 0xf3, 0xd7, 0x9b,
+		0xea, 0xfa, 0x86, 0xb2, 0x56, 0x5d, 0x55, 0x38, 0x3b, 0x3a, 0x05, 0xe9, 0x8e, 0xf6, 0xe6, 0x61,
+		0x74, 0x79, 0xa5, 0x45, 0x68, 0x11, 0xca, 0x3d, 0x73, 0x1f, 0x3b, 0xc6, 0x8e, 0x41, 0x86, 0x8a,
+		0x50, 0x95, 0xc3, 0x54, 0xa5, 0xe0, 0xed, 0x2a, 0xa1, 0x1f, 0x53, 0x3d, 0x4e, 0x41, 0xfa, 0x36,
+		0xd6, 0x6e, 0x45, 0x17, 0x41, 0x5a, 0x84, 0xce, 0xc2, 0x54, 0x1b, 0x6f, 0xf7, 0x76, 0x55, 0x07,
+		0xb7, 0x35, 0xdd, 0x8b, 0x9a, 0xfe, 0x02, 0x7d, 0xa5, 0xd0, 0x37, 0xe8, 0x06, 0xe4, 0xc9, 0x18,
+		0x99, 0x74, 0x8c, 0xa7, 0xa9, 0x08, 0x9e, 0x3e, 0x5a, 0x04, 0x7c, 0x88, 0x05, 0x93, 0x12, 0xf0,
+		0xa3, 0x2b, 0x90, 0xf1, 0x34, 0x67, 0x17, 0x7b, 0xd4, 0xf2, 0x97, 0x86, 0x24, 0x3f, 0x86, 0x20,
+		0xb5, 0x28, 0x07, 0x8d, 0x69, 0x39, 0xf7, 0xbb, 0x68, 0x65, 0xce, 0xc3, 0x24, 0x55, 0x0f, 0x04,
+		0xc0, 0x15, 0x44, 0x9a, 0x40, 0x39, 0x48, 0xd7, 0x37, 0x14, 0x62, 0x69, 0x24, 0x98, 0x62, 0xa5,
+		0xea, 0xe6, 0x4a, 0xa3, 0xde, 0x90, 0x92, 0xf2, 0x45, 0xc8, 0xb0, 0x31, 0x27, 0x56, 0xc8, 0x1f,
+		0x75, 0x69, 0x82, 0x3f, 0x72, 0x8c, 0x84, 0x78, 0xbb, 0xb5, 0x56, 0x6b, 0x28, 0x52, 0x52, 0xde,
+		0x82, 0x72, 0x9f, 0x9c, 0xd0, 0x03, 0x30, 0xad, 0x34, 0x5a, 0x8d, 0x75, 0x12, 0x67, 0xa9, 0x5b,
+		0xeb, 0x37, 0xd6, 0x37, 0x5e, 0x59, 0x97, 0x26, 0xa2, 0xc5, 0xc2, 0xa4, 0x25, 0xd0, 0x2c, 0x48,
+		0x41, 0x71, 0x73, 0x63, 0x4b, 0xa1, 0xad, 0xf9, 0xc6, 0x24, 0x48, 0xfd, 0x52, 0x43, 0x27, 0x61,
+		0xa6, 0x55, 0x55, 0xae, 0x36, 0x5a, 0x2a, 0x8b, 0x1d, 0x7d, 0xe8, 0x59, 0x90, 0xc2, 0x2f, 0xae,
+		0xac, 0xd0, 0xd0, 0x78, 0x1e, 0x4e, 0x87, 0x4b, 0x1b, 0xaf, 0xb6, 0x1a, 0xeb, 0x4d, 0x5a, 0x79,
+		0x75, 0xfd, 0x2a, 0xb1, 0xaf, 0x7d, 0x78, 0x22, 0x5a, 0x4d, 0x91, 0xa6, 0x46, 0xf1, 0x1a, 0xab,
+		0xcb, 0x52, 0xba, 0xbf, 0x78, 0x63, 0xbd, 0xb1, 0x71, 0x45, 0x9a, 0xec, 0xaf, 0x9d, 0x46, 0xb0,
+		0x19, 0x54, 0x81, 0x13, 0xfd, 0xa5, 0x6a, 0x63, 0xbd, 0xa5, 0xbc, 0x26, 0x65, 0xfb, 0x2b, 0x6e,
+		0x36, 0x94, 0x9b, 0x2b, 0xf5, 0x86, 0x94, 0x43, 0x27, 0x00, 0x45, 0x5b, 0xd4, 0xba, 0xb6, 0xb1,
+		0x2c, 0xe5, 0x07, 0x2c, 0x8a, 0xec, 0xc2, 0x54, 0x38, 0x8c, 0xfc, 0xf2, 0xe4, 0x92, 0xde, 0x4e,
+		0x42, 0x21, 0x14, 0x16, 0x12, 0x7f, 0x5e, 0xeb, 0x74, 0xac, 0xdb, 0xaa, 0xd6, 0x31, 0x34, 0x97,
+		0xdb, 0x1b, 0xa0, 0x45, 0x55, 0x52, 0x32, 0xee, 0xfc, 0x1e, 0xdf, 0xc2, 0x67, 0xfe, 0x36, 0x5a,
+		0xf8, 0x49, 0x29, 0x23, 0x7f, 0x77, 0x02, 0xa4, 0xfe, 0x78, 0xaf, 0xaf, 0xfb, 0x89, 0x51, 0xdd,
+		0xff, 0xb2, 0x8c, 0xdd, 0x77, 0x25, 0xa0, 0x14, 0x0d, 0xf2, 0xfa, 0x9a, 0xf7, 0xf0, 0xff, 0xd6,
+		0xe6, 0xfd, 0x5e, 0x12, 0x8a, 0x91, 0xd0, 0x6e, 0xdc, 0xd6, 0x7d, 0x18, 0xa6, 0x8d, 0x36, 0xee,
+		0xda, 0x96, 0x87, 0x4d, 0xfd, 0x50, 0xed, 0xe0, 0x7d, 0xdc, 0x99, 0x93, 0xa9, 0x51, 0x3e, 0x7f,
+		0x74, 0xf0, 0xb8, 0xb8, 0x12, 0xf0, 0xad, 0x12, 0xb6, 0xa5, 0x99, 0x95, 0xe5, 0xc6, 0xda, 0xe6,
+		0x46, 0xab, 0xb1, 0x5e, 0x7f, 0x4d, 0x58, 0x17, 0x45, 0x32, 0xfa, 0xc8, 0xde, 0x45, 0xa3, 0xbd,
+		0x09, 0x52, 0x7f, 0xa3, 0x88, 0xad, 0x18, 0xd2, 0x2c, 0x69, 0x02, 0xcd, 0x40, 0x79, 0x7d, 0x43,
+		0x6d, 0xae, 0x2c, 0x37, 0xd4, 0xc6, 0x95, 0x2b, 0x8d, 0x7a, 0xab, 0xc9, 0xd2, 0x81, 0x3e, 0x75,
+		0x4b, 0x4a, 0x86, 0x45, 0xfc, 0x1d, 0x29, 0x98, 0x19, 0xd2, 0x12, 0x54, 0xe5, 0x81, 0x3c, 0xcb,
+		0x2d, 0x3c, 0x3d, 0x4e, 0xeb, 0x17, 0x89, 0x2b, 0xbd, 0xa9, 0x39, 0x1e, 0x8f, 0xfb, 0x9f, 0x00,
+		0x22, 0x25, 0xd3, 0x23, 0x2b, 0xbb, 0xc3, 0xd3, 0xac, 0x2c, 0xba, 0x2f, 0x07, 0xe5, 0x2c, 0xd3,
+		0xfa, 0x14, 0x20, 0xdb, 0x72, 0x0d, 0xcf, 0xd8, 0xc7, 0xaa, 0x61, 0x8a, 0x9c, 0x2c, 0x89, 0xf6,
+		0xd3, 0x8a, 0x24, 0xde, 0xac, 0x98, 0x9e, 0x4f, 0x6d, 0xe2, 0x5d, 0xad, 0x8f, 0x9a, 0x78, 0x1e,
+		0x29, 0x45, 0x12, 0x6f, 0x7c, 0xea, 0x87, 0x61, 0xaa, 0x6d, 0xf5, 0x48, 0x08, 0xc4, 0xe8, 0x88,
+		0xb5, 0x48, 0x28, 0x05, 0x56, 0xe6, 0x93, 0xf0, 0xe0, 0x36, 0x48, 0x06, 0x4f, 0x29, 0x05, 0x56,
+		0xc6, 0x48, 0x1e, 0x87, 0xb2, 0xb6, 0xbb, 0xeb, 0x10, 0x70, 0x01, 0xc4, 0xc2, 0xf5, 0x92, 0x5f,
+		0x4c, 0x09, 0x2b, 0xd7, 0x21, 0x27, 0xe4, 0x40, 0x3c, 0x58, 0x22, 0x09, 0xd5, 0x66, 0x39, 0xa8,
+		0xe4, 0xd9, 0xbc, 0x92, 0x33, 0xc5, 0xcb, 0x87, 0x61, 0xca, 0x70, 0xd5, 0x60, 0x6f, 0x2b, 0xb9,
+		0x90, 0x3c, 0x9b, 0x53, 0x0a, 0x86, 0xeb, 0xef, 0x0b, 0xc8, 0x3f, 0x98, 0x84, 0x52, 0x74, 0xd7,
+		0x0e, 0x2d, 0x43, 0xae, 0x63, 0xe9, 0x1a, 0x55, 0x2d, 0xb6, 0x65, 0x7c, 0x36, 0x66, 0xa3, 0x6f,
+		0x71, 0x95, 0xd3, 0x2b, 0x3e, 0x67, 0xe5, 0xdf, 0x25, 0x20, 0x27, 0x8a, 0xd1, 0x09, 0x48, 0xdb,
+		0x9a, 0xb7, 0x47, 0xe1, 0x26, 0x6b, 0x49, 0x29, 0xa1, 0xd0, 0x67, 0x52, 0xee, 0xda, 0x9a, 0x49,
+		0x55, 0x80, 0x97, 0x93, 0x67, 0x32, 0xae, 0x1d, 0xac, 0xb5, 0x69, 0x2e, 0xc0, 0xea, 0x76, 0xb1,
+		0xe9, 0xb9, 0x62, 0x5c, 0x79, 0x79, 0x9d, 0x17, 0xa3, 0x27, 0x61, 0xda, 0x73, 0x34, 0xa3, 0x13,
+		0xa1, 0x4d, 0x53, 0x5a, 0x49, 0xbc, 0xf0, 0x89, 0x97, 0xe0, 0x94, 0xc0, 0x6d, 0x63, 0x4f, 0xd3,
+		0xf7, 0x70, 0x3b, 0x60, 0xca, 0xd0, 0x9c, 0xdf, 0x49, 0x4e, 0xb0, 0xcc, 0xdf, 0x0b, 0x5e, 0xf9,
+		0xd3, 0x49, 0x98, 0x16, 0xd9, 0x8b, 0xb6, 0x2f, 0xac, 0x35, 0x00, 0xcd, 0x34, 0x2d, 0x2f, 0x2c,
+		0xae, 0x41, 0x55, 0x1e, 0xe0, 0x5b, 0xac, 0xfa, 0x4c, 0x4a, 0x08, 0xa0, 0xf2, 0x27, 0x09, 0x80,
+		0xe0, 0xd5, 0x48, 0xb9, 0xcd, 0x43, 0x81, 0xef, 0xc9, 0xd2, 0x8d, 0x7d, 0x96, 0xf0, 0x02, 0x56,
+		0x74, 0xc5, 0xe8, 0xd0, 0xb4, 0xe4, 0x36, 0xde, 0x35, 0x4c, 0xbe, 0x9f, 0xc2, 0x1e, 0x44, 0x5a,
+		0x32, 0x1d, 0x6c, 0x4f, 0x29, 0x90, 0x73, 0x71, 0x57, 0x33, 0x3d, 0x43, 0xe7, 0x3b, 0x24, 0x97,
+		0x8e, 0xd5, 0xf8, 0xc5, 0x26, 0xe7, 0x56, 0x7c, 0x1c, 0xf9, 0x2c, 0xe4, 0x44, 0x29, 0x71, 0xfc,
+		0xd6, 0x37, 0xd6, 0x1b, 0xd2, 0x04, 0xca, 0x42, 0xaa, 0xd9, 0x68, 0x49, 0x09, 0x12, 0x76, 0x56,
+		0x57, 0x57, 0xaa, 0x4d, 0x29, 0x59, 0xfb, 0x7f, 0x60, 0x46, 0xb7, 0xba, 0xfd, 0x15, 0xd6, 0xa4,
+		0xbe, 0x94, 0x9f, 0x7b, 0x2d, 0xf1, 0xfa, 0xd3, 0x9c, 0x68, 0xd7, 0xea, 0x68, 0xe6, 0xee, 0xa2,
+		0xe5, 0xec, 0x06, 0xc7, 0x22, 0x48, 0x74, 0xe0, 0x86, 0x0e, 0x47, 0xd8, 0xdb, 0xff, 0x23, 0x91,
+		0xf8, 0x9e, 0x64, 0xea, 0xea, 0x66, 0xed, 0x87, 0x93, 0x95, 0xab, 0x8c, 0x71, 0x53, 0x74, 0x47,
+		0xc1, 0x3b, 0x1d, 0xac, 0x93, 0xc6, 0xc3, 0x9f, 0x3e, 0x09, 0xb3, 0xbb, 0xd6, 0xae, 0x45, 0x91,
+		0xce, 0x93, 0x5f, 0xfc, 0x5c, 0x45, 0xde, 0x2f, 0xad, 0xc4, 0x1e, 0xc2, 0x58, 0x5a, 0x87, 0x19,
+		0x4e, 0xac, 0xd2, 0xed, 0x5b, 0x96, 0x5c, 0x40, 0x47, 0x66, 0xb6, 0xe7, 0x7e, 0xe2, 0x0f, 0xa8,
+		0x57, 0xa2, 0x4c, 0x73, 0x56, 0xf2, 0x8e, 0xe5, 0x1f, 0x96, 0x14, 0x78, 0x20, 0x82, 0xc7, 0x6c,
+		0x04, 0x76, 0x62, 0x10, 0x7f, 0x99, 0x23, 0xce, 0x84, 0x10, 0x9b, 0x9c, 0x75, 0xa9, 0x0e, 0xc5,
+		0xe3, 0x60, 0xfd, 0x0a, 0xc7, 0x9a, 0xc2, 0x61, 0x90, 0xab, 0x50, 0xa6, 0x20, 0x7a, 0xcf, 0xf5,
+		0xac, 0x2e, 0x35, 0xc0, 0x47, 0xc3, 0xfc, 0xdb, 0x3f, 0x60, 0x93, 0xb6, 0x44, 0xd8, 0xea, 0x3e,
+		0xd7, 0xd2, 0x12, 0xd0, 0x1d, 0xeb, 0x36, 0xd6, 0x3b, 0x31, 0x08, 0xbf, 0xca, 0x1b, 0xe2, 0xd3,
+		0x2f, 0xdd, 0x84, 0x59, 0xf2, 0x9b, 0xda, 0xc7, 0x70, 0x4b, 0xe2, 0xd3, 0xe0, 0x73, 0xff, 0xfe,
+		0x23, 0xcc, 0x2e, 0xcc, 0xf8, 0x00, 0xa1, 0x36, 0x85, 0x46, 0x71, 0x17, 0x7b, 0x1e, 0x76, 0x5c,
+		0x55, 0xeb, 0x0c, 0x6b, 0x5e, 0x28, 0x8f, 0x38, 0xf7, 0xed, 0x9f, 0x8f, 0x8e, 0xe2, 0x55, 0xc6,
+		0x59, 0xed, 0x74, 0x96, 0xb6, 0xe0, 0xe4, 0x10, 0xad, 0x18, 0x03, 0xf3, 0x3b, 0x38, 0xe6, 0xec,
+		0x80, 0x66, 0x10, 0xd8, 0x4d, 0x10, 0xe5, 0xfe, 0x58, 0x8e, 0x81, 0xf9, 0x9d, 0x1c, 0x13, 0x71,
+		0x5e, 0x31, 0xa4, 0x04, 0xf1, 0x3a, 0x4c, 0xef, 0x63, 0x67, 0xdb, 0x72, 0x79, 0xee, 0x76, 0x0c,
+		0xb8, 0xef, 0xe2, 0x70, 0x65, 0xce, 0x48, 0x93, 0xb9, 0x04, 0xeb, 0x25, 0xc8, 0xed, 0x68, 0x3a,
+		0x1e, 0x03, 0xe2, 0x2e, 0x87, 0xc8, 0x12, 0x7a, 0xc2, 0x5a, 0x85, 0xa9, 0x5d, 0x8b, 0x2f, 0x91,
+		0xf1, 0xec, 0xdf, 0xcd, 0xd9, 0x0b, 0x82, 0x87, 0x43, 0xd8, 0x96, 0xdd, 0xeb, 0x90, 0xf5, 0x33,
+		0x1e, 0xe2, 0x1f, 0x0b, 0x08, 0xc1, 0xc3, 0x21, 0x8e, 0x21, 0xd6, 0x8f, 0x0b, 0x08, 0x37, 0x24,
+		0xcf, 0x97, 0xa1, 0x60, 0x99, 0x9d, 0x43, 0xcb, 0x1c, 0xa7, 0x11, 0x9f, 0xe0, 0x08, 0xc0, 0x59,
+		0x08, 0xc0, 0x65, 0xc8, 0x8f, 0x3b, 0x10, 0xdf, 0xff, 0x79, 0x31, 0x3d, 0xc4, 0x08, 0x5c, 0x85,
+		0xb2, 0x30, 0x50, 0x86, 0x65, 0x8e, 0x01, 0xf1, 0x03, 0x1c, 0xa2, 0x14, 0x62, 0xe3, 0xdd, 0xf0,
+		0xb0, 0xeb, 0xed, 0xe2, 0x71, 0x40, 0x7e, 0x50, 0x74, 0x83, 0xb3, 0x70, 0x51, 0x6e, 0x63, 0x53,
+		0xdf, 0x1b, 0x0f, 0xe1, 0x93, 0x42, 0x94, 0x82, 0x87, 0x40, 0xd4, 0xa1, 0xd8, 0xd5, 0x1c, 0x77,
+		0x4f, 0xeb, 0x8c, 0x35, 0x1c, 0x3f, 0xc4, 0x31, 0xa6, 0x7c, 0x26, 0x2e, 0x91, 0x9e, 0x79, 0x1c,
+		0x98, 0x1f, 0x16, 0x12, 0x09, 0xb1, 0xf1, 0xa9, 0xe7, 0x7a, 0x34, 0xd1, 0x7d, 0x1c, 0xb4, 0x1f,
+		0x11, 0x53, 0x8f, 0xf1, 0xae, 0x85, 0x11, 0x2f, 0x43, 0xde, 0x35, 0xde, 0x1c, 0x0b, 0xe6, 0x47,
+		0xc5, 0x48, 0x53, 0x06, 0xc2, 0xfc, 0x1a, 0x9c, 0x1a, 0xba, 0x4c, 0x8c, 0x01, 0xf6, 0x63, 0x1c,
+		0xec, 0xc4, 0x90, 0xa5, 0x82, 0x9b, 0x84, 0xe3, 0x42, 0xfe, 0x13, 0x61, 0x12, 0x70, 0x1f, 0xd6,
+		0x26, 0x09, 0x5a, 0x5c, 0x6d, 0xe7, 0x78, 0x52, 0xfb, 0xa7, 0x42, 0x6a, 0x8c, 0x37, 0x22, 0xb5,
+		0x16, 0x9c, 0xe0, 0x88, 0xc7, 0x1b, 0xd7, 0x1f, 0x17, 0x86, 0x95, 0x71, 0x6f, 0x45, 0x47, 0xf7,
+		0x2b, 0xa0, 0xe2, 0x8b, 0x53, 0x78, 0xc7, 0xae, 0xda, 0xd5, 0xec, 0x31, 0x90, 0x7f, 0x82, 0x23,
+		0x0b, 0x8b, 0xef, 0xbb, 0xd7, 0xee, 0x9a, 0x66, 0x13, 0xf0, 0x57, 0x61, 0x4e, 0x80, 0xf7, 0x4c,
+		0x07, 0xeb, 0xd6, 0xae, 0x69, 0xbc, 0x89, 0xdb, 0x63, 0x40, 0xff, 0x64, 0xdf, 0x50, 0x6d, 0x85,
+		0xd8, 0x09, 0xf2, 0x0a, 0x48, 0xbe, 0xaf, 0xa2, 0x1a, 0x5d, 0xdb, 0x72, 0xbc, 0x18, 0xc4, 0x9f,
+		0x12, 0x23, 0xe5, 0xf3, 0xad, 0x50, 0xb6, 0xa5, 0x06, 0xb0, 0xd3, 0x1f, 0xe3, 0xaa, 0xe4, 0xa7,
+		0x38, 0x50, 0x31, 0xe0, 0xe2, 0x86, 0x43, 0xb7, 0xba, 0xb6, 0xe6, 0x8c, 0x63, 0xff, 0xfe, 0x99,
+		0x30, 0x1c, 0x9c, 0x85, 0x1b, 0x0e, 0xe2, 0xd1, 0x91, 0xd5, 0x7e, 0x0c, 0x84, 0x9f, 0x16, 0x86,
+		0x43, 0xf0, 0x70, 0x08, 0xe1, 0x30, 0x8c, 0x01, 0xf1, 0x33, 0x02, 0x42, 0xf0, 0x10, 0x88, 0x0f,
+		0x06, 0x0b, 0xad, 0x83, 0x77, 0x0d, 0xd7, 0x73, 0x98, 0x4b, 0x7e, 0x34, 0xd4, 0xcf, 0x7e, 0x3e,
+		0xea, 0x84, 0x29, 0x21, 0x56, 0x62, 0x89, 0xf8, 0xd6, 0x07, 0x0d, 0xd9, 0xe2, 0x1b, 0xf6, 0x73,
+		0xc2, 0x12, 0x85, 0xd8, 0x48, 0xdb, 0x42, 0x1e, 0x22, 0x11, 0xbb, 0x4e, 0x02, 0x95, 0x31, 0xe0,
+		0xfe, 0x79, 0x5f, 0xe3, 0x9a, 0x82, 0x97, 0x60, 0x86, 0xfc, 0x9f, 0x9e, 0x79, 0x0b, 0x1f, 0x8e,
+		0xa5, 0x9d, 0x3f, 0xdf, 0xe7, 0xff, 0x6c, 0x31, 0x4e, 0x66, 0x43, 0xca, 0x7d, 0xfe, 0x14, 0x8a,
+		0x3b, 0xeb, 0x37, 0xf7, 0x35, 0x5f, 0xe0, 0xfd, 0x8d, 0xba, 0x53, 0x4b, 0xab, 0x44, 0xc9, 0xa3,
+		0x4e, 0x4f, 0x3c, 0xd8, 0x47, 0xbe, 0xe0, 0xeb, 0x79, 0xc4, 0xe7, 0x59, 0xba, 0x02, 0xc5, 0x88,
+		0xc3, 0x13, 0x0f, 0xf5, 0xff, 0x72, 0xa8, 0xa9, 0xb0, 0xbf, 0xb3, 0x74, 0x11, 0xd2, 0xc4, 0x79,
+		0x89, 0x67, 0xff, 0x3a, 0xce, 0x4e, 0xc9, 0x97, 0xde, 0x07, 0x39, 0xe1, 0xb4, 0xc4, 0xb3, 0x7e,
+		0x3d, 0x67, 0xf5, 0x59, 0x08, 0xbb, 0x70, 0x58, 0xe2, 0xd9, 0xff, 0x3f, 0xc1, 0x2e, 0x58, 0x08,
+		0xfb, 0xf8, 0x22, 0xfc, 0xc5, 0xff, 0x3f, 0xcd, 0x17, 0x1d, 0x21, 0xbb, 0xcb, 0x90, 0xe5, 0x9e,
+		0x4a, 0x3c, 0xf7, 0x37, 0xf0, 0xca, 0x05, 0xc7, 0xd2, 0x0b, 0x30, 0x39, 0xa6, 0xc0, 0xff, 0x1e,
+		0x67, 0x65, 0xf4, 0x4b, 0x75, 0x28, 0x84, 0xbc, 0x93, 0x78, 0xf6, 0x6f, 0xe2, 0xec, 0x61, 0x2e,
+		0xd2, 0x74, 0xee, 0x9d, 0xc4, 0x03, 0xfc, 0x7d, 0xd1, 0x74, 0xce, 0x41, 0xc4, 0x26, 0x1c, 0x93,
+		0x78, 0xee, 0x8f, 0x09, 0xa9, 0x0b, 0x96, 0xa5, 0x97, 0x21, 0xef, 0x2f, 0x36, 0xf1, 0xfc, 0xdf,
+		0xcc, 0xf9, 0x03, 0x1e, 0x22, 0x81, 0xd0, 0x62, 0x17, 0x0f, 0xf1, 0x0f, 0x84, 0x04, 0x42, 0x5c,
+		0x64, 0x1a, 0xf5, 0x3b, 0x30, 0xf1, 0x48, 0xdf, 0x22, 0xa6, 0x51, 0x9f, 0xff, 0x42, 0x46, 0x93,
+		0xda, 0xfc, 0x78, 0x88, 0x7f, 0x28, 0x46, 0x93, 0xd2, 0x93, 0x66, 0xf4, 0x7b, 0x04, 0xf1, 0x18,
+		0xdf, 0x26, 0x9a, 0xd1, 0xe7, 0x10, 0x2c, 0x6d, 0x02, 0x1a, 0xf4, 0x06, 0xe2, 0xf1, 0xde, 0xe6,
+		0x78, 0xd3, 0x03, 0xce, 0xc0, 0xd2, 0x2b, 0x70, 0x62, 0xb8, 0x27, 0x10, 0x8f, 0xfa, 0xed, 0x5f,
+		0xe8, 0x8b, 0xdd, 0xc2, 0x8e, 0xc0, 0x52, 0x2b, 0x58, 0x52, 0xc2, 0x5e, 0x40, 0x3c, 0xec, 0x77,
+		0x7c, 0x21, 0x6a, 0xb8, 0xc3, 0x4e, 0xc0, 0x52, 0x15, 0x20, 0x58, 0x80, 0xe3, 0xb1, 0xbe, 0x8b,
+		0x63, 0x85, 0x98, 0xc8, 0xd4, 0xe0, 0xeb, 0x6f, 0x3c, 0xff, 0x5d, 0x31, 0x35, 0x38, 0x07, 0x99,
+		0x1a, 0x62, 0xe9, 0x8d, 0xe7, 0xfe, 0x6e, 0x31, 0x35, 0x04, 0x0b, 0xd1, 0xec, 0xd0, 0xea, 0x16,
+		0x8f, 0xf0, 0x09, 0xa1, 0xd9, 0x21, 0xae, 0xa5, 0x75, 0x98, 0x1e, 0x58, 0x10, 0xe3, 0xa1, 0xbe,
+		0x87, 0x43, 0x49, 0xfd, 0xeb, 0x61, 0x78, 0xf1, 0xe2, 0x8b, 0x61, 0x3c, 0xda, 0xf7, 0xf6, 0x2d,
+		0x5e, 0x7c, 0x2d, 0x5c, 0xba, 0x0c, 0x39, 0xb3, 0xd7, 0xe9, 0x90, 0xc9, 0x83, 0x8e, 0x3e, 0x9f,
+		0x3b, 0xf7, 0x5f, 0xbe, 0xc8, 0xa5, 0x23, 0x18, 0x96, 0x2e, 0xc2, 0x24, 0xee, 0x6e, 0xe3, 0x76,
+		0x1c, 0xe7, 0x1f, 0x7f, 0x51, 0x18, 0x4c, 0x42, 0xbd, 0xf4, 0x32, 0x00, 0x4b, 0x8d, 0xd0, 0x8d,
+		0xf3, 0x18, 0xde, 0x3f, 0xf9, 0x22, 0x3f, 0x10, 0x17, 0xb0, 0x04, 0x00, 0xec, 0x78, 0xdd, 0xd1,
+		0x00, 0x9f, 0x8f, 0x02, 0xd0, 0x11, 0x79, 0x09, 0xb2, 0x6f, 0xb8, 0x96, 0xe9, 0x69, 0xbb, 0x71,
+		0xdc, 0x7f, 0xca, 0xb9, 0x05, 0x3d, 0x11, 0x58, 0xd7, 0x72, 0xb0, 0xa7, 0xed, 0xba, 0x71, 0xbc,
+		0xff, 0x95, 0xf3, 0xfa, 0x0c, 0x84, 0x59, 0xd7, 0x5c, 0x6f, 0x9c, 0x7e, 0xff, 0x99, 0x60, 0x16,
+		0x0c, 0xa4, 0xd1, 0xe4, 0xf7, 0x2d, 0x7c, 0x18, 0xc7, 0xfb, 0xe7, 0xa2, 0xd1, 0x9c, 0x7e, 0xe9,
+		0x7d, 0x90, 0x27, 0x3f, 0xd9, 0x29, 0xd7, 0x18, 0xe6, 0xbf, 0xe0, 0xcc, 0x01, 0x07, 0xa9, 0xd9,
+		0xf5, 0xda, 0x9e, 0x11, 0x2f, 0xec, 0xbf, 0xe4, 0x23, 0x2d, 0xe8, 0x97, 0xaa, 0x50, 0x70, 0xbd,
+		0x76, 0xbb, 0xc7, 0xfd, 0xd3, 0x18, 0xf6, 0xff, 0xf6, 0x45, 0x3f, 0x65, 0xe1, 0xf3, 0x90, 0xd1,
+		0xbe, 0x7d, 0xcb, 0xb3, 0x2d, 0xba, 0xdf, 0x12, 0x87, 0xf0, 0x05, 0x8e, 0x10, 0x62, 0x59, 0xaa,
+		0xc3, 0x14, 0xe9, 0x8b, 0x83, 0x6d, 0x4c, 0x37, 0xc7, 0x62, 0x20, 0xfe, 0x8a, 0x0b, 0x20, 0xc2,
+		0x54, 0xfb, 0xaa, 0x5f, 0xfd, 0xcc, 0x99, 0xc4, 0xa7, 0x3f, 0x73, 0x26, 0xf1, 0x7b, 0x9f, 0x39,
+		0x93, 0xf8, 0xd8, 0x67, 0xcf, 0x4c, 0x7c, 0xfa, 0xb3, 0x67, 0x26, 0x7e, 0xfb, 0xb3, 0x67, 0x26,
+		0x86, 0x67, 0x89, 0xe1, 0xaa, 0x75, 0xd5, 0x62, 0xf9, 0xe1, 0xd7, 0x1f, 0xdd, 0x35, 0xbc, 0xbd,
+		0xde, 0xf6, 0xa2, 0x6e, 0x75, 0xcf, 0xeb, 0x96, 0xdb, 0xb5, 0xdc, 0xf3, 0xd1, 0xbc, 0x2e, 0xfd,
+		0x05, 0x7f, 0x9d, 0x20, 0x31, 0x73, 0x34, 0x9d, 0xab, 0x99, 0x87, 0xa3, 0x3e, 0xa6, 0xbb, 0x04,
+		0xa9, 0xaa, 0x79, 0x88, 0x4e, 0x31, 0x03, 0xa7, 0xf6, 0x9c, 0x0e, 0x3f, 0x6a, 0x99, 0x25, 0xcf,
+		0x5b, 0x4e, 0x07, 0xcd, 0x06, 0xe7, 0xa1, 0x13, 0x67, 0xa7, 0xf8, 0x21, 0xe7, 0xda, 0x37, 0x25,
+		0x8e, 0xd7, 0x93, 0x5c, 0xd5, 0x3c, 0xa4, 0x1d, 0xd9, 0x4c, 0xbc, 0xfe, 0x54, 0x6c, 0x9e, 0xfb,
+		0x96, 0x69, 0xdd, 0x36, 0x49, 0xb3, 0xed, 0x6d, 0x91, 0xe3, 0x3e, 0xd3, 0x9f, 0xe3, 0x7e, 0x05,
+		0x77, 0x3a, 0x37, 0x08, 0x5d, 0x8b, 0xb0, 0x6c, 0x67, 0xd8, 0xa9, 0x7e, 0xf8, 0x96, 0x24, 0x9c,
+		0x19, 0x48, 0x67, 0x73, 0x25, 0x18, 0x25, 0x84, 0x25, 0xc8, 0x2d, 0x0b, 0xdd, 0x9a, 0x83, 0xac,
+		0x8b, 0x75, 0xcb, 0x6c, 0xbb, 0x54, 0x10, 0x29, 0x45, 0x3c, 0x12, 0x41, 0x98, 0x9a, 0x69, 0xb9,
+		0xfc, 0xb0, 0x32, 0x7b, 0xa8, 0x7d, 0xe7, 0x31, 0x05, 0x51, 0x14, 0x35, 0x09, 0x69, 0x3c, 0x3b,
+		0xa6, 0x34, 0x44, 0x27, 0x22, 0x99, 0xff, 0x71, 0xa5, 0xf2, 0x6d, 0x49, 0x98, 0xef, 0x97, 0x0a,
+		0x99, 0x59, 0xae, 0xa7, 0x75, 0xed, 0x51, 0x62, 0xb9, 0x0c, 0xf9, 0x96, 0xa0, 0x39, 0xb6, 0x5c,
+		0xee, 0x1e, 0x53, 0x2e, 0x25, 0xbf, 0x2a, 0x21, 0x98, 0x0b, 0x63, 0x0a, 0xc6, 0xef, 0xc7, 0x3d,
+		0x49, 0xe6, 0x7f, 0x66, 0xe0, 0x14, 0x9b, 0x4e, 0x2a, 0x9b, 0x4a, 0xec, 0x81, 0xcb, 0x64, 0x2a,
+		0xfc, 0x2a, 0x7e, 0x9f, 0x44, 0xbe, 0x01, 0x33, 0x2b, 0xc4, 0x5a, 0x90, 0x28, 0x28, 0xd8, 0xe1,
+		0x19, 0x7a, 0x9e, 0x7b, 0x21, 0xe2, 0xf0, 0xf3, 0xfd, 0xad, 0x70, 0x91, 0xfc, 0x35, 0x09, 0x90,
+		0x9a, 0xba, 0xd6, 0xd1, 0x9c, 0x2f, 0x15, 0x0a, 0xbd, 0x00, 0xc0, 0x8e, 0x7b, 0xf8, 0x1f, 0xee,
+		0x95, 0x2e, 0xcc, 0x2d, 0x86, 0x3b, 0xb7, 0xc8, 0x6a, 0xa2, 0x27, 0xa8, 0xf2, 0x94, 0x96, 0xfc,
+		0x3c, 0xf7, 0x2a, 0x40, 0xf0, 0x02, 0x9d, 0x86, 0x93, 0xcd, 0x7a, 0x75, 0xb5, 0xaa, 0x88, 0x43,
+		0x42, 0xcd, 0xcd, 0x46, 0x7d, 0xe5, 0xca, 0x4a, 0x63, 0x59, 0x9a, 0x40, 0x27, 0x00, 0x85, 0x5f,
+		0xfa, 0x87, 0x9a, 0x1e, 0x80, 0xe9, 0x70, 0x39, 0xfb, 0x4a, 0x25, 0x49, 0x3c, 0x45, 0xa3, 0x6b,
+		0x77, 0x30, 0xdd, 0x79, 0x54, 0x0d, 0x21, 0xb5, 0x78, 0x27, 0xe4, 0xd7, 0xfe, 0x03, 0xfb, 0x72,
+		0x61, 0x26, 0x60, 0xf7, 0x65, 0xbe, 0xb4, 0x0a, 0xd3, 0x9a, 0xae, 0x63, 0x3b, 0x02, 0x19, 0x63,
+		0xaa, 0x09, 0x20, 0xdd, 0x4b, 0xe5, 0x9c, 0x01, 0xda, 0x0b, 0x90, 0x71, 0x69, 0xef, 0xe3, 0x20,
+		0x7e, 0x9d, 0x43, 0x70, 0xf2, 0x25, 0x13, 0xa6, 0x89, 0xe7, 0xa7, 0x39, 0x38, 0xd4, 0x8c, 0xa3,
+		0xf3, 0x0c, 0xff, 0xf2, 0xa7, 0x9e, 0xa1, 0x3b, 0xab, 0x0f, 0x47, 0x87, 0x65, 0x88, 0x3a, 0x29,
+		0x12, 0xc7, 0x0e, 0x1a, 0x8a, 0xa1, 0x24, 0xea, 0xe3, 0x0d, 0x3e, 0xba, 0xb2, 0x5f, 0xe0, 0x95,
+		0x9d, 0x19, 0xa6, 0x03, 0xa1, 0x9a, 0x8a, 0x1c, 0x95, 0xbd, 0xa8, 0x35, 0x46, 0xcd, 0xe9, 0xd7,
+		0x9f, 0x1c, 0x5c, 0x9d, 0xd8, 0x7f, 0x4f, 0x53, 0xe4, 0xcb, 0xe1, 0x6a, 0xfc, 0xb9, 0xf7, 0x3b,
+		0x29, 0x98, 0xd6, 0xba, 0x86, 0x69, 0x9d, 0xa7, 0xff, 0xf2, 0x39, 0x37, 0x49, 0x1f, 0xc6, 0xd8,
+		0x94, 0xbc, 0xc4, 0xa6, 0x42, 0xbc, 0xc6, 0xfc, 0xc5, 0x37, 0xfe, 0xc0, 0x64, 0x30, 0x5d, 0x96,
+		0xd6, 0x40, 0x12, 0x87, 0x78, 0xb1, 0xa9, 0x5b, 0xed, 0xb1, 0xb2, 0x14, 0x7f, 0x29, 0x30, 0x44,
+		0x7e, 0xab, 0xc1, 0x59, 0x97, 0xde, 0x0b, 0x39, 0x1f, 0x26, 0xce, 0x33, 0x11, 0x20, 0x3e, 0x07,
+		0xf1, 0x4b, 0xd8, 0xcc, 0x1c, 0xc7, 0x0b, 0xfd, 0x82, 0xe0, 0x67, 0x33, 0x74, 0x9d, 0xf4, 0xe6,
+		0x2a, 0x94, 0xda, 0x96, 0xe9, 0xa9, 0x56, 0xd7, 0xf0, 0x70, 0xd7, 0xf6, 0x62, 0xfd, 0xba, 0xbf,
+		0x62, 0x20, 0x39, 0xa5, 0x48, 0xf8, 0x36, 0x04, 0xdb, 0x3d, 0x0d, 0xae, 0xdb, 0xbe, 0xc5, 0x6d,
+		0xb1, 0x77, 0xc0, 0x06, 0xd1, 0x1f, 0xdc, 0x3f, 0x4b, 0xc1, 0x19, 0x4e, 0xbc, 0xad, 0xb9, 0xf8,
+		0xfc, 0xfe, 0xb3, 0xdb, 0xd8, 0xd3, 0x9e, 0x3d, 0xaf, 0x5b, 0x86, 0x58, 0x88, 0x67, 0xb8, 0xad,
+		0x25, 0xef, 0x17, 0xf9, 0xfb, 0xca, 0xd0, 0xdd, 0xea, 0xca, 0x68, 0x1b, 0x5d, 0x19, 0x54, 0x21,
+		0xf9, 0x75, 0x48, 0xd7, 0x2d, 0xc3, 0x24, 0x4b, 0x53, 0x1b, 0x9b, 0x56, 0x97, 0x5b, 0x4b, 0xf6,
+		0x80, 0x2e, 0x41, 0x46, 0xeb, 0x5a, 0x3d, 0xd3, 0x63, 0x96, 0xb2, 0x76, 0xe6, 0x57, 0xdf, 0x99,
+		0x9f, 0xf8, 0x9d, 0x77, 0xe6, 0x53, 0x2b, 0xa6, 0xf7, 0x5b, 0x9f, 0x7a, 0x1a, 0x38, 0xfa, 0x8a,
+		0xe9, 0x7d, 0xf2, 0x8f, 0x7e, 0xfc, 0x5c, 0x42, 0xe1, 0xd4, 0x4b, 0xe9, 0xcf, 0x7d, 0x7c, 0x3e,
+		0x21, 0xbf, 0x0a, 0xd9, 0x65, 0xac, 0x1f, 0x01, 0xff, 0x6c, 0x1f, 0xfc, 0x29, 0x01, 0xbf, 0x8c,
+		0xf5, 0x10, 0xfc, 0x32, 0xd6, 0xfb, 0x90, 0x5f, 0x80, 0xdc, 0x8a, 0xe9, 0xb1, 0x2f, 0x80, 0x9e,
+		0x84, 0x94, 0x61, 0xb2, 0x43, 0xe5, 0x21, 0x84, 0x81, 0x06, 0x2a, 0x84, 0x8a, 0x30, 0x2e, 0x63,
+		0xdd, 0x67, 0x6c, 0x63, 0xbd, 0x9f, 0x71, 0xb0, 0x6a, 0x42, 0x55, 0x5b, 0xfe, 0xed, 0xdf, 0x3f,
+		0x33, 0xf1, 0xd6, 0x67, 0xce, 0x4c, 0x8c, 0x1c, 0x7a, 0x39, 0x7e, 0xe8, 0xfd, 0x11, 0xff, 0x81,
+		0x34, 0x3c, 0x44, 0x3f, 0x0c, 0x75, 0xba, 0x86, 0xe9, 0x9d, 0xd7, 0x9d, 0x43, 0xdb, 0xb3, 0xc8,
+		0xfc, 0xb5, 0x76, 0xf8, 0x80, 0x4f, 0x07, 0xaf, 0x17, 0xd9, 0xeb, 0xe1, 0xc3, 0x2d, 0xef, 0xc0,
+		0xe4, 0x26, 0xe1, 0x23, 0x22, 0xf6, 0x2c, 0x4f, 0xeb, 0x70, 0xa7, 0x83, 0x3d, 0x90, 0x52, 0xf6,
+		0x31, 0x69, 0x92, 0x95, 0x1a, 0xe2, 0x3b, 0xd2, 0x0e, 0xd6, 0x76, 0xd8, 0x37, 0x39, 0x29, 0xea,
+		0xad, 0xe6, 0x48, 0x01, 0xfd, 0xfc, 0x66, 0x16, 0x26, 0xb5, 0x1e, 0x3b, 0x37, 0x93, 0x22, 0x6e,
+		0x2c, 0x7d, 0x90, 0x6f, 0x40, 0x96, 0x6f, 0x9f, 0x23, 0x09, 0x52, 0xb7, 0xf0, 0x21, 0xad, 0x67,
+		0x4a, 0x21, 0x3f, 0xd1, 0x22, 0x4c, 0xd2, 0xc6, 0xf3, 0x8f, 0x0d, 0xe7, 0x16, 0x07, 0x5a, 0xbf,
+		0x48, 0x1b, 0xa9, 0x30, 0x32, 0xf9, 0x3a, 0xe4, 0x96, 0x2d, 0xa2, 0x85, 0x51, 0xb4, 0x3c, 0x43,
+		0xa3, 0x6d, 0xb6, 0x7b, 0x5c, 0x2b, 0x14, 0xf6, 0x80, 0x4e, 0x40, 0x86, 0x7d, 0xa3, 0xc5, 0xcf,
+		0xfe, 0xf0, 0x27, 0xb9, 0x0e, 0x59, 0x8a, 0xbd, 0x61, 0x93, 0x15, 0xdf, 0x3f, 0xb6, 0x9e, 0xe7,
+		0x5f, 0xec, 0x72, 0xf8, 0x64, 0xd0, 0x58, 0x04, 0xe9, 0xb6, 0xe6, 0x69, 0xbc, 0xdf, 0xf4, 0xb7,
+		0xfc, 0x7e, 0xc8, 0x71, 0x10, 0x17, 0x5d, 0x80, 0x94, 0x65, 0xbb, 0xfc, 0xf4, 0x4e, 0x65, 0x54,
+		0x57, 0x36, 0xec, 0x5a, 0x9a, 0xe8, 0x8c, 0x42, 0x88, 0x6b, 0xeb, 0x23, 0xd5, 0xe2, 0xf9, 0x88,
+		0x5a, 0x74, 0xb1, 0xb7, 0xbd, 0xe3, 0x05, 0x3f, 0xd8, 0x70, 0x0e, 0xa8, 0x82, 0xaf, 0x28, 0x77,
+		0x93, 0x70, 0x26, 0xf4, 0x76, 0x1f, 0x3b, 0xae, 0x61, 0x99, 0x4c, 0x9b, 0xb8, 0xa6, 0xa0, 0x50,
+		0x03, 0xf9, 0xfb, 0x11, 0xaa, 0xf2, 0x3e, 0x48, 0x55, 0x6d, 0x1b, 0x55, 0x20, 0x47, 0x9f, 0x75,
+		0x8b, 0xe9, 0x4a, 0x5a, 0xf1, 0x9f, 0xc9, 0x3b, 0xd7, 0xda, 0xf1, 0x6e, 0x6b, 0x8e, 0xff, 0x09,
+		0xb3, 0x78, 0x96, 0x5f, 0x82, 0x7c, 0xdd, 0x32, 0x5d, 0x6c, 0xba, 0x3d, 0xea, 0xca, 0x6e, 0x77,
+		0x2c, 0xfd, 0x16, 0x47, 0x60, 0x0f, 0x44, 0xd8, 0x9a, 0x6d, 0x53, 0xce, 0xb4, 0x42, 0x7e, 0xb2,
+		0xf9, 0x5a, 0xdb, 0x18, 0x29, 0x9e, 0x8b, 0xc7, 0x13, 0x0f, 0xef, 0x60, 0xe0, 0x93, 0x26, 0xe0,
+		0xc1, 0xc1, 0x89, 0x74, 0x0b, 0x1f, 0xba, 0xc7, 0x9d, 0x47, 0xaf, 0x42, 0x7e, 0x93, 0xde, 0x2e,
+		0x72, 0x03, 0x1f, 0xa2, 0x0a, 0x64, 0x71, 0xfb, 0xc2, 0xc5, 0x8b, 0xcf, 0xbe, 0xc4, 0xb4, 0xfc,
+		0xda, 0x84, 0x22, 0x0a, 0xd0, 0x19, 0xc8, 0xbb, 0x58, 0xb7, 0x2f, 0x5c, 0xbc, 0x74, 0xeb, 0x59,
+		0xa6, 0x56, 0xd7, 0x26, 0x94, 0xa0, 0x68, 0x29, 0x47, 0x7a, 0xfc, 0xb9, 0x4f, 0xcc, 0x27, 0x6a,
+		0x93, 0x90, 0x72, 0x7b, 0xdd, 0x77, 0x4d, 0x37, 0xbe, 0x75, 0x12, 0x16, 0x42, 0x6f, 0xd9, 0xe2,
+		0xb2, 0xaf, 0x75, 0x8c, 0xb6, 0x16, 0xdc, 0x09, 0x23, 0x85, 0xfa, 0x4f, 0x29, 0x46, 0xac, 0x1a,
+		0x47, 0x4a, 0x51, 0xfe, 0xc9, 0x04, 0x4c, 0xdd, 0x14, 0xc8, 0x4d, 0xec, 0xa1, 0xcb, 0x00, 0x7e,
+		0x4d, 0x62, 0xaa, 0x9c, 0x5e, 0xec, 0xaf, 0x6b, 0xd1, 0xe7, 0x51, 0x42, 0xe4, 0xe8, 0x05, 0xaa,
+		0x80, 0xb6, 0xe5, 0xf2, 0xcf, 0x59, 0x63, 0x58, 0x7d, 0x62, 0xf4, 0x14, 0x20, 0x6a, 0xd5, 0xd4,
+		0x7d, 0xcb, 0x33, 0xcc, 0x5d, 0xd5, 0xb6, 0x6e, 0xf3, 0x4b, 0x02, 0x52, 0x8a, 0x44, 0xdf, 0xdc,
+		0xa4, 0x2f, 0x36, 0x49, 0x39, 0x69, 0x74, 0xde, 0x47, 0x21, 0x51, 0x99, 0xd6, 0x6e, 0x3b, 0xd8,
+		0x75, 0xb9, 0xe1, 0x12, 0x8f, 0xe8, 0x32, 0x64, 0xed, 0xde, 0xb6, 0x2a, 0xac, 0x44, 0xe1, 0xc2,
+		0x83, 0xc3, 0xe6, 0xbc, 0xd0, 0x0d, 0x3e, 0xeb, 0x33, 0x76, 0x6f, 0x9b, 0x68, 0xca, 0xc3, 0x30,
+		0x35, 0xa4, 0x31, 0x85, 0xfd, 0xa0, 0x1d, 0xf4, 0x42, 0x1b, 0xde, 0x03, 0xd5, 0x76, 0x0c, 0xcb,
+		0x31, 0xbc, 0x43, 0x7a, 0xea, 0x2e, 0xa5, 0x48, 0xe2, 0xc5, 0x26, 0x2f, 0x97, 0x6f, 0x41, 0xb9,
+		0x49, 0xbd, 0xf5, 0xa0, 0xe5, 0x17, 0x83, 0xf6, 0x25, 0xe2, 0xdb, 0x37, 0xb2, 0x65, 0xc9, 0x81,
+		0x96, 0xd5, 0xd6, 0x46, 0x6a, 0xe6, 0x73, 0xc7, 0xd3, 0xcc, 0xe8, 0xea, 0xf6, 0x23, 0x95, 0xc8,
+		0xa4, 0xe4, 0x5e, 0x4f, 0xc8, 0x64, 0x8d, 0xab, 0x94, 0x71, 0x81, 0x78, 0xe5, 0xe8, 0x45, 0xb4,
+		0x12, 0x63, 0x3a, 0x2b, 0xb1, 0xd3, 0x47, 0x7e, 0x09, 0x8a, 0x9b, 0x9a, 0xe3, 0x35, 0xb1, 0x77,
+		0x0d, 0x6b, 0x6d, 0xec, 0x44, 0x57, 0xd9, 0xa2, 0x58, 0x65, 0x11, 0xa4, 0xe9, 0x52, 0xca, 0x56,
+		0x19, 0xfa, 0x5b, 0xde, 0x83, 0x34, 0x3d, 0x76, 0xeb, 0xaf, 0xc0, 0x9c, 0x83, 0xad, 0xc0, 0xc4,
+		0x7e, 0x1e, 0x7a, 0xd8, 0x15, 0xb9, 0x22, 0xfa, 0x80, 0x9e, 0x17, 0xeb, 0x68, 0xea, 0xe8, 0x75,
+		0x94, 0x2b, 0x21, 0x5f, 0x4d, 0x3b, 0x90, 0xad, 0x11, 0xf3, 0xbb, 0xb2, 0xec, 0x37, 0x24, 0x11,
+		0x34, 0x04, 0xad, 0x41, 0xd9, 0xd6, 0x1c, 0x8f, 0x7e, 0x86, 0xb7, 0x47, 0x7b, 0xc1, 0xf5, 0x7c,
+		0x7e, 0x70, 0xd6, 0x45, 0x3a, 0xcb, 0x6b, 0x29, 0xda, 0xe1, 0x42, 0xf9, 0x0f, 0xd3, 0x90, 0xe1,
+		0xc2, 0x78, 0x1f, 0x64, 0xb9, 0x58, 0xb9, 0x66, 0x3e, 0xb4, 0x38, 0xb8, 0x18, 0x2d, 0xfa, 0x8b,
+		0x06, 0xc7, 0x13, 0x3c, 0xe8, 0x31, 0xc8, 0xe9, 0x7b, 0x9a, 0x61, 0xaa, 0x46, 0x9b, 0x3b, 0x80,
+		0x85, 0xcf, 0xbc, 0x33, 0x9f, 0xad, 0x93, 0xb2, 0x95, 0x65, 0x25, 0x4b, 0x5f, 0xae, 0xb4, 0xc9,
+		0xca, 0xbf, 0x87, 0x8d, 0xdd, 0x3d, 0x8f, 0xcf, 0x2e, 0xfe, 0x84, 0x5e, 0x84, 0x34, 0x51, 0x08,
+		0xfe, 0x91, 0x76, 0x65, 0xc0, 0x97, 0xf7, 0xf3, 0x24, 0xb5, 0x1c, 0xa9, 0xf8, 0x63, 0xff, 0x79,
+		0x3e, 0xa1, 0x50, 0x0e, 0x54, 0x87, 0x62, 0x47, 0x73, 0x3d, 0x95, 0xae, 0x5a, 0xa4, 0xfa, 0x49,
+		0x0a, 0x71, 0x6a, 0x50, 0x20, 0x5c, 0xb0, 0xbc, 0xe9, 0x05, 0xc2, 0xc5, 0x8a, 0xda, 0xe8, 0x2c,
+		0x48, 0x14, 0x44, 0xb7, 0xba, 0x5d, 0xc3, 0x63, 0xbe, 0x54, 0x86, 0xca, 0xbd, 0x44, 0xca, 0xeb,
+		0xb4, 0x98, 0x7a, 0x54, 0xa7, 0x21, 0x4f, 0x3f, 0x0b, 0xa5, 0x24, 0xec, 0xac, 0x77, 0x8e, 0x14,
+		0xd0, 0x97, 0x8f, 0x43, 0x39, 0xb0, 0x8d, 0x8c, 0x24, 0xc7, 0x50, 0x82, 0x62, 0x4a, 0xf8, 0x0c,
+		0xcc, 0x9a, 0xf8, 0x80, 0x9e, 0x3e, 0x8f, 0x50, 0xe7, 0x29, 0x35, 0x22, 0xef, 0x6e, 0x46, 0x39,
+		0x1e, 0x85, 0x92, 0x2e, 0x84, 0xcf, 0x68, 0x81, 0xd2, 0x16, 0xfd, 0x52, 0x4a, 0x76, 0x0a, 0x72,
+		0x9a, 0x6d, 0x33, 0x82, 0x02, 0xb7, 0x8d, 0xb6, 0x4d, 0x5f, 0x9d, 0x83, 0x69, 0xda, 0x47, 0x07,
+		0xbb, 0xbd, 0x8e, 0xc7, 0x41, 0xa6, 0x28, 0x4d, 0x99, 0xbc, 0x50, 0x58, 0x39, 0xa5, 0x7d, 0x04,
+		0x8a, 0x78, 0xdf, 0x68, 0x63, 0x53, 0xc7, 0x8c, 0xae, 0x48, 0xe9, 0xa6, 0x44, 0x21, 0x25, 0x7a,
+		0x02, 0x7c, 0x9b, 0xa7, 0x0a, 0x7b, 0x5c, 0x62, 0x78, 0xa2, 0xbc, 0xca, 0x8a, 0xe5, 0x39, 0x48,
+		0x2f, 0x6b, 0x9e, 0x46, 0x9c, 0x0a, 0xef, 0x80, 0x2d, 0x32, 0x53, 0x0a, 0xf9, 0x29, 0x7f, 0x2e,
+		0x09, 0xe9, 0x9b, 0x96, 0x87, 0xd1, 0x73, 0x21, 0x87, 0xaf, 0x34, 0x4c, 0x9f, 0x9b, 0xc6, 0xae,
+		0x89, 0xdb, 0x6b, 0xee, 0x6e, 0xe8, 0x0e, 0x97, 0x40, 0x9d, 0x92, 0x11, 0x75, 0x9a, 0x85, 0x49,
+		0xc7, 0xea, 0x99, 0x6d, 0x71, 0x4a, 0x9a, 0x3e, 0xa0, 0x06, 0xe4, 0x7c, 0x2d, 0x49, 0xc7, 0x69,
+		0x49, 0x99, 0x68, 0x09, 0xd1, 0x61, 0x5e, 0xa0, 0x64, 0xb7, 0xb9, 0xb2, 0xd4, 0x20, 0xef, 0x1b,
+		0x2f, 0xae, 0x6d, 0xe3, 0x29, 0x6c, 0xc0, 0x46, 0x16, 0x12, 0x7f, 0xec, 0x7d, 0xe1, 0x31, 0x8d,
+		0x93, 0xfc, 0x17, 0x5c, 0x7a, 0x11, 0xb5, 0xe2, 0xf7, 0xc9, 0x64, 0x69, 0xbf, 0x02, 0xb5, 0x62,
+		0x77, 0xca, 0x3c, 0x08, 0x79, 0xd7, 0xd8, 0x35, 0x35, 0xaf, 0xe7, 0x60, 0xae, 0x79, 0x41, 0x81,
+		0xfc, 0x8b, 0x09, 0xc8, 0x30, 0x4d, 0x0e, 0xc9, 0x2d, 0x31, 0x5c, 0x6e, 0xc9, 0x51, 0x72, 0x4b,
+		0xdd, 0xbb, 0xdc, 0xaa, 0x00, 0x7e, 0x63, 0x5c, 0x7e, 0xcd, 0xc7, 0x10, 0x6f, 0x81, 0x35, 0xb1,
+		0x69, 0xec, 0xf2, 0x89, 0x1a, 0x62, 0x92, 0xff, 0x53, 0x82, 0x38, 0xae, 0xfc, 0x3d, 0xaa, 0x42,
+		0x51, 0xb4, 0x4b, 0xdd, 0xe9, 0x68, 0xbb, 0x5c, 0x77, 0x1e, 0x1a, 0xd9, 0xb8, 0x2b, 0x1d, 0x6d,
+		0x57, 0x29, 0xf0, 0xf6, 0x90, 0x87, 0xe1, 0xe3, 0x90, 0x1c, 0x31, 0x0e, 0x91, 0x81, 0x4f, 0xdd,
+		0xdb, 0xc0, 0x47, 0x86, 0x28, 0xdd, 0x3f, 0x44, 0x3f, 0x95, 0xa4, 0xc1, 0x8b, 0x6d, 0xb9, 0x5a,
+		0xe7, 0xcb, 0x31, 0x23, 0x4e, 0x43, 0xde, 0xb6, 0x3a, 0x2a, 0x7b, 0xc3, 0xbe, 0x1e, 0xc8, 0xd9,
+		0x56, 0x47, 0x19, 0x18, 0xf6, 0xc9, 0xfb, 0x34, 0x5d, 0x32, 0xf7, 0x41, 0x6a, 0xd9, 0x7e, 0xa9,
+		0x39, 0x30, 0xc5, 0x44, 0xc1, 0xd7, 0xb2, 0x67, 0x88, 0x0c, 0xe8, 0xe2, 0x98, 0x18, 0x5c, 0x7b,
+		0x59, 0xb3, 0x19, 0xa5, 0xc2, 0xe9, 0x08, 0x07, 0x33, 0xfd, 0xc3, 0xa2, 0xde, 0xb0, 0x5a, 0x2a,
+		0x9c, 0x4e, 0xfe, 0xd6, 0x04, 0xc0, 0x2a, 0x91, 0x2c, 0xed, 0x2f, 0x59, 0x85, 0x5c, 0xda, 0x04,
+		0x35, 0x52, 0xf3, 0x99, 0x51, 0x83, 0xc6, 0xeb, 0x9f, 0x72, 0xc3, 0xed, 0xae, 0x43, 0x31, 0x50,
+		0x46, 0x17, 0x8b, 0xc6, 0x9c, 0x39, 0xc2, 0xa3, 0x6e, 0x62, 0x4f, 0x99, 0xda, 0x0f, 0x3d, 0xc9,
+		0xff, 0x3a, 0x01, 0x79, 0xda, 0xa6, 0x35, 0xec, 0x69, 0x91, 0x31, 0x4c, 0xdc, 0xfb, 0x18, 0x3e,
+		0x04, 0xc0, 0x60, 0x5c, 0xe3, 0x4d, 0xcc, 0x35, 0x2b, 0x4f, 0x4b, 0x9a, 0xc6, 0x9b, 0x18, 0x5d,
+		0xf2, 0x05, 0x9e, 0x3a, 0x5a, 0xe0, 0xc2, 0xe3, 0xe6, 0x62, 0x3f, 0x09, 0x59, 0x7a, 0x2d, 0xde,
+		0x81, 0xcb, 0x9d, 0xe8, 0x8c, 0xd9, 0xeb, 0xb6, 0x0e, 0x5c, 0xf9, 0x0d, 0xc8, 0xb6, 0x0e, 0x58,
+		0x2e, 0xe4, 0x34, 0xe4, 0x1d, 0xcb, 0xe2, 0x6b, 0x32, 0xf3, 0x85, 0x72, 0xa4, 0x80, 0x2e, 0x41,
+		0x22, 0xfe, 0x4f, 0x06, 0xf1, 0x7f, 0x90, 0xc0, 0x48, 0x8d, 0x97, 0xc0, 0x78, 0x3f, 0x64, 0x14,
+		0xbc, 0x8f, 0xb5, 0xce, 0x48, 0xab, 0x18, 0xd1, 0xbe, 0x64, 0x9f, 0xf6, 0x9d, 0xfb, 0x8f, 0x09,
+		0x28, 0x84, 0xec, 0x0b, 0x7a, 0x16, 0x1e, 0xa8, 0xad, 0x6e, 0xd4, 0x6f, 0xa8, 0x2b, 0xcb, 0xea,
+		0x95, 0xd5, 0xea, 0xd5, 0xe0, 0x03, 0xbb, 0xca, 0x89, 0x3b, 0x77, 0x17, 0x50, 0x88, 0x76, 0xcb,
+		0xa4, 0x9b, 0x39, 0xe8, 0x3c, 0xcc, 0x46, 0x59, 0xaa, 0xb5, 0x66, 0x63, 0xbd, 0x25, 0x25, 0x2a,
+		0x0f, 0xdc, 0xb9, 0xbb, 0x30, 0x1d, 0xe2, 0xa8, 0x6e, 0xbb, 0xd8, 0xf4, 0x06, 0x19, 0xea, 0x1b,
+		0x6b, 0x6b, 0x2b, 0x2d, 0x29, 0x39, 0xc0, 0xc0, 0x0d, 0xfe, 0x13, 0x30, 0x1d, 0x65, 0x58, 0x5f,
+		0x59, 0x95, 0x52, 0x15, 0x74, 0xe7, 0xee, 0x42, 0x29, 0x44, 0xbd, 0x6e, 0x74, 0x2a, 0xb9, 0x8f,
+		0x7e, 0xef, 0x99, 0x89, 0x4f, 0x7e, 0xdf, 0x99, 0x04, 0xe9, 0x59, 0x31, 0x62, 0x63, 0xd0, 0x53,
+		0x70, 0xb2, 0xb9, 0x72, 0x75, 0xbd, 0xb1, 0xac, 0xae, 0x35, 0xaf, 0xf6, 0x7d, 0x33, 0x5d, 0x29,
+		0xdf, 0xb9, 0xbb, 0x50, 0xe0, 0x5d, 0x1a, 0x45, 0xbd, 0xa9, 0x34, 0x6e, 0x6e, 0xb4, 0x1a, 0x52,
+		0x82, 0x51, 0x6f, 0x3a, 0x78, 0xdf, 0xf2, 0xd8, 0x8d, 0x9c, 0xcf, 0xc0, 0xa9, 0x21, 0xd4, 0x7e,
+		0xc7, 0xa6, 0xef, 0xdc, 0x5d, 0x28, 0x6e, 0x3a, 0x98, 0xcd, 0x3f, 0xca, 0xb1, 0x08, 0x73, 0x83,
+		0x1c, 0x1b, 0x9b, 0x1b, 0xcd, 0xea, 0xaa, 0xb4, 0x50, 0x91, 0xee, 0xdc, 0x5d, 0x98, 0x12, 0xc6,
+		0x94, 0xd0, 0x07, 0x3d, 0x7b, 0xb7, 0xa2, 0xa5, 0xaf, 0xcf, 0x46, 0x72, 0x81, 0x2c, 0x0e, 0xb1,
+		0x35, 0x47, 0xeb, 0x1e, 0x37, 0x5c, 0x8a, 0xd9, 0xcd, 0x95, 0xdf, 0x4a, 0x42, 0xd9, 0x77, 0xc6,
+		0x37, 0x69, 0x0d, 0xe8, 0xb9, 0x70, 0x1e, 0xa7, 0x30, 0x72, 0x19, 0x64, 0xd4, 0x22, 0xcd, 0xf3,
+		0x5e, 0xc8, 0x09, 0xa7, 0x8e, 0x9b, 0x9b, 0x85, 0x41, 0xbe, 0x06, 0xa7, 0xe0, 0xac, 0x3e, 0x07,
+		0x7a, 0x19, 0xf2, 0xbe, 0xf1, 0xf1, 0x6f, 0xad, 0x1a, 0x6d, 0xad, 0x38, 0x7f, 0xc0, 0x83, 0x5e,
+		0x0a, 0xc2, 0x8e, 0xf4, 0xa8, 0x40, 0xe6, 0x26, 0x23, 0xe0, 0xcc, 0x82, 0x5e, 0x5e, 0xe1, 0xd3,
+		0x8e, 0xf7, 0x9e, 0xde, 0xf8, 0x71, 0xa0, 0xb2, 0x48, 0x8c, 0xcd, 0xdf, 0x5c, 0x57, 0x3b, 0xa8,
+		0xd1, 0x60, 0xec, 0x24, 0x64, 0xc9, 0xcb, 0x5d, 0xfe, 0x4d, 0x79, 0x4a, 0xc9, 0x74, 0xb5, 0x83,
+		0xab, 0x9a, 0x7b, 0x3d, 0x9d, 0x4b, 0x49, 0x69, 0xf9, 0x87, 0x12, 0x50, 0x8a, 0xf6, 0x11, 0x3d,
+		0x09, 0x88, 0x70, 0x68, 0xbb, 0x58, 0x25, 0x26, 0x8a, 0x0a, 0x4b, 0xe0, 0x96, 0xbb, 0xda, 0x41,
+		0x75, 0x17, 0xaf, 0xf7, 0xba, 0xb4, 0x01, 0x2e, 0x5a, 0x03, 0x49, 0x10, 0x8b, 0x71, 0xe2, 0xc2,
+		0x3c, 0x35, 0x78, 0x87, 0x25, 0x27, 0x60, 0x0b, 0xdd, 0xdb, 0x64, 0xa1, 0x2b, 0x31, 0x3c, 0x7f,
+		0x37, 0x3e, 0xd2, 0x95, 0x54, 0xb4, 0x2b, 0xf2, 0xcb, 0x50, 0xee, 0x93, 0x27, 0x92, 0xa1, 0xc8,
+		0xb3, 0x0a, 0x74, 0xa7, 0x93, 0xf9, 0xd7, 0x79, 0xa5, 0xc0, 0xb2, 0x07, 0x74, 0xe7, 0x77, 0x29,
+		0xf7, 0xb3, 0x1f, 0x9f, 0x4f, 0xd0, 0x84, 0xfb, 0x93, 0x50, 0x8c, 0x48, 0x54, 0x64, 0xfa, 0x12,
+		0x41, 0xa6, 0x2f, 0x20, 0x7e, 0x1d, 0xa6, 0x88, 0xa1, 0xc5, 0x6d, 0x4e, 0xfb, 0x18, 0x94, 0xd9,
+		0x42, 0xd0, 0x2f, 0x6b, 0xe6, 0x89, 0xad, 0x09, 0x81, 0xcb, 0xc2, 0x35, 0x8b, 0x8a, 0xbd, 0x20,
+		0xa8, 0xae, 0x6a, 0x6e, 0xed, 0x83, 0x9f, 0xfc, 0xcc, 0x99, 0xc4, 0xbb, 0x33, 0x11, 0x7f, 0xa5,
+		0x05, 0xa7, 0x43, 0x2f, 0xb5, 0x6d, 0xdd, 0x88, 0x64, 0x2d, 0xca, 0x21, 0x25, 0x23, 0x2f, 0xe3,
+		0xb2, 0x0f, 0x47, 0xe6, 0x40, 0x8e, 0x4e, 0xb8, 0x55, 0x8e, 0xb6, 0x08, 0xf1, 0x89, 0x91, 0xe1,
+		0x39, 0xce, 0x5f, 0xcc, 0x43, 0x56, 0xc1, 0x1f, 0xee, 0x61, 0xd7, 0x43, 0x17, 0x20, 0x8d, 0xf5,
+		0x3d, 0x6b, 0x58, 0x4a, 0x89, 0x74, 0x6e, 0x91, 0xd3, 0x35, 0xf4, 0x3d, 0xeb, 0xda, 0x84, 0x42,
+		0x69, 0xd1, 0x45, 0x98, 0xdc, 0xe9, 0xf4, 0x78, 0x9e, 0xa3, 0xcf, 0x58, 0x84, 0x99, 0xae, 0x10,
+		0xa2, 0x6b, 0x13, 0x0a, 0xa3, 0x26, 0x55, 0xd1, 0x7b, 0x82, 0x53, 0x47, 0x57, 0xb5, 0x62, 0xee,
+		0xd0, 0xaa, 0x08, 0x2d, 0xaa, 0x01, 0x18, 0xa6, 0xe1, 0xa9, 0x34, 0x07, 0xc0, 0x3d, 0xc9, 0x87,
+		0x47, 0x73, 0x1a, 0x1e, 0xcd, 0x1a, 0x5c, 0x9b, 0x50, 0xf2, 0x86, 0x78, 0x20, 0xcd, 0xfd, 0x70,
+		0x0f, 0x3b, 0x87, 0xdc, 0x81, 0x1c, 0xd9, 0xdc, 0x0f, 0x12, 0x22, 0xd2, 0x5c, 0x4a, 0x8d, 0x1a,
+		0x50, 0xa0, 0x1f, 0xc8, 0xb2, 0xf9, 0xcb, 0x6f, 0xa6, 0x95, 0x47, 0x31, 0xd7, 0x08, 0x29, 0x9d,
+		0xd2, 0xd7, 0x26, 0x14, 0xd8, 0xf6, 0x9f, 0x88, 0x91, 0x64, 0x37, 0x97, 0x79, 0x07, 0xfc, 0x3e,
+		0xce, 0xf9, 0x51, 0x18, 0xf4, 0xfa, 0xb2, 0xd6, 0xc1, 0xb5, 0x09, 0x25, 0xab, 0xb3, 0x9f, 0xa4,
+		0xff, 0x6d, 0xdc, 0x31, 0xf6, 0xb1, 0x43, 0xf8, 0xf3, 0x47, 0xf7, 0x7f, 0x99, 0x51, 0x52, 0x84,
+		0x7c, 0x5b, 0x3c, 0x10, 0x43, 0x8b, 0xcd, 0x36, 0xef, 0x06, 0x0c, 0xda, 0xe9, 0xc8, 0x38, 0x9b,
+		0x6d, 0xd1, 0x89, 0x1c, 0xe6, 0xbf, 0xd1, 0x8b, 0xbe, 0x87, 0x5b, 0x18, 0x74, 0x2a, 0x23, 0x1d,
+		0x60, 0xb9, 0x8e, 0x09, 0xe1, 0xe9, 0xa2, 0x75, 0x28, 0x75, 0x0c, 0xd7, 0x53, 0x5d, 0x53, 0xb3,
+		0xdd, 0x3d, 0xcb, 0x73, 0x69, 0xd2, 0xa0, 0x70, 0xe1, 0xd1, 0x51, 0x08, 0xab, 0x86, 0xeb, 0x35,
+		0x05, 0xf1, 0xb5, 0x09, 0xa5, 0xd8, 0x09, 0x17, 0x10, 0x3c, 0x6b, 0x67, 0x07, 0x3b, 0x3e, 0x20,
+		0x4d, 0x2e, 0x1c, 0x81, 0xb7, 0x41, 0xa8, 0x05, 0x3f, 0xc1, 0xb3, 0xc2, 0x05, 0xe8, 0x2b, 0x60,
+		0xa6, 0x63, 0x69, 0x6d, 0x1f, 0x4e, 0xd5, 0xf7, 0x7a, 0xe6, 0x2d, 0x9a, 0x89, 0x28, 0x5c, 0x78,
+		0x62, 0x64, 0x23, 0x2d, 0xad, 0x2d, 0x20, 0xea, 0x84, 0xe1, 0xda, 0x84, 0x32, 0xdd, 0xe9, 0x2f,
+		0x44, 0x1f, 0x82, 0x59, 0xcd, 0xb6, 0x3b, 0x87, 0xfd, 0xe8, 0x65, 0x8a, 0x7e, 0x6e, 0x14, 0x7a,
+		0x95, 0xf0, 0xf4, 0xc3, 0x23, 0x6d, 0xa0, 0x14, 0xb5, 0x40, 0xb2, 0x1d, 0x4c, 0xbf, 0xa9, 0xb1,
+		0xb9, 0xab, 0x42, 0x6f, 0x1c, 0x2a, 0x5c, 0x78, 0x7c, 0x14, 0xf6, 0x26, 0xa3, 0x17, 0x9e, 0xcd,
+		0xb5, 0x09, 0xa5, 0x6c, 0x47, 0x8b, 0x18, 0xaa, 0xa5, 0x63, 0x7a, 0x21, 0x1a, 0x47, 0x9d, 0x8e,
+		0x43, 0xa5, 0xf4, 0x51, 0xd4, 0x48, 0x11, 0xd5, 0x41, 0x6f, 0x4f, 0x65, 0xf3, 0x10, 0xc5, 0xe8,
+		0xa0, 0xb7, 0x27, 0xa6, 0x62, 0x0e, 0xf3, 0xdf, 0xb5, 0x2c, 0x3f, 0x54, 0xc7, 0x6f, 0x9c, 0x79,
+		0x1c, 0x0a, 0x21, 0xcb, 0x84, 0xe6, 0x20, 0xcb, 0x0f, 0x19, 0x88, 0xc3, 0x78, 0xfc, 0x51, 0x2e,
+		0xc1, 0x54, 0xd8, 0x1a, 0xc9, 0x1f, 0x4b, 0xf8, 0x9c, 0xf4, 0x53, 0xfd, 0xb9, 0x68, 0xee, 0x32,
+		0x1f, 0xa4, 0x25, 0x1f, 0x11, 0xcb, 0x90, 0x78, 0xcf, 0xb6, 0xb3, 0xa6, 0x68, 0x21, 0x5f, 0x05,
+		0xd1, 0x3c, 0x14, 0xec, 0x0b, 0xb6, 0x4f, 0x92, 0xa2, 0x24, 0x60, 0x5f, 0xb0, 0x05, 0xc1, 0xc3,
+		0x30, 0x45, 0xfa, 0xa6, 0x86, 0x3d, 0x95, 0xbc, 0x52, 0x20, 0x65, 0x9c, 0x44, 0xfe, 0x8d, 0x24,
+		0x48, 0xfd, 0x16, 0xcc, 0x4f, 0x6a, 0x26, 0x8e, 0x9d, 0xd4, 0x3c, 0xd5, 0x9f, 0x4e, 0x0d, 0x32,
+		0xa8, 0xab, 0x20, 0x05, 0x89, 0x40, 0xb6, 0x92, 0x8c, 0xf6, 0xbc, 0xfa, 0x5c, 0x44, 0xa5, 0xac,
+		0xf7, 0xf9, 0x8c, 0x57, 0x22, 0x9b, 0x3f, 0xe2, 0x26, 0xfd, 0xfe, 0x41, 0xf5, 0x1d, 0x8e, 0x2d,
+		0xbb, 0xad, 0x79, 0x58, 0x24, 0x66, 0x42, 0xfb, 0x40, 0x8f, 0x41, 0x59, 0xb3, 0x6d, 0xd5, 0xf5,
+		0x34, 0x0f, 0x73, 0xbf, 0x60, 0x92, 0xe5, 0x27, 0x35, 0xdb, 0x6e, 0x92, 0x52, 0xe6, 0x17, 0x3c,
+		0x0a, 0x25, 0x62, 0xd4, 0x0d, 0xad, 0xa3, 0xf2, 0x50, 0x2b, 0xc3, 0xdc, 0x07, 0x5e, 0x7a, 0x8d,
+		0x16, 0xca, 0x6d, 0x7f, 0xc4, 0xa9, 0xe6, 0xf8, 0x71, 0x5e, 0x22, 0x14, 0xe7, 0x21, 0x7e, 0x85,
+		0x02, 0x93, 0x8f, 0xb8, 0x76, 0x62, 0x78, 0x7a, 0x79, 0x96, 0xc6, 0x84, 0xfb, 0x2c, 0xe3, 0x92,
+		0x53, 0xd8, 0x83, 0xfc, 0x91, 0x24, 0x4c, 0x0f, 0x98, 0xfe, 0xa1, 0x79, 0xf7, 0x20, 0xc0, 0x4d,
+		0x1e, 0x2b, 0xc0, 0xbd, 0x11, 0xcd, 0x2b, 0x87, 0x96, 0xce, 0xd3, 0x03, 0x42, 0x66, 0x86, 0x97,
+		0x28, 0x34, 0x07, 0x09, 0xa5, 0x9e, 0xa9, 0x9a, 0x6f, 0xc1, 0xec, 0xf6, 0xe1, 0x9b, 0x9a, 0xe9,
+		0x19, 0x26, 0x56, 0x07, 0x46, 0x6d, 0x70, 0x2d, 0x5e, 0x33, 0xdc, 0x6d, 0xbc, 0xa7, 0xed, 0x1b,
+		0x96, 0x68, 0xd6, 0x8c, 0xcf, 0x1f, 0x64, 0x97, 0x65, 0x05, 0x4a, 0xd1, 0xb5, 0x0b, 0x95, 0x20,
+		0xe9, 0x1d, 0xf0, 0xfe, 0x27, 0xbd, 0x03, 0xf4, 0x0c, 0x4f, 0x44, 0x25, 0x69, 0x22, 0x6a, 0xb0,
+		0x22, 0xce, 0x17, 0x64, 0xa1, 0x64, 0xd9, 0x9f, 0x0d, 0xfe, 0x7a, 0xd6, 0x8f, 0x2a, 0x3f, 0x01,
+		0xe5, 0xbe, 0x05, 0x6b, 0x54, 0x00, 0x2e, 0x97, 0xa1, 0x18, 0x59, 0x9d, 0xe4, 0x13, 0x30, 0x3b,
+		0x6c, 0xb1, 0x91, 0xf7, 0xfc, 0xf2, 0xc8, 0xa2, 0x81, 0x2e, 0x42, 0xce, 0x5f, 0x6d, 0x86, 0xa4,
+		0x41, 0x68, 0x2f, 0x04, 0xb1, 0xe2, 0x93, 0x46, 0xb2, 0xe9, 0xc9, 0x48, 0x36, 0x5d, 0xfe, 0x2a,
+		0x98, 0x1b, 0xb5, 0x92, 0xf4, 0x75, 0x23, 0xed, 0x6b, 0xe1, 0x09, 0xc8, 0xf0, 0x6b, 0x13, 0x93,
+		0x74, 0xff, 0x88, 0x3f, 0x11, 0xed, 0x64, 0xab, 0x4a, 0x8a, 0x6d, 0x2b, 0xd1, 0x07, 0x59, 0x85,
+		0x53, 0x23, 0x57, 0x93, 0xd1, 0x3b, 0x51, 0x0c, 0x88, 0xef, 0x44, 0xe9, 0xa2, 0x39, 0x2e, 0xed,
+		0xab, 0x38, 0x6d, 0xc1, 0x9e, 0xe4, 0xb7, 0x53, 0x70, 0x62, 0xf8, 0x9a, 0x82, 0x16, 0x60, 0x8a,
+		0x38, 0xee, 0x5e, 0xd4, 0xc7, 0x87, 0xae, 0x76, 0xd0, 0xe2, 0x0e, 0x3e, 0xcf, 0xe4, 0x27, 0xfd,
+		0x4c, 0x3e, 0xda, 0x82, 0xe9, 0x8e, 0xa5, 0x6b, 0x1d, 0x35, 0xa4, 0xf1, 0x5c, 0xd9, 0x1f, 0x19,
+		0x10, 0x76, 0x83, 0x5d, 0xa6, 0xde, 0x1e, 0x50, 0xfa, 0x32, 0xc5, 0x58, 0xf5, 0x35, 0x1f, 0x2d,
+		0x43, 0xa1, 0x1b, 0x28, 0xf2, 0x31, 0x94, 0x3d, 0xcc, 0x16, 0x1a, 0x92, 0xc9, 0xa1, 0xfb, 0x4e,
+		0x99, 0x63, 0x9b, 0xe8, 0x51, 0x5b, 0x38, 0xd9, 0x91, 0x5b, 0x38, 0xc3, 0xf6, 0x4b, 0x72, 0xc3,
+		0xf7, 0x4b, 0x3e, 0x1a, 0x1e, 0x9a, 0xe8, 0x2a, 0x3c, 0xb0, 0x85, 0x82, 0x9a, 0x30, 0xcb, 0xf9,
+		0xdb, 0x11, 0xd9, 0x27, 0xc7, 0x35, 0x34, 0x48, 0xb0, 0x8f, 0x16, 0x7b, 0xea, 0xde, 0xc4, 0x2e,
+		0x6c, 0x69, 0x3a, 0x64, 0x4b, 0xff, 0x0f, 0x1b, 0x8a, 0x27, 0x03, 0x33, 0xc5, 0xfd, 0x18, 0xe2,
+		0x6f, 0x38, 0xac, 0x48, 0x9c, 0x3f, 0xe0, 0x8f, 0xf2, 0xb7, 0x02, 0xe4, 0x14, 0xec, 0xda, 0x64,
+		0x95, 0x45, 0x35, 0xc8, 0xe3, 0x03, 0x1d, 0xdb, 0x5e, 0xb0, 0xa9, 0x3a, 0x2c, 0xf4, 0x60, 0xd4,
+		0x0d, 0x41, 0x49, 0xfc, 0x7e, 0x9f, 0x0d, 0x3d, 0xc7, 0x43, 0xbb, 0xd1, 0x51, 0x1a, 0x67, 0x0f,
+		0xc7, 0x76, 0x97, 0x44, 0x6c, 0x97, 0x1a, 0xe9, 0xea, 0x33, 0xae, 0xbe, 0xe0, 0xee, 0x39, 0x1e,
+		0xdc, 0xa5, 0x63, 0x2a, 0x8b, 0x44, 0x77, 0xf5, 0x48, 0x74, 0x97, 0x89, 0xe9, 0xe6, 0x88, 0xf0,
+		0xee, 0x92, 0x08, 0xef, 0xb2, 0x31, 0x2d, 0xee, 0x8b, 0xef, 0xae, 0x44, 0xe3, 0xbb, 0xdc, 0x08,
+		0x6b, 0x23, 0xb8, 0x47, 0x06, 0x78, 0xef, 0x0b, 0x05, 0x78, 0xf9, 0x91, 0x9e, 0x2d, 0x03, 0x19,
+		0x12, 0xe1, 0xd5, 0x23, 0x11, 0x1e, 0xc4, 0xc8, 0x60, 0x44, 0x88, 0xf7, 0x81, 0x70, 0x88, 0x57,
+		0x18, 0x19, 0x25, 0xf2, 0xf1, 0x1e, 0x16, 0xe3, 0xbd, 0xe4, 0xc7, 0x78, 0x53, 0x23, 0x83, 0x54,
+		0xde, 0x87, 0xfe, 0x20, 0x6f, 0x63, 0x20, 0xc8, 0x2b, 0xf2, 0x3f, 0x6a, 0x32, 0x0a, 0x22, 0x26,
+		0xca, 0xdb, 0x18, 0x88, 0xf2, 0x4a, 0x31, 0x80, 0x31, 0x61, 0xde, 0xff, 0x35, 0x3c, 0xcc, 0x1b,
+		0x1d, 0x88, 0xf1, 0x66, 0x8e, 0x17, 0xe7, 0xa9, 0x23, 0xe2, 0x3c, 0x16, 0x8b, 0x3d, 0x39, 0x12,
+		0x7e, 0xec, 0x40, 0x6f, 0x6b, 0x48, 0xa0, 0xc7, 0x42, 0xb2, 0xb3, 0x23, 0xc1, 0xc7, 0x88, 0xf4,
+		0xb6, 0x86, 0x44, 0x7a, 0x28, 0x16, 0x36, 0x36, 0xd4, 0xfb, 0x40, 0x38, 0xd4, 0x9b, 0x89, 0xd3,
+		0xc5, 0x98, 0x58, 0x6f, 0x52, 0xca, 0xc8, 0x4f, 0x10, 0x4f, 0xbb, 0xcf, 0xd2, 0x11, 0x77, 0x05,
+		0x3b, 0x8e, 0xe5, 0x88, 0x93, 0xc4, 0xf4, 0x41, 0x3e, 0x4b, 0x7c, 0xff, 0xc0, 0xaa, 0x1d, 0x11,
+		0x17, 0x52, 0xb7, 0x30, 0x64, 0xc9, 0xe4, 0x9f, 0x4d, 0x04, 0xbc, 0xd4, 0x65, 0x0e, 0xc7, 0x0d,
+		0x79, 0x1e, 0x37, 0x84, 0xa2, 0xc5, 0x64, 0x34, 0x5a, 0x9c, 0x87, 0x02, 0x71, 0xf7, 0xfa, 0x02,
+		0x41, 0xcd, 0xf6, 0x03, 0x41, 0x71, 0x84, 0x82, 0xc5, 0x94, 0x7c, 0x15, 0x63, 0x3b, 0x57, 0x65,
+		0xff, 0x38, 0x09, 0x0b, 0x61, 0xd0, 0xd3, 0x30, 0x13, 0xa2, 0xf5, 0xdd, 0x48, 0x16, 0x15, 0x49,
+		0x3e, 0x75, 0x95, 0xfb, 0x93, 0xff, 0x26, 0x11, 0x48, 0x28, 0x88, 0x20, 0x87, 0x05, 0x7b, 0x89,
+		0xfb, 0x14, 0xec, 0x25, 0xef, 0x39, 0xd8, 0x0b, 0xbb, 0xc5, 0xa9, 0xa8, 0x5b, 0xfc, 0xdf, 0x13,
+		0xc1, 0x98, 0xf8, 0xa1, 0x9b, 0x6e, 0xb5, 0x31, 0x77, 0x54, 0xe9, 0x6f, 0xe2, 0xc3, 0x74, 0xac,
+		0x5d, 0xee, 0x8e, 0x92, 0x9f, 0x84, 0xca, 0x5f, 0x7a, 0xf2, 0x7c, 0x65, 0xf1, 0x7d, 0xdc, 0xc9,
+		0xf0, 0x79, 0x67, 0x7e, 0x08, 0x38, 0x13, 0x1c, 0x02, 0xf6, 0xbf, 0xd5, 0xcb, 0x86, 0xbe, 0xd5,
+		0x43, 0x2f, 0x42, 0x9e, 0x66, 0x70, 0x55, 0xcb, 0x16, 0x7f, 0x44, 0xe7, 0xf4, 0xe8, 0x03, 0xc0,
+		0x2e, 0x3d, 0x9a, 0xc8, 0x0e, 0x0d, 0x07, 0x0e, 0x4a, 0xbe, 0x7f, 0x1b, 0x90, 0xb4, 0x9e, 0x5d,
+		0x06, 0x0f, 0xfc, 0x43, 0x4f, 0x51, 0x20, 0x7f, 0x08, 0xd0, 0xe0, 0x32, 0x83, 0xae, 0x41, 0x06,
+		0xef, 0xd3, 0x4b, 0x08, 0xd9, 0xc1, 0xca, 0x13, 0x83, 0x9e, 0x30, 0x79, 0x5d, 0x9b, 0x23, 0x42,
+		0xfe, 0xe3, 0x77, 0xe6, 0x25, 0x46, 0xfd, 0x94, 0xff, 0x5d, 0x83, 0xc2, 0xf9, 0xe5, 0xdf, 0x4d,
+		0x12, 0x47, 0x24, 0xb2, 0x04, 0x0d, 0x95, 0xed, 0xb0, 0x2d, 0xd1, 0xf1, 0xe4, 0x7d, 0x06, 0x60,
+		0x57, 0x73, 0xd5, 0xdb, 0x9a, 0xe9, 0xe1, 0x36, 0x17, 0x7a, 0xa8, 0x04, 0x55, 0x20, 0x47, 0x9e,
+		0x7a, 0x2e, 0x6e, 0xf3, 0xa8, 0xdd, 0x7f, 0x0e, 0xf5, 0x33, 0xfb, 0xa5, 0xf5, 0x33, 0x2a, 0xe5,
+		0x5c, 0x9f, 0x94, 0x43, 0xb1, 0x4c, 0x3e, 0x1c, 0xcb, 0xb0, 0x83, 0xd0, 0xfc, 0x3c, 0x26, 0xb0,
+		0xb6, 0x89, 0x67, 0xf4, 0x08, 0x14, 0xbb, 0xb8, 0x6b, 0x5b, 0x56, 0x47, 0x65, 0xe6, 0x86, 0xfd,
+		0xd5, 0x87, 0x29, 0x5e, 0xd8, 0xa0, 0x56, 0xe7, 0xeb, 0x92, 0xc1, 0xfc, 0x0b, 0x62, 0xd6, 0xbf,
+		0x73, 0x02, 0xa6, 0xd7, 0x64, 0xf7, 0x3b, 0x19, 0xa8, 0x19, 0x3e, 0x25, 0xd3, 0xa3, 0x66, 0x41,
+		0x28, 0xf4, 0xb8, 0xf6, 0x23, 0x38, 0x4d, 0xc3, 0x8a, 0x5d, 0xf4, 0x1a, 0x9c, 0xec, 0xb3, 0x6d,
+		0x3e, 0x74, 0x72, 0x5c, 0x13, 0xf7, 0x40, 0xd4, 0xc4, 0x09, 0xe8, 0x40, 0x58, 0xa9, 0x2f, 0x71,
+		0xd6, 0xad, 0x40, 0x29, 0xea, 0x33, 0x0d, 0x1d, 0x7e, 0xfa, 0x57, 0x86, 0x3c, 0xcd, 0x30, 0xd5,
+		0x48, 0xf6, 0x69, 0x8a, 0x15, 0xf2, 0x9c, 0xd6, 0x26, 0x3c, 0x30, 0xd4, 0x77, 0x42, 0x2f, 0x40,
+		0x3e, 0x70, 0xbb, 0x98, 0x54, 0x8f, 0xc8, 0x4e, 0x04, 0xb4, 0xf2, 0x2f, 0x24, 0x02, 0xc8, 0x68,
+		0xbe, 0xa3, 0x01, 0x19, 0x76, 0xcc, 0x8f, 0x1f, 0x1e, 0x7a, 0x7a, 0x3c, 0xaf, 0x6b, 0x91, 0x9d,
+		0x01, 0x54, 0x38, 0xb3, 0xfc, 0x21, 0xc8, 0xb0, 0x12, 0x54, 0x80, 0x6c, 0x70, 0x4f, 0x30, 0x40,
+		0xa6, 0x5a, 0xaf, 0x37, 0x36, 0xc5, 0xa5, 0x9f, 0xb5, 0x0d, 0xa5, 0x25, 0x25, 0x49, 0xb1, 0xd2,
+		0xb8, 0xde, 0xa8, 0xb7, 0xa4, 0x14, 0x9a, 0x86, 0x22, 0xfb, 0xad, 0x5e, 0xd9, 0x50, 0xd6, 0xaa,
+		0x2d, 0x29, 0x1d, 0x2a, 0x6a, 0x36, 0xd6, 0x97, 0x1b, 0x8a, 0x34, 0x29, 0x3f, 0x0b, 0xa7, 0x46,
+		0xfa, 0x69, 0x41, 0x32, 0x23, 0x11, 0x4a, 0x66, 0xc8, 0x6f, 0x27, 0xa1, 0x32, 0xda, 0xf9, 0x42,
+		0xd7, 0xfb, 0x3a, 0x7e, 0xe1, 0x18, 0x9e, 0x5b, 0x5f, 0xef, 0xd1, 0xa3, 0x50, 0x72, 0xf0, 0x0e,
+		0xf6, 0xf4, 0x3d, 0xe6, 0x0c, 0xb2, 0x25, 0xb3, 0xa8, 0x14, 0x79, 0x29, 0x65, 0x72, 0x19, 0xd9,
+		0x1b, 0x58, 0xf7, 0x54, 0x66, 0x8b, 0x5c, 0xfe, 0x87, 0x50, 0x8b, 0xac, 0xb4, 0xc9, 0x0a, 0xe5,
+		0xaf, 0x3a, 0x96, 0x2c, 0xf3, 0x30, 0xa9, 0x34, 0x5a, 0xca, 0x6b, 0x52, 0x0a, 0x21, 0x28, 0xd1,
+		0x9f, 0x6a, 0x73, 0xbd, 0xba, 0xd9, 0xbc, 0xb6, 0x41, 0x64, 0x39, 0x03, 0x65, 0x21, 0x4b, 0x51,
+		0x38, 0x29, 0x3f, 0x09, 0x27, 0x47, 0x78, 0x8e, 0x43, 0xce, 0x5d, 0x7e, 0x22, 0x11, 0xa6, 0x8e,
+		0x7a, 0x7f, 0x1b, 0x90, 0x71, 0x3d, 0xcd, 0xeb, 0xb9, 0x5c, 0x88, 0x2f, 0x8c, 0xeb, 0x4a, 0x2e,
+		0x8a, 0x1f, 0x4d, 0xca, 0xae, 0x70, 0x18, 0xf9, 0x22, 0x94, 0xa2, 0x6f, 0x46, 0xcb, 0x20, 0x50,
+		0xa2, 0xa4, 0xec, 0x84, 0x4c, 0x91, 0x88, 0xbd, 0x8f, 0x70, 0x27, 0x92, 0x81, 0xf5, 0x8d, 0xd8,
+		0xb8, 0x54, 0xff, 0x22, 0x52, 0x81, 0x9c, 0xc3, 0x71, 0x79, 0x66, 0xc2, 0x7f, 0x96, 0x5f, 0x03,
+		0x08, 0xa5, 0x5c, 0xfd, 0x53, 0x73, 0x89, 0xf0, 0xa9, 0xb9, 0x8b, 0x30, 0xb9, 0x6f, 0x31, 0x3b,
+		0x35, 0x7c, 0xb2, 0xde, 0xb4, 0x3c, 0x1c, 0xca, 0xaf, 0x30, 0x6a, 0xd9, 0x00, 0x34, 0x98, 0xf6,
+		0x1a, 0x51, 0xc5, 0xfb, 0xa2, 0x55, 0x3c, 0x3c, 0x32, 0x81, 0x36, 0xbc, 0xaa, 0x37, 0x61, 0x92,
+		0x5a, 0xb8, 0xa1, 0x9f, 0x51, 0x7d, 0x25, 0x80, 0xe6, 0x79, 0x8e, 0xb1, 0xdd, 0x0b, 0x2a, 0x98,
+		0x1f, 0x6e, 0x21, 0xab, 0x82, 0xae, 0xf6, 0x20, 0x37, 0x95, 0xb3, 0x01, 0x6b, 0xc8, 0x5c, 0x86,
+		0x00, 0xe5, 0x75, 0x28, 0x45, 0x79, 0x87, 0x7f, 0x16, 0x16, 0x5c, 0xaf, 0x90, 0x17, 0x2e, 0x9b,
+		0xef, 0xf0, 0xf1, 0x2b, 0x4f, 0xe8, 0x83, 0x7c, 0x27, 0x01, 0xb9, 0xd6, 0x01, 0x9f, 0x3b, 0x47,
+		0x1c, 0x5c, 0x0d, 0xbe, 0x8d, 0xf3, 0xf3, 0xa1, 0x2c, 0xe5, 0x9c, 0xf2, 0x13, 0xd9, 0x1f, 0xf0,
+		0xad, 0x43, 0x7a, 0xdc, 0x18, 0x5d, 0x24, 0xf4, 0xb9, 0x45, 0xbc, 0x3c, 0xde, 0x77, 0x28, 0xb3,
+		0x30, 0x19, 0xfe, 0x86, 0x84, 0x3d, 0xc8, 0xed, 0xd0, 0xd1, 0x0d, 0xb6, 0x54, 0x85, 0x3f, 0x58,
+		0x49, 0x1c, 0xfb, 0x83, 0x15, 0xbf, 0x96, 0x64, 0xb8, 0x96, 0x7d, 0xc8, 0x09, 0xa5, 0x40, 0xef,
+		0x0f, 0x9f, 0xcf, 0x11, 0xdb, 0x50, 0x23, 0x17, 0x6c, 0x0e, 0x1f, 0x3a, 0x9e, 0x73, 0x0e, 0xa6,
+		0xf9, 0xb1, 0xc6, 0x20, 0x96, 0xe1, 0x7f, 0x95, 0xa1, 0xcc, 0x5e, 0xac, 0x8a, 0x40, 0x46, 0xfe,
+		0xfe, 0x04, 0x48, 0xfd, 0x5a, 0xf9, 0xe5, 0x6c, 0x00, 0x31, 0xc4, 0x44, 0xfb, 0x43, 0xd7, 0xa2,
+		0xb3, 0x91, 0x2f, 0x92, 0xd2, 0xe0, 0x62, 0xf4, 0x8f, 0x24, 0xa1, 0x10, 0x4a, 0x5b, 0xa2, 0xe7,
+		0x23, 0xc7, 0x6c, 0x17, 0x8e, 0x4a, 0x71, 0x86, 0xce, 0xd9, 0x46, 0x3a, 0x96, 0x3c, 0x7e, 0xc7,
+		0xee, 0xff, 0x87, 0x10, 0xc3, 0xbf, 0xa8, 0x9a, 0x1c, 0xf1, 0x45, 0xd5, 0xd7, 0x26, 0x20, 0xe7,
+		0xbb, 0x0b, 0xc7, 0xdd, 0xb0, 0x38, 0x01, 0x19, 0xbe, 0x22, 0xb2, 0x1d, 0x0b, 0xfe, 0x34, 0x34,
+		0xdd, 0x5b, 0x81, 0x9c, 0xf8, 0x5b, 0x5a, 0x3c, 0xf8, 0xf5, 0x9f, 0xcf, 0xbd, 0x04, 0x85, 0xd0,
+		0xde, 0x11, 0xb1, 0x13, 0xeb, 0x8d, 0x57, 0xa4, 0x89, 0x4a, 0xf6, 0xce, 0xdd, 0x85, 0xd4, 0x3a,
+		0xbe, 0x4d, 0x66, 0x98, 0xd2, 0xa8, 0x5f, 0x6b, 0xd4, 0x6f, 0x48, 0x89, 0x4a, 0xe1, 0xce, 0xdd,
+		0x85, 0xac, 0x82, 0x69, 0xd2, 0xed, 0xdc, 0x0d, 0x28, 0xf7, 0x0d, 0x4c, 0x74, 0x4d, 0x41, 0x50,
+		0x5a, 0xde, 0xda, 0x5c, 0x5d, 0xa9, 0x57, 0x5b, 0x0d, 0x95, 0x1d, 0x39, 0x44, 0x27, 0x61, 0x66,
+		0x75, 0xe5, 0xea, 0xb5, 0x96, 0x5a, 0x5f, 0x5d, 0x69, 0xac, 0xb7, 0xd4, 0x6a, 0xab, 0x55, 0xad,
+		0xdf, 0x90, 0x92, 0x17, 0x7e, 0xa3, 0x00, 0xe5, 0x6a, 0xad, 0xbe, 0x42, 0x7c, 0x02, 0x83, 0xdf,
+		0x6c, 0x5f, 0x87, 0x34, 0x4d, 0x3f, 0x1c, 0x79, 0x9c, 0xa6, 0x72, 0x74, 0x46, 0x16, 0x5d, 0x81,
+		0x49, 0x9a, 0x99, 0x40, 0x47, 0x9f, 0xaf, 0xa9, 0xc4, 0xa4, 0x68, 0x49, 0x63, 0xe8, 0x74, 0x3a,
+		0xf2, 0xc0, 0x4d, 0xe5, 0xe8, 0x8c, 0x2d, 0x52, 0x20, 0x1f, 0x44, 0x36, 0xf1, 0x07, 0x50, 0x2a,
+		0x63, 0x58, 0x47, 0xb4, 0x0a, 0x59, 0x11, 0x8c, 0xc6, 0x1d, 0x89, 0xa9, 0xc4, 0xa6, 0x54, 0x89,
+		0xb8, 0xd8, 0x2a, 0x7f, 0xf4, 0xf9, 0x9e, 0x4a, 0x4c, 0x7e, 0x18, 0xad, 0xf8, 0x1f, 0x3a, 0xc4,
+		0x1c, 0x73, 0xa9, 0xc4, 0xa5, 0x48, 0x89, 0xd0, 0x82, 0x74, 0x4c, 0xfc, 0xa9, 0xa5, 0xca, 0x18,
+		0xa9, 0x6f, 0xb4, 0x05, 0x10, 0x4a, 0x11, 0x8c, 0x71, 0x1c, 0xa9, 0x32, 0x4e, 0x4a, 0x1b, 0x6d,
+		0x40, 0xce, 0x8f, 0xd8, 0x62, 0x0f, 0x07, 0x55, 0xe2, 0x73, 0xcb, 0xe8, 0x43, 0x50, 0x8c, 0x46,
+		0x2a, 0xe3, 0x1d, 0xf9, 0xa9, 0x8c, 0x99, 0x34, 0x26, 0xf8, 0xd1, 0xb0, 0x65, 0xbc, 0x23, 0x40,
+		0x95, 0x31, 0x73, 0xc8, 0xe8, 0x0d, 0x98, 0x1e, 0x0c, 0x2b, 0xc6, 0x3f, 0x11, 0x54, 0x39, 0x46,
+		0x56, 0x19, 0x75, 0x01, 0x0d, 0x09, 0x47, 0x8e, 0x71, 0x40, 0xa8, 0x72, 0x9c, 0x24, 0x33, 0x6a,
+		0x43, 0xb9, 0xdf, 0xc7, 0x1f, 0xf7, 0xc0, 0x50, 0x65, 0xec, 0x84, 0x33, 0xab, 0x25, 0x1a, 0x1b,
+		0x8c, 0x7b, 0x80, 0xa8, 0x32, 0x76, 0xfe, 0x99, 0xea, 0xad, 0x70, 0xef, 0x63, 0x0f, 0x14, 0x55,
+		0xe2, 0xf3, 0xd0, 0xb5, 0xea, 0xc8, 0x43, 0xa5, 0x8f, 0x1f, 0x79, 0xa8, 0x34, 0x38, 0x26, 0xea,
+		0x1f, 0x24, 0xfd, 0xc3, 0x1a, 0xbc, 0x87, 0xdf, 0x00, 0xe1, 0x7a, 0xda, 0x2d, 0xc3, 0xdc, 0xf5,
+		0xaf, 0xf4, 0xe0, 0xcf, 0xfc, 0x44, 0xe9, 0x09, 0x7e, 0xcb, 0x84, 0x28, 0x8d, 0xb9, 0xd8, 0x63,
+		0xe4, 0x65, 0x65, 0x71, 0x27, 0xbf, 0xe3, 0xcf, 0x8b, 0x1e, 0x71, 0x69, 0x48, 0xcc, 0xd5, 0x24,
+		0x43, 0x2e, 0x15, 0x89, 0x39, 0xf9, 0x7a, 0xd4, 0x21, 0x5b, 0xf9, 0x5b, 0x12, 0x50, 0xba, 0x66,
+		0xb8, 0x9e, 0xe5, 0x18, 0xba, 0xd6, 0xa1, 0x4b, 0xd0, 0xe5, 0x71, 0xbf, 0xcc, 0xa9, 0xe5, 0x89,
+		0x77, 0xc3, 0x6f, 0x22, 0xe1, 0x87, 0x69, 0x96, 0x21, 0xb3, 0xaf, 0x75, 0xd8, 0x77, 0x31, 0xe1,
+		0x3b, 0x83, 0xfa, 0x65, 0x1e, 0x72, 0xbb, 0xc2, 0x28, 0x8c, 0x57, 0xfe, 0x51, 0x7a, 0x72, 0xbe,
+		0xdb, 0x35, 0x5c, 0xf6, 0xe7, 0xf3, 0x3d, 0xec, 0xa2, 0x4d, 0x48, 0x3b, 0x9a, 0xc7, 0xa3, 0xa4,
+		0xda, 0x7b, 0xf9, 0xfd, 0x21, 0x8f, 0xc5, 0xdf, 0x02, 0xb2, 0x38, 0x78, 0xc5, 0x08, 0x45, 0x42,
+		0xaf, 0x40, 0xae, 0xab, 0x1d, 0xa8, 0x14, 0x35, 0x79, 0x1f, 0x50, 0xb3, 0x5d, 0xed, 0x80, 0xb4,
+		0x95, 0x4c, 0x49, 0x02, 0xac, 0xef, 0x69, 0xe6, 0x2e, 0x66, 0xf8, 0xa9, 0xfb, 0x80, 0x5f, 0xec,
+		0x6a, 0x07, 0x75, 0x8a, 0x49, 0x6a, 0x59, 0xca, 0xbd, 0xfd, 0xf1, 0xf9, 0x09, 0x7a, 0x00, 0xfc,
+		0x97, 0x13, 0x3c, 0x20, 0xa6, 0xe2, 0x42, 0x1a, 0x48, 0xba, 0xff, 0x44, 0xab, 0x17, 0x1b, 0x12,
+		0x8f, 0x8f, 0x1a, 0x8d, 0x3e, 0x61, 0xd7, 0x8a, 0xa4, 0xa1, 0x9f, 0x7e, 0x67, 0x3e, 0xc1, 0xc6,
+		0xa5, 0xac, 0xf7, 0x0d, 0xc6, 0x75, 0x28, 0xb0, 0x3c, 0xa0, 0x4a, 0x1d, 0xe1, 0x64, 0xac, 0x23,
+		0x5c, 0x14, 0x8e, 0x30, 0x03, 0x04, 0xc6, 0x4d, 0xde, 0x87, 0xfa, 0xf1, 0xa3, 0x09, 0x28, 0x2c,
+		0x87, 0xee, 0x06, 0x9b, 0x83, 0x6c, 0xd7, 0x32, 0x8d, 0x5b, 0xd8, 0xf1, 0x77, 0x95, 0xd8, 0x23,
+		0x71, 0x58, 0xd9, 0x9f, 0x98, 0xf2, 0x0e, 0xc5, 0xbd, 0x19, 0xe2, 0x99, 0x70, 0xdd, 0xc6, 0xdb,
+		0xae, 0x21, 0xa4, 0xae, 0x88, 0x47, 0xf4, 0x04, 0x48, 0x2e, 0xd6, 0x7b, 0x8e, 0xe1, 0x1d, 0xaa,
+		0xba, 0x65, 0x7a, 0x9a, 0xee, 0xf1, 0xdc, 0x6f, 0x59, 0x94, 0xd7, 0x59, 0x31, 0x01, 0x69, 0x63,
+		0x4f, 0x33, 0x3a, 0xec, 0x8c, 0x5c, 0x5e, 0x11, 0x8f, 0xa1, 0xe6, 0x7e, 0x5f, 0x3e, 0x1c, 0x68,
+		0xd6, 0x41, 0xb2, 0x6c, 0xec, 0x44, 0x3e, 0x52, 0x64, 0xba, 0x3a, 0xf7, 0x5b, 0x9f, 0x7a, 0x7a,
+		0x96, 0x0b, 0x9e, 0x9f, 0x58, 0x60, 0xd7, 0x61, 0x2b, 0x65, 0xc1, 0x21, 0xbe, 0x5e, 0x7c, 0x2d,
+		0xb2, 0x97, 0xd4, 0xdb, 0x0e, 0x2e, 0x49, 0x98, 0x1d, 0x10, 0x6e, 0xd5, 0x3c, 0xac, 0xcd, 0xfd,
+		0x7a, 0x00, 0x1d, 0x04, 0xa2, 0x37, 0xf0, 0x61, 0x78, 0x63, 0x89, 0xc2, 0x90, 0x78, 0xe0, 0x0d,
+		0xcd, 0xe8, 0x88, 0xbf, 0xc8, 0xa7, 0xf0, 0x27, 0xb4, 0xe4, 0x27, 0x92, 0xd2, 0x34, 0xb8, 0x92,
+		0x47, 0xe9, 0x48, 0xcd, 0x32, 0xdb, 0xd1, 0x9c, 0x11, 0x6a, 0x41, 0xc6, 0xb3, 0x6e, 0x61, 0x93,
+		0x0b, 0xe9, 0x58, 0xfa, 0x3d, 0x78, 0x63, 0x10, 0xc7, 0x42, 0xbb, 0x20, 0xb5, 0x71, 0x07, 0xef,
+		0xb2, 0x4f, 0xec, 0xf6, 0x34, 0x07, 0xb3, 0xcf, 0x6e, 0xbf, 0xd4, 0xf9, 0x53, 0xf6, 0x51, 0x9b,
+		0x14, 0x14, 0x6d, 0x46, 0x6f, 0xa7, 0xcb, 0xf2, 0x93, 0x09, 0x23, 0xfa, 0x1f, 0xd2, 0xcc, 0xb0,
+		0xcd, 0x8a, 0xdc, 0x66, 0xf7, 0x04, 0x48, 0x3d, 0x73, 0xdb, 0x32, 0xe9, 0x1f, 0xb9, 0xe2, 0xe1,
+		0x5a, 0x8e, 0xed, 0x3d, 0xfa, 0xe5, 0x7c, 0xef, 0x71, 0x13, 0x4a, 0x01, 0x29, 0x9d, 0x45, 0xf9,
+		0xe3, 0xce, 0xa2, 0xa2, 0x0f, 0x40, 0x48, 0xd0, 0x1a, 0x40, 0x30, 0x4f, 0xfd, 0x13, 0x0e, 0xb1,
+		0x33, 0x3e, 0xdc, 0x99, 0x10, 0x00, 0xea, 0xc0, 0x4c, 0xd7, 0x30, 0x55, 0x17, 0x77, 0x76, 0x54,
+		0x2e, 0x39, 0x82, 0x5b, 0xb8, 0x0f, 0x23, 0x3d, 0xdd, 0x35, 0xcc, 0x26, 0xee, 0xec, 0x2c, 0xfb,
+		0xb0, 0xe8, 0xbd, 0x70, 0x3a, 0x10, 0x87, 0x65, 0xaa, 0x7b, 0x56, 0xa7, 0xad, 0x3a, 0x78, 0x47,
+		0xd5, 0xe9, 0x85, 0x55, 0x53, 0x54, 0x88, 0x27, 0x7d, 0x92, 0x0d, 0xf3, 0x9a, 0xd5, 0x69, 0x2b,
+		0x78, 0xa7, 0x4e, 0x5e, 0xa3, 0x47, 0x20, 0x90, 0x85, 0x6a, 0xb4, 0xdd, 0xb9, 0xe2, 0x42, 0xea,
+		0x6c, 0x5a, 0x99, 0xf2, 0x0b, 0x57, 0xda, 0x2e, 0xaa, 0x91, 0xe9, 0xcf, 0x3a, 0x13, 0xf9, 0x16,
+		0xfe, 0xa8, 0x19, 0x5a, 0x72, 0x59, 0x33, 0xc5, 0x04, 0xad, 0x42, 0xd9, 0xc1, 0x1d, 0xed, 0x30,
+		0x74, 0x26, 0xa9, 0x1c, 0x07, 0xc1, 0x19, 0x04, 0xc4, 0x55, 0x40, 0xfa, 0x9e, 0xd6, 0xe9, 0x60,
+		0xf6, 0xc7, 0x3d, 0x38, 0x8a, 0x14, 0x83, 0x32, 0x1d, 0xf0, 0x08, 0xa0, 0x93, 0x90, 0xdd, 0xee,
+		0xb8, 0x34, 0x2f, 0x35, 0x4d, 0x83, 0xf6, 0xcc, 0x76, 0xc7, 0xbd, 0x81, 0x0f, 0x97, 0xa6, 0x3e,
+		0xfa, 0xf1, 0xf9, 0x09, 0x6e, 0xa6, 0x26, 0xe4, 0x4d, 0x7a, 0x97, 0x0c, 0x67, 0xc2, 0x2e, 0xba,
+		0x04, 0x79, 0x4d, 0x3c, 0xb0, 0xaf, 0x90, 0x8e, 0xa8, 0x36, 0x20, 0x65, 0x86, 0xef, 0xad, 0xdf,
+		0x5d, 0x48, 0xc8, 0xdf, 0x97, 0x80, 0xcc, 0xf2, 0xcd, 0x4d, 0xcd, 0x70, 0x50, 0x03, 0xa6, 0x83,
+		0xb9, 0x3a, 0xae, 0xd9, 0x0b, 0xa6, 0xb7, 0xe8, 0x4a, 0x63, 0xd4, 0x27, 0xde, 0x47, 0xc2, 0xf4,
+		0x7f, 0xfc, 0xdd, 0xd7, 0xf1, 0xeb, 0x90, 0x65, 0xad, 0x74, 0xd1, 0xcb, 0x30, 0x69, 0x93, 0x1f,
+		0x7c, 0xeb, 0xe6, 0xcc, 0xc8, 0x39, 0x4e, 0xe9, 0xc3, 0x33, 0x82, 0xf1, 0xc9, 0x7f, 0x9d, 0x00,
+		0x58, 0xbe, 0x79, 0xb3, 0xe5, 0x18, 0x76, 0x07, 0x7b, 0xf7, 0xab, 0xdb, 0xab, 0xf0, 0x40, 0xe8,
+		0x63, 0x62, 0x47, 0x1f, 0xbb, 0xeb, 0x33, 0xc1, 0xe7, 0xc4, 0x8e, 0x3e, 0x14, 0xad, 0xed, 0x7a,
+		0x3e, 0x5a, 0x6a, 0x6c, 0xb4, 0x65, 0xd7, 0x1b, 0x2e, 0xcb, 0x57, 0xa1, 0x10, 0x74, 0xdf, 0x45,
+		0x2b, 0x90, 0xf3, 0xf8, 0x6f, 0x2e, 0x52, 0x79, 0xb4, 0x48, 0x05, 0x5b, 0x58, 0xac, 0x3e, 0xbb,
+		0xfc, 0x37, 0x44, 0xb2, 0x81, 0x1d, 0xf8, 0x5b, 0xa5, 0x50, 0x64, 0x81, 0xe3, 0x0b, 0xd0, 0xfd,
+		0x70, 0xe0, 0x38, 0x56, 0x9f, 0x68, 0x3f, 0x9a, 0x84, 0x99, 0x2d, 0x61, 0xa7, 0xfe, 0xd6, 0x4a,
+		0x62, 0x0b, 0xb2, 0xd8, 0xf4, 0x1c, 0x03, 0x8b, 0xfd, 0xda, 0x67, 0x46, 0x0d, 0xf8, 0x90, 0xbe,
+		0xd0, 0x3f, 0xe9, 0x1e, 0x1e, 0x7e, 0x81, 0xd5, 0x27, 0x8a, 0x5f, 0x4a, 0xc1, 0xdc, 0x28, 0x76,
+		0xf4, 0x38, 0x94, 0x75, 0x07, 0xd3, 0x02, 0x35, 0xb2, 0xbf, 0x50, 0x12, 0xc5, 0x7c, 0x65, 0x55,
+		0x80, 0xf8, 0xab, 0x44, 0xbb, 0x08, 0xe9, 0xbd, 0x39, 0xa8, 0xa5, 0x00, 0x81, 0xae, 0xad, 0x18,
+		0xca, 0xe2, 0x9b, 0x88, 0x6d, 0xad, 0xa3, 0x99, 0xfa, 0xbd, 0xb8, 0xf4, 0x83, 0x0b, 0xa1, 0xf8,
+		0xd0, 0xa2, 0xc6, 0x30, 0xd1, 0x4d, 0xc8, 0x0a, 0xf8, 0xf4, 0x7d, 0x80, 0x17, 0x60, 0xe8, 0x61,
+		0x98, 0x0a, 0xaf, 0x8f, 0xd4, 0x5d, 0x4b, 0x2b, 0x85, 0xd0, 0xf2, 0x18, 0xb7, 0x00, 0x67, 0x8e,
+		0x5c, 0x80, 0x43, 0x5e, 0xf1, 0xbf, 0x4a, 0xc1, 0xb4, 0x82, 0xdb, 0x7f, 0x07, 0x07, 0xef, 0x2b,
+		0x00, 0xd8, 0x04, 0x27, 0xc6, 0xf7, 0x1e, 0xc6, 0x6f, 0xd0, 0x60, 0xe4, 0x19, 0xde, 0xb2, 0xeb,
+		0x7d, 0x39, 0x47, 0xf0, 0x37, 0x93, 0x30, 0x15, 0x1e, 0xc1, 0xbf, 0x03, 0xab, 0x1d, 0x5a, 0x0f,
+		0xcc, 0x1b, 0xfb, 0x7a, 0xe1, 0x89, 0x51, 0xe6, 0x6d, 0x40, 0xb7, 0xc7, 0xb0, 0x6b, 0x6f, 0xe7,
+		0x21, 0xc3, 0xcf, 0xf7, 0x6d, 0x0c, 0xb8, 0xfd, 0x89, 0xb8, 0x8f, 0xd0, 0x8b, 0xe2, 0x23, 0xf4,
+		0xa1, 0x5e, 0xff, 0xa3, 0x50, 0xea, 0x6a, 0x07, 0x6a, 0xe4, 0xd0, 0x60, 0xe2, 0x6c, 0x91, 0x66,
+		0x0b, 0x82, 0x13, 0xf5, 0x68, 0x1e, 0x0a, 0x84, 0x2c, 0xb0, 0xe1, 0x84, 0x06, 0xba, 0xda, 0x41,
+		0x83, 0x95, 0xa0, 0xa7, 0x01, 0xed, 0xf9, 0x89, 0x20, 0x35, 0x10, 0x06, 0xa1, 0x9b, 0x0e, 0xde,
+		0x08, 0xf2, 0x87, 0x00, 0x48, 0x2b, 0x54, 0x76, 0xcd, 0x2c, 0xbf, 0xfb, 0x97, 0x94, 0x2c, 0xd3,
+		0xab, 0x66, 0xbf, 0x9a, 0x05, 0x0f, 0x7d, 0x79, 0x08, 0x1e, 0xc6, 0xad, 0x1e, 0x6f, 0x52, 0xfc,
+		0xe5, 0x3b, 0xf3, 0x95, 0x43, 0xad, 0xdb, 0x59, 0x92, 0x87, 0x40, 0xca, 0x34, 0x98, 0x88, 0xe6,
+		0x2f, 0x46, 0x85, 0x2e, 0xd9, 0x77, 0x27, 0x74, 0x79, 0x0e, 0x4e, 0x70, 0x3f, 0x5c, 0xb5, 0xf6,
+		0xb1, 0xd3, 0xd1, 0x6c, 0x71, 0x15, 0x41, 0x8e, 0x4e, 0xd2, 0x19, 0xe6, 0x96, 0x6f, 0xb0, 0x77,
+		0xfc, 0x3a, 0x82, 0x6f, 0x48, 0x00, 0xed, 0x8e, 0x8d, 0x1d, 0xde, 0xf2, 0x0e, 0x76, 0xdd, 0x81,
+		0x26, 0xe7, 0xef, 0x43, 0x93, 0xe7, 0xbb, 0x86, 0xb9, 0x19, 0xa9, 0xa6, 0xaf, 0x03, 0x6f, 0xc0,
+		0x83, 0x21, 0x17, 0x21, 0xd8, 0x5f, 0x23, 0x8d, 0x33, 0xac, 0x36, 0x0f, 0x25, 0xc7, 0xd7, 0xd0,
+		0x4a, 0xe0, 0x3d, 0x04, 0x60, 0x9b, 0x14, 0x0b, 0x75, 0xe0, 0xa1, 0xa0, 0x2e, 0x07, 0x7b, 0x86,
+		0x43, 0x2f, 0x4a, 0x57, 0x75, 0xcb, 0xea, 0xb4, 0xad, 0xdb, 0x26, 0x3f, 0x55, 0x3f, 0x7e, 0x65,
+		0xa7, 0x7d, 0x38, 0xc5, 0x47, 0xab, 0x73, 0x30, 0x74, 0x1b, 0x4e, 0xed, 0x76, 0xac, 0x6d, 0xad,
+		0xa3, 0x76, 0x8c, 0x0f, 0xf7, 0x8c, 0xb6, 0xca, 0x67, 0xb3, 0xaa, 0x6b, 0x36, 0x8d, 0x29, 0xbf,
+		0x54, 0x0b, 0x7d, 0x82, 0xc1, 0xaf, 0x52, 0xf4, 0x26, 0x03, 0xaf, 0x6b, 0x36, 0xfa, 0xbf, 0xc3,
+		0x22, 0x1d, 0x52, 0x77, 0xf1, 0x3e, 0xd4, 0x7d, 0xca, 0xaf, 0xa1, 0xbf, 0xfa, 0xa5, 0xb3, 0xc2,
+		0x98, 0xdf, 0xf9, 0xa3, 0x1f, 0x3f, 0x77, 0x3a, 0x84, 0x75, 0xe0, 0xa7, 0xc8, 0x99, 0x3d, 0x92,
+		0x7f, 0x33, 0x01, 0xa5, 0x1a, 0xd5, 0x4f, 0x45, 0xfc, 0xdd, 0xeb, 0xa1, 0x1e, 0x63, 0xe2, 0xd8,
+		0x1e, 0xe3, 0x02, 0x4c, 0xd9, 0x0e, 0xde, 0x57, 0x45, 0x8c, 0xca, 0x0e, 0xe3, 0x01, 0x29, 0x63,
+		0x15, 0x86, 0x03, 0xd8, 0x54, 0x38, 0x80, 0x0d, 0xed, 0x75, 0xa7, 0x23, 0x1b, 0xef, 0x8f, 0x40,
+		0x11, 0x1f, 0xd8, 0x86, 0x73, 0xa8, 0x46, 0xbe, 0x4e, 0x9a, 0x62, 0x85, 0xfc, 0x0c, 0xdf, 0x3f,
+		0x4a, 0xc1, 0xec, 0xcd, 0x21, 0x0a, 0x88, 0xae, 0x0f, 0x9e, 0x73, 0x38, 0x5e, 0x7a, 0x3a, 0x74,
+		0x34, 0x60, 0xe8, 0x92, 0x98, 0x3c, 0xf6, 0x92, 0xb8, 0x24, 0xce, 0xd8, 0x88, 0x2b, 0xdb, 0x86,
+		0xdc, 0x3b, 0xbe, 0x58, 0xb7, 0x8c, 0x48, 0x92, 0x86, 0x9f, 0xc4, 0x59, 0x86, 0x1c, 0x36, 0xdb,
+		0xea, 0x98, 0x27, 0x11, 0xfa, 0x5c, 0xa4, 0x2c, 0x36, 0xdb, 0x74, 0xf9, 0xb8, 0x04, 0x79, 0xcd,
+		0xb6, 0x1d, 0x6b, 0x5f, 0xa3, 0xa9, 0xce, 0xb8, 0x6c, 0x80, 0x20, 0x45, 0xcf, 0x40, 0x66, 0x1f,
+		0x7b, 0x16, 0xe6, 0x7f, 0xb9, 0xfd, 0x08, 0x26, 0x4e, 0x27, 0xff, 0x8b, 0x04, 0xcc, 0xdc, 0x1c,
+		0x9c, 0xab, 0xf7, 0x4b, 0xdd, 0xc2, 0xe2, 0x48, 0xde, 0xb3, 0x38, 0xe8, 0x67, 0x63, 0xa4, 0x69,
+		0x22, 0x4d, 0x2a, 0x1e, 0xe5, 0x4f, 0x25, 0x60, 0xa6, 0x65, 0xdd, 0xc2, 0xa6, 0xf1, 0x26, 0xa6,
+		0xf9, 0x43, 0x05, 0xeb, 0x96, 0xd3, 0x46, 0x25, 0x48, 0xf2, 0x7b, 0xbb, 0xd2, 0x4a, 0xd2, 0x68,
+		0xa3, 0x45, 0x98, 0xb4, 0x6e, 0x9b, 0xfc, 0xc0, 0xcf, 0x51, 0x5d, 0x60, 0x64, 0x74, 0xfd, 0xb6,
+		0xda, 0xbd, 0x0e, 0x56, 0x35, 0x9d, 0x39, 0x6a, 0x2c, 0x6b, 0x5d, 0x64, 0xa5, 0x55, 0x56, 0x48,
+		0xc6, 0x29, 0x50, 0xde, 0x74, 0x0c, 0x74, 0x40, 0x2a, 0xbf, 0x9d, 0x04, 0xe4, 0x4b, 0x9d, 0xcc,
+		0x72, 0xd6, 0xea, 0xfb, 0x24, 0xf4, 0x51, 0x37, 0xd9, 0x0d, 0x49, 0x93, 0xa5, 0xee, 0x4b, 0x9a,
+		0x2c, 0xfd, 0x25, 0xa5, 0xc9, 0x26, 0xc3, 0x56, 0x46, 0xfe, 0xb1, 0x04, 0xa0, 0x60, 0x15, 0xf4,
+		0x3f, 0x09, 0x5c, 0xa3, 0x1f, 0x8a, 0x89, 0x05, 0x38, 0x71, 0x74, 0x1a, 0x35, 0xe0, 0x8f, 0xa4,
+		0x51, 0x43, 0xce, 0xf3, 0xfb, 0x83, 0x90, 0x2e, 0x79, 0x8c, 0x49, 0x2e, 0x98, 0x7c, 0xbf, 0x7c,
+		0x42, 0x7e, 0x27, 0x01, 0xa7, 0x06, 0xbc, 0x4f, 0xbf, 0xd9, 0x3a, 0x20, 0x27, 0xf4, 0x92, 0x7a,
+		0x70, 0xe2, 0xc0, 0xda, 0xbd, 0x39, 0xb3, 0xd3, 0xce, 0x40, 0x18, 0xf7, 0x2e, 0xc5, 0xa7, 0xfc,
+		0xcf, 0x0c, 0xfc, 0x5a, 0x02, 0x66, 0xc3, 0x2d, 0xf2, 0xfb, 0xd6, 0x84, 0xa9, 0x70, 0x5b, 0x78,
+		0xaf, 0xde, 0x33, 0x4e, 0xaf, 0xc2, 0x1d, 0x8a, 0x80, 0x90, 0xbe, 0x08, 0x2f, 0x97, 0xed, 0x55,
+		0x3e, 0x3b, 0xb6, 0x94, 0xfc, 0x83, 0x1d, 0xc3, 0x5c, 0xff, 0x34, 0x1d, 0xac, 0x6f, 0x4a, 0x42,
+		0x7a, 0xd3, 0xb2, 0x3a, 0xe8, 0x6b, 0x13, 0x30, 0x6d, 0x5a, 0x9e, 0x4a, 0x7c, 0x63, 0xdc, 0x56,
+		0xf9, 0x7e, 0x09, 0x9b, 0x6a, 0x37, 0x8f, 0x27, 0xbd, 0x3f, 0x7e, 0x67, 0x7e, 0x10, 0x6a, 0xd8,
+		0xdf, 0x85, 0x28, 0x9b, 0x96, 0x57, 0xa3, 0x44, 0x2d, 0xb6, 0xa5, 0x72, 0x1b, 0x8a, 0xd1, 0xfa,
+		0x99, 0x71, 0x52, 0x8e, 0x5d, 0x7f, 0x31, 0xb6, 0xee, 0xa9, 0xed, 0x50, 0xc5, 0xec, 0x76, 0xf6,
+		0x3f, 0x27, 0x83, 0xfb, 0x1a, 0x48, 0x37, 0xfb, 0x3f, 0x2f, 0x68, 0x40, 0xf6, 0xb8, 0x5f, 0x2a,
+		0x84, 0x25, 0xce, 0x79, 0xcf, 0xfd, 0x74, 0x02, 0x20, 0xd8, 0x9d, 0x42, 0x4f, 0xc1, 0xc9, 0xda,
+		0xc6, 0xfa, 0xb2, 0xda, 0x6c, 0x55, 0x5b, 0x5b, 0xcd, 0xe8, 0xdf, 0x02, 0x12, 0x17, 0xe0, 0xb9,
+		0x36, 0xd6, 0x8d, 0x1d, 0x03, 0xb7, 0xd1, 0x63, 0x30, 0x1b, 0xa5, 0x26, 0x4f, 0x8d, 0x65, 0x29,
+		0x51, 0x99, 0xba, 0x73, 0x77, 0x21, 0xc7, 0xd2, 0x51, 0xb8, 0x8d, 0xce, 0xc2, 0x03, 0x83, 0x74,
+		0x2b, 0xeb, 0x57, 0xa5, 0x64, 0xa5, 0x78, 0xe7, 0xee, 0x42, 0xde, 0xcf, 0x5b, 0x21, 0x19, 0x50,
+		0x98, 0x92, 0xe3, 0xa5, 0x2a, 0x70, 0xe7, 0xee, 0x42, 0x86, 0x0d, 0x4b, 0x25, 0xfd, 0xd1, 0xef,
+		0x3d, 0x33, 0x71, 0xee, 0x2b, 0x01, 0x56, 0xcc, 0x1d, 0x47, 0xd3, 0xa9, 0x42, 0x56, 0xe0, 0xc4,
+		0xca, 0xfa, 0x15, 0xa5, 0x5a, 0x6f, 0xad, 0x6c, 0xac, 0xf7, 0xfd, 0x09, 0xa3, 0xe8, 0xbb, 0xe5,
+		0x8d, 0xad, 0xda, 0x6a, 0x43, 0x6d, 0xae, 0x5c, 0x5d, 0x67, 0x47, 0xe6, 0x22, 0xef, 0x5e, 0x59,
+		0x6f, 0xad, 0xac, 0x35, 0xa4, 0x64, 0xed, 0xca, 0xc8, 0x33, 0x16, 0x4f, 0x1d, 0x39, 0xe0, 0x81,
+		0x93, 0x18, 0x39, 0x68, 0xf1, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x04, 0x28, 0x7a, 0xc8, 0x16,
+		0xa5, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2327,6 +2830,24 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinSelfDelegation.Equal(that1.MinSelfDelegation) {
 		return false
 	}
+	if this.BlsKeyOverlapBlocks != that1.BlsKeyOverlapBlocks {
+		return false
+	}
+	if !this.MinPermissionlessSelfDelegation.Equal(that1.MinPermissionlessSelfDelegation) {
+		return false
+	}
+	if this.ValidatorApplicationPeriod != that1.ValidatorApplicationPeriod {
+		return false
+	}
+	if this.ValidatorRetirementCooldown != that1.ValidatorRetirementCooldown {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3253,26 +3774,77 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	{
-		size := m.MinSelfDelegation.Size()
+		size := m.ValidatorLiquidStakingCap.Size()
 		i -= size
-		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
+	dAtA[i] = 0x6a
 	{
-		size := m.MinCommissionRate.Size()
+		size := m.GlobalLiquidStakingCap.Size()
 		i -= size
-		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
-	if len(m.BondDenom) > 0 {
+	dAtA[i] = 0x62
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ValidatorRetirementCooldown, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ValidatorRetirementCooldown):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x5a
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ValidatorApplicationPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ValidatorApplicationPeriod):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MinPermissionlessSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinPermissionlessSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.BlsKeyOverlapBlocks != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.BlsKeyOverlapBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
 		i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenom)))
@@ -3294,18 +3866,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n14
+	i = encodeVarintStaking(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *BlsKeyRotation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3315,18 +3887,98 @@ func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlsKeyRotation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlsKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlsKey) > 0 {
+		i -= len(m.BlsKey)
+		copy(dAtA[i:], m.BlsKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.BlsKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PrevBlsKey) > 0 {
+		i -= len(m.PrevBlsKey)
+		copy(dAtA[i:], m.PrevBlsKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.PrevBlsKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Vetoes) > 0 {
+		for iNdEx := len(m.Vetoes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Vetoes[iNdEx])
+			copy(dAtA[i:], m.Vetoes[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.Vetoes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
 	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3334,9 +3986,16 @@ func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3348,7 +4007,7 @@ func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorRetirement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3358,40 +4017,45 @@ func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorRetirement) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorRetirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Balance.Size()
-		i -= size
-		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Retired {
+		i--
+		if m.Retired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err18 != nil {
+		return 0, err18
 	}
+	i -= n18
+	i = encodeVarintStaking(dAtA, i, uint64(n18))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3401,44 +4065,46 @@ func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
 	}
-	{
-		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorKeyRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3448,32 +4114,88 @@ func (m *Pool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorKeyRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorKeyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlsKey) > 0 {
+		i -= len(m.BlsKey)
+		copy(dAtA[i:], m.BlsKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.BlsKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChallengerAddress) > 0 {
+		i -= len(m.ChallengerAddress)
+		copy(dAtA[i:], m.ChallengerAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ChallengerAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RelayerAddress) > 0 {
+		i -= len(m.RelayerAddress)
+		copy(dAtA[i:], m.RelayerAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.RelayerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.BondedTokens.Size()
-		i -= size
-		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
 	{
-		size := m.NotBondedTokens.Size()
-		i -= size
-		if _, err := m.NotBondedTokens.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
@@ -3481,7 +4203,7 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
+func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3491,17 +4213,150 @@ func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
+func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Updates) > 0 {
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NotBondedTokens.Size()
+		i -= size
+		if _, err := m.NotBondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
 		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
@@ -3892,6 +4747,147 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.MinSelfDelegation.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.BlsKeyOverlapBlocks != 0 {
+		n += 1 + sovStaking(uint64(m.BlsKeyOverlapBlocks))
+	}
+	l = m.MinPermissionlessSelfDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ValidatorApplicationPeriod)
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ValidatorRetirementCooldown)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *BlsKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.PrevBlsKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovStaking(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *ValidatorApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if len(m.Vetoes) > 0 {
+		for _, s := range m.Vetoes {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorRetirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.Retired {
+		n += 2
+	}
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorKeyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = len(m.RelayerAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ChallengerAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -4172,7 +5168,1135 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types1.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnbondingIds = append(m.UnbondingIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStaking
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStaking
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnbondingIds) == 0 {
+					m.UnbondingIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStaking
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnbondingIds = append(m.UnbondingIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelfDelAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsKey = append(m.BlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsKey == nil {
+				m.BlsKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4200,13 +6324,11 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4234,9 +6356,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4259,7 +6379,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commission) Unmarshal(dAtA []byte) error {
+func (m *DVPairs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4282,48 +6402,15 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4350,7 +6437,8 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Pairs = append(m.Pairs, DVPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4375,7 +6463,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4398,15 +6486,15 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4434,11 +6522,11 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4466,75 +6554,11 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Website = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4562,7 +6586,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4585,7 +6609,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4608,47 +6632,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4675,55 +6667,64 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusPubkey == nil {
-				m.ConsensusPubkey = &types1.Any{}
-			}
-			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Triplets = append(m.Triplets, DVVTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
 			}
-			m.Jailed = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= BondStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4751,13 +6752,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4785,15 +6784,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4803,49 +6800,81 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.UnbondingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
 			}
-		case 9:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4855,30 +6884,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4888,30 +6916,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4921,126 +6948,100 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.UnbondingOnHoldRefCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
 			}
-		case 13:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStaking
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.UnbondingIds = append(m.UnbondingIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStaking
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStaking
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthStaking
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.UnbondingIds) == 0 {
-					m.UnbondingIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStaking
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.UnbondingIds = append(m.UnbondingIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
 			}
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5050,27 +7051,28 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SelfDelAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5098,11 +7100,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5130,13 +7134,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
 			}
-			var byteLen int
+			m.UnbondingId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5146,26 +7152,30 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.UnbondingId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
 			}
-			m.BlsKey = append(m.BlsKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlsKey == nil {
-				m.BlsKey = []byte{}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5187,7 +7197,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5210,15 +7220,101 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5246,8 +7342,48 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5269,7 +7405,7 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVPair) Unmarshal(dAtA []byte) error {
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5292,10 +7428,10 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5332,7 +7468,7 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5360,61 +7496,43 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVPairs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5441,8 +7559,8 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, DVPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5467,7 +7585,7 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5490,17 +7608,17 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5510,27 +7628,85 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5558,11 +7734,11 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5590,63 +7766,68 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKeyOverlapBlocks", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BlsKeyOverlapBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlsKeyOverlapBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPermissionlessSelfDelegation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5656,81 +7837,64 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Triplets = append(m.Triplets, DVVTriplet{})
-			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinPermissionlessSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorApplicationPeriod", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Delegation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ValidatorApplicationPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRetirementCooldown", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5740,27 +7904,28 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ValidatorRetirementCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5788,11 +7953,13 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5820,7 +7987,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5845,7 +8012,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *BlsKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5868,15 +8035,15 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlsKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlsKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5904,13 +8071,13 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevBlsKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5920,29 +8087,31 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.PrevBlsKey = append(m.PrevBlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevBlsKey == nil {
+				m.PrevBlsKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5952,26 +8121,64 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlsKey = append(m.BlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsKey == nil {
+				m.BlsKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5993,7 +8200,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *ValidatorApplication) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6016,17 +8223,17 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorApplication: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorApplication: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.CreationHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6036,16 +8243,30 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6055,30 +8276,29 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6088,31 +8308,30 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6122,31 +8341,30 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
 			}
-			m.UnbondingId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6156,16 +8374,29 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoes", wireType)
 			}
-			m.UnbondingOnHoldRefCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6175,11 +8406,24 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vetoes = append(m.Vetoes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6201,7 +8445,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRetirement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6224,67 +8468,15 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRetirement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRetirement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6312,15 +8504,13 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6330,50 +8520,30 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
-			}
-			m.UnbondingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retired", wireType)
 			}
-			m.UnbondingOnHoldRefCount = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6383,11 +8553,12 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Retired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6409,7 +8580,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6432,17 +8603,17 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6452,27 +8623,14 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6500,11 +8658,11 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6532,13 +8690,13 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6548,25 +8706,23 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6589,7 +8745,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ValidatorKeyRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6612,17 +8768,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorKeyRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorKeyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6632,30 +8788,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.MaxValidators = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6665,52 +8820,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
-			}
-			m.MaxEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
-			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6738,11 +8855,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6770,15 +8887,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6788,24 +8903,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlsKey = append(m.BlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsKey == nil {
+				m.BlsKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
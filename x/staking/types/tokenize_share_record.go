@@ -0,0 +1,81 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// TokenizeShareDenomPrefix is the prefix of the denoms of the share tokens
+	TokenizeShareDenomPrefix = "share"
+
+	// TokenizeShareModuleAccountPrefix is the prefix of the names the addresses of the tokenize share
+	// record accounts are derived from
+	TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+)
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+//
+//nolint:interfacer
+func NewTokenizeShareRecord(id uint64, owner, validator sdk.AccAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     validator.String(),
+	}
+}
+
+// GetModuleAddress returns the address of the account holding the tokenized delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return address.Module(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return GetTokenizeShareDenom(sdk.MustAccAddressFromHex(r.Validator), r.Id)
+}
+
+// Validate performs a stateless validation of the record.
+func (r TokenizeShareRecord) Validate() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(r.Owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromHexUnsafe(r.Validator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if r.ModuleAccount != fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, r.Id) {
+		return fmt.Errorf("invalid module account of tokenize share record %d: %s", r.Id, r.ModuleAccount)
+	}
+
+	return nil
+}
+
+// GetTokenizeShareDenom returns the denom of the share tokens of the tokenize share record with the
+// validator and id, e.g. share/<validator hex address>/<id>.
+//
+//nolint:interfacer
+func GetTokenizeShareDenom(validator sdk.AccAddress, id uint64) string {
+	return fmt.Sprintf("%s/%s/%d", TokenizeShareDenomPrefix, hex.EncodeToString(validator), id)
+}
+
+// ParseTokenizeShareRecordID parses the id of the tokenize share record from the denom of its share tokens.
+func ParseTokenizeShareRecordID(denom string) (uint64, error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 3 || parts[0] != TokenizeShareDenomPrefix {
+		return 0, sdkerrors.Wrapf(ErrInvalidTokenizedShares, "invalid share token denom: %s", denom)
+	}
+
+	id, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(ErrInvalidTokenizedShares, "invalid share token denom: %s", denom)
+	}
+
+	return id, nil
+}
@@ -601,6 +601,11 @@ func (v Validator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(v.ConsensusPubkey, &pk)
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a ValidatorApplication) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return a.Validator.UnpackInterfaces(unpacker)
+}
+
 func (v Validator) GetSelfDelegator() sdk.AccAddress {
 	if v.SelfDelAddress == "" {
 		return nil
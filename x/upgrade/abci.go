@@ -21,9 +21,20 @@ import (
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
 // a migration to be executed if needed upon this switch (migration defined in the new binary)
 // skipUpgradeHeightArray is a set of block heights for which the upgrade must be skipped
+//
+// If the upgrade schedule of the node differs from the canonical schedule published by governance,
+// the mismatch is logged at every block, and the node halts when the plans of the schedules due at
+// the block differ so that it never forks away from the other validators.
 func BeginBlocker(k *keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	if err := k.VerifyUpgradeSchedule(ctx); err != nil {
+		if k.IsDuePlanMismatch(ctx) {
+			panic(BuildScheduleMismatchMsg(ctx.BlockHeight(), err))
+		}
+		ctx.Logger().Error("the upgrade schedule differs from the canonical one, please check the upgrade config in app.toml", "err", err)
+	}
+
 	plans, found := k.GetUpgradePlan(ctx)
 
 	if !found {
@@ -51,3 +62,8 @@ func BeginBlocker(k *keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 func BuildUpgradeNeededMsg(plan types.Plan) string {
 	return fmt.Sprintf("UPGRADE \"%s\" NEEDED at %s: %s", plan.Name, plan.DueAt(), plan.Info)
 }
+
+// BuildScheduleMismatchMsg prints the message that notifies that the upgrade schedule mismatches.
+func BuildScheduleMismatchMsg(height int64, err error) string {
+	return fmt.Sprintf("UPGRADE SCHEDULE MISMATCH at height: %d: %s", height, err)
+}
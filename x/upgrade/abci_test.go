@@ -17,6 +17,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
@@ -49,7 +51,7 @@ func setupTest(t *testing.T, height int64) *TestSuite {
 		s.encCfg.TxConfig.TxDecoder(),
 	)
 
-	s.keeper = keeper.NewKeeper(key, s.encCfg.Codec, t.TempDir(), nil)
+	s.keeper = keeper.NewKeeper(key, s.encCfg.Codec, t.TempDir(), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	s.keeper.SetVersionSetter(s.baseApp)
 
 	s.ctx = testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now(), Height: height})
@@ -165,6 +167,38 @@ func TestHaltIfTooNew(t *testing.T) {
 	VerifyCleared(t, futCtx)
 }
 
+func TestHaltOnScheduleMismatch(t *testing.T) {
+	s := setupTest(t, 10)
+	err := s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1})
+	require.NoError(t, err)
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+
+	err = s.keeper.SetUpgradeSchedule(s.ctx, []types.Plan{{Name: "test", Height: s.ctx.BlockHeight() + 2}})
+	require.NoError(t, err)
+
+	t.Log("Verify that no panic happens before any plan is due")
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(s.ctx, abci.RequestBeginBlock{Header: s.ctx.BlockHeader()})
+	})
+
+	t.Log("Verify that a panic happens when the plan of the node is due")
+	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	require.Panics(t, func() {
+		s.module.BeginBlock(newCtx, abci.RequestBeginBlock{Header: newCtx.BlockHeader()})
+	})
+	VerifyNotDone(t, newCtx, "test")
+
+	t.Log("Verify that the upgrade is applied once the schedules match")
+	err = s.keeper.SetUpgradeSchedule(s.ctx, []types.Plan{{Name: "test", Height: s.ctx.BlockHeight() + 1}})
+	require.NoError(t, err)
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx, abci.RequestBeginBlock{Header: newCtx.BlockHeader()})
+	})
+	VerifyDone(t, newCtx, "test")
+}
+
 func VerifyCleared(t *testing.T, newCtx sdk.Context) {
 	t.Log("Verify that the upgrade plan has been cleared")
 	plan, _ := s.keeper.GetUpgradePlan(newCtx)
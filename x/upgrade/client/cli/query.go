@@ -22,6 +22,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetUpgradeScheduleCmd(),
 	)
 
 	return cmd
@@ -161,3 +162,32 @@ func GetModuleVersionsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetUpgradeScheduleCmd returns the effective upgrade schedule of the node
+func GetUpgradeScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "get the effective upgrade schedule",
+		Long: "Gets the pending upgrade plans merged from the compiled config and app.toml of the node,\n" +
+			"and checks their hash against the canonical schedule published by governance.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UpgradeSchedule(cmd.Context(), &types.QueryUpgradeScheduleRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
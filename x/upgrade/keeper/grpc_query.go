@@ -71,3 +71,16 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 		ModuleVersions: mv,
 	}, nil
 }
+
+// UpgradeSchedule implements the Query/UpgradeSchedule gRPC method
+func (k Keeper) UpgradeSchedule(c context.Context, req *types.QueryUpgradeScheduleRequest) (*types.QueryUpgradeScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	local, canonical := k.GetScheduleHashes(ctx)
+	return &types.QueryUpgradeScheduleResponse{
+		Plans:                 k.GetEffectivePlans(ctx),
+		ScheduleHash:          local,
+		CanonicalScheduleHash: canonical,
+		Matched:               k.VerifyUpgradeSchedule(ctx) == nil,
+	}, nil
+}
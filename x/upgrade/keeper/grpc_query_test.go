@@ -12,6 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 
 	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
@@ -32,7 +34,7 @@ func (suite *UpgradeTestSuite) SetupTest() {
 	key := sdk.NewKVStoreKey(types.StoreKey)
 	testCtx := testutil.DefaultContextWithDB(suite.T(), key, sdk.NewTransientStoreKey("transient_test"))
 	suite.ctx = testCtx.Ctx
-	suite.upgradeKeeper = keeper.NewKeeper(key, suite.encCfg.Codec, "", nil)
+	suite.upgradeKeeper = keeper.NewKeeper(key, suite.encCfg.Codec, "", nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	suite.upgradeKeeper.SetModuleVersionMap(suite.ctx, module.VersionMap{
 		"bank": 0,
 	})
@@ -213,6 +215,24 @@ func (suite *UpgradeTestSuite) TestModuleVersions() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestUpgradeSchedule() {
+	plan := types.Plan{Name: "test-plan", Height: 5}
+	suite.Require().NoError(suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, plan))
+
+	res, err := suite.queryClient.UpgradeSchedule(context.Background(), &types.QueryUpgradeScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.Plan{&plan}, res.Plans)
+	suite.Require().Equal(types.ScheduleHash([]*types.Plan{&plan}), res.ScheduleHash)
+	suite.Require().Empty(res.CanonicalScheduleHash)
+	suite.Require().True(res.Matched)
+
+	suite.Require().NoError(suite.upgradeKeeper.SetUpgradeSchedule(suite.ctx, []types.Plan{{Name: "test-plan", Height: 6}}))
+	res, err = suite.queryClient.UpgradeSchedule(context.Background(), &types.QueryUpgradeScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(res.ScheduleHash, res.CanonicalScheduleHash)
+	suite.Require().False(res.Matched)
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
@@ -32,6 +32,7 @@ type Keeper struct {
 	upgradeConfig      *types.UpgradeConfig                // upgrade config for upcoming upgrade plan or upgraded plan
 	versionSetter      xp.ProtocolVersionSetter            // implements setting the protocol version field on BaseApp
 	initVersionMap     module.VersionMap
+	authority          string // the address capable of publishing the upgrade schedule
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -40,7 +41,8 @@ type Keeper struct {
 // cdc - the app-wide binary codec
 // homePath - root directory of the application's config
 // vs - the interface implemented by baseapp which allows setting baseapp's protocol version field
-func NewKeeper(storeKey storetypes.StoreKey, cdc codec.BinaryCodec, homePath string, vs xp.ProtocolVersionSetter) *Keeper {
+// authority - the address capable of publishing the upgrade schedule
+func NewKeeper(storeKey storetypes.StoreKey, cdc codec.BinaryCodec, homePath string, vs xp.ProtocolVersionSetter, authority string) *Keeper {
 	return &Keeper{
 		homePath:           homePath,
 		storeKey:           storeKey,
@@ -49,9 +51,15 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.BinaryCodec, homePath str
 		upgradeInitializer: make(map[string]types.UpgradeInitializer),
 		upgradeConfig:      types.NewUpgradeConfig(),
 		versionSetter:      vs,
+		authority:          authority,
 	}
 }
 
+// GetAuthority returns the x/upgrade module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetVersionSetter sets the interface implemented by baseapp which allows setting baseapp's protocol version field
 func (k *Keeper) SetVersionSetter(vs xp.ProtocolVersionSetter) {
 	k.versionSetter = vs
@@ -459,6 +467,17 @@ func (keeper *Keeper) RegisterUpgradePlan(chianID string, plans []serverconfig.U
 	return nil
 }
 
+// GetUpgradeHeight returns the height of the registered upgrade plan of the name, it's used to set
+// the store loader of the upgrade before the app loads the latest version.
+func (k Keeper) GetUpgradeHeight(name string) (int64, bool) {
+	for _, plan := range k.upgradeConfig.Plans() {
+		if plan.Name == name {
+			return plan.Height, true
+		}
+	}
+	return 0, false
+}
+
 // getExistChainConfig returns the exist chain config
 func getExistChainConfig(chainID string) *types.UpgradeConfig {
 	switch chainID {
@@ -10,12 +10,15 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -47,7 +50,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	)
 
 	homeDir := filepath.Join(s.T().TempDir(), "x_upgrade_keeper_test")
-	s.upgradeKeeper = keeper.NewKeeper(key, s.encCfg.Codec, homeDir, s.baseApp)
+	s.upgradeKeeper = keeper.NewKeeper(key, s.encCfg.Codec, homeDir, s.baseApp, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	vs := s.upgradeKeeper.GetVersionSetter()
 	s.Require().Equal(vs, s.baseApp)
@@ -275,6 +278,76 @@ func (s *KeeperTestSuite) TestLastCompletedUpgradeOrdering() {
 	require.Equal(int64(15), height)
 }
 
+func (s *KeeperTestSuite) TestUpgradeSchedule() {
+	require := s.Require()
+	keeper := s.upgradeKeeper
+
+	// no schedule has been published
+	require.NoError(keeper.VerifyUpgradeSchedule(s.ctx))
+	_, canonical := keeper.GetScheduleHashes(s.ctx)
+	require.Nil(canonical)
+
+	require.NoError(keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test-v0.9", Height: 20}))
+	require.NoError(keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test-v0.10", Height: 30}))
+
+	// the order of the plans and the info do not matter
+	require.NoError(keeper.SetUpgradeSchedule(s.ctx, []types.Plan{
+		{Name: "test-v0.10", Height: 30, Info: "info"},
+		{Name: "test-v0.9", Height: 20},
+	}))
+	require.NoError(keeper.VerifyUpgradeSchedule(s.ctx))
+	local, canonical := keeper.GetScheduleHashes(s.ctx)
+	require.Equal(local, canonical)
+
+	// the height of a plan differs
+	require.NoError(keeper.SetUpgradeSchedule(s.ctx, []types.Plan{
+		{Name: "test-v0.9", Height: 20},
+		{Name: "test-v0.10", Height: 31},
+	}))
+	require.ErrorIs(keeper.VerifyUpgradeSchedule(s.ctx), types.ErrUpgradeScheduleMismatch)
+	require.False(keeper.IsDuePlanMismatch(s.ctx))
+	require.False(keeper.IsDuePlanMismatch(s.ctx.WithBlockHeight(20)))
+	require.True(keeper.IsDuePlanMismatch(s.ctx.WithBlockHeight(30)))
+	require.True(keeper.IsDuePlanMismatch(s.ctx.WithBlockHeight(31)))
+
+	// a plan missing from the canonical schedule only mismatches at its height
+	require.NoError(keeper.SetUpgradeSchedule(s.ctx, []types.Plan{{Name: "test-v0.9", Height: 20}}))
+	require.ErrorIs(keeper.VerifyUpgradeSchedule(s.ctx), types.ErrUpgradeScheduleMismatch)
+	require.False(keeper.IsDuePlanMismatch(s.ctx.WithBlockHeight(20)))
+	require.True(keeper.IsDuePlanMismatch(s.ctx.WithBlockHeight(30)))
+
+	// invalid schedules
+	require.Error(keeper.SetUpgradeSchedule(s.ctx, []types.Plan{{Name: "test-v0.9", Height: 20}, {Name: "test-v0.9", Height: 21}}))
+	require.ErrorIs(keeper.SetUpgradeSchedule(s.ctx, []types.Plan{{Name: "test-v0.8", Height: 5}}), types.ErrUpgradeScheduled)
+
+	// the applied plans are not compared
+	require.NoError(keeper.SetUpgradeSchedule(s.ctx, []types.Plan{{Name: "test-v0.9", Height: 20}}))
+	keeper.SetUpgradeHandler("test-v0.9", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	keeper.ApplyUpgrade(s.ctx.WithBlockHeight(20), types.Plan{Name: "test-v0.9", Height: 20})
+	require.ErrorIs(keeper.VerifyUpgradeSchedule(s.ctx.WithBlockHeight(21)), types.ErrUpgradeScheduleMismatch)
+	require.NoError(keeper.SetUpgradeSchedule(s.ctx.WithBlockHeight(21), []types.Plan{
+		{Name: "test-v0.9", Height: 20},
+		{Name: "test-v0.10", Height: 30},
+	}))
+	require.NoError(keeper.VerifyUpgradeSchedule(s.ctx.WithBlockHeight(21)))
+}
+
+func (s *KeeperTestSuite) TestGetUpgradeHeight() {
+	require := s.Require()
+	keeper := s.upgradeKeeper
+
+	require.NoError(keeper.RegisterUpgradePlan(s.ctx.ChainID(), []serverconfig.UpgradeConfig{
+		{Name: "test-v0.9", Height: 20},
+	}))
+	height, found := keeper.GetUpgradeHeight("test-v0.9")
+	require.True(found)
+	require.Equal(int64(20), height)
+	_, found = keeper.GetUpgradeHeight("test-v0.10")
+	require.False(found)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
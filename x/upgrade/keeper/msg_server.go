@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the upgrade MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k *Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// UpdateUpgradeSchedule implements the Msg/UpdateUpgradeSchedule Msg service.
+func (k msgServer) UpdateUpgradeSchedule(goCtx context.Context, req *types.MsgUpdateUpgradeSchedule) (*types.MsgUpdateUpgradeScheduleResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !ctx.IsUpgraded(types.Nagqu) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "upgrade schedule is not enabled")
+	}
+	if err := k.SetUpgradeSchedule(ctx, req.Plans); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateUpgradeSchedule,
+			sdk.NewAttribute(types.AttributeKeyPlanCount, fmt.Sprintf("%d", len(req.Plans))),
+		),
+	)
+	return &types.MsgUpdateUpgradeScheduleResponse{}, nil
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func (s *KeeperTestSuite) TestMsgUpdateUpgradeSchedule() {
+	authority := s.upgradeKeeper.GetAuthority()
+	plans := []types.Plan{{Name: "test", Height: 100}}
+	msgSrvr := keeper.NewMsgServerImpl(s.upgradeKeeper)
+
+	upgradedCtx := sdk.NewContext(s.ctx.MultiStore(), s.ctx.BlockHeader(), false, func(_ sdk.Context, name string) bool {
+		return name == types.Nagqu
+	}, s.ctx.Logger())
+
+	testCases := []struct {
+		name      string
+		ctx       sdk.Context
+		input     *types.MsgUpdateUpgradeSchedule
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			ctx:       upgradedCtx,
+			input:     types.NewMsgUpdateUpgradeSchedule(s.addrs[0].String(), plans),
+			expErrMsg: "expected " + authority,
+		},
+		{
+			name:      "not upgraded",
+			ctx:       s.ctx,
+			input:     types.NewMsgUpdateUpgradeSchedule(authority, plans),
+			expErrMsg: "upgrade schedule is not enabled",
+		},
+		{
+			name:      "plan in the past",
+			ctx:       upgradedCtx,
+			input:     types.NewMsgUpdateUpgradeSchedule(authority, []types.Plan{{Name: "test", Height: 5}}),
+			expErrMsg: "upgrade cannot be scheduled in the past",
+		},
+		{
+			name:  "valid",
+			ctx:   upgradedCtx,
+			input: types.NewMsgUpdateUpgradeSchedule(authority, plans),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.Require().NoError(tc.input.ValidateBasic())
+
+			_, err := msgSrvr.UpdateUpgradeSchedule(tc.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	schedule, found := s.upgradeKeeper.GetUpgradeSchedule(s.ctx)
+	s.Require().True(found)
+	s.Require().Equal([]*types.Plan{&plans[0]}, schedule)
+
+	// the plans must be valid and unique
+	s.Require().Error(types.NewMsgUpdateUpgradeSchedule(authority, []types.Plan{{Name: "test"}}).ValidateBasic())
+	s.Require().ErrorIs(types.NewMsgUpdateUpgradeSchedule(authority, append(plans, plans...)).ValidateBasic(), types.ErrInvalidUpgradeSchedule)
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// SetUpgradeSchedule publishes the canonical upgrade schedule, which replaces the previous one.
+// The nodes whose effective schedule differs from it halt before forking.
+func (k Keeper) SetUpgradeSchedule(ctx sdk.Context, plans []types.Plan) error {
+	names := make(map[string]bool, len(plans))
+	for _, plan := range plans {
+		if err := plan.ValidateBasic(); err != nil {
+			return err
+		}
+		if names[plan.Name] {
+			return errors.Wrapf(types.ErrInvalidUpgradeSchedule, "duplicate plan name %s", plan.Name)
+		}
+		names[plan.Name] = true
+
+		if plan.Height < ctx.BlockHeight() && k.GetDoneHeight(ctx, plan.Name) == 0 {
+			return errors.Wrapf(types.ErrUpgradeScheduled, "plan %s at height %d", plan.Name, plan.Height)
+		}
+	}
+
+	schedule := types.UpgradeSchedule{Plans: plans}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte{types.UpgradeScheduleByte}, k.cdc.MustMarshal(&schedule))
+	return nil
+}
+
+// GetUpgradeSchedule returns the canonical upgrade schedule, and false if it has not been published
+func (k Keeper) GetUpgradeSchedule(ctx sdk.Context) ([]*types.Plan, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte{types.UpgradeScheduleByte})
+	if bz == nil {
+		return nil, false
+	}
+
+	var schedule types.UpgradeSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+
+	plans := make([]*types.Plan, 0, len(schedule.Plans))
+	for i := range schedule.Plans {
+		plans = append(plans, &schedule.Plans[i])
+	}
+	return plans, true
+}
+
+// GetEffectivePlans returns the pending plans merged from the compiled config and the app config of the node
+func (k Keeper) GetEffectivePlans(ctx sdk.Context) []*types.Plan {
+	return k.pendingPlans(ctx, k.upgradeConfig.Plans())
+}
+
+// pendingPlans filters out the plans which are applied or in the past, so that the schedules
+// published before or after the executed upgrades are compared with the same plans
+func (k Keeper) pendingPlans(ctx sdk.Context, plans []*types.Plan) []*types.Plan {
+	pending := make([]*types.Plan, 0, len(plans))
+	for _, plan := range plans {
+		if plan.Height < ctx.BlockHeight() || k.GetDoneHeight(ctx, plan.Name) != 0 {
+			continue
+		}
+		pending = append(pending, plan)
+	}
+	types.SortPlans(pending)
+	return pending
+}
+
+// GetScheduleHashes returns the hash of the effective schedule of the node and the hash of the canonical schedule,
+// the latter is nil if no schedule has been published.
+func (k Keeper) GetScheduleHashes(ctx sdk.Context) ([]byte, []byte) {
+	local := types.ScheduleHash(k.GetEffectivePlans(ctx))
+
+	canonical, found := k.GetUpgradeSchedule(ctx)
+	if !found {
+		return local, nil
+	}
+	return local, types.ScheduleHash(k.pendingPlans(ctx, canonical))
+}
+
+// VerifyUpgradeSchedule checks the effective schedule of the node against the canonical schedule,
+// it returns nil if no schedule has been published.
+func (k Keeper) VerifyUpgradeSchedule(ctx sdk.Context) error {
+	local, canonical := k.GetScheduleHashes(ctx)
+	if canonical == nil || bytes.Equal(local, canonical) {
+		return nil
+	}
+	return errors.Wrap(types.ErrUpgradeScheduleMismatch, fmt.Sprintf("local %X, canonical %X", local, canonical))
+}
+
+// IsDuePlanMismatch returns true if the plans of the effective schedule due at the current height differ from
+// the ones of the canonical schedule, i.e. the node would fork away from the other validators at the height.
+// It returns false if no schedule has been published.
+func (k Keeper) IsDuePlanMismatch(ctx sdk.Context) bool {
+	canonical, found := k.GetUpgradeSchedule(ctx)
+	if !found {
+		return false
+	}
+
+	local := duePlans(ctx, k.GetEffectivePlans(ctx))
+	return !bytes.Equal(types.ScheduleHash(local), types.ScheduleHash(duePlans(ctx, k.pendingPlans(ctx, canonical))))
+}
+
+// duePlans returns the plans due at the current height
+func duePlans(ctx sdk.Context, plans []*types.Plan) []*types.Plan {
+	due := make([]*types.Plan, 0, len(plans))
+	for _, plan := range plans {
+		if plan.Height == ctx.BlockHeight() {
+			due = append(due, plan)
+		}
+	}
+	return due
+}
@@ -10,4 +10,8 @@ var (
 	ErrUpgradeScheduled = errors.Register(ModuleName, 2, "upgrade cannot be scheduled in the past")
 	// ErrUpgradeCompleted error if the upgrade has already been completed
 	ErrUpgradeCompleted = errors.Register(ModuleName, 3, "upgrade has already been completed")
+	// ErrUpgradeScheduleMismatch error if the upgrade schedule of the node differs from the canonical one
+	ErrUpgradeScheduleMismatch = errors.Register(ModuleName, 4, "upgrade schedule mismatch")
+	// ErrInvalidUpgradeSchedule error if the upgrade schedule is invalid
+	ErrInvalidUpgradeSchedule = errors.Register(ModuleName, 5, "invalid upgrade schedule")
 )
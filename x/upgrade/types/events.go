@@ -0,0 +1,8 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeUpdateUpgradeSchedule = "update_upgrade_schedule"
+
+	AttributeKeyPlanCount = "plan_count"
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdateUpgradeSchedule{}
+
+// NewMsgUpdateUpgradeSchedule creates a new MsgUpdateUpgradeSchedule instance
+func NewMsgUpdateUpgradeSchedule(authority string, plans []Plan) *MsgUpdateUpgradeSchedule {
+	return &MsgUpdateUpgradeSchedule{
+		Authority: authority,
+		Plans:     plans,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateUpgradeSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	names := make(map[string]bool, len(msg.Plans))
+	for _, plan := range msg.Plans {
+		if err := plan.ValidateBasic(); err != nil {
+			return err
+		}
+		if names[plan.Name] {
+			return errorsmod.Wrapf(ErrInvalidUpgradeSchedule, "duplicate plan name %s", plan.Name)
+		}
+		names[plan.Name] = true
+	}
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUpdateUpgradeSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateUpgradeSchedule.
+func (msg MsgUpdateUpgradeSchedule) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromHexUnsafe(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
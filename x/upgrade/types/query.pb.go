@@ -406,6 +406,119 @@ func (m *QueryModuleVersionsResponse) GetModuleVersions() []*ModuleVersion {
 	return nil
 }
 
+// QueryUpgradeScheduleRequest is the request type for the Query/UpgradeSchedule
+// RPC method.
+type QueryUpgradeScheduleRequest struct {
+}
+
+func (m *QueryUpgradeScheduleRequest) Reset()         { *m = QueryUpgradeScheduleRequest{} }
+func (m *QueryUpgradeScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeScheduleRequest) ProtoMessage()    {}
+func (*QueryUpgradeScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{8}
+}
+func (m *QueryUpgradeScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeScheduleRequest.Merge(m, src)
+}
+func (m *QueryUpgradeScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeScheduleRequest proto.InternalMessageInfo
+
+// QueryUpgradeScheduleResponse is the response type for the Query/UpgradeSchedule
+// RPC method.
+type QueryUpgradeScheduleResponse struct {
+	// plans are the pending plans of the schedule merged from the compiled config and app.toml.
+	Plans []*Plan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	// schedule_hash is the hash of the pending plans of the node.
+	ScheduleHash []byte `protobuf:"bytes,2,opt,name=schedule_hash,json=scheduleHash,proto3" json:"schedule_hash,omitempty"`
+	// canonical_schedule_hash is the hash of the pending plans published by governance,
+	// it's empty if no schedule has been published.
+	CanonicalScheduleHash []byte `protobuf:"bytes,3,opt,name=canonical_schedule_hash,json=canonicalScheduleHash,proto3" json:"canonical_schedule_hash,omitempty"`
+	// matched is true if no schedule has been published or the hashes are equal.
+	Matched bool `protobuf:"varint,4,opt,name=matched,proto3" json:"matched,omitempty"`
+}
+
+func (m *QueryUpgradeScheduleResponse) Reset()         { *m = QueryUpgradeScheduleResponse{} }
+func (m *QueryUpgradeScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeScheduleResponse) ProtoMessage()    {}
+func (*QueryUpgradeScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{9}
+}
+func (m *QueryUpgradeScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeScheduleResponse.Merge(m, src)
+}
+func (m *QueryUpgradeScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeScheduleResponse) GetPlans() []*Plan {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
+func (m *QueryUpgradeScheduleResponse) GetScheduleHash() []byte {
+	if m != nil {
+		return m.ScheduleHash
+	}
+	return nil
+}
+
+func (m *QueryUpgradeScheduleResponse) GetCanonicalScheduleHash() []byte {
+	if m != nil {
+		return m.CanonicalScheduleHash
+	}
+	return nil
+}
+
+func (m *QueryUpgradeScheduleResponse) GetMatched() bool {
+	if m != nil {
+		return m.Matched
+	}
+	return false
+}
+
 // QueryAuthorityRequest is the request type for Query/Authority
 //
 // Since: cosmos-sdk 0.46
@@ -416,7 +529,7 @@ func (m *QueryAuthorityRequest) Reset()         { *m = QueryAuthorityRequest{} }
 func (m *QueryAuthorityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthorityRequest) ProtoMessage()    {}
 func (*QueryAuthorityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{8}
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
 }
 func (m *QueryAuthorityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +569,7 @@ func (m *QueryAuthorityResponse) Reset()         { *m = QueryAuthorityResponse{}
 func (m *QueryAuthorityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthorityResponse) ProtoMessage()    {}
 func (*QueryAuthorityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{9}
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
 }
 func (m *QueryAuthorityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,6 +614,8 @@ func init() {
 	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradedConsensusStateResponse")
 	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsRequest")
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
+	proto.RegisterType((*QueryUpgradeScheduleRequest)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeScheduleRequest")
+	proto.RegisterType((*QueryUpgradeScheduleResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeScheduleResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
 }
@@ -510,46 +625,53 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 611 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xee, 0xa5, 0x7f, 0x7e, 0x3f, 0x2e, 0xa8, 0xa0, 0x93, 0x48, 0x8d, 0xa9, 0x4c, 0x75, 0x14,
-	0x28, 0xa2, 0xf1, 0xb5, 0xc9, 0x82, 0x8a, 0x40, 0x40, 0x25, 0x44, 0x11, 0x54, 0x10, 0x04, 0x03,
-	0x4b, 0x74, 0x89, 0x4f, 0x89, 0x85, 0xed, 0x73, 0x7d, 0xe7, 0x8a, 0xa8, 0xea, 0xc2, 0xc4, 0x88,
-	0xc4, 0xce, 0xc6, 0xc2, 0x27, 0x61, 0xac, 0xc4, 0xc2, 0xd0, 0x01, 0x25, 0x7c, 0x10, 0xe4, 0xf3,
-	0x19, 0x39, 0x8d, 0x9d, 0x02, 0x53, 0xe2, 0xbb, 0xe7, 0x79, 0xde, 0xe7, 0xf5, 0xfb, 0xbc, 0x86,
-	0xb8, 0xcb, 0x85, 0xcf, 0x05, 0x89, 0xc3, 0x5e, 0x44, 0x1d, 0x46, 0xf6, 0x37, 0x3b, 0x4c, 0xd2,
-	0x4d, 0xb2, 0x17, 0xb3, 0x68, 0x60, 0x87, 0x11, 0x97, 0x1c, 0xd5, 0x52, 0x8c, 0xad, 0x31, 0xb6,
-	0xc6, 0x98, 0xcb, 0x3d, 0xce, 0x7b, 0x1e, 0x23, 0x34, 0x74, 0x09, 0x0d, 0x02, 0x2e, 0xa9, 0x74,
-	0x79, 0x20, 0x52, 0x96, 0xb9, 0x5a, 0xa2, 0x9c, 0xa9, 0x28, 0x14, 0xbe, 0x08, 0x97, 0x9e, 0x27,
-	0xa5, 0xb6, 0xe3, 0x28, 0x62, 0x81, 0x7c, 0xe6, 0xd1, 0xa0, 0xc5, 0xf6, 0x62, 0x26, 0x24, 0x7e,
-	0x02, 0x8d, 0xc9, 0x2b, 0x11, 0xf2, 0x40, 0x30, 0xb4, 0x01, 0xe7, 0x42, 0x8f, 0x06, 0x06, 0x58,
-	0x99, 0x5d, 0xab, 0x36, 0x96, 0xed, 0x62, 0x87, 0xb6, 0xe2, 0x28, 0x24, 0xae, 0xeb, 0x42, 0xf7,
-	0xc3, 0xd0, 0x73, 0x99, 0x93, 0x2b, 0x84, 0x10, 0x9c, 0x0b, 0xa8, 0xcf, 0x0c, 0xb0, 0x02, 0xd6,
-	0xce, 0xb4, 0xd4, 0x7f, 0xdc, 0xd0, 0xc5, 0xc7, 0xe0, 0xba, 0x78, 0x0d, 0x2e, 0xf4, 0x99, 0xdb,
-	0xeb, 0x4b, 0xc5, 0x98, 0x6d, 0xe9, 0x27, 0xbc, 0x03, 0xb1, 0xe2, 0xbc, 0x4c, 0x5d, 0x38, 0xdb,
-	0x09, 0x3a, 0x10, 0xb1, 0x78, 0x21, 0xa9, 0x64, 0x59, 0xb5, 0xcb, 0xb0, 0xea, 0x51, 0x21, 0xdb,
-	0x63, 0x12, 0x30, 0x39, 0x7a, 0xa4, 0x4e, 0xb6, 0x2a, 0x06, 0xc0, 0x2e, 0xbc, 0x32, 0x55, 0x4a,
-	0x3b, 0xb9, 0x05, 0x0d, 0xdd, 0xb2, 0xd3, 0xee, 0x66, 0x90, 0xb6, 0x48, 0x30, 0x46, 0x65, 0x05,
-	0xac, 0x9d, 0x6d, 0xd5, 0xe2, 0x42, 0x85, 0xa4, 0xc8, 0xe3, 0xb9, 0xff, 0xc1, 0xf9, 0x0a, 0xbe,
-	0x03, 0x4d, 0x55, 0xea, 0x29, 0x77, 0x62, 0x8f, 0xbd, 0x62, 0x91, 0x48, 0x86, 0x98, 0x73, 0xeb,
-	0xab, 0x8b, 0x76, 0xee, 0x15, 0xc1, 0xf4, 0x68, 0x37, 0x79, 0x51, 0x3e, 0xbc, 0x54, 0x48, 0xd7,
-	0x0e, 0x77, 0xe1, 0x39, 0xcd, 0xdf, 0xd7, 0x57, 0x7a, 0x66, 0x57, 0xcb, 0x66, 0x36, 0x26, 0xd4,
-	0x5a, 0xf4, 0xc7, 0x74, 0xf1, 0x12, 0xbc, 0x90, 0xce, 0x25, 0x96, 0x7d, 0x1e, 0xb9, 0x72, 0x90,
-	0xa5, 0xa5, 0x01, 0x6b, 0x27, 0x2f, 0xb4, 0x05, 0x03, 0xfe, 0x47, 0x1d, 0x27, 0x62, 0x42, 0x68,
-	0xfb, 0xd9, 0x63, 0xe3, 0x78, 0x1e, 0xce, 0x2b, 0x12, 0xfa, 0x04, 0x60, 0x35, 0x97, 0x33, 0x44,
-	0xca, 0xdc, 0x95, 0x84, 0xd5, 0xdc, 0xf8, 0x73, 0x42, 0x6a, 0x0b, 0xaf, 0xbf, 0xfb, 0xf6, 0xf3,
-	0x63, 0xe5, 0x1a, 0x5a, 0x25, 0x25, 0x8b, 0xd2, 0x4d, 0x49, 0xed, 0x24, 0xbe, 0xe8, 0x33, 0x80,
-	0xd5, 0x5c, 0x16, 0x4f, 0x31, 0x38, 0x19, 0xf2, 0x53, 0x0c, 0x16, 0xc4, 0x1c, 0x37, 0x95, 0xc1,
-	0x3a, 0xba, 0x59, 0x66, 0x90, 0xa6, 0x24, 0x65, 0x90, 0x1c, 0x24, 0xf9, 0x38, 0x44, 0xc7, 0x00,
-	0xd6, 0x8a, 0x43, 0x8b, 0xb6, 0xa6, 0x3a, 0x98, 0xba, 0x34, 0xe6, 0xed, 0x7f, 0xe2, 0xea, 0x46,
-	0x76, 0x54, 0x23, 0xf7, 0xd0, 0x5d, 0x32, 0xfd, 0x93, 0x34, 0xb1, 0x43, 0xe4, 0x20, 0xb7, 0xa9,
-	0x87, 0xef, 0x2b, 0x00, 0x7d, 0x01, 0x70, 0x71, 0x3c, 0xe9, 0xa8, 0x31, 0xd5, 0x5a, 0xe1, 0x56,
-	0x99, 0xcd, 0xbf, 0xe2, 0xe8, 0x36, 0x88, 0x6a, 0xe3, 0x06, 0xba, 0x5e, 0xd6, 0xc6, 0x89, 0x45,
-	0x7b, 0xf0, 0xf0, 0xeb, 0xd0, 0x02, 0x47, 0x43, 0x0b, 0xfc, 0x18, 0x5a, 0xe0, 0xc3, 0xc8, 0x9a,
-	0x39, 0x1a, 0x59, 0x33, 0xdf, 0x47, 0xd6, 0xcc, 0xeb, 0xf5, 0x9e, 0x2b, 0xfb, 0x71, 0xc7, 0xee,
-	0x72, 0x3f, 0x13, 0x4b, 0x7f, 0xea, 0xc2, 0x79, 0x43, 0xde, 0xfe, 0x56, 0x96, 0x83, 0x90, 0x89,
-	0xce, 0x82, 0xfa, 0x54, 0x37, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x46, 0x87, 0x09, 0xdc, 0x2c,
-	0x06, 0x00, 0x00,
+	// 732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6f, 0xd3, 0x4a,
+	0x10, 0xee, 0xa6, 0xe9, 0x8f, 0x37, 0xe9, 0x6b, 0x9f, 0x56, 0x6a, 0xea, 0x97, 0xd7, 0x17, 0x22,
+	0xb7, 0x40, 0x80, 0x36, 0x6e, 0x13, 0x84, 0x50, 0x11, 0x08, 0xa8, 0x84, 0x5a, 0x04, 0x15, 0xa4,
+	0x82, 0x03, 0x97, 0x68, 0x6b, 0xaf, 0x62, 0x0b, 0xff, 0xaa, 0x77, 0x5d, 0x51, 0x55, 0xbd, 0x70,
+	0xe2, 0x82, 0x84, 0xc4, 0x9d, 0x1b, 0x17, 0x0e, 0xfc, 0x1d, 0x70, 0xab, 0xc4, 0x85, 0x03, 0x07,
+	0xd4, 0xf2, 0x87, 0x20, 0xaf, 0xd7, 0x95, 0x93, 0xda, 0x69, 0xcb, 0x29, 0xd9, 0x99, 0xef, 0x9b,
+	0xf9, 0x66, 0x76, 0x67, 0x0c, 0xaa, 0xee, 0x31, 0xc7, 0x63, 0x5a, 0xe8, 0x77, 0x03, 0x62, 0x50,
+	0x6d, 0x67, 0x79, 0x8b, 0x72, 0xb2, 0xac, 0x6d, 0x87, 0x34, 0xd8, 0x6d, 0xf8, 0x81, 0xc7, 0x3d,
+	0x5c, 0x8e, 0x31, 0x0d, 0x89, 0x69, 0x48, 0x4c, 0x65, 0xb6, 0xeb, 0x79, 0x5d, 0x9b, 0x6a, 0xc4,
+	0xb7, 0x34, 0xe2, 0xba, 0x1e, 0x27, 0xdc, 0xf2, 0x5c, 0x16, 0xb3, 0x2a, 0xf3, 0x39, 0x91, 0x93,
+	0x28, 0x02, 0xa5, 0xfe, 0x0b, 0x33, 0x4f, 0xa3, 0x54, 0xab, 0x61, 0x10, 0x50, 0x97, 0x3f, 0xb1,
+	0x89, 0xdb, 0xa6, 0xdb, 0x21, 0x65, 0x5c, 0x7d, 0x04, 0xca, 0x49, 0x17, 0xf3, 0x3d, 0x97, 0x51,
+	0xbc, 0x04, 0x45, 0xdf, 0x26, 0xae, 0x82, 0x6a, 0xc3, 0xf5, 0x52, 0x73, 0xb6, 0x91, 0xad, 0xb0,
+	0x21, 0x38, 0x02, 0xa9, 0x2e, 0xca, 0x44, 0xf7, 0x7c, 0xdf, 0xb6, 0xa8, 0x91, 0x4a, 0x84, 0x31,
+	0x14, 0x5d, 0xe2, 0x50, 0x05, 0xd5, 0x50, 0xfd, 0xaf, 0xb6, 0xf8, 0xaf, 0x36, 0x65, 0xf2, 0x1e,
+	0xb8, 0x4c, 0x5e, 0x86, 0x51, 0x93, 0x5a, 0x5d, 0x93, 0x0b, 0xc6, 0x70, 0x5b, 0x9e, 0xd4, 0x75,
+	0x50, 0x05, 0xe7, 0x59, 0xac, 0xc2, 0x58, 0x8d, 0xd0, 0x2e, 0x0b, 0xd9, 0x26, 0x27, 0x9c, 0x26,
+	0xd9, 0x2e, 0x40, 0xc9, 0x26, 0x8c, 0x77, 0x7a, 0x42, 0x40, 0x64, 0x5a, 0x13, 0x96, 0x95, 0x82,
+	0x82, 0x54, 0x0b, 0xe6, 0x06, 0x86, 0x92, 0x4a, 0x6e, 0x82, 0x22, 0x4b, 0x36, 0x3a, 0x7a, 0x02,
+	0xe9, 0xb0, 0x08, 0xa3, 0x14, 0x6a, 0xa8, 0x3e, 0xd1, 0x2e, 0x87, 0x99, 0x11, 0xa2, 0x24, 0x0f,
+	0x8b, 0xe3, 0xe8, 0x9f, 0x82, 0x7a, 0x1b, 0x2a, 0x22, 0xd5, 0x63, 0xcf, 0x08, 0x6d, 0xfa, 0x9c,
+	0x06, 0x2c, 0xba, 0xc4, 0x94, 0x5a, 0x47, 0x38, 0x3a, 0xa9, 0x16, 0x41, 0x6c, 0xda, 0x88, 0x1a,
+	0xe5, 0xc0, 0x7f, 0x99, 0x74, 0xa9, 0x70, 0x03, 0xa6, 0x24, 0x7f, 0x47, 0xba, 0xe4, 0x9d, 0x5d,
+	0xcc, 0xbb, 0xb3, 0x9e, 0x40, 0xed, 0x49, 0xa7, 0x27, 0xae, 0xfa, 0xbf, 0x4c, 0x27, 0x1b, 0xb3,
+	0xa9, 0x9b, 0x34, 0xf2, 0x27, 0x6f, 0xe6, 0x2b, 0x82, 0xd9, 0x6c, 0xbf, 0xd4, 0xd3, 0x84, 0x91,
+	0xe8, 0x39, 0xb0, 0x33, 0xbd, 0x9c, 0x18, 0x8a, 0xe7, 0xe0, 0x6f, 0x26, 0xe3, 0x74, 0x4c, 0xc2,
+	0x4c, 0xd9, 0xda, 0x89, 0xc4, 0xb8, 0x46, 0x98, 0x89, 0x6f, 0xc0, 0x8c, 0x4e, 0x5c, 0xcf, 0xb5,
+	0x74, 0x62, 0x77, 0x7a, 0xe1, 0xc3, 0x02, 0x3e, 0x7d, 0xec, 0xde, 0x4c, 0xf3, 0x14, 0x18, 0x73,
+	0x08, 0x8f, 0x2c, 0x4a, 0xb1, 0x86, 0xea, 0xe3, 0xed, 0xe4, 0xa8, 0xce, 0xc0, 0x74, 0xfc, 0x04,
+	0x43, 0x6e, 0x7a, 0x81, 0xc5, 0x77, 0x93, 0x22, 0x9b, 0x50, 0xee, 0x77, 0xc8, 0xea, 0x14, 0x18,
+	0x23, 0x86, 0x11, 0x50, 0xc6, 0xe4, 0x4d, 0x25, 0xc7, 0xe6, 0xdb, 0x31, 0x18, 0x11, 0x24, 0xfc,
+	0x01, 0x41, 0x29, 0x35, 0x52, 0x58, 0xcb, 0x6b, 0x41, 0xce, 0x5c, 0x56, 0x96, 0xce, 0x4e, 0x88,
+	0x65, 0xa9, 0x0b, 0xaf, 0xbf, 0xfd, 0x7a, 0x5f, 0xb8, 0x84, 0xe7, 0xb5, 0x9c, 0x9d, 0xa0, 0xc7,
+	0xa4, 0x4e, 0xd4, 0x6f, 0xfc, 0x11, 0x41, 0x29, 0x35, 0x76, 0xa7, 0x08, 0x3c, 0x39, 0xcf, 0xa7,
+	0x08, 0xcc, 0x98, 0x68, 0xb5, 0x25, 0x04, 0x2e, 0xe2, 0x6b, 0x79, 0x02, 0x49, 0x4c, 0x12, 0x02,
+	0xb5, 0xbd, 0x68, 0x14, 0xf6, 0xf1, 0x0f, 0x04, 0xe5, 0xec, 0xf9, 0xc4, 0x2b, 0x03, 0x15, 0x0c,
+	0xdc, 0x0f, 0x95, 0x5b, 0x7f, 0xc4, 0x95, 0x85, 0xac, 0x8b, 0x42, 0xee, 0xe2, 0x3b, 0xda, 0xe0,
+	0xed, 0x7b, 0x62, 0x5d, 0x68, 0x7b, 0xa9, 0xa5, 0xb4, 0xff, 0xa6, 0x80, 0xf0, 0x27, 0x04, 0x93,
+	0xbd, 0x43, 0x8d, 0x9b, 0x03, 0xa5, 0x65, 0x2e, 0x90, 0x4a, 0xeb, 0x5c, 0x1c, 0x59, 0x86, 0x26,
+	0xca, 0xb8, 0x82, 0x2f, 0xe7, 0x95, 0xd1, 0xb7, 0x53, 0xf0, 0x67, 0x04, 0x53, 0x7d, 0x23, 0x8f,
+	0x5b, 0x67, 0x69, 0x64, 0xdf, 0x02, 0xa9, 0x5c, 0x3f, 0x1f, 0x49, 0xea, 0x5d, 0x12, 0x7a, 0xaf,
+	0xe2, 0xfa, 0x29, 0x6d, 0x3f, 0x5e, 0x0c, 0xf7, 0x1f, 0x7c, 0x39, 0xac, 0xa2, 0x83, 0xc3, 0x2a,
+	0xfa, 0x79, 0x58, 0x45, 0xef, 0x8e, 0xaa, 0x43, 0x07, 0x47, 0xd5, 0xa1, 0xef, 0x47, 0xd5, 0xa1,
+	0x17, 0x0b, 0x5d, 0x8b, 0x9b, 0xe1, 0x56, 0x43, 0xf7, 0x9c, 0x24, 0x5a, 0xfc, 0xb3, 0xc8, 0x8c,
+	0x97, 0xda, 0xab, 0xe3, 0xd0, 0x7c, 0xd7, 0xa7, 0x6c, 0x6b, 0x54, 0x7c, 0x46, 0x5b, 0xbf, 0x03,
+	0x00, 0x00, 0xff, 0xff, 0xac, 0xb4, 0x72, 0x59, 0xc8, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -579,6 +701,9 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// UpgradeSchedule queries the effective upgrade schedule of the node and
+	// checks it against the canonical schedule published by governance.
+	UpgradeSchedule(ctx context.Context, in *QueryUpgradeScheduleRequest, opts ...grpc.CallOption) (*QueryUpgradeScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -626,6 +751,15 @@ func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersion
 	return out, nil
 }
 
+func (c *queryClient) UpgradeSchedule(ctx context.Context, in *QueryUpgradeScheduleRequest, opts ...grpc.CallOption) (*QueryUpgradeScheduleResponse, error) {
+	out := new(QueryUpgradeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/UpgradeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -643,6 +777,9 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// UpgradeSchedule queries the effective upgrade schedule of the node and
+	// checks it against the canonical schedule published by governance.
+	UpgradeSchedule(context.Context, *QueryUpgradeScheduleRequest) (*QueryUpgradeScheduleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -661,6 +798,9 @@ func (*UnimplementedQueryServer) UpgradedConsensusState(ctx context.Context, req
 func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
 }
+func (*UnimplementedQueryServer) UpgradeSchedule(ctx context.Context, req *QueryUpgradeScheduleRequest) (*QueryUpgradeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeSchedule not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -738,6 +878,24 @@ func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpgradeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/UpgradeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeSchedule(ctx, req.(*QueryUpgradeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -758,6 +916,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleVersions",
 			Handler:    _Query_ModuleVersions_Handler,
 		},
+		{
+			MethodName: "UpgradeSchedule",
+			Handler:    _Query_UpgradeSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1006,6 +1168,90 @@ func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUpgradeScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Matched {
+		i--
+		if m.Matched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CanonicalScheduleHash) > 0 {
+		i -= len(m.CanonicalScheduleHash)
+		copy(dAtA[i:], m.CanonicalScheduleHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CanonicalScheduleHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScheduleHash) > 0 {
+		i -= len(m.ScheduleHash)
+		copy(dAtA[i:], m.ScheduleHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScheduleHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAuthorityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1172,6 +1418,41 @@ func (m *QueryModuleVersionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUpgradeScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpgradeScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ScheduleHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CanonicalScheduleHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Matched {
+		n += 2
+	}
+	return n
+}
+
 func (m *QueryAuthorityRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1804,6 +2085,228 @@ func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUpgradeScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, &Plan{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleHash = append(m.ScheduleHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ScheduleHash == nil {
+				m.ScheduleHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalScheduleHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalScheduleHash = append(m.CanonicalScheduleHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CanonicalScheduleHash == nil {
+				m.CanonicalScheduleHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Matched = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAuthorityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
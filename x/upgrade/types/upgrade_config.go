@@ -1,14 +1,17 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"math"
+	"sort"
 )
 
 const (
 	// EnablePublicDelegationUpgrade is the upgrade name for enabling public delegation
 	EnablePublicDelegationUpgrade = "EnablePublicDelegationUpgrade"
 
-	// Nagqu is the upgrade name for following features:
+	// Nagqu is the upgrade name for enabling the staking, governance, cross chain and signing features of the release
 	Nagqu = "Nagqu"
 )
 
@@ -83,3 +86,42 @@ func (c *UpgradeConfig) GetPlan(height int64) []*Plan {
 	}
 	return plans
 }
+
+// Plans returns all the upgrade plans sorted by height and name
+func (c *UpgradeConfig) Plans() []*Plan {
+	plans := make([]*Plan, 0, len(c.keys))
+	for _, vPlans := range c.elements {
+		plans = append(plans, vPlans...)
+	}
+	SortPlans(plans)
+	return plans
+}
+
+// SortPlans sorts the upgrade plans by height and name
+func SortPlans(plans []*Plan) {
+	sort.Slice(plans, func(i, j int) bool {
+		if plans[i].Height != plans[j].Height {
+			return plans[i].Height < plans[j].Height
+		}
+		return plans[i].Name < plans[j].Name
+	})
+}
+
+// ScheduleHash returns the hash of the upgrade schedule. Only the names and the heights of the plans
+// are hashed, since they are what the nodes must agree on, and the order of the plans does not matter.
+func ScheduleHash(plans []*Plan) []byte {
+	sorted := make([]*Plan, len(plans))
+	copy(sorted, plans)
+	SortPlans(sorted)
+
+	h := sha256.New()
+	buf := make([]byte, 8)
+	for _, plan := range sorted {
+		binary.BigEndian.PutUint64(buf, uint64(plan.Height))
+		h.Write(buf)
+		binary.BigEndian.PutUint64(buf, uint64(len(plan.Name)))
+		h.Write(buf)
+		h.Write([]byte(plan.Name))
+	}
+	return h.Sum(nil)
+}